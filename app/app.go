@@ -121,9 +121,11 @@ import (
 	perpamm "github.com/NibiruChain/nibiru/x/perp/amm"
 	perpammcli "github.com/NibiruChain/nibiru/x/perp/amm/cli"
 	perpammkeeper "github.com/NibiruChain/nibiru/x/perp/amm/keeper"
+	perpv2cli "github.com/NibiruChain/nibiru/x/perp/client/cli/v2"
 	perpkeeper "github.com/NibiruChain/nibiru/x/perp/keeper/v1"
 	perpkeeperv2 "github.com/NibiruChain/nibiru/x/perp/keeper/v2"
 	perp "github.com/NibiruChain/nibiru/x/perp/module/v1"
+	perpv2 "github.com/NibiruChain/nibiru/x/perp/module/v2"
 	perptypes "github.com/NibiruChain/nibiru/x/perp/types/v1"
 	perptypesv2 "github.com/NibiruChain/nibiru/x/perp/types/v2"
 
@@ -176,6 +178,8 @@ var (
 			upgradeclient.CancelProposalHandler,
 			perpammcli.CreatePoolProposalHandler,
 			perpammcli.EditPoolConfigProposalHandler,
+			perpv2cli.SetAutoDeleveragingProposalHandler,
+			perpv2cli.ResetCircuitBreakerProposalHandler,
 			ibcclientclient.UpdateClientProposalHandler,
 			ibcclientclient.UpgradeProposalHandler,
 		),
@@ -196,6 +200,7 @@ var (
 		epochs.AppModuleBasic{},
 		stablecoin.AppModuleBasic{},
 		perp.AppModuleBasic{},
+		perpv2.AppModuleBasic{},
 		perpamm.AppModuleBasic{},
 		inflation.AppModuleBasic{},
 		sudo.AppModuleBasic{},
@@ -205,26 +210,28 @@ var (
 
 	// module account permissions
 	maccPerms = map[string][]string{
-		authtypes.FeeCollectorName:            nil,
-		distrtypes.ModuleName:                 nil,
-		inflationtypes.ModuleName:             {authtypes.Minter},
-		stakingtypes.BondedPoolName:           {authtypes.Burner, authtypes.Staking},
-		stakingtypes.NotBondedPoolName:        {authtypes.Burner, authtypes.Staking},
-		govtypes.ModuleName:                   {authtypes.Burner},
-		spottypes.ModuleName:                  {authtypes.Minter, authtypes.Burner},
-		oracletypes.ModuleName:                {},
-		ibctransfertypes.ModuleName:           {authtypes.Minter, authtypes.Burner},
-		ibcfeetypes.ModuleName:                nil,
-		stablecointypes.ModuleName:            {authtypes.Minter, authtypes.Burner},
-		perptypes.ModuleName:                  {authtypes.Minter, authtypes.Burner},
-		perptypes.VaultModuleAccount:          {},
-		perptypes.PerpEFModuleAccount:         {},
-		perptypes.FeePoolModuleAccount:        {},
-		epochstypes.ModuleName:                {},
-		stablecointypes.StableEFModuleAccount: {authtypes.Burner},
-		sudo.ModuleName:                       {},
-		common.TreasuryPoolModuleAccount:      {},
-		wasm.ModuleName:                       {},
+		authtypes.FeeCollectorName:                  nil,
+		distrtypes.ModuleName:                       nil,
+		inflationtypes.ModuleName:                   {authtypes.Minter},
+		stakingtypes.BondedPoolName:                 {authtypes.Burner, authtypes.Staking},
+		stakingtypes.NotBondedPoolName:              {authtypes.Burner, authtypes.Staking},
+		govtypes.ModuleName:                         {authtypes.Burner},
+		spottypes.ModuleName:                        {authtypes.Minter, authtypes.Burner},
+		oracletypes.ModuleName:                      {},
+		ibctransfertypes.ModuleName:                 {authtypes.Minter, authtypes.Burner},
+		ibcfeetypes.ModuleName:                      nil,
+		stablecointypes.ModuleName:                  {authtypes.Minter, authtypes.Burner},
+		perptypes.ModuleName:                        {authtypes.Minter, authtypes.Burner},
+		perptypes.VaultModuleAccount:                {},
+		perptypes.PerpEFModuleAccount:               {},
+		perptypes.FeePoolModuleAccount:              {},
+		perptypesv2.InsuranceFundModuleAccount:      {},
+		perptypesv2.TriggerOrderEscrowModuleAccount: {},
+		epochstypes.ModuleName:                      {},
+		stablecointypes.StableEFModuleAccount:       {authtypes.Burner},
+		sudo.ModuleName:                             {},
+		common.TreasuryPoolModuleAccount:            {},
+		wasm.ModuleName:                             {},
 	}
 )
 
@@ -340,6 +347,7 @@ func GetWasmOpts(nibiru NibiruApp, appOpts servertypes.AppOptions) []wasm.Option
 	wasmOpts = append(wasmOpts, wasmbinding.RegisterWasmOptions(
 		&nibiru.PerpKeeper,
 		&nibiru.PerpAmmKeeper,
+		&nibiru.PerpKeeperV2,
 		&nibiru.SudoKeeper,
 	)...)
 
@@ -400,6 +408,13 @@ func NewNibiruApp(
 		return fromVM, nil
 	})
 
+	app.upgradeKeeper.SetUpgradeHandler("v0.20.0", func(ctx sdk.Context, plan upgradetypes.Plan, fromVM module.VersionMap) (module.VersionMap, error) {
+		// x/perp v2 is new, so its version is set to 1 for its state to be
+		// migrated from x/perp v1 and x/perp/amm instead of InitGenesis
+		fromVM[perptypesv2.ModuleName] = 1
+		return app.mm.RunMigrations(ctx, app.configurator, fromVM)
+	})
+
 	// add test gRPC service for testing gRPC queries in isolation
 	testdata.RegisterQueryServer(app.GRPCQueryRouter(), testdata.QueryImpl{})
 
@@ -103,6 +103,7 @@ import (
 	perpkeeper "github.com/NibiruChain/nibiru/x/perp/keeper/v1"
 	v2perpkeeper "github.com/NibiruChain/nibiru/x/perp/keeper/v2"
 	perp "github.com/NibiruChain/nibiru/x/perp/module/v1"
+	perpv2 "github.com/NibiruChain/nibiru/x/perp/module/v2"
 
 	perptypes "github.com/NibiruChain/nibiru/x/perp/types/v1"
 	v2perptypes "github.com/NibiruChain/nibiru/x/perp/types/v2"
@@ -269,7 +270,7 @@ func (app *NibiruApp) InitKeepers(
 		app.AccountKeeper, app.BankKeeper, app.OracleKeeper, app.PerpAmmKeeper, app.EpochsKeeper,
 	)
 
-	app.PerpKeeperV2 = v2perpkeeper.NewKeeper(appCodec, keys[v2perptypes.StoreKey], app.GetSubspace(v2perptypes.ModuleName), app.AccountKeeper, app.BankKeeper, app.OracleKeeper, app.EpochsKeeper)
+	app.PerpKeeperV2 = v2perpkeeper.NewKeeper(appCodec, keys[v2perptypes.StoreKey], app.GetSubspace(v2perptypes.ModuleName), app.AccountKeeper, app.BankKeeper, app.OracleKeeper, app.EpochsKeeper, app.stakingKeeper)
 
 	app.InflationKeeper = inflationkeeper.NewKeeper(
 		appCodec, keys[inflationtypes.StoreKey], app.GetSubspace(inflationtypes.ModuleName),
@@ -355,7 +356,8 @@ func (app *NibiruApp) InitKeepers(
 		AddRoute(distrtypes.RouterKey, distr.NewCommunityPoolSpendProposalHandler(app.DistrKeeper)).
 		AddRoute(upgradetypes.RouterKey, upgrade.NewSoftwareUpgradeProposalHandler(app.upgradeKeeper)).
 		AddRoute(ibcclienttypes.RouterKey, ibcclient.NewClientProposalHandler(app.ibcKeeper.ClientKeeper)).
-		AddRoute(perpammtypes.RouterKey, perpamm.NewMarketProposalHandler(app.PerpAmmKeeper))
+		AddRoute(perpammtypes.RouterKey, perpamm.NewMarketProposalHandler(app.PerpAmmKeeper)).
+		AddRoute(v2perptypes.RouterKey, perpv2.NewProposalHandler(app.PerpKeeperV2))
 
 	// Create evidence keeper.
 	// This keeper automatically includes an evidence router.
@@ -448,8 +450,12 @@ func (app *NibiruApp) AppModules(
 		appCodec, app.PerpKeeper, app.AccountKeeper, app.BankKeeper,
 		app.OracleKeeper,
 	)
+	perpv2Module := perpv2.NewAppModule(
+		appCodec, app.PerpKeeperV2, app.PerpKeeper, app.AccountKeeper, app.BankKeeper,
+		app.OracleKeeper,
+	)
 	perpAmmModule := perpamm.NewAppModule(
-		appCodec, app.PerpAmmKeeper, app.OracleKeeper,
+		appCodec, app.PerpAmmKeeper, app.OracleKeeper, app.PerpKeeper,
 	)
 	inflationModule := inflation.NewAppModule(
 		app.InflationKeeper, app.AccountKeeper, app.stakingKeeper,
@@ -482,6 +488,7 @@ func (app *NibiruApp) AppModules(
 		epochsModule,
 		perpAmmModule,
 		perpModule,
+		perpv2Module,
 		inflationModule,
 		sudoModule,
 
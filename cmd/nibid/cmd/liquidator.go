@@ -0,0 +1,119 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cobra"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/NibiruChain/nibiru/x/common/asset"
+	"github.com/NibiruChain/nibiru/x/perp/client/liquidator"
+)
+
+const (
+	flagLiquidatorPairs     = "pairs"
+	flagLiquidatorBatchSize = "batch-size"
+	flagLiquidatorMaxGas    = "max-gas"
+	flagLiquidatorMaxFee    = "max-fee"
+	flagLiquidatorOnce      = "once"
+)
+
+// LiquidatorCmd returns the command running the x/perp liquidation bot.
+func LiquidatorCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "liquidator",
+		Short: "Run a bot liquidating underwater x/perp positions",
+		Long: `liquidator follows new blocks through the RPC of a node, scans the
+positions of every x/perp market and liquidates the positions below their
+maintenance margin ratio with batched MsgMultiLiquidate transactions signed by
+the --from account. With --dry-run, the positions to liquidate are only logged.
+
+Example:
+	nibid liquidator --from liquidator --gas auto --gas-prices 0.025unibi --max-fee 10000unibi
+	nibid liquidator --pairs ubtc:unusd --dry-run --once
+	`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			txf := tx.NewFactoryCLI(clientCtx, cmd.Flags())
+
+			cfg, err := readLiquidatorConfig(cmd)
+			if err != nil {
+				return err
+			}
+
+			logger := log.NewTMLogger(log.NewSyncWriter(cmd.OutOrStdout()))
+			bot, err := liquidator.New(clientCtx.WithSkipConfirmation(true), txf, cfg, logger)
+			if err != nil {
+				return err
+			}
+
+			once, err := cmd.Flags().GetBool(flagLiquidatorOnce)
+			if err != nil {
+				return err
+			}
+			if once {
+				_, err = bot.RunOnce(cmd.Context())
+				return err
+			}
+			return bot.Run(cmd.Context())
+		},
+	}
+
+	defaultCfg := liquidator.DefaultConfig()
+	cmd.Flags().StringSlice(flagLiquidatorPairs, nil, "only liquidate positions in these markets, every market if empty")
+	cmd.Flags().Int(flagLiquidatorBatchSize, defaultCfg.BatchSize, "maximum number of liquidations per transaction")
+	cmd.Flags().Uint64(flagLiquidatorMaxGas, defaultCfg.MaxGas, "maximum gas of a liquidation transaction, unlimited if zero")
+	cmd.Flags().String(flagLiquidatorMaxFee, "", "maximum fee of a liquidation transaction, unlimited if empty")
+	cmd.Flags().Bool(flagLiquidatorOnce, false, "scan the positions once and exit")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func readLiquidatorConfig(cmd *cobra.Command) (cfg liquidator.Config, err error) {
+	cfg = liquidator.DefaultConfig()
+
+	pairs, err := cmd.Flags().GetStringSlice(flagLiquidatorPairs)
+	if err != nil {
+		return cfg, err
+	}
+	for _, pair := range pairs {
+		p, err := asset.TryNewPair(pair)
+		if err != nil {
+			return cfg, err
+		}
+		cfg.Pairs = append(cfg.Pairs, p)
+	}
+
+	if cfg.BatchSize, err = cmd.Flags().GetInt(flagLiquidatorBatchSize); err != nil {
+		return cfg, err
+	}
+	if cfg.MaxGas, err = cmd.Flags().GetUint64(flagLiquidatorMaxGas); err != nil {
+		return cfg, err
+	}
+
+	maxFee, err := cmd.Flags().GetString(flagLiquidatorMaxFee)
+	if err != nil {
+		return cfg, err
+	}
+	if maxFee != "" {
+		if cfg.MaxFee, err = sdk.ParseCoinsNormalized(maxFee); err != nil {
+			return cfg, fmt.Errorf("invalid max fee: %w", err)
+		}
+	}
+
+	// reuses the --dry-run tx flag
+	if cfg.DryRun, err = cmd.Flags().GetBool(flags.FlagDryRun); err != nil {
+		return cfg, err
+	}
+
+	return cfg, nil
+}
@@ -94,7 +94,7 @@ func PerpAmmGenesis() *perpammtypes.GenesisState {
 
 func OracleGenesis() *oracletypes.GenesisState {
 	oracleGenesis := oracletypes.DefaultGenesisState()
-	oracleGenesis.ExchangeRates = []oracletypes.ExchangeRateTuple{
+	oracleGenesis.ExchangeRates = []oracletypes.GenesisExchangeRate{
 		{Pair: asset.Registry.Pair(denoms.ETH, denoms.NUSD), ExchangeRate: sdk.NewDec(1_000)},
 		{Pair: asset.Registry.Pair(denoms.NIBI, denoms.NUSD), ExchangeRate: sdk.NewDec(10)},
 	}
@@ -255,6 +255,21 @@ func (mr *MockOracleKeeperMockRecorder) GetExchangeRateTwap(arg0, arg1 interface
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetExchangeRateTwap", reflect.TypeOf((*MockOracleKeeper)(nil).GetExchangeRateTwap), arg0, arg1)
 }
 
+// GetExchangeRateWithMaxAge mocks base method.
+func (m *MockOracleKeeper) GetExchangeRateWithMaxAge(arg0 types1.Context, arg1 asset.Pair) (types1.Dec, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetExchangeRateWithMaxAge", arg0, arg1)
+	ret0, _ := ret[0].(types1.Dec)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetExchangeRateWithMaxAge indicates an expected call of GetExchangeRateWithMaxAge.
+func (mr *MockOracleKeeperMockRecorder) GetExchangeRateWithMaxAge(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetExchangeRateWithMaxAge", reflect.TypeOf((*MockOracleKeeper)(nil).GetExchangeRateWithMaxAge), arg0, arg1)
+}
+
 // SetPrice mocks base method.
 func (m *MockOracleKeeper) SetPrice(arg0 types1.Context, arg1 asset.Pair, arg2 types1.Dec) {
 	m.ctrl.T.Helper()
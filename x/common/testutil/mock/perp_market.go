@@ -26,5 +26,17 @@ func TestMarket() *v2types.Market {
 		FundingRateEpochId:              "30 min",
 		TwapLookbackWindow:              time.Minute * 30,
 		PrepaidBadDebt:                  sdk.NewInt64Coin(denoms.NUSD, 0),
+		InsuranceFundFeeShare:           sdk.ZeroDec(),
+		MaxFundingRate:                  sdk.ZeroDec(),
+		MinFundingRate:                  sdk.ZeroDec(),
+		InterestRate:                    sdk.ZeroDec(),
+		MaxSpreadRatio:                  sdk.ZeroDec(),
+		MaxSkewRatio:                    sdk.ZeroDec(),
+		AmmUpdateBudget:                 sdk.ZeroInt(),
+		CircuitBreakerPriceChangeRatio:  sdk.ZeroDec(),
+		MaxOpenInterest:                 sdk.ZeroDec(),
+		MaxPositionNotional:             sdk.ZeroDec(),
+		AuctionDiscountPerBlock:         sdk.ZeroDec(),
+		AuctionMaxDiscount:              sdk.ZeroDec(),
 	}
 }
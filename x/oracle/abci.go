@@ -27,4 +27,6 @@ func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
 	if types.IsPeriodLastBlock(ctx, params.SlashWindow) {
 		k.SlashAndResetMissCounters(ctx)
 	}
+
+	k.PruneSnapshots(ctx)
 }
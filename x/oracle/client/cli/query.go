@@ -26,6 +26,8 @@ func GetQueryCmd() *cobra.Command {
 
 	oracleQueryCmd.AddCommand(
 		GetCmdQueryExchangeRates(),
+		GetCmdQueryDerivedExchangeRates(),
+		GetCmdQueryExchangeRateStats(),
 		GetCmdQueryActives(),
 		GetCmdQueryParams(),
 		GetCmdQueryFeederDelegation(),
@@ -91,6 +93,78 @@ $ nibid query oracle exchange-rates nibi:usd
 	return cmd
 }
 
+// GetCmdQueryDerivedExchangeRates implements the query derived exchange rates command.
+func GetCmdQueryDerivedExchangeRates() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "derived-exchange-rates",
+		Args:  cobra.NoArgs,
+		Short: "Query the exchange rates derived from cross rate routes",
+		Long: strings.TrimSpace(`
+Query the exchange rates of the pairs derived from the ballots of two voted pairs,
+as configured by the cross_rate_routes param.
+
+$ nibid query oracle derived-exchange-rates
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.DerivedExchangeRates(context.Background(), &types.QueryDerivedExchangeRatesRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryExchangeRateStats implements the query exchange rate stats command.
+func GetCmdQueryExchangeRateStats() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "exchange-rate-stats [pair]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the statistics of the last passing ballot of a pair",
+		Long: strings.TrimSpace(`
+Query the exchange rate, standard deviation, number of voters and voting power
+share of the last passing ballot of a pair.
+
+$ nibid query oracle exchange-rate-stats ubtc:unusd
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			assetPair, err := asset.TryNewPair(args[0])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.ExchangeRateStats(
+				context.Background(),
+				&types.QueryExchangeRateStatsRequest{Pair: assetPair},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
 // GetCmdQueryActives implements the query actives command.
 func GetCmdQueryActives() *cobra.Command {
 	cmd := &cobra.Command{
@@ -2,6 +2,7 @@ package oracle
 
 import (
 	"fmt"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
@@ -30,7 +31,29 @@ func InitGenesis(ctx sdk.Context, keeper keeper.Keeper, data *types.GenesisState
 	}
 
 	for _, ex := range data.ExchangeRates {
-		keeper.SetPrice(ctx, ex.Pair, ex.ExchangeRate)
+		_, derived := data.Params.CrossRateRouteOf(ex.Pair)
+		if ex.CreatedTimestampMs == 0 {
+			// exchange rates of a new chain are set at the genesis block
+			if derived {
+				keeper.SetDerivedPrice(ctx, ex.Pair, ex.ExchangeRate)
+			} else {
+				keeper.SetPrice(ctx, ex.Pair, ex.ExchangeRate)
+			}
+			continue
+		}
+
+		// exported exchange rates keep their age
+		keeper.ExchangeRates.Insert(ctx, ex.Pair, types.DatedPrice{
+			ExchangeRate:       ex.ExchangeRate,
+			CreatedBlock:       ex.CreatedBlock,
+			CreatedTimestampMs: ex.CreatedTimestampMs,
+			Derived:            derived,
+		})
+		keeper.PriceSnapshots.Insert(ctx, collections.Join(ex.Pair, time.UnixMilli(ex.CreatedTimestampMs)), types.PriceSnapshot{
+			Pair:        ex.Pair,
+			Price:       ex.ExchangeRate,
+			TimestampMs: ex.CreatedTimestampMs,
+		})
 	}
 
 	for _, missCounter := range data.MissCounters {
@@ -74,6 +97,10 @@ func InitGenesis(ctx sdk.Context, keeper keeper.Keeper, data *types.GenesisState
 		keeper.Rewards.Insert(ctx, pr.Id, pr)
 	}
 
+	for _, stats := range data.ExchangeRateStats {
+		keeper.ExchangeRateStats.Insert(ctx, stats.Pair, stats.Stats)
+	}
+
 	// set last ID based on the last pair reward
 	if len(data.Rewards) != 0 {
 		keeper.RewardsID.Set(ctx, data.Rewards[len(data.Rewards)-1].Id)
@@ -101,9 +128,14 @@ func ExportGenesis(ctx sdk.Context, keeper keeper.Keeper) *types.GenesisState {
 		})
 	}
 
-	exchangeRates := []types.ExchangeRateTuple{}
+	exchangeRates := []types.GenesisExchangeRate{}
 	for _, er := range keeper.ExchangeRates.Iterate(ctx, collections.Range[asset.Pair]{}).KeyValues() {
-		exchangeRates = append(exchangeRates, types.ExchangeRateTuple{Pair: er.Key, ExchangeRate: er.Value})
+		exchangeRates = append(exchangeRates, types.GenesisExchangeRate{
+			Pair:               er.Key,
+			ExchangeRate:       er.Value.ExchangeRate,
+			CreatedBlock:       er.Value.CreatedBlock,
+			CreatedTimestampMs: er.Value.CreatedTimestampMs,
+		})
 	}
 
 	missCounters := []types.MissCounter{}
@@ -114,6 +146,11 @@ func ExportGenesis(ctx sdk.Context, keeper keeper.Keeper) *types.GenesisState {
 		})
 	}
 
+	exchangeRateStats := []types.PairExchangeRateStats{}
+	for _, kv := range keeper.ExchangeRateStats.Iterate(ctx, collections.Range[asset.Pair]{}).KeyValues() {
+		exchangeRateStats = append(exchangeRateStats, types.PairExchangeRateStats{Pair: kv.Key, Stats: kv.Value})
+	}
+
 	var pairs []asset.Pair
 	pairs = append(pairs, keeper.WhitelistedPairs.Iterate(ctx, collections.Range[asset.Pair]{}).Keys()...)
 
@@ -125,5 +162,6 @@ func ExportGenesis(ctx sdk.Context, keeper keeper.Keeper) *types.GenesisState {
 		keeper.Votes.Iterate(ctx, collections.Range[sdk.ValAddress]{}).Values(),
 		pairs,
 		keeper.Rewards.Iterate(ctx, collections.Range[uint64]{}).Values(),
+		exchangeRateStats,
 	)
 }
@@ -16,12 +16,21 @@ func TestExportInitGenesis(t *testing.T) {
 
 	input.OracleKeeper.Params.Set(input.Ctx, types.DefaultParams())
 	input.OracleKeeper.FeederDelegations.Insert(input.Ctx, keeper.ValAddrs[0], keeper.Addrs[1])
-	input.OracleKeeper.ExchangeRates.Insert(input.Ctx, "pair1:pair2", sdk.NewDec(123))
+	datedPrice := types.DatedPrice{ExchangeRate: sdk.NewDec(123), CreatedBlock: 1, CreatedTimestampMs: 1_600_000_000_000}
+	input.OracleKeeper.ExchangeRates.Insert(input.Ctx, "pair1:pair2", datedPrice)
 	input.OracleKeeper.Prevotes.Insert(input.Ctx, keeper.ValAddrs[0], types.NewAggregateExchangeRatePrevote(types.AggregateVoteHash{123}, keeper.ValAddrs[0], uint64(2)))
 	input.OracleKeeper.Votes.Insert(input.Ctx, keeper.ValAddrs[0], types.NewAggregateExchangeRateVote(types.ExchangeRateTuples{{Pair: "foo", ExchangeRate: sdk.NewDec(123)}}, keeper.ValAddrs[0]))
 	input.OracleKeeper.WhitelistedPairs.Insert(input.Ctx, "pair1:pair1")
 	input.OracleKeeper.WhitelistedPairs.Insert(input.Ctx, "pair2:pair2")
 	input.OracleKeeper.MissCounters.Insert(input.Ctx, keeper.ValAddrs[0], 10)
+	input.OracleKeeper.ExchangeRateStats.Insert(input.Ctx, "pair1:pair2", types.ExchangeRateStats{
+		ExchangeRate:       sdk.NewDec(123),
+		StandardDeviation:  sdk.NewDec(2),
+		NumVoters:          3,
+		VotingPowerShare:   sdk.MustNewDecFromStr("0.8"),
+		CreatedBlock:       1,
+		CreatedTimestampMs: 1_600_000_000_000,
+	})
 	input.OracleKeeper.Rewards.Insert(input.Ctx, 0, types.Rewards{
 		Id:          0,
 		VotePeriods: 100,
@@ -34,6 +43,12 @@ func TestExportInitGenesis(t *testing.T) {
 	newGenesis := oracle.ExportGenesis(newInput.Ctx, newInput.OracleKeeper)
 
 	require.Equal(t, genesis, newGenesis)
+	require.Len(t, newGenesis.ExchangeRateStats, 1)
+
+	// exported exchange rates keep the block they were set at
+	importedPrice, err := newInput.OracleKeeper.ExchangeRates.Get(newInput.Ctx, "pair1:pair2")
+	require.NoError(t, err)
+	require.Equal(t, datedPrice, importedPrice)
 }
 
 func TestInitGenesis(t *testing.T) {
@@ -54,3 +54,37 @@ func (s insertOraclePriceSnapshot) Do(app *app.NibiruApp, ctx sdk.Context) (sdk.
 
 	return ctx, nil, true
 }
+
+// SetOraclePriceMaxAge sets the max age of the exchange rate of the pair.
+func SetOraclePriceMaxAge(pair asset.Pair, maxAge time.Duration) action.Action {
+	return &setOraclePriceMaxAge{
+		Pair:   pair,
+		MaxAge: maxAge,
+	}
+}
+
+type setOraclePriceMaxAge struct {
+	Pair   asset.Pair
+	MaxAge time.Duration
+}
+
+func (s setOraclePriceMaxAge) Do(app *app.NibiruApp, ctx sdk.Context) (sdk.Context, error, bool) {
+	params, err := app.OracleKeeper.Params.Get(ctx)
+	if err != nil {
+		return ctx, err, true
+	}
+
+	pairMaxPriceAges := []types.PairMaxPriceAge{{Pair: s.Pair, MaxAge: s.MaxAge}}
+	for _, pairMaxAge := range params.PairMaxPriceAges {
+		if !pairMaxAge.Pair.Equal(s.Pair) {
+			pairMaxPriceAges = append(pairMaxPriceAges, pairMaxAge)
+		}
+	}
+	params.PairMaxPriceAges = pairMaxPriceAges
+	if err = params.Validate(); err != nil {
+		return ctx, err, true
+	}
+	app.OracleKeeper.Params.Set(ctx, params)
+
+	return ctx, nil, true
+}
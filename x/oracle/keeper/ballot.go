@@ -89,6 +89,50 @@ func isPassingVoteThreshold(ballots types.ExchangeRateBallots, thresholdVotingPo
 	return true
 }
 
+// totalBondedPower returns the consensus power of the bonded tokens.
+func (k Keeper) totalBondedPower(ctx sdk.Context) int64 {
+	return sdk.TokensToConsensusPower(k.StakingKeeper.TotalBondedTokens(ctx), k.StakingKeeper.PowerReduction(ctx))
+}
+
+// thresholdVotingPower returns the voting power a ballot needs to pass, the
+// VoteThreshold share of the total bonded power.
+func (k Keeper) thresholdVotingPower(ctx sdk.Context) sdk.Int {
+	return k.VoteThreshold(ctx).MulInt64(k.totalBondedPower(ctx)).RoundInt()
+}
+
+// setExchangeRateStats stores the statistics of the passing ballot of the pair
+// along with the exchange rate it was aggregated to. Unlike the reward spread
+// of Tally, the standard deviation leaves out the abstain votes, so that
+// abstaining does not look like a disagreement.
+func (k Keeper) setExchangeRateStats(
+	ctx sdk.Context,
+	pair asset.Pair,
+	ballots types.ExchangeRateBallots,
+	exchangeRate sdk.Dec,
+	totalBondedPower int64,
+) {
+	var votes types.ExchangeRateBallots
+	for _, ballot := range ballots {
+		if ballot.ExchangeRate.IsPositive() {
+			votes = append(votes, ballot)
+		}
+	}
+
+	votingPowerShare := sdk.ZeroDec()
+	if totalBondedPower > 0 {
+		votingPowerShare = sdk.NewDec(ballots.Power()).QuoInt64(totalBondedPower)
+	}
+
+	k.ExchangeRateStats.Insert(ctx, pair, types.ExchangeRateStats{
+		ExchangeRate:       exchangeRate,
+		StandardDeviation:  votes.StandardDeviation(exchangeRate),
+		NumVoters:          ballots.NumValidVoters(),
+		VotingPowerShare:   votingPowerShare,
+		CreatedBlock:       ctx.BlockHeight(),
+		CreatedTimestampMs: ctx.BlockTime().UnixMilli(),
+	})
+}
+
 // removeInvalidBallots removes the ballots which have not reached the vote threshold
 // or which are not part of the whitelisted pairs anymore: example when params change during a vote period
 // but some votes were already made.
@@ -101,9 +145,8 @@ func (k Keeper) removeInvalidBallots(
 ) (map[asset.Pair]types.ExchangeRateBallots, set.Set[asset.Pair]) {
 	whitelistedPairs := set.New(k.GetWhitelistedPairs(ctx)...)
 
-	totalBondedPower := sdk.TokensToConsensusPower(k.StakingKeeper.TotalBondedTokens(ctx), k.StakingKeeper.PowerReduction(ctx))
-	thresholdVotingPower := k.VoteThreshold(ctx).MulInt64(totalBondedPower).RoundInt()
-	minVoters := k.MinVoters(ctx)
+	thresholdVotingPower := k.thresholdVotingPower(ctx)
+	params, _ := k.Params.Get(ctx)
 
 	for pair, ballots := range pairBallotsMap {
 		// If pair is not whitelisted, or the ballot for it has failed, then skip
@@ -115,7 +158,7 @@ func (k Keeper) removeInvalidBallots(
 
 		// If the ballot is not passed, remove it from the whitelistedPairs set
 		// to prevent slashing validators who did valid vote.
-		if !isPassingVoteThreshold(ballots, thresholdVotingPower, minVoters) {
+		if !isPassingVoteThreshold(ballots, thresholdVotingPower, params.AggregationOf(pair).MinVoters) {
 			delete(whitelistedPairs, pair)
 			delete(pairBallotsMap, pair)
 			continue
@@ -130,11 +173,22 @@ func (k Keeper) removeInvalidBallots(
 //
 // ALERT: This function mutates validatorPerformances slice based on the votes made by the validators.
 func Tally(ballots types.ExchangeRateBallots, rewardBand sdk.Dec, validatorPerformances types.ValidatorPerformances) sdk.Dec {
+	return tally(ballots, types.PairAggregation{
+		Method:     types.AggregationMethod_WEIGHTED_MEDIAN,
+		RewardBand: rewardBand,
+	}, validatorPerformances)
+}
+
+// tally aggregates the votes with the aggregation method of the pair and returns the exchange rate. Sets the
+// set of voters to be rewarded, i.e. voted within the reward band of the pair from the exchange rate.
+//
+// ALERT: This function mutates validatorPerformances slice based on the votes made by the validators.
+func tally(ballots types.ExchangeRateBallots, aggregation types.PairAggregation, validatorPerformances types.ValidatorPerformances) sdk.Dec {
 	sort.Sort(ballots)
 
-	weightedMedian := ballots.WeightedMedianWithAssertion()
-	standardDeviation := ballots.StandardDeviation(weightedMedian)
-	rewardSpread := weightedMedian.Mul(rewardBand.QuoInt64(2))
+	exchangeRate := ballots.Aggregate(aggregation)
+	standardDeviation := ballots.StandardDeviation(exchangeRate)
+	rewardSpread := exchangeRate.Mul(aggregation.RewardBand.QuoInt64(2))
 
 	if standardDeviation.GT(rewardSpread) {
 		rewardSpread = standardDeviation
@@ -142,8 +196,8 @@ func Tally(ballots types.ExchangeRateBallots, rewardBand sdk.Dec, validatorPerfo
 
 	for _, ballot := range ballots {
 		// Filter ballot winners & abstain voters
-		voteInsideSpread := ballot.ExchangeRate.GTE(weightedMedian.Sub(rewardSpread)) &&
-			ballot.ExchangeRate.LTE(weightedMedian.Add(rewardSpread))
+		voteInsideSpread := ballot.ExchangeRate.GTE(exchangeRate.Sub(rewardSpread)) &&
+			ballot.ExchangeRate.LTE(exchangeRate.Add(rewardSpread))
 		isAbstainVote := !ballot.ExchangeRate.IsPositive()
 
 		if voteInsideSpread || isAbstainVote {
@@ -156,5 +210,5 @@ func Tally(ballots types.ExchangeRateBallots, rewardBand sdk.Dec, validatorPerfo
 		}
 	}
 
-	return weightedMedian
+	return exchangeRate
 }
@@ -0,0 +1,62 @@
+package keeper
+
+import (
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/NibiruChain/nibiru/x/common/asset"
+	"github.com/NibiruChain/nibiru/x/oracle/types"
+)
+
+// updateCrossRates derives the exchange rates of the cross rate routes from
+// the passing ballots of their legs, this is supposed to be executed after
+// the tally. Validators voting on both legs get a cross rate vote, weighted by
+// their power, and the aggregate of these votes with the aggregation method of
+// the pair is set as the derived exchange rate of the pair. Cross rate votes are neither rewarded nor
+// counted as misses.
+func (k Keeper) updateCrossRates(ctx sdk.Context, pairBallotsMap map[asset.Pair]types.ExchangeRateBallots) {
+	params, err := k.Params.Get(ctx)
+	if err != nil || len(params.CrossRateRoutes) == 0 {
+		return
+	}
+
+	totalBondedPower := k.totalBondedPower(ctx)
+	thresholdVotingPower := k.thresholdVotingPower(ctx)
+
+	for _, route := range params.CrossRateRoutes {
+		baseBallots, baseOk := pairBallotsMap[route.BaseLeg]
+		quoteBallots, quoteOk := pairBallotsMap[route.QuoteLeg]
+		if !baseOk || !quoteOk {
+			continue
+		}
+
+		// (base:x) / (quote:x) = base:quote, voters missing a leg abstain
+		var crossBallots types.ExchangeRateBallots
+		for _, ballot := range quoteBallots.ToCrossRate(baseBallots.ToMap()) {
+			if !ballot.ExchangeRate.IsPositive() {
+				continue
+			}
+			ballot.Pair = route.Pair
+			crossBallots = append(crossBallots, ballot)
+		}
+
+		aggregation := params.AggregationOf(route.Pair)
+		if !isPassingVoteThreshold(crossBallots, thresholdVotingPower, aggregation.MinVoters) {
+			continue
+		}
+
+		sort.Sort(crossBallots)
+		exchangeRate := crossBallots.Aggregate(aggregation)
+
+		k.SetDerivedPrice(ctx, route.Pair, exchangeRate)
+		k.setExchangeRateStats(ctx, route.Pair, crossBallots, exchangeRate, totalBondedPower)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(types.EventTypeExchangeRateUpdate,
+				sdk.NewAttribute(types.AttributeKeyPair, route.Pair.String()),
+				sdk.NewAttribute(types.AttributeKeyExchangeRate, exchangeRate.String()),
+			),
+		)
+	}
+}
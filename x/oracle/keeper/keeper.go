@@ -33,7 +33,8 @@ type Keeper struct {
 	distrModuleName string
 
 	Params            collections.Item[types.Params]
-	ExchangeRates     collections.Map[asset.Pair, sdk.Dec]
+	ExchangeRates     collections.Map[asset.Pair, types.DatedPrice]
+	ExchangeRateStats collections.Map[asset.Pair, types.ExchangeRateStats]
 	FeederDelegations collections.Map[sdk.ValAddress, sdk.AccAddress]
 	MissCounters      collections.Map[sdk.ValAddress, uint64]
 	Prevotes          collections.Map[sdk.ValAddress, types.AggregateExchangeRatePrevote]
@@ -65,7 +66,8 @@ func NewKeeper(cdc codec.BinaryCodec, storeKey sdk.StoreKey,
 		StakingKeeper:     stakingKeeper,
 		distrModuleName:   distrName,
 		Params:            collections.NewItem(storeKey, 11, collections.ProtoValueEncoder[types.Params](cdc)),
-		ExchangeRates:     collections.NewMap(storeKey, 1, asset.PairKeyEncoder, collections.DecValueEncoder),
+		ExchangeRates:     collections.NewMap(storeKey, 1, asset.PairKeyEncoder, collections.ProtoValueEncoder[types.DatedPrice](cdc)),
+		ExchangeRateStats: collections.NewMap(storeKey, 12, asset.PairKeyEncoder, collections.ProtoValueEncoder[types.ExchangeRateStats](cdc)),
 		PriceSnapshots:    collections.NewMap(storeKey, 10, collections.PairKeyEncoder(asset.PairKeyEncoder, collections.TimeKeyEncoder), collections.ProtoValueEncoder[types.PriceSnapshot](cdc)),
 		FeederDelegations: collections.NewMap(storeKey, 2, collections.ValAddressKeyEncoder, collections.AccAddressValueEncoder),
 		MissCounters:      collections.NewMap(storeKey, 3, collections.ValAddressKeyEncoder, collections.Uint64ValueEncoder),
@@ -182,13 +184,67 @@ func (k Keeper) GetExchangeRateTwap(ctx sdk.Context, pair asset.Pair) (price sdk
 	return k.calcTwap(ctx, snapshots)
 }
 
+// GetExchangeRate returns the latest exchange rate of the pair, whatever its
+// age. Use GetExchangeRateWithMaxAge to trade on it.
 func (k Keeper) GetExchangeRate(ctx sdk.Context, pair asset.Pair) (price sdk.Dec, err error) {
-	return k.ExchangeRates.Get(ctx, pair)
+	exchangeRate, err := k.ExchangeRates.Get(ctx, pair)
+	if err != nil {
+		return price, err
+	}
+	return exchangeRate.ExchangeRate, nil
+}
+
+// GetExchangeRateWithMaxAge returns the latest exchange rate of the pair,
+// failing with ErrStalePrice if it was set longer than the max price age of
+// the pair ago.
+func (k Keeper) GetExchangeRateWithMaxAge(ctx sdk.Context, pair asset.Pair) (price sdk.Dec, err error) {
+	exchangeRate, err := k.ExchangeRates.Get(ctx, pair)
+	if err != nil {
+		return price, err
+	}
+
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return price, err
+	}
+
+	if age, maxAge, stale := isStale(ctx, params, pair, exchangeRate); stale {
+		return price, types.ErrStalePrice.Wrapf(
+			"exchange rate of %s set at block %d is %s old, max age is %s",
+			pair, exchangeRate.CreatedBlock, age, maxAge)
+	}
+
+	return exchangeRate.ExchangeRate, nil
+}
+
+// isStale returns whether the exchange rate of the pair was set longer than
+// its max price age ago, along with its age and max age.
+func isStale(
+	ctx sdk.Context, params types.Params, pair asset.Pair, exchangeRate types.DatedPrice,
+) (age time.Duration, maxAge time.Duration, stale bool) {
+	maxAge = params.MaxPriceAgeOf(pair)
+	age = ctx.BlockTime().Sub(time.UnixMilli(exchangeRate.CreatedTimestampMs))
+	return age, maxAge, maxAge > 0 && age > maxAge
 }
 
 // SetPrice sets the price for a pair as well as the price snapshot.
 func (k Keeper) SetPrice(ctx sdk.Context, pair asset.Pair, price sdk.Dec) {
-	k.ExchangeRates.Insert(ctx, pair, price)
+	k.setPrice(ctx, pair, price, false)
+}
+
+// SetDerivedPrice sets the price of a pair derived from a cross rate route as
+// well as the price snapshot.
+func (k Keeper) SetDerivedPrice(ctx sdk.Context, pair asset.Pair, price sdk.Dec) {
+	k.setPrice(ctx, pair, price, true)
+}
+
+func (k Keeper) setPrice(ctx sdk.Context, pair asset.Pair, price sdk.Dec, derived bool) {
+	k.ExchangeRates.Insert(ctx, pair, types.DatedPrice{
+		ExchangeRate:       price,
+		CreatedBlock:       ctx.BlockHeight(),
+		CreatedTimestampMs: ctx.BlockTime().UnixMilli(),
+		Derived:            derived,
+	})
 
 	key := collections.Join(pair, ctx.BlockTime())
 	timestampMs := ctx.BlockTime().UnixMilli()
@@ -2,11 +2,16 @@ package keeper
 
 import (
 	"testing"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/staking"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	"github.com/stretchr/testify/require"
+
+	"github.com/NibiruChain/nibiru/x/common/asset"
+	"github.com/NibiruChain/nibiru/x/common/denoms"
+	"github.com/NibiruChain/nibiru/x/oracle/types"
 )
 
 func TestValidateFeeder(t *testing.T) {
@@ -51,3 +56,68 @@ func TestValidateFeeder(t *testing.T) {
 	input.StakingKeeper.SetValidator(input.Ctx, validator)
 	require.Error(t, input.OracleKeeper.ValidateFeeder(input.Ctx, sdk.AccAddress(addr1), addr))
 }
+
+func TestGetExchangeRateWithMaxAge(t *testing.T) {
+	input := CreateTestFixture(t)
+	pairBtc := asset.Registry.Pair(denoms.BTC, denoms.NUSD)
+	pairEth := asset.Registry.Pair(denoms.ETH, denoms.NUSD)
+	startTime := time.UnixMilli(1_000_000)
+
+	params := types.DefaultParams()
+	params.MaxPriceAge = time.Minute
+	params.PairMaxPriceAges = []types.PairMaxPriceAge{
+		{Pair: pairEth, MaxAge: 10 * time.Second},
+	}
+	input.OracleKeeper.Params.Set(input.Ctx, params)
+
+	ctx := input.Ctx.WithBlockHeight(1).WithBlockTime(startTime)
+	input.OracleKeeper.SetPrice(ctx, pairBtc, sdk.NewDec(20_000))
+	input.OracleKeeper.SetPrice(ctx, pairEth, sdk.NewDec(1_000))
+
+	t.Run("fresh exchange rates are returned", func(t *testing.T) {
+		ctx := ctx.WithBlockHeight(2).WithBlockTime(startTime.Add(10 * time.Second))
+
+		price, err := input.OracleKeeper.GetExchangeRateWithMaxAge(ctx, pairBtc)
+		require.NoError(t, err)
+		require.Equal(t, sdk.NewDec(20_000), price)
+
+		price, err = input.OracleKeeper.GetExchangeRateWithMaxAge(ctx, pairEth)
+		require.NoError(t, err)
+		require.Equal(t, sdk.NewDec(1_000), price)
+	})
+
+	t.Run("the max age of the pair overrides the default one", func(t *testing.T) {
+		ctx := ctx.WithBlockHeight(3).WithBlockTime(startTime.Add(11 * time.Second))
+
+		_, err := input.OracleKeeper.GetExchangeRateWithMaxAge(ctx, pairBtc)
+		require.NoError(t, err)
+
+		_, err = input.OracleKeeper.GetExchangeRateWithMaxAge(ctx, pairEth)
+		require.ErrorIs(t, err, types.ErrStalePrice)
+	})
+
+	t.Run("stale exchange rates are still returned by GetExchangeRate", func(t *testing.T) {
+		ctx := ctx.WithBlockHeight(4).WithBlockTime(startTime.Add(time.Hour))
+
+		_, err := input.OracleKeeper.GetExchangeRateWithMaxAge(ctx, pairBtc)
+		require.ErrorIs(t, err, types.ErrStalePrice)
+
+		price, err := input.OracleKeeper.GetExchangeRate(ctx, pairBtc)
+		require.NoError(t, err)
+		require.Equal(t, sdk.NewDec(20_000), price)
+	})
+
+	t.Run("zero max age disables the check", func(t *testing.T) {
+		params.MaxPriceAge = 0
+		input.OracleKeeper.Params.Set(ctx, params)
+		ctx := ctx.WithBlockHeight(5).WithBlockTime(startTime.Add(time.Hour))
+
+		_, err := input.OracleKeeper.GetExchangeRateWithMaxAge(ctx, pairBtc)
+		require.NoError(t, err)
+	})
+
+	t.Run("unknown pair", func(t *testing.T) {
+		_, err := input.OracleKeeper.GetExchangeRateWithMaxAge(ctx, asset.Registry.Pair(denoms.ATOM, denoms.NUSD))
+		require.Error(t, err)
+	})
+}
@@ -0,0 +1,40 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+
+	"github.com/NibiruChain/collections"
+
+	"github.com/NibiruChain/nibiru/x/common/asset"
+	"github.com/NibiruChain/nibiru/x/oracle/types"
+)
+
+// From1To2 stores the exchange rates along with the block they were set at:
+//   - every exchange rate becomes a DatedPrice dated at the upgrade block
+//   - the MaxPriceAge and PriceSnapshotRetention params are set to their
+//     default values
+func From1To2(k Keeper) module.MigrationHandler {
+	return func(ctx sdk.Context) error {
+		legacyExchangeRates := collections.NewMap(k.storeKey, 1, asset.PairKeyEncoder, collections.DecValueEncoder)
+
+		for _, kv := range legacyExchangeRates.Iterate(ctx, collections.Range[asset.Pair]{}).KeyValues() {
+			k.ExchangeRates.Insert(ctx, kv.Key, types.DatedPrice{
+				ExchangeRate:       kv.Value,
+				CreatedBlock:       ctx.BlockHeight(),
+				CreatedTimestampMs: ctx.BlockTime().UnixMilli(),
+			})
+		}
+
+		params, err := k.Params.Get(ctx)
+		if err != nil {
+			return err
+		}
+		params.MaxPriceAge = types.DefaultMaxPriceAge
+		params.PairMaxPriceAges = []types.PairMaxPriceAge{}
+		params.PriceSnapshotRetention = types.DefaultPriceSnapshotRetention
+		k.Params.Set(ctx, params)
+
+		return nil
+	}
+}
@@ -0,0 +1,45 @@
+package keeper
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/NibiruChain/collections"
+
+	"github.com/NibiruChain/nibiru/x/common/asset"
+	"github.com/NibiruChain/nibiru/x/common/denoms"
+	"github.com/NibiruChain/nibiru/x/oracle/types"
+)
+
+func TestFrom1To2(t *testing.T) {
+	input := CreateTestFixture(t)
+	ctx := input.Ctx.WithBlockHeight(10).WithBlockTime(time.UnixMilli(1_000_000))
+	pair := asset.Registry.Pair(denoms.BTC, denoms.NUSD)
+
+	params := types.DefaultParams()
+	params.MaxPriceAge = 0
+	params.PairMaxPriceAges = nil
+	params.PriceSnapshotRetention = 0
+	input.OracleKeeper.Params.Set(ctx, params)
+
+	legacyExchangeRates := collections.NewMap(input.OracleKeeper.storeKey, 1, asset.PairKeyEncoder, collections.DecValueEncoder)
+	legacyExchangeRates.Insert(ctx, pair, sdk.NewDec(20_000))
+
+	require.NoError(t, From1To2(input.OracleKeeper)(ctx))
+
+	exchangeRate, err := input.OracleKeeper.ExchangeRates.Get(ctx, pair)
+	require.NoError(t, err)
+	require.Equal(t, types.DatedPrice{
+		ExchangeRate:       sdk.NewDec(20_000),
+		CreatedBlock:       10,
+		CreatedTimestampMs: 1_000_000,
+	}, exchangeRate)
+
+	params, err = input.OracleKeeper.Params.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, types.DefaultMaxPriceAge, params.MaxPriceAge)
+	require.Equal(t, types.DefaultPriceSnapshotRetention, params.PriceSnapshotRetention)
+}
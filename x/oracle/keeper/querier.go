@@ -50,12 +50,17 @@ func (q querier) ExchangeRate(c context.Context, req *types.QueryExchangeRateReq
 	}
 
 	ctx := sdk.UnwrapSDKContext(c)
-	exchangeRate, err := q.Keeper.GetExchangeRate(ctx, req.Pair)
+	exchangeRate, err := q.Keeper.ExchangeRates.Get(ctx, req.Pair)
 	if err != nil {
 		return nil, err
 	}
 
-	return &types.QueryExchangeRateResponse{ExchangeRate: exchangeRate}, nil
+	return &types.QueryExchangeRateResponse{
+		ExchangeRate:     exchangeRate.ExchangeRate,
+		BlockHeight:      exchangeRate.CreatedBlock,
+		BlockTimestampMs: exchangeRate.CreatedTimestampMs,
+		Derived:          exchangeRate.Derived,
+	}, nil
 }
 
 /*
@@ -79,24 +84,93 @@ func (q querier) ExchangeRateTwap(c context.Context, req *types.QueryExchangeRat
 	return &types.QueryExchangeRateResponse{ExchangeRate: twap}, nil
 }
 
-// ExchangeRates queries exchange rates of all pairs
+// ExchangeRates queries exchange rates of all pairs, leaving out the ones older
+// than the max price age of their pair
 func (q querier) ExchangeRates(c context.Context, _ *types.QueryExchangeRatesRequest) (*types.QueryExchangeRatesResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
+	params, err := q.Keeper.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
 
 	var exchangeRates types.ExchangeRateTuples
 	for _, er := range q.Keeper.ExchangeRates.Iterate(ctx, collections.Range[asset.Pair]{}).KeyValues() {
+		if _, _, stale := isStale(ctx, params, er.Key, er.Value); stale {
+			continue
+		}
 		exchangeRates = append(exchangeRates, types.ExchangeRateTuple{
 			Pair:         er.Key,
-			ExchangeRate: er.Value,
+			ExchangeRate: er.Value.ExchangeRate,
 		})
 	}
 
 	return &types.QueryExchangeRatesResponse{ExchangeRates: exchangeRates}, nil
 }
 
-// Actives queries all pairs for which exchange rates exist
+// ExchangeRateStats queries the statistics of the last passing ballot of a pair
+func (q querier) ExchangeRateStats(c context.Context, req *types.QueryExchangeRateStatsRequest) (*types.QueryExchangeRateStatsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if len(req.Pair) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "empty pair")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	stats, err := q.Keeper.ExchangeRateStats.Get(ctx, req.Pair)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryExchangeRateStatsResponse{Stats: stats}, nil
+}
+
+// DerivedExchangeRates queries the exchange rates of the pairs derived from
+// cross rate routes, leaving out the ones older than the max price age of their
+// pair
+func (q querier) DerivedExchangeRates(c context.Context, _ *types.QueryDerivedExchangeRatesRequest) (*types.QueryDerivedExchangeRatesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	params, err := q.Keeper.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var exchangeRates types.ExchangeRateTuples
+	for _, er := range q.Keeper.ExchangeRates.Iterate(ctx, collections.Range[asset.Pair]{}).KeyValues() {
+		if !er.Value.Derived {
+			continue
+		}
+		if _, _, stale := isStale(ctx, params, er.Key, er.Value); stale {
+			continue
+		}
+		exchangeRates = append(exchangeRates, types.ExchangeRateTuple{
+			Pair:         er.Key,
+			ExchangeRate: er.Value.ExchangeRate,
+		})
+	}
+
+	return &types.QueryDerivedExchangeRatesResponse{ExchangeRates: exchangeRates}, nil
+}
+
+// Actives queries all pairs for which an exchange rate no older than the max
+// price age of the pair exists
 func (q querier) Actives(c context.Context, _ *types.QueryActivesRequest) (*types.QueryActivesResponse, error) {
-	return &types.QueryActivesResponse{Actives: q.Keeper.ExchangeRates.Iterate(sdk.UnwrapSDKContext(c), collections.Range[asset.Pair]{}).Keys()}, nil
+	ctx := sdk.UnwrapSDKContext(c)
+	params, err := q.Keeper.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var actives []asset.Pair
+	for _, er := range q.Keeper.ExchangeRates.Iterate(ctx, collections.Range[asset.Pair]{}).KeyValues() {
+		if _, _, stale := isStale(ctx, params, er.Key, er.Value); stale {
+			continue
+		}
+		actives = append(actives, er.Key)
+	}
+
+	return &types.QueryActivesResponse{Actives: actives}, nil
 }
 
 // VoteTargets queries the voting target list on current vote period
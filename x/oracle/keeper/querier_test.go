@@ -36,7 +36,7 @@ func TestQueryExchangeRate(t *testing.T) {
 	querier := NewQuerier(input.OracleKeeper)
 
 	rate := sdk.NewDec(1700)
-	input.OracleKeeper.ExchangeRates.Insert(input.Ctx, asset.Registry.Pair(denoms.ETH, denoms.NUSD), rate)
+	input.OracleKeeper.ExchangeRates.Insert(input.Ctx, asset.Registry.Pair(denoms.ETH, denoms.NUSD), types.DatedPrice{ExchangeRate: rate, CreatedBlock: input.Ctx.BlockHeight()})
 
 	// empty request
 	_, err := querier.ExchangeRate(ctx, nil)
@@ -48,6 +48,7 @@ func TestQueryExchangeRate(t *testing.T) {
 	})
 	require.NoError(t, err)
 	require.Equal(t, rate, res.ExchangeRate)
+	require.Equal(t, input.Ctx.BlockHeight(), res.BlockHeight)
 }
 
 func TestQueryMissCounter(t *testing.T) {
@@ -76,8 +77,8 @@ func TestQueryExchangeRates(t *testing.T) {
 	querier := NewQuerier(input.OracleKeeper)
 
 	rate := sdk.NewDec(1700)
-	input.OracleKeeper.ExchangeRates.Insert(input.Ctx, asset.Registry.Pair(denoms.BTC, denoms.NUSD), rate)
-	input.OracleKeeper.ExchangeRates.Insert(input.Ctx, asset.Registry.Pair(denoms.ETH, denoms.NUSD), rate)
+	input.OracleKeeper.ExchangeRates.Insert(input.Ctx, asset.Registry.Pair(denoms.BTC, denoms.NUSD), types.DatedPrice{ExchangeRate: rate, CreatedBlock: input.Ctx.BlockHeight(), CreatedTimestampMs: input.Ctx.BlockTime().UnixMilli()})
+	input.OracleKeeper.ExchangeRates.Insert(input.Ctx, asset.Registry.Pair(denoms.ETH, denoms.NUSD), types.DatedPrice{ExchangeRate: rate, CreatedBlock: input.Ctx.BlockHeight(), CreatedTimestampMs: input.Ctx.BlockTime().UnixMilli()})
 
 	res, err := querier.ExchangeRates(ctx, &types.QueryExchangeRatesRequest{})
 	require.NoError(t, err)
@@ -88,6 +89,95 @@ func TestQueryExchangeRates(t *testing.T) {
 	}, res.ExchangeRates)
 }
 
+func TestQueryExchangeRatesLeavesOutExpiredPrices(t *testing.T) {
+	input := CreateTestFixture(t)
+	input.Ctx = input.Ctx.WithBlockTime(time.UnixMilli(1_000_000_000))
+	ctx := sdk.WrapSDKContext(input.Ctx)
+	querier := NewQuerier(input.OracleKeeper)
+
+	params, err := input.OracleKeeper.Params.Get(input.Ctx)
+	require.NoError(t, err)
+
+	rate := sdk.NewDec(1700)
+	btcPair := asset.Registry.Pair(denoms.BTC, denoms.NUSD)
+	ethPair := asset.Registry.Pair(denoms.ETH, denoms.NUSD)
+	input.OracleKeeper.ExchangeRates.Insert(input.Ctx, btcPair, types.DatedPrice{
+		ExchangeRate:       rate,
+		CreatedBlock:       input.Ctx.BlockHeight(),
+		CreatedTimestampMs: input.Ctx.BlockTime().UnixMilli(),
+	})
+	// expired
+	input.OracleKeeper.ExchangeRates.Insert(input.Ctx, ethPair, types.DatedPrice{
+		ExchangeRate:       rate,
+		CreatedBlock:       input.Ctx.BlockHeight(),
+		CreatedTimestampMs: input.Ctx.BlockTime().Add(-params.MaxPriceAge - time.Second).UnixMilli(),
+	})
+
+	res, err := querier.ExchangeRates(ctx, &types.QueryExchangeRatesRequest{})
+	require.NoError(t, err)
+	require.Equal(t, types.ExchangeRateTuples{{Pair: btcPair, ExchangeRate: rate}}, res.ExchangeRates)
+
+	actives, err := querier.Actives(ctx, &types.QueryActivesRequest{})
+	require.NoError(t, err)
+	require.Equal(t, []asset.Pair{btcPair}, actives.Actives)
+
+	// the expired exchange rate can still be queried along with its age
+	expired, err := querier.ExchangeRate(ctx, &types.QueryExchangeRateRequest{Pair: ethPair})
+	require.NoError(t, err)
+	require.Equal(t, input.Ctx.BlockTime().Add(-params.MaxPriceAge-time.Second).UnixMilli(), expired.BlockTimestampMs)
+}
+
+func TestQueryExchangeRateStats(t *testing.T) {
+	input := CreateTestFixture(t)
+	ctx := sdk.WrapSDKContext(input.Ctx)
+	querier := NewQuerier(input.OracleKeeper)
+
+	pair := asset.Registry.Pair(denoms.ETH, denoms.NUSD)
+	stats := types.ExchangeRateStats{
+		ExchangeRate:      sdk.NewDec(1700),
+		StandardDeviation: sdk.NewDec(5),
+		NumVoters:         3,
+		VotingPowerShare:  sdk.MustNewDecFromStr("0.6"),
+		CreatedBlock:      input.Ctx.BlockHeight(),
+	}
+	input.OracleKeeper.ExchangeRateStats.Insert(input.Ctx, pair, stats)
+
+	// empty request
+	_, err := querier.ExchangeRateStats(ctx, nil)
+	require.Error(t, err)
+
+	// unknown pair
+	_, err = querier.ExchangeRateStats(ctx, &types.QueryExchangeRateStatsRequest{Pair: asset.Registry.Pair(denoms.BTC, denoms.NUSD)})
+	require.Error(t, err)
+
+	res, err := querier.ExchangeRateStats(ctx, &types.QueryExchangeRateStatsRequest{Pair: pair})
+	require.NoError(t, err)
+	require.Equal(t, stats, res.Stats)
+}
+
+func TestQueryDerivedExchangeRates(t *testing.T) {
+	input := CreateTestFixture(t)
+	ctx := sdk.WrapSDKContext(input.Ctx)
+	querier := NewQuerier(input.OracleKeeper)
+
+	input.OracleKeeper.SetPrice(input.Ctx, asset.Registry.Pair(denoms.BTC, denoms.NUSD), sdk.NewDec(20_000))
+	input.OracleKeeper.SetDerivedPrice(input.Ctx, asset.NewPair(denoms.BTC, denoms.NIBI), sdk.NewDec(2_000))
+
+	res, err := querier.DerivedExchangeRates(ctx, &types.QueryDerivedExchangeRatesRequest{})
+	require.NoError(t, err)
+	require.Equal(t, types.ExchangeRateTuples{
+		{Pair: asset.NewPair(denoms.BTC, denoms.NIBI), ExchangeRate: sdk.NewDec(2_000)},
+	}, res.ExchangeRates)
+
+	rate, err := querier.ExchangeRate(ctx, &types.QueryExchangeRateRequest{Pair: asset.NewPair(denoms.BTC, denoms.NIBI)})
+	require.NoError(t, err)
+	require.True(t, rate.Derived)
+
+	rate, err = querier.ExchangeRate(ctx, &types.QueryExchangeRateRequest{Pair: asset.Registry.Pair(denoms.BTC, denoms.NUSD)})
+	require.NoError(t, err)
+	require.False(t, rate.Derived)
+}
+
 func TestQueryExchangeRateTwap(t *testing.T) {
 	input := CreateTestFixture(t)
 	querier := NewQuerier(input.OracleKeeper)
@@ -205,9 +295,9 @@ func TestQueryActives(t *testing.T) {
 	queryClient := NewQuerier(input.OracleKeeper)
 
 	rate := sdk.NewDec(1700)
-	input.OracleKeeper.ExchangeRates.Insert(input.Ctx, asset.Registry.Pair(denoms.BTC, denoms.NUSD), rate)
-	input.OracleKeeper.ExchangeRates.Insert(input.Ctx, asset.Registry.Pair(denoms.NIBI, denoms.NUSD), rate)
-	input.OracleKeeper.ExchangeRates.Insert(input.Ctx, asset.Registry.Pair(denoms.ETH, denoms.NUSD), rate)
+	input.OracleKeeper.ExchangeRates.Insert(input.Ctx, asset.Registry.Pair(denoms.BTC, denoms.NUSD), types.DatedPrice{ExchangeRate: rate, CreatedBlock: input.Ctx.BlockHeight(), CreatedTimestampMs: input.Ctx.BlockTime().UnixMilli()})
+	input.OracleKeeper.ExchangeRates.Insert(input.Ctx, asset.Registry.Pair(denoms.NIBI, denoms.NUSD), types.DatedPrice{ExchangeRate: rate, CreatedBlock: input.Ctx.BlockHeight(), CreatedTimestampMs: input.Ctx.BlockTime().UnixMilli()})
+	input.OracleKeeper.ExchangeRates.Insert(input.Ctx, asset.Registry.Pair(denoms.ETH, denoms.NUSD), types.DatedPrice{ExchangeRate: rate, CreatedBlock: input.Ctx.BlockHeight(), CreatedTimestampMs: input.Ctx.BlockTime().UnixMilli()})
 
 	res, err := queryClient.Actives(ctx, &types.QueryActivesRequest{})
 	require.NoError(t, err)
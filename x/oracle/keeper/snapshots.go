@@ -0,0 +1,51 @@
+package keeper
+
+import (
+	"time"
+
+	"github.com/NibiruChain/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/NibiruChain/nibiru/x/common/asset"
+)
+
+// MaxPrunedSnapshotsPerBlock bounds the number of price snapshots deleted by
+// PruneSnapshots in a single block, so that catching up on a large backlog is
+// spread over several blocks.
+const MaxPrunedSnapshotsPerBlock = 100
+
+// PruneSnapshots deletes the price snapshots older than the
+// PriceSnapshotRetention param, at most MaxPrunedSnapshotsPerBlock of them.
+// It is a no-op when the retention is zero.
+func (k Keeper) PruneSnapshots(ctx sdk.Context) {
+	params, err := k.Params.Get(ctx)
+	if err != nil || params.PriceSnapshotRetention == 0 {
+		return
+	}
+
+	// the twap reads the snapshots of the lookback window only, never prune them
+	retention := params.PriceSnapshotRetention
+	if retention < params.TwapLookbackWindow {
+		retention = params.TwapLookbackWindow
+	}
+	cutoff := ctx.BlockTime().Add(-retention)
+
+	var staleKeys []collections.Pair[asset.Pair, time.Time]
+	for _, pair := range k.ExchangeRates.Iterate(ctx, collections.Range[asset.Pair]{}).Keys() {
+		if len(staleKeys) >= MaxPrunedSnapshotsPerBlock {
+			break
+		}
+
+		iter := k.PriceSnapshots.Iterate(ctx, collections.PairRange[asset.Pair, time.Time]{}.
+			Prefix(pair).
+			EndExclusive(cutoff))
+		for ; iter.Valid() && len(staleKeys) < MaxPrunedSnapshotsPerBlock; iter.Next() {
+			staleKeys = append(staleKeys, iter.Key())
+		}
+		iter.Close()
+	}
+
+	for _, key := range staleKeys {
+		_ = k.PriceSnapshots.Delete(ctx, key)
+	}
+}
@@ -0,0 +1,82 @@
+package keeper
+
+import (
+	"testing"
+	"time"
+
+	"github.com/NibiruChain/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/NibiruChain/nibiru/x/common/asset"
+	"github.com/NibiruChain/nibiru/x/common/denoms"
+	"github.com/NibiruChain/nibiru/x/oracle/types"
+)
+
+func TestPruneSnapshots(t *testing.T) {
+	pairBtc := asset.Registry.Pair(denoms.BTC, denoms.NUSD)
+	pairEth := asset.Registry.Pair(denoms.ETH, denoms.NUSD)
+	startTime := time.UnixMilli(1_000_000).UTC()
+
+	snapshotTimes := func(ctx sdk.Context, k Keeper, pair asset.Pair) (times []time.Time) {
+		for _, key := range k.PriceSnapshots.Iterate(ctx, collections.PairRange[asset.Pair, time.Time]{}.Prefix(pair)).Keys() {
+			times = append(times, key.K2())
+		}
+		return times
+	}
+
+	setup := func(t *testing.T, retention time.Duration, numSnapshots int) (TestFixture, sdk.Context) {
+		input := CreateTestFixture(t)
+		params := types.DefaultParams()
+		params.TwapLookbackWindow = time.Minute
+		params.PriceSnapshotRetention = retention
+		input.OracleKeeper.Params.Set(input.Ctx, params)
+
+		// one snapshot per pair every second
+		for i := 0; i < numSnapshots; i++ {
+			ctx := input.Ctx.WithBlockHeight(int64(i + 1)).WithBlockTime(startTime.Add(time.Duration(i) * time.Second))
+			input.OracleKeeper.SetPrice(ctx, pairBtc, sdk.NewDec(20_000))
+			input.OracleKeeper.SetPrice(ctx, pairEth, sdk.NewDec(1_000))
+		}
+		return input, input.Ctx.WithBlockTime(startTime.Add(time.Duration(numSnapshots-1) * time.Second))
+	}
+
+	t.Run("snapshots older than the retention are pruned", func(t *testing.T) {
+		input, ctx := setup(t, 2*time.Minute, 150)
+
+		input.OracleKeeper.PruneSnapshots(ctx)
+
+		// snapshots at 29s..149s are kept
+		for _, pair := range []asset.Pair{pairBtc, pairEth} {
+			times := snapshotTimes(ctx, input.OracleKeeper, pair)
+			require.Len(t, times, 121)
+			require.Equal(t, startTime.Add(29*time.Second), times[0])
+		}
+
+		_, err := input.OracleKeeper.GetExchangeRateTwap(ctx, pairBtc)
+		require.NoError(t, err)
+	})
+
+	t.Run("deletions are bounded per block", func(t *testing.T) {
+		input, ctx := setup(t, 2*time.Minute, 300)
+
+		// 179 stale snapshots per pair
+		input.OracleKeeper.PruneSnapshots(ctx)
+		require.Len(t, snapshotTimes(ctx, input.OracleKeeper, pairBtc), 300-MaxPrunedSnapshotsPerBlock)
+		require.Len(t, snapshotTimes(ctx, input.OracleKeeper, pairEth), 300)
+
+		input.OracleKeeper.PruneSnapshots(ctx)
+		input.OracleKeeper.PruneSnapshots(ctx)
+		input.OracleKeeper.PruneSnapshots(ctx)
+		require.Len(t, snapshotTimes(ctx, input.OracleKeeper, pairBtc), 121)
+		require.Len(t, snapshotTimes(ctx, input.OracleKeeper, pairEth), 121)
+	})
+
+	t.Run("zero retention keeps every snapshot", func(t *testing.T) {
+		input, ctx := setup(t, 0, 150)
+
+		input.OracleKeeper.PruneSnapshots(ctx)
+		require.Len(t, snapshotTimes(ctx, input.OracleKeeper, pairBtc), 150)
+		require.Len(t, snapshotTimes(ctx, input.OracleKeeper, pairEth), 150)
+	})
+}
@@ -3,22 +3,22 @@ package keeper
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
-	"github.com/NibiruChain/collections"
-
 	"github.com/NibiruChain/nibiru/x/common/asset"
 	"github.com/NibiruChain/nibiru/x/common/set"
 	"github.com/NibiruChain/nibiru/x/oracle/types"
 )
 
 // UpdateExchangeRates updates the ExchangeRates, this is supposed to be executed on EndBlock.
+// The exchange rate of a pair whose ballot fails is kept along with the block
+// it was set at, consumers check its age with GetExchangeRateWithMaxAge.
 func (k Keeper) UpdateExchangeRates(ctx sdk.Context) {
 	k.Logger(ctx).Info("processing validator price votes")
-	k.resetExchangeRates(ctx)
 
 	validatorPerformances := k.newValidatorPerformances(ctx)
 	pairBallotsMap, whitelistedPairs := k.getPairBallotsMapAndWhitelistedPairs(ctx, validatorPerformances)
 
 	k.countVotesAndUpdateExchangeRates(ctx, pairBallotsMap, validatorPerformances)
+	k.updateCrossRates(ctx, pairBallotsMap)
 	k.registerMissedVotes(ctx, whitelistedPairs, validatorPerformances)
 	k.rewardBallotWinners(ctx, validatorPerformances)
 
@@ -45,12 +45,14 @@ func (k Keeper) countVotesAndUpdateExchangeRates(
 	pairBallotsMap map[asset.Pair]types.ExchangeRateBallots,
 	validatorPerformances types.ValidatorPerformances,
 ) {
-	rewardBand := k.RewardBand(ctx)
+	params, _ := k.Params.Get(ctx)
+	totalBondedPower := k.totalBondedPower(ctx)
 
 	for pair, ballots := range pairBallotsMap {
-		exchangeRate := Tally(ballots, rewardBand, validatorPerformances)
+		exchangeRate := tally(ballots, params.AggregationOf(pair), validatorPerformances)
 
 		k.SetPrice(ctx, pair, exchangeRate)
+		k.setExchangeRateStats(ctx, pair, ballots, exchangeRate, totalBondedPower)
 
 		ctx.EventManager().EmitEvent(
 			sdk.NewEvent(types.EventTypeExchangeRateUpdate,
@@ -72,16 +74,6 @@ func (k Keeper) getPairBallotsMapAndWhitelistedPairs(
 	return k.removeInvalidBallots(ctx, pairBallotsMap)
 }
 
-// resetExchangeRates removes all exchange rates from the state
-func (k Keeper) resetExchangeRates(ctx sdk.Context) {
-	for _, key := range k.ExchangeRates.Iterate(ctx, collections.Range[asset.Pair]{}).Keys() {
-		err := k.ExchangeRates.Delete(ctx, key)
-		if err != nil {
-			panic(err)
-		}
-	}
-}
-
 // newValidatorPerformances creates a new map of validators and their performance, excluding validators that are
 // not bonded.
 func (k Keeper) newValidatorPerformances(ctx sdk.Context) types.ValidatorPerformances {
@@ -65,10 +65,12 @@ func TestOracleThreshold(t *testing.T) {
 	fixture.OracleKeeper.UpdateExchangeRates(fixture.Ctx)
 	rate, err := fixture.OracleKeeper.ExchangeRates.Get(fixture.Ctx, exchangeRates[0].Pair)
 	require.NoError(t, err)
-	assert.Equal(t, randomExchangeRate, rate)
+	assert.Equal(t, randomExchangeRate, rate.ExchangeRate)
+	assert.Equal(t, fixture.Ctx.BlockHeight(), rate.CreatedBlock)
 
 	// Case 3.
 	// Increase voting power of absent validator, exchange rate consensus fails
+	// and the last exchange rate is kept
 	val, _ := fixture.StakingKeeper.GetValidator(fixture.Ctx, ValAddrs[4])
 	_, _ = fixture.StakingKeeper.Delegate(fixture.Ctx.WithBlockHeight(0), Addrs[4], stakingAmt.MulRaw(8), stakingtypes.Unbonded, val, false)
 
@@ -83,9 +85,11 @@ func TestOracleThreshold(t *testing.T) {
 		require.NoError(t, err1)
 		require.NoError(t, err2)
 	}
-	fixture.OracleKeeper.UpdateExchangeRates(fixture.Ctx)
-	_, err = fixture.OracleKeeper.ExchangeRates.Get(fixture.Ctx, exchangeRates[0].Pair)
-	assert.Error(t, err)
+	fixture.OracleKeeper.UpdateExchangeRates(fixture.Ctx.WithBlockHeight(2))
+	rate, err = fixture.OracleKeeper.ExchangeRates.Get(fixture.Ctx, exchangeRates[0].Pair)
+	require.NoError(t, err)
+	assert.Equal(t, randomExchangeRate, rate.ExchangeRate)
+	assert.Equal(t, fixture.Ctx.BlockHeight(), rate.CreatedBlock)
 }
 
 func TestOracleTally(t *testing.T) {
@@ -421,3 +425,131 @@ func TestWhitelistedPairs(t *testing.T) {
 	assert.Equal(t, uint64(1), fixture.OracleKeeper.MissCounters.GetOr(fixture.Ctx, ValAddrs[2], 0))
 	assert.Equal(t, uint64(1), fixture.OracleKeeper.MissCounters.GetOr(fixture.Ctx, ValAddrs[2], 0))
 }
+
+func TestOracleCrossRate(t *testing.T) {
+	input, h := Setup(t)
+
+	pairBtcNusd := asset.Registry.Pair(denoms.BTC, denoms.NUSD)
+	pairNibiNusd := asset.Registry.Pair(denoms.NIBI, denoms.NUSD)
+	pairEthNusd := asset.Registry.Pair(denoms.ETH, denoms.NUSD)
+	pairBtcNibi := asset.NewPair(denoms.BTC, denoms.NIBI)
+	pairEthNibi := asset.NewPair(denoms.ETH, denoms.NIBI)
+
+	params, err := input.OracleKeeper.Params.Get(input.Ctx)
+	require.NoError(t, err)
+	params.CrossRateRoutes = []types.CrossRateRoute{
+		{Pair: pairBtcNibi, BaseLeg: pairBtcNusd, QuoteLeg: pairNibiNusd},
+		{Pair: pairEthNibi, BaseLeg: pairEthNusd, QuoteLeg: pairNibiNusd},
+	}
+	require.NoError(t, params.Validate())
+	input.OracleKeeper.Params.Set(input.Ctx, params)
+
+	// eth:nusd has too few voters to pass
+	btcRates := []int64{20_000, 20_000, 20_000, 21_000}
+	for i, btcRate := range btcRates {
+		rates := types.ExchangeRateTuples{
+			{Pair: pairBtcNusd, ExchangeRate: sdk.NewDec(btcRate)},
+			{Pair: pairNibiNusd, ExchangeRate: sdk.NewDec(10)},
+		}
+		if i == 0 {
+			rates = append(rates, types.ExchangeRateTuple{Pair: pairEthNusd, ExchangeRate: sdk.NewDec(1_000)})
+		}
+		MakeAggregatePrevoteAndVote(t, input, h, 0, rates, i)
+	}
+
+	input.OracleKeeper.UpdateExchangeRates(input.Ctx)
+
+	rate, err := input.OracleKeeper.ExchangeRates.Get(input.Ctx, pairBtcNibi)
+	require.NoError(t, err)
+	assert.Equal(t, sdk.NewDec(2_000), rate.ExchangeRate)
+	assert.True(t, rate.Derived)
+
+	stats, err := input.OracleKeeper.ExchangeRateStats.Get(input.Ctx, pairBtcNibi)
+	require.NoError(t, err)
+	assert.Equal(t, sdk.NewDec(50), stats.StandardDeviation)
+	assert.Equal(t, uint64(4), stats.NumVoters)
+
+	rate, err = input.OracleKeeper.ExchangeRates.Get(input.Ctx, pairBtcNusd)
+	require.NoError(t, err)
+	assert.Equal(t, sdk.NewDec(20_000), rate.ExchangeRate)
+	assert.False(t, rate.Derived)
+
+	_, err = input.OracleKeeper.ExchangeRates.Get(input.Ctx, pairEthNibi)
+	assert.Error(t, err)
+}
+
+func TestOracleExchangeRateStats(t *testing.T) {
+	input, h := Setup(t)
+	pair := asset.Registry.Pair(denoms.BTC, denoms.NUSD)
+
+	// 4 out of 5 validators of equal power vote, one of them abstains
+	for i, rate := range []int64{20_000, 20_000, 20_000, 21_000, 0} {
+		MakeAggregatePrevoteAndVote(t, input, h, 0, types.ExchangeRateTuples{
+			{Pair: pair, ExchangeRate: sdk.NewDec(rate)},
+		}, i)
+	}
+
+	ctx := input.Ctx.WithBlockHeight(2)
+	input.OracleKeeper.UpdateExchangeRates(ctx)
+
+	stats, err := input.OracleKeeper.ExchangeRateStats.Get(ctx, pair)
+	require.NoError(t, err)
+	assert.Equal(t, types.ExchangeRateStats{
+		ExchangeRate:       sdk.NewDec(20_000),
+		StandardDeviation:  sdk.NewDec(500),
+		NumVoters:          4,
+		VotingPowerShare:   sdk.MustNewDecFromStr("0.8"),
+		CreatedBlock:       2,
+		CreatedTimestampMs: ctx.BlockTime().UnixMilli(),
+	}, stats)
+
+	// a failing ballot keeps the last stats
+	MakeAggregatePrevoteAndVote(t, input, h, 0, types.ExchangeRateTuples{
+		{Pair: pair, ExchangeRate: sdk.NewDec(30_000)},
+	}, 0)
+	input.OracleKeeper.UpdateExchangeRates(input.Ctx.WithBlockHeight(3))
+
+	stats, err = input.OracleKeeper.ExchangeRateStats.Get(ctx, pair)
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), stats.CreatedBlock)
+}
+
+func TestOracleAggregation(t *testing.T) {
+	input, h := Setup(t)
+	pairBtcNusd := asset.Registry.Pair(denoms.BTC, denoms.NUSD)
+	pairEthNusd := asset.Registry.Pair(denoms.ETH, denoms.NUSD)
+
+	params, err := input.OracleKeeper.Params.Get(input.Ctx)
+	require.NoError(t, err)
+	params.PairAggregations = []types.PairAggregation{{
+		Pair:       pairBtcNusd,
+		Method:     types.AggregationMethod_TRIMMED_MEAN,
+		RewardBand: sdk.NewDecWithPrec(2, 2),
+		MinVoters:  3,
+		TrimRatio:  sdk.MustNewDecFromStr("0.25"),
+	}}
+	require.NoError(t, params.Validate())
+	input.OracleKeeper.Params.Set(input.Ctx, params)
+
+	// 3 voters are enough for btc:nusd only, eth:nusd needs the default min voters
+	for i, rate := range []int64{100, 110, 150} {
+		MakeAggregatePrevoteAndVote(t, input, h, 0, types.ExchangeRateTuples{
+			{Pair: pairBtcNusd, ExchangeRate: sdk.NewDec(rate)},
+			{Pair: pairEthNusd, ExchangeRate: sdk.NewDec(rate)},
+		}, i)
+	}
+
+	input.OracleKeeper.UpdateExchangeRates(input.Ctx)
+
+	// a quarter of the power of 100 and 150 is kept: (25 + 110 + 37.5) / 1.5
+	rate, err := input.OracleKeeper.ExchangeRates.Get(input.Ctx, pairBtcNusd)
+	require.NoError(t, err)
+	assert.Equal(t, sdk.NewDec(115), rate.ExchangeRate)
+
+	stats, err := input.OracleKeeper.ExchangeRateStats.Get(input.Ctx, pairBtcNusd)
+	require.NoError(t, err)
+	assert.Equal(t, sdk.NewDec(115), stats.ExchangeRate, "the stats hold the trimmed mean, not the median")
+
+	_, err = input.OracleKeeper.ExchangeRates.Get(input.Ctx, pairEthNusd)
+	assert.Error(t, err)
+}
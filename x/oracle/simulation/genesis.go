@@ -107,7 +107,7 @@ func RandomizedGenState(simState *module.SimulationState) {
 			SlashWindow:       slashWindow,
 			MinValidPerWindow: minValidPerWindow,
 		},
-		[]types.ExchangeRateTuple{
+		[]types.GenesisExchangeRate{
 			{Pair: asset.Registry.Pair(denoms.BTC, denoms.NUSD), ExchangeRate: sdk.NewDec(20_000)},
 		},
 		[]types.FeederDelegation{},
@@ -116,6 +116,7 @@ func RandomizedGenState(simState *module.SimulationState) {
 		[]types.AggregateExchangeRateVote{},
 		[]asset.Pair{},
 		[]types.Rewards{},
+		[]types.PairExchangeRateStats{},
 	)
 
 	bz, err := json.MarshalIndent(&oracleGenesis, "", " ")
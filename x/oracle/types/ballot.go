@@ -126,6 +126,71 @@ func (pb ExchangeRateBallots) WeightedMedianWithAssertion() sdk.Dec {
 	return sdk.ZeroDec()
 }
 
+// TrimmedMean returns the mean of the votes weighted by their power, once trimRatio of the total power is
+// trimmed from each end of the ballot. Votes straddling a cut only count for their power inside the cuts.
+// CONTRACT: ballot must be sorted
+func (pb ExchangeRateBallots) TrimmedMean(trimRatio sdk.Dec) sdk.Dec {
+	totalPower := sdk.NewDec(pb.Power())
+	lowerCut := totalPower.Mul(trimRatio)
+	upperCut := totalPower.Sub(lowerCut)
+
+	weightedSum := sdk.ZeroDec()
+	keptPower := sdk.ZeroDec()
+	cumulativePower := sdk.ZeroDec()
+	for _, v := range pb {
+		votePowerStart := cumulativePower
+		cumulativePower = cumulativePower.Add(sdk.NewDec(v.Power))
+
+		power := sdk.MinDec(cumulativePower, upperCut).Sub(sdk.MaxDec(votePowerStart, lowerCut))
+		if !power.IsPositive() {
+			continue
+		}
+		weightedSum = weightedSum.Add(v.ExchangeRate.Mul(power))
+		keptPower = keptPower.Add(power)
+	}
+
+	if !keptPower.IsPositive() {
+		return sdk.ZeroDec()
+	}
+	return weightedSum.Quo(keptPower)
+}
+
+// OutlierExcludedMedian returns the weighted median of the votes within maxStdDevs standard deviations of the
+// weighted median of all the votes. Abstain votes are left out.
+// CONTRACT: ballot must be sorted
+func (pb ExchangeRateBallots) OutlierExcludedMedian(maxStdDevs sdk.Dec) sdk.Dec {
+	var votes ExchangeRateBallots
+	for _, v := range pb {
+		if v.ExchangeRate.IsPositive() {
+			votes = append(votes, v)
+		}
+	}
+
+	median := votes.WeightedMedian()
+	maxDeviation := votes.StandardDeviation(median).Mul(maxStdDevs)
+
+	var inliers ExchangeRateBallots
+	for _, v := range votes {
+		if v.ExchangeRate.Sub(median).Abs().LTE(maxDeviation) {
+			inliers = append(inliers, v)
+		}
+	}
+	return inliers.WeightedMedian()
+}
+
+// Aggregate returns the exchange rate of the ballot with the aggregation method of the pair.
+// CONTRACT: ballot must be sorted
+func (pb ExchangeRateBallots) Aggregate(aggregation PairAggregation) sdk.Dec {
+	switch aggregation.Method {
+	case AggregationMethod_TRIMMED_MEAN:
+		return pb.TrimmedMean(aggregation.TrimRatio)
+	case AggregationMethod_OUTLIER_EXCLUDED_MEDIAN:
+		return pb.OutlierExcludedMedian(aggregation.MaxStdDevs)
+	default:
+		return pb.WeightedMedianWithAssertion()
+	}
+}
+
 // StandardDeviation returns the standard deviation by the power of the ExchangeRateVote.
 func (pb ExchangeRateBallots) StandardDeviation(median sdk.Dec) (standardDeviation sdk.Dec) {
 	if len(pb) == 0 {
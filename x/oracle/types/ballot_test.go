@@ -66,6 +66,45 @@ func TestToMap(t *testing.T) {
 	}
 }
 
+func TestTrimmedMean(t *testing.T) {
+	pair := asset.Registry.Pair(denoms.BTC, denoms.NUSD)
+	ballots := types.ExchangeRateBallots{
+		// abstain votes have no power
+		types.NewExchangeRateBallot(sdk.ZeroDec(), pair, sdk.ValAddress(secp256k1.GenPrivKey().PubKey().Address()), 0),
+	}
+	for _, rate := range []int64{1, 2, 3, 4, 100} {
+		ballots = append(ballots, types.NewExchangeRateBallot(sdk.NewDec(rate), pair, sdk.ValAddress(secp256k1.GenPrivKey().PubKey().Address()), 1))
+	}
+
+	require.Equal(t, sdk.NewDec(22), ballots.TrimmedMean(sdk.ZeroDec()))
+	// half of the power of 1 and 100 is trimmed
+	require.Equal(t, sdk.MustNewDecFromStr("14.875"), ballots.TrimmedMean(sdk.MustNewDecFromStr("0.1")))
+	require.Equal(t, sdk.NewDec(3), ballots.TrimmedMean(sdk.MustNewDecFromStr("0.2")))
+	require.Equal(t, sdk.ZeroDec(), types.ExchangeRateBallots{}.TrimmedMean(sdk.MustNewDecFromStr("0.2")))
+}
+
+func TestOutlierExcludedMedian(t *testing.T) {
+	pair := asset.Registry.Pair(denoms.BTC, denoms.NUSD)
+	ballots := types.ExchangeRateBallots{
+		types.NewExchangeRateBallot(sdk.ZeroDec(), pair, sdk.ValAddress(secp256k1.GenPrivKey().PubKey().Address()), 0),
+	}
+	for _, rate := range []int64{10, 11, 12, 1000, 1000} {
+		ballots = append(ballots, types.NewExchangeRateBallot(sdk.NewDec(rate), pair, sdk.ValAddress(secp256k1.GenPrivKey().PubKey().Address()), 1))
+	}
+
+	// the standard deviation around 11 is ~625
+	require.Equal(t, sdk.NewDec(11), ballots.OutlierExcludedMedian(sdk.NewDec(2)))
+	require.Equal(t, sdk.NewDec(10), ballots.OutlierExcludedMedian(sdk.OneDec()))
+
+	require.Equal(t, sdk.NewDec(10), ballots.Aggregate(types.PairAggregation{
+		Method:     types.AggregationMethod_OUTLIER_EXCLUDED_MEDIAN,
+		MaxStdDevs: sdk.OneDec(),
+	}))
+	require.Equal(t, ballots.WeightedMedianWithAssertion(), ballots.Aggregate(types.PairAggregation{
+		Method: types.AggregationMethod_WEIGHTED_MEDIAN,
+	}))
+}
+
 func TestToCrossRate(t *testing.T) {
 	data := []struct {
 		base     sdk.Dec
@@ -23,4 +23,5 @@ var (
 	ErrNoAggregateVote       = sdkerrors.Register(ModuleName, 12, "no aggregate vote")
 	ErrUnknownPair           = sdkerrors.Register(ModuleName, 13, "unknown pair")
 	ErrNoValidTWAP           = sdkerrors.Register(ModuleName, 14, "TWA price not found")
+	ErrStalePrice            = sdkerrors.Register(ModuleName, 15, "exchange rate is older than its max age")
 )
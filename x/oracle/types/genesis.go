@@ -10,12 +10,13 @@ import (
 
 // NewGenesisState creates a new GenesisState object
 func NewGenesisState(
-	params Params, rates []ExchangeRateTuple,
+	params Params, rates []GenesisExchangeRate,
 	feederDelegations []FeederDelegation, missCounters []MissCounter,
 	aggregateExchangeRatePrevotes []AggregateExchangeRatePrevote,
 	aggregateExchangeRateVotes []AggregateExchangeRateVote,
 	pairs []asset.Pair,
 	rewards []Rewards,
+	exchangeRateStats []PairExchangeRateStats,
 ) *GenesisState {
 	return &GenesisState{
 		Params:                        params,
@@ -26,6 +27,7 @@ func NewGenesisState(
 		AggregateExchangeRateVotes:    aggregateExchangeRateVotes,
 		Pairs:                         pairs,
 		Rewards:                       rewards,
+		ExchangeRateStats:             exchangeRateStats,
 	}
 }
 
@@ -33,13 +35,14 @@ func NewGenesisState(
 func DefaultGenesisState() *GenesisState {
 	return NewGenesisState(
 		DefaultParams(),
-		[]ExchangeRateTuple{},
+		[]GenesisExchangeRate{},
 		[]FeederDelegation{},
 		[]MissCounter{},
 		[]AggregateExchangeRatePrevote{},
 		[]AggregateExchangeRateVote{},
 		[]asset.Pair{},
-		[]Rewards{})
+		[]Rewards{},
+		[]PairExchangeRateStats{})
 }
 
 // ValidateGenesis validates the oracle genesis state
@@ -7,6 +7,7 @@ import (
 	fmt "fmt"
 	github_com_NibiruChain_nibiru_x_common_asset "github.com/NibiruChain/nibiru/x/common/asset"
 	_ "github.com/cosmos/cosmos-sdk/types"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	io "io"
@@ -29,12 +30,13 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type GenesisState struct {
 	Params                        Params                                              `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
 	FeederDelegations             []FeederDelegation                                  `protobuf:"bytes,2,rep,name=feeder_delegations,json=feederDelegations,proto3" json:"feeder_delegations"`
-	ExchangeRates                 ExchangeRateTuples                                  `protobuf:"bytes,3,rep,name=exchange_rates,json=exchangeRates,proto3,castrepeated=ExchangeRateTuples" json:"exchange_rates"`
+	ExchangeRates                 []GenesisExchangeRate                               `protobuf:"bytes,3,rep,name=exchange_rates,json=exchangeRates,proto3" json:"exchange_rates"`
 	MissCounters                  []MissCounter                                       `protobuf:"bytes,4,rep,name=miss_counters,json=missCounters,proto3" json:"miss_counters"`
 	AggregateExchangeRatePrevotes []AggregateExchangeRatePrevote                      `protobuf:"bytes,5,rep,name=aggregate_exchange_rate_prevotes,json=aggregateExchangeRatePrevotes,proto3" json:"aggregate_exchange_rate_prevotes"`
 	AggregateExchangeRateVotes    []AggregateExchangeRateVote                         `protobuf:"bytes,6,rep,name=aggregate_exchange_rate_votes,json=aggregateExchangeRateVotes,proto3" json:"aggregate_exchange_rate_votes"`
 	Pairs                         []github_com_NibiruChain_nibiru_x_common_asset.Pair `protobuf:"bytes,7,rep,name=pairs,proto3,customtype=github.com/NibiruChain/nibiru/x/common/asset.Pair" json:"pairs"`
 	Rewards                       []Rewards                                           `protobuf:"bytes,8,rep,name=rewards,proto3" json:"rewards"`
+	ExchangeRateStats             []PairExchangeRateStats                             `protobuf:"bytes,9,rep,name=exchange_rate_stats,json=exchangeRateStats,proto3" json:"exchange_rate_stats"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -84,7 +86,7 @@ func (m *GenesisState) GetFeederDelegations() []FeederDelegation {
 	return nil
 }
 
-func (m *GenesisState) GetExchangeRates() ExchangeRateTuples {
+func (m *GenesisState) GetExchangeRates() []GenesisExchangeRate {
 	if m != nil {
 		return m.ExchangeRates
 	}
@@ -119,6 +121,120 @@ func (m *GenesisState) GetRewards() []Rewards {
 	return nil
 }
 
+func (m *GenesisState) GetExchangeRateStats() []PairExchangeRateStats {
+	if m != nil {
+		return m.ExchangeRateStats
+	}
+	return nil
+}
+
+// GenesisExchangeRate is the exchange rate of a pair in the genesis state,
+// along with the block it was set at.
+type GenesisExchangeRate struct {
+	Pair         github_com_NibiruChain_nibiru_x_common_asset.Pair `protobuf:"bytes,1,opt,name=pair,proto3,customtype=github.com/NibiruChain/nibiru/x/common/asset.Pair" json:"pair"`
+	ExchangeRate github_com_cosmos_cosmos_sdk_types.Dec            `protobuf:"bytes,2,opt,name=exchange_rate,json=exchangeRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"exchange_rate"`
+	// height of the block at which the exchange rate was set. Exchange rates
+	// without a creation time are stamped with the genesis block.
+	CreatedBlock int64 `protobuf:"varint,3,opt,name=created_block,json=createdBlock,proto3" json:"created_block,omitempty"`
+	// time of the block at which the exchange rate was set, in milliseconds
+	// since unix epoch
+	CreatedTimestampMs int64 `protobuf:"varint,4,opt,name=created_timestamp_ms,json=createdTimestampMs,proto3" json:"created_timestamp_ms,omitempty"`
+}
+
+func (m *GenesisExchangeRate) Reset()         { *m = GenesisExchangeRate{} }
+func (m *GenesisExchangeRate) String() string { return proto.CompactTextString(m) }
+func (*GenesisExchangeRate) ProtoMessage()    {}
+func (*GenesisExchangeRate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_14b982a0a6345d1d, []int{1}
+}
+func (m *GenesisExchangeRate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GenesisExchangeRate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GenesisExchangeRate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GenesisExchangeRate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GenesisExchangeRate.Merge(m, src)
+}
+func (m *GenesisExchangeRate) XXX_Size() int {
+	return m.Size()
+}
+func (m *GenesisExchangeRate) XXX_DiscardUnknown() {
+	xxx_messageInfo_GenesisExchangeRate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GenesisExchangeRate proto.InternalMessageInfo
+
+func (m *GenesisExchangeRate) GetCreatedBlock() int64 {
+	if m != nil {
+		return m.CreatedBlock
+	}
+	return 0
+}
+
+func (m *GenesisExchangeRate) GetCreatedTimestampMs() int64 {
+	if m != nil {
+		return m.CreatedTimestampMs
+	}
+	return 0
+}
+
+// PairExchangeRateStats is the statistics of the ballot that last set the
+// exchange rate of a pair, used in the oracle module's genesis state
+type PairExchangeRateStats struct {
+	Pair  github_com_NibiruChain_nibiru_x_common_asset.Pair `protobuf:"bytes,1,opt,name=pair,proto3,customtype=github.com/NibiruChain/nibiru/x/common/asset.Pair" json:"pair"`
+	Stats ExchangeRateStats                                 `protobuf:"bytes,2,opt,name=stats,proto3" json:"stats"`
+}
+
+func (m *PairExchangeRateStats) Reset()         { *m = PairExchangeRateStats{} }
+func (m *PairExchangeRateStats) String() string { return proto.CompactTextString(m) }
+func (*PairExchangeRateStats) ProtoMessage()    {}
+func (*PairExchangeRateStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_14b982a0a6345d1d, []int{2}
+}
+func (m *PairExchangeRateStats) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PairExchangeRateStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PairExchangeRateStats.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PairExchangeRateStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PairExchangeRateStats.Merge(m, src)
+}
+func (m *PairExchangeRateStats) XXX_Size() int {
+	return m.Size()
+}
+func (m *PairExchangeRateStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_PairExchangeRateStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PairExchangeRateStats proto.InternalMessageInfo
+
+func (m *PairExchangeRateStats) GetStats() ExchangeRateStats {
+	if m != nil {
+		return m.Stats
+	}
+	return ExchangeRateStats{}
+}
+
 // FeederDelegation is the address for where oracle feeder authority are
 // delegated to. By default this struct is only used at genesis to feed in
 // default feeder addresses.
@@ -131,7 +247,7 @@ func (m *FeederDelegation) Reset()         { *m = FeederDelegation{} }
 func (m *FeederDelegation) String() string { return proto.CompactTextString(m) }
 func (*FeederDelegation) ProtoMessage()    {}
 func (*FeederDelegation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_14b982a0a6345d1d, []int{1}
+	return fileDescriptor_14b982a0a6345d1d, []int{3}
 }
 func (m *FeederDelegation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -185,7 +301,7 @@ func (m *MissCounter) Reset()         { *m = MissCounter{} }
 func (m *MissCounter) String() string { return proto.CompactTextString(m) }
 func (*MissCounter) ProtoMessage()    {}
 func (*MissCounter) Descriptor() ([]byte, []int) {
-	return fileDescriptor_14b982a0a6345d1d, []int{2}
+	return fileDescriptor_14b982a0a6345d1d, []int{4}
 }
 func (m *MissCounter) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -230,6 +346,8 @@ func (m *MissCounter) GetMissCounter() uint64 {
 
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "nibiru.oracle.v1.GenesisState")
+	proto.RegisterType((*GenesisExchangeRate)(nil), "nibiru.oracle.v1.GenesisExchangeRate")
+	proto.RegisterType((*PairExchangeRateStats)(nil), "nibiru.oracle.v1.PairExchangeRateStats")
 	proto.RegisterType((*FeederDelegation)(nil), "nibiru.oracle.v1.FeederDelegation")
 	proto.RegisterType((*MissCounter)(nil), "nibiru.oracle.v1.MissCounter")
 }
@@ -237,42 +355,51 @@ func init() {
 func init() { proto.RegisterFile("oracle/v1/genesis.proto", fileDescriptor_14b982a0a6345d1d) }
 
 var fileDescriptor_14b982a0a6345d1d = []byte{
-	// 545 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x94, 0x5f, 0x6b, 0x13, 0x4d,
-	0x14, 0xc6, 0xb3, 0xfd, 0xfb, 0x76, 0xd2, 0x96, 0x74, 0x78, 0xd1, 0x35, 0x90, 0x4d, 0x8c, 0x08,
-	0x81, 0xca, 0x2e, 0xa9, 0x20, 0xf4, 0xb2, 0xa9, 0x56, 0x6f, 0xd4, 0xb0, 0x8a, 0x82, 0x20, 0x61,
-	0xb2, 0x7b, 0xb2, 0x19, 0xc8, 0xee, 0x2c, 0x73, 0x26, 0xb1, 0x5e, 0xf8, 0x1d, 0xfc, 0x1c, 0x7e,
-	0x92, 0x5e, 0x16, 0xaf, 0xc4, 0x8b, 0x2a, 0xc9, 0x17, 0x91, 0x9d, 0xd9, 0x36, 0x31, 0xdb, 0xaa,
-	0x77, 0xe1, 0x3c, 0xbf, 0xf3, 0x3c, 0xcf, 0x84, 0x93, 0x90, 0xdb, 0x42, 0xb2, 0x60, 0x04, 0xde,
-	0xa4, 0xed, 0x45, 0x90, 0x00, 0x72, 0x74, 0x53, 0x29, 0x94, 0xa0, 0x95, 0x84, 0xf7, 0xb9, 0x1c,
-	0xbb, 0x46, 0x77, 0x27, 0xed, 0xea, 0xff, 0x91, 0x88, 0x84, 0x16, 0xbd, 0xec, 0x93, 0xe1, 0xaa,
-	0xb7, 0xe6, 0x06, 0x39, 0x6a, 0xe6, 0x4e, 0x20, 0x30, 0x16, 0xe8, 0xf5, 0x19, 0x66, 0x62, 0x1f,
-	0x14, 0x6b, 0x7b, 0x81, 0xe0, 0x89, 0xd1, 0x9b, 0x5f, 0xd7, 0xc9, 0xf6, 0x53, 0x93, 0xf8, 0x4a,
-	0x31, 0x05, 0xf4, 0x11, 0xd9, 0x48, 0x99, 0x64, 0x31, 0xda, 0x56, 0xc3, 0x6a, 0x95, 0x0f, 0x6c,
-	0x77, 0xb9, 0x81, 0xdb, 0xd5, 0x7a, 0x67, 0xed, 0xec, 0xa2, 0x5e, 0xf2, 0x73, 0x9a, 0xbe, 0x25,
-	0x74, 0x00, 0x10, 0x82, 0xec, 0x85, 0x30, 0x82, 0x88, 0x29, 0x2e, 0x12, 0xb4, 0x57, 0x1a, 0xab,
-	0xad, 0xf2, 0x41, 0xb3, 0xe8, 0x71, 0xa2, 0xd9, 0xc7, 0x57, 0x68, 0xee, 0xb6, 0x37, 0x58, 0x9a,
-	0x23, 0x1d, 0x90, 0x5d, 0x38, 0x0d, 0x86, 0x2c, 0x89, 0xa0, 0x27, 0x99, 0x02, 0xb4, 0x57, 0xb5,
-	0xe9, 0xbd, 0xa2, 0xe9, 0x93, 0x9c, 0xf3, 0x99, 0x82, 0xd7, 0xe3, 0x74, 0x04, 0x9d, 0x6a, 0xe6,
-	0xfa, 0xe5, 0x47, 0x9d, 0x16, 0x24, 0xf4, 0x77, 0x60, 0x61, 0x86, 0xf4, 0x19, 0xd9, 0x89, 0x39,
-	0x62, 0x2f, 0x10, 0xe3, 0x44, 0x81, 0x44, 0x7b, 0x4d, 0xc7, 0xd4, 0x8a, 0x31, 0xcf, 0x39, 0xe2,
-	0xb1, 0xa1, 0xf2, 0xda, 0xdb, 0xf1, 0x7c, 0x84, 0xf4, 0x13, 0x69, 0xb0, 0x28, 0x92, 0xd9, 0x0b,
-	0xa0, 0xf7, 0x5b, 0xf7, 0x5e, 0x2a, 0x61, 0x22, 0xb2, 0x37, 0xac, 0x6b, 0x73, 0xb7, 0x68, 0x7e,
-	0x74, 0xb9, 0xb9, 0xd8, 0xb8, 0x6b, 0xd6, 0xf2, 0xb4, 0x1a, 0xfb, 0x03, 0x83, 0x54, 0x91, 0xda,
-	0x4d, 0xf1, 0x26, 0x7b, 0x43, 0x67, 0xef, 0xff, 0x63, 0xf6, 0x9b, 0x79, 0x70, 0x95, 0xdd, 0x04,
-	0x20, 0x7d, 0x49, 0xd6, 0x53, 0xc6, 0x25, 0xda, 0x9b, 0x8d, 0xd5, 0xd6, 0x56, 0xe7, 0x30, 0x5b,
-	0xf8, 0x7e, 0x51, 0x6f, 0x47, 0x5c, 0x0d, 0xc7, 0x7d, 0x37, 0x10, 0xb1, 0xf7, 0x42, 0xe7, 0x1d,
-	0x0f, 0x19, 0x4f, 0x3c, 0x93, 0xed, 0x9d, 0x7a, 0x81, 0x88, 0x63, 0x91, 0x78, 0x0c, 0x11, 0x94,
-	0xdb, 0x65, 0x5c, 0xfa, 0xc6, 0x87, 0x1e, 0x92, 0x4d, 0x09, 0x1f, 0x98, 0x0c, 0xd1, 0xfe, 0x4f,
-	0x17, 0xbe, 0x53, 0x2c, 0xec, 0x1b, 0x20, 0xaf, 0x77, 0xc9, 0x37, 0x07, 0xa4, 0xb2, 0x7c, 0x5f,
-	0xf4, 0x3e, 0xd9, 0xcd, 0xef, 0x93, 0x85, 0xa1, 0x04, 0x34, 0xf7, 0xbd, 0xe5, 0xef, 0x98, 0xe9,
-	0x91, 0x19, 0xd2, 0x7d, 0xb2, 0x37, 0x61, 0x23, 0x1e, 0x32, 0x25, 0xe6, 0xe4, 0x8a, 0x26, 0x2b,
-	0x57, 0x42, 0x0e, 0x37, 0xdf, 0x93, 0xf2, 0xc2, 0x2d, 0x5c, 0xbf, 0x6b, 0x5d, 0xbf, 0x4b, 0xef,
-	0x92, 0xed, 0xc5, 0x73, 0xd3, 0x19, 0x6b, 0x7e, 0x79, 0xe1, 0x90, 0x3a, 0x27, 0x67, 0x53, 0xc7,
-	0x3a, 0x9f, 0x3a, 0xd6, 0xcf, 0xa9, 0x63, 0x7d, 0x9e, 0x39, 0xa5, 0xf3, 0x99, 0x53, 0xfa, 0x36,
-	0x73, 0x4a, 0xef, 0x1e, 0xfc, 0xed, 0x5b, 0xcd, 0xff, 0x0d, 0xd4, 0xc7, 0x14, 0xb0, 0xbf, 0xa1,
-	0x7f, 0xea, 0x0f, 0x7f, 0x05, 0x00, 0x00, 0xff, 0xff, 0x20, 0x48, 0xe8, 0x74, 0x65, 0x04, 0x00,
-	0x00,
+	// 696 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0xd1, 0x4e, 0x13, 0x4d,
+	0x14, 0xee, 0xb6, 0xa5, 0xfc, 0x4c, 0x5b, 0x02, 0x03, 0xfc, 0xff, 0xfe, 0x24, 0x94, 0x5a, 0x82,
+	0x36, 0x41, 0x77, 0x2d, 0x26, 0x26, 0x5c, 0x19, 0x0a, 0xa2, 0x37, 0x28, 0x59, 0x8c, 0x26, 0x26,
+	0xa4, 0x99, 0xee, 0x0e, 0xcb, 0x84, 0xee, 0x4e, 0x33, 0x67, 0xa8, 0x78, 0xe1, 0x3b, 0xf0, 0x02,
+	0x5e, 0xfb, 0x2a, 0x5c, 0x72, 0x69, 0xbc, 0x20, 0x06, 0x5e, 0xc4, 0xec, 0xcc, 0x94, 0x2e, 0x6d,
+	0x51, 0x13, 0xbd, 0x6a, 0xf3, 0x9d, 0xef, 0x7c, 0xe7, 0x3b, 0x33, 0x67, 0xce, 0xa2, 0xff, 0xb8,
+	0x20, 0x7e, 0x87, 0xba, 0xbd, 0x86, 0x1b, 0xd2, 0x98, 0x02, 0x03, 0xa7, 0x2b, 0xb8, 0xe4, 0x78,
+	0x26, 0x66, 0x6d, 0x26, 0x4e, 0x1c, 0x1d, 0x77, 0x7a, 0x8d, 0xc5, 0xf9, 0x90, 0x87, 0x5c, 0x05,
+	0xdd, 0xe4, 0x9f, 0xe6, 0x2d, 0xfe, 0x3b, 0x10, 0x30, 0x54, 0x8d, 0x2f, 0x0c, 0x70, 0x90, 0x44,
+	0xf6, 0xe1, 0x8a, 0xcf, 0x21, 0xe2, 0xe0, 0xb6, 0x09, 0x24, 0xb1, 0x36, 0x95, 0xa4, 0xe1, 0xfa,
+	0x9c, 0xc5, 0x3a, 0x5e, 0xfb, 0x5c, 0x40, 0xa5, 0x17, 0xda, 0xc8, 0x7e, 0x92, 0x86, 0x9f, 0xa2,
+	0x42, 0x97, 0x08, 0x12, 0x81, 0x6d, 0x55, 0xad, 0x7a, 0x71, 0xdd, 0x76, 0x86, 0x8d, 0x39, 0x7b,
+	0x2a, 0xde, 0xcc, 0x9f, 0x5f, 0x2e, 0x67, 0x3c, 0xc3, 0xc6, 0xef, 0x10, 0x3e, 0xa4, 0x34, 0xa0,
+	0xa2, 0x15, 0xd0, 0x0e, 0x0d, 0x89, 0x64, 0x3c, 0x06, 0x3b, 0x5b, 0xcd, 0xd5, 0x8b, 0xeb, 0xb5,
+	0x51, 0x8d, 0x1d, 0xc5, 0xdd, 0xbe, 0xa1, 0x1a, 0xb5, 0xd9, 0xc3, 0x21, 0x1c, 0xb0, 0x87, 0xa6,
+	0xe9, 0xa9, 0x7f, 0x44, 0xe2, 0x90, 0xb6, 0x04, 0x91, 0x14, 0xec, 0x9c, 0x12, 0x5d, 0x1d, 0x15,
+	0x35, 0x8d, 0x3c, 0x37, 0x74, 0x8f, 0x48, 0x6a, 0x74, 0xcb, 0x34, 0x85, 0x01, 0x7e, 0x89, 0xca,
+	0x11, 0x03, 0x68, 0xf9, 0xfc, 0x24, 0x96, 0x54, 0x80, 0x9d, 0x57, 0x92, 0x4b, 0xa3, 0x92, 0xbb,
+	0x0c, 0x60, 0x4b, 0xb3, 0x8c, 0x54, 0x29, 0x1a, 0x40, 0x80, 0x3f, 0xa1, 0x2a, 0x09, 0x43, 0x91,
+	0xb8, 0xa5, 0xad, 0x5b, 0x3e, 0x5b, 0x5d, 0x41, 0x7b, 0x3c, 0xf1, 0x3b, 0xa1, 0xc4, 0x9d, 0x51,
+	0xf1, 0xcd, 0x7e, 0x66, 0xda, 0xf1, 0x9e, 0x4e, 0x33, 0xd5, 0x96, 0xc8, 0x4f, 0x38, 0x80, 0x25,
+	0x5a, 0xba, 0xab, 0xbc, 0xae, 0x5d, 0x50, 0xb5, 0xd7, 0x7e, 0xb3, 0xf6, 0xdb, 0x41, 0xe1, 0x45,
+	0x72, 0x17, 0x01, 0xf0, 0x6b, 0x34, 0xd1, 0x25, 0x4c, 0x80, 0x3d, 0x59, 0xcd, 0xd5, 0xa7, 0x9a,
+	0x1b, 0x49, 0xc2, 0xb7, 0xcb, 0xe5, 0x46, 0xc8, 0xe4, 0xd1, 0x49, 0xdb, 0xf1, 0x79, 0xe4, 0xbe,
+	0x52, 0xf5, 0xb6, 0x8e, 0x08, 0x8b, 0x5d, 0x5d, 0xdb, 0x3d, 0x75, 0x7d, 0x1e, 0x45, 0x3c, 0x76,
+	0x09, 0x00, 0x95, 0xce, 0x1e, 0x61, 0xc2, 0xd3, 0x3a, 0x78, 0x03, 0x4d, 0x0a, 0xfa, 0x81, 0x88,
+	0x00, 0xec, 0x7f, 0x94, 0xe1, 0xff, 0x47, 0x0d, 0x7b, 0x9a, 0x60, 0xec, 0xf5, 0xf9, 0xf8, 0x00,
+	0xcd, 0xdd, 0xee, 0x3b, 0x99, 0x7e, 0xb0, 0xa7, 0x94, 0xcc, 0x83, 0x71, 0xc3, 0xcb, 0x44, 0xba,
+	0xa3, 0x64, 0xea, 0xfb, 0xa2, 0xb3, 0x74, 0x38, 0x50, 0x3b, 0xcb, 0xa2, 0xb9, 0x31, 0x63, 0x85,
+	0x77, 0x51, 0x3e, 0xb1, 0xae, 0x1e, 0xc9, 0x1f, 0x9d, 0x80, 0x92, 0xc1, 0xfb, 0xa8, 0x7c, 0xab,
+	0x0b, 0x3b, 0xab, 0x74, 0x1d, 0xa3, 0x7b, 0x3f, 0xa5, 0x6b, 0x1e, 0xb4, 0xfe, 0x79, 0x04, 0xc1,
+	0xb1, 0x2b, 0x3f, 0x76, 0x29, 0x38, 0xdb, 0xd4, 0xf7, 0x4a, 0xe9, 0x06, 0xf0, 0x0a, 0x2a, 0xfb,
+	0x82, 0x12, 0x49, 0x83, 0x56, 0xbb, 0xc3, 0xfd, 0x63, 0x3b, 0x57, 0xb5, 0xea, 0x39, 0xaf, 0x64,
+	0xc0, 0x66, 0x82, 0xe1, 0xc7, 0x68, 0xbe, 0x4f, 0x92, 0x2c, 0xa2, 0x20, 0x49, 0xd4, 0x6d, 0x45,
+	0xc9, 0x8b, 0x48, 0xb8, 0xd8, 0xc4, 0xde, 0xf4, 0x43, 0xbb, 0x50, 0xfb, 0x62, 0xa1, 0x85, 0xb1,
+	0xa7, 0xf8, 0xb7, 0x0f, 0xe5, 0x19, 0x9a, 0xd0, 0x97, 0x99, 0x55, 0x9b, 0x68, 0x65, 0xf4, 0x32,
+	0xef, 0xba, 0x48, 0x9d, 0x57, 0x3b, 0x44, 0x33, 0xc3, 0x7b, 0x06, 0xaf, 0xa2, 0x69, 0xb3, 0xa7,
+	0x48, 0x10, 0x08, 0x0a, 0x7a, 0xcf, 0x4d, 0x79, 0x65, 0x8d, 0x6e, 0x6a, 0x10, 0xaf, 0xa1, 0xd9,
+	0x1e, 0xe9, 0xb0, 0x80, 0x48, 0x3e, 0x60, 0xaa, 0x4b, 0xf1, 0x66, 0x6e, 0x02, 0x86, 0x5c, 0x3b,
+	0x40, 0xc5, 0xd4, 0x9e, 0x18, 0x9f, 0x6b, 0x8d, 0xcf, 0xc5, 0xf7, 0x50, 0x29, 0xbd, 0x8a, 0x54,
+	0x8d, 0xbc, 0x57, 0x4c, 0x2d, 0x99, 0xe6, 0xce, 0xf9, 0x55, 0xc5, 0xba, 0xb8, 0xaa, 0x58, 0xdf,
+	0xaf, 0x2a, 0xd6, 0xd9, 0x75, 0x25, 0x73, 0x71, 0x5d, 0xc9, 0x7c, 0xbd, 0xae, 0x64, 0xde, 0x3f,
+	0xfc, 0xd5, 0xd1, 0x9a, 0x8f, 0x82, 0x9a, 0x90, 0x76, 0x41, 0xad, 0xfc, 0x27, 0x3f, 0x06, 0x00,
+	0xd3, 0x4f, 0x44, 0x5e, 0x84, 0x06, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -295,6 +422,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ExchangeRateStats) > 0 {
+		for iNdEx := len(m.ExchangeRateStats) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ExchangeRateStats[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
 	if len(m.Rewards) > 0 {
 		for iNdEx := len(m.Rewards) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -406,6 +547,102 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *GenesisExchangeRate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GenesisExchangeRate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GenesisExchangeRate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CreatedTimestampMs != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.CreatedTimestampMs))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.CreatedBlock != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.CreatedBlock))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.ExchangeRate.Size()
+		i -= size
+		if _, err := m.ExchangeRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.Pair.Size()
+		i -= size
+		if _, err := m.Pair.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *PairExchangeRateStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PairExchangeRateStats) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PairExchangeRateStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Stats.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.Pair.Size()
+		i -= size
+		if _, err := m.Pair.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *FeederDelegation) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -539,6 +776,44 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.ExchangeRateStats) > 0 {
+		for _, e := range m.ExchangeRateStats {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *GenesisExchangeRate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Pair.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.ExchangeRate.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if m.CreatedBlock != 0 {
+		n += 1 + sovGenesis(uint64(m.CreatedBlock))
+	}
+	if m.CreatedTimestampMs != 0 {
+		n += 1 + sovGenesis(uint64(m.CreatedTimestampMs))
+	}
+	return n
+}
+
+func (m *PairExchangeRateStats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Pair.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.Stats.Size()
+	n += 1 + l + sovGenesis(uint64(l))
 	return n
 }
 
@@ -706,7 +981,7 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ExchangeRates = append(m.ExchangeRates, ExchangeRateTuple{})
+			m.ExchangeRates = append(m.ExchangeRates, GenesisExchangeRate{})
 			if err := m.ExchangeRates[len(m.ExchangeRates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
@@ -883,6 +1158,313 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExchangeRateStats", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExchangeRateStats = append(m.ExchangeRateStats, PairExchangeRateStats{})
+			if err := m.ExchangeRateStats[len(m.ExchangeRateStats)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GenesisExchangeRate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisExchangeRate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisExchangeRate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pair", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Pair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExchangeRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ExchangeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedBlock", wireType)
+			}
+			m.CreatedBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreatedBlock |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedTimestampMs", wireType)
+			}
+			m.CreatedTimestampMs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreatedTimestampMs |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PairExchangeRateStats) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PairExchangeRateStats: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PairExchangeRateStats: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pair", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Pair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stats", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Stats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -30,6 +30,41 @@ var _ = time.Kitchen
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// AggregationMethod is how the votes of a ballot are aggregated into an
+// exchange rate.
+type AggregationMethod int32
+
+const (
+	// The median of the votes weighted by voting power.
+	AggregationMethod_WEIGHTED_MEDIAN AggregationMethod = 0
+	// The mean of the votes weighted by voting power, once trim_ratio of the
+	// voting power is trimmed from each end of the sorted votes.
+	AggregationMethod_TRIMMED_MEAN AggregationMethod = 1
+	// The weighted median of the votes within max_std_devs standard deviations
+	// of the weighted median of all votes.
+	AggregationMethod_OUTLIER_EXCLUDED_MEDIAN AggregationMethod = 2
+)
+
+var AggregationMethod_name = map[int32]string{
+	0: "WEIGHTED_MEDIAN",
+	1: "TRIMMED_MEAN",
+	2: "OUTLIER_EXCLUDED_MEDIAN",
+}
+
+var AggregationMethod_value = map[string]int32{
+	"WEIGHTED_MEDIAN":         0,
+	"TRIMMED_MEAN":            1,
+	"OUTLIER_EXCLUDED_MEDIAN": 2,
+}
+
+func (x AggregationMethod) String() string {
+	return proto.EnumName(AggregationMethod_name, int32(x))
+}
+
+func (AggregationMethod) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_652b57db11528d07, []int{0}
+}
+
 // Params defines the module parameters for the x/oracle module.
 type Params struct {
 	// VotePeriod defines the number of blocks during which voting takes place.
@@ -66,6 +101,22 @@ type Params struct {
 	MinVoters uint64 `protobuf:"varint,9,opt,name=min_voters,json=minVoters,proto3" json:"min_voters,omitempty" yaml:"min_voters"`
 	// The validator fee ratio that is given to validators every epoch.
 	ValidatorFeeRatio github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,10,opt,name=validator_fee_ratio,json=validatorFeeRatio,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"validator_fee_ratio" yaml:"validator_fee_ratio"`
+	// The maximum age of an exchange rate for it to be used by
+	// GetExchangeRateWithMaxAge, for pairs without a max age in
+	// pair_max_price_ages. Zero disables the check.
+	MaxPriceAge time.Duration `protobuf:"bytes,11,opt,name=max_price_age,json=maxPriceAge,proto3,stdduration" json:"max_price_age,omitempty" yaml:"max_price_age"`
+	// The maximum age of the exchange rate of specific pairs, overriding
+	// max_price_age.
+	PairMaxPriceAges []PairMaxPriceAge `protobuf:"bytes,12,rep,name=pair_max_price_ages,json=pairMaxPriceAges,proto3" json:"pair_max_price_ages" yaml:"pair_max_price_ages"`
+	// How long price snapshots are kept, at least twap_lookback_window. Zero
+	// keeps them forever.
+	PriceSnapshotRetention time.Duration `protobuf:"bytes,13,opt,name=price_snapshot_retention,json=priceSnapshotRetention,proto3,stdduration" json:"price_snapshot_retention,omitempty" yaml:"price_snapshot_retention"`
+	// The pairs whose exchange rate is derived from the ballots of two
+	// whitelisted pairs instead of being voted.
+	CrossRateRoutes []CrossRateRoute `protobuf:"bytes,14,rep,name=cross_rate_routes,json=crossRateRoutes,proto3" json:"cross_rate_routes" yaml:"cross_rate_routes"`
+	// How the ballots of specific pairs are aggregated, overriding the weighted
+	// median with reward_band and min_voters.
+	PairAggregations []PairAggregation `protobuf:"bytes,15,rep,name=pair_aggregations,json=pairAggregations,proto3" json:"pair_aggregations" yaml:"pair_aggregations"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -128,6 +179,199 @@ func (m *Params) GetMinVoters() uint64 {
 	return 0
 }
 
+func (m *Params) GetMaxPriceAge() time.Duration {
+	if m != nil {
+		return m.MaxPriceAge
+	}
+	return 0
+}
+
+func (m *Params) GetPairMaxPriceAges() []PairMaxPriceAge {
+	if m != nil {
+		return m.PairMaxPriceAges
+	}
+	return nil
+}
+
+func (m *Params) GetPriceSnapshotRetention() time.Duration {
+	if m != nil {
+		return m.PriceSnapshotRetention
+	}
+	return 0
+}
+
+func (m *Params) GetCrossRateRoutes() []CrossRateRoute {
+	if m != nil {
+		return m.CrossRateRoutes
+	}
+	return nil
+}
+
+func (m *Params) GetPairAggregations() []PairAggregation {
+	if m != nil {
+		return m.PairAggregations
+	}
+	return nil
+}
+
+// PairAggregation is how the ballots of a pair are aggregated.
+type PairAggregation struct {
+	Pair   github_com_NibiruChain_nibiru_x_common_asset.Pair `protobuf:"bytes,1,opt,name=pair,proto3,customtype=github.com/NibiruChain/nibiru/x/common/asset.Pair" json:"pair" yaml:"pair"`
+	Method AggregationMethod                                 `protobuf:"varint,2,opt,name=method,proto3,enum=nibiru.oracle.v1.AggregationMethod" json:"method,omitempty" yaml:"method"`
+	// The reward band of the pair, overriding the reward_band param.
+	RewardBand github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=reward_band,json=rewardBand,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"reward_band" yaml:"reward_band"`
+	// The minimum number of voters for a ballot of the pair to pass, overriding
+	// the min_voters param.
+	MinVoters uint64 `protobuf:"varint,4,opt,name=min_voters,json=minVoters,proto3" json:"min_voters,omitempty" yaml:"min_voters"`
+	// The share of the voting power trimmed from each end of the votes, in
+	// [0, 0.5). Only used by TRIMMED_MEAN.
+	TrimRatio github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=trim_ratio,json=trimRatio,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"trim_ratio" yaml:"trim_ratio"`
+	// The number of standard deviations from the weighted median beyond which a
+	// vote is an outlier. Only used by OUTLIER_EXCLUDED_MEDIAN.
+	MaxStdDevs github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=max_std_devs,json=maxStdDevs,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_std_devs" yaml:"max_std_devs"`
+}
+
+func (m *PairAggregation) Reset()         { *m = PairAggregation{} }
+func (m *PairAggregation) String() string { return proto.CompactTextString(m) }
+func (*PairAggregation) ProtoMessage()    {}
+func (*PairAggregation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_652b57db11528d07, []int{1}
+}
+func (m *PairAggregation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PairAggregation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PairAggregation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PairAggregation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PairAggregation.Merge(m, src)
+}
+func (m *PairAggregation) XXX_Size() int {
+	return m.Size()
+}
+func (m *PairAggregation) XXX_DiscardUnknown() {
+	xxx_messageInfo_PairAggregation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PairAggregation proto.InternalMessageInfo
+
+func (m *PairAggregation) GetMethod() AggregationMethod {
+	if m != nil {
+		return m.Method
+	}
+	return AggregationMethod_WEIGHTED_MEDIAN
+}
+
+func (m *PairAggregation) GetMinVoters() uint64 {
+	if m != nil {
+		return m.MinVoters
+	}
+	return 0
+}
+
+// CrossRateRoute derives the exchange rate of a pair from two whitelisted
+// pairs sharing their quote asset, e.g. ubtc:unibi from ubtc:unusd and
+// unibi:unusd. The vote of each validator on the base leg is divided by its
+// vote on the quote leg, and the weighted median of these cross rates becomes
+// the exchange rate of the pair.
+type CrossRateRoute struct {
+	// the derived pair, not whitelisted
+	Pair github_com_NibiruChain_nibiru_x_common_asset.Pair `protobuf:"bytes,1,opt,name=pair,proto3,customtype=github.com/NibiruChain/nibiru/x/common/asset.Pair" json:"pair" yaml:"pair"`
+	// the whitelisted pair of the base asset of the derived pair
+	BaseLeg github_com_NibiruChain_nibiru_x_common_asset.Pair `protobuf:"bytes,2,opt,name=base_leg,json=baseLeg,proto3,customtype=github.com/NibiruChain/nibiru/x/common/asset.Pair" json:"base_leg" yaml:"base_leg"`
+	// the whitelisted pair of the quote asset of the derived pair
+	QuoteLeg github_com_NibiruChain_nibiru_x_common_asset.Pair `protobuf:"bytes,3,opt,name=quote_leg,json=quoteLeg,proto3,customtype=github.com/NibiruChain/nibiru/x/common/asset.Pair" json:"quote_leg" yaml:"quote_leg"`
+}
+
+func (m *CrossRateRoute) Reset()         { *m = CrossRateRoute{} }
+func (m *CrossRateRoute) String() string { return proto.CompactTextString(m) }
+func (*CrossRateRoute) ProtoMessage()    {}
+func (*CrossRateRoute) Descriptor() ([]byte, []int) {
+	return fileDescriptor_652b57db11528d07, []int{2}
+}
+func (m *CrossRateRoute) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CrossRateRoute) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CrossRateRoute.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CrossRateRoute) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CrossRateRoute.Merge(m, src)
+}
+func (m *CrossRateRoute) XXX_Size() int {
+	return m.Size()
+}
+func (m *CrossRateRoute) XXX_DiscardUnknown() {
+	xxx_messageInfo_CrossRateRoute.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CrossRateRoute proto.InternalMessageInfo
+
+// PairMaxPriceAge is the maximum age of the exchange rate of a pair.
+type PairMaxPriceAge struct {
+	Pair github_com_NibiruChain_nibiru_x_common_asset.Pair `protobuf:"bytes,1,opt,name=pair,proto3,customtype=github.com/NibiruChain/nibiru/x/common/asset.Pair" json:"pair" yaml:"pair"`
+	// Zero disables the check for the pair.
+	MaxAge time.Duration `protobuf:"bytes,2,opt,name=max_age,json=maxAge,proto3,stdduration" json:"max_age,omitempty" yaml:"max_age"`
+}
+
+func (m *PairMaxPriceAge) Reset()         { *m = PairMaxPriceAge{} }
+func (m *PairMaxPriceAge) String() string { return proto.CompactTextString(m) }
+func (*PairMaxPriceAge) ProtoMessage()    {}
+func (*PairMaxPriceAge) Descriptor() ([]byte, []int) {
+	return fileDescriptor_652b57db11528d07, []int{3}
+}
+func (m *PairMaxPriceAge) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PairMaxPriceAge) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PairMaxPriceAge.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PairMaxPriceAge) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PairMaxPriceAge.Merge(m, src)
+}
+func (m *PairMaxPriceAge) XXX_Size() int {
+	return m.Size()
+}
+func (m *PairMaxPriceAge) XXX_DiscardUnknown() {
+	xxx_messageInfo_PairMaxPriceAge.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PairMaxPriceAge proto.InternalMessageInfo
+
+func (m *PairMaxPriceAge) GetMaxAge() time.Duration {
+	if m != nil {
+		return m.MaxAge
+	}
+	return 0
+}
+
 // Struct for aggregate prevoting on the ExchangeRateVote.
 // The purpose of aggregate prevote is to hide vote exchange rates with hash
 // which is formatted as hex string in
@@ -141,7 +385,7 @@ type AggregateExchangeRatePrevote struct {
 func (m *AggregateExchangeRatePrevote) Reset()      { *m = AggregateExchangeRatePrevote{} }
 func (*AggregateExchangeRatePrevote) ProtoMessage() {}
 func (*AggregateExchangeRatePrevote) Descriptor() ([]byte, []int) {
-	return fileDescriptor_652b57db11528d07, []int{1}
+	return fileDescriptor_652b57db11528d07, []int{4}
 }
 func (m *AggregateExchangeRatePrevote) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -180,7 +424,7 @@ type AggregateExchangeRateVote struct {
 func (m *AggregateExchangeRateVote) Reset()      { *m = AggregateExchangeRateVote{} }
 func (*AggregateExchangeRateVote) ProtoMessage() {}
 func (*AggregateExchangeRateVote) Descriptor() ([]byte, []int) {
-	return fileDescriptor_652b57db11528d07, []int{2}
+	return fileDescriptor_652b57db11528d07, []int{5}
 }
 func (m *AggregateExchangeRateVote) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -218,7 +462,7 @@ type ExchangeRateTuple struct {
 func (m *ExchangeRateTuple) Reset()      { *m = ExchangeRateTuple{} }
 func (*ExchangeRateTuple) ProtoMessage() {}
 func (*ExchangeRateTuple) Descriptor() ([]byte, []int) {
-	return fileDescriptor_652b57db11528d07, []int{3}
+	return fileDescriptor_652b57db11528d07, []int{6}
 }
 func (m *ExchangeRateTuple) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -263,7 +507,7 @@ func (m *Rewards) Reset()         { *m = Rewards{} }
 func (m *Rewards) String() string { return proto.CompactTextString(m) }
 func (*Rewards) ProtoMessage()    {}
 func (*Rewards) Descriptor() ([]byte, []int) {
-	return fileDescriptor_652b57db11528d07, []int{4}
+	return fileDescriptor_652b57db11528d07, []int{7}
 }
 func (m *Rewards) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -314,7 +558,11 @@ func (m *Rewards) GetCoins() []types.Coin {
 }
 
 func init() {
+	proto.RegisterEnum("nibiru.oracle.v1.AggregationMethod", AggregationMethod_name, AggregationMethod_value)
 	proto.RegisterType((*Params)(nil), "nibiru.oracle.v1.Params")
+	proto.RegisterType((*PairAggregation)(nil), "nibiru.oracle.v1.PairAggregation")
+	proto.RegisterType((*CrossRateRoute)(nil), "nibiru.oracle.v1.CrossRateRoute")
+	proto.RegisterType((*PairMaxPriceAge)(nil), "nibiru.oracle.v1.PairMaxPriceAge")
 	proto.RegisterType((*AggregateExchangeRatePrevote)(nil), "nibiru.oracle.v1.AggregateExchangeRatePrevote")
 	proto.RegisterType((*AggregateExchangeRateVote)(nil), "nibiru.oracle.v1.AggregateExchangeRateVote")
 	proto.RegisterType((*ExchangeRateTuple)(nil), "nibiru.oracle.v1.ExchangeRateTuple")
@@ -324,64 +572,93 @@ func init() {
 func init() { proto.RegisterFile("oracle/v1/oracle.proto", fileDescriptor_652b57db11528d07) }
 
 var fileDescriptor_652b57db11528d07 = []byte{
-	// 906 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x55, 0x4f, 0x6f, 0x1b, 0x45,
-	0x14, 0xf7, 0x26, 0x4e, 0x1a, 0x8f, 0x93, 0x92, 0x4c, 0xdd, 0xb2, 0x09, 0xc8, 0x6b, 0xa6, 0x52,
-	0xe5, 0x43, 0xd9, 0x55, 0x0a, 0x08, 0x91, 0x1b, 0xdb, 0x10, 0xa8, 0x54, 0x90, 0x35, 0xaa, 0x40,
-	0x42, 0x48, 0xd6, 0xec, 0xee, 0x64, 0x3d, 0xf2, 0xee, 0x8e, 0xb5, 0x33, 0x8e, 0x1b, 0x09, 0x71,
-	0xe2, 0xc0, 0xb1, 0x27, 0xd4, 0x63, 0xce, 0xdc, 0xf9, 0x0e, 0x3d, 0xf6, 0x88, 0x7a, 0xd8, 0xa2,
-	0x84, 0x03, 0x42, 0x9c, 0xfc, 0x09, 0xd0, 0xcc, 0x8e, 0xe3, 0x4d, 0x62, 0x09, 0x42, 0x4f, 0xbb,
-	0xef, 0xbd, 0x99, 0xdf, 0xfb, 0xbd, 0x7f, 0xf3, 0xc0, 0x1d, 0x9e, 0x93, 0x30, 0xa1, 0xde, 0xd1,
-	0xae, 0x57, 0xfe, 0xb9, 0xa3, 0x9c, 0x4b, 0x0e, 0x37, 0x33, 0x16, 0xb0, 0x7c, 0xec, 0x1a, 0xe5,
-	0xd1, 0xee, 0x4e, 0x2b, 0xe6, 0x31, 0xd7, 0x46, 0x4f, 0xfd, 0x95, 0xe7, 0x76, 0xda, 0x31, 0xe7,
-	0x71, 0x42, 0x3d, 0x2d, 0x05, 0xe3, 0x43, 0x2f, 0x1a, 0xe7, 0x44, 0x32, 0x9e, 0xcd, 0xec, 0x21,
-	0x17, 0x29, 0x17, 0x5e, 0x40, 0x84, 0x72, 0x12, 0x50, 0x49, 0x76, 0xbd, 0x90, 0x33, 0x63, 0x47,
-	0x3f, 0xae, 0x81, 0xd5, 0x1e, 0xc9, 0x49, 0x2a, 0xe0, 0xc7, 0xa0, 0x79, 0xc4, 0x25, 0xed, 0x8f,
-	0x68, 0xce, 0x78, 0x64, 0x5b, 0x1d, 0xab, 0x5b, 0xf7, 0xef, 0x4c, 0x0b, 0x07, 0x1e, 0x93, 0x34,
-	0xd9, 0x43, 0x15, 0x23, 0xc2, 0x40, 0x49, 0x3d, 0x2d, 0xc0, 0x0c, 0xdc, 0xd4, 0x36, 0x39, 0xc8,
-	0xa9, 0x18, 0xf0, 0x24, 0xb2, 0x97, 0x3a, 0x56, 0xb7, 0xe1, 0x7f, 0xfe, 0xa2, 0x70, 0x6a, 0xaf,
-	0x0a, 0xe7, 0x5e, 0xcc, 0xe4, 0x60, 0x1c, 0xb8, 0x21, 0x4f, 0x3d, 0x43, 0xa7, 0xfc, 0xbc, 0x2f,
-	0xa2, 0xa1, 0x27, 0x8f, 0x47, 0x54, 0xb8, 0xfb, 0x34, 0x9c, 0x16, 0xce, 0xed, 0x8a, 0xa7, 0x73,
-	0x34, 0x84, 0x37, 0x94, 0xe2, 0xc9, 0x4c, 0x86, 0x14, 0x34, 0x73, 0x3a, 0x21, 0x79, 0xd4, 0x0f,
-	0x48, 0x16, 0xd9, 0xcb, 0xda, 0xd9, 0xfe, 0xb5, 0x9d, 0x99, 0xb0, 0x2a, 0x50, 0x08, 0x83, 0x52,
-	0xf2, 0x49, 0x16, 0xc1, 0x18, 0x34, 0x26, 0x03, 0x26, 0x69, 0xc2, 0x84, 0xb4, 0xeb, 0x9d, 0xe5,
-	0x6e, 0xc3, 0x7f, 0xf4, 0xaa, 0x70, 0x76, 0x2b, 0x0e, 0xbe, 0xd2, 0x45, 0x7a, 0x38, 0x20, 0x2c,
-	0xf3, 0xca, 0x82, 0x79, 0x4f, 0xbd, 0x90, 0xa7, 0x29, 0xcf, 0x3c, 0x22, 0x04, 0x95, 0x6e, 0x8f,
-	0xb0, 0x7c, 0x5a, 0x38, 0x9b, 0xa5, 0xaf, 0x73, 0x3c, 0x84, 0xe7, 0xd8, 0x2a, 0x7f, 0x22, 0x21,
-	0x62, 0xd0, 0x3f, 0xcc, 0x49, 0xa8, 0x6a, 0x67, 0xaf, 0xbc, 0x59, 0xfe, 0x2e, 0xa2, 0x21, 0xbc,
-	0xa1, 0x15, 0x07, 0x46, 0x86, 0x7b, 0x60, 0xbd, 0x3c, 0x31, 0x61, 0x59, 0xc4, 0x27, 0xf6, 0xaa,
-	0xae, 0xf4, 0xdb, 0xd3, 0xc2, 0xb9, 0x55, 0xbd, 0x5f, 0x5a, 0x11, 0x6e, 0x6a, 0xf1, 0x1b, 0x2d,
-	0xc1, 0x1f, 0x40, 0x2b, 0x65, 0x59, 0xff, 0x88, 0x24, 0x2c, 0x52, 0xcd, 0x30, 0xc3, 0xb8, 0xa1,
-	0x19, 0x7f, 0x79, 0x6d, 0xc6, 0xef, 0x94, 0x1e, 0x17, 0x61, 0x22, 0xbc, 0x95, 0xb2, 0xec, 0x6b,
-	0xa5, 0xed, 0xd1, 0xdc, 0xf8, 0xff, 0xd9, 0x02, 0x2d, 0x39, 0x21, 0xa3, 0x7e, 0xc2, 0xf9, 0x30,
-	0x20, 0xe1, 0x70, 0x46, 0x60, 0xad, 0x63, 0x75, 0x9b, 0x0f, 0xb6, 0xdd, 0x72, 0x1e, 0xdc, 0xd9,
-	0x3c, 0xb8, 0xfb, 0x66, 0x1e, 0xfc, 0x47, 0x8a, 0xdb, 0x5f, 0x85, 0xd3, 0x5e, 0x74, 0xfd, 0x3e,
-	0x4f, 0x99, 0xa4, 0xe9, 0x48, 0x1e, 0xcf, 0x39, 0x2d, 0x3a, 0x87, 0x9e, 0xbf, 0x76, 0x2c, 0x0c,
-	0x95, 0xe9, 0xb1, 0xb1, 0x18, 0x62, 0x1f, 0x02, 0xa0, 0x83, 0xe0, 0x92, 0xe6, 0xc2, 0x6e, 0xe8,
-	0x94, 0xde, 0x9e, 0x16, 0xce, 0x56, 0x25, 0x40, 0x6d, 0x43, 0xb8, 0xa1, 0xc2, 0xd2, 0xff, 0xf0,
-	0x7b, 0x70, 0x4b, 0x87, 0x4d, 0x24, 0xcf, 0xfb, 0x87, 0x94, 0xf6, 0x35, 0x59, 0x1b, 0xe8, 0x6c,
-	0x3e, 0xbe, 0x76, 0x36, 0x77, 0xcc, 0xfc, 0x5c, 0x85, 0x44, 0x78, 0xeb, 0x5c, 0x7b, 0x40, 0x29,
-	0x56, 0xba, 0xbd, 0xb5, 0xe7, 0x27, 0x4e, 0xed, 0xcf, 0x13, 0xc7, 0x42, 0xbf, 0x5a, 0xe0, 0xdd,
-	0x4f, 0xe3, 0x38, 0xa7, 0x31, 0x91, 0xf4, 0xb3, 0xa7, 0xe1, 0x80, 0x64, 0xb1, 0x3a, 0x44, 0x7b,
-	0x39, 0x55, 0xb4, 0xe1, 0x5d, 0x50, 0x1f, 0x10, 0x31, 0xd0, 0xaf, 0x42, 0xc3, 0x7f, 0x6b, 0x5a,
-	0x38, 0xcd, 0xd2, 0x97, 0xd2, 0x22, 0xac, 0x8d, 0xf0, 0x1e, 0x58, 0xd1, 0x31, 0x9a, 0xf9, 0xdf,
-	0x9c, 0x16, 0xce, 0xfa, 0x7c, 0xa2, 0x73, 0x84, 0x4b, 0xb3, 0x6e, 0xc0, 0x71, 0x90, 0x32, 0xd9,
-	0x0f, 0x12, 0x1e, 0x0e, 0xf5, 0x04, 0x5f, 0x6c, 0xc0, 0x8a, 0x55, 0x35, 0xa0, 0x16, 0x7d, 0x25,
-	0xed, 0xad, 0xff, 0x74, 0xe2, 0xd4, 0x0c, 0xef, 0x1a, 0xfa, 0xc3, 0x02, 0xdb, 0x0b, 0x79, 0xab,
-	0xfc, 0xc2, 0x67, 0x16, 0x68, 0x51, 0xa3, 0x54, 0x69, 0xa0, 0x7d, 0x39, 0x1e, 0x25, 0x54, 0xd8,
-	0x56, 0x67, 0xb9, 0xdb, 0x7c, 0x70, 0xd7, 0xbd, 0xfc, 0xc8, 0xba, 0x55, 0x88, 0x27, 0xea, 0xac,
-	0xff, 0x89, 0x2a, 0xc2, 0xbc, 0x29, 0x16, 0xc1, 0xa1, 0x5f, 0x5e, 0x3b, 0xf0, 0xca, 0x4d, 0x81,
-	0x21, 0xbd, 0xa2, 0xfb, 0xaf, 0x29, 0xba, 0x14, 0xe6, 0xdf, 0x16, 0xd8, 0xba, 0xe2, 0x00, 0x7e,
-	0x07, 0xea, 0x23, 0xc2, 0x72, 0x53, 0x93, 0x2f, 0x4c, 0xb7, 0xfc, 0xaf, 0xf7, 0xc9, 0x14, 0x53,
-	0xc1, 0x21, 0xac, 0x51, 0xe1, 0x10, 0x6c, 0x5c, 0x08, 0xd6, 0x30, 0x3e, 0xb8, 0x76, 0x53, 0xb6,
-	0x16, 0x64, 0x0e, 0xe1, 0xf5, 0x6a, 0x72, 0x2e, 0x85, 0x2b, 0xc0, 0x0d, 0xac, 0xdf, 0x61, 0x01,
-	0x6f, 0x82, 0x25, 0x66, 0x76, 0x11, 0x5e, 0x62, 0x11, 0x7c, 0x0f, 0xac, 0x57, 0xf6, 0x90, 0xd0,
-	0xa4, 0xea, 0xb8, 0x39, 0xdf, 0x46, 0x02, 0x7e, 0x04, 0x56, 0xd4, 0x82, 0x13, 0xf6, 0xb2, 0xae,
-	0xf2, 0xb6, 0x5b, 0xf2, 0x72, 0xd5, 0x0a, 0x74, 0xcd, 0x0a, 0x74, 0x1f, 0x72, 0x96, 0xf9, 0x75,
-	0x15, 0x0b, 0x2e, 0x4f, 0xfb, 0x07, 0x2f, 0x4e, 0xdb, 0xd6, 0xcb, 0xd3, 0xb6, 0xf5, 0xfb, 0x69,
-	0xdb, 0x7a, 0x76, 0xd6, 0xae, 0xbd, 0x3c, 0x6b, 0xd7, 0x7e, 0x3b, 0x6b, 0xd7, 0xbe, 0xbd, 0xff,
-	0x6f, 0x19, 0x35, 0x3b, 0x5c, 0x07, 0x1d, 0xac, 0xea, 0xa7, 0xe7, 0x83, 0x7f, 0x02, 0x00, 0x00,
-	0xff, 0xff, 0x39, 0x63, 0x00, 0xbd, 0xda, 0x07, 0x00, 0x00,
+	// 1376 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0xcf, 0x6f, 0x1b, 0xc5,
+	0x17, 0xf7, 0x3a, 0x6e, 0x12, 0x8f, 0xed, 0xc4, 0x9e, 0xa4, 0xed, 0xb6, 0xfd, 0xca, 0xeb, 0x4e,
+	0xa5, 0x2a, 0xfa, 0xaa, 0x78, 0x95, 0x02, 0x42, 0x84, 0x53, 0x36, 0x76, 0xda, 0x48, 0x49, 0x08,
+	0xd3, 0x94, 0x22, 0x84, 0xb4, 0x1a, 0xaf, 0xa7, 0xeb, 0x25, 0xde, 0x1d, 0xb3, 0xb3, 0x4e, 0x52,
+	0x84, 0x90, 0xb8, 0x71, 0xec, 0x09, 0xf5, 0xd8, 0x13, 0x07, 0x2e, 0x9c, 0xf8, 0x1f, 0xca, 0xad,
+	0x47, 0xd4, 0x83, 0x8b, 0x5a, 0x0e, 0x08, 0x71, 0xf2, 0x5f, 0x80, 0x66, 0x76, 0x1c, 0xaf, 0x63,
+	0x97, 0x34, 0xad, 0xc2, 0x69, 0xf7, 0xfd, 0x98, 0xf7, 0xe3, 0xf3, 0xe6, 0xbd, 0x99, 0x01, 0x17,
+	0x58, 0x48, 0x9c, 0x36, 0x35, 0xf7, 0x97, 0xcd, 0xf8, 0xaf, 0xda, 0x09, 0x59, 0xc4, 0x60, 0x31,
+	0xf0, 0x1a, 0x5e, 0xd8, 0xad, 0x2a, 0xe6, 0xfe, 0xf2, 0xe5, 0x45, 0x97, 0xb9, 0x4c, 0x0a, 0x4d,
+	0xf1, 0x17, 0xeb, 0x5d, 0x2e, 0xbb, 0x8c, 0xb9, 0x6d, 0x6a, 0x4a, 0xaa, 0xd1, 0xbd, 0x6f, 0x36,
+	0xbb, 0x21, 0x89, 0x3c, 0x16, 0x0c, 0xe4, 0x0e, 0xe3, 0x3e, 0xe3, 0x66, 0x83, 0x70, 0xe1, 0xa4,
+	0x41, 0x23, 0xb2, 0x6c, 0x3a, 0xcc, 0x53, 0x72, 0xf4, 0x5d, 0x01, 0x4c, 0xef, 0x90, 0x90, 0xf8,
+	0x1c, 0x7e, 0x00, 0x72, 0xfb, 0x2c, 0xa2, 0x76, 0x87, 0x86, 0x1e, 0x6b, 0xea, 0x5a, 0x45, 0x5b,
+	0xca, 0x58, 0x17, 0xfa, 0x3d, 0x03, 0x3e, 0x20, 0x7e, 0x7b, 0x05, 0x25, 0x84, 0x08, 0x03, 0x41,
+	0xed, 0x48, 0x02, 0x06, 0x60, 0x4e, 0xca, 0xa2, 0x56, 0x48, 0x79, 0x8b, 0xb5, 0x9b, 0x7a, 0xba,
+	0xa2, 0x2d, 0x65, 0xad, 0x5b, 0x4f, 0x7a, 0x46, 0xea, 0x59, 0xcf, 0xb8, 0xee, 0x7a, 0x51, 0xab,
+	0xdb, 0xa8, 0x3a, 0xcc, 0x37, 0x55, 0x38, 0xf1, 0xe7, 0x1d, 0xde, 0xdc, 0x33, 0xa3, 0x07, 0x1d,
+	0xca, 0xab, 0x35, 0xea, 0xf4, 0x7b, 0xc6, 0xf9, 0x84, 0xa7, 0x23, 0x6b, 0x08, 0x17, 0x04, 0x63,
+	0x77, 0x40, 0x43, 0x0a, 0x72, 0x21, 0x3d, 0x20, 0x61, 0xd3, 0x6e, 0x90, 0xa0, 0xa9, 0x4f, 0x49,
+	0x67, 0xb5, 0x53, 0x3b, 0x53, 0x69, 0x25, 0x4c, 0x21, 0x0c, 0x62, 0xca, 0x22, 0x41, 0x13, 0xba,
+	0x20, 0x7b, 0xd0, 0xf2, 0x22, 0xda, 0xf6, 0x78, 0xa4, 0x67, 0x2a, 0x53, 0x4b, 0x59, 0x6b, 0xe3,
+	0x59, 0xcf, 0x58, 0x4e, 0x38, 0xd8, 0x96, 0x45, 0x5a, 0x6b, 0x11, 0x2f, 0x30, 0xe3, 0x82, 0x99,
+	0x87, 0xa6, 0xc3, 0x7c, 0x9f, 0x05, 0x26, 0xe1, 0x9c, 0x46, 0xd5, 0x1d, 0xe2, 0x85, 0xfd, 0x9e,
+	0x51, 0x8c, 0x7d, 0x1d, 0xd9, 0x43, 0x78, 0x68, 0x5b, 0xe0, 0xc7, 0xdb, 0x84, 0xb7, 0xec, 0xfb,
+	0x21, 0x71, 0x44, 0xed, 0xf4, 0x73, 0x6f, 0x87, 0xdf, 0xa8, 0x35, 0x84, 0x0b, 0x92, 0xb1, 0xae,
+	0x68, 0xb8, 0x02, 0xf2, 0xb1, 0xc6, 0x81, 0x17, 0x34, 0xd9, 0x81, 0x3e, 0x2d, 0x2b, 0x7d, 0xb1,
+	0xdf, 0x33, 0x16, 0x92, 0xeb, 0x63, 0x29, 0xc2, 0x39, 0x49, 0xde, 0x93, 0x14, 0xfc, 0x16, 0x2c,
+	0xfa, 0x5e, 0x60, 0xef, 0x93, 0xb6, 0xd7, 0x14, 0x9b, 0x61, 0x60, 0x63, 0x46, 0x46, 0xbc, 0x75,
+	0xea, 0x88, 0xaf, 0xc4, 0x1e, 0x27, 0xd9, 0x44, 0xb8, 0xe4, 0x7b, 0xc1, 0xa7, 0x82, 0xbb, 0x43,
+	0x43, 0xe5, 0xff, 0x07, 0x0d, 0x2c, 0x46, 0x07, 0xa4, 0x63, 0xb7, 0x19, 0xdb, 0x6b, 0x10, 0x67,
+	0x6f, 0x10, 0xc0, 0x6c, 0x45, 0x5b, 0xca, 0xdd, 0xbc, 0x54, 0x8d, 0xfb, 0xa1, 0x3a, 0xe8, 0x87,
+	0x6a, 0x4d, 0xf5, 0x83, 0xb5, 0x21, 0x62, 0xfb, 0xab, 0x67, 0x94, 0x27, 0x2d, 0xbf, 0xc1, 0x7c,
+	0x2f, 0xa2, 0x7e, 0x27, 0x7a, 0x30, 0x8c, 0x69, 0x92, 0x1e, 0x7a, 0xf4, 0xdc, 0xd0, 0x30, 0x14,
+	0xa2, 0x4d, 0x25, 0x51, 0x81, 0xbd, 0x07, 0x80, 0x4c, 0x82, 0x45, 0x34, 0xe4, 0x7a, 0x56, 0x42,
+	0x7a, 0xbe, 0xdf, 0x33, 0x4a, 0x89, 0x04, 0xa5, 0x0c, 0xe1, 0xac, 0x48, 0x4b, 0xfe, 0xc3, 0x6f,
+	0xc0, 0x82, 0x4c, 0x9b, 0x44, 0x2c, 0xb4, 0xef, 0x53, 0x6a, 0xcb, 0x60, 0x75, 0x20, 0xd1, 0xdc,
+	0x3c, 0x35, 0x9a, 0x97, 0x55, 0xff, 0x8c, 0x9b, 0x44, 0xb8, 0x74, 0xc4, 0x5d, 0xa7, 0x14, 0x0b,
+	0x1e, 0xfc, 0x1a, 0x14, 0x7c, 0x72, 0x68, 0x77, 0x42, 0xcf, 0xa1, 0x36, 0x71, 0xa9, 0x9e, 0x3b,
+	0x09, 0xc4, 0x8f, 0x14, 0x88, 0x17, 0x47, 0xd6, 0x8d, 0xa0, 0xb7, 0xa8, 0x12, 0x4e, 0x2a, 0xc4,
+	0xb0, 0xe5, 0x7c, 0x72, 0xb8, 0x23, 0x58, 0xab, 0x2e, 0x85, 0x11, 0x58, 0xe8, 0x10, 0x2f, 0xb4,
+	0x47, 0xf4, 0xb8, 0x9e, 0xaf, 0x4c, 0x2d, 0xe5, 0x6e, 0x5e, 0xad, 0x1e, 0x1f, 0x7f, 0xb2, 0x91,
+	0xb6, 0x86, 0xeb, 0x2d, 0x24, 0x22, 0x19, 0xa6, 0x3c, 0xc1, 0x16, 0xc2, 0xc5, 0xce, 0xe8, 0x22,
+	0x0e, 0x7f, 0xd4, 0x80, 0x1e, 0x6b, 0xf0, 0x80, 0x74, 0x78, 0x8b, 0x45, 0x76, 0x48, 0x23, 0x1a,
+	0xc8, 0xae, 0x2b, 0x9c, 0x94, 0xfd, 0x27, 0x2a, 0x7b, 0xf4, 0x2a, 0x13, 0x23, 0x40, 0x18, 0x2a,
+	0xb2, 0x57, 0xe8, 0xc6, 0x98, 0x5c, 0x90, 0xe2, 0x3b, 0x4a, 0x8a, 0x07, 0x42, 0x18, 0x80, 0x92,
+	0x13, 0x32, 0xce, 0x45, 0xf5, 0xa8, 0x1d, 0xb2, 0x6e, 0x44, 0xb9, 0x3e, 0x27, 0xc1, 0xa9, 0x8c,
+	0x83, 0xb3, 0x26, 0x54, 0x31, 0x89, 0x28, 0x16, 0x8a, 0x56, 0x45, 0x61, 0xa3, 0xc7, 0x11, 0x8c,
+	0x19, 0x42, 0x78, 0xde, 0x19, 0x59, 0xc1, 0x61, 0x07, 0x94, 0x24, 0x84, 0xc4, 0x75, 0x43, 0xea,
+	0xca, 0x74, 0xb9, 0x3e, 0xff, 0x6f, 0xc5, 0x58, 0x1d, 0x6a, 0x1e, 0x77, 0x38, 0x66, 0x49, 0x95,
+	0x22, 0xb1, 0x84, 0xaf, 0xcc, 0x3e, 0x7a, 0x6c, 0xa4, 0xfe, 0x7c, 0x6c, 0x68, 0xe8, 0xe7, 0x0c,
+	0x98, 0x3f, 0x66, 0x11, 0x7e, 0x01, 0x32, 0x62, 0x85, 0x3c, 0x85, 0xb2, 0xd6, 0x6d, 0xd5, 0x09,
+	0x6f, 0x34, 0x7b, 0x73, 0xc3, 0xa0, 0x10, 0x96, 0x56, 0xe1, 0x36, 0x98, 0xf6, 0x69, 0xd4, 0x62,
+	0xf1, 0x49, 0x35, 0x77, 0xf3, 0xda, 0x78, 0x8a, 0x89, 0x60, 0xb6, 0xa4, 0xaa, 0x55, 0xea, 0xf7,
+	0x8c, 0x82, 0xda, 0xdc, 0x92, 0x83, 0xb0, 0xb2, 0xf2, 0x5f, 0x9d, 0x48, 0xa3, 0x33, 0x26, 0xf3,
+	0x9a, 0x33, 0xa6, 0x01, 0x40, 0x14, 0x7a, 0xbe, 0x1a, 0x2d, 0xf1, 0xd1, 0xb2, 0x76, 0xea, 0xd8,
+	0x94, 0x8f, 0xa1, 0x25, 0x84, 0xb3, 0x82, 0x88, 0x27, 0x89, 0x0b, 0xf2, 0xa2, 0xf9, 0x78, 0xd4,
+	0xb4, 0x9b, 0x74, 0x9f, 0xcb, 0x23, 0x25, 0x6b, 0xd5, 0x4f, 0xed, 0x65, 0x61, 0x38, 0x3c, 0x06,
+	0xb6, 0x10, 0x06, 0x3e, 0x39, 0xbc, 0x13, 0x35, 0x6b, 0x74, 0x9f, 0xaf, 0x64, 0xe4, 0x8e, 0xf9,
+	0x35, 0x0d, 0xe6, 0x46, 0xf7, 0xfc, 0x19, 0x6f, 0x18, 0x17, 0xcc, 0x8a, 0x1b, 0x94, 0xdd, 0xa6,
+	0xae, 0x9e, 0x1e, 0x19, 0xce, 0x6f, 0xe4, 0x61, 0x3e, 0xf6, 0x30, 0x30, 0x89, 0xf0, 0x8c, 0xf8,
+	0xdd, 0xa4, 0x2e, 0xfc, 0x12, 0x64, 0xbf, 0xea, 0xb2, 0x28, 0xf6, 0x34, 0x35, 0x72, 0xa8, 0xbe,
+	0xcd, 0xc5, 0xe3, 0xc8, 0x26, 0xc2, 0xb3, 0xf2, 0x7f, 0x93, 0xba, 0x0a, 0xcb, 0x67, 0x5a, 0xdc,
+	0x7d, 0x89, 0x39, 0x79, 0xc6, 0x60, 0x3a, 0x60, 0x46, 0x14, 0x58, 0x1c, 0x38, 0xe9, 0x93, 0x46,
+	0xae, 0xa9, 0x46, 0x6e, 0x49, 0xad, 0x18, 0x99, 0xb0, 0x73, 0xc3, 0xdd, 0x72, 0x74, 0xc8, 0x4c,
+	0xfb, 0xe4, 0x70, 0xd5, 0xa5, 0x2a, 0xb9, 0x5f, 0x34, 0xf0, 0xbf, 0x41, 0x27, 0xd3, 0xfa, 0xa1,
+	0xd3, 0x22, 0x81, 0x2b, 0x0e, 0x3f, 0xba, 0x13, 0x52, 0xd1, 0x2a, 0xf0, 0x1a, 0xc8, 0xb4, 0x08,
+	0x6f, 0xa9, 0x4c, 0xe7, 0x87, 0x01, 0x0b, 0x2e, 0xc2, 0x52, 0x08, 0xaf, 0x83, 0x73, 0xb2, 0xaf,
+	0x54, 0xe9, 0x8b, 0xfd, 0x9e, 0x91, 0x1f, 0xde, 0x54, 0x43, 0x84, 0x63, 0xb1, 0xbc, 0x58, 0x75,
+	0x1b, 0xbe, 0x17, 0xd9, 0x8d, 0x36, 0x73, 0xf6, 0xf4, 0xa9, 0xb1, 0x8b, 0x55, 0x42, 0x2a, 0x2e,
+	0x56, 0x92, 0xb4, 0x04, 0xb5, 0x92, 0xff, 0xfe, 0xb1, 0x91, 0x52, 0x23, 0x31, 0x85, 0xfe, 0xd0,
+	0xc0, 0xa5, 0x89, 0x71, 0x8b, 0x9e, 0x86, 0x0f, 0x35, 0xb0, 0x48, 0x15, 0x33, 0x9e, 0xeb, 0x51,
+	0xb7, 0xd3, 0xa6, 0x5c, 0xd7, 0xe4, 0xc0, 0x9e, 0x30, 0xcd, 0x92, 0x26, 0x76, 0x85, 0xae, 0xf5,
+	0xa1, 0x1a, 0xd9, 0xea, 0xb2, 0x33, 0xc9, 0x1c, 0xfa, 0xe9, 0xb9, 0x01, 0xc7, 0x56, 0x72, 0x0c,
+	0xe9, 0x18, 0xef, 0x75, 0x21, 0x3a, 0x96, 0xe6, 0xdf, 0x1a, 0x28, 0x8d, 0x39, 0x38, 0xe3, 0xdd,
+	0xb7, 0x07, 0x0a, 0x23, 0xc9, 0xaa, 0x88, 0xd7, 0x4f, 0x3d, 0xab, 0x16, 0x27, 0x20, 0x87, 0x70,
+	0x3e, 0x09, 0xce, 0xb1, 0x74, 0x39, 0x98, 0xc1, 0x72, 0x9a, 0x73, 0x38, 0x07, 0xd2, 0x9e, 0x7a,
+	0x63, 0xe1, 0xb4, 0xd7, 0x84, 0x57, 0x41, 0x3e, 0xf1, 0xbe, 0xe2, 0x32, 0xa8, 0x0c, 0xce, 0x0d,
+	0x5f, 0x59, 0x1c, 0xbe, 0x0f, 0xce, 0x89, 0x87, 0x1b, 0xd7, 0xa7, 0x64, 0x95, 0x2f, 0x55, 0xe3,
+	0xb8, 0xaa, 0x62, 0x74, 0x54, 0xd5, 0xd3, 0xae, 0xba, 0xc6, 0xbc, 0xc0, 0xca, 0x88, 0x5c, 0x70,
+	0xac, 0xfd, 0xff, 0x7b, 0xa0, 0x34, 0x76, 0x96, 0xc1, 0x05, 0x30, 0x7f, 0xaf, 0xbe, 0x71, 0xeb,
+	0xf6, 0x6e, 0xbd, 0x66, 0x6f, 0xd5, 0x6b, 0x1b, 0xab, 0xdb, 0xc5, 0x14, 0x2c, 0x82, 0xfc, 0x2e,
+	0xde, 0xd8, 0xda, 0x92, 0xbc, 0xd5, 0xed, 0xa2, 0x06, 0xaf, 0x80, 0x8b, 0x1f, 0xdf, 0xdd, 0xdd,
+	0xdc, 0xa8, 0x63, 0xbb, 0xfe, 0xd9, 0xda, 0xe6, 0xdd, 0xda, 0x50, 0x3d, 0x6d, 0xad, 0x3f, 0x79,
+	0x51, 0xd6, 0x9e, 0xbe, 0x28, 0x6b, 0xbf, 0xbf, 0x28, 0x6b, 0x0f, 0x5f, 0x96, 0x53, 0x4f, 0x5f,
+	0x96, 0x53, 0xbf, 0xbd, 0x2c, 0xa7, 0x3e, 0xbf, 0x71, 0x52, 0xa9, 0xd4, 0xa3, 0x57, 0xa2, 0xd9,
+	0x98, 0x96, 0x5d, 0xff, 0xee, 0x3f, 0x03, 0x00, 0x5b, 0x05, 0xa0, 0x7a, 0x0b, 0x0f, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -438,6 +715,132 @@ func (this *Params) Equal(that interface{}) bool {
 	if !this.ValidatorFeeRatio.Equal(that1.ValidatorFeeRatio) {
 		return false
 	}
+	if this.MaxPriceAge != that1.MaxPriceAge {
+		return false
+	}
+	if len(this.PairMaxPriceAges) != len(that1.PairMaxPriceAges) {
+		return false
+	}
+	for i := range this.PairMaxPriceAges {
+		if !this.PairMaxPriceAges[i].Equal(&that1.PairMaxPriceAges[i]) {
+			return false
+		}
+	}
+	if this.PriceSnapshotRetention != that1.PriceSnapshotRetention {
+		return false
+	}
+	if len(this.CrossRateRoutes) != len(that1.CrossRateRoutes) {
+		return false
+	}
+	for i := range this.CrossRateRoutes {
+		if !this.CrossRateRoutes[i].Equal(&that1.CrossRateRoutes[i]) {
+			return false
+		}
+	}
+	if len(this.PairAggregations) != len(that1.PairAggregations) {
+		return false
+	}
+	for i := range this.PairAggregations {
+		if !this.PairAggregations[i].Equal(&that1.PairAggregations[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *PairAggregation) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*PairAggregation)
+	if !ok {
+		that2, ok := that.(PairAggregation)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Pair.Equal(that1.Pair) {
+		return false
+	}
+	if this.Method != that1.Method {
+		return false
+	}
+	if !this.RewardBand.Equal(that1.RewardBand) {
+		return false
+	}
+	if this.MinVoters != that1.MinVoters {
+		return false
+	}
+	if !this.TrimRatio.Equal(that1.TrimRatio) {
+		return false
+	}
+	if !this.MaxStdDevs.Equal(that1.MaxStdDevs) {
+		return false
+	}
+	return true
+}
+func (this *CrossRateRoute) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*CrossRateRoute)
+	if !ok {
+		that2, ok := that.(CrossRateRoute)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Pair.Equal(that1.Pair) {
+		return false
+	}
+	if !this.BaseLeg.Equal(that1.BaseLeg) {
+		return false
+	}
+	if !this.QuoteLeg.Equal(that1.QuoteLeg) {
+		return false
+	}
+	return true
+}
+func (this *PairMaxPriceAge) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*PairMaxPriceAge)
+	if !ok {
+		that2, ok := that.(PairMaxPriceAge)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Pair.Equal(that1.Pair) {
+		return false
+	}
+	if this.MaxAge != that1.MaxAge {
+		return false
+	}
 	return true
 }
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -460,34 +863,92 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	{
-		size := m.ValidatorFeeRatio.Size()
-		i -= size
-		if _, err := m.ValidatorFeeRatio.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	if len(m.PairAggregations) > 0 {
+		for iNdEx := len(m.PairAggregations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PairAggregations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintOracle(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x7a
 		}
-		i = encodeVarintOracle(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0x52
-	if m.MinVoters != 0 {
-		i = encodeVarintOracle(dAtA, i, uint64(m.MinVoters))
-		i--
-		dAtA[i] = 0x48
+	if len(m.CrossRateRoutes) > 0 {
+		for iNdEx := len(m.CrossRateRoutes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CrossRateRoutes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintOracle(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
 	}
-	n1, err1 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.TwapLookbackWindow, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.TwapLookbackWindow):])
+	n1, err1 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.PriceSnapshotRetention, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.PriceSnapshotRetention):])
 	if err1 != nil {
 		return 0, err1
 	}
 	i -= n1
 	i = encodeVarintOracle(dAtA, i, uint64(n1))
 	i--
-	dAtA[i] = 0x42
-	{
-		size := m.MinValidPerWindow.Size()
-		i -= size
-		if _, err := m.MinValidPerWindow.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	dAtA[i] = 0x6a
+	if len(m.PairMaxPriceAges) > 0 {
+		for iNdEx := len(m.PairMaxPriceAges) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PairMaxPriceAges[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintOracle(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	n2, err2 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.MaxPriceAge, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.MaxPriceAge):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintOracle(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x5a
+	{
+		size := m.ValidatorFeeRatio.Size()
+		i -= size
+		if _, err := m.ValidatorFeeRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	if m.MinVoters != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.MinVoters))
+		i--
+		dAtA[i] = 0x48
+	}
+	n3, err3 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.TwapLookbackWindow, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.TwapLookbackWindow):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintOracle(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.MinValidPerWindow.Size()
+		i -= size
+		if _, err := m.MinValidPerWindow.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
 		i = encodeVarintOracle(dAtA, i, uint64(size))
 	}
@@ -550,6 +1011,173 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *PairAggregation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PairAggregation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PairAggregation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MaxStdDevs.Size()
+		i -= size
+		if _, err := m.MaxStdDevs.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.TrimRatio.Size()
+		i -= size
+		if _, err := m.TrimRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.MinVoters != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.MinVoters))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.RewardBand.Size()
+		i -= size
+		if _, err := m.RewardBand.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.Method != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.Method))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size := m.Pair.Size()
+		i -= size
+		if _, err := m.Pair.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *CrossRateRoute) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CrossRateRoute) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CrossRateRoute) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.QuoteLeg.Size()
+		i -= size
+		if _, err := m.QuoteLeg.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.BaseLeg.Size()
+		i -= size
+		if _, err := m.BaseLeg.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.Pair.Size()
+		i -= size
+		if _, err := m.Pair.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *PairMaxPriceAge) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PairMaxPriceAge) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PairMaxPriceAge) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n4, err4 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.MaxAge, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.MaxAge):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintOracle(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.Pair.Size()
+		i -= size
+		if _, err := m.Pair.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *AggregateExchangeRatePrevote) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -770,6 +1398,79 @@ func (m *Params) Size() (n int) {
 	}
 	l = m.ValidatorFeeRatio.Size()
 	n += 1 + l + sovOracle(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.MaxPriceAge)
+	n += 1 + l + sovOracle(uint64(l))
+	if len(m.PairMaxPriceAges) > 0 {
+		for _, e := range m.PairMaxPriceAges {
+			l = e.Size()
+			n += 1 + l + sovOracle(uint64(l))
+		}
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.PriceSnapshotRetention)
+	n += 1 + l + sovOracle(uint64(l))
+	if len(m.CrossRateRoutes) > 0 {
+		for _, e := range m.CrossRateRoutes {
+			l = e.Size()
+			n += 1 + l + sovOracle(uint64(l))
+		}
+	}
+	if len(m.PairAggregations) > 0 {
+		for _, e := range m.PairAggregations {
+			l = e.Size()
+			n += 1 + l + sovOracle(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PairAggregation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Pair.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	if m.Method != 0 {
+		n += 1 + sovOracle(uint64(m.Method))
+	}
+	l = m.RewardBand.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	if m.MinVoters != 0 {
+		n += 1 + sovOracle(uint64(m.MinVoters))
+	}
+	l = m.TrimRatio.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	l = m.MaxStdDevs.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	return n
+}
+
+func (m *CrossRateRoute) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Pair.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	l = m.BaseLeg.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	l = m.QuoteLeg.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	return n
+}
+
+func (m *PairMaxPriceAge) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Pair.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.MaxAge)
+	n += 1 + l + sovOracle(uint64(l))
 	return n
 }
 
@@ -1177,6 +1878,667 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPriceAge", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.MaxPriceAge, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PairMaxPriceAges", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PairMaxPriceAges = append(m.PairMaxPriceAges, PairMaxPriceAge{})
+			if err := m.PairMaxPriceAges[len(m.PairMaxPriceAges)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceSnapshotRetention", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.PriceSnapshotRetention, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CrossRateRoutes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CrossRateRoutes = append(m.CrossRateRoutes, CrossRateRoute{})
+			if err := m.CrossRateRoutes[len(m.CrossRateRoutes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PairAggregations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PairAggregations = append(m.PairAggregations, PairAggregation{})
+			if err := m.PairAggregations[len(m.PairAggregations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PairAggregation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PairAggregation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PairAggregation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pair", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Pair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Method", wireType)
+			}
+			m.Method = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Method |= AggregationMethod(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardBand", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RewardBand.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinVoters", wireType)
+			}
+			m.MinVoters = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinVoters |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TrimRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TrimRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxStdDevs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxStdDevs.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CrossRateRoute) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CrossRateRoute: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CrossRateRoute: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pair", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Pair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseLeg", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BaseLeg.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuoteLeg", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.QuoteLeg.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PairMaxPriceAge) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PairMaxPriceAge: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PairMaxPriceAge: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pair", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Pair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAge", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.MaxAge, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipOracle(dAtA[iNdEx:])
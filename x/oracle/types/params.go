@@ -15,16 +15,21 @@ import (
 
 // Parameter keys
 var (
-	KeyVotePeriod         = []byte("VotePeriod")
-	KeyVoteThreshold      = []byte("VoteThreshold")
-	KeyMinVoters          = []byte("MinVoters")
-	KeyRewardBand         = []byte("RewardBand")
-	KeyWhitelist          = []byte("Whitelist")
-	KeySlashFraction      = []byte("SlashFraction")
-	KeySlashWindow        = []byte("SlashWindow")
-	KeyMinValidPerWindow  = []byte("MinValidPerWindow")
-	KeyTwapLookbackWindow = []byte("TwapLookbackWindow")
-	KeyValidatorFeeRatio  = []byte("ValidatorFeeRatio")
+	KeyVotePeriod             = []byte("VotePeriod")
+	KeyVoteThreshold          = []byte("VoteThreshold")
+	KeyMinVoters              = []byte("MinVoters")
+	KeyRewardBand             = []byte("RewardBand")
+	KeyWhitelist              = []byte("Whitelist")
+	KeySlashFraction          = []byte("SlashFraction")
+	KeySlashWindow            = []byte("SlashWindow")
+	KeyMinValidPerWindow      = []byte("MinValidPerWindow")
+	KeyTwapLookbackWindow     = []byte("TwapLookbackWindow")
+	KeyValidatorFeeRatio      = []byte("ValidatorFeeRatio")
+	KeyMaxPriceAge            = []byte("MaxPriceAge")
+	KeyPairMaxPriceAges       = []byte("PairMaxPriceAges")
+	KeyPriceSnapshotRetention = []byte("PriceSnapshotRetention")
+	KeyCrossRateRoutes        = []byte("CrossRateRoutes")
+	KeyPairAggregations       = []byte("PairAggregations")
 )
 
 // Default parameter values
@@ -69,10 +74,12 @@ var (
 		// asset.Registry.Pair(denoms.SOL, denoms.USD),
 		// asset.Registry.Pair(denoms.ADA, denoms.USD),
 	}
-	DefaultSlashFraction      = sdk.NewDecWithPrec(1, 4)        // 0.01%
-	DefaultMinValidPerWindow  = sdk.NewDecWithPrec(5, 2)        // 5%
-	DefaultTwapLookbackWindow = time.Duration(15 * time.Minute) // 15 minutes
-	DefaultValidatorFeeRatio  = sdk.MustNewDecFromStr("0.05")   // 1%
+	DefaultSlashFraction          = sdk.NewDecWithPrec(1, 4)        // 0.01%
+	DefaultMinValidPerWindow      = sdk.NewDecWithPrec(5, 2)        // 5%
+	DefaultTwapLookbackWindow     = time.Duration(15 * time.Minute) // 15 minutes
+	DefaultValidatorFeeRatio      = sdk.MustNewDecFromStr("0.05")   // 1%
+	DefaultMaxPriceAge            = time.Duration(5 * time.Minute)  // 5 minutes
+	DefaultPriceSnapshotRetention = time.Duration(24 * time.Hour)   // 1 day
 )
 
 var _ paramstypes.ParamSet = &Params{}
@@ -80,16 +87,21 @@ var _ paramstypes.ParamSet = &Params{}
 // DefaultParams creates default oracle module parameters
 func DefaultParams() Params {
 	return Params{
-		VotePeriod:         DefaultVotePeriod,
-		VoteThreshold:      DefaultVoteThreshold,
-		MinVoters:          DefaultMinVoters,
-		RewardBand:         DefaultRewardBand,
-		Whitelist:          DefaultWhitelist,
-		SlashFraction:      DefaultSlashFraction,
-		SlashWindow:        DefaultSlashWindow,
-		MinValidPerWindow:  DefaultMinValidPerWindow,
-		TwapLookbackWindow: DefaultTwapLookbackWindow,
-		ValidatorFeeRatio:  DefaultValidatorFeeRatio,
+		VotePeriod:             DefaultVotePeriod,
+		VoteThreshold:          DefaultVoteThreshold,
+		MinVoters:              DefaultMinVoters,
+		RewardBand:             DefaultRewardBand,
+		Whitelist:              DefaultWhitelist,
+		SlashFraction:          DefaultSlashFraction,
+		SlashWindow:            DefaultSlashWindow,
+		MinValidPerWindow:      DefaultMinValidPerWindow,
+		TwapLookbackWindow:     DefaultTwapLookbackWindow,
+		ValidatorFeeRatio:      DefaultValidatorFeeRatio,
+		MaxPriceAge:            DefaultMaxPriceAge,
+		PairMaxPriceAges:       []PairMaxPriceAge{},
+		PriceSnapshotRetention: DefaultPriceSnapshotRetention,
+		CrossRateRoutes:        []CrossRateRoute{},
+		PairAggregations:       []PairAggregation{},
 	}
 }
 
@@ -112,6 +124,11 @@ func (p *Params) ParamSetPairs() paramstypes.ParamSetPairs {
 		paramstypes.NewParamSetPair(KeyMinValidPerWindow, &p.MinValidPerWindow, validateMinValidPerWindow),
 		paramstypes.NewParamSetPair(KeyTwapLookbackWindow, &p.TwapLookbackWindow, validateTwapLookbackWindow),
 		paramstypes.NewParamSetPair(KeyValidatorFeeRatio, &p.ValidatorFeeRatio, validateValidatorFeeRatio),
+		paramstypes.NewParamSetPair(KeyMaxPriceAge, &p.MaxPriceAge, validateMaxPriceAge),
+		paramstypes.NewParamSetPair(KeyPairMaxPriceAges, &p.PairMaxPriceAges, validatePairMaxPriceAges),
+		paramstypes.NewParamSetPair(KeyPriceSnapshotRetention, &p.PriceSnapshotRetention, validatePriceSnapshotRetention),
+		paramstypes.NewParamSetPair(KeyCrossRateRoutes, &p.CrossRateRoutes, validateCrossRateRoutes),
+		paramstypes.NewParamSetPair(KeyPairAggregations, &p.PairAggregations, validatePairAggregations),
 	}
 }
 
@@ -160,9 +177,87 @@ func (p Params) Validate() error {
 			return fmt.Errorf("oracle parameter Whitelist Pair invalid format: %w", err)
 		}
 	}
+
+	if err := validateMaxPriceAge(p.MaxPriceAge); err != nil {
+		return err
+	}
+
+	if err := validatePairMaxPriceAges(p.PairMaxPriceAges); err != nil {
+		return err
+	}
+
+	if err := validatePriceSnapshotRetention(p.PriceSnapshotRetention); err != nil {
+		return err
+	}
+
+	if p.PriceSnapshotRetention != 0 && p.PriceSnapshotRetention < p.TwapLookbackWindow {
+		return fmt.Errorf("oracle parameter PriceSnapshotRetention must be zero or at least TwapLookbackWindow: %s < %s",
+			p.PriceSnapshotRetention, p.TwapLookbackWindow)
+	}
+
+	if err := validateCrossRateRoutes(p.CrossRateRoutes); err != nil {
+		return err
+	}
+
+	if err := validatePairAggregations(p.PairAggregations); err != nil {
+		return err
+	}
+
+	// the derived pairs are not voted and their legs are
+	whitelist := asset.Pairs(p.Whitelist)
+	for _, route := range p.CrossRateRoutes {
+		if whitelist.Contains(route.Pair) {
+			return fmt.Errorf("oracle parameter CrossRateRoutes pair %s must not be whitelisted", route.Pair)
+		}
+		if !whitelist.Contains(route.BaseLeg) || !whitelist.Contains(route.QuoteLeg) {
+			return fmt.Errorf("oracle parameter CrossRateRoutes legs %s and %s of %s must be whitelisted",
+				route.BaseLeg, route.QuoteLeg, route.Pair)
+		}
+	}
+
 	return nil
 }
 
+// MaxPriceAgeOf returns the maximum age of the exchange rate of the pair: its
+// entry in PairMaxPriceAges if any, MaxPriceAge otherwise. Zero means that the
+// age of the exchange rate is not checked.
+func (p Params) MaxPriceAgeOf(pair asset.Pair) time.Duration {
+	for _, pairMaxAge := range p.PairMaxPriceAges {
+		if pairMaxAge.Pair.Equal(pair) {
+			return pairMaxAge.MaxAge
+		}
+	}
+	return p.MaxPriceAge
+}
+
+// CrossRateRouteOf returns the cross rate route deriving the exchange rate of
+// the pair, if any.
+func (p Params) CrossRateRouteOf(pair asset.Pair) (CrossRateRoute, bool) {
+	for _, route := range p.CrossRateRoutes {
+		if route.Pair.Equal(pair) {
+			return route, true
+		}
+	}
+	return CrossRateRoute{}, false
+}
+
+// AggregationOf returns how the ballots of the pair are aggregated: its entry
+// in PairAggregations if any, the weighted median with RewardBand and
+// MinVoters otherwise.
+func (p Params) AggregationOf(pair asset.Pair) PairAggregation {
+	for _, aggregation := range p.PairAggregations {
+		if aggregation.Pair.Equal(pair) {
+			return aggregation
+		}
+	}
+	return PairAggregation{
+		Pair:       pair,
+		Method:     AggregationMethod_WEIGHTED_MEDIAN,
+		RewardBand: p.RewardBand,
+		MinVoters:  p.MinVoters,
+	}
+}
+
 func validateVotePeriod(i interface{}) error {
 	v, ok := i.(uint64)
 	if !ok {
@@ -312,3 +407,115 @@ func validateValidatorFeeRatio(i interface{}) error {
 
 	return nil
 }
+
+func validateMaxPriceAge(i interface{}) error {
+	v, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v < 0 {
+		return fmt.Errorf("max price age should not be negative: %s", v)
+	}
+	return nil
+}
+
+func validatePairMaxPriceAges(i interface{}) error {
+	v, ok := i.([]PairMaxPriceAge)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	seen := make(map[asset.Pair]struct{}, len(v))
+	for _, pairMaxAge := range v {
+		if err := pairMaxAge.Pair.Validate(); err != nil {
+			return fmt.Errorf("oracle parameter PairMaxPriceAges Pair invalid format: %w", err)
+		}
+		if _, ok := seen[pairMaxAge.Pair]; ok {
+			return fmt.Errorf("duplicate max price age for pair %s", pairMaxAge.Pair)
+		}
+		seen[pairMaxAge.Pair] = struct{}{}
+		if pairMaxAge.MaxAge < 0 {
+			return fmt.Errorf("max price age of %s should not be negative: %s", pairMaxAge.Pair, pairMaxAge.MaxAge)
+		}
+	}
+	return nil
+}
+
+func validatePriceSnapshotRetention(i interface{}) error {
+	v, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v < 0 {
+		return fmt.Errorf("price snapshot retention should not be negative: %s", v)
+	}
+	return nil
+}
+
+func validateCrossRateRoutes(i interface{}) error {
+	v, ok := i.([]CrossRateRoute)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	seen := make(map[asset.Pair]struct{}, len(v))
+	for _, route := range v {
+		for _, pair := range []asset.Pair{route.Pair, route.BaseLeg, route.QuoteLeg} {
+			if err := pair.Validate(); err != nil {
+				return fmt.Errorf("oracle parameter CrossRateRoutes Pair invalid format: %w", err)
+			}
+		}
+		if _, ok := seen[route.Pair]; ok {
+			return fmt.Errorf("duplicate cross rate route for pair %s", route.Pair)
+		}
+		seen[route.Pair] = struct{}{}
+
+		if route.BaseLeg.BaseDenom() != route.Pair.BaseDenom() ||
+			route.QuoteLeg.BaseDenom() != route.Pair.QuoteDenom() ||
+			route.BaseLeg.QuoteDenom() != route.QuoteLeg.QuoteDenom() {
+			return fmt.Errorf("cross rate route of %s must go through %s:x and %s:x, not %s and %s",
+				route.Pair, route.Pair.BaseDenom(), route.Pair.QuoteDenom(), route.BaseLeg, route.QuoteLeg)
+		}
+	}
+	return nil
+}
+
+func validatePairAggregations(i interface{}) error {
+	v, ok := i.([]PairAggregation)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	seen := make(map[asset.Pair]struct{}, len(v))
+	for _, aggregation := range v {
+		if err := aggregation.Pair.Validate(); err != nil {
+			return fmt.Errorf("oracle parameter PairAggregations Pair invalid format: %w", err)
+		}
+		if _, ok := seen[aggregation.Pair]; ok {
+			return fmt.Errorf("duplicate aggregation for pair %s", aggregation.Pair)
+		}
+		seen[aggregation.Pair] = struct{}{}
+
+		if aggregation.RewardBand.IsNil() || aggregation.RewardBand.IsNegative() || aggregation.RewardBand.GT(sdk.OneDec()) {
+			return fmt.Errorf("reward band of %s must be between [0, 1]: %s", aggregation.Pair, aggregation.RewardBand)
+		}
+		if aggregation.MinVoters == 0 {
+			return fmt.Errorf("min voters of %s must be positive", aggregation.Pair)
+		}
+
+		switch aggregation.Method {
+		case AggregationMethod_WEIGHTED_MEDIAN:
+		case AggregationMethod_TRIMMED_MEAN:
+			if aggregation.TrimRatio.IsNil() || aggregation.TrimRatio.IsNegative() || aggregation.TrimRatio.GTE(sdk.NewDecWithPrec(5, 1)) {
+				return fmt.Errorf("trim ratio of %s must be between [0, 0.5): %s", aggregation.Pair, aggregation.TrimRatio)
+			}
+		case AggregationMethod_OUTLIER_EXCLUDED_MEDIAN:
+			if aggregation.MaxStdDevs.IsNil() || !aggregation.MaxStdDevs.IsPositive() {
+				return fmt.Errorf("max std devs of %s must be positive: %s", aggregation.Pair, aggregation.MaxStdDevs)
+			}
+		default:
+			return fmt.Errorf("unknown aggregation method of %s: %s", aggregation.Pair, aggregation.Method)
+		}
+	}
+	return nil
+}
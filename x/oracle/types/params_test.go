@@ -3,6 +3,7 @@ package types_test
 import (
 	"bytes"
 	"testing"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
@@ -55,12 +56,46 @@ func TestParamsEqual(t *testing.T) {
 	err = p6.Validate()
 	require.Error(t, err)
 
+	// price snapshot retention shorter than the twap lookback window
+	p8 := types.DefaultParams()
+	p8.PriceSnapshotRetention = p8.TwapLookbackWindow - time.Second
+	err = p8.Validate()
+	require.Error(t, err)
+
+	p8.PriceSnapshotRetention = 0
+	err = p8.Validate()
+	require.NoError(t, err)
+
+	// cross rate route through whitelisted legs
+	p9 := types.DefaultParams()
+	p9.CrossRateRoutes = []types.CrossRateRoute{
+		{Pair: "ubtc:unibi", BaseLeg: "ubtc:unusd", QuoteLeg: "unibi:unusd"},
+	}
+	err = p9.Validate()
+	require.NoError(t, err)
+
+	// whitelisted derived pair
+	p9.CrossRateRoutes[0] = types.CrossRateRoute{Pair: "ubtc:unusd", BaseLeg: "ubtc:uusd", QuoteLeg: "unusd:uusd"}
+	err = p9.Validate()
+	require.Error(t, err)
+
+	// leg not whitelisted
+	p9.CrossRateRoutes[0] = types.CrossRateRoute{Pair: "ubtc:unibi", BaseLeg: "ubtc:uosmo", QuoteLeg: "unibi:uosmo"}
+	err = p9.Validate()
+	require.Error(t, err)
+
 	// empty name
 	p10 := types.DefaultParams()
 	p10.Whitelist[0] = ""
 	err = p10.Validate()
 	require.Error(t, err)
 
+	// negative max price age
+	p7 := types.DefaultParams()
+	p7.MaxPriceAge = -time.Second
+	err = p7.Validate()
+	require.Error(t, err)
+
 	p11 := types.DefaultParams()
 	require.NotNil(t, p11.ParamSetPairs())
 	require.NotNil(t, p11.String())
@@ -93,6 +128,62 @@ func TestValidate(t *testing.T) {
 			require.NoError(t, pair.ValidatorFn([]asset.Pair{"BTC:USDT"}))
 			require.Error(t, pair.ValidatorFn("invalid"))
 			require.Error(t, pair.ValidatorFn([]asset.Pair{""}))
+		case bytes.Equal(types.KeyMaxPriceAge, pair.Key):
+			require.NoError(t, pair.ValidatorFn(time.Minute))
+			require.NoError(t, pair.ValidatorFn(time.Duration(0)))
+			require.Error(t, pair.ValidatorFn("invalid"))
+			require.Error(t, pair.ValidatorFn(-time.Minute))
+		case bytes.Equal(types.KeyPairMaxPriceAges, pair.Key):
+			require.NoError(t, pair.ValidatorFn([]types.PairMaxPriceAge{{Pair: "BTC:USDT", MaxAge: time.Minute}}))
+			require.Error(t, pair.ValidatorFn("invalid"))
+			require.Error(t, pair.ValidatorFn([]types.PairMaxPriceAge{{Pair: "", MaxAge: time.Minute}}))
+			require.Error(t, pair.ValidatorFn([]types.PairMaxPriceAge{{Pair: "BTC:USDT", MaxAge: -time.Minute}}))
+			require.Error(t, pair.ValidatorFn([]types.PairMaxPriceAge{
+				{Pair: "BTC:USDT", MaxAge: time.Minute},
+				{Pair: "BTC:USDT", MaxAge: time.Second},
+			}))
+		case bytes.Equal(types.KeyCrossRateRoutes, pair.Key):
+			require.NoError(t, pair.ValidatorFn([]types.CrossRateRoute{{Pair: "BTC:NIBI", BaseLeg: "BTC:USD", QuoteLeg: "NIBI:USD"}}))
+			require.Error(t, pair.ValidatorFn("invalid"))
+			require.Error(t, pair.ValidatorFn([]types.CrossRateRoute{{Pair: "", BaseLeg: "BTC:USD", QuoteLeg: "NIBI:USD"}}))
+			require.Error(t, pair.ValidatorFn([]types.CrossRateRoute{{Pair: "BTC:NIBI", BaseLeg: "ETH:USD", QuoteLeg: "NIBI:USD"}}))
+			require.Error(t, pair.ValidatorFn([]types.CrossRateRoute{{Pair: "BTC:NIBI", BaseLeg: "BTC:USD", QuoteLeg: "NIBI:USDT"}}))
+			require.Error(t, pair.ValidatorFn([]types.CrossRateRoute{
+				{Pair: "BTC:NIBI", BaseLeg: "BTC:USD", QuoteLeg: "NIBI:USD"},
+				{Pair: "BTC:NIBI", BaseLeg: "BTC:USDT", QuoteLeg: "NIBI:USDT"},
+			}))
+		case bytes.Equal(types.KeyPairAggregations, pair.Key):
+			valid := types.PairAggregation{
+				Pair:       "BTC:USD",
+				Method:     types.AggregationMethod_TRIMMED_MEAN,
+				RewardBand: sdk.NewDecWithPrec(2, 2),
+				MinVoters:  3,
+				TrimRatio:  sdk.NewDecWithPrec(2, 1),
+			}
+			require.NoError(t, pair.ValidatorFn([]types.PairAggregation{valid}))
+			require.Error(t, pair.ValidatorFn("invalid"))
+			require.Error(t, pair.ValidatorFn([]types.PairAggregation{valid, valid}))
+
+			for _, modifier := range []func(aggregation *types.PairAggregation){
+				func(aggregation *types.PairAggregation) { aggregation.Pair = "" },
+				func(aggregation *types.PairAggregation) { aggregation.RewardBand = sdk.NewDec(2) },
+				func(aggregation *types.PairAggregation) { aggregation.MinVoters = 0 },
+				func(aggregation *types.PairAggregation) { aggregation.TrimRatio = sdk.NewDecWithPrec(5, 1) },
+				func(aggregation *types.PairAggregation) { aggregation.Method = types.AggregationMethod(42) },
+				func(aggregation *types.PairAggregation) {
+					aggregation.Method = types.AggregationMethod_OUTLIER_EXCLUDED_MEDIAN
+					aggregation.MaxStdDevs = sdk.ZeroDec()
+				},
+			} {
+				invalid := valid
+				modifier(&invalid)
+				require.Error(t, pair.ValidatorFn([]types.PairAggregation{invalid}))
+			}
+		case bytes.Equal(types.KeyPriceSnapshotRetention, pair.Key):
+			require.NoError(t, pair.ValidatorFn(time.Hour))
+			require.NoError(t, pair.ValidatorFn(time.Duration(0)))
+			require.Error(t, pair.ValidatorFn("invalid"))
+			require.Error(t, pair.ValidatorFn(-time.Hour))
 		}
 	}
 }
@@ -77,6 +77,15 @@ var xxx_messageInfo_QueryExchangeRateRequest proto.InternalMessageInfo
 type QueryExchangeRateResponse struct {
 	// exchange_rate defines the exchange rate of assets voted by validators
 	ExchangeRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=exchange_rate,json=exchangeRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"exchange_rate"`
+	// block_height is the height of the block at which the exchange rate was
+	// set. Only set by Query/ExchangeRate.
+	BlockHeight int64 `protobuf:"varint,2,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	// block_timestamp_ms is the time of the block at which the exchange rate was
+	// set, in milliseconds since unix epoch. Only set by Query/ExchangeRate.
+	BlockTimestampMs int64 `protobuf:"varint,3,opt,name=block_timestamp_ms,json=blockTimestampMs,proto3" json:"block_timestamp_ms,omitempty"`
+	// derived is true when the exchange rate was derived from a cross rate
+	// route rather than voted. Only set by Query/ExchangeRate.
+	Derived bool `protobuf:"varint,4,opt,name=derived,proto3" json:"derived,omitempty"`
 }
 
 func (m *QueryExchangeRateResponse) Reset()         { *m = QueryExchangeRateResponse{} }
@@ -112,6 +121,27 @@ func (m *QueryExchangeRateResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryExchangeRateResponse proto.InternalMessageInfo
 
+func (m *QueryExchangeRateResponse) GetBlockHeight() int64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+func (m *QueryExchangeRateResponse) GetBlockTimestampMs() int64 {
+	if m != nil {
+		return m.BlockTimestampMs
+	}
+	return 0
+}
+
+func (m *QueryExchangeRateResponse) GetDerived() bool {
+	if m != nil {
+		return m.Derived
+	}
+	return false
+}
+
 // QueryExchangeRatesRequest is the request type for the Query/ExchangeRates RPC
 // method.
 type QueryExchangeRatesRequest struct {
@@ -198,6 +228,178 @@ func (m *QueryExchangeRatesResponse) GetExchangeRates() ExchangeRateTuples {
 	return nil
 }
 
+// QueryExchangeRateStatsRequest is the request type for the
+// Query/ExchangeRateStats RPC method.
+type QueryExchangeRateStatsRequest struct {
+	// pair defines the pair to query for.
+	Pair github_com_NibiruChain_nibiru_x_common_asset.Pair `protobuf:"bytes,1,opt,name=pair,proto3,customtype=github.com/NibiruChain/nibiru/x/common/asset.Pair" json:"pair"`
+}
+
+func (m *QueryExchangeRateStatsRequest) Reset()         { *m = QueryExchangeRateStatsRequest{} }
+func (m *QueryExchangeRateStatsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryExchangeRateStatsRequest) ProtoMessage()    {}
+func (*QueryExchangeRateStatsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_34238c8dfdfcd7ec, []int{4}
+}
+func (m *QueryExchangeRateStatsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryExchangeRateStatsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryExchangeRateStatsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryExchangeRateStatsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryExchangeRateStatsRequest.Merge(m, src)
+}
+func (m *QueryExchangeRateStatsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryExchangeRateStatsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryExchangeRateStatsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryExchangeRateStatsRequest proto.InternalMessageInfo
+
+// QueryExchangeRateStatsResponse is response type for the
+// Query/ExchangeRateStats RPC method.
+type QueryExchangeRateStatsResponse struct {
+	Stats ExchangeRateStats `protobuf:"bytes,1,opt,name=stats,proto3" json:"stats"`
+}
+
+func (m *QueryExchangeRateStatsResponse) Reset()         { *m = QueryExchangeRateStatsResponse{} }
+func (m *QueryExchangeRateStatsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryExchangeRateStatsResponse) ProtoMessage()    {}
+func (*QueryExchangeRateStatsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_34238c8dfdfcd7ec, []int{5}
+}
+func (m *QueryExchangeRateStatsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryExchangeRateStatsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryExchangeRateStatsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryExchangeRateStatsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryExchangeRateStatsResponse.Merge(m, src)
+}
+func (m *QueryExchangeRateStatsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryExchangeRateStatsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryExchangeRateStatsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryExchangeRateStatsResponse proto.InternalMessageInfo
+
+func (m *QueryExchangeRateStatsResponse) GetStats() ExchangeRateStats {
+	if m != nil {
+		return m.Stats
+	}
+	return ExchangeRateStats{}
+}
+
+// QueryDerivedExchangeRatesRequest is the request type for the
+// Query/DerivedExchangeRates RPC method.
+type QueryDerivedExchangeRatesRequest struct {
+}
+
+func (m *QueryDerivedExchangeRatesRequest) Reset()         { *m = QueryDerivedExchangeRatesRequest{} }
+func (m *QueryDerivedExchangeRatesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDerivedExchangeRatesRequest) ProtoMessage()    {}
+func (*QueryDerivedExchangeRatesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_34238c8dfdfcd7ec, []int{6}
+}
+func (m *QueryDerivedExchangeRatesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDerivedExchangeRatesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDerivedExchangeRatesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDerivedExchangeRatesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDerivedExchangeRatesRequest.Merge(m, src)
+}
+func (m *QueryDerivedExchangeRatesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDerivedExchangeRatesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDerivedExchangeRatesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDerivedExchangeRatesRequest proto.InternalMessageInfo
+
+// QueryDerivedExchangeRatesResponse is response type for the
+// Query/DerivedExchangeRates RPC method.
+type QueryDerivedExchangeRatesResponse struct {
+	// exchange_rates defines a list of the exchange rate of the pairs derived
+	// from cross rate routes.
+	ExchangeRates ExchangeRateTuples `protobuf:"bytes,1,rep,name=exchange_rates,json=exchangeRates,proto3,castrepeated=ExchangeRateTuples" json:"exchange_rates"`
+}
+
+func (m *QueryDerivedExchangeRatesResponse) Reset()         { *m = QueryDerivedExchangeRatesResponse{} }
+func (m *QueryDerivedExchangeRatesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDerivedExchangeRatesResponse) ProtoMessage()    {}
+func (*QueryDerivedExchangeRatesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_34238c8dfdfcd7ec, []int{7}
+}
+func (m *QueryDerivedExchangeRatesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDerivedExchangeRatesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDerivedExchangeRatesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDerivedExchangeRatesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDerivedExchangeRatesResponse.Merge(m, src)
+}
+func (m *QueryDerivedExchangeRatesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDerivedExchangeRatesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDerivedExchangeRatesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDerivedExchangeRatesResponse proto.InternalMessageInfo
+
+func (m *QueryDerivedExchangeRatesResponse) GetExchangeRates() ExchangeRateTuples {
+	if m != nil {
+		return m.ExchangeRates
+	}
+	return nil
+}
+
 // QueryActivesRequest is the request type for the Query/Actives RPC method.
 type QueryActivesRequest struct {
 }
@@ -206,7 +408,7 @@ func (m *QueryActivesRequest) Reset()         { *m = QueryActivesRequest{} }
 func (m *QueryActivesRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryActivesRequest) ProtoMessage()    {}
 func (*QueryActivesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_34238c8dfdfcd7ec, []int{4}
+	return fileDescriptor_34238c8dfdfcd7ec, []int{8}
 }
 func (m *QueryActivesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -246,7 +448,7 @@ func (m *QueryActivesResponse) Reset()         { *m = QueryActivesResponse{} }
 func (m *QueryActivesResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryActivesResponse) ProtoMessage()    {}
 func (*QueryActivesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_34238c8dfdfcd7ec, []int{5}
+	return fileDescriptor_34238c8dfdfcd7ec, []int{9}
 }
 func (m *QueryActivesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -284,7 +486,7 @@ func (m *QueryVoteTargetsRequest) Reset()         { *m = QueryVoteTargetsRequest
 func (m *QueryVoteTargetsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryVoteTargetsRequest) ProtoMessage()    {}
 func (*QueryVoteTargetsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_34238c8dfdfcd7ec, []int{6}
+	return fileDescriptor_34238c8dfdfcd7ec, []int{10}
 }
 func (m *QueryVoteTargetsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -325,7 +527,7 @@ func (m *QueryVoteTargetsResponse) Reset()         { *m = QueryVoteTargetsRespon
 func (m *QueryVoteTargetsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryVoteTargetsResponse) ProtoMessage()    {}
 func (*QueryVoteTargetsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_34238c8dfdfcd7ec, []int{7}
+	return fileDescriptor_34238c8dfdfcd7ec, []int{11}
 }
 func (m *QueryVoteTargetsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -365,7 +567,7 @@ func (m *QueryFeederDelegationRequest) Reset()         { *m = QueryFeederDelegat
 func (m *QueryFeederDelegationRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryFeederDelegationRequest) ProtoMessage()    {}
 func (*QueryFeederDelegationRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_34238c8dfdfcd7ec, []int{8}
+	return fileDescriptor_34238c8dfdfcd7ec, []int{12}
 }
 func (m *QueryFeederDelegationRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -405,7 +607,7 @@ func (m *QueryFeederDelegationResponse) Reset()         { *m = QueryFeederDelega
 func (m *QueryFeederDelegationResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryFeederDelegationResponse) ProtoMessage()    {}
 func (*QueryFeederDelegationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_34238c8dfdfcd7ec, []int{9}
+	return fileDescriptor_34238c8dfdfcd7ec, []int{13}
 }
 func (m *QueryFeederDelegationResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -452,7 +654,7 @@ func (m *QueryMissCounterRequest) Reset()         { *m = QueryMissCounterRequest
 func (m *QueryMissCounterRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryMissCounterRequest) ProtoMessage()    {}
 func (*QueryMissCounterRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_34238c8dfdfcd7ec, []int{10}
+	return fileDescriptor_34238c8dfdfcd7ec, []int{14}
 }
 func (m *QueryMissCounterRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -492,7 +694,7 @@ func (m *QueryMissCounterResponse) Reset()         { *m = QueryMissCounterRespon
 func (m *QueryMissCounterResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryMissCounterResponse) ProtoMessage()    {}
 func (*QueryMissCounterResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_34238c8dfdfcd7ec, []int{11}
+	return fileDescriptor_34238c8dfdfcd7ec, []int{15}
 }
 func (m *QueryMissCounterResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -539,7 +741,7 @@ func (m *QueryAggregatePrevoteRequest) Reset()         { *m = QueryAggregatePrev
 func (m *QueryAggregatePrevoteRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryAggregatePrevoteRequest) ProtoMessage()    {}
 func (*QueryAggregatePrevoteRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_34238c8dfdfcd7ec, []int{12}
+	return fileDescriptor_34238c8dfdfcd7ec, []int{16}
 }
 func (m *QueryAggregatePrevoteRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -580,7 +782,7 @@ func (m *QueryAggregatePrevoteResponse) Reset()         { *m = QueryAggregatePre
 func (m *QueryAggregatePrevoteResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryAggregatePrevoteResponse) ProtoMessage()    {}
 func (*QueryAggregatePrevoteResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_34238c8dfdfcd7ec, []int{13}
+	return fileDescriptor_34238c8dfdfcd7ec, []int{17}
 }
 func (m *QueryAggregatePrevoteResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -625,7 +827,7 @@ func (m *QueryAggregatePrevotesRequest) Reset()         { *m = QueryAggregatePre
 func (m *QueryAggregatePrevotesRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryAggregatePrevotesRequest) ProtoMessage()    {}
 func (*QueryAggregatePrevotesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_34238c8dfdfcd7ec, []int{14}
+	return fileDescriptor_34238c8dfdfcd7ec, []int{18}
 }
 func (m *QueryAggregatePrevotesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -666,7 +868,7 @@ func (m *QueryAggregatePrevotesResponse) Reset()         { *m = QueryAggregatePr
 func (m *QueryAggregatePrevotesResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryAggregatePrevotesResponse) ProtoMessage()    {}
 func (*QueryAggregatePrevotesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_34238c8dfdfcd7ec, []int{15}
+	return fileDescriptor_34238c8dfdfcd7ec, []int{19}
 }
 func (m *QueryAggregatePrevotesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -713,7 +915,7 @@ func (m *QueryAggregateVoteRequest) Reset()         { *m = QueryAggregateVoteReq
 func (m *QueryAggregateVoteRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryAggregateVoteRequest) ProtoMessage()    {}
 func (*QueryAggregateVoteRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_34238c8dfdfcd7ec, []int{16}
+	return fileDescriptor_34238c8dfdfcd7ec, []int{20}
 }
 func (m *QueryAggregateVoteRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -754,7 +956,7 @@ func (m *QueryAggregateVoteResponse) Reset()         { *m = QueryAggregateVoteRe
 func (m *QueryAggregateVoteResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryAggregateVoteResponse) ProtoMessage()    {}
 func (*QueryAggregateVoteResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_34238c8dfdfcd7ec, []int{17}
+	return fileDescriptor_34238c8dfdfcd7ec, []int{21}
 }
 func (m *QueryAggregateVoteResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -799,7 +1001,7 @@ func (m *QueryAggregateVotesRequest) Reset()         { *m = QueryAggregateVotesR
 func (m *QueryAggregateVotesRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryAggregateVotesRequest) ProtoMessage()    {}
 func (*QueryAggregateVotesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_34238c8dfdfcd7ec, []int{18}
+	return fileDescriptor_34238c8dfdfcd7ec, []int{22}
 }
 func (m *QueryAggregateVotesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -840,7 +1042,7 @@ func (m *QueryAggregateVotesResponse) Reset()         { *m = QueryAggregateVotes
 func (m *QueryAggregateVotesResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryAggregateVotesResponse) ProtoMessage()    {}
 func (*QueryAggregateVotesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_34238c8dfdfcd7ec, []int{19}
+	return fileDescriptor_34238c8dfdfcd7ec, []int{23}
 }
 func (m *QueryAggregateVotesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -884,7 +1086,7 @@ func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
 func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryParamsRequest) ProtoMessage()    {}
 func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_34238c8dfdfcd7ec, []int{20}
+	return fileDescriptor_34238c8dfdfcd7ec, []int{24}
 }
 func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -923,7 +1125,7 @@ func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
 func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryParamsResponse) ProtoMessage()    {}
 func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_34238c8dfdfcd7ec, []int{21}
+	return fileDescriptor_34238c8dfdfcd7ec, []int{25}
 }
 func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -964,6 +1166,10 @@ func init() {
 	proto.RegisterType((*QueryExchangeRateResponse)(nil), "nibiru.oracle.v1.QueryExchangeRateResponse")
 	proto.RegisterType((*QueryExchangeRatesRequest)(nil), "nibiru.oracle.v1.QueryExchangeRatesRequest")
 	proto.RegisterType((*QueryExchangeRatesResponse)(nil), "nibiru.oracle.v1.QueryExchangeRatesResponse")
+	proto.RegisterType((*QueryExchangeRateStatsRequest)(nil), "nibiru.oracle.v1.QueryExchangeRateStatsRequest")
+	proto.RegisterType((*QueryExchangeRateStatsResponse)(nil), "nibiru.oracle.v1.QueryExchangeRateStatsResponse")
+	proto.RegisterType((*QueryDerivedExchangeRatesRequest)(nil), "nibiru.oracle.v1.QueryDerivedExchangeRatesRequest")
+	proto.RegisterType((*QueryDerivedExchangeRatesResponse)(nil), "nibiru.oracle.v1.QueryDerivedExchangeRatesResponse")
 	proto.RegisterType((*QueryActivesRequest)(nil), "nibiru.oracle.v1.QueryActivesRequest")
 	proto.RegisterType((*QueryActivesResponse)(nil), "nibiru.oracle.v1.QueryActivesResponse")
 	proto.RegisterType((*QueryVoteTargetsRequest)(nil), "nibiru.oracle.v1.QueryVoteTargetsRequest")
@@ -987,77 +1193,89 @@ func init() {
 func init() { proto.RegisterFile("oracle/v1/query.proto", fileDescriptor_34238c8dfdfcd7ec) }
 
 var fileDescriptor_34238c8dfdfcd7ec = []byte{
-	// 1106 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x97, 0xcf, 0x6f, 0x1b, 0x45,
-	0x14, 0xc7, 0x3d, 0x50, 0x52, 0x18, 0xc7, 0xae, 0x33, 0xa4, 0x90, 0x6e, 0x53, 0xbb, 0x2c, 0x4d,
-	0xd4, 0x36, 0xc9, 0x2e, 0x4e, 0x50, 0x51, 0xf8, 0x21, 0x70, 0x12, 0x22, 0x81, 0x1a, 0x08, 0xa6,
-	0x8a, 0x50, 0x85, 0x64, 0x8d, 0xd7, 0xd3, 0xcd, 0xaa, 0xf6, 0xce, 0x76, 0x67, 0x6d, 0x12, 0x01,
-	0x97, 0x0a, 0x10, 0x47, 0x24, 0x84, 0xb8, 0x41, 0x2f, 0x48, 0x88, 0x33, 0x70, 0x87, 0x53, 0x8f,
-	0x95, 0xb8, 0x20, 0x0e, 0x05, 0x25, 0x1c, 0xf8, 0x33, 0xd0, 0xce, 0x8c, 0xd7, 0xbb, 0x5e, 0x8f,
-	0xbc, 0x38, 0xe2, 0x94, 0x68, 0xde, 0xdb, 0xf7, 0x3e, 0xef, 0x3b, 0xb3, 0xf3, 0xf5, 0xc2, 0xb3,
-	0xd4, 0xc7, 0x56, 0x9b, 0x98, 0xbd, 0xaa, 0x79, 0xa7, 0x4b, 0xfc, 0x43, 0xc3, 0xf3, 0x69, 0x40,
-	0x51, 0xc9, 0x75, 0x9a, 0x8e, 0xdf, 0x35, 0x44, 0xd4, 0xe8, 0x55, 0xb5, 0x59, 0x9b, 0xda, 0x94,
-	0x07, 0xcd, 0xf0, 0x3f, 0x91, 0xa7, 0xcd, 0xdb, 0x94, 0xda, 0x6d, 0x62, 0x62, 0xcf, 0x31, 0xb1,
-	0xeb, 0xd2, 0x00, 0x07, 0x0e, 0x75, 0x99, 0x8c, 0x3e, 0x35, 0x28, 0x2e, 0x0b, 0x89, 0xf5, 0xb2,
-	0x45, 0x59, 0x87, 0x32, 0xb3, 0x89, 0x59, 0x18, 0x6c, 0x92, 0x00, 0x57, 0x4d, 0x8b, 0x3a, 0xae,
-	0x88, 0xeb, 0x0c, 0xce, 0xbd, 0x13, 0xc2, 0xbc, 0x7e, 0x60, 0xed, 0x63, 0xd7, 0x26, 0x75, 0x1c,
-	0x90, 0x3a, 0xb9, 0xd3, 0x25, 0x2c, 0x40, 0x3b, 0xf0, 0x94, 0x87, 0x1d, 0x7f, 0x0e, 0x5c, 0x04,
-	0x97, 0x9f, 0xd8, 0x58, 0xbf, 0xff, 0xb0, 0x92, 0xfb, 0xe3, 0x61, 0xa5, 0x6a, 0x3b, 0xc1, 0x7e,
-	0xb7, 0x69, 0x58, 0xb4, 0x63, 0xbe, 0xc5, 0xd1, 0x37, 0xf7, 0xb1, 0xe3, 0x9a, 0x62, 0x0c, 0xf3,
-	0xc0, 0xb4, 0x68, 0xa7, 0x43, 0x5d, 0x13, 0x33, 0x46, 0x02, 0x63, 0x17, 0x3b, 0x7e, 0x9d, 0x97,
-	0x79, 0xf1, 0xf1, 0xcf, 0xef, 0x55, 0x72, 0xff, 0xdc, 0xab, 0xe4, 0x74, 0x0f, 0x9e, 0x1b, 0xd1,
-	0x94, 0x79, 0xd4, 0x65, 0x04, 0xbd, 0x0b, 0x0b, 0x44, 0xae, 0x37, 0x7c, 0x1c, 0x10, 0xd9, 0xde,
-	0x90, 0xed, 0x17, 0x63, 0xed, 0xe5, 0x6c, 0xe2, 0xcf, 0x0a, 0x6b, 0xdd, 0x36, 0x83, 0x43, 0x8f,
-	0x30, 0x63, 0x8b, 0x58, 0xf5, 0x69, 0x12, 0x2b, 0xae, 0x9f, 0x1f, 0xd1, 0x91, 0xc9, 0x39, 0xf5,
-	0x4f, 0x00, 0xd4, 0x46, 0x45, 0x25, 0xd0, 0x2d, 0x58, 0x4c, 0x00, 0xb1, 0x39, 0x70, 0xf1, 0xd1,
-	0xcb, 0xf9, 0xd5, 0x67, 0x8d, 0xe1, 0x9d, 0x33, 0xe2, 0x05, 0x6e, 0x74, 0xbd, 0x36, 0xd9, 0xd0,
-	0x42, 0xec, 0x1f, 0xfe, 0xac, 0xa0, 0x54, 0x88, 0xd5, 0x0b, 0x71, 0x44, 0xa6, 0x9f, 0x85, 0x4f,
-	0x72, 0x8a, 0x9a, 0x15, 0x38, 0xbd, 0x01, 0xdd, 0x6d, 0x38, 0x9b, 0x5c, 0x8e, 0x74, 0x3a, 0x8d,
-	0xc5, 0x12, 0xe7, 0x39, 0xd1, 0x06, 0xf5, 0x2b, 0xe9, 0xe7, 0xe0, 0xd3, 0xbc, 0xd9, 0x1e, 0x0d,
-	0xc8, 0x0d, 0xec, 0xdb, 0x24, 0x88, 0x38, 0x0e, 0xe4, 0x49, 0x49, 0x84, 0x24, 0xcb, 0xfb, 0x70,
-	0xba, 0x47, 0x03, 0xd2, 0x08, 0xc4, 0xfa, 0xc9, 0x81, 0xf2, 0xbd, 0x41, 0x17, 0xfd, 0x6d, 0x38,
-	0xcf, 0x3b, 0x6f, 0x13, 0xd2, 0x22, 0xfe, 0x16, 0x69, 0x13, 0x9b, 0x9f, 0xfd, 0xfe, 0x39, 0x5d,
-	0x80, 0xc5, 0x1e, 0x6e, 0x3b, 0x2d, 0x1c, 0x50, 0xbf, 0x81, 0x5b, 0x2d, 0x79, 0x62, 0xeb, 0x85,
-	0x68, 0xb5, 0xd6, 0x6a, 0xc5, 0xcf, 0xdf, 0x6b, 0xf0, 0x82, 0xa2, 0xa0, 0x9c, 0xa7, 0x02, 0xf3,
-	0xb7, 0x78, 0x2c, 0x5e, 0x0e, 0x8a, 0xa5, 0xb0, 0x96, 0xfe, 0xa6, 0xd4, 0x69, 0xc7, 0x61, 0x6c,
-	0x93, 0x76, 0xdd, 0x80, 0xf8, 0x13, 0xd3, 0xbc, 0x22, 0x85, 0x4d, 0xd4, 0x92, 0x20, 0xcf, 0xc0,
-	0xe9, 0x8e, 0xc3, 0x58, 0xc3, 0x12, 0xeb, 0xbc, 0xd4, 0xa9, 0x7a, 0xbe, 0x33, 0x48, 0x8d, 0xd4,
-	0xa9, 0xd9, 0xb6, 0x1f, 0xce, 0x41, 0x76, 0x7d, 0x12, 0xaa, 0x37, 0x31, 0xcf, 0x5d, 0x20, 0xe5,
-	0x49, 0x57, 0x94, 0x54, 0x18, 0xce, 0xe0, 0x7e, 0xac, 0xe1, 0x89, 0x20, 0xaf, 0x9a, 0x5f, 0x35,
-	0xd2, 0x2f, 0x45, 0x54, 0x26, 0xfe, 0x0a, 0xc8, 0x92, 0x1b, 0xa7, 0xc2, 0x33, 0x52, 0x2f, 0xe1,
-	0xa1, 0x56, 0x7a, 0x45, 0xc1, 0x10, 0x1d, 0xc7, 0x4f, 0x01, 0x2c, 0xab, 0x32, 0x24, 0xa6, 0x05,
-	0x51, 0x0a, 0xb3, 0xff, 0xf2, 0x4e, 0xc6, 0x39, 0x33, 0xcc, 0xc9, 0xf4, 0xeb, 0xf2, 0x66, 0x89,
-	0x9e, 0xde, 0x3b, 0x89, 0xf6, 0x3d, 0x79, 0x13, 0x0d, 0x55, 0x93, 0x03, 0xbd, 0x07, 0x8b, 0x83,
-	0x81, 0x62, 0xa2, 0x2f, 0x65, 0x1c, 0x66, 0x6f, 0x30, 0x49, 0x01, 0xc7, 0x3b, 0xe8, 0xf3, 0xa3,
-	0xfa, 0x46, 0x5a, 0x1f, 0xc2, 0xf3, 0x23, 0xa3, 0x12, 0xeb, 0x26, 0x3c, 0x93, 0xc4, 0xea, 0x8b,
-	0x3c, 0x01, 0x57, 0x31, 0xc1, 0xc5, 0xf4, 0x59, 0x88, 0x78, 0xeb, 0x5d, 0xec, 0xe3, 0x4e, 0x04,
-	0xb4, 0x23, 0xaf, 0xca, 0xfe, 0xaa, 0x04, 0xb9, 0x06, 0xa7, 0x3c, 0xbe, 0x22, 0x75, 0x99, 0x4b,
-	0xf7, 0x17, 0x4f, 0xc8, 0x66, 0x32, 0x7b, 0xf5, 0xd7, 0x33, 0xf0, 0x31, 0x5e, 0x0f, 0x7d, 0x05,
-	0xe0, 0x74, 0x9c, 0x0c, 0x5d, 0x4d, 0x97, 0x50, 0xf9, 0xa5, 0xb6, 0x94, 0x29, 0x57, 0xb0, 0xea,
-	0xcb, 0x77, 0x7f, 0xfb, 0xfb, 0xcb, 0x47, 0x16, 0xd1, 0xa5, 0xfe, 0x35, 0x18, 0x19, 0xb8, 0xf0,
-	0xe8, 0x84, 0xe5, 0xa0, 0x6f, 0x00, 0x2c, 0x25, 0x1c, 0xe4, 0x03, 0xec, 0xfd, 0x7f, 0x6c, 0x55,
-	0xce, 0xb6, 0x84, 0xae, 0x64, 0x61, 0x6b, 0x04, 0x21, 0xcb, 0xb7, 0x00, 0x16, 0x12, 0xf6, 0x89,
-	0xb2, 0x74, 0xec, 0x6f, 0xa8, 0xb6, 0x9c, 0x2d, 0x59, 0xf2, 0xad, 0x71, 0xbe, 0x15, 0xb4, 0xa4,
-	0xe0, 0x0b, 0x7f, 0x6e, 0xb0, 0x24, 0x25, 0x43, 0x9f, 0x01, 0x78, 0x5a, 0x7a, 0x28, 0x5a, 0x50,
-	0xb4, 0x4b, 0x5a, 0xaf, 0xb6, 0x38, 0x2e, 0x2d, 0xe3, 0x5e, 0x0a, 0x1e, 0xe9, 0xb1, 0xe8, 0x6b,
-	0x00, 0xf3, 0x31, 0x13, 0x45, 0x57, 0x14, 0x5d, 0xd2, 0x1e, 0xac, 0x5d, 0xcd, 0x92, 0x9a, 0x71,
-	0x13, 0x05, 0x54, 0xdc, 0xb6, 0xd1, 0xcf, 0x00, 0x96, 0x86, 0x3d, 0x11, 0x19, 0x8a, 0x9e, 0x0a,
-	0x37, 0xd6, 0xcc, 0xcc, 0xf9, 0x12, 0xb4, 0xc6, 0x41, 0x5f, 0x42, 0xeb, 0x0a, 0xd0, 0xe8, 0xae,
-	0x64, 0xe6, 0x87, 0xc9, 0xdb, 0xf4, 0x63, 0x53, 0x58, 0x32, 0xfa, 0x0e, 0xc0, 0x7c, 0xcc, 0x3e,
-	0x95, 0x92, 0xa6, 0xed, 0x5a, 0x29, 0xe9, 0x08, 0x37, 0xd6, 0x5f, 0xe5, 0xa4, 0xeb, 0xe8, 0x85,
-	0x09, 0x48, 0x43, 0xcb, 0x46, 0xbf, 0x00, 0x58, 0x1a, 0xf6, 0x2b, 0xa5, 0xc0, 0x0a, 0x43, 0x57,
-	0x0a, 0xac, 0xb2, 0x6b, 0xfd, 0x3a, 0xc7, 0xde, 0x46, 0x5b, 0x13, 0x60, 0xa7, 0x0c, 0x14, 0xfd,
-	0x08, 0xe0, 0x4c, 0xca, 0x73, 0x51, 0x56, 0xa8, 0xe8, 0x28, 0x3f, 0x97, 0xfd, 0x01, 0x39, 0xc6,
-	0xcb, 0x7c, 0x8c, 0x6b, 0xe8, 0xf9, 0xf1, 0x63, 0xa4, 0x6d, 0x1f, 0xfd, 0x04, 0x60, 0x21, 0xe1,
-	0x5f, 0xca, 0x0b, 0x6a, 0x94, 0x93, 0x2b, 0x2f, 0xa8, 0x91, 0x46, 0xad, 0xbf, 0xc1, 0x51, 0x37,
-	0x51, 0x4d, 0x8d, 0xda, 0x72, 0xc6, 0x2a, 0xce, 0xe5, 0xfe, 0x1e, 0xc0, 0x62, 0xd2, 0x77, 0x51,
-	0x26, 0x96, 0x48, 0xe8, 0x95, 0x8c, 0xd9, 0x12, 0x7d, 0x9d, 0xa3, 0xaf, 0xa1, 0xea, 0x7f, 0x51,
-	0x59, 0x48, 0xfc, 0x11, 0x9c, 0x12, 0xf6, 0x8a, 0x2e, 0x29, 0x7a, 0x26, 0x5c, 0x5c, 0x5b, 0x18,
-	0x93, 0x25, 0x89, 0x16, 0x38, 0x51, 0x05, 0x5d, 0x50, 0x5e, 0x64, 0xdc, 0xd2, 0xb7, 0xef, 0x1f,
-	0x95, 0xc1, 0x83, 0xa3, 0x32, 0xf8, 0xeb, 0xa8, 0x0c, 0xbe, 0x38, 0x2e, 0xe7, 0x1e, 0x1c, 0x97,
-	0x73, 0xbf, 0x1f, 0x97, 0x73, 0x37, 0x97, 0xc7, 0x7d, 0x7f, 0xc8, 0x82, 0xfc, 0xe3, 0xb1, 0x39,
-	0xc5, 0x3f, 0x8c, 0xd7, 0xfe, 0x0d, 0x00, 0x00, 0xff, 0xff, 0x07, 0x60, 0xa3, 0x6d, 0xaf, 0x0f,
-	0x00, 0x00,
+	// 1299 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x98, 0xcf, 0x6f, 0x1b, 0x45,
+	0x14, 0xc7, 0x3d, 0x6d, 0x9a, 0x94, 0xe7, 0x38, 0x38, 0xd3, 0x14, 0xdc, 0x6d, 0x62, 0x27, 0x4b,
+	0x13, 0xa5, 0x89, 0xe3, 0xad, 0x13, 0x28, 0x0a, 0x05, 0x95, 0xfc, 0x20, 0x02, 0xd4, 0x40, 0x70,
+	0xa3, 0x08, 0x55, 0x48, 0xd6, 0xd8, 0x9e, 0x3a, 0xab, 0xd8, 0x5e, 0x77, 0x67, 0x6c, 0x12, 0x01,
+	0x97, 0x0a, 0x10, 0x12, 0x17, 0x24, 0x84, 0xb8, 0x41, 0x2f, 0x48, 0xa8, 0x07, 0x4e, 0xc0, 0x89,
+	0x0b, 0xb7, 0x1e, 0x2b, 0x71, 0x41, 0x20, 0x15, 0x94, 0x70, 0xe0, 0xcf, 0x40, 0x3b, 0x3b, 0x5e,
+	0xef, 0x7a, 0xbd, 0xf1, 0xd6, 0x51, 0x39, 0xb5, 0x9d, 0xf7, 0xe6, 0x7d, 0x3f, 0xef, 0xed, 0xcc,
+	0xee, 0xb7, 0x86, 0xf3, 0x86, 0x49, 0x8a, 0x15, 0xaa, 0x35, 0xb3, 0xda, 0x9d, 0x06, 0x35, 0x0f,
+	0x32, 0x75, 0xd3, 0xe0, 0x06, 0x8e, 0xd7, 0xf4, 0x82, 0x6e, 0x36, 0x32, 0x76, 0x34, 0xd3, 0xcc,
+	0x2a, 0x63, 0x65, 0xa3, 0x6c, 0x88, 0xa0, 0x66, 0xfd, 0xcd, 0xce, 0x53, 0xc6, 0xcb, 0x86, 0x51,
+	0xae, 0x50, 0x8d, 0xd4, 0x75, 0x8d, 0xd4, 0x6a, 0x06, 0x27, 0x5c, 0x37, 0x6a, 0x4c, 0x46, 0x9f,
+	0x69, 0x17, 0x97, 0x85, 0xec, 0x75, 0x97, 0x28, 0xe3, 0x84, 0xb7, 0x96, 0x93, 0x45, 0x83, 0x55,
+	0x0d, 0xa6, 0x15, 0x08, 0xb3, 0x62, 0x05, 0xca, 0x49, 0x56, 0x2b, 0x1a, 0x7a, 0xcd, 0x8e, 0xab,
+	0x0c, 0x12, 0xef, 0x58, 0x8c, 0xaf, 0xed, 0x17, 0x77, 0x49, 0xad, 0x4c, 0x73, 0x84, 0xd3, 0x1c,
+	0xbd, 0xd3, 0xa0, 0x8c, 0xe3, 0x4d, 0x18, 0xa8, 0x13, 0xdd, 0x4c, 0xa0, 0x49, 0x34, 0xfb, 0xd4,
+	0xea, 0xf2, 0x83, 0x47, 0xa9, 0xc8, 0x1f, 0x8f, 0x52, 0xd9, 0xb2, 0xce, 0x77, 0x1b, 0x85, 0x4c,
+	0xd1, 0xa8, 0x6a, 0x6f, 0x89, 0x8e, 0xd6, 0x76, 0x89, 0x5e, 0xd3, 0xec, 0xee, 0xb4, 0x7d, 0xad,
+	0x68, 0x54, 0xab, 0x46, 0x4d, 0x23, 0x8c, 0x51, 0x9e, 0xd9, 0x22, 0xba, 0x99, 0x13, 0x65, 0x5e,
+	0x3a, 0xfb, 0xd9, 0xbd, 0x54, 0xe4, 0xdf, 0x7b, 0xa9, 0x88, 0xfa, 0x27, 0x82, 0x0b, 0x5d, 0x54,
+	0x59, 0xdd, 0xa8, 0x31, 0x8a, 0x6f, 0x42, 0x8c, 0xca, 0xf5, 0xbc, 0x49, 0x38, 0x95, 0xfa, 0x19,
+	0xa9, 0x3f, 0xe3, 0xd2, 0x97, 0xcd, 0xd9, 0x7f, 0x2c, 0xb0, 0xd2, 0x9e, 0xc6, 0x0f, 0xea, 0x94,
+	0x65, 0xd6, 0x69, 0x31, 0x37, 0x4c, 0x5d, 0xc5, 0xf1, 0x14, 0x0c, 0x17, 0x2a, 0x46, 0x71, 0x2f,
+	0xbf, 0x4b, 0xf5, 0xf2, 0x2e, 0x4f, 0x9c, 0x9a, 0x44, 0xb3, 0xa7, 0x73, 0x51, 0xb1, 0xf6, 0xba,
+	0x58, 0xc2, 0x69, 0xc0, 0x76, 0x0a, 0xd7, 0xab, 0x94, 0x71, 0x52, 0xad, 0xe7, 0xab, 0x2c, 0x71,
+	0x5a, 0x24, 0xc6, 0x45, 0x64, 0xbb, 0x15, 0xd8, 0x64, 0x38, 0x01, 0x43, 0x25, 0x6a, 0xea, 0x4d,
+	0x5a, 0x4a, 0x0c, 0x4c, 0xa2, 0xd9, 0xb3, 0xb9, 0xd6, 0x3f, 0xd5, 0x8b, 0x5d, 0x9a, 0x63, 0x72,
+	0xa6, 0xea, 0xc7, 0x08, 0x94, 0x6e, 0x51, 0xd9, 0xfb, 0x6d, 0x18, 0xf1, 0xf4, 0xce, 0x12, 0x68,
+	0xf2, 0xf4, 0x6c, 0x74, 0xf1, 0xb9, 0x4c, 0xe7, 0xe1, 0xc9, 0xb8, 0x0b, 0x6c, 0x37, 0xea, 0x15,
+	0xba, 0xaa, 0x58, 0x13, 0xba, 0xff, 0x57, 0x0a, 0xfb, 0x42, 0x2c, 0x17, 0x73, 0x4f, 0x83, 0xa9,
+	0xfb, 0x30, 0xe1, 0xa3, 0xb8, 0xc9, 0x09, 0x67, 0x4f, 0xfc, 0xd9, 0x13, 0x48, 0x06, 0x29, 0xcb,
+	0x19, 0x5c, 0x87, 0x33, 0xd6, 0x09, 0x66, 0x42, 0xbb, 0x67, 0xeb, 0x62, 0xef, 0xea, 0x80, 0x05,
+	0x98, 0xb3, 0xf7, 0xa9, 0x2a, 0x4c, 0x0a, 0x89, 0x75, 0xfb, 0x81, 0x74, 0x7d, 0x0e, 0x9f, 0x23,
+	0x98, 0x3a, 0x26, 0xe9, 0x7f, 0x7e, 0x1c, 0xe7, 0xe1, 0x9c, 0x80, 0x59, 0x29, 0x72, 0xbd, 0xd9,
+	0x86, 0xdc, 0x83, 0x31, 0xef, 0xb2, 0x73, 0x43, 0x86, 0x88, 0xbd, 0x24, 0x78, 0x4e, 0xf4, 0x7c,
+	0x5a, 0x95, 0xd4, 0x0b, 0xf0, 0xac, 0x10, 0xdb, 0x31, 0x38, 0xdd, 0x26, 0x66, 0x99, 0x3a, 0x87,
+	0x41, 0xdd, 0x87, 0x84, 0x3f, 0x24, 0x59, 0xde, 0x83, 0xe1, 0xa6, 0xc1, 0x69, 0x9e, 0xdb, 0xeb,
+	0x27, 0x07, 0x8a, 0x36, 0xdb, 0x2a, 0xea, 0xdb, 0x30, 0x2e, 0x94, 0x37, 0x28, 0x2d, 0x51, 0x73,
+	0x9d, 0x56, 0x68, 0x59, 0xbc, 0x0d, 0x5b, 0xc7, 0x74, 0x1a, 0x46, 0x9a, 0xa4, 0xa2, 0x97, 0x08,
+	0x37, 0xcc, 0x3c, 0x29, 0x95, 0xe4, 0x81, 0xcd, 0xc5, 0x9c, 0xd5, 0x95, 0x52, 0xc9, 0x7d, 0xfc,
+	0x5e, 0x85, 0x89, 0x80, 0x82, 0xb2, 0x9f, 0x14, 0x44, 0x6f, 0x8b, 0x98, 0xbb, 0x1c, 0xd8, 0x4b,
+	0x56, 0x2d, 0xf5, 0x4d, 0x39, 0xa7, 0x4d, 0x9d, 0xb1, 0x35, 0xa3, 0x51, 0xe3, 0xd4, 0xec, 0x9b,
+	0xe6, 0x15, 0x48, 0xf8, 0x6b, 0x49, 0x90, 0x29, 0x18, 0xae, 0xea, 0x8c, 0xe5, 0x8b, 0xf6, 0xba,
+	0x28, 0x35, 0x90, 0x8b, 0x56, 0xdb, 0xa9, 0xce, 0x74, 0x56, 0xca, 0x65, 0xd3, 0xea, 0x83, 0x6e,
+	0x99, 0xd4, 0x9a, 0x5e, 0xdf, 0x3c, 0x77, 0x11, 0x4c, 0x04, 0x54, 0x94, 0x54, 0x04, 0x46, 0x49,
+	0x2b, 0x96, 0xaf, 0xdb, 0x41, 0x79, 0x51, 0x33, 0xfe, 0x4b, 0xe1, 0x94, 0x71, 0x5f, 0x01, 0x59,
+	0x52, 0xde, 0xd9, 0x38, 0xe9, 0x90, 0x52, 0x53, 0x01, 0x0c, 0xce, 0x71, 0xfc, 0x04, 0x41, 0x32,
+	0x28, 0x43, 0x62, 0x16, 0x01, 0xfb, 0x30, 0x5b, 0x97, 0xb7, 0x3f, 0xce, 0xd1, 0x4e, 0x4e, 0xa6,
+	0xde, 0x90, 0x2f, 0x7a, 0x67, 0xf7, 0xce, 0x49, 0x66, 0xdf, 0x04, 0xa5, 0x5b, 0x35, 0xd9, 0xd0,
+	0xbb, 0x30, 0xd2, 0x6e, 0xc8, 0x35, 0xf4, 0xf9, 0x90, 0xcd, 0xec, 0xb4, 0x3b, 0x89, 0x11, 0xb7,
+	0x82, 0x3a, 0xde, 0x4d, 0xd7, 0x99, 0xf5, 0x01, 0x5c, 0xec, 0x1a, 0x95, 0x58, 0xb7, 0xe0, 0x69,
+	0x2f, 0x56, 0x6b, 0xc8, 0x7d, 0x70, 0x8d, 0x78, 0xb8, 0x98, 0x3a, 0x06, 0x58, 0x48, 0x6f, 0x11,
+	0x93, 0x54, 0x1d, 0xa0, 0x4d, 0x38, 0xe7, 0x59, 0x95, 0x20, 0x57, 0x61, 0xb0, 0x2e, 0x56, 0xe4,
+	0x5c, 0x12, 0x7e, 0x7d, 0x7b, 0x87, 0x14, 0x93, 0xd9, 0x8b, 0x3f, 0x60, 0x38, 0x23, 0xea, 0xe1,
+	0xaf, 0x10, 0x0c, 0xbb, 0xc9, 0xf0, 0x9c, 0xbf, 0x44, 0x90, 0x55, 0x52, 0xe6, 0x43, 0xe5, 0xda,
+	0xac, 0x6a, 0xfa, 0xee, 0x6f, 0xff, 0x7c, 0x79, 0x6a, 0x06, 0x5f, 0x6a, 0xbd, 0x06, 0x1d, 0xeb,
+	0x66, 0xdb, 0x33, 0xcf, 0x27, 0x07, 0x7f, 0x83, 0x20, 0xee, 0xf9, 0x82, 0xbc, 0x4f, 0xea, 0x4f,
+	0x8e, 0x2d, 0x2b, 0xd8, 0xe6, 0xf1, 0xe5, 0x30, 0x6c, 0x79, 0x6e, 0xb1, 0x7c, 0x8b, 0x20, 0xe6,
+	0xf9, 0x7c, 0xe2, 0x30, 0x8a, 0xad, 0x07, 0xaa, 0xa4, 0xc3, 0x25, 0x4b, 0xbe, 0x25, 0xc1, 0xb7,
+	0x80, 0xe7, 0x03, 0xf8, 0x2c, 0xb7, 0xc1, 0xbc, 0x94, 0x0c, 0xdf, 0x47, 0x30, 0xea, 0xf3, 0x0c,
+	0x58, 0x0b, 0x21, 0xec, 0xf6, 0x44, 0xca, 0x95, 0xf0, 0x1b, 0x24, 0xed, 0xa2, 0xa0, 0x4d, 0xe3,
+	0xb9, 0x50, 0xd3, 0x14, 0xee, 0x05, 0xff, 0x82, 0x60, 0xac, 0x9b, 0x29, 0xc1, 0x8b, 0x01, 0xf2,
+	0xc7, 0xd8, 0x1c, 0x65, 0xe9, 0xb1, 0xf6, 0x48, 0xea, 0x6b, 0x82, 0xfa, 0x05, 0xbc, 0x74, 0xec,
+	0x8c, 0xa5, 0xdd, 0xcd, 0x77, 0xcc, 0xfa, 0x53, 0x04, 0x43, 0xd2, 0xaf, 0xe0, 0xe9, 0x00, 0x75,
+	0xaf, 0xcd, 0x51, 0x66, 0x7a, 0xa5, 0x85, 0xbc, 0x37, 0x36, 0x97, 0xf4, 0x33, 0xf8, 0x6b, 0x04,
+	0x51, 0x97, 0x61, 0xc1, 0x97, 0x03, 0x54, 0xfc, 0x7e, 0x47, 0x99, 0x0b, 0x93, 0x1a, 0xf2, 0xc2,
+	0xd8, 0x50, 0x6e, 0x8b, 0x84, 0x7f, 0x46, 0x10, 0xef, 0xf4, 0x1f, 0x38, 0x13, 0xa0, 0x19, 0xe0,
+	0x7c, 0x14, 0x2d, 0x74, 0xbe, 0x04, 0x5d, 0x11, 0xa0, 0xd7, 0xf0, 0x72, 0x00, 0xa8, 0xf3, 0x5d,
+	0x62, 0xda, 0x07, 0xde, 0x2f, 0xd7, 0x47, 0x9a, 0x6d, 0x7f, 0xf0, 0x77, 0x08, 0xa2, 0x2e, 0xab,
+	0x12, 0x38, 0x52, 0xbf, 0x35, 0x52, 0xe6, 0xc2, 0xa4, 0x4a, 0xd2, 0xeb, 0x82, 0x74, 0x19, 0xbf,
+	0xd8, 0x07, 0xa9, 0x65, 0x8f, 0xf0, 0xaf, 0x08, 0xe2, 0x9d, 0xde, 0x20, 0x70, 0xc0, 0x01, 0xe6,
+	0x49, 0xd1, 0x42, 0xe7, 0x4b, 0xec, 0x1b, 0x02, 0x7b, 0x03, 0xaf, 0xf7, 0x81, 0xed, 0x33, 0x2b,
+	0xf8, 0x47, 0x04, 0xa3, 0x9d, 0x52, 0xc1, 0xef, 0xac, 0x20, 0xaf, 0xa4, 0x5c, 0x09, 0xbf, 0x41,
+	0xb6, 0xf1, 0xb2, 0x68, 0xe3, 0x2a, 0x7e, 0xbe, 0x77, 0x1b, 0x7e, 0x8b, 0x85, 0x7f, 0x42, 0x10,
+	0xf3, 0x78, 0x85, 0xc0, 0x8f, 0x41, 0x37, 0xd7, 0xa4, 0xa4, 0xc3, 0x25, 0x4b, 0xd4, 0x37, 0x04,
+	0xea, 0x1a, 0x5e, 0x09, 0x46, 0x2d, 0xe9, 0x3d, 0x27, 0x2e, 0xc6, 0xfd, 0x3d, 0x82, 0x11, 0x8f,
+	0x08, 0xc3, 0xa1, 0x58, 0x9c, 0x41, 0x2f, 0x84, 0xcc, 0x96, 0xe8, 0xcb, 0x02, 0x7d, 0x09, 0x67,
+	0x1f, 0x67, 0xca, 0xf6, 0x88, 0x3f, 0x84, 0x41, 0xdb, 0xca, 0xe0, 0x4b, 0x01, 0x9a, 0x1e, 0xc7,
+	0xa4, 0x4c, 0xf7, 0xc8, 0x92, 0x44, 0xd3, 0x82, 0x28, 0x85, 0x27, 0x02, 0x5f, 0x64, 0xc2, 0x3e,
+	0x6d, 0x3c, 0x38, 0x4c, 0xa2, 0x87, 0x87, 0x49, 0xf4, 0xf7, 0x61, 0x12, 0x7d, 0x71, 0x94, 0x8c,
+	0x3c, 0x3c, 0x4a, 0x46, 0x7e, 0x3f, 0x4a, 0x46, 0x6e, 0xa5, 0x7b, 0xfd, 0x5f, 0x4f, 0x16, 0x14,
+	0x3f, 0xd1, 0x14, 0x06, 0xc5, 0xef, 0x4f, 0x4b, 0xff, 0x0d, 0x00, 0x49, 0x5b, 0xe0, 0x88, 0x2d,
+	0x13, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1076,9 +1294,18 @@ type QueryClient interface {
 	ExchangeRate(ctx context.Context, in *QueryExchangeRateRequest, opts ...grpc.CallOption) (*QueryExchangeRateResponse, error)
 	// ExchangeRateTwap returns twap exchange rate of a pair
 	ExchangeRateTwap(ctx context.Context, in *QueryExchangeRateRequest, opts ...grpc.CallOption) (*QueryExchangeRateResponse, error)
-	// ExchangeRates returns exchange rates of all pairs
+	// ExchangeRates returns exchange rates of all pairs, leaving out the ones
+	// older than the max price age of their pair
 	ExchangeRates(ctx context.Context, in *QueryExchangeRatesRequest, opts ...grpc.CallOption) (*QueryExchangeRatesResponse, error)
-	// Actives returns all active pairs
+	// ExchangeRateStats returns the statistics of the last passing ballot of a
+	// pair
+	ExchangeRateStats(ctx context.Context, in *QueryExchangeRateStatsRequest, opts ...grpc.CallOption) (*QueryExchangeRateStatsResponse, error)
+	// DerivedExchangeRates returns the exchange rates of the pairs derived from
+	// cross rate routes, leaving out the ones older than the max price age of
+	// their pair
+	DerivedExchangeRates(ctx context.Context, in *QueryDerivedExchangeRatesRequest, opts ...grpc.CallOption) (*QueryDerivedExchangeRatesResponse, error)
+	// Actives returns all pairs with an exchange rate no older than the max
+	// price age of the pair
 	Actives(ctx context.Context, in *QueryActivesRequest, opts ...grpc.CallOption) (*QueryActivesResponse, error)
 	// VoteTargets returns all vote target for pairs
 	VoteTargets(ctx context.Context, in *QueryVoteTargetsRequest, opts ...grpc.CallOption) (*QueryVoteTargetsResponse, error)
@@ -1133,6 +1360,24 @@ func (c *queryClient) ExchangeRates(ctx context.Context, in *QueryExchangeRatesR
 	return out, nil
 }
 
+func (c *queryClient) ExchangeRateStats(ctx context.Context, in *QueryExchangeRateStatsRequest, opts ...grpc.CallOption) (*QueryExchangeRateStatsResponse, error) {
+	out := new(QueryExchangeRateStatsResponse)
+	err := c.cc.Invoke(ctx, "/nibiru.oracle.v1.Query/ExchangeRateStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DerivedExchangeRates(ctx context.Context, in *QueryDerivedExchangeRatesRequest, opts ...grpc.CallOption) (*QueryDerivedExchangeRatesResponse, error) {
+	out := new(QueryDerivedExchangeRatesResponse)
+	err := c.cc.Invoke(ctx, "/nibiru.oracle.v1.Query/DerivedExchangeRates", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) Actives(ctx context.Context, in *QueryActivesRequest, opts ...grpc.CallOption) (*QueryActivesResponse, error) {
 	out := new(QueryActivesResponse)
 	err := c.cc.Invoke(ctx, "/nibiru.oracle.v1.Query/Actives", in, out, opts...)
@@ -1220,9 +1465,18 @@ type QueryServer interface {
 	ExchangeRate(context.Context, *QueryExchangeRateRequest) (*QueryExchangeRateResponse, error)
 	// ExchangeRateTwap returns twap exchange rate of a pair
 	ExchangeRateTwap(context.Context, *QueryExchangeRateRequest) (*QueryExchangeRateResponse, error)
-	// ExchangeRates returns exchange rates of all pairs
+	// ExchangeRates returns exchange rates of all pairs, leaving out the ones
+	// older than the max price age of their pair
 	ExchangeRates(context.Context, *QueryExchangeRatesRequest) (*QueryExchangeRatesResponse, error)
-	// Actives returns all active pairs
+	// ExchangeRateStats returns the statistics of the last passing ballot of a
+	// pair
+	ExchangeRateStats(context.Context, *QueryExchangeRateStatsRequest) (*QueryExchangeRateStatsResponse, error)
+	// DerivedExchangeRates returns the exchange rates of the pairs derived from
+	// cross rate routes, leaving out the ones older than the max price age of
+	// their pair
+	DerivedExchangeRates(context.Context, *QueryDerivedExchangeRatesRequest) (*QueryDerivedExchangeRatesResponse, error)
+	// Actives returns all pairs with an exchange rate no older than the max
+	// price age of the pair
 	Actives(context.Context, *QueryActivesRequest) (*QueryActivesResponse, error)
 	// VoteTargets returns all vote target for pairs
 	VoteTargets(context.Context, *QueryVoteTargetsRequest) (*QueryVoteTargetsResponse, error)
@@ -1255,6 +1509,12 @@ func (*UnimplementedQueryServer) ExchangeRateTwap(ctx context.Context, req *Quer
 func (*UnimplementedQueryServer) ExchangeRates(ctx context.Context, req *QueryExchangeRatesRequest) (*QueryExchangeRatesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ExchangeRates not implemented")
 }
+func (*UnimplementedQueryServer) ExchangeRateStats(ctx context.Context, req *QueryExchangeRateStatsRequest) (*QueryExchangeRateStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExchangeRateStats not implemented")
+}
+func (*UnimplementedQueryServer) DerivedExchangeRates(ctx context.Context, req *QueryDerivedExchangeRatesRequest) (*QueryDerivedExchangeRatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DerivedExchangeRates not implemented")
+}
 func (*UnimplementedQueryServer) Actives(ctx context.Context, req *QueryActivesRequest) (*QueryActivesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Actives not implemented")
 }
@@ -1341,6 +1601,42 @@ func _Query_ExchangeRates_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ExchangeRateStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryExchangeRateStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ExchangeRateStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nibiru.oracle.v1.Query/ExchangeRateStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ExchangeRateStats(ctx, req.(*QueryExchangeRateStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DerivedExchangeRates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDerivedExchangeRatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DerivedExchangeRates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nibiru.oracle.v1.Query/DerivedExchangeRates",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DerivedExchangeRates(ctx, req.(*QueryDerivedExchangeRatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_Actives_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryActivesRequest)
 	if err := dec(in); err != nil {
@@ -1519,6 +1815,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ExchangeRates",
 			Handler:    _Query_ExchangeRates_Handler,
 		},
+		{
+			MethodName: "ExchangeRateStats",
+			Handler:    _Query_ExchangeRateStats_Handler,
+		},
+		{
+			MethodName: "DerivedExchangeRates",
+			Handler:    _Query_DerivedExchangeRates_Handler,
+		},
 		{
 			MethodName: "Actives",
 			Handler:    _Query_Actives_Handler,
@@ -1613,6 +1917,26 @@ func (m *QueryExchangeRateResponse) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	_ = i
 	var l int
 	_ = l
+	if m.Derived {
+		i--
+		if m.Derived {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.BlockTimestampMs != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BlockTimestampMs))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.BlockHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x10
+	}
 	{
 		size := m.ExchangeRate.Size()
 		i -= size
@@ -1686,7 +2010,7 @@ func (m *QueryExchangeRatesResponse) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryActivesRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryExchangeRateStatsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1696,20 +2020,30 @@ func (m *QueryActivesRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryActivesRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryExchangeRateStatsRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryActivesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryExchangeRateStatsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.Pair.Size()
+		i -= size
+		if _, err := m.Pair.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryActivesResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryExchangeRateStatsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1719,34 +2053,30 @@ func (m *QueryActivesResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryActivesResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryExchangeRateStatsResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryActivesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryExchangeRateStatsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Actives) > 0 {
-		for iNdEx := len(m.Actives) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size := m.Actives[iNdEx].Size()
-				i -= size
-				if _, err := m.Actives[iNdEx].MarshalTo(dAtA[i:]); err != nil {
-					return 0, err
-				}
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+	{
+		size, err := m.Stats.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryVoteTargetsRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryDerivedExchangeRatesRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1756,12 +2086,12 @@ func (m *QueryVoteTargetsRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryVoteTargetsRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryDerivedExchangeRatesRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryVoteTargetsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryDerivedExchangeRatesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -1769,7 +2099,7 @@ func (m *QueryVoteTargetsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryVoteTargetsResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryDerivedExchangeRatesResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1779,24 +2109,24 @@ func (m *QueryVoteTargetsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryVoteTargetsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryDerivedExchangeRatesResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryVoteTargetsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryDerivedExchangeRatesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.VoteTargets) > 0 {
-		for iNdEx := len(m.VoteTargets) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.ExchangeRates) > 0 {
+		for iNdEx := len(m.ExchangeRates) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size := m.VoteTargets[iNdEx].Size()
-				i -= size
-				if _, err := m.VoteTargets[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+				size, err := m.ExchangeRates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
 					return 0, err
 				}
+				i -= size
 				i = encodeVarintQuery(dAtA, i, uint64(size))
 			}
 			i--
@@ -1806,7 +2136,7 @@ func (m *QueryVoteTargetsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryFeederDelegationRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryActivesRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1816,12 +2146,132 @@ func (m *QueryFeederDelegationRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryFeederDelegationRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryActivesRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryFeederDelegationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryActivesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryActivesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryActivesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryActivesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Actives) > 0 {
+		for iNdEx := len(m.Actives) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.Actives[iNdEx].Size()
+				i -= size
+				if _, err := m.Actives[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVoteTargetsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVoteTargetsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVoteTargetsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVoteTargetsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVoteTargetsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVoteTargetsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.VoteTargets) > 0 {
+		for iNdEx := len(m.VoteTargets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.VoteTargets[iNdEx].Size()
+				i -= size
+				if _, err := m.VoteTargets[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFeederDelegationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeederDelegationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeederDelegationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -2256,6 +2706,15 @@ func (m *QueryExchangeRateResponse) Size() (n int) {
 	_ = l
 	l = m.ExchangeRate.Size()
 	n += 1 + l + sovQuery(uint64(l))
+	if m.BlockHeight != 0 {
+		n += 1 + sovQuery(uint64(m.BlockHeight))
+	}
+	if m.BlockTimestampMs != 0 {
+		n += 1 + sovQuery(uint64(m.BlockTimestampMs))
+	}
+	if m.Derived {
+		n += 2
+	}
 	return n
 }
 
@@ -2283,6 +2742,52 @@ func (m *QueryExchangeRatesResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryExchangeRateStatsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Pair.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryExchangeRateStatsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Stats.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryDerivedExchangeRatesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryDerivedExchangeRatesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ExchangeRates) > 0 {
+		for _, e := range m.ExchangeRates {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *QueryActivesRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2651,6 +3156,64 @@ func (m *QueryExchangeRateResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockTimestampMs", wireType)
+			}
+			m.BlockTimestampMs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockTimestampMs |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Derived", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Derived = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2806,6 +3369,307 @@ func (m *QueryExchangeRatesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryExchangeRateStatsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryExchangeRateStatsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryExchangeRateStatsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pair", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Pair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryExchangeRateStatsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryExchangeRateStatsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryExchangeRateStatsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stats", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Stats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDerivedExchangeRatesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDerivedExchangeRatesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDerivedExchangeRatesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDerivedExchangeRatesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDerivedExchangeRatesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDerivedExchangeRatesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExchangeRates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExchangeRates = append(m.ExchangeRates, ExchangeRateTuple{})
+			if err := m.ExchangeRates[len(m.ExchangeRates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *QueryActivesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
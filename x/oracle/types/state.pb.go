@@ -75,35 +75,189 @@ func (m *PriceSnapshot) GetTimestampMs() int64 {
 	return 0
 }
 
+// the exchange rate of a pair with the block at which it was set
+type DatedPrice struct {
+	ExchangeRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=exchange_rate,json=exchangeRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"exchange_rate"`
+	// height of the block at which the exchange rate was set
+	CreatedBlock int64 `protobuf:"varint,2,opt,name=created_block,json=createdBlock,proto3" json:"created_block,omitempty"`
+	// time of the block at which the exchange rate was set, in milliseconds
+	// since unix epoch
+	CreatedTimestampMs int64 `protobuf:"varint,3,opt,name=created_timestamp_ms,json=createdTimestampMs,proto3" json:"created_timestamp_ms,omitempty"`
+	// whether the exchange rate was derived from the ballots of the legs of a
+	// cross rate route rather than voted directly
+	Derived bool `protobuf:"varint,4,opt,name=derived,proto3" json:"derived,omitempty"`
+}
+
+func (m *DatedPrice) Reset()         { *m = DatedPrice{} }
+func (m *DatedPrice) String() string { return proto.CompactTextString(m) }
+func (*DatedPrice) ProtoMessage()    {}
+func (*DatedPrice) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8840885873256d8c, []int{1}
+}
+func (m *DatedPrice) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DatedPrice) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DatedPrice.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DatedPrice) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DatedPrice.Merge(m, src)
+}
+func (m *DatedPrice) XXX_Size() int {
+	return m.Size()
+}
+func (m *DatedPrice) XXX_DiscardUnknown() {
+	xxx_messageInfo_DatedPrice.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DatedPrice proto.InternalMessageInfo
+
+func (m *DatedPrice) GetCreatedBlock() int64 {
+	if m != nil {
+		return m.CreatedBlock
+	}
+	return 0
+}
+
+func (m *DatedPrice) GetCreatedTimestampMs() int64 {
+	if m != nil {
+		return m.CreatedTimestampMs
+	}
+	return 0
+}
+
+func (m *DatedPrice) GetDerived() bool {
+	if m != nil {
+		return m.Derived
+	}
+	return false
+}
+
+// the statistics of the ballot that set the exchange rate of a pair
+type ExchangeRateStats struct {
+	// the exchange rate the ballot was aggregated to, with the aggregation of
+	// the pair
+	ExchangeRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=exchange_rate,json=exchangeRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"exchange_rate"`
+	// standard deviation of the votes around the exchange rate, abstentions
+	// excluded
+	StandardDeviation github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=standard_deviation,json=standardDeviation,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"standard_deviation"`
+	// number of validators who voted, abstentions excluded
+	NumVoters uint64 `protobuf:"varint,3,opt,name=num_voters,json=numVoters,proto3" json:"num_voters,omitempty"`
+	// voting power of the ballot over the total bonded voting power
+	VotingPowerShare github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=voting_power_share,json=votingPowerShare,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"voting_power_share"`
+	// height of the block at which the ballot was tallied
+	CreatedBlock int64 `protobuf:"varint,5,opt,name=created_block,json=createdBlock,proto3" json:"created_block,omitempty"`
+	// time of the block at which the ballot was tallied, in milliseconds since
+	// unix epoch
+	CreatedTimestampMs int64 `protobuf:"varint,6,opt,name=created_timestamp_ms,json=createdTimestampMs,proto3" json:"created_timestamp_ms,omitempty"`
+}
+
+func (m *ExchangeRateStats) Reset()         { *m = ExchangeRateStats{} }
+func (m *ExchangeRateStats) String() string { return proto.CompactTextString(m) }
+func (*ExchangeRateStats) ProtoMessage()    {}
+func (*ExchangeRateStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8840885873256d8c, []int{2}
+}
+func (m *ExchangeRateStats) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExchangeRateStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExchangeRateStats.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ExchangeRateStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExchangeRateStats.Merge(m, src)
+}
+func (m *ExchangeRateStats) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExchangeRateStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExchangeRateStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExchangeRateStats proto.InternalMessageInfo
+
+func (m *ExchangeRateStats) GetNumVoters() uint64 {
+	if m != nil {
+		return m.NumVoters
+	}
+	return 0
+}
+
+func (m *ExchangeRateStats) GetCreatedBlock() int64 {
+	if m != nil {
+		return m.CreatedBlock
+	}
+	return 0
+}
+
+func (m *ExchangeRateStats) GetCreatedTimestampMs() int64 {
+	if m != nil {
+		return m.CreatedTimestampMs
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*PriceSnapshot)(nil), "nibiru.oracle.v1.PriceSnapshot")
+	proto.RegisterType((*DatedPrice)(nil), "nibiru.oracle.v1.DatedPrice")
+	proto.RegisterType((*ExchangeRateStats)(nil), "nibiru.oracle.v1.ExchangeRateStats")
 }
 
 func init() { proto.RegisterFile("oracle/v1/state.proto", fileDescriptor_8840885873256d8c) }
 
 var fileDescriptor_8840885873256d8c = []byte{
-	// 328 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x90, 0xc1, 0x4a, 0x33, 0x31,
-	0x10, 0xc7, 0x77, 0xbf, 0x7e, 0x0a, 0x6e, 0x15, 0x64, 0x51, 0x29, 0x45, 0xd2, 0xda, 0x83, 0xf4,
-	0xa0, 0x09, 0x8b, 0x37, 0x8f, 0xb5, 0x88, 0x17, 0xa5, 0xd4, 0x9b, 0x08, 0x32, 0x1b, 0xc3, 0x36,
-	0xd8, 0x64, 0xc2, 0x26, 0x2d, 0xf6, 0x2d, 0x7c, 0xac, 0x1e, 0x7b, 0x12, 0xf1, 0x50, 0xa4, 0x7d,
-	0x03, 0x9f, 0x40, 0x76, 0xb3, 0xa8, 0x37, 0x4f, 0x09, 0xf3, 0x4b, 0x7e, 0xf3, 0x9f, 0x89, 0xf6,
-	0x31, 0x07, 0x3e, 0x16, 0x6c, 0x9a, 0x30, 0xeb, 0xc0, 0x09, 0x6a, 0x72, 0x74, 0x18, 0xef, 0x6a,
-	0x99, 0xca, 0x7c, 0x42, 0x3d, 0xa5, 0xd3, 0xa4, 0xb9, 0x97, 0x61, 0x86, 0x25, 0x64, 0xc5, 0xcd,
-	0xbf, 0x6b, 0x1e, 0x66, 0x88, 0xd9, 0x58, 0x30, 0x30, 0x92, 0x81, 0xd6, 0xe8, 0xc0, 0x49, 0xd4,
-	0xb6, 0xa2, 0x07, 0x3f, 0xf2, 0x4a, 0xe4, 0xeb, 0x84, 0xa3, 0x55, 0x68, 0x59, 0x0a, 0xb6, 0x80,
-	0xa9, 0x70, 0x90, 0x30, 0x8e, 0x52, 0x7b, 0xde, 0x79, 0x0d, 0xa3, 0x9d, 0x41, 0x2e, 0xb9, 0xb8,
-	0xd5, 0x60, 0xec, 0x08, 0x5d, 0x7c, 0x1f, 0xfd, 0x37, 0x20, 0xf3, 0x46, 0xd8, 0x0e, 0xbb, 0x5b,
-	0xbd, 0xab, 0xf9, 0xb2, 0x15, 0xbc, 0x2f, 0x5b, 0x49, 0x26, 0xdd, 0x68, 0x92, 0x52, 0x8e, 0x8a,
-	0xdd, 0x94, 0x81, 0x2f, 0x46, 0x20, 0x35, 0xf3, 0xe1, 0xd9, 0x33, 0xe3, 0xa8, 0x14, 0x6a, 0x06,
-	0xd6, 0x0a, 0x47, 0x07, 0x20, 0xf3, 0xcf, 0x65, 0xab, 0x3e, 0x03, 0x35, 0x3e, 0xef, 0x14, 0xba,
-	0xce, 0xb0, 0xb4, 0xc6, 0xfd, 0x68, 0xc3, 0x14, 0xed, 0x1a, 0xff, 0x4a, 0x3d, 0xad, 0xf4, 0xc7,
-	0xbf, 0xf4, 0x55, 0x62, 0x7f, 0x9c, 0xda, 0xc7, 0x27, 0xe6, 0x66, 0x46, 0x58, 0xda, 0x17, 0x7c,
-	0xe8, 0x3f, 0xc7, 0x47, 0xd1, 0xb6, 0x93, 0x4a, 0x58, 0x07, 0xca, 0x3c, 0x28, 0xdb, 0xa8, 0xb5,
-	0xc3, 0x6e, 0x6d, 0x58, 0xff, 0xae, 0x5d, 0xdb, 0xde, 0xe5, 0x7c, 0x45, 0xc2, 0xc5, 0x8a, 0x84,
-	0x1f, 0x2b, 0x12, 0xbe, 0xac, 0x49, 0xb0, 0x58, 0x93, 0xe0, 0x6d, 0x4d, 0x82, 0xbb, 0x93, 0xbf,
-	0x46, 0xa9, 0x56, 0x59, 0x76, 0x4d, 0x37, 0xcb, 0x3d, 0x9d, 0x7d, 0x05, 0x00, 0x00, 0xff, 0xff,
-	0x59, 0x7f, 0x0f, 0x73, 0xbe, 0x01, 0x00, 0x00,
+	// 513 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x93, 0x41, 0x8b, 0xd3, 0x40,
+	0x14, 0xc7, 0x1b, 0xdb, 0x5d, 0xdd, 0xd9, 0x2d, 0xec, 0x0e, 0xab, 0x84, 0x45, 0xd3, 0x5a, 0x41,
+	0x7a, 0xd0, 0x8c, 0xc5, 0x9b, 0xc7, 0x5a, 0xc5, 0x8b, 0x52, 0x52, 0xf1, 0x20, 0x2b, 0xe1, 0x25,
+	0x79, 0xa4, 0xc3, 0x36, 0x33, 0x61, 0x66, 0x1a, 0x77, 0xbf, 0x85, 0x77, 0xbf, 0xd0, 0x1e, 0x17,
+	0x0f, 0x22, 0x1e, 0x8a, 0xb4, 0xdf, 0xc0, 0x4f, 0x20, 0x99, 0xa4, 0xee, 0x82, 0x8a, 0x58, 0xf0,
+	0x94, 0xe4, 0xff, 0x9f, 0xfc, 0xde, 0xff, 0xe5, 0xe5, 0x91, 0x9b, 0x52, 0x41, 0x3c, 0x43, 0x56,
+	0x0c, 0x98, 0x36, 0x60, 0xd0, 0xcf, 0x95, 0x34, 0x92, 0xee, 0x0b, 0x1e, 0x71, 0x35, 0xf7, 0x2b,
+	0xd7, 0x2f, 0x06, 0x47, 0x87, 0xa9, 0x4c, 0xa5, 0x35, 0x59, 0x79, 0x57, 0x9d, 0x3b, 0xba, 0x9d,
+	0x4a, 0x99, 0xce, 0x90, 0x41, 0xce, 0x19, 0x08, 0x21, 0x0d, 0x18, 0x2e, 0x85, 0xae, 0xdd, 0x5b,
+	0x97, 0xf0, 0x1a, 0x54, 0xe9, 0x5e, 0x2c, 0x75, 0x26, 0x35, 0x8b, 0x40, 0x97, 0x66, 0x84, 0x06,
+	0x06, 0x2c, 0x96, 0x5c, 0x54, 0x7e, 0xef, 0xb3, 0x43, 0xda, 0x63, 0xc5, 0x63, 0x9c, 0x08, 0xc8,
+	0xf5, 0x54, 0x1a, 0x7a, 0x4c, 0x5a, 0x39, 0x70, 0xe5, 0x3a, 0x5d, 0xa7, 0xbf, 0x33, 0x7c, 0x71,
+	0xbe, 0xe8, 0x34, 0xbe, 0x2e, 0x3a, 0x83, 0x94, 0x9b, 0xe9, 0x3c, 0xf2, 0x63, 0x99, 0xb1, 0x57,
+	0x36, 0xf0, 0xd3, 0x29, 0x70, 0xc1, 0xaa, 0xf0, 0xec, 0x94, 0xc5, 0x32, 0xcb, 0xa4, 0x60, 0xa0,
+	0x35, 0x1a, 0x7f, 0x0c, 0x5c, 0x7d, 0x5f, 0x74, 0x76, 0xcf, 0x20, 0x9b, 0x3d, 0xe9, 0x95, 0xb8,
+	0x5e, 0x60, 0xa9, 0x74, 0x44, 0xb6, 0xf2, 0xb2, 0x9c, 0x7b, 0xcd, 0xe2, 0xfd, 0x1a, 0x7f, 0xff,
+	0x0a, 0xbe, 0x4e, 0x5c, 0x5d, 0x1e, 0xea, 0xe4, 0x84, 0x99, 0xb3, 0x1c, 0xb5, 0x3f, 0xc2, 0x38,
+	0xa8, 0x5e, 0xa6, 0x77, 0xc9, 0x9e, 0xe1, 0x19, 0x6a, 0x03, 0x59, 0x1e, 0x66, 0xda, 0x6d, 0x76,
+	0x9d, 0x7e, 0x33, 0xd8, 0xfd, 0xa9, 0xbd, 0xd4, 0xbd, 0x4f, 0x0e, 0x21, 0x23, 0x30, 0x98, 0xd8,
+	0xee, 0xe8, 0x84, 0xb4, 0xf1, 0x34, 0x9e, 0x82, 0x48, 0x31, 0x54, 0x60, 0xd0, 0x75, 0x36, 0xaa,
+	0xbf, 0xb7, 0x86, 0x04, 0x60, 0x90, 0xde, 0x23, 0xed, 0x58, 0x61, 0x59, 0x24, 0x8c, 0x66, 0x32,
+	0x3e, 0xb1, 0x4d, 0x35, 0x83, 0xbd, 0x5a, 0x1c, 0x96, 0x1a, 0x7d, 0x44, 0x0e, 0xd7, 0x87, 0x7e,
+	0x93, 0x99, 0xd6, 0xde, 0xeb, 0xcb, 0xe8, 0xd4, 0x25, 0xd7, 0x13, 0x54, 0xbc, 0xc0, 0xc4, 0x6d,
+	0x75, 0x9d, 0xfe, 0x8d, 0x60, 0xfd, 0xd8, 0xfb, 0xd8, 0x24, 0x07, 0xcf, 0xae, 0x24, 0x98, 0x18,
+	0x30, 0xfa, 0xff, 0xf4, 0xf6, 0x8e, 0x50, 0x6d, 0x40, 0x24, 0xa0, 0x92, 0x30, 0xc1, 0x82, 0xdb,
+	0xbf, 0x6d, 0xc3, 0xa9, 0x1d, 0xac, 0x49, 0xa3, 0x35, 0x88, 0xde, 0x21, 0x44, 0xcc, 0xb3, 0xb0,
+	0x90, 0x06, 0x55, 0xf5, 0x2d, 0x5a, 0xc1, 0x8e, 0x98, 0x67, 0x6f, 0xac, 0x40, 0x8f, 0x09, 0x2d,
+	0xa4, 0xe1, 0x22, 0x0d, 0x73, 0xf9, 0x1e, 0x55, 0xa8, 0xa7, 0xa0, 0xd0, 0x6d, 0x6d, 0x54, 0x7d,
+	0xbf, 0x22, 0x8d, 0x4b, 0xd0, 0xa4, 0xe4, 0xfc, 0x3a, 0xb7, 0xad, 0x7f, 0x98, 0xdb, 0xf6, 0x9f,
+	0xe6, 0x36, 0x7c, 0x7e, 0xbe, 0xf4, 0x9c, 0x8b, 0xa5, 0xe7, 0x7c, 0x5b, 0x7a, 0xce, 0x87, 0x95,
+	0xd7, 0xb8, 0x58, 0x79, 0x8d, 0x2f, 0x2b, 0xaf, 0xf1, 0xf6, 0xc1, 0xdf, 0xb6, 0xa7, 0xde, 0x5e,
+	0x1b, 0x3a, 0xda, 0xb6, 0xab, 0xf9, 0xf8, 0xc7, 0x00, 0x8e, 0xbb, 0xdc, 0xe1, 0x31, 0x04, 0x00,
+	0x00,
 }
 
 func (m *PriceSnapshot) Marshal() (dAtA []byte, err error) {
@@ -154,6 +308,127 @@ func (m *PriceSnapshot) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *DatedPrice) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DatedPrice) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DatedPrice) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Derived {
+		i--
+		if m.Derived {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.CreatedTimestampMs != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.CreatedTimestampMs))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.CreatedBlock != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.CreatedBlock))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size := m.ExchangeRate.Size()
+		i -= size
+		if _, err := m.ExchangeRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ExchangeRateStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExchangeRateStats) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExchangeRateStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CreatedTimestampMs != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.CreatedTimestampMs))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.CreatedBlock != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.CreatedBlock))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size := m.VotingPowerShare.Size()
+		i -= size
+		if _, err := m.VotingPowerShare.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.NumVoters != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.NumVoters))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.StandardDeviation.Size()
+		i -= size
+		if _, err := m.StandardDeviation.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.ExchangeRate.Size()
+		i -= size
+		if _, err := m.ExchangeRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintState(dAtA []byte, offset int, v uint64) int {
 	offset -= sovState(v)
 	base := offset
@@ -181,6 +456,50 @@ func (m *PriceSnapshot) Size() (n int) {
 	return n
 }
 
+func (m *DatedPrice) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ExchangeRate.Size()
+	n += 1 + l + sovState(uint64(l))
+	if m.CreatedBlock != 0 {
+		n += 1 + sovState(uint64(m.CreatedBlock))
+	}
+	if m.CreatedTimestampMs != 0 {
+		n += 1 + sovState(uint64(m.CreatedTimestampMs))
+	}
+	if m.Derived {
+		n += 2
+	}
+	return n
+}
+
+func (m *ExchangeRateStats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ExchangeRate.Size()
+	n += 1 + l + sovState(uint64(l))
+	l = m.StandardDeviation.Size()
+	n += 1 + l + sovState(uint64(l))
+	if m.NumVoters != 0 {
+		n += 1 + sovState(uint64(m.NumVoters))
+	}
+	l = m.VotingPowerShare.Size()
+	n += 1 + l + sovState(uint64(l))
+	if m.CreatedBlock != 0 {
+		n += 1 + sovState(uint64(m.CreatedBlock))
+	}
+	if m.CreatedTimestampMs != 0 {
+		n += 1 + sovState(uint64(m.CreatedTimestampMs))
+	}
+	return n
+}
+
 func sovState(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -324,6 +643,357 @@ func (m *PriceSnapshot) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *DatedPrice) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowState
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DatedPrice: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DatedPrice: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExchangeRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ExchangeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedBlock", wireType)
+			}
+			m.CreatedBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreatedBlock |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedTimestampMs", wireType)
+			}
+			m.CreatedTimestampMs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreatedTimestampMs |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Derived", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Derived = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipState(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthState
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExchangeRateStats) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowState
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExchangeRateStats: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExchangeRateStats: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExchangeRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ExchangeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StandardDeviation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.StandardDeviation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumVoters", wireType)
+			}
+			m.NumVoters = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumVoters |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingPowerShare", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.VotingPowerShare.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedBlock", wireType)
+			}
+			m.CreatedBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreatedBlock |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedTimestampMs", wireType)
+			}
+			m.CreatedTimestampMs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreatedTimestampMs |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipState(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthState
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipState(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -11,8 +11,9 @@ import (
 	"github.com/NibiruChain/nibiru/x/perp/amm/types"
 )
 
-// EndBlocker Called every block to store a snapshot of the perpamm.
-func EndBlocker(ctx sdk.Context, k keeper.Keeper) []abci.ValidatorUpdate {
+// EndBlocker Called every block to store a snapshot of the perpamm and prune
+// the snapshots that are no longer needed for the twaps of x/perp.
+func EndBlocker(ctx sdk.Context, k keeper.Keeper, perpKeeper types.PerpKeeper) []abci.ValidatorUpdate {
 	for _, pool := range k.Pools.Iterate(ctx, collections.Range[asset.Pair]{}).Values() {
 		snapshot := types.NewReserveSnapshot(
 			pool.Pair,
@@ -32,5 +33,6 @@ func EndBlocker(ctx sdk.Context, k keeper.Keeper) []abci.ValidatorUpdate {
 			BlockTimestamp: ctx.BlockTime(),
 		})
 	}
+	k.PruneReserveSnapshots(ctx, perpKeeper.TwapLookbackWindow(ctx))
 	return []abci.ValidatorUpdate{}
 }
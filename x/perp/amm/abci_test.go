@@ -22,13 +22,14 @@ func TestSnapshotUpdates(t *testing.T) {
 	perpammKeeper := nibiruApp.PerpAmmKeeper
 
 	runBlock := func(duration time.Duration) {
-		perpamm.EndBlocker(ctx, nibiruApp.PerpAmmKeeper)
+		perpamm.EndBlocker(ctx, nibiruApp.PerpAmmKeeper, nibiruApp.PerpKeeper)
 		ctx = ctx.
 			WithBlockHeight(ctx.BlockHeight() + 1).
 			WithBlockTime(ctx.BlockTime().Add(duration))
 	}
 
 	ctx = ctx.WithBlockTime(time.Date(2015, 10, 21, 0, 0, 0, 0, time.UTC)).WithBlockHeight(1)
+	nibiruApp.OracleKeeper.SetPrice(ctx, asset.Registry.Pair(denoms.BTC, denoms.NUSD), sdk.NewDec(20000))
 
 	require.NoError(t, perpammKeeper.CreatePool(
 		/* ctx */ ctx,
@@ -28,6 +28,8 @@ func TestGenesis(t *testing.T) {
 				MaxOracleSpreadRatio:   sdk.MustNewDecFromStr("0.20"),
 				MaintenanceMarginRatio: sdk.MustNewDecFromStr("0.0625"),
 				MaxLeverage:            sdk.MustNewDecFromStr("15"),
+				MaxOpenInterest:        sdk.ZeroDec(),
+				MaxPositionNotional:    sdk.ZeroDec(),
 			},
 			TotalLong:     sdk.ZeroDec(),
 			TotalShort:    sdk.ZeroDec(),
@@ -44,6 +46,8 @@ func TestGenesis(t *testing.T) {
 				MaxOracleSpreadRatio:   sdk.MustNewDecFromStr("0.30"),
 				MaintenanceMarginRatio: sdk.MustNewDecFromStr("0.0625"),
 				MaxLeverage:            sdk.MustNewDecFromStr("15"),
+				MaxOpenInterest:        sdk.ZeroDec(),
+				MaxPositionNotional:    sdk.ZeroDec(),
 			},
 			TotalLong:     sdk.NewDec(0),
 			TotalShort:    sdk.NewDec(0),
@@ -75,7 +75,7 @@ func (k Keeper) SwapBaseForQuote(
 		return market, sdk.ZeroDec(), nil
 	}
 
-	if _, err = k.oracleKeeper.GetExchangeRate(ctx, market.Pair); err != nil {
+	if _, err = k.oracleKeeper.GetExchangeRateWithMaxAge(ctx, market.Pair); err != nil {
 		return market, sdk.Dec{}, types.ErrNoValidPrice.Wrapf("%s", market.Pair)
 	}
 
@@ -169,7 +169,7 @@ func (k Keeper) SwapQuoteForBase(
 		return types.Market{}, sdk.ZeroDec(), nil
 	}
 
-	if _, err = k.oracleKeeper.GetExchangeRate(ctx, market.Pair); err != nil {
+	if _, err = k.oracleKeeper.GetExchangeRateWithMaxAge(ctx, market.Pair); err != nil {
 		return types.Market{}, sdk.Dec{}, types.ErrNoValidPrice.Wrapf("%s", market.Pair)
 	}
 
@@ -286,7 +286,7 @@ func (k Keeper) IsOverSpreadLimit(ctx sdk.Context, pair asset.Pair) (bool, error
 		return false, err
 	}
 
-	indexPrice, err := k.oracleKeeper.GetExchangeRate(ctx, pair)
+	indexPrice, err := k.oracleKeeper.GetExchangeRateWithMaxAge(ctx, pair)
 	if err != nil {
 		return false, err
 	}
@@ -160,7 +160,7 @@ func TestSwapQuoteForBase(t *testing.T) {
 			oracleKeeper := mock.NewMockOracleKeeper(gomock.NewController(t))
 			perpammKeeper, ctx := PerpAmmKeeper(t, oracleKeeper)
 
-			oracleKeeper.EXPECT().GetExchangeRate(gomock.Any(), gomock.Any()).Return(sdk.NewDec(1), nil).AnyTimes()
+			oracleKeeper.EXPECT().GetExchangeRateWithMaxAge(gomock.Any(), gomock.Any()).Return(sdk.NewDec(1), nil).AnyTimes()
 
 			assert.NoError(t, perpammKeeper.CreatePool(
 				ctx,
@@ -356,7 +356,7 @@ func TestSwapBaseForQuote(t *testing.T) {
 
 			perpammKeeper, ctx := PerpAmmKeeper(t, pfKeeper)
 			pfKeeper.EXPECT().
-				GetExchangeRate(gomock.Any(), gomock.Any()).Return(sdk.NewDec(1), nil).AnyTimes()
+				GetExchangeRateWithMaxAge(gomock.Any(), gomock.Any()).Return(sdk.NewDec(1), nil).AnyTimes()
 
 			assert.NoError(t, perpammKeeper.CreatePool(
 				ctx,
@@ -424,6 +424,8 @@ func TestGetMarkets(t *testing.T) {
 			MaxOracleSpreadRatio:   sdk.OneDec(),
 			MaintenanceMarginRatio: sdk.MustNewDecFromStr("0.0625"),
 			MaxLeverage:            sdk.MustNewDecFromStr("15"),
+			MaxOpenInterest:        sdk.ZeroDec(),
+			MaxPositionNotional:    sdk.ZeroDec(),
 		},
 		sdk.NewDec(2),
 	))
@@ -438,6 +440,8 @@ func TestGetMarkets(t *testing.T) {
 			MaxOracleSpreadRatio:   sdk.OneDec(),
 			MaintenanceMarginRatio: sdk.MustNewDecFromStr("0.0625"),
 			MaxLeverage:            sdk.MustNewDecFromStr("15"),
+			MaxOpenInterest:        sdk.ZeroDec(),
+			MaxPositionNotional:    sdk.ZeroDec(),
 		},
 		sdk.MustNewDecFromStr("0.5"),
 	))
@@ -456,6 +460,8 @@ func TestGetMarkets(t *testing.T) {
 			MaxOracleSpreadRatio:   sdk.OneDec(),
 			MaintenanceMarginRatio: sdk.MustNewDecFromStr("0.0625"),
 			MaxLeverage:            sdk.MustNewDecFromStr("15"),
+			MaxOpenInterest:        sdk.ZeroDec(),
+			MaxPositionNotional:    sdk.ZeroDec(),
 		},
 		TotalLong:     sdk.ZeroDec(),
 		TotalShort:    sdk.ZeroDec(),
@@ -471,6 +477,8 @@ func TestGetMarkets(t *testing.T) {
 			MaxOracleSpreadRatio:   sdk.OneDec(),
 			MaintenanceMarginRatio: sdk.MustNewDecFromStr("0.0625"),
 			MaxLeverage:            sdk.MustNewDecFromStr("15"),
+			MaxOpenInterest:        sdk.ZeroDec(),
+			MaxPositionNotional:    sdk.ZeroDec(),
 		},
 		TotalLong:     sdk.ZeroDec(),
 		TotalShort:    sdk.ZeroDec(),
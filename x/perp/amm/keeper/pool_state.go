@@ -195,7 +195,7 @@ func (k Keeper) GetPoolPrices(
 		return types.PoolPrices{}, err
 	}
 
-	indexPrice, err := k.oracleKeeper.GetExchangeRate(ctx, pool.Pair)
+	indexPrice, err := k.oracleKeeper.GetExchangeRateWithMaxAge(ctx, pool.Pair)
 	if err != nil {
 		// fail gracefully so that market queries run even if the oracle price feeds stop
 		k.Logger(ctx).Error(err.Error())
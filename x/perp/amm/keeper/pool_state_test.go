@@ -76,6 +76,8 @@ func TestEditPoolConfig(t *testing.T) {
 			FluctuationLimitRatio:  sdk.MustNewDecFromStr("0.1"),
 			MaintenanceMarginRatio: sdk.MustNewDecFromStr("0.0625"),
 			MaxLeverage:            sdk.MustNewDecFromStr("15"),
+			MaxOpenInterest:        sdk.ZeroDec(),
+			MaxPositionNotional:    sdk.ZeroDec(),
 			MaxOracleSpreadRatio:   sdk.MustNewDecFromStr("0.1"),
 			TradeLimitRatio:        sdk.MustNewDecFromStr("0.9"),
 		},
@@ -396,7 +398,7 @@ func TestGetPoolPrices(t *testing.T) {
 
 			t.Log("mock oracleKeeper index price")
 			mocks.mockOracleKeeper.EXPECT().
-				GetExchangeRate(ctx, tc.market.Pair).
+				GetExchangeRateWithMaxAge(ctx, tc.market.Pair).
 				Return(tc.mockIndexPrice, tc.oracleKeeperErr).
 				AnyTimes()
 
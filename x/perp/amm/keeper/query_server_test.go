@@ -85,7 +85,7 @@ func TestQueryAllPools(t *testing.T) {
 	ctx = ctx.WithBlockHeight(2).WithBlockTime(time.Now().Add(5 * time.Second))
 	indexPrice := sdk.NewDec(25_000)
 	mocks.mockOracleKeeper.EXPECT().
-		GetExchangeRate(ctx, pair).
+		GetExchangeRateWithMaxAge(ctx, pair).
 		Return(indexPrice, nil)
 	resp, err := queryServer.AllPools(
 		sdk.WrapSDKContext(ctx),
@@ -1,8 +1,12 @@
 package keeper
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	"github.com/NibiruChain/collections"
+
 	"github.com/NibiruChain/nibiru/x/common"
 	"github.com/NibiruChain/nibiru/x/common/asset"
 	"github.com/NibiruChain/nibiru/x/perp/amm/types"
@@ -104,3 +108,56 @@ func getPriceWithSnapshot(
 
 	return sdk.ZeroDec(), nil
 }
+
+const (
+	// ReserveSnapshotRetention is how long reserve snapshots are kept, unless
+	// the twap lookback window of x/perp is longer.
+	ReserveSnapshotRetention = 24 * time.Hour
+
+	// MaxPrunedReserveSnapshotsPerBlock bounds the number of reserve snapshots
+	// deleted by PruneReserveSnapshots in a single block.
+	MaxPrunedReserveSnapshotsPerBlock = 100
+)
+
+/*
+PruneReserveSnapshots deletes the reserve snapshots older than
+ReserveSnapshotRetention, or than the twap lookback window if it is longer, at
+most MaxPrunedReserveSnapshotsPerBlock of them. The last snapshot before the
+cutoff is kept since the twap starts from its price.
+
+args:
+  - ctx: the cosmos-sdk context
+  - twapLookbackWindow: the twap lookback window of x/perp
+*/
+func (k Keeper) PruneReserveSnapshots(ctx sdk.Context, twapLookbackWindow time.Duration) {
+	retention := ReserveSnapshotRetention
+	if retention < twapLookbackWindow {
+		retention = twapLookbackWindow
+	}
+
+	var staleKeys []collections.Pair[asset.Pair, time.Time]
+	for _, pair := range k.Pools.Iterate(ctx, collections.Range[asset.Pair]{}).Keys() {
+		budget := MaxPrunedReserveSnapshotsPerBlock - len(staleKeys)
+		if budget <= 0 {
+			break
+		}
+
+		// read one key past the budget: the last snapshot before the cutoff is kept
+		var keys []collections.Pair[asset.Pair, time.Time]
+		iter := k.ReserveSnapshots.Iterate(ctx, collections.PairRange[asset.Pair, time.Time]{}.
+			Prefix(pair).
+			EndExclusive(ctx.BlockTime().Add(-retention)))
+		for ; iter.Valid() && len(keys) <= budget; iter.Next() {
+			keys = append(keys, iter.Key())
+		}
+		iter.Close()
+
+		if len(keys) > 0 {
+			staleKeys = append(staleKeys, keys[:len(keys)-1]...)
+		}
+	}
+
+	for _, key := range staleKeys {
+		_ = k.ReserveSnapshots.Delete(ctx, key)
+	}
+}
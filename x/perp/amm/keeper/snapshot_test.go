@@ -7,6 +7,8 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 
+	"github.com/NibiruChain/collections"
+
 	"github.com/NibiruChain/nibiru/x/common/asset"
 	"github.com/NibiruChain/nibiru/x/common/denoms"
 	"github.com/NibiruChain/nibiru/x/perp/amm/types"
@@ -131,3 +133,54 @@ func TestGetSnapshotPrice(t *testing.T) {
 		})
 	}
 }
+
+func TestPruneReserveSnapshots(t *testing.T) {
+	pair := asset.Registry.Pair(denoms.BTC, denoms.NUSD)
+	startTime := time.UnixMilli(1_000_000).UTC()
+
+	// a snapshot every hour for 200 hours
+	setup := func(t *testing.T) (Keeper, sdk.Context) {
+		perpammKeeper, ctx := PerpAmmKeeper(t, nil)
+		perpammKeeper.Pools.Insert(ctx, pair, types.Market{Pair: pair})
+		for i := 0; i < 200; i++ {
+			blockTime := startTime.Add(time.Duration(i) * time.Hour)
+			perpammKeeper.ReserveSnapshots.Insert(ctx, collections.Join(pair, blockTime),
+				types.NewReserveSnapshot(pair, sdk.OneDec(), sdk.OneDec(), sdk.OneDec(), blockTime))
+		}
+		return perpammKeeper, ctx.WithBlockTime(startTime.Add(199 * time.Hour))
+	}
+
+	snapshotTimes := func(perpammKeeper Keeper, ctx sdk.Context) (times []time.Time) {
+		rng := collections.PairRange[asset.Pair, time.Time]{}.Prefix(pair)
+		for _, key := range perpammKeeper.ReserveSnapshots.Iterate(ctx, rng).Keys() {
+			times = append(times, key.K2())
+		}
+		return times
+	}
+
+	t.Run("deletions are bounded per block", func(t *testing.T) {
+		perpammKeeper, ctx := setup(t)
+
+		// the snapshots at 0..174h are older than the retention, the one at 174h is kept
+		perpammKeeper.PruneReserveSnapshots(ctx, 15*time.Minute)
+		require.Len(t, snapshotTimes(perpammKeeper, ctx), 200-MaxPrunedReserveSnapshotsPerBlock)
+
+		perpammKeeper.PruneReserveSnapshots(ctx, 15*time.Minute)
+		times := snapshotTimes(perpammKeeper, ctx)
+		require.Len(t, times, 26)
+		require.Equal(t, startTime.Add(174*time.Hour), times[0])
+
+		perpammKeeper.PruneReserveSnapshots(ctx, 15*time.Minute)
+		require.Len(t, snapshotTimes(perpammKeeper, ctx), 26)
+	})
+
+	t.Run("the twap lookback window is always kept", func(t *testing.T) {
+		perpammKeeper, ctx := setup(t)
+
+		perpammKeeper.PruneReserveSnapshots(ctx, 48*time.Hour)
+		perpammKeeper.PruneReserveSnapshots(ctx, 48*time.Hour)
+		times := snapshotTimes(perpammKeeper, ctx)
+		require.Len(t, times, 50)
+		require.Equal(t, startTime.Add(150*time.Hour), times[0])
+	})
+}
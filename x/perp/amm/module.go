@@ -104,17 +104,20 @@ type AppModule struct {
 
 	keeper       keeper.Keeper
 	oracleKeeper types.OracleKeeper
+	perpKeeper   types.PerpKeeper
 }
 
 func NewAppModule(
 	cdc codec.Codec,
 	keeper keeper.Keeper,
 	oracleKeeper types.OracleKeeper,
+	perpKeeper types.PerpKeeper,
 ) AppModule {
 	return AppModule{
 		AppModuleBasic: NewAppModuleBasic(cdc),
 		keeper:         keeper,
 		oracleKeeper:   oracleKeeper,
+		perpKeeper:     perpKeeper,
 	}
 }
 
@@ -177,7 +180,7 @@ func (am AppModule) BeginBlock(_ sdk.Context, _ abci.RequestBeginBlock) {}
 // EndBlock executes all ABCI EndBlock logic respective to the capability module. It
 // returns no validator updates.
 func (am AppModule) EndBlock(ctx sdk.Context, _ abci.RequestEndBlock) []abci.ValidatorUpdate {
-	return EndBlocker(ctx, am.keeper)
+	return EndBlocker(ctx, am.keeper, am.perpKeeper)
 }
 
 // ____________________________________________________________________________
@@ -1,12 +1,20 @@
 package types
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/NibiruChain/nibiru/x/common/asset"
 )
 
 type OracleKeeper interface {
-	GetExchangeRate(ctx sdk.Context, pair asset.Pair) (sdk.Dec, error)
+	GetExchangeRateWithMaxAge(ctx sdk.Context, pair asset.Pair) (sdk.Dec, error)
 	SetPrice(ctx sdk.Context, pair asset.Pair, price sdk.Dec)
 }
+
+// PerpKeeper is the x/perp keeper, whose twap lookback window the reserve
+// snapshots must cover.
+type PerpKeeper interface {
+	TwapLookbackWindow(ctx sdk.Context) time.Duration
+}
@@ -352,6 +352,20 @@ func (cfg *MarketConfig) Validate() error {
 		return fmt.Errorf("margin ratio opened with max leverage position will be lower than Maintenance margin ratio")
 	}
 
+	if err := validateMarginTiers(cfg.MarginTierTable()); err != nil {
+		return err
+	}
+
+	// the caps are empty in configs stored before they were introduced, which
+	// disables them like a zero cap
+	if !cfg.MaxOpenInterest.IsNil() && cfg.MaxOpenInterest.IsNegative() {
+		return fmt.Errorf("max open interest must be >= 0, not %s", cfg.MaxOpenInterest)
+	}
+
+	if !cfg.MaxPositionNotional.IsNil() && cfg.MaxPositionNotional.IsNegative() {
+		return fmt.Errorf("max position notional must be >= 0, not %s", cfg.MaxPositionNotional)
+	}
+
 	return nil
 }
 
@@ -363,7 +377,9 @@ func DefaultMarketConfig() *MarketConfig {
 		MaintenanceMarginRatio: sdk.MustNewDecFromStr("0.0625"),
 		// 0.0625 = 1 / 16. This implies that an effective leverage of 16x is
 		// what defines the liquidation threshold and maintenance margin ratio.
-		MaxLeverage: sdk.NewDec(10),
+		MaxLeverage:         sdk.NewDec(10),
+		MaxOpenInterest:     sdk.ZeroDec(),
+		MaxPositionNotional: sdk.ZeroDec(),
 	}
 }
 
@@ -373,6 +389,9 @@ func (poolCfg *MarketConfig) SetConfig(cfg MarketConfig) *MarketConfig {
 	poolCfg.MaxOracleSpreadRatio = cfg.MaxOracleSpreadRatio
 	poolCfg.MaintenanceMarginRatio = cfg.MaintenanceMarginRatio
 	poolCfg.MaxLeverage = cfg.MaxLeverage
+	poolCfg.MarginTiers = cfg.MarginTiers
+	poolCfg.MaxOpenInterest = cfg.MaxOpenInterest
+	poolCfg.MaxPositionNotional = cfg.MaxPositionNotional
 	return poolCfg
 }
 
@@ -406,6 +425,24 @@ func (poolCfg *MarketConfig) WithMaxLeverage(value sdk.Dec) *MarketConfig {
 	return newPoolCfg
 }
 
+func (poolCfg *MarketConfig) WithMarginTiers(tiers ...MarginTier) *MarketConfig {
+	newPoolCfg := new(MarketConfig).SetConfig(*poolCfg)
+	newPoolCfg.MarginTiers = tiers
+	return newPoolCfg
+}
+
+func (poolCfg *MarketConfig) WithMaxOpenInterest(value sdk.Dec) *MarketConfig {
+	newPoolCfg := new(MarketConfig).SetConfig(*poolCfg)
+	newPoolCfg.MaxOpenInterest = value
+	return newPoolCfg
+}
+
+func (poolCfg *MarketConfig) WithMaxPositionNotional(value sdk.Dec) *MarketConfig {
+	newPoolCfg := new(MarketConfig).SetConfig(*poolCfg)
+	newPoolCfg.MaxPositionNotional = value
+	return newPoolCfg
+}
+
 // ----------------------------------------------------------------------------
 // Market - validation functions
 // ----------------------------------------------------------------------------
@@ -596,6 +596,44 @@ func TestPool_Validate(t *testing.T) {
 		},
 	}
 
+	tieredMarket := func(tiers ...MarginTier) *Market {
+		return &Market{
+			Pair:          asset.MustNewPair("btc:usd"),
+			BaseReserve:   sdk.OneDec(),
+			QuoteReserve:  sdk.OneDec(),
+			PegMultiplier: sdk.OneDec(),
+			SqrtDepth:     common.MustSqrtDec(sdk.NewDec(1)),
+			Config: MarketConfig{
+				FluctuationLimitRatio:  sdk.MustNewDecFromStr("0.10"),
+				MaintenanceMarginRatio: sdk.MustNewDecFromStr("0.0625"),
+				MaxLeverage:            sdk.MustNewDecFromStr("10"),
+				MaxOracleSpreadRatio:   sdk.MustNewDecFromStr("0.10"),
+				TradeLimitRatio:        sdk.MustNewDecFromStr("0.10"),
+				MarginTiers:            tiers,
+			},
+		}
+	}
+	cases["valid margin tiers"] = test{
+		m: tieredMarket(
+			MarginTier{NotionalFloor: sdk.NewDec(1000), MaintenanceMarginRatio: sdk.MustNewDecFromStr("0.1"), MaxLeverage: sdk.NewDec(5)},
+			MarginTier{NotionalFloor: sdk.NewDec(5000), MaintenanceMarginRatio: sdk.MustNewDecFromStr("0.2"), MaxLeverage: sdk.NewDec(2)},
+		),
+		expectErr: false,
+	}
+	cases["margin tiers not sorted by notional floor"] = test{
+		m: tieredMarket(
+			MarginTier{NotionalFloor: sdk.NewDec(5000), MaintenanceMarginRatio: sdk.MustNewDecFromStr("0.1"), MaxLeverage: sdk.NewDec(5)},
+			MarginTier{NotionalFloor: sdk.NewDec(1000), MaintenanceMarginRatio: sdk.MustNewDecFromStr("0.2"), MaxLeverage: sdk.NewDec(2)},
+		),
+		expectErr: true,
+	}
+	cases["margin tier with a higher max leverage than the base tier"] = test{
+		m: tieredMarket(
+			MarginTier{NotionalFloor: sdk.NewDec(1000), MaintenanceMarginRatio: sdk.MustNewDecFromStr("0.0625"), MaxLeverage: sdk.NewDec(12)},
+		),
+		expectErr: true,
+	}
+
 	for name, tc := range cases {
 		tc := tc
 		t.Run(name, func(t *testing.T) {
@@ -876,6 +914,8 @@ func TestMarketConfigWith(t *testing.T) {
 		MaxOracleSpreadRatio:   sdk.NewDec(56),
 		MaintenanceMarginRatio: sdk.NewDec(78),
 		MaxLeverage:            sdk.NewDec(910),
+		MaxOpenInterest:        sdk.NewDec(1112),
+		MaxPositionNotional:    sdk.NewDec(1314),
 	}
 
 	var newMarketCfg MarketConfig
@@ -906,6 +946,20 @@ func TestMarketConfigWith(t *testing.T) {
 			newMarketCfg = *marketCfg.WithMaxLeverage(marketCfgUpdates.MaxLeverage)
 			assert.EqualValues(t, marketCfgUpdates.MaxLeverage, newMarketCfg.MaxLeverage)
 		}},
+		{Name: "WithMaxOpenInterest", Test: func() {
+			assert.NotEqualValues(t, marketCfgUpdates.MaxOpenInterest, marketCfg.MaxOpenInterest)
+			newMarketCfg = *marketCfg.WithMaxOpenInterest(marketCfgUpdates.MaxOpenInterest)
+			assert.EqualValues(t, marketCfgUpdates.MaxOpenInterest, newMarketCfg.MaxOpenInterest)
+		}},
+		{Name: "WithMaxPositionNotional", Test: func() {
+			assert.NotEqualValues(t, marketCfgUpdates.MaxPositionNotional, marketCfg.MaxPositionNotional)
+			newMarketCfg = *marketCfg.WithMaxPositionNotional(marketCfgUpdates.MaxPositionNotional)
+			assert.EqualValues(t, marketCfgUpdates.MaxPositionNotional, newMarketCfg.MaxPositionNotional)
+		}},
+		{Name: "negative caps are invalid", Test: func() {
+			assert.Error(t, marketCfg.WithMaxOpenInterest(sdk.NewDec(-1)).Validate())
+			assert.Error(t, marketCfg.WithMaxPositionNotional(sdk.NewDec(-1)).Validate())
+		}},
 	}
 
 	testutil.RunFunctionTests(t, testCases)
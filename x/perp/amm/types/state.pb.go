@@ -161,6 +161,16 @@ type MarketConfig struct {
 	MaintenanceMarginRatio github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=maintenance_margin_ratio,json=maintenanceMarginRatio,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"maintenance_margin_ratio"`
 	// max_leverage
 	MaxLeverage github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=max_leverage,json=maxLeverage,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_leverage"`
+	// margin tiers of positions with a notional above the first tier floor,
+	// sorted by notional floor. Positions below the first tier use
+	// maintenance_margin_ratio and max_leverage.
+	MarginTiers []MarginTier `protobuf:"bytes,6,rep,name=margin_tiers,json=marginTiers,proto3" json:"margin_tiers"`
+	// the most open interest, in base asset units, either side of the market
+	// can have. Zero disables the cap.
+	MaxOpenInterest github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,7,opt,name=max_open_interest,json=maxOpenInterest,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_open_interest"`
+	// the most notional, in quote asset units, a single position can have.
+	// Zero disables the cap.
+	MaxPositionNotional github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,8,opt,name=max_position_notional,json=maxPositionNotional,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_position_notional"`
 }
 
 func (m *MarketConfig) Reset()         { *m = MarketConfig{} }
@@ -196,6 +206,56 @@ func (m *MarketConfig) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MarketConfig proto.InternalMessageInfo
 
+func (m *MarketConfig) GetMarginTiers() []MarginTier {
+	if m != nil {
+		return m.MarginTiers
+	}
+	return nil
+}
+
+// A band of position notional with its own maintenance margin ratio and max
+// leverage. A tier applies to positions whose notional is at least its floor
+// and below the floor of the next tier.
+type MarginTier struct {
+	// the smallest position notional, in quote asset units, of the tier
+	NotionalFloor          github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=notional_floor,json=notionalFloor,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"notional_floor"`
+	MaintenanceMarginRatio github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=maintenance_margin_ratio,json=maintenanceMarginRatio,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"maintenance_margin_ratio"`
+	MaxLeverage            github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=max_leverage,json=maxLeverage,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_leverage"`
+}
+
+func (m *MarginTier) Reset()         { *m = MarginTier{} }
+func (m *MarginTier) String() string { return proto.CompactTextString(m) }
+func (*MarginTier) ProtoMessage()    {}
+func (*MarginTier) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ccae3672192ecda2, []int{2}
+}
+func (m *MarginTier) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MarginTier) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MarginTier.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MarginTier) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MarginTier.Merge(m, src)
+}
+func (m *MarginTier) XXX_Size() int {
+	return m.Size()
+}
+func (m *MarginTier) XXX_DiscardUnknown() {
+	xxx_messageInfo_MarginTier.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MarginTier proto.InternalMessageInfo
+
 // CurrentTWAP states defines the numerator and denominator for the TWAP
 // calculation
 type CurrentTWAP struct {
@@ -209,7 +269,7 @@ func (m *CurrentTWAP) Reset()         { *m = CurrentTWAP{} }
 func (m *CurrentTWAP) String() string { return proto.CompactTextString(m) }
 func (*CurrentTWAP) ProtoMessage()    {}
 func (*CurrentTWAP) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ccae3672192ecda2, []int{2}
+	return fileDescriptor_ccae3672192ecda2, []int{3}
 }
 func (m *CurrentTWAP) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -261,7 +321,7 @@ func (m *ReserveSnapshot) Reset()         { *m = ReserveSnapshot{} }
 func (m *ReserveSnapshot) String() string { return proto.CompactTextString(m) }
 func (*ReserveSnapshot) ProtoMessage()    {}
 func (*ReserveSnapshot) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ccae3672192ecda2, []int{3}
+	return fileDescriptor_ccae3672192ecda2, []int{4}
 }
 func (m *ReserveSnapshot) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -320,7 +380,7 @@ func (m *PoolPrices) Reset()         { *m = PoolPrices{} }
 func (m *PoolPrices) String() string { return proto.CompactTextString(m) }
 func (*PoolPrices) ProtoMessage()    {}
 func (*PoolPrices) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ccae3672192ecda2, []int{4}
+	return fileDescriptor_ccae3672192ecda2, []int{5}
 }
 func (m *PoolPrices) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -375,6 +435,7 @@ func init() {
 	proto.RegisterEnum("nibiru.perp.amm.v1.TwapCalcOption", TwapCalcOption_name, TwapCalcOption_value)
 	proto.RegisterType((*Market)(nil), "nibiru.perp.amm.v1.Market")
 	proto.RegisterType((*MarketConfig)(nil), "nibiru.perp.amm.v1.MarketConfig")
+	proto.RegisterType((*MarginTier)(nil), "nibiru.perp.amm.v1.MarginTier")
 	proto.RegisterType((*CurrentTWAP)(nil), "nibiru.perp.amm.v1.CurrentTWAP")
 	proto.RegisterType((*ReserveSnapshot)(nil), "nibiru.perp.amm.v1.ReserveSnapshot")
 	proto.RegisterType((*PoolPrices)(nil), "nibiru.perp.amm.v1.PoolPrices")
@@ -383,65 +444,72 @@ func init() {
 func init() { proto.RegisterFile("perp/amm/v1/state.proto", fileDescriptor_ccae3672192ecda2) }
 
 var fileDescriptor_ccae3672192ecda2 = []byte{
-	// 922 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x96, 0x31, 0x6f, 0xdb, 0x46,
-	0x14, 0xc7, 0x25, 0x59, 0x56, 0xac, 0x27, 0xd9, 0x56, 0xaf, 0x76, 0xcd, 0xa4, 0x85, 0xe4, 0xaa,
-	0x40, 0x11, 0x04, 0x28, 0x09, 0xb7, 0x53, 0x53, 0xa0, 0x80, 0x2d, 0xb9, 0x89, 0x00, 0xc9, 0x92,
-	0x49, 0x19, 0x01, 0xb2, 0x10, 0x27, 0xea, 0x4c, 0x1d, 0xcc, 0xbb, 0x63, 0x8e, 0x27, 0x47, 0x59,
-	0xbb, 0x76, 0xe9, 0x98, 0xb1, 0x1f, 0x27, 0x63, 0xc6, 0xa2, 0x83, 0x51, 0xd8, 0xdf, 0xa0, 0x43,
-	0x97, 0x2e, 0xc5, 0x1d, 0x19, 0x5b, 0x46, 0x03, 0x14, 0x21, 0x9c, 0x49, 0xba, 0x77, 0x8f, 0xbf,
-	0xff, 0xe3, 0x7b, 0x0f, 0x7f, 0x10, 0x76, 0x62, 0x22, 0x63, 0x07, 0x33, 0xe6, 0x9c, 0xef, 0x39,
-	0x89, 0xc2, 0x8a, 0xd8, 0xb1, 0x14, 0x4a, 0x20, 0xc4, 0xe9, 0x84, 0xca, 0xb9, 0xad, 0xef, 0x6d,
-	0xcc, 0x98, 0x7d, 0xbe, 0xf7, 0xe0, 0x7e, 0x20, 0x12, 0x26, 0x12, 0xdf, 0x64, 0x38, 0xe9, 0x21,
-	0x4d, 0x7f, 0xb0, 0x15, 0x8a, 0x50, 0xa4, 0x71, 0xfd, 0x2f, 0x8d, 0xb6, 0x7f, 0x59, 0x85, 0xca,
-	0x00, 0xcb, 0x33, 0xa2, 0xd0, 0x00, 0xca, 0x31, 0xa6, 0xd2, 0x2a, 0xee, 0x16, 0x1f, 0x56, 0x0f,
-	0xbe, 0x7f, 0x73, 0xd1, 0x2a, 0xfc, 0x71, 0xd1, 0xda, 0x0b, 0xa9, 0x9a, 0xcd, 0x27, 0x76, 0x20,
-	0x98, 0x73, 0x64, 0x04, 0x3b, 0x33, 0x4c, 0xb9, 0x93, 0x8a, 0x3b, 0x0b, 0x27, 0x10, 0x8c, 0x09,
-	0xee, 0xe0, 0x24, 0x21, 0xca, 0x1e, 0x61, 0x2a, 0x5d, 0x83, 0x41, 0xc7, 0x50, 0x9f, 0xe0, 0x84,
-	0xf8, 0x92, 0x24, 0x44, 0x9e, 0x13, 0xab, 0x64, 0xb0, 0x76, 0x86, 0xfd, 0x7a, 0x09, 0x9b, 0x96,
-	0x99, 0xfd, 0x7c, 0x93, 0x4c, 0xcf, 0x1c, 0xf5, 0x2a, 0x26, 0x89, 0xdd, 0x25, 0x81, 0x5b, 0xd3,
-	0x0c, 0x37, 0x45, 0x20, 0x0f, 0xd6, 0x5f, 0xcc, 0x85, 0xba, 0x61, 0xae, 0xe4, 0x62, 0xd6, 0x0d,
-	0xe4, 0x1d, 0xf4, 0x47, 0xa8, 0x04, 0x82, 0x9f, 0xd2, 0xd0, 0x2a, 0xef, 0x16, 0x1f, 0xd6, 0xbe,
-	0xdd, 0xb5, 0xff, 0xdb, 0x57, 0x3b, 0x6d, 0x51, 0xc7, 0xe4, 0x1d, 0x94, 0xb5, 0x9e, 0x9b, 0x3d,
-	0x85, 0x06, 0x00, 0xc9, 0x0b, 0xa9, 0xfc, 0x29, 0x89, 0xd5, 0xcc, 0x5a, 0xcd, 0x55, 0x51, 0x55,
-	0x13, 0xba, 0x1a, 0xa0, 0x71, 0x4a, 0x28, 0x1c, 0xf9, 0x91, 0xe0, 0xa1, 0x55, 0xc9, 0x87, 0x33,
-	0x84, 0xbe, 0xe0, 0x21, 0x1a, 0x42, 0x2d, 0xc5, 0x25, 0x33, 0x21, 0x95, 0x75, 0x2f, 0x17, 0x2f,
-	0xad, 0xc8, 0xd3, 0x04, 0x74, 0x02, 0x1b, 0x31, 0x09, 0x7d, 0x36, 0x8f, 0x14, 0x8d, 0x23, 0x4a,
-	0xa4, 0xb5, 0x96, 0x8b, 0xb9, 0x1e, 0x93, 0x70, 0x70, 0x0d, 0x79, 0x5c, 0x7e, 0xfd, 0x5b, 0xab,
-	0xd0, 0xfe, 0x7b, 0x05, 0xea, 0xcb, 0xad, 0x46, 0xcf, 0xe1, 0x13, 0x25, 0xf1, 0x94, 0xf8, 0x11,
-	0x65, 0x54, 0xf9, 0x12, 0x2b, 0x2a, 0xb2, 0x05, 0xfd, 0x50, 0xc1, 0x4d, 0x03, 0xea, 0x6b, 0x8e,
-	0xab, 0x31, 0xe8, 0x14, 0x76, 0x4e, 0xa3, 0x79, 0xa0, 0xe6, 0xfa, 0xc4, 0x6f, 0x29, 0xe4, 0xdb,
-	0xd5, 0xed, 0x25, 0xdc, 0x92, 0x0e, 0x81, 0x1d, 0x86, 0x17, 0xbe, 0x90, 0x38, 0x88, 0x88, 0x9f,
-	0xc4, 0x92, 0xe0, 0x69, 0xa6, 0x93, 0x6f, 0x7f, 0xb7, 0x18, 0x5e, 0x0c, 0x0d, 0xcd, 0x33, 0xb0,
-	0x54, 0x66, 0x06, 0x16, 0xc3, 0x94, 0x2b, 0xc2, 0x31, 0x0f, 0x88, 0xcf, 0xb0, 0x0c, 0x29, 0xcf,
-	0x74, 0xca, 0xb9, 0x74, 0x3e, 0x5b, 0xe2, 0x0d, 0x0c, 0x2e, 0x55, 0x3a, 0x86, 0xba, 0x7e, 0xa1,
-	0x88, 0x9c, 0x13, 0x89, 0x43, 0x92, 0x73, 0xe7, 0x6b, 0x0c, 0x2f, 0xfa, 0x19, 0xa2, 0xfd, 0xba,
-	0x04, 0xb5, 0xce, 0x5c, 0x4a, 0xc2, 0xd5, 0xf8, 0xd9, 0xfe, 0x08, 0x7d, 0x05, 0xf7, 0xb4, 0x89,
-	0xf8, 0x74, 0x9a, 0x4d, 0x1b, 0x2e, 0x2f, 0x5a, 0x15, 0xed, 0x2e, 0xbd, 0xae, 0x5b, 0xd1, 0x57,
-	0xbd, 0x29, 0xea, 0x43, 0x95, 0xcf, 0x19, 0x91, 0x58, 0x09, 0x99, 0x73, 0x64, 0x37, 0x00, 0x34,
-	0x82, 0xda, 0x94, 0x70, 0xc1, 0x28, 0x37, 0xbc, 0x7c, 0xa3, 0x59, 0x46, 0xa0, 0x2e, 0xac, 0xc6,
-	0x92, 0x06, 0x24, 0x67, 0xfb, 0xd3, 0x87, 0xdb, 0x3f, 0xaf, 0xc0, 0x66, 0xe6, 0x55, 0x1e, 0xc7,
-	0x71, 0x32, 0x13, 0x37, 0x56, 0xbd, 0xfa, 0x71, 0xac, 0xba, 0xf8, 0x11, 0xac, 0xba, 0x74, 0x07,
-	0x56, 0xfd, 0x25, 0xd4, 0x15, 0x65, 0x24, 0x51, 0x98, 0xc5, 0x3e, 0x4b, 0x4c, 0x5f, 0x57, 0xdc,
-	0xda, 0x75, 0x6c, 0x90, 0xbc, 0xc7, 0x9e, 0x2a, 0x77, 0x60, 0x4f, 0xed, 0x7f, 0x4a, 0x00, 0x23,
-	0x21, 0xa2, 0x91, 0x1e, 0x49, 0x72, 0xdd, 0xff, 0xea, 0xdd, 0xf4, 0x7f, 0x00, 0xc0, 0xb0, 0x3c,
-	0xf3, 0xd3, 0x6d, 0x81, 0x7c, 0x9b, 0xac, 0x09, 0xa6, 0x3c, 0xd4, 0x82, 0x1a, 0xe5, 0x53, 0xb2,
-	0xc8, 0x78, 0x35, 0xcd, 0x73, 0xc1, 0x84, 0xd2, 0x84, 0xcf, 0xa1, 0xaa, 0x5e, 0xe2, 0x58, 0x7b,
-	0xc4, 0x99, 0x55, 0x37, 0xd7, 0x6b, 0x3a, 0xa0, 0xad, 0x17, 0x71, 0xd8, 0x48, 0xf4, 0x25, 0xe5,
-	0xe7, 0x58, 0x52, 0xcc, 0x95, 0xb5, 0x6e, 0x0a, 0x7a, 0xf2, 0x01, 0x05, 0xf5, 0xb8, 0xfa, 0xeb,
-	0xa2, 0xb5, 0xfd, 0x0a, 0xb3, 0xe8, 0x71, 0xfb, 0x36, 0xad, 0xed, 0xae, 0xeb, 0x40, 0xef, 0xdd,
-	0x59, 0x0f, 0x75, 0x12, 0x89, 0xe0, 0xcc, 0xe7, 0x73, 0x36, 0x21, 0xd2, 0xda, 0x48, 0x87, 0x6a,
-	0x62, 0x47, 0x26, 0xf4, 0xe8, 0x07, 0xa8, 0x76, 0xa9, 0x24, 0x81, 0x36, 0x56, 0x74, 0x1f, 0xb6,
-	0xbb, 0x3d, 0xf7, 0xb0, 0x33, 0xee, 0x0d, 0x8f, 0xfc, 0x93, 0x23, 0x6f, 0x74, 0xd8, 0xe9, 0xfd,
-	0xd4, 0x3b, 0xec, 0x36, 0x0a, 0x68, 0x0d, 0xca, 0xfd, 0xe1, 0xd1, 0x93, 0x46, 0x11, 0x55, 0x61,
-	0xd5, 0x7b, 0x3a, 0x74, 0xc7, 0x8d, 0xd2, 0xa3, 0x10, 0x36, 0xc6, 0x2f, 0x71, 0xdc, 0xc1, 0x51,
-	0x30, 0x8c, 0x0d, 0x61, 0x17, 0xbe, 0xd0, 0x26, 0xe3, 0x77, 0xf6, 0xfb, 0x1d, 0x7f, 0x38, 0x7a,
-	0x3f, 0xc8, 0x1b, 0x0d, 0xc7, 0x8d, 0x22, 0xda, 0x82, 0xc6, 0xf1, 0xc9, 0x70, 0x7c, 0xe8, 0xef,
-	0x7b, 0xde, 0xe1, 0xd8, 0xf7, 0x9e, 0xed, 0x8f, 0x1a, 0x25, 0xf4, 0x29, 0x6c, 0x1e, 0xec, 0x7b,
-	0xb7, 0x82, 0x2b, 0x07, 0x4f, 0xdf, 0x5c, 0x36, 0x8b, 0x6f, 0x2f, 0x9b, 0xc5, 0x3f, 0x2f, 0x9b,
-	0xc5, 0x5f, 0xaf, 0x9a, 0x85, 0xb7, 0x57, 0xcd, 0xc2, 0xef, 0x57, 0xcd, 0xc2, 0x73, 0xfb, 0xff,
-	0x16, 0xe3, 0xfa, 0x13, 0xcf, 0xb4, 0x6f, 0x52, 0x31, 0xdf, 0x66, 0xdf, 0xfd, 0x1b, 0x00, 0x00,
-	0xff, 0xff, 0x7e, 0xb2, 0x43, 0x97, 0xfb, 0x09, 0x00, 0x00,
+	// 1029 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x97, 0xcf, 0x6f, 0xdb, 0x36,
+	0x14, 0xc7, 0xfd, 0xbb, 0xf5, 0xb3, 0x93, 0x78, 0x6c, 0xb2, 0xa8, 0xdd, 0x60, 0x67, 0x1e, 0x30,
+	0x04, 0x05, 0x66, 0x23, 0xdd, 0x69, 0x1d, 0x30, 0x20, 0xb1, 0xd3, 0xd4, 0x80, 0x7f, 0xc5, 0x72,
+	0x50, 0xa0, 0x17, 0x81, 0x96, 0x19, 0x99, 0x88, 0x48, 0xaa, 0x14, 0x9d, 0xba, 0xd7, 0x5d, 0x77,
+	0xd9, 0xb1, 0xc7, 0xfe, 0x39, 0x3d, 0xf6, 0x38, 0xec, 0x10, 0x0c, 0xc9, 0x7f, 0xb0, 0xeb, 0x0e,
+	0x1b, 0x48, 0x29, 0x89, 0x83, 0x75, 0x1b, 0xa6, 0x25, 0x27, 0x45, 0x8f, 0xd4, 0xe7, 0xfb, 0xf8,
+	0xf8, 0x25, 0x5f, 0x0c, 0x9b, 0x01, 0x91, 0x41, 0x13, 0x33, 0xd6, 0x3c, 0xdd, 0x69, 0x86, 0x0a,
+	0x2b, 0xd2, 0x08, 0xa4, 0x50, 0x02, 0x21, 0x4e, 0x27, 0x54, 0xce, 0x1b, 0x7a, 0xbc, 0x81, 0x19,
+	0x6b, 0x9c, 0xee, 0x3c, 0x7a, 0xe8, 0x8a, 0x90, 0x89, 0xd0, 0x31, 0x33, 0x9a, 0xd1, 0x4b, 0x34,
+	0xfd, 0xd1, 0xba, 0x27, 0x3c, 0x11, 0xc5, 0xf5, 0x5f, 0x51, 0xb4, 0xfe, 0x63, 0x1e, 0x0a, 0x3d,
+	0x2c, 0x4f, 0x88, 0x42, 0x3d, 0xc8, 0x05, 0x98, 0x4a, 0x2b, 0xbd, 0x95, 0xde, 0x2e, 0xee, 0x7d,
+	0xfb, 0xfe, 0xac, 0x96, 0xfa, 0xe5, 0xac, 0xb6, 0xe3, 0x51, 0x35, 0x9b, 0x4f, 0x1a, 0xae, 0x60,
+	0xcd, 0xbe, 0x11, 0x6c, 0xcd, 0x30, 0xe5, 0xcd, 0x48, 0xbc, 0xb9, 0x68, 0xba, 0x82, 0x31, 0xc1,
+	0x9b, 0x38, 0x0c, 0x89, 0x6a, 0x0c, 0x31, 0x95, 0x23, 0x83, 0x41, 0x87, 0x50, 0x9e, 0xe0, 0x90,
+	0x38, 0x92, 0x84, 0x44, 0x9e, 0x12, 0x2b, 0x63, 0xb0, 0x8d, 0x18, 0xfb, 0xd5, 0x12, 0x36, 0x4a,
+	0x33, 0x7e, 0x7c, 0x1d, 0x4e, 0x4f, 0x9a, 0xea, 0x4d, 0x40, 0xc2, 0x46, 0x9b, 0xb8, 0xa3, 0x92,
+	0x66, 0x8c, 0x22, 0x04, 0xb2, 0x61, 0xe5, 0xd5, 0x5c, 0xa8, 0x6b, 0x66, 0x36, 0x11, 0xb3, 0x6c,
+	0x20, 0x97, 0xd0, 0xef, 0xa1, 0xe0, 0x0a, 0x7e, 0x4c, 0x3d, 0x2b, 0xb7, 0x95, 0xde, 0x2e, 0x3d,
+	0xd9, 0x6a, 0xfc, 0xb5, 0xae, 0x8d, 0xa8, 0x44, 0x2d, 0x33, 0x6f, 0x2f, 0xa7, 0xf5, 0x46, 0xf1,
+	0x57, 0xa8, 0x07, 0x10, 0xbe, 0x92, 0xca, 0x99, 0x92, 0x40, 0xcd, 0xac, 0x7c, 0xa2, 0x8c, 0x8a,
+	0x9a, 0xd0, 0xd6, 0x00, 0x8d, 0x53, 0x42, 0x61, 0xdf, 0xf1, 0x05, 0xf7, 0xac, 0x42, 0x32, 0x9c,
+	0x21, 0x74, 0x05, 0xf7, 0xd0, 0x00, 0x4a, 0x11, 0x2e, 0x9c, 0x09, 0xa9, 0xac, 0x7b, 0x89, 0x78,
+	0x51, 0x46, 0xb6, 0x26, 0xa0, 0x23, 0x58, 0x0d, 0x88, 0xe7, 0xb0, 0xb9, 0xaf, 0x68, 0xe0, 0x53,
+	0x22, 0xad, 0xfb, 0x89, 0x98, 0x2b, 0x01, 0xf1, 0x7a, 0x57, 0x90, 0xa7, 0xb9, 0xb7, 0xef, 0x6a,
+	0xa9, 0xfa, 0x1f, 0x79, 0x28, 0x2f, 0x97, 0x1a, 0xbd, 0x84, 0x4f, 0x94, 0xc4, 0x53, 0xe2, 0xf8,
+	0x94, 0x51, 0xe5, 0x48, 0xac, 0xa8, 0xb0, 0xd2, 0x89, 0x04, 0xd7, 0x0c, 0xa8, 0xab, 0x39, 0x23,
+	0x8d, 0x41, 0xc7, 0xb0, 0x79, 0xec, 0xcf, 0x5d, 0x35, 0xd7, 0x6f, 0xfc, 0x86, 0x42, 0x32, 0xaf,
+	0x6e, 0x2c, 0xe1, 0x96, 0x74, 0x08, 0x6c, 0x32, 0xbc, 0x70, 0x84, 0xc4, 0xae, 0x4f, 0x9c, 0x30,
+	0x90, 0x04, 0x4f, 0x63, 0x9d, 0x64, 0xfe, 0x5d, 0x67, 0x78, 0x31, 0x30, 0x34, 0xdb, 0xc0, 0x22,
+	0x99, 0x19, 0x58, 0x0c, 0x53, 0xae, 0x08, 0xc7, 0xdc, 0x25, 0x0e, 0xc3, 0xd2, 0xa3, 0x3c, 0xd6,
+	0xc9, 0x25, 0xd2, 0xf9, 0x74, 0x89, 0xd7, 0x33, 0xb8, 0x48, 0xe9, 0x10, 0xca, 0x7a, 0x41, 0x3e,
+	0x39, 0x25, 0x12, 0x7b, 0x24, 0xa1, 0xe7, 0x4b, 0x0c, 0x2f, 0xba, 0x31, 0x02, 0x1d, 0x68, 0xa4,
+	0x49, 0x58, 0x51, 0x22, 0x43, 0xab, 0xb0, 0x95, 0xdd, 0x2e, 0x3d, 0xa9, 0xfe, 0xcd, 0x51, 0xf4,
+	0x28, 0x1f, 0x53, 0x22, 0xe3, 0x83, 0x58, 0x62, 0x57, 0x91, 0x50, 0x1b, 0xc6, 0x14, 0x3b, 0x20,
+	0xdc, 0xd1, 0xc9, 0x4b, 0x12, 0x26, 0x75, 0xfd, 0x9a, 0x2e, 0x73, 0x40, 0x78, 0x27, 0xc6, 0xa0,
+	0x09, 0x6c, 0x68, 0x76, 0x20, 0x42, 0x6a, 0x1c, 0xc3, 0x85, 0x7e, 0x60, 0x3f, 0xe1, 0x09, 0x78,
+	0xc0, 0xf0, 0x62, 0x18, 0xb3, 0xfa, 0x31, 0xaa, 0xfe, 0x2e, 0x03, 0x70, 0xbd, 0x42, 0x7d, 0xda,
+	0x2e, 0x55, 0x9c, 0x63, 0x5f, 0x08, 0x99, 0xd0, 0xfc, 0x2b, 0x97, 0x94, 0x67, 0x1a, 0xf2, 0x8f,
+	0x5e, 0xc9, 0xdc, 0xa9, 0x57, 0xb2, 0xff, 0xdb, 0x2b, 0xf5, 0xb7, 0x19, 0x28, 0xb5, 0xe6, 0x52,
+	0x12, 0xae, 0xc6, 0x2f, 0x76, 0x87, 0xe8, 0x4b, 0xb8, 0xa7, 0x1b, 0x8e, 0x43, 0xa7, 0x71, 0x71,
+	0xe0, 0xfc, 0xac, 0x56, 0xd0, 0x9d, 0xa8, 0xd3, 0x1e, 0x15, 0xf4, 0x50, 0x67, 0x8a, 0xba, 0x50,
+	0xe4, 0x73, 0x46, 0x24, 0x56, 0x42, 0x26, 0x5c, 0xe2, 0x35, 0x00, 0x0d, 0xa1, 0x34, 0x25, 0x5c,
+	0x30, 0xca, 0x0d, 0x2f, 0xe1, 0xa2, 0x96, 0x10, 0xa8, 0x0d, 0xf9, 0x40, 0x52, 0x97, 0x24, 0x3c,
+	0xaa, 0xd1, 0xc7, 0xf5, 0x1f, 0xb2, 0xb0, 0x16, 0xf7, 0x35, 0x9b, 0xe3, 0x20, 0x9c, 0x89, 0xeb,
+	0xb6, 0x9e, 0xbf, 0x9b, 0xb6, 0x9e, 0xbe, 0x83, 0xb6, 0x9e, 0xb9, 0x85, 0xb6, 0xfe, 0x05, 0x94,
+	0x15, 0x65, 0x24, 0x54, 0x98, 0x05, 0x0e, 0x0b, 0x4d, 0x5d, 0xb3, 0xa3, 0xd2, 0x55, 0xac, 0x17,
+	0x7e, 0xa4, 0x95, 0x15, 0x6e, 0xa1, 0x95, 0xd5, 0x7f, 0xcf, 0x00, 0x0c, 0x85, 0xf0, 0x87, 0x7a,
+	0x4b, 0xc2, 0xab, 0xfa, 0x17, 0x6f, 0xa7, 0xfe, 0x3d, 0x00, 0x86, 0xe5, 0x89, 0x13, 0xb9, 0x05,
+	0x92, 0x39, 0x59, 0x13, 0x4c, 0x7a, 0xa8, 0x06, 0x25, 0xca, 0xa7, 0x64, 0x11, 0xf3, 0x4a, 0x9a,
+	0x37, 0x02, 0x13, 0x8a, 0x26, 0x7c, 0x06, 0x45, 0xf5, 0x1a, 0x07, 0xfa, 0x8e, 0x38, 0xb1, 0xca,
+	0x66, 0xf8, 0xbe, 0x0e, 0xe8, 0x36, 0x8d, 0x38, 0xac, 0x86, 0x7a, 0x90, 0xf2, 0x53, 0x2c, 0x29,
+	0xe6, 0xca, 0x5a, 0x31, 0x09, 0x1d, 0xfc, 0x87, 0x84, 0x3a, 0x5c, 0xfd, 0x76, 0x56, 0xdb, 0x78,
+	0x83, 0x99, 0xff, 0xb4, 0x7e, 0x93, 0x56, 0x1f, 0xad, 0xe8, 0x40, 0xe7, 0xf2, 0x5d, 0x6f, 0xea,
+	0xc4, 0x17, 0xee, 0x89, 0xc3, 0xe7, 0x6c, 0x42, 0xa4, 0xb5, 0x1a, 0x6d, 0xaa, 0x89, 0xf5, 0x4d,
+	0xe8, 0xf1, 0x77, 0x50, 0x6c, 0x53, 0x49, 0x5c, 0x7d, 0xdd, 0xa1, 0x87, 0xb0, 0xd1, 0xee, 0x8c,
+	0xf6, 0x5b, 0xe3, 0xce, 0xa0, 0xef, 0x1c, 0xf5, 0xed, 0xe1, 0x7e, 0xab, 0xf3, 0xac, 0xb3, 0xdf,
+	0xae, 0xa4, 0xd0, 0x7d, 0xc8, 0x75, 0x07, 0xfd, 0x83, 0x4a, 0x1a, 0x15, 0x21, 0x6f, 0x3f, 0x1f,
+	0x8c, 0xc6, 0x95, 0xcc, 0x63, 0x0f, 0x56, 0xc7, 0xaf, 0x71, 0xd0, 0xc2, 0xbe, 0x3b, 0x08, 0x0c,
+	0x61, 0x0b, 0x3e, 0xd7, 0x97, 0x8c, 0xd3, 0xda, 0xed, 0xb6, 0x9c, 0xc1, 0xf0, 0xe3, 0x20, 0x7b,
+	0x38, 0x18, 0x57, 0xd2, 0x68, 0x1d, 0x2a, 0x87, 0x47, 0x83, 0xf1, 0xbe, 0xb3, 0x6b, 0xdb, 0xfb,
+	0x63, 0xc7, 0x7e, 0xb1, 0x3b, 0xac, 0x64, 0xd0, 0x03, 0x58, 0xdb, 0xdb, 0xb5, 0x6f, 0x04, 0xb3,
+	0x7b, 0xcf, 0xdf, 0x9f, 0x57, 0xd3, 0x1f, 0xce, 0xab, 0xe9, 0x5f, 0xcf, 0xab, 0xe9, 0x9f, 0x2e,
+	0xaa, 0xa9, 0x0f, 0x17, 0xd5, 0xd4, 0xcf, 0x17, 0xd5, 0xd4, 0xcb, 0xc6, 0xbf, 0x19, 0xe3, 0xea,
+	0xe7, 0x80, 0x29, 0xdf, 0xa4, 0x60, 0xfe, 0x8f, 0xff, 0xe6, 0xcf, 0x01, 0x00, 0xc8, 0x8f, 0x0f,
+	0x72, 0x27, 0x0c, 0x00, 0x00,
 }
 
 func (m *Market) Marshal() (dAtA []byte, err error) {
@@ -567,6 +635,40 @@ func (m *MarketConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.MaxPositionNotional.Size()
+		i -= size
+		if _, err := m.MaxPositionNotional.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.MaxOpenInterest.Size()
+		i -= size
+		if _, err := m.MaxOpenInterest.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	if len(m.MarginTiers) > 0 {
+		for iNdEx := len(m.MarginTiers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MarginTiers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintState(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
 	{
 		size := m.MaxLeverage.Size()
 		i -= size
@@ -620,6 +722,59 @@ func (m *MarketConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MarginTier) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MarginTier) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MarginTier) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MaxLeverage.Size()
+		i -= size
+		if _, err := m.MaxLeverage.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.MaintenanceMarginRatio.Size()
+		i -= size
+		if _, err := m.MaintenanceMarginRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.NotionalFloor.Size()
+		i -= size
+		if _, err := m.NotionalFloor.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *CurrentTWAP) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -872,6 +1027,31 @@ func (m *MarketConfig) Size() (n int) {
 	n += 1 + l + sovState(uint64(l))
 	l = m.MaxLeverage.Size()
 	n += 1 + l + sovState(uint64(l))
+	if len(m.MarginTiers) > 0 {
+		for _, e := range m.MarginTiers {
+			l = e.Size()
+			n += 1 + l + sovState(uint64(l))
+		}
+	}
+	l = m.MaxOpenInterest.Size()
+	n += 1 + l + sovState(uint64(l))
+	l = m.MaxPositionNotional.Size()
+	n += 1 + l + sovState(uint64(l))
+	return n
+}
+
+func (m *MarginTier) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.NotionalFloor.Size()
+	n += 1 + l + sovState(uint64(l))
+	l = m.MaintenanceMarginRatio.Size()
+	n += 1 + l + sovState(uint64(l))
+	l = m.MaxLeverage.Size()
+	n += 1 + l + sovState(uint64(l))
 	return n
 }
 
@@ -1466,6 +1646,260 @@ func (m *MarketConfig) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarginTiers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MarginTiers = append(m.MarginTiers, MarginTier{})
+			if err := m.MarginTiers[len(m.MarginTiers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxOpenInterest", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxOpenInterest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPositionNotional", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxPositionNotional.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipState(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthState
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MarginTier) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowState
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MarginTier: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MarginTier: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NotionalFloor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.NotionalFloor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaintenanceMarginRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaintenanceMarginRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxLeverage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxLeverage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipState(dAtA[iNdEx:])
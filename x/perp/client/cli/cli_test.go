@@ -150,7 +150,7 @@ func (s *IntegrationTestSuite) SetupSuite() {
 		asset.Registry.Pair(denoms.OSMO, denoms.NUSD),
 	}
 	oracleGenesis.Params.VotePeriod = 1_000
-	oracleGenesis.ExchangeRates = []oracletypes.ExchangeRateTuple{
+	oracleGenesis.ExchangeRates = []oracletypes.GenesisExchangeRate{
 		{Pair: asset.Registry.Pair(denoms.BTC, denoms.NUSD), ExchangeRate: sdk.NewDec(20_000)},
 		{Pair: asset.Registry.Pair(denoms.ETH, denoms.NUSD), ExchangeRate: sdk.NewDec(2_000)},
 		{Pair: asset.Registry.Pair(denoms.ATOM, denoms.NUSD), ExchangeRate: sdk.NewDec(6_000)},
@@ -10,6 +10,7 @@ import (
 
 	"github.com/NibiruChain/nibiru/x/common/asset"
 	perpammcli "github.com/NibiruChain/nibiru/x/perp/amm/cli"
+	perpammtypes "github.com/NibiruChain/nibiru/x/perp/amm/types"
 	types "github.com/NibiruChain/nibiru/x/perp/types/v1"
 )
 
@@ -32,6 +33,7 @@ func GetQueryCmd() *cobra.Command {
 		CmdQueryCumulativePremiumFraction(),
 		CmdQueryMetrics(),
 		CmdQueryModuleAccounts(),
+		CmdQueryMarketPositions(),
 		perpammcli.CmdGetMarketReserveAssets(),
 		perpammcli.CmdGetMarkets(),
 		perpammcli.CmdGetBaseAssetPrice(),
@@ -251,3 +253,82 @@ func CmdQueryModuleAccounts() *cobra.Command {
 
 	return cmd
 }
+
+// Flags of the market-positions query.
+const (
+	FlagSide           = "side"
+	FlagMaxMarginRatio = "max-margin-ratio"
+	FlagMinNotional    = "min-notional"
+)
+
+func CmdQueryMarketPositions() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "market-positions [pair]",
+		Short: "return the positions of every trader in a market, optionally filtered",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pair, err := asset.TryNewPair(args[0])
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryMarketPositionsRequest{Pair: pair}
+
+			side, err := cmd.Flags().GetString(FlagSide)
+			if err != nil {
+				return err
+			}
+			switch side {
+			case "":
+			case "buy":
+				req.Direction = perpammtypes.Direction_LONG
+			case "sell":
+				req.Direction = perpammtypes.Direction_SHORT
+			default:
+				return fmt.Errorf("invalid side: %s, must be either 'buy' or 'sell'", side)
+			}
+
+			if req.MaxMarginRatio, err = readOptionalDec(cmd, FlagMaxMarginRatio); err != nil {
+				return err
+			}
+			if req.MinNotional, err = readOptionalDec(cmd, FlagMinNotional); err != nil {
+				return err
+			}
+
+			if req.Pagination, err = client.ReadPageRequest(cmd.Flags()); err != nil {
+				return err
+			}
+
+			res, err := queryClient.QueryMarketPositions(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(FlagSide, "", "only return the positions on this side, either 'buy' or 'sell'")
+	cmd.Flags().String(FlagMaxMarginRatio, "", "only return the positions with a margin ratio below this value")
+	cmd.Flags().String(FlagMinNotional, "", "only return the positions with at least this notional")
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "market-positions")
+
+	return cmd
+}
+
+// readOptionalDec reads a decimal flag, which is nil if the flag isn't set.
+func readOptionalDec(cmd *cobra.Command, flag string) (sdk.Dec, error) {
+	value, err := cmd.Flags().GetString(flag)
+	if err != nil || value == "" {
+		return sdk.Dec{}, err
+	}
+	return sdk.NewDecFromStr(value)
+}
@@ -0,0 +1,178 @@
+package cli_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/suite"
+	abcitypes "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/NibiruChain/nibiru/app"
+	"github.com/NibiruChain/nibiru/x/common"
+	"github.com/NibiruChain/nibiru/x/common/asset"
+	"github.com/NibiruChain/nibiru/x/common/denoms"
+	testutilcli "github.com/NibiruChain/nibiru/x/common/testutil/cli"
+	"github.com/NibiruChain/nibiru/x/common/testutil/genesis"
+	epochstypes "github.com/NibiruChain/nibiru/x/epochs/types"
+	cli "github.com/NibiruChain/nibiru/x/perp/client/cli/v2"
+	v2types "github.com/NibiruChain/nibiru/x/perp/types/v2"
+)
+
+type IntegrationTestSuite struct {
+	suite.Suite
+
+	cfg     testutilcli.Config
+	network *testutilcli.Network
+	users   []sdk.AccAddress
+}
+
+func (s *IntegrationTestSuite) SetupSuite() {
+	if testing.Short() {
+		s.T().Skip("skipping integration test suite")
+	}
+
+	s.T().Log("setting up integration test suite")
+
+	app.SetPrefixes(app.AccountAddressPrefix)
+	encodingConfig := app.MakeTestEncodingConfig()
+	genesisState := genesis.NewTestGenesisState()
+
+	perpGenesis := v2types.DefaultGenesis()
+	for _, pair := range []asset.Pair{
+		asset.Registry.Pair(denoms.BTC, denoms.NUSD),
+		asset.Registry.Pair(denoms.ETH, denoms.NUSD),
+	} {
+		perpGenesis.Markets = append(perpGenesis.Markets, v2types.Market{
+			Pair:                            pair,
+			Enabled:                         true,
+			PriceFluctuationLimitRatio:      sdk.OneDec(),
+			MaintenanceMarginRatio:          sdk.MustNewDecFromStr("0.0625"),
+			MaxLeverage:                     sdk.NewDec(15),
+			LatestCumulativePremiumFraction: sdk.ZeroDec(),
+			ExchangeFeeRatio:                sdk.MustNewDecFromStr("0.001"),
+			EcosystemFundFeeRatio:           sdk.MustNewDecFromStr("0.001"),
+			LiquidationFeeRatio:             sdk.MustNewDecFromStr("0.025"),
+			PartialLiquidationRatio:         sdk.MustNewDecFromStr("0.5"),
+			FundingRateEpochId:              epochstypes.ThirtyMinuteEpochID,
+			TwapLookbackWindow:              30 * time.Minute,
+			PrepaidBadDebt:                  sdk.NewInt64Coin(denoms.NUSD, 0),
+			InsuranceFundFeeShare:           sdk.ZeroDec(),
+			MaxFundingRate:                  sdk.ZeroDec(),
+			MinFundingRate:                  sdk.ZeroDec(),
+			InterestRate:                    sdk.ZeroDec(),
+			MaxSpreadRatio:                  sdk.ZeroDec(),
+			MaxSkewRatio:                    sdk.ZeroDec(),
+			AmmUpdateBudget:                 sdk.ZeroInt(),
+			CircuitBreakerPriceChangeRatio:  sdk.ZeroDec(),
+			MaxOpenInterest:                 sdk.ZeroDec(),
+			MaxPositionNotional:             sdk.ZeroDec(),
+		})
+		perpGenesis.Amms = append(perpGenesis.Amms, v2types.AMM{
+			Pair:            pair,
+			BaseReserve:     sdk.NewDec(10 * common.TO_MICRO),
+			QuoteReserve:    sdk.NewDec(10 * common.TO_MICRO),
+			SqrtDepth:       sdk.NewDec(10 * common.TO_MICRO),
+			PriceMultiplier: sdk.NewDec(6_000),
+			TotalLong:       sdk.ZeroDec(),
+			TotalShort:      sdk.ZeroDec(),
+		})
+	}
+	genesisState[v2types.ModuleName] = encodingConfig.Marshaler.MustMarshalJSON(perpGenesis)
+
+	s.cfg = testutilcli.BuildNetworkConfig(genesisState)
+	s.cfg.NumValidators = 1
+	s.network = testutilcli.NewNetwork(s.T(), s.cfg)
+	s.NoError(s.network.WaitForNextBlock())
+
+	val := s.network.Validators[0]
+
+	for i := 0; i < 2; i++ {
+		newUser := testutilcli.NewAccount(s.network, fmt.Sprintf("user%d", i))
+		s.users = append(s.users, newUser)
+		s.NoError(
+			testutilcli.FillWalletFromValidator(newUser,
+				sdk.NewCoins(
+					sdk.NewInt64Coin(denoms.NIBI, 10*common.TO_MICRO),
+					sdk.NewInt64Coin(denoms.NUSD, 5e3*common.TO_MICRO),
+				),
+				val,
+				denoms.NIBI,
+			),
+		)
+	}
+}
+
+func (s *IntegrationTestSuite) TearDownSuite() {
+	s.T().Log("tearing down integration test suite")
+	s.network.Cleanup()
+}
+
+func (s *IntegrationTestSuite) TestOpenPositionAndCloseCmd() {
+	val := s.network.Validators[0]
+	user := s.users[0]
+	pair := asset.Registry.Pair(denoms.BTC, denoms.NUSD)
+
+	s.T().Log("A. check trader has no existing positions")
+	var queryResp v2types.QueryPositionResponse
+	s.Error(testutilcli.ExecQuery(val.ClientCtx, cli.CmdQueryPosition(), []string{user.String(), pair.String()}, &queryResp))
+
+	s.T().Log("B. open position")
+	txResp, err := testutilcli.ExecTx(s.network, cli.OpenPositionCmd(), user, []string{
+		"buy",
+		pair.String(),
+		/* leverage */ "1",
+		/* quoteAmt */ "2000000",
+		/* baseAssetLimit */ "0",
+	})
+	s.Require().NoError(err)
+	s.EqualValues(abcitypes.CodeTypeOK, txResp.Code)
+
+	s.T().Log("B. check trader position")
+	s.Require().NoError(testutilcli.ExecQuery(val.ClientCtx, cli.CmdQueryPosition(), []string{user.String(), pair.String()}, &queryResp))
+	s.EqualValues(user.String(), queryResp.Position.TraderAddress)
+	s.EqualValues(pair, queryResp.Position.Pair)
+	s.EqualValues(sdk.NewDec(2*common.TO_MICRO), queryResp.Position.Margin)
+	s.EqualValues(sdk.NewDec(2*common.TO_MICRO), queryResp.Position.OpenNotional)
+	s.True(queryResp.Position.Size_.IsPositive())
+
+	var positionsResp v2types.QueryPositionsResponse
+	s.Require().NoError(testutilcli.ExecQuery(val.ClientCtx, cli.CmdQueryPositions(), []string{user.String()}, &positionsResp))
+	s.Len(positionsResp.Positions, 1)
+
+	s.T().Log("C. close position")
+	txResp, err = testutilcli.ExecTx(s.network, cli.ClosePositionCmd(), user, []string{pair.String()})
+	s.Require().NoError(err)
+	s.EqualValues(abcitypes.CodeTypeOK, txResp.Code)
+
+	s.Error(testutilcli.ExecQuery(val.ClientCtx, cli.CmdQueryPosition(), []string{user.String(), pair.String()}, &queryResp))
+}
+
+func (s *IntegrationTestSuite) TestDonateToEcosystemFund() {
+	val := s.network.Validators[0]
+
+	txResp, err := testutilcli.ExecTx(s.network, cli.DonateToEcosystemFundCmd(), s.users[1], []string{"100unusd"})
+	s.Require().NoError(err)
+	s.EqualValues(abcitypes.CodeTypeOK, txResp.Code)
+
+	var resp v2types.QueryModuleAccountsResponse
+	s.Require().NoError(testutilcli.ExecQuery(val.ClientCtx, cli.CmdQueryModuleAccounts(), nil, &resp))
+	for _, acc := range resp.Accounts {
+		if acc.Name == v2types.PerpEFModuleAccount {
+			s.True(acc.Balance.AmountOf(denoms.NUSD).GTE(sdk.NewInt(100)))
+			return
+		}
+	}
+	s.Fail("perp ecosystem fund module account not found")
+}
+
+func (s *IntegrationTestSuite) TestQueryParams() {
+	var resp v2types.QueryParamsResponse
+	s.Require().NoError(testutilcli.ExecQuery(s.network.Validators[0].ClientCtx, cli.CmdQueryParams(), nil, &resp))
+	s.Equal(v2types.DefaultParams(), resp.Params)
+}
+
+func TestIntegrationTestSuite(t *testing.T) {
+	suite.Run(t, new(IntegrationTestSuite))
+}
@@ -0,0 +1,190 @@
+package cli
+
+import (
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	govclient "github.com/cosmos/cosmos-sdk/x/gov/client"
+	govcli "github.com/cosmos/cosmos-sdk/x/gov/client/cli"
+	govclientrest "github.com/cosmos/cosmos-sdk/x/gov/client/rest"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/spf13/cobra"
+
+	types "github.com/NibiruChain/nibiru/x/perp/types/v2"
+)
+
+func NewProposalHandler(cliHandler govclient.CLIHandlerFn) govclient.ProposalHandler {
+	return govclient.NewProposalHandler(
+		/* govclient.CLIHandlerFn */ cliHandler,
+		/* govclient.RESTHandlerFn */ func(context client.Context) govclientrest.ProposalRESTHandler {
+			return govclientrest.ProposalRESTHandler{
+				SubRoute: "deprecated",
+				Handler: func(writer http.ResponseWriter, request *http.Request) {
+					// The govclient.RESTHandlerFn is entirely removed in sdk v0.46
+					_, _ = writer.Write([]byte("deprecated"))
+					writer.WriteHeader(http.StatusMethodNotAllowed)
+				},
+			}
+		})
+}
+
+var (
+	SetAutoDeleveragingProposalHandler = NewProposalHandler(CmdSetAutoDeleveragingProposal)
+	ResetCircuitBreakerProposalHandler = NewProposalHandler(CmdResetCircuitBreakerProposal)
+)
+
+// CmdSetAutoDeleveragingProposal implements the client command to submit a
+// governance proposal to turn auto-deleveraging on or off for a market.
+func CmdSetAutoDeleveragingProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-auto-deleveraging [proposal-json] --deposit=[deposit]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a proposal to turn auto-deleveraging on or off for a market",
+		Example: strings.TrimSpace(fmt.Sprintf(`
+			Example:
+			$ %s tx gov submit-proposal set-auto-deleveraging <path/to/proposal.json> --deposit="1000unibi" --from=<key_or_address>
+			`, version.AppName)),
+		Long: strings.TrimSpace(
+			`Submits a proposal to turn auto-deleveraging on or off for a market. With
+			auto-deleveraging on, bad debt that neither the insurance fund nor the
+			ecosystem fund can cover is taken out of the most profitable and leveraged
+			positions on the other side of the bankrupt position.
+
+			A proposal.json for 'SetAutoDeleveragingProposal' contains:
+			{
+			  "title": "Enable auto-deleveraging on ETH:NUSD",
+			  "description": "The ecosystem fund cannot cover a market crash",
+			  "pair": "ueth:unusd",
+			  "enabled": true
+			}
+			`),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			from := clientCtx.GetFromAddress()
+
+			proposal := &types.SetAutoDeleveragingProposal{}
+			contents, err := os.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+
+			// marshals the contents into the proto.Message to which 'proposal' points.
+			if err = clientCtx.Codec.UnmarshalJSON(contents, proposal); err != nil {
+				return err
+			}
+
+			depositStr, err := cmd.Flags().GetString(govcli.FlagDeposit)
+			if err != nil {
+				return err
+			}
+			deposit, err := sdk.ParseCoinsNormalized(depositStr)
+			if err != nil {
+				return err
+			}
+
+			msg, err := govtypes.NewMsgSubmitProposal(proposal, deposit, from)
+			if err != nil {
+				return err
+			}
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(
+		/*name=*/ govcli.FlagDeposit,
+		/*defaultValue=*/ "",
+		/*usage=*/ "governance deposit for proposal")
+	if err := cmd.MarkFlagRequired(govcli.FlagDeposit); err != nil {
+		panic(err)
+	}
+
+	return cmd
+}
+
+// CmdResetCircuitBreakerProposal implements the client command to submit a
+// governance proposal to reset the tripped circuit breaker of a market.
+func CmdResetCircuitBreakerProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "reset-circuit-breaker [proposal-json] --deposit=[deposit]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a proposal to reset the circuit breaker of a market",
+		Example: strings.TrimSpace(fmt.Sprintf(`
+			Example:
+			$ %s tx gov submit-proposal reset-circuit-breaker <path/to/proposal.json> --deposit="1000unibi" --from=<key_or_address>
+			`, version.AppName)),
+		Long: strings.TrimSpace(
+			`Submits a proposal to reset the circuit breaker of a market. A tripped
+			circuit breaker only lets traders reduce or close their positions and add
+			margin, until it is reset.
+
+			A proposal.json for 'ResetCircuitBreakerProposal' contains:
+			{
+			  "title": "Resume trading on ETH:NUSD",
+			  "description": "The oracle price is back to normal",
+			  "pair": "ueth:unusd"
+			}
+			`),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			from := clientCtx.GetFromAddress()
+
+			proposal := &types.ResetCircuitBreakerProposal{}
+			contents, err := os.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+
+			// marshals the contents into the proto.Message to which 'proposal' points.
+			if err = clientCtx.Codec.UnmarshalJSON(contents, proposal); err != nil {
+				return err
+			}
+
+			depositStr, err := cmd.Flags().GetString(govcli.FlagDeposit)
+			if err != nil {
+				return err
+			}
+			deposit, err := sdk.ParseCoinsNormalized(depositStr)
+			if err != nil {
+				return err
+			}
+
+			msg, err := govtypes.NewMsgSubmitProposal(proposal, deposit, from)
+			if err != nil {
+				return err
+			}
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(
+		/*name=*/ govcli.FlagDeposit,
+		/*defaultValue=*/ "",
+		/*usage=*/ "governance deposit for proposal")
+	if err := cmd.MarkFlagRequired(govcli.FlagDeposit); err != nil {
+		panic(err)
+	}
+
+	return cmd
+}
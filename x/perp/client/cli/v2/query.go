@@ -0,0 +1,842 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cobra"
+
+	"github.com/NibiruChain/nibiru/x/common/asset"
+	types "github.com/NibiruChain/nibiru/x/perp/types/v2"
+)
+
+// GetQueryCmd returns the cli query commands for this module
+func GetQueryCmd() *cobra.Command {
+	// Group perp queries under a subcommand
+	moduleQueryCmd := &cobra.Command{
+		Use: types.ModuleName,
+		Short: fmt.Sprintf(
+			"Querying commands for the %s module", types.ModuleName),
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmds := []*cobra.Command{
+		CmdQueryParams(),
+		CmdQueryMarkets(),
+		CmdQueryPosition(),
+		CmdQueryPositions(),
+		CmdQueryModuleAccounts(),
+		CmdQueryTriggerOrders(),
+		CmdQueryCrossMarginAccount(),
+		CmdQueryInsuranceFund(),
+		CmdQueryInsuranceFundDrawDowns(),
+		CmdQueryFundingRates(),
+		CmdQueryCircuitBreaker(),
+		CmdQueryMarginTiers(),
+		CmdQueryFeeTier(),
+		CmdQueryReferralRebates(),
+		CmdQueryTraderHistory(),
+		CmdQueryMarketPositions(),
+		CmdQueryLiquidationPrice(),
+		CmdQuerySimulateOpenPosition(),
+		CmdQuerySimulateRemoveMargin(),
+		CmdQueryLiquidationAuctions(),
+	}
+	for _, cmd := range cmds {
+		moduleQueryCmd.AddCommand(cmd)
+	}
+
+	return moduleQueryCmd
+}
+
+func CmdQueryParams() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "params",
+		Short: "shows the parameters of the x/perp v2 module",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Params(
+				cmd.Context(), &types.QueryParamsRequest{},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// sample token-pair: btc:nusd
+func CmdQueryMarkets() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "markets",
+		Short: "return every market and its AMM",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.QueryMarkets(cmd.Context(), &types.QueryMarketsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdQueryPosition() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "position [trader] [token-pair]",
+		Short: "trader's position for a given token pair",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			trader, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return fmt.Errorf("invalid trader address: %w", err)
+			}
+
+			pair, err := asset.TryNewPair(args[1])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.QueryPosition(
+				cmd.Context(), &types.QueryPositionRequest{
+					Trader: trader.String(),
+					Pair:   pair,
+				},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdQueryPositions() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "positions [trader]",
+		Short: "return all of a trader's open positions",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			trader, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return fmt.Errorf("invalid trader address: %w", err)
+			}
+
+			res, err := queryClient.QueryPositions(
+				cmd.Context(), &types.QueryPositionsRequest{
+					Trader: trader.String(),
+				},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdQueryModuleAccounts() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "module-accounts",
+		Short: "shows all the module accounts in the blockchain",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.ModuleAccounts(cmd.Context(), &types.QueryModuleAccountsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdQueryTriggerOrders() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "trigger-orders [trader] [token-pair]",
+		Short: "return the resting trigger orders of a trader, optionally on a single pair",
+		Args:  cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			trader, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return fmt.Errorf("invalid trader address: %w", err)
+			}
+
+			req := &types.QueryTriggerOrdersRequest{Trader: trader.String()}
+			if len(args) == 2 {
+				pair, err := asset.TryNewPair(args[1])
+				if err != nil {
+					return err
+				}
+				req.Pair = pair.String()
+			}
+
+			res, err := queryClient.QueryTriggerOrders(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdQueryCrossMarginAccount() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cross-margin-account [trader]",
+		Short: "return the margin mode of a trader and the state of their accounts, one per quote denom",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			trader, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return fmt.Errorf("invalid trader address: %w", err)
+			}
+
+			res, err := queryClient.QueryCrossMarginAccount(
+				cmd.Context(), &types.QueryCrossMarginAccountRequest{Trader: trader.String()},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdQueryInsuranceFund() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "insurance-fund [pair]",
+		Short: "return the insurance fund of a market and its coverage of the open interest",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pair, err := asset.TryNewPair(args[0])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.QueryInsuranceFund(
+				cmd.Context(), &types.QueryInsuranceFundRequest{Pair: pair},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdQueryInsuranceFundDrawDowns() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "insurance-fund-draw-downs [pair]",
+		Short: "return the draw-downs of the insurance fund of a market",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pair, err := asset.TryNewPair(args[0])
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.QueryInsuranceFundDrawDowns(
+				cmd.Context(), &types.QueryInsuranceFundDrawDownsRequest{
+					Pair:       pair,
+					Pagination: pageReq,
+				},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "insurance-fund-draw-downs")
+
+	return cmd
+}
+
+func CmdQueryFundingRates() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "funding-rates [pair]",
+		Short: "return the funding rate history of a market, oldest first",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pair, err := asset.TryNewPair(args[0])
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.QueryFundingRates(
+				cmd.Context(), &types.QueryFundingRatesRequest{
+					Pair:       pair,
+					Pagination: pageReq,
+				},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "funding-rates")
+
+	return cmd
+}
+
+func CmdQueryCircuitBreaker() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "circuit-breaker [pair]",
+		Short: "return the circuit breaker of a market and whether trading on it is halted",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pair, err := asset.TryNewPair(args[0])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.QueryCircuitBreaker(
+				cmd.Context(), &types.QueryCircuitBreakerRequest{Pair: pair},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdQueryMarginTiers() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "margin-tiers [pair]",
+		Short: "return the margin tier table of a market",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pair, err := asset.TryNewPair(args[0])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.QueryMarginTiers(
+				cmd.Context(), &types.QueryMarginTiersRequest{Pair: pair},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdQueryFeeTier() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "fee-tier [trader]",
+		Short: "return the trading volume, fee tier and fee discount of a trader",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.QueryFeeTier(
+				cmd.Context(), &types.QueryFeeTierRequest{Trader: args[0]},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdQueryReferralRebates() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "referral-rebates [referrer]",
+		Short: "return the referral codes of a referrer and the rebates it earned",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.QueryReferralRebates(
+				cmd.Context(), &types.QueryReferralRebatesRequest{Referrer: args[0]},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdQueryTraderHistory() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "trader-history [trader] [pair]",
+		Short: "return the realized PnL ledger and history of a trader, in every market unless a pair is given",
+		Args:  cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			var pair asset.Pair
+			if len(args) == 2 {
+				if pair, err = asset.TryNewPair(args[1]); err != nil {
+					return err
+				}
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.QueryTraderHistory(
+				cmd.Context(), &types.QueryTraderHistoryRequest{
+					Trader:     args[0],
+					Pair:       pair,
+					Pagination: pageReq,
+				},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "trader-history")
+
+	return cmd
+}
+
+// Flags of the market-positions query.
+const (
+	FlagSide           = "side"
+	FlagMaxMarginRatio = "max-margin-ratio"
+	FlagMinNotional    = "min-notional"
+)
+
+func CmdQueryMarketPositions() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "market-positions [pair]",
+		Short: "return the positions of every trader in a market, optionally filtered",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pair, err := asset.TryNewPair(args[0])
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryMarketPositionsRequest{Pair: pair}
+
+			side, err := cmd.Flags().GetString(FlagSide)
+			if err != nil {
+				return err
+			}
+			switch side {
+			case "":
+			case "buy":
+				req.Direction = types.Direction_LONG
+			case "sell":
+				req.Direction = types.Direction_SHORT
+			default:
+				return fmt.Errorf("invalid side: %s, must be either 'buy' or 'sell'", side)
+			}
+
+			if req.MaxMarginRatio, err = readOptionalDec(cmd, FlagMaxMarginRatio); err != nil {
+				return err
+			}
+			if req.MinNotional, err = readOptionalDec(cmd, FlagMinNotional); err != nil {
+				return err
+			}
+
+			if req.Pagination, err = client.ReadPageRequest(cmd.Flags()); err != nil {
+				return err
+			}
+
+			res, err := queryClient.QueryMarketPositions(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(FlagSide, "", "only return the positions on this side, either 'buy' or 'sell'")
+	cmd.Flags().String(FlagMaxMarginRatio, "", "only return the positions with a margin ratio below this value")
+	cmd.Flags().String(FlagMinNotional, "", "only return the positions with at least this notional")
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "market-positions")
+
+	return cmd
+}
+
+// readOptionalDec reads a decimal flag, which is nil if the flag isn't set.
+func readOptionalDec(cmd *cobra.Command, flag string) (sdk.Dec, error) {
+	value, err := cmd.Flags().GetString(flag)
+	if err != nil || value == "" {
+		return sdk.Dec{}, err
+	}
+	return sdk.NewDecFromStr(value)
+}
+
+func CmdQueryLiquidationPrice() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "liquidation-price [trader] [pair]",
+		Short: "return the mark price at which the position of a trader gets liquidated",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pair, err := asset.TryNewPair(args[1])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.LiquidationPrice(
+				cmd.Context(), &types.QueryLiquidationPriceRequest{
+					Trader: args[0],
+					Pair:   pair,
+				},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdQuerySimulateOpenPosition() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "simulate-open-position [trader] [buy/sell] [pair] [leverage] [quoteAmt / sdk.Int] [baseAmtLimit / sdk.Dec]",
+		Short: "simulate opening a position, returning the resulting position, fees and price impact",
+		Args:  cobra.ExactArgs(6),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			var side types.Direction
+			switch args[1] {
+			case "buy":
+				side = types.Direction_LONG
+			case "sell":
+				side = types.Direction_SHORT
+			default:
+				return fmt.Errorf("invalid side: %s", args[1])
+			}
+
+			pair, err := asset.TryNewPair(args[2])
+			if err != nil {
+				return err
+			}
+
+			leverage, err := sdk.NewDecFromStr(args[3])
+			if err != nil {
+				return err
+			}
+
+			amount, ok := sdk.NewIntFromString(args[4])
+			if !ok {
+				return fmt.Errorf("invalid quote amount: %s", args[4])
+			}
+
+			baseAmtLimit, err := sdk.NewDecFromStr(args[5])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.SimulateOpenPosition(
+				cmd.Context(), &types.QuerySimulateOpenPositionRequest{
+					Trader:               args[0],
+					Pair:                 pair,
+					Direction:            side,
+					QuoteAssetAmount:     amount,
+					Leverage:             leverage,
+					BaseAssetAmountLimit: baseAmtLimit,
+				},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdQuerySimulateRemoveMargin() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "simulate-remove-margin [trader] [pair] [margin]",
+		Short: "simulate removing margin from a position, returning the resulting position",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pair, err := asset.TryNewPair(args[1])
+			if err != nil {
+				return err
+			}
+
+			margin, err := sdk.ParseCoinNormalized(args[2])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.SimulateRemoveMargin(
+				cmd.Context(), &types.QuerySimulateRemoveMarginRequest{
+					Trader: args[0],
+					Pair:   pair,
+					Margin: margin,
+				},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdQueryLiquidationAuctions() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "liquidation-auctions [token-pair]",
+		Short: "return the running liquidation auctions of a market with their current price",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pair, err := asset.TryNewPair(args[0])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.QueryLiquidationAuctions(cmd.Context(), &types.QueryLiquidationAuctionsRequest{
+				Pair: pair,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
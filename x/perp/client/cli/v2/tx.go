@@ -0,0 +1,701 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/spf13/cobra"
+
+	"github.com/NibiruChain/nibiru/x/common/asset"
+	types "github.com/NibiruChain/nibiru/x/perp/types/v2"
+)
+
+// FlagIndexPrice makes a trigger order follow the oracle index price instead
+// of the mark price.
+const FlagIndexPrice = "index-price"
+
+func GetTxCmd() *cobra.Command {
+	txCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Perpetual futures (v2) transaction subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	txCmd.AddCommand(
+		RemoveMarginCmd(),
+		AddMarginCmd(),
+		OpenPositionCmd(),
+		ClosePositionCmd(),
+		MultiLiquidateCmd(),
+		DonateToEcosystemFundCmd(),
+		PlaceLimitOrderCmd(),
+		PlaceStopLossCmd(),
+		PlaceTakeProfitCmd(),
+		CancelTriggerOrderCmd(),
+		SetMarginModeCmd(),
+		RegisterReferralCodeCmd(),
+		BindReferralCodeCmd(),
+		BidLiquidationAuctionCmd(),
+	)
+
+	return txCmd
+}
+
+func MultiLiquidateCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "multi-liquidate [Pair1:Trader1] [Pair2:Trader2] ...",
+		Short: "liquidates multiple positions at once",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`
+			$ %s tx v2perp multi-liquidate ubtc:unusd:nibi1zaavvzxez0elundtn32qnk9lkm8kmcsz44g7xl ueth:unusd:nibi1zaavvzxez0elundtn32qnk9lkm8kmcsz44g7xl
+			`, version.AppName),
+		),
+		Args: cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			liquidations := make([]*types.MsgMultiLiquidate_Liquidation, len(args))
+
+			for i, arg := range args {
+				parts := strings.Split(arg, ":")
+				if len(parts) != 3 {
+					return fmt.Errorf("invalid liquidation format: %s", arg)
+				}
+
+				pair, err := asset.TryNewPair(fmt.Sprintf("%s:%s", parts[0], parts[1]))
+				if err != nil {
+					return err
+				}
+
+				traderAddr, err := sdk.AccAddressFromBech32(parts[2])
+				if err != nil {
+					return err
+				}
+
+				liquidations[i] = &types.MsgMultiLiquidate_Liquidation{
+					Pair:   pair,
+					Trader: traderAddr.String(),
+				}
+			}
+
+			msg := &types.MsgMultiLiquidate{
+				Sender:       clientCtx.GetFromAddress().String(),
+				Liquidations: liquidations,
+			}
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func OpenPositionCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "open-position [buy/sell] [pair] [leverage] [quoteAmt / sdk.Dec] [baseAmtLimit / sdk.Dec]",
+		Short: "Opens a position",
+		Args:  cobra.ExactArgs(5),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			var side types.Direction
+			switch args[0] {
+			case "buy":
+				side = types.Direction_LONG
+			case "sell":
+				side = types.Direction_SHORT
+			default:
+				return fmt.Errorf("invalid side: %s", args[0])
+			}
+
+			assetPair, err := asset.TryNewPair(args[1])
+			if err != nil {
+				return err
+			}
+
+			leverage := sdk.MustNewDecFromStr(args[2])
+
+			amount, ok := sdk.NewIntFromString(args[3])
+			if !ok {
+				return fmt.Errorf("invalid quote amount: %s", args[3])
+			}
+
+			baseAmtLimit := sdk.MustNewDecFromStr(args[4])
+
+			msg := &types.MsgOpenPosition{
+				Sender:               clientCtx.GetFromAddress().String(),
+				Pair:                 assetPair,
+				Side:                 side,
+				QuoteAssetAmount:     amount,
+				Leverage:             leverage,
+				BaseAssetAmountLimit: baseAmtLimit.RoundInt(),
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func ClosePositionCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "close-position [pair]",
+		Short: "Closes a position",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pair, err := asset.TryNewPair(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgClosePosition{
+				Sender: clientCtx.GetFromAddress().String(),
+				Pair:   pair,
+			}
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+/*
+RemoveMarginCmd is a CLI command that removes margin from a position,
+realizing any outstanding funding payments and decreasing the margin ratio.
+*/
+func RemoveMarginCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "remove-margin [market] [margin]",
+		Short: "Removes margin from a position, decreasing its margin ratio",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`
+			$ %s tx v2perp remove-margin osmo:nusd 100nusd
+			`, version.AppName),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			marginToRemove, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return err
+			}
+
+			pair, err := asset.TryNewPair(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgRemoveMargin{
+				Sender: clientCtx.GetFromAddress().String(),
+				Pair:   pair,
+				Margin: marginToRemove,
+			}
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func AddMarginCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add-margin [market] [margin]",
+		Short: "Adds margin to a position, increasing its margin ratio",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`
+			$ %s tx v2perp add-margin osmo:nusd 100nusd
+			`, version.AppName),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			marginToAdd, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return err
+			}
+
+			pair, err := asset.TryNewPair(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgAddMargin{
+				Sender: clientCtx.GetFromAddress().String(),
+				Pair:   pair,
+				Margin: marginToAdd,
+			}
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func DonateToEcosystemFundCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "donate-ef [amount]",
+		Short: "Donates <amount> of coins to the Ecosystem Fund.",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`
+			$ %s tx v2perp donate-ef 100unusd
+			`, version.AppName),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			donation, err := sdk.ParseCoinNormalized(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgDonateToEcosystemFund{
+				Sender:   clientCtx.GetFromAddress().String(),
+				Donation: donation,
+			}
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func PlaceLimitOrderCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "place-limit-order [buy/sell] [pair] [trigger-price] [leverage] [collateral] [baseAmtLimit / sdk.Dec]",
+		Short: "Places an order opening a position once the price reaches the trigger price",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`
+			$ %s tx v2perp place-limit-order buy ubtc:unusd 19000 10 100unusd 0
+			`, version.AppName),
+		),
+		Args: cobra.ExactArgs(6),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			var side types.Direction
+			switch args[0] {
+			case "buy":
+				side = types.Direction_LONG
+			case "sell":
+				side = types.Direction_SHORT
+			default:
+				return fmt.Errorf("invalid side: %s", args[0])
+			}
+
+			pair, err := asset.TryNewPair(args[1])
+			if err != nil {
+				return err
+			}
+
+			triggerPrice, err := sdk.NewDecFromStr(args[2])
+			if err != nil {
+				return err
+			}
+
+			leverage, err := sdk.NewDecFromStr(args[3])
+			if err != nil {
+				return err
+			}
+
+			collateral, err := sdk.ParseCoinNormalized(args[4])
+			if err != nil {
+				return err
+			}
+
+			baseAmtLimit, err := sdk.NewDecFromStr(args[5])
+			if err != nil {
+				return err
+			}
+
+			priceSource, err := triggerPriceSource(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgPlaceTriggerOrder{
+				Sender:               clientCtx.GetFromAddress().String(),
+				Pair:                 pair,
+				OrderType:            types.TriggerOrderType_LIMIT_OPEN,
+				Side:                 side,
+				TriggerPrice:         triggerPrice,
+				PriceSource:          priceSource,
+				Collateral:           collateral,
+				Leverage:             leverage,
+				BaseAssetAmountLimit: baseAmtLimit,
+			}
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().Bool(FlagIndexPrice, false, "trigger on the oracle index price instead of the mark price")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func PlaceStopLossCmd() *cobra.Command {
+	return placeCloseOrderCmd(
+		"place-stop-loss", "Places an order closing the position once the price moves against it past the trigger price",
+		types.TriggerOrderType_STOP_LOSS,
+	)
+}
+
+func PlaceTakeProfitCmd() *cobra.Command {
+	return placeCloseOrderCmd(
+		"place-take-profit", "Places an order closing the position once the price moves in its favour past the trigger price",
+		types.TriggerOrderType_TAKE_PROFIT,
+	)
+}
+
+func placeCloseOrderCmd(use string, short string, orderType types.TriggerOrderType) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   use + " [pair] [trigger-price]",
+		Short: short,
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`
+			$ %s tx v2perp %s ubtc:unusd 19000
+			`, version.AppName, use),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pair, err := asset.TryNewPair(args[0])
+			if err != nil {
+				return err
+			}
+
+			triggerPrice, err := sdk.NewDecFromStr(args[1])
+			if err != nil {
+				return err
+			}
+
+			priceSource, err := triggerPriceSource(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgPlaceTriggerOrder{
+				Sender:               clientCtx.GetFromAddress().String(),
+				Pair:                 pair,
+				OrderType:            orderType,
+				TriggerPrice:         triggerPrice,
+				PriceSource:          priceSource,
+				Collateral:           sdk.NewCoin(pair.QuoteDenom(), sdk.ZeroInt()),
+				Leverage:             sdk.ZeroDec(),
+				BaseAssetAmountLimit: sdk.ZeroDec(),
+			}
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().Bool(FlagIndexPrice, false, "trigger on the oracle index price instead of the mark price")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func triggerPriceSource(cmd *cobra.Command) (types.TriggerPriceSource, error) {
+	useIndexPrice, err := cmd.Flags().GetBool(FlagIndexPrice)
+	if err != nil {
+		return types.TriggerPriceSource_TRIGGER_PRICE_SOURCE_UNSPECIFIED, err
+	}
+	if useIndexPrice {
+		return types.TriggerPriceSource_INDEX, nil
+	}
+	return types.TriggerPriceSource_MARK, nil
+}
+
+func CancelTriggerOrderCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-trigger-order [pair] [order-id]",
+		Short: "Cancels a trigger order, refunding its escrowed collateral",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`
+			$ %s tx v2perp cancel-trigger-order ubtc:unusd 1
+			`, version.AppName),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pair, err := asset.TryNewPair(args[0])
+			if err != nil {
+				return err
+			}
+
+			orderID, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid order id: %w", err)
+			}
+
+			msg := &types.MsgCancelTriggerOrder{
+				Sender:  clientCtx.GetFromAddress().String(),
+				Pair:    pair,
+				OrderId: orderID,
+			}
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func SetMarginModeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-margin-mode [cross|isolated]",
+		Short: "Sets whether the positions of the sender are cross-margined or isolated",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`
+			$ %s tx v2perp set-margin-mode cross
+			`, version.AppName),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			var crossMargin bool
+			switch args[0] {
+			case "cross":
+				crossMargin = true
+			case "isolated":
+				crossMargin = false
+			default:
+				return fmt.Errorf("invalid margin mode %s, expected cross or isolated", args[0])
+			}
+
+			msg := &types.MsgSetMarginMode{
+				Sender:      clientCtx.GetFromAddress().String(),
+				CrossMargin: crossMargin,
+			}
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func RegisterReferralCodeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "register-referral-code [code]",
+		Short: "Registers a referral code owned by the sender",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`
+			$ %s tx v2perp register-referral-code my-code
+			`, version.AppName),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgRegisterReferralCode{
+				Sender: clientCtx.GetFromAddress().String(),
+				Code:   args[0],
+			}
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func BindReferralCodeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "bind-referral-code [code]",
+		Short: "Binds the sender to the referral code of a referrer",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`
+			$ %s tx v2perp bind-referral-code my-code
+			`, version.AppName),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgBindReferralCode{
+				Sender: clientCtx.GetFromAddress().String(),
+				Code:   args[0],
+			}
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func BidLiquidationAuctionCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "bid-liquidation-auction [market] [trader] [size] [margin]",
+		Short: "Takes over an auctioned position, or part of it, at the auction price",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`
+			Takes over size of the position of trader being auctioned for liquidation,
+			adding it to your position on the market with the given margin.
+			A size of 0 takes over the whole position.
+
+			$ %s tx v2perp bid-liquidation-auction osmo:nusd nibi1... 0 100nusd
+			`, version.AppName),
+		),
+		Args: cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pair, err := asset.TryNewPair(args[0])
+			if err != nil {
+				return err
+			}
+
+			trader, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return fmt.Errorf("invalid trader address: %w", err)
+			}
+
+			size, err := sdk.NewDecFromStr(args[2])
+			if err != nil {
+				return err
+			}
+
+			margin, err := sdk.ParseCoinNormalized(args[3])
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgBidLiquidationAuction{
+				Sender: clientCtx.GetFromAddress().String(),
+				Pair:   pair,
+				Trader: trader.String(),
+				Size_:  size,
+				Margin: margin,
+			}
+			if err = msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,370 @@
+/*
+Package liquidator implements an off-chain liquidation bot for the x/perp v2
+module. It follows new blocks through the RPC of a node, scans the positions of
+every market through the perp query service, and liquidates the positions
+below the maintenance margin ratio of their margin tier with batched
+MsgMultiLiquidate transactions.
+
+The liquidation fee of x/perp v2 is paid to whoever liquidates a position, so
+the bot doesn't need to be whitelisted. The WhitelistedLiquidators param only
+gates the stopped x/perp v1 module.
+*/
+package liquidator
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/tendermint/tendermint/libs/log"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	"github.com/NibiruChain/nibiru/x/common/asset"
+	v2types "github.com/NibiruChain/nibiru/x/perp/types/v2"
+)
+
+// positionsPageLimit is the number of positions fetched per query while
+// scanning a market.
+const positionsPageLimit = 100
+
+// Config configures a Liquidator.
+type Config struct {
+	// Pairs restricts the liquidator to these markets, every market if empty.
+	Pairs []asset.Pair
+	// BatchSize is the maximum number of liquidations per transaction.
+	BatchSize int
+	// MaxGas is the maximum gas of a liquidation transaction, unlimited if
+	// zero. Batches estimated above it are split in halves.
+	MaxGas uint64
+	// MaxFee is the maximum fee of a liquidation transaction, unlimited if
+	// empty. Transactions above it are not broadcast.
+	MaxFee sdk.Coins
+	// DryRun only logs the liquidations instead of broadcasting them.
+	DryRun bool
+}
+
+// DefaultConfig returns the default liquidator config.
+func DefaultConfig() Config {
+	return Config{
+		BatchSize: 20,
+	}
+}
+
+// Validate checks the liquidator config.
+func (cfg Config) Validate() error {
+	if cfg.BatchSize <= 0 {
+		return fmt.Errorf("batch size must be positive, not %d", cfg.BatchSize)
+	}
+	return cfg.MaxFee.Validate()
+}
+
+// Liquidator liquidates underwater x/perp v2 positions.
+type Liquidator struct {
+	clientCtx   client.Context
+	txf         tx.Factory
+	cfg         Config
+	queryClient v2types.QueryClient
+	logger      log.Logger
+}
+
+// New returns a liquidator signing its transactions with the from account of
+// the client context.
+func New(clientCtx client.Context, txf tx.Factory, cfg Config, logger log.Logger) (*Liquidator, error) {
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+	if !cfg.DryRun && clientCtx.GetFromAddress().Empty() {
+		return nil, fmt.Errorf("a liquidator account is required unless in dry-run mode")
+	}
+
+	return &Liquidator{
+		clientCtx:   clientCtx,
+		txf:         txf,
+		cfg:         cfg,
+		queryClient: v2types.NewQueryClient(clientCtx),
+		logger:      logger,
+	}, nil
+}
+
+// Run scans and liquidates positions on every new block until the context is
+// done.
+func (l *Liquidator) Run(ctx context.Context) error {
+	node, err := l.clientCtx.GetNode()
+	if err != nil {
+		return err
+	}
+	// the HTTP client only opens its websocket once started
+	if service, ok := node.(interface {
+		IsRunning() bool
+		Start() error
+	}); ok && !service.IsRunning() {
+		if err = service.Start(); err != nil {
+			return err
+		}
+	}
+
+	const subscriber = "liquidator"
+	blocks, err := node.Subscribe(ctx, subscriber, tmtypes.QueryForEvent(tmtypes.EventNewBlock).String())
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = node.UnsubscribeAll(context.Background(), subscriber)
+	}()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case event, ok := <-blocks:
+			if !ok {
+				return fmt.Errorf("new block subscription closed")
+			}
+			if block, ok := event.Data.(tmtypes.EventDataNewBlock); ok {
+				l.logger.Debug("new block", "height", block.Block.Height)
+			}
+			if _, err := l.RunOnce(ctx); err != nil {
+				l.logger.Error("liquidation round failed", "error", err)
+			}
+		}
+	}
+}
+
+// RunOnce scans the positions once and liquidates the underwater ones. It
+// returns the responses of the broadcast transactions.
+func (l *Liquidator) RunOnce(ctx context.Context) ([]*sdk.TxResponse, error) {
+	liquidations, err := l.Scan(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(liquidations) == 0 {
+		return nil, nil
+	}
+	return l.Liquidate(liquidations)
+}
+
+// Scan returns the positions below the maintenance margin ratio of their
+// margin tier.
+func (l *Liquidator) Scan(ctx context.Context) ([]*v2types.MsgMultiLiquidate_Liquidation, error) {
+	marketsResp, err := l.queryClient.QueryMarkets(ctx, &v2types.QueryMarketsRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	pairs := make(map[asset.Pair]bool, len(l.cfg.Pairs))
+	for _, pair := range l.cfg.Pairs {
+		pairs[pair] = true
+	}
+
+	var liquidations []*v2types.MsgMultiLiquidate_Liquidation
+	for _, market := range marketsResp.Markets {
+		if len(pairs) > 0 && !pairs[market.Pair] {
+			continue
+		}
+		marketLiquidations, err := l.scanMarket(ctx, market)
+		if err != nil {
+			return nil, err
+		}
+		liquidations = append(liquidations, marketLiquidations...)
+	}
+
+	return liquidations, nil
+}
+
+// scanMarket returns the underwater positions of the market. Only positions
+// below the highest maintenance margin ratio of the market are fetched. As in
+// the chain, the margin ratio and margin tier of a position come from the
+// larger of its spot and TWAP notional. Positions up for a liquidation auction
+// that has not expired are left out, since liquidating them fails.
+func (l *Liquidator) scanMarket(
+	ctx context.Context, market v2types.Market,
+) ([]*v2types.MsgMultiLiquidate_Liquidation, error) {
+	maxMaintenanceMarginRatio := market.MaintenanceMarginRatio
+	for _, tier := range market.MarginTierTable() {
+		maxMaintenanceMarginRatio = sdk.MaxDec(maxMaintenanceMarginRatio, tier.MaintenanceMarginRatio)
+	}
+
+	var (
+		underwater  []v2types.QueryPositionResponse
+		blockHeight int64
+	)
+	pageReq := &query.PageRequest{Limit: positionsPageLimit}
+	for {
+		resp, err := l.queryClient.QueryMarketPositions(ctx, &v2types.QueryMarketPositionsRequest{
+			Pair:           market.Pair,
+			MaxMarginRatio: maxMaintenanceMarginRatio,
+			Pagination:     pageReq,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		for _, position := range resp.Positions {
+			blockHeight = position.BlockNumber
+			maintenanceMarginRatio := market.MarginTierFor(position.PositionNotionalMaxSpotTwap).MaintenanceMarginRatio
+			if position.MarginRatioMark.GTE(maintenanceMarginRatio) {
+				continue
+			}
+			underwater = append(underwater, position)
+		}
+
+		if resp.Pagination == nil || len(resp.Pagination.NextKey) == 0 {
+			break
+		}
+		pageReq = &query.PageRequest{Key: resp.Pagination.NextKey, Limit: positionsPageLimit}
+	}
+	if len(underwater) == 0 {
+		return nil, nil
+	}
+
+	auctioned := l.activeAuctions(ctx, market, blockHeight)
+	var liquidations []*v2types.MsgMultiLiquidate_Liquidation
+	for _, position := range underwater {
+		if auctioned[position.Position.TraderAddress] {
+			continue
+		}
+		liquidations = append(liquidations, &v2types.MsgMultiLiquidate_Liquidation{
+			Pair:   market.Pair,
+			Trader: position.Position.TraderAddress,
+		})
+	}
+	return liquidations, nil
+}
+
+// activeAuctions returns the traders of the market whose position is up for a
+// liquidation auction that has not expired at the given block height. If the
+// auctions can't be queried, e.g. because the market has no index price, no
+// position is left out.
+func (l *Liquidator) activeAuctions(
+	ctx context.Context, market v2types.Market, blockHeight int64,
+) map[string]bool {
+	resp, err := l.queryClient.QueryLiquidationAuctions(ctx, &v2types.QueryLiquidationAuctionsRequest{
+		Pair: market.Pair,
+	})
+	if err != nil {
+		l.logger.Error("failed to query liquidation auctions", "pair", market.Pair, "error", err)
+		return nil
+	}
+
+	auctioned := make(map[string]bool, len(resp.Auctions))
+	for _, auction := range resp.Auctions {
+		if !market.IsAuctionExpired(auction.Auction, blockHeight) {
+			auctioned[auction.Auction.TraderAddress] = true
+		}
+	}
+	return auctioned
+}
+
+// Liquidate broadcasts the liquidations in batches of MsgMultiLiquidate. A
+// batch that can't be broadcast is logged and skipped.
+func (l *Liquidator) Liquidate(liquidations []*v2types.MsgMultiLiquidate_Liquidation) ([]*sdk.TxResponse, error) {
+	if l.cfg.DryRun {
+		for _, liquidation := range liquidations {
+			l.logger.Info("dry run: would liquidate", "pair", liquidation.Pair, "trader", liquidation.Trader)
+		}
+		return nil, nil
+	}
+
+	// the account sequence is fetched once and then tracked locally, as the
+	// transactions of a round are all in the mempool at the same time
+	txf := l.txf.WithAccountNumber(0).WithSequence(0)
+
+	var responses []*sdk.TxResponse
+	for start := 0; start < len(liquidations); start += l.cfg.BatchSize {
+		end := start + l.cfg.BatchSize
+		if end > len(liquidations) {
+			end = len(liquidations)
+		}
+
+		var batchResponses []*sdk.TxResponse
+		txf, batchResponses = l.broadcast(txf, liquidations[start:end])
+		responses = append(responses, batchResponses...)
+	}
+
+	return responses, nil
+}
+
+// broadcast broadcasts a batch of liquidations, split in halves while its
+// estimated gas is above the max gas. It returns the tx factory to use for the
+// next transaction, which refetches the account sequence if it's unknown.
+func (l *Liquidator) broadcast(
+	txf tx.Factory, liquidations []*v2types.MsgMultiLiquidate_Liquidation,
+) (tx.Factory, []*sdk.TxResponse) {
+	logger := l.logger.With("liquidations", len(liquidations))
+
+	txf, err := txf.Prepare(l.clientCtx)
+	if err != nil {
+		logger.Error("failed to fetch the liquidator account", "error", err)
+		return txf, nil
+	}
+
+	msg := &v2types.MsgMultiLiquidate{
+		Sender:       l.clientCtx.GetFromAddress().String(),
+		Liquidations: liquidations,
+	}
+	gas := txf.Gas()
+	if txf.SimulateAndExecute() || l.cfg.MaxGas > 0 {
+		_, estimatedGas, err := tx.CalculateGas(l.clientCtx, txf, msg)
+		if err != nil {
+			logger.Error("failed to estimate gas", "error", err)
+			return txf, nil
+		}
+		if txf.SimulateAndExecute() {
+			gas = estimatedGas
+		}
+
+		if l.cfg.MaxGas > 0 && estimatedGas > l.cfg.MaxGas {
+			if len(liquidations) == 1 {
+				logger.Error("liquidation above max gas", "gas", estimatedGas, "max_gas", l.cfg.MaxGas)
+				return txf, nil
+			}
+			half := len(liquidations) / 2
+			txf, first := l.broadcast(txf, liquidations[:half])
+			txf, second := l.broadcast(txf, liquidations[half:])
+			return txf, append(first, second...)
+		}
+	}
+	txf = txf.WithGas(gas).WithSimulateAndExecute(false)
+
+	fees := txf.Fees()
+	if fees.IsZero() && !txf.GasPrices().IsZero() {
+		for _, gasPrice := range txf.GasPrices() {
+			fees = fees.Add(sdk.NewCoin(gasPrice.Denom, gasPrice.Amount.MulInt64(int64(gas)).Ceil().RoundInt()))
+		}
+	}
+	if !l.cfg.MaxFee.Empty() && !fees.IsAllLTE(l.cfg.MaxFee) {
+		logger.Error("liquidation fee above max fee", "fee", fees, "max_fee", l.cfg.MaxFee)
+		return txf, nil
+	}
+
+	txBuilder, err := tx.BuildUnsignedTx(txf, msg)
+	if err != nil {
+		logger.Error("failed to build transaction", "error", err)
+		return txf, nil
+	}
+	if err = tx.Sign(txf, l.clientCtx.GetFromName(), txBuilder, true); err != nil {
+		logger.Error("failed to sign transaction", "error", err)
+		return txf, nil
+	}
+	txBytes, err := l.clientCtx.TxConfig.TxEncoder()(txBuilder.GetTx())
+	if err != nil {
+		logger.Error("failed to encode transaction", "error", err)
+		return txf, nil
+	}
+
+	resp, err := l.clientCtx.BroadcastTx(txBytes)
+	if err != nil {
+		logger.Error("failed to broadcast transaction", "error", err)
+		return txf.WithSequence(0), nil
+	}
+	if resp.Code != 0 {
+		logger.Error("liquidation transaction failed", "code", resp.Code, "log", resp.RawLog, "txhash", resp.TxHash)
+		return txf.WithSequence(0), []*sdk.TxResponse{resp}
+	}
+
+	logger.Info("liquidation transaction broadcast", "txhash", resp.TxHash, "height", resp.Height)
+	return txf.WithSequence(txf.Sequence() + 1), []*sdk.TxResponse{resp}
+}
@@ -0,0 +1,259 @@
+package liquidator_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/suite"
+	abcitypes "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/NibiruChain/nibiru/app"
+	"github.com/NibiruChain/nibiru/x/common"
+	"github.com/NibiruChain/nibiru/x/common/asset"
+	"github.com/NibiruChain/nibiru/x/common/denoms"
+	"github.com/NibiruChain/nibiru/x/common/testutil"
+	testutilcli "github.com/NibiruChain/nibiru/x/common/testutil/cli"
+	"github.com/NibiruChain/nibiru/x/common/testutil/genesis"
+	epochstypes "github.com/NibiruChain/nibiru/x/epochs/types"
+	oracletypes "github.com/NibiruChain/nibiru/x/oracle/types"
+	"github.com/NibiruChain/nibiru/x/perp/client/liquidator"
+	v2types "github.com/NibiruChain/nibiru/x/perp/types/v2"
+)
+
+type IntegrationTestSuite struct {
+	suite.Suite
+
+	cfg     testutilcli.Config
+	network *testutilcli.Network
+
+	pair       asset.Pair
+	underwater []sdk.AccAddress
+	healthy    sdk.AccAddress
+	// underwater, but up for a liquidation auction
+	auctioned sdk.AccAddress
+}
+
+func TestIntegrationTestSuite(t *testing.T) {
+	suite.Run(t, new(IntegrationTestSuite))
+}
+
+func (s *IntegrationTestSuite) SetupSuite() {
+	if testing.Short() {
+		s.T().Skip("skipping integration test suite")
+	}
+
+	s.T().Log("setting up integration test suite")
+
+	app.SetPrefixes(app.AccountAddressPrefix)
+	encodingConfig := app.MakeTestEncodingConfig()
+	genesisState := genesis.NewTestGenesisState()
+
+	s.pair = asset.Registry.Pair(denoms.BTC, denoms.NUSD)
+	s.underwater = []sdk.AccAddress{testutil.AccAddress(), testutil.AccAddress(), testutil.AccAddress()}
+	s.healthy = testutil.AccAddress()
+	s.auctioned = testutil.AccAddress()
+	auctionPair := asset.Registry.Pair(denoms.ETH, denoms.NUSD)
+
+	perpGenesis := v2types.DefaultGenesis()
+	newMarket := func(pair asset.Pair) v2types.Market {
+		return v2types.Market{
+			Pair:                            pair,
+			Enabled:                         true,
+			PriceFluctuationLimitRatio:      sdk.OneDec(),
+			MaintenanceMarginRatio:          sdk.MustNewDecFromStr("0.0625"),
+			MaxLeverage:                     sdk.NewDec(15),
+			LatestCumulativePremiumFraction: sdk.ZeroDec(),
+			ExchangeFeeRatio:                sdk.MustNewDecFromStr("0.001"),
+			EcosystemFundFeeRatio:           sdk.MustNewDecFromStr("0.001"),
+			LiquidationFeeRatio:             sdk.MustNewDecFromStr("0.025"),
+			PartialLiquidationRatio:         sdk.MustNewDecFromStr("0.5"),
+			FundingRateEpochId:              epochstypes.ThirtyMinuteEpochID,
+			TwapLookbackWindow:              30 * time.Minute,
+			PrepaidBadDebt:                  sdk.NewInt64Coin(denoms.NUSD, 0),
+			InsuranceFundFeeShare:           sdk.ZeroDec(),
+			MaxFundingRate:                  sdk.ZeroDec(),
+			MinFundingRate:                  sdk.ZeroDec(),
+			InterestRate:                    sdk.ZeroDec(),
+			MaxSpreadRatio:                  sdk.ZeroDec(),
+			MaxSkewRatio:                    sdk.ZeroDec(),
+			AmmUpdateBudget:                 sdk.ZeroInt(),
+			CircuitBreakerPriceChangeRatio:  sdk.ZeroDec(),
+			MaxOpenInterest:                 sdk.ZeroDec(),
+			MaxPositionNotional:             sdk.ZeroDec(),
+		}
+	}
+	auctionMarket := newMarket(auctionPair)
+	auctionMarket.WithLiquidationAuction(sdk.MustNewDecFromStr("0.001"), sdk.MustNewDecFromStr("0.05"), 1_000_000)
+	perpGenesis.Markets = []v2types.Market{newMarket(s.pair), auctionMarket}
+	newAMM := func(pair asset.Pair, totalLong int64) v2types.AMM {
+		return v2types.AMM{
+			Pair:            pair,
+			BaseReserve:     sdk.NewDec(10 * common.TO_MICRO),
+			QuoteReserve:    sdk.NewDec(10 * common.TO_MICRO),
+			SqrtDepth:       sdk.NewDec(10 * common.TO_MICRO),
+			PriceMultiplier: sdk.OneDec(),
+			TotalLong:       sdk.NewDec(totalLong),
+			TotalShort:      sdk.ZeroDec(),
+		}
+	}
+	perpGenesis.Amms = []v2types.AMM{newAMM(s.pair, 4_000), newAMM(auctionPair, 1_000)}
+
+	// margin ratios of ~0.05 for the underwater positions and ~0.2 for the
+	// healthy one, against a maintenance margin ratio of 0.0625
+	newPosition := func(pair asset.Pair, trader sdk.AccAddress, margin int64) v2types.Position {
+		return v2types.Position{
+			TraderAddress:                   trader.String(),
+			Pair:                            pair,
+			Size_:                           sdk.NewDec(1_000),
+			Margin:                          sdk.NewDec(margin),
+			OpenNotional:                    sdk.NewDec(1_000),
+			LatestCumulativePremiumFraction: sdk.ZeroDec(),
+		}
+	}
+	for _, trader := range s.underwater {
+		perpGenesis.Positions = append(perpGenesis.Positions, newPosition(s.pair, trader, 50))
+	}
+	perpGenesis.Positions = append(perpGenesis.Positions,
+		newPosition(s.pair, s.healthy, 200),
+		newPosition(auctionPair, s.auctioned, 50),
+	)
+	perpGenesis.LiquidationAuctions = []v2types.LiquidationAuction{{
+		Pair:             auctionPair,
+		TraderAddress:    s.auctioned.String(),
+		InitiatorAddress: s.healthy.String(),
+		StartBlockHeight: 1,
+	}}
+	genesisState[v2types.ModuleName] = encodingConfig.Marshaler.MustMarshalJSON(perpGenesis)
+
+	// the liquidation auctions are priced off the index price
+	var oracleGenesis oracletypes.GenesisState
+	encodingConfig.Marshaler.MustUnmarshalJSON(genesisState[oracletypes.ModuleName], &oracleGenesis)
+	oracleGenesis.ExchangeRates = append(oracleGenesis.ExchangeRates, oracletypes.GenesisExchangeRate{
+		Pair:         auctionPair,
+		ExchangeRate: sdk.OneDec(),
+	})
+	genesisState[oracletypes.ModuleName] = encodingConfig.Marshaler.MustMarshalJSON(&oracleGenesis)
+
+	// the vault holds the margin of the positions
+	var bankGenesis banktypes.GenesisState
+	encodingConfig.Marshaler.MustUnmarshalJSON(genesisState[banktypes.ModuleName], &bankGenesis)
+	bankGenesis.Balances = append(bankGenesis.Balances, banktypes.Balance{
+		Address: authtypes.NewModuleAddress(v2types.VaultModuleAccount).String(),
+		Coins:   sdk.NewCoins(sdk.NewInt64Coin(denoms.NUSD, 1_050)),
+	})
+	genesisState[banktypes.ModuleName] = encodingConfig.Marshaler.MustMarshalJSON(&bankGenesis)
+
+	s.cfg = testutilcli.BuildNetworkConfig(genesisState)
+	s.cfg.NumValidators = 1
+	s.network = testutilcli.NewNetwork(s.T(), s.cfg)
+	s.NoError(s.network.WaitForNextBlock())
+}
+
+func (s *IntegrationTestSuite) TearDownSuite() {
+	s.T().Log("tearing down integration test suite")
+	s.network.Cleanup()
+}
+
+// newLiquidator returns a liquidator signing with the validator account.
+func (s *IntegrationTestSuite) newLiquidator(cfg liquidator.Config, fees string) *liquidator.Liquidator {
+	val := s.network.Validators[0]
+	clientCtx := val.ClientCtx.
+		WithFromAddress(val.Address).
+		WithFromName(val.Moniker).
+		WithBroadcastMode(flags.BroadcastBlock).
+		WithSkipConfirmation(true)
+	txf := tx.Factory{}.
+		WithChainID(s.cfg.ChainID).
+		WithKeybase(val.ClientCtx.Keyring).
+		WithTxConfig(s.cfg.TxConfig).
+		WithAccountRetriever(s.cfg.AccountRetriever).
+		WithGas(2_000_000).
+		WithGasAdjustment(1.5).
+		WithFees(fees)
+
+	bot, err := liquidator.New(clientCtx, txf, cfg, log.NewNopLogger())
+	s.Require().NoError(err)
+	return bot
+}
+
+func (s *IntegrationTestSuite) positionSize(trader sdk.AccAddress) sdk.Dec {
+	resp, err := v2types.NewQueryClient(s.network.Validators[0].ClientCtx).QueryPosition(
+		context.Background(), &v2types.QueryPositionRequest{Pair: s.pair, Trader: trader.String()},
+	)
+	s.Require().NoError(err)
+	return resp.Position.Size_
+}
+
+func (s *IntegrationTestSuite) TestLiquidator() {
+	ctx := context.Background()
+	cfg := liquidator.DefaultConfig()
+
+	s.Run("scan finds the underwater positions not up for auction", func() {
+		liquidations, err := s.newLiquidator(cfg, "10unibi").Scan(ctx)
+		s.Require().NoError(err)
+
+		var traders []string
+		for _, liquidation := range liquidations {
+			s.Equal(s.pair, liquidation.Pair)
+			traders = append(traders, liquidation.Trader)
+		}
+		s.ElementsMatch([]string{s.underwater[0].String(), s.underwater[1].String(), s.underwater[2].String()}, traders)
+	})
+
+	s.Run("dry run doesn't broadcast", func() {
+		dryRunCfg := cfg
+		dryRunCfg.DryRun = true
+		responses, err := s.newLiquidator(dryRunCfg, "10unibi").RunOnce(ctx)
+		s.Require().NoError(err)
+		s.Empty(responses)
+		s.Equal(sdk.NewDec(1_000), s.positionSize(s.underwater[0]))
+	})
+
+	s.Run("transactions above the max fee are not broadcast", func() {
+		maxFeeCfg := cfg
+		maxFeeCfg.MaxFee = sdk.NewCoins(sdk.NewInt64Coin(denoms.NIBI, 1))
+		responses, err := s.newLiquidator(maxFeeCfg, "10unibi").RunOnce(ctx)
+		s.Require().NoError(err)
+		s.Empty(responses)
+		s.Equal(sdk.NewDec(1_000), s.positionSize(s.underwater[0]))
+	})
+
+	s.Run("underwater positions are liquidated in batches", func() {
+		batchCfg := cfg
+		batchCfg.BatchSize = 2
+		responses, err := s.newLiquidator(batchCfg, "10unibi").RunOnce(ctx)
+		s.Require().NoError(err)
+		s.Require().Len(responses, 2)
+		for _, resp := range responses {
+			s.EqualValues(abcitypes.CodeTypeOK, resp.Code, resp.RawLog)
+		}
+
+		for _, trader := range s.underwater {
+			s.True(s.positionSize(trader).LT(sdk.NewDec(1_000)))
+		}
+		s.Equal(sdk.NewDec(1_000), s.positionSize(s.healthy))
+
+		liquidations, err := s.newLiquidator(cfg, "10unibi").Scan(ctx)
+		s.Require().NoError(err)
+		s.Empty(liquidations)
+	})
+
+	s.Run("run follows new blocks until the context is done", func() {
+		runCtx, cancel := context.WithCancel(ctx)
+		done := make(chan error)
+		go func() {
+			done <- s.newLiquidator(cfg, "10unibi").Run(runCtx)
+		}()
+
+		s.Require().NoError(s.network.WaitForNextBlock())
+		cancel()
+		s.NoError(<-done)
+	})
+}
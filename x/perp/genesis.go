@@ -21,6 +21,7 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 	// create positions
 	for _, p := range genState.Positions {
 		k.Positions.Insert(ctx, collections.Join(p.Pair, sdk.MustAccAddressFromBech32(p.TraderAddress)), p)
+		k.Metrics.Insert(ctx, p.Pair, k.GetMetrics(ctx, p.Pair).UpdateOpenInterest(sdk.ZeroDec(), p.Size_))
 	}
 
 	// set params
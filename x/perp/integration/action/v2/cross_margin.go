@@ -0,0 +1,46 @@
+package action
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/NibiruChain/nibiru/app"
+	"github.com/NibiruChain/nibiru/x/common/testutil/action"
+)
+
+type setMarginModeAction struct {
+	Account     sdk.AccAddress
+	CrossMargin bool
+	ShouldFail  bool
+}
+
+func (s setMarginModeAction) Do(app *app.NibiruApp, ctx sdk.Context) (sdk.Context, error, bool) {
+	err := app.PerpKeeperV2.SetMarginMode(ctx, s.Account, s.CrossMargin)
+	if s.ShouldFail && err == nil {
+		return ctx, fmt.Errorf("expected set margin mode to fail, got nil"), true
+	}
+	if !s.ShouldFail && err != nil {
+		return ctx, err, true
+	}
+
+	return ctx, nil, true
+}
+
+// SetMarginMode sets whether the positions of the account are cross-margined.
+func SetMarginMode(account sdk.AccAddress, crossMargin bool) action.Action {
+	return setMarginModeAction{
+		Account:     account,
+		CrossMargin: crossMargin,
+	}
+}
+
+// SetMarginModeExpectingFail sets whether the positions of the account are
+// cross-margined, expecting it to fail.
+func SetMarginModeExpectingFail(account sdk.AccAddress, crossMargin bool) action.Action {
+	return setMarginModeAction{
+		Account:     account,
+		CrossMargin: crossMargin,
+		ShouldFail:  true,
+	}
+}
@@ -0,0 +1,39 @@
+package action
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/NibiruChain/nibiru/app"
+	"github.com/NibiruChain/nibiru/x/common/asset"
+	"github.com/NibiruChain/nibiru/x/common/testutil/action"
+	"github.com/NibiruChain/nibiru/x/common/testutil/testapp"
+	v2types "github.com/NibiruChain/nibiru/x/perp/types/v2"
+)
+
+type seedInsuranceFund struct {
+	pair   asset.Pair
+	amount sdk.Int
+}
+
+func (s seedInsuranceFund) Do(app *app.NibiruApp, ctx sdk.Context) (sdk.Context, error, bool) {
+	coin := sdk.NewCoin(s.pair.QuoteDenom(), s.amount)
+	if err := testapp.FundModuleAccount(app.BankKeeper, ctx, v2types.InsuranceFundModuleAccount, sdk.NewCoins(coin)); err != nil {
+		return ctx, err, true
+	}
+
+	fund := app.PerpKeeperV2.InsuranceFund(ctx, s.pair)
+	fund.Balance = fund.Balance.Add(coin)
+	fund.TotalContributions = fund.TotalContributions.Add(coin)
+	app.PerpKeeperV2.InsuranceFunds.Insert(ctx, s.pair, fund)
+
+	return ctx, nil, true
+}
+
+// SeedInsuranceFund credits amount to the insurance fund of the pair and
+// funds the insurance fund module account accordingly.
+func SeedInsuranceFund(pair asset.Pair, amount sdk.Int) action.Action {
+	return seedInsuranceFund{
+		pair:   pair,
+		amount: amount,
+	}
+}
@@ -0,0 +1,128 @@
+package action
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/NibiruChain/nibiru/app"
+	"github.com/NibiruChain/nibiru/x/common/asset"
+	"github.com/NibiruChain/nibiru/x/common/testutil/action"
+	v2types "github.com/NibiruChain/nibiru/x/perp/types/v2"
+)
+
+type liquidate struct {
+	Liquidator sdk.AccAddress
+	Trader     sdk.AccAddress
+	Pair       asset.Pair
+	ShouldFail bool
+}
+
+func (l liquidate) Do(app *app.NibiruApp, ctx sdk.Context) (sdk.Context, error, bool) {
+	_, _, err := app.PerpKeeperV2.Liquidate(ctx, l.Liquidator, l.Pair, l.Trader)
+	if l.ShouldFail && err == nil {
+		return ctx, fmt.Errorf("expected liquidation to fail, got nil"), true
+	}
+	if !l.ShouldFail && err != nil {
+		return ctx, err, true
+	}
+
+	return ctx, nil, true
+}
+
+// Liquidate liquidates the position of the trader in the given pair.
+func Liquidate(liquidator, trader sdk.AccAddress, pair asset.Pair) action.Action {
+	return liquidate{
+		Liquidator: liquidator,
+		Trader:     trader,
+		Pair:       pair,
+	}
+}
+
+// LiquidateExpectingFail liquidates the position of the trader in the given
+// pair, expecting the liquidation to fail.
+func LiquidateExpectingFail(liquidator, trader sdk.AccAddress, pair asset.Pair) action.Action {
+	return liquidate{
+		Liquidator: liquidator,
+		Trader:     trader,
+		Pair:       pair,
+		ShouldFail: true,
+	}
+}
+
+type multiLiquidate struct {
+	Liquidator   sdk.AccAddress
+	Liquidations []*v2types.MsgMultiLiquidate_Liquidation
+	ShouldFail   bool
+}
+
+func (m multiLiquidate) Do(app *app.NibiruApp, ctx sdk.Context) (sdk.Context, error, bool) {
+	_, err := app.PerpKeeperV2.MultiLiquidate(ctx, m.Liquidator, m.Liquidations)
+	if m.ShouldFail && err == nil {
+		return ctx, fmt.Errorf("expected multi liquidation to fail, got nil"), true
+	}
+	if !m.ShouldFail && err != nil {
+		return ctx, err, true
+	}
+
+	return ctx, nil, true
+}
+
+// MultiLiquidate liquidates a batch of positions.
+func MultiLiquidate(
+	liquidator sdk.AccAddress, shouldAllFail bool, liquidations ...*v2types.MsgMultiLiquidate_Liquidation,
+) action.Action {
+	return multiLiquidate{
+		Liquidator:   liquidator,
+		Liquidations: liquidations,
+		ShouldFail:   shouldAllFail,
+	}
+}
+
+type bidLiquidationAuction struct {
+	Liquidator sdk.AccAddress
+	Trader     sdk.AccAddress
+	Pair       asset.Pair
+	Size       sdk.Dec
+	Margin     sdk.Int
+	ShouldFail bool
+}
+
+func (b bidLiquidationAuction) Do(app *app.NibiruApp, ctx sdk.Context) (sdk.Context, error, bool) {
+	_, err := app.PerpKeeperV2.BidLiquidationAuction(
+		ctx, b.Liquidator, b.Pair, b.Trader, b.Size, sdk.NewCoin(b.Pair.QuoteDenom(), b.Margin),
+	)
+	if b.ShouldFail && err == nil {
+		return ctx, fmt.Errorf("expected liquidation auction bid to fail, got nil"), true
+	}
+	if !b.ShouldFail && err != nil {
+		return ctx, err, true
+	}
+
+	return ctx, nil, true
+}
+
+// BidLiquidationAuction takes over size of the auctioned position of the
+// trader, the whole position if size is zero.
+func BidLiquidationAuction(liquidator, trader sdk.AccAddress, pair asset.Pair, size sdk.Dec, margin sdk.Int) action.Action {
+	return bidLiquidationAuction{
+		Liquidator: liquidator,
+		Trader:     trader,
+		Pair:       pair,
+		Size:       size,
+		Margin:     margin,
+	}
+}
+
+// BidLiquidationAuctionExpectingFail bids on the auctioned position of the
+// trader, expecting the bid to fail.
+func BidLiquidationAuctionExpectingFail(liquidator, trader sdk.AccAddress, pair asset.Pair, size sdk.Dec, margin sdk.Int) action.Action {
+	return bidLiquidationAuction{
+		Liquidator: liquidator,
+		Trader:     trader,
+		Pair:       pair,
+		Size:       size,
+		Margin:     margin,
+		ShouldFail: true,
+	}
+}
@@ -0,0 +1,93 @@
+package action
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/NibiruChain/nibiru/app"
+	"github.com/NibiruChain/nibiru/x/common/asset"
+	"github.com/NibiruChain/nibiru/x/common/testutil/action"
+)
+
+type addMarginAction struct {
+	Account    sdk.AccAddress
+	Pair       asset.Pair
+	Margin     sdk.Int
+	ShouldFail bool
+}
+
+func (a addMarginAction) Do(app *app.NibiruApp, ctx sdk.Context) (sdk.Context, error, bool) {
+	_, err := app.PerpKeeperV2.AddMargin(
+		ctx, a.Pair, a.Account, sdk.NewCoin(a.Pair.QuoteDenom(), a.Margin),
+	)
+	if a.ShouldFail && err == nil {
+		return ctx, fmt.Errorf("expected add margin to fail, got nil"), true
+	}
+	if !a.ShouldFail && err != nil {
+		return ctx, err, true
+	}
+
+	return ctx, nil, true
+}
+
+// AddMargin adds margin to the position of the account in the given pair.
+func AddMargin(account sdk.AccAddress, pair asset.Pair, margin sdk.Int) action.Action {
+	return addMarginAction{
+		Account: account,
+		Pair:    pair,
+		Margin:  margin,
+	}
+}
+
+// AddMarginExpectingFail adds margin to the position of the account in the
+// given pair, expecting it to fail.
+func AddMarginExpectingFail(account sdk.AccAddress, pair asset.Pair, margin sdk.Int) action.Action {
+	return addMarginAction{
+		Account:    account,
+		Pair:       pair,
+		Margin:     margin,
+		ShouldFail: true,
+	}
+}
+
+type removeMarginAction struct {
+	Account    sdk.AccAddress
+	Pair       asset.Pair
+	Margin     sdk.Int
+	ShouldFail bool
+}
+
+func (r removeMarginAction) Do(app *app.NibiruApp, ctx sdk.Context) (sdk.Context, error, bool) {
+	_, _, _, err := app.PerpKeeperV2.RemoveMargin(
+		ctx, r.Pair, r.Account, sdk.NewCoin(r.Pair.QuoteDenom(), r.Margin),
+	)
+	if r.ShouldFail && err == nil {
+		return ctx, fmt.Errorf("expected remove margin to fail, got nil"), true
+	}
+	if !r.ShouldFail && err != nil {
+		return ctx, err, true
+	}
+
+	return ctx, nil, true
+}
+
+// RemoveMargin removes margin from the position of the account in the given pair.
+func RemoveMargin(account sdk.AccAddress, pair asset.Pair, margin sdk.Int) action.Action {
+	return removeMarginAction{
+		Account: account,
+		Pair:    pair,
+		Margin:  margin,
+	}
+}
+
+// RemoveMarginExpectingFail removes margin from the position of the account
+// in the given pair, expecting it to fail.
+func RemoveMarginExpectingFail(account sdk.AccAddress, pair asset.Pair, margin sdk.Int) action.Action {
+	return removeMarginAction{
+		Account:    account,
+		Pair:       pair,
+		Margin:     margin,
+		ShouldFail: true,
+	}
+}
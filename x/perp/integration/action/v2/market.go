@@ -13,6 +13,7 @@ import (
 
 	"github.com/NibiruChain/nibiru/app"
 	epochstypes "github.com/NibiruChain/nibiru/x/epochs/types"
+	perpv2 "github.com/NibiruChain/nibiru/x/perp/module/v2"
 	v2types "github.com/NibiruChain/nibiru/x/perp/types/v2"
 )
 
@@ -35,7 +36,7 @@ func (c CreateMarketAction) Do(app *app.NibiruApp, ctx sdk.Context) (sdk.Context
 }
 
 // CreateCustomMarket creates a market with custom parameters
-func CreateCustomMarket(pair asset.Pair, marketModifiers ...marketModifier) action.Action {
+func CreateCustomMarket(pair asset.Pair, MarketModifiers ...MarketModifier) action.Action {
 	market := v2types.Market{
 		Pair:                            pair,
 		Enabled:                         true,
@@ -50,8 +51,20 @@ func CreateCustomMarket(pair asset.Pair, marketModifiers ...marketModifier) acti
 		PriceFluctuationLimitRatio:      sdk.MustNewDecFromStr("0.1000"),
 		MaintenanceMarginRatio:          sdk.MustNewDecFromStr("0.0625"),
 		MaxLeverage:                     sdk.NewDec(10),
+		InsuranceFundFeeShare:           sdk.ZeroDec(),
+		MaxFundingRate:                  sdk.ZeroDec(),
+		MinFundingRate:                  sdk.ZeroDec(),
+		InterestRate:                    sdk.ZeroDec(),
+		MaxSpreadRatio:                  sdk.ZeroDec(),
+		MaxSkewRatio:                    sdk.ZeroDec(),
+		AmmUpdateBudget:                 sdk.ZeroInt(),
+		CircuitBreakerPriceChangeRatio:  sdk.ZeroDec(),
+		MaxOpenInterest:                 sdk.ZeroDec(),
+		MaxPositionNotional:             sdk.ZeroDec(),
+		AuctionDiscountPerBlock:         sdk.ZeroDec(),
+		AuctionMaxDiscount:              sdk.ZeroDec(),
 	}
-	for _, modifier := range marketModifiers {
+	for _, modifier := range MarketModifiers {
 		modifier(&market)
 	}
 
@@ -71,10 +84,202 @@ func CreateCustomMarket(pair asset.Pair, marketModifiers ...marketModifier) acti
 	}
 }
 
-type marketModifier func(market *v2types.Market)
+type MarketModifier func(market *v2types.Market)
 
-func WithPrepaidBadDebt(amount sdk.Int) marketModifier {
+func WithPrepaidBadDebt(amount sdk.Int) MarketModifier {
 	return func(market *v2types.Market) {
 		market.PrepaidBadDebt = sdk.NewCoin(market.Pair.QuoteDenom(), amount)
 	}
 }
+
+func WithLiquidationFeeRatio(ratio sdk.Dec) MarketModifier {
+	return func(market *v2types.Market) {
+		market.LiquidationFeeRatio = ratio
+	}
+}
+
+func WithLatestMarketCPF(cpf sdk.Dec) MarketModifier {
+	return func(market *v2types.Market) {
+		market.LatestCumulativePremiumFraction = cpf
+	}
+}
+
+func WithInsuranceFundFeeShare(share sdk.Dec) MarketModifier {
+	return func(market *v2types.Market) {
+		market.InsuranceFundFeeShare = share
+	}
+}
+
+func WithAutoDeleveragingEnabled(enabled bool) MarketModifier {
+	return func(market *v2types.Market) {
+		market.AutoDeleveragingEnabled = enabled
+	}
+}
+
+type passSetAutoDeleveragingProposal struct {
+	pair    asset.Pair
+	enabled bool
+}
+
+func (p passSetAutoDeleveragingProposal) Do(app *app.NibiruApp, ctx sdk.Context) (sdk.Context, error, bool) {
+	err := perpv2.NewProposalHandler(app.PerpKeeperV2)(ctx, &v2types.SetAutoDeleveragingProposal{
+		Title:       "set auto-deleveraging",
+		Description: "set auto-deleveraging",
+		Pair:        p.pair,
+		Enabled:     p.enabled,
+	})
+	return ctx, err, true
+}
+
+// PassSetAutoDeleveragingProposal executes a passed governance proposal
+// turning auto-deleveraging on or off for the market.
+func PassSetAutoDeleveragingProposal(pair asset.Pair, enabled bool) action.Action {
+	return passSetAutoDeleveragingProposal{
+		pair:    pair,
+		enabled: enabled,
+	}
+}
+
+func WithFundingRateBounds(min sdk.Dec, max sdk.Dec) MarketModifier {
+	return func(market *v2types.Market) {
+		market.MinFundingRate = min
+		market.MaxFundingRate = max
+	}
+}
+
+func WithInterestRate(rate sdk.Dec) MarketModifier {
+	return func(market *v2types.Market) {
+		market.InterestRate = rate
+	}
+}
+
+func WithEnabled(enabled bool) MarketModifier {
+	return func(market *v2types.Market) {
+		market.Enabled = enabled
+	}
+}
+
+func WithMaxSpreadRatio(ratio sdk.Dec) MarketModifier {
+	return func(market *v2types.Market) {
+		market.MaxSpreadRatio = ratio
+	}
+}
+
+func WithMaxSkewRatio(ratio sdk.Dec) MarketModifier {
+	return func(market *v2types.Market) {
+		market.MaxSkewRatio = ratio
+	}
+}
+
+func WithAmmUpdateBudget(budget sdk.Int) MarketModifier {
+	return func(market *v2types.Market) {
+		market.AmmUpdateBudget = budget
+	}
+}
+
+func WithCircuitBreakerPriceChange(ratio sdk.Dec, windowBlocks uint64) MarketModifier {
+	return func(market *v2types.Market) {
+		market.CircuitBreakerPriceChangeRatio = ratio
+		market.CircuitBreakerWindowBlocks = windowBlocks
+	}
+}
+
+func WithCircuitBreakerMaxFluctuationViolations(maxViolations uint64) MarketModifier {
+	return func(market *v2types.Market) {
+		market.CircuitBreakerMaxFluctuationViolations = maxViolations
+	}
+}
+
+func WithMaxOpenInterest(maxOpenInterest sdk.Dec) MarketModifier {
+	return func(market *v2types.Market) {
+		market.MaxOpenInterest = maxOpenInterest
+	}
+}
+
+func WithMaxPositionNotional(maxPositionNotional sdk.Dec) MarketModifier {
+	return func(market *v2types.Market) {
+		market.MaxPositionNotional = maxPositionNotional
+	}
+}
+
+func WithMarginTiers(tiers ...v2types.MarginTier) MarketModifier {
+	return func(market *v2types.Market) {
+		market.MarginTiers = tiers
+	}
+}
+
+func WithLiquidationAuction(discountPerBlock sdk.Dec, maxDiscount sdk.Dec, durationBlocks uint64) MarketModifier {
+	return func(market *v2types.Market) {
+		market.WithLiquidationAuction(discountPerBlock, maxDiscount, durationBlocks)
+	}
+}
+
+type passResetCircuitBreakerProposal struct {
+	pair asset.Pair
+}
+
+func (p passResetCircuitBreakerProposal) Do(app *app.NibiruApp, ctx sdk.Context) (sdk.Context, error, bool) {
+	err := perpv2.NewProposalHandler(app.PerpKeeperV2)(ctx, &v2types.ResetCircuitBreakerProposal{
+		Title:       "reset circuit breaker",
+		Description: "reset circuit breaker",
+		Pair:        p.pair,
+	})
+	return ctx, err, true
+}
+
+// PassResetCircuitBreakerProposal executes a passed governance proposal
+// resetting the circuit breaker of the market.
+func PassResetCircuitBreakerProposal(pair asset.Pair) action.Action {
+	return passResetCircuitBreakerProposal{
+		pair: pair,
+	}
+}
+
+type editPriceMultiplier struct {
+	pair            asset.Pair
+	priceMultiplier sdk.Dec
+}
+
+func (e editPriceMultiplier) Do(app *app.NibiruApp, ctx sdk.Context) (sdk.Context, error, bool) {
+	amm, err := app.PerpKeeperV2.AMMs.Get(ctx, e.pair)
+	if err != nil {
+		return ctx, err, true
+	}
+
+	amm.PriceMultiplier = e.priceMultiplier
+	app.PerpKeeperV2.AMMs.Insert(ctx, e.pair, amm)
+	return ctx, nil, true
+}
+
+// EditPriceMultiplier sets the price multiplier of the AMM of the market,
+// without settling the cost of the repeg.
+func EditPriceMultiplier(pair asset.Pair, priceMultiplier sdk.Dec) action.Action {
+	return editPriceMultiplier{
+		pair:            pair,
+		priceMultiplier: priceMultiplier,
+	}
+}
+
+type editMaxOpenInterest struct {
+	pair            asset.Pair
+	maxOpenInterest sdk.Dec
+}
+
+func (e editMaxOpenInterest) Do(app *app.NibiruApp, ctx sdk.Context) (sdk.Context, error, bool) {
+	market, err := app.PerpKeeperV2.Markets.Get(ctx, e.pair)
+	if err != nil {
+		return ctx, err, true
+	}
+
+	market.MaxOpenInterest = e.maxOpenInterest
+	app.PerpKeeperV2.Markets.Insert(ctx, e.pair, market)
+	return ctx, nil, true
+}
+
+// EditMaxOpenInterest changes the max open interest of an existing market.
+func EditMaxOpenInterest(pair asset.Pair, maxOpenInterest sdk.Dec) action.Action {
+	return editMaxOpenInterest{
+		pair:            pair,
+		maxOpenInterest: maxOpenInterest,
+	}
+}
@@ -1,11 +1,14 @@
 package action
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/NibiruChain/nibiru/app"
 	"github.com/NibiruChain/nibiru/x/common/asset"
 	"github.com/NibiruChain/nibiru/x/common/testutil/action"
+	v2types "github.com/NibiruChain/nibiru/x/perp/types/v2"
 )
 
 type changeLiquidationFeeRatio struct {
@@ -45,3 +48,130 @@ func (c changeEnableParameter) Do(app *app.NibiruApp, ctx sdk.Context) (sdk.Cont
 
 	return ctx, nil, true
 }
+
+type setStopped struct {
+	Stopped bool
+}
+
+func (s setStopped) Do(app *app.NibiruApp, ctx sdk.Context) (sdk.Context, error, bool) {
+	params := app.PerpKeeperV2.GetParams(ctx)
+	params.Stopped = s.Stopped
+	app.PerpKeeperV2.SetParams(ctx, params)
+
+	return ctx, nil, true
+}
+
+// SetStopped stops or resumes trading on all markets.
+func SetStopped(stopped bool) action.Action {
+	return setStopped{
+		Stopped: stopped,
+	}
+}
+
+type setFeeTiers struct {
+	FeeTiers []v2types.FeeTier
+}
+
+func (s setFeeTiers) Do(app *app.NibiruApp, ctx sdk.Context) (sdk.Context, error, bool) {
+	params := app.PerpKeeperV2.GetParams(ctx)
+	params.FeeTiers = s.FeeTiers
+	if err := params.Validate(); err != nil {
+		return ctx, err, true
+	}
+	app.PerpKeeperV2.SetParams(ctx, params)
+
+	return ctx, nil, true
+}
+
+// SetFeeTiers sets the volume based fee tiers of the module.
+func SetFeeTiers(feeTiers ...v2types.FeeTier) action.Action {
+	return setFeeTiers{
+		FeeTiers: feeTiers,
+	}
+}
+
+type setReferralRebateRatio struct {
+	Ratio sdk.Dec
+}
+
+func (s setReferralRebateRatio) Do(app *app.NibiruApp, ctx sdk.Context) (sdk.Context, error, bool) {
+	params := app.PerpKeeperV2.GetParams(ctx)
+	params.ReferralRebateRatio = s.Ratio
+	app.PerpKeeperV2.SetParams(ctx, params)
+
+	return ctx, nil, true
+}
+
+// SetReferralRebateRatio sets the share of the exchange fee rebated to referrers.
+func SetReferralRebateRatio(ratio sdk.Dec) action.Action {
+	return setReferralRebateRatio{
+		Ratio: ratio,
+	}
+}
+
+type setTraderHistory struct {
+	Enabled   bool
+	Retention time.Duration
+}
+
+func (s setTraderHistory) Do(app *app.NibiruApp, ctx sdk.Context) (sdk.Context, error, bool) {
+	params := app.PerpKeeperV2.GetParams(ctx)
+	params.TraderHistoryEnabled = s.Enabled
+	params.TraderHistoryRetention = s.Retention
+	app.PerpKeeperV2.SetParams(ctx, params)
+
+	return ctx, nil, true
+}
+
+// SetTraderHistory enables or disables the trader history and sets how long
+// its entries are kept.
+func SetTraderHistory(enabled bool, retention time.Duration) action.Action {
+	return setTraderHistory{
+		Enabled:   enabled,
+		Retention: retention,
+	}
+}
+
+type setAuctionLiquidators struct {
+	Liquidators []string
+}
+
+func (s setAuctionLiquidators) Do(app *app.NibiruApp, ctx sdk.Context) (sdk.Context, error, bool) {
+	params := app.PerpKeeperV2.GetParams(ctx)
+	params.AuctionLiquidators = s.Liquidators
+	if err := params.Validate(); err != nil {
+		return ctx, err, true
+	}
+	app.PerpKeeperV2.SetParams(ctx, params)
+
+	return ctx, nil, true
+}
+
+// SetAuctionLiquidators sets the accounts allowed to bid on liquidation
+// auctions.
+func SetAuctionLiquidators(liquidators ...sdk.AccAddress) action.Action {
+	s := setAuctionLiquidators{}
+	for _, liquidator := range liquidators {
+		s.Liquidators = append(s.Liquidators, liquidator.String())
+	}
+	return s
+}
+
+type setReserveSnapshotRetention struct {
+	Retention time.Duration
+}
+
+func (s setReserveSnapshotRetention) Do(app *app.NibiruApp, ctx sdk.Context) (sdk.Context, error, bool) {
+	params := app.PerpKeeperV2.GetParams(ctx)
+	params.ReserveSnapshotRetention = s.Retention
+	app.PerpKeeperV2.SetParams(ctx, params)
+
+	return ctx, nil, true
+}
+
+// SetReserveSnapshotRetention sets how long the reserve snapshots are kept.
+func SetReserveSnapshotRetention(retention time.Duration) action.Action {
+	return setReserveSnapshotRetention{
+		Retention: retention,
+	}
+}
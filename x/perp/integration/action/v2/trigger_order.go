@@ -0,0 +1,112 @@
+package action
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/NibiruChain/nibiru/app"
+	"github.com/NibiruChain/nibiru/x/common/asset"
+	"github.com/NibiruChain/nibiru/x/common/testutil/action"
+	v2types "github.com/NibiruChain/nibiru/x/perp/types/v2"
+)
+
+type placeTriggerOrderAction struct {
+	Order      v2types.TriggerOrder
+	ShouldFail bool
+}
+
+func (p placeTriggerOrderAction) Do(app *app.NibiruApp, ctx sdk.Context) (sdk.Context, error, bool) {
+	_, err := app.PerpKeeperV2.PlaceTriggerOrder(ctx, p.Order)
+	if p.ShouldFail && err == nil {
+		return ctx, fmt.Errorf("expected place trigger order to fail, got nil"), true
+	}
+	if !p.ShouldFail && err != nil {
+		return ctx, err, true
+	}
+
+	return ctx, nil, true
+}
+
+// PlaceLimitOrder places a LIMIT_OPEN trigger order on the mark price.
+func PlaceLimitOrder(
+	account sdk.AccAddress, pair asset.Pair, side v2types.Direction, triggerPrice sdk.Dec, collateral sdk.Int, leverage sdk.Dec,
+) action.Action {
+	return placeTriggerOrderAction{
+		Order: v2types.TriggerOrder{
+			TraderAddress:        account.String(),
+			Pair:                 pair,
+			OrderType:            v2types.TriggerOrderType_LIMIT_OPEN,
+			Side:                 side,
+			TriggerPrice:         triggerPrice,
+			PriceSource:          v2types.TriggerPriceSource_MARK,
+			Collateral:           sdk.NewCoin(pair.QuoteDenom(), collateral),
+			Leverage:             leverage,
+			BaseAssetAmountLimit: sdk.ZeroDec(),
+		},
+	}
+}
+
+// PlaceCloseOrder places a STOP_LOSS or TAKE_PROFIT trigger order.
+func PlaceCloseOrder(
+	account sdk.AccAddress, pair asset.Pair, orderType v2types.TriggerOrderType, triggerPrice sdk.Dec, priceSource v2types.TriggerPriceSource,
+) action.Action {
+	return placeTriggerOrderAction{
+		Order: v2types.TriggerOrder{
+			TraderAddress: account.String(),
+			Pair:          pair,
+			OrderType:     orderType,
+			TriggerPrice:  triggerPrice,
+			PriceSource:   priceSource,
+		},
+	}
+}
+
+// PlaceCloseOrderExpectingFail places a STOP_LOSS or TAKE_PROFIT trigger
+// order on the mark price, expecting it to fail.
+func PlaceCloseOrderExpectingFail(
+	account sdk.AccAddress, pair asset.Pair, orderType v2types.TriggerOrderType, triggerPrice sdk.Dec,
+) action.Action {
+	return placeTriggerOrderAction{
+		Order: v2types.TriggerOrder{
+			TraderAddress: account.String(),
+			Pair:          pair,
+			OrderType:     orderType,
+			TriggerPrice:  triggerPrice,
+			PriceSource:   v2types.TriggerPriceSource_MARK,
+		},
+		ShouldFail: true,
+	}
+}
+
+type cancelTriggerOrderAction struct {
+	Account sdk.AccAddress
+	Pair    asset.Pair
+	OrderID uint64
+}
+
+func (c cancelTriggerOrderAction) Do(app *app.NibiruApp, ctx sdk.Context) (sdk.Context, error, bool) {
+	_, err := app.PerpKeeperV2.CancelTriggerOrder(ctx, c.Pair, c.Account, c.OrderID)
+	return ctx, err, true
+}
+
+// CancelTriggerOrder cancels the trigger order of the account.
+func CancelTriggerOrder(account sdk.AccAddress, pair asset.Pair, orderID uint64) action.Action {
+	return cancelTriggerOrderAction{
+		Account: account,
+		Pair:    pair,
+		OrderID: orderID,
+	}
+}
+
+type executeTriggerOrdersAction struct{}
+
+func (e executeTriggerOrdersAction) Do(app *app.NibiruApp, ctx sdk.Context) (sdk.Context, error, bool) {
+	app.PerpKeeperV2.ExecuteTriggerOrders(ctx)
+	return ctx, nil, true
+}
+
+// ExecuteTriggerOrders runs the end blocker check of the trigger orders.
+func ExecuteTriggerOrders() action.Action {
+	return executeTriggerOrdersAction{}
+}
@@ -0,0 +1,67 @@
+package assertion
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/NibiruChain/nibiru/app"
+	"github.com/NibiruChain/nibiru/x/common/asset"
+	v2types "github.com/NibiruChain/nibiru/x/perp/types/v2"
+)
+
+type AMMChecker func(amm v2types.AMM) error
+
+type ammShouldBeEqual struct {
+	Pair     asset.Pair
+	Checkers []AMMChecker
+}
+
+func (a ammShouldBeEqual) Do(app *app.NibiruApp, ctx sdk.Context) (sdk.Context, error, bool) {
+	amm, err := app.PerpKeeperV2.AMMs.Get(ctx, a.Pair)
+	if err != nil {
+		return ctx, err, false
+	}
+
+	for _, checker := range a.Checkers {
+		if err := checker(amm); err != nil {
+			return ctx, err, false
+		}
+	}
+
+	return ctx, nil, false
+}
+
+func AMMShouldBeEqual(pair asset.Pair, ammCheckers ...AMMChecker) ammShouldBeEqual {
+	return ammShouldBeEqual{
+		Pair:     pair,
+		Checkers: ammCheckers,
+	}
+}
+
+func AMM_PriceMultiplierShouldBeEqualTo(expected sdk.Dec) AMMChecker {
+	return func(amm v2types.AMM) error {
+		if !amm.PriceMultiplier.Equal(expected) {
+			return fmt.Errorf("expected price multiplier to be %s, got %s", expected, amm.PriceMultiplier)
+		}
+		return nil
+	}
+}
+
+func AMM_SqrtDepthShouldBeEqualTo(expected sdk.Dec) AMMChecker {
+	return func(amm v2types.AMM) error {
+		if !amm.SqrtDepth.Equal(expected) {
+			return fmt.Errorf("expected sqrt depth to be %s, got %s", expected, amm.SqrtDepth)
+		}
+		return nil
+	}
+}
+
+func AMM_BaseReserveShouldBeEqualTo(expected sdk.Dec) AMMChecker {
+	return func(amm v2types.AMM) error {
+		if !amm.BaseReserve.Equal(expected) {
+			return fmt.Errorf("expected base reserve to be %s, got %s", expected, amm.BaseReserve)
+		}
+		return nil
+	}
+}
@@ -0,0 +1,64 @@
+package assertion
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/NibiruChain/nibiru/app"
+	"github.com/NibiruChain/nibiru/x/common/asset"
+	v2types "github.com/NibiruChain/nibiru/x/perp/types/v2"
+)
+
+type CircuitBreakerChecker func(breaker v2types.CircuitBreaker) error
+
+type circuitBreakerShouldBeEqual struct {
+	Pair     asset.Pair
+	Checkers []CircuitBreakerChecker
+}
+
+func (c circuitBreakerShouldBeEqual) Do(app *app.NibiruApp, ctx sdk.Context) (sdk.Context, error, bool) {
+	breaker := app.PerpKeeperV2.CircuitBreaker(ctx, c.Pair)
+
+	for _, checker := range c.Checkers {
+		if err := checker(breaker); err != nil {
+			return ctx, err, false
+		}
+	}
+
+	return ctx, nil, false
+}
+
+func CircuitBreakerShouldBeEqual(pair asset.Pair, checkers ...CircuitBreakerChecker) circuitBreakerShouldBeEqual {
+	return circuitBreakerShouldBeEqual{
+		Pair:     pair,
+		Checkers: checkers,
+	}
+}
+
+func CircuitBreaker_TrippedShouldBeEqualTo(expected bool) CircuitBreakerChecker {
+	return func(breaker v2types.CircuitBreaker) error {
+		if breaker.Tripped != expected {
+			return fmt.Errorf("expected circuit breaker tripped to be %t, got %t", expected, breaker.Tripped)
+		}
+		return nil
+	}
+}
+
+func CircuitBreaker_ReasonShouldBeEqualTo(expected v2types.CircuitBreakerReason) CircuitBreakerChecker {
+	return func(breaker v2types.CircuitBreaker) error {
+		if breaker.Reason != expected {
+			return fmt.Errorf("expected circuit breaker reason to be %s, got %s", expected, breaker.Reason)
+		}
+		return nil
+	}
+}
+
+func CircuitBreaker_FluctuationViolationsShouldBeEqualTo(expected uint64) CircuitBreakerChecker {
+	return func(breaker v2types.CircuitBreaker) error {
+		if breaker.FluctuationViolations != expected {
+			return fmt.Errorf("expected circuit breaker fluctuation violations to be %d, got %d", expected, breaker.FluctuationViolations)
+		}
+		return nil
+	}
+}
@@ -102,3 +102,62 @@ func PositionChangedEventShouldBeEqual(
 		ExpectedEvent: expectedEvent,
 	}
 }
+
+type autoDeleveragedEventShouldBeEmitted struct {
+	Trader          sdk.AccAddress
+	ExchangedSize   sdk.Dec
+	BankruptcyPrice sdk.Dec
+	Haircut         sdk.Int
+}
+
+func (a autoDeleveragedEventShouldBeEmitted) Do(_ *app.NibiruApp, ctx sdk.Context) (sdk.Context, error, bool) {
+	for _, abciEvent := range ctx.EventManager().Events() {
+		if abciEvent.Type != proto.MessageName(&v2types.AutoDeleveragedEvent{}) {
+			continue
+		}
+		typedEvent, err := sdk.ParseTypedEvent(abci.Event{
+			Type:       abciEvent.Type,
+			Attributes: abciEvent.Attributes,
+		})
+		if err != nil {
+			return ctx, err, false
+		}
+
+		theEvent, ok := typedEvent.(*v2types.AutoDeleveragedEvent)
+		if !ok {
+			return ctx, fmt.Errorf("expected event is not of type AutoDeleveragedEvent"), false
+		}
+		if theEvent.TraderAddress != a.Trader.String() {
+			continue
+		}
+
+		if !theEvent.ExchangedSize.Equal(a.ExchangedSize) {
+			return ctx, fmt.Errorf("expected exchanged size %s, got %s", a.ExchangedSize, theEvent.ExchangedSize), false
+		}
+
+		if !theEvent.BankruptcyPrice.Equal(a.BankruptcyPrice) {
+			return ctx, fmt.Errorf("expected bankruptcy price %s, got %s", a.BankruptcyPrice, theEvent.BankruptcyPrice), false
+		}
+
+		if !theEvent.Haircut.Amount.Equal(a.Haircut) {
+			return ctx, fmt.Errorf("expected haircut %s, got %s", a.Haircut, theEvent.Haircut.Amount), false
+		}
+
+		return ctx, nil, false
+	}
+
+	return ctx, fmt.Errorf("auto-deleveraged event of %s not found", a.Trader), false
+}
+
+// AutoDeleveragedEventShouldBeEmitted checks that the position of the trader
+// was deleveraged by the given size, at the given bankruptcy price and haircut.
+func AutoDeleveragedEventShouldBeEmitted(
+	trader sdk.AccAddress, exchangedSize sdk.Dec, bankruptcyPrice sdk.Dec, haircut sdk.Int,
+) action.Action {
+	return autoDeleveragedEventShouldBeEmitted{
+		Trader:          trader,
+		ExchangedSize:   exchangedSize,
+		BankruptcyPrice: bankruptcyPrice,
+		Haircut:         haircut,
+	}
+}
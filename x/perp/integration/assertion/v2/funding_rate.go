@@ -0,0 +1,49 @@
+package assertion
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/NibiruChain/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/NibiruChain/nibiru/app"
+	"github.com/NibiruChain/nibiru/x/common/asset"
+	"github.com/NibiruChain/nibiru/x/common/testutil/action"
+)
+
+type latestFundingRateShouldBeEqual struct {
+	Pair            asset.Pair
+	ExpectedRate    sdk.Dec
+	ExpectedClamped bool
+}
+
+func (l latestFundingRateShouldBeEqual) Do(app *app.NibiruApp, ctx sdk.Context) (sdk.Context, error, bool) {
+	fundingRates := app.PerpKeeperV2.FundingRates.Iterate(
+		ctx,
+		collections.PairRange[asset.Pair, time.Time]{}.Prefix(l.Pair).Descending(),
+	).Values()
+	if len(fundingRates) == 0 {
+		return ctx, fmt.Errorf("no funding rate recorded for %s", l.Pair), false
+	}
+
+	latest := fundingRates[0]
+	if !latest.FundingRate.Equal(l.ExpectedRate) {
+		return ctx, fmt.Errorf("expected funding rate %s, got %s", l.ExpectedRate, latest.FundingRate), false
+	}
+	if latest.Clamped != l.ExpectedClamped {
+		return ctx, fmt.Errorf("expected funding rate clamped %t, got %t", l.ExpectedClamped, latest.Clamped), false
+	}
+
+	return ctx, nil, false
+}
+
+// LatestFundingRateShouldBeEqual checks the funding rate of the last funding
+// interval of the market.
+func LatestFundingRateShouldBeEqual(pair asset.Pair, expectedRate sdk.Dec, expectedClamped bool) action.Action {
+	return latestFundingRateShouldBeEqual{
+		Pair:            pair,
+		ExpectedRate:    expectedRate,
+		ExpectedClamped: expectedClamped,
+	}
+}
@@ -0,0 +1,84 @@
+package assertion
+
+import (
+	"fmt"
+
+	"github.com/NibiruChain/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/NibiruChain/nibiru/app"
+	"github.com/NibiruChain/nibiru/x/common/asset"
+	"github.com/NibiruChain/nibiru/x/common/testutil/action"
+)
+
+type insuranceFundBalanceShouldBeEqual struct {
+	Pair     asset.Pair
+	Expected sdk.Int
+}
+
+func (i insuranceFundBalanceShouldBeEqual) Do(app *app.NibiruApp, ctx sdk.Context) (sdk.Context, error, bool) {
+	fund := app.PerpKeeperV2.InsuranceFund(ctx, i.Pair)
+	if !fund.Balance.Amount.Equal(i.Expected) {
+		return ctx, fmt.Errorf("expected insurance fund balance of %s to be %s, got %s", i.Pair, i.Expected, fund.Balance.Amount), false
+	}
+
+	return ctx, nil, false
+}
+
+func InsuranceFundBalanceShouldBeEqual(pair asset.Pair, expected sdk.Int) action.Action {
+	return insuranceFundBalanceShouldBeEqual{
+		Pair:     pair,
+		Expected: expected,
+	}
+}
+
+type insuranceFundDrawDownShouldBeEqual struct {
+	Pair            asset.Pair
+	ID              uint64
+	ExpectedAmount  sdk.Int
+	ExpectedBadDebt sdk.Int
+}
+
+func (i insuranceFundDrawDownShouldBeEqual) Do(app *app.NibiruApp, ctx sdk.Context) (sdk.Context, error, bool) {
+	drawDown, err := app.PerpKeeperV2.InsuranceFundDrawDowns.Get(ctx, collections.Join(i.Pair, i.ID))
+	if err != nil {
+		return ctx, fmt.Errorf("insurance fund draw-down %d should exist: %w", i.ID, err), false
+	}
+	if !drawDown.Amount.Amount.Equal(i.ExpectedAmount) {
+		return ctx, fmt.Errorf("expected draw-down amount %s, got %s", i.ExpectedAmount, drawDown.Amount.Amount), false
+	}
+	if !drawDown.BadDebt.Amount.Equal(i.ExpectedBadDebt) {
+		return ctx, fmt.Errorf("expected draw-down bad debt %s, got %s", i.ExpectedBadDebt, drawDown.BadDebt.Amount), false
+	}
+
+	return ctx, nil, false
+}
+
+func InsuranceFundDrawDownShouldBeEqual(pair asset.Pair, id uint64, amount sdk.Int, badDebt sdk.Int) action.Action {
+	return insuranceFundDrawDownShouldBeEqual{
+		Pair:            pair,
+		ID:              id,
+		ExpectedAmount:  amount,
+		ExpectedBadDebt: badDebt,
+	}
+}
+
+type insuranceFundDrawDownShouldNotExist struct {
+	Pair asset.Pair
+	ID   uint64
+}
+
+func (i insuranceFundDrawDownShouldNotExist) Do(app *app.NibiruApp, ctx sdk.Context) (sdk.Context, error, bool) {
+	if _, err := app.PerpKeeperV2.InsuranceFundDrawDowns.Get(ctx, collections.Join(i.Pair, i.ID)); err == nil {
+		return ctx, fmt.Errorf("insurance fund draw-down %d should not exist", i.ID), false
+	}
+
+	return ctx, nil, false
+}
+
+func InsuranceFundDrawDownShouldNotExist(pair asset.Pair, id uint64) action.Action {
+	return insuranceFundDrawDownShouldNotExist{
+		Pair: pair,
+		ID:   id,
+	}
+}
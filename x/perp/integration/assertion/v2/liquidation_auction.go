@@ -0,0 +1,49 @@
+package assertion
+
+import (
+	"fmt"
+
+	"github.com/NibiruChain/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/NibiruChain/nibiru/app"
+	"github.com/NibiruChain/nibiru/x/common/asset"
+	"github.com/NibiruChain/nibiru/x/common/testutil/action"
+)
+
+type liquidationAuctionShouldExist struct {
+	Trader      sdk.AccAddress
+	Pair        asset.Pair
+	ShouldExist bool
+}
+
+func (l liquidationAuctionShouldExist) Do(app *app.NibiruApp, ctx sdk.Context) (sdk.Context, error, bool) {
+	_, err := app.PerpKeeperV2.LiquidationAuctions.Get(ctx, collections.Join(l.Pair, l.Trader))
+	if l.ShouldExist && err != nil {
+		return ctx, fmt.Errorf("liquidation auction of %s in %s should exist: %w", l.Trader, l.Pair, err), false
+	}
+	if !l.ShouldExist && err == nil {
+		return ctx, fmt.Errorf("liquidation auction of %s in %s should not exist", l.Trader, l.Pair), false
+	}
+
+	return ctx, nil, false
+}
+
+// LiquidationAuctionShouldExist checks that the position of the trader is
+// being auctioned.
+func LiquidationAuctionShouldExist(trader sdk.AccAddress, pair asset.Pair) action.Action {
+	return liquidationAuctionShouldExist{
+		Trader:      trader,
+		Pair:        pair,
+		ShouldExist: true,
+	}
+}
+
+// LiquidationAuctionShouldNotExist checks that the position of the trader is
+// not being auctioned.
+func LiquidationAuctionShouldNotExist(trader sdk.AccAddress, pair asset.Pair) action.Action {
+	return liquidationAuctionShouldExist{
+		Trader: trader,
+		Pair:   pair,
+	}
+}
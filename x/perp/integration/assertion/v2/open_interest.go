@@ -0,0 +1,37 @@
+package assertion
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/NibiruChain/nibiru/app"
+	"github.com/NibiruChain/nibiru/x/common/asset"
+	"github.com/NibiruChain/nibiru/x/common/testutil/action"
+)
+
+type openInterestShouldBeEqual struct {
+	Pair          asset.Pair
+	ExpectedLong  sdk.Dec
+	ExpectedShort sdk.Dec
+}
+
+func (o openInterestShouldBeEqual) Do(app *app.NibiruApp, ctx sdk.Context) (sdk.Context, error, bool) {
+	oi := app.PerpKeeperV2.OpenInterest(ctx, o.Pair)
+	if !oi.Long.Equal(o.ExpectedLong) || !oi.Short.Equal(o.ExpectedShort) {
+		return ctx, fmt.Errorf(
+			"expected open interest of %s to be long %s short %s, got long %s short %s",
+			o.Pair, o.ExpectedLong, o.ExpectedShort, oi.Long, oi.Short,
+		), false
+	}
+
+	return ctx, nil, false
+}
+
+func OpenInterestShouldBeEqual(pair asset.Pair, expectedLong sdk.Dec, expectedShort sdk.Dec) action.Action {
+	return openInterestShouldBeEqual{
+		Pair:          pair,
+		ExpectedLong:  expectedLong,
+		ExpectedShort: expectedShort,
+	}
+}
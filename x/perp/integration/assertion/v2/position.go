@@ -70,6 +70,16 @@ func Position_PositionSizeShouldBeEqualTo(expectedSize sdk.Dec) PositionChecker
 	}
 }
 
+// Position_MarginShouldBeEqualTo checks if the position margin is equal to the expected margin
+func Position_MarginShouldBeEqualTo(expectedMargin sdk.Dec) PositionChecker {
+	return func(position v2types.Position) error {
+		if position.Margin.Equal(expectedMargin) {
+			return nil
+		}
+		return fmt.Errorf("expected position margin %s, got %s", expectedMargin, position.Margin.String())
+	}
+}
+
 type positionShouldNotExist struct {
 	Account sdk.AccAddress
 	Pair    asset.Pair
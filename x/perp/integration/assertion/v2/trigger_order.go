@@ -0,0 +1,51 @@
+package assertion
+
+import (
+	"fmt"
+
+	"github.com/NibiruChain/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/NibiruChain/nibiru/app"
+	"github.com/NibiruChain/nibiru/x/common/asset"
+	"github.com/NibiruChain/nibiru/x/common/testutil/action"
+)
+
+type triggerOrderShouldExist struct {
+	Account     sdk.AccAddress
+	Pair        asset.Pair
+	OrderID     uint64
+	ShouldExist bool
+}
+
+func (t triggerOrderShouldExist) Do(app *app.NibiruApp, ctx sdk.Context) (sdk.Context, error, bool) {
+	_, err := app.PerpKeeperV2.TriggerOrders.Get(ctx, collections.Join(collections.Join(t.Pair, t.Account), t.OrderID))
+	if t.ShouldExist && err != nil {
+		return ctx, fmt.Errorf("trigger order %d should exist: %w", t.OrderID, err), false
+	}
+	if !t.ShouldExist && err == nil {
+		return ctx, fmt.Errorf("trigger order %d should not exist", t.OrderID), false
+	}
+
+	return ctx, nil, false
+}
+
+// TriggerOrderShouldExist checks that the trigger order of the account is resting.
+func TriggerOrderShouldExist(account sdk.AccAddress, pair asset.Pair, orderID uint64) action.Action {
+	return triggerOrderShouldExist{
+		Account:     account,
+		Pair:        pair,
+		OrderID:     orderID,
+		ShouldExist: true,
+	}
+}
+
+// TriggerOrderShouldNotExist checks that the trigger order of the account was
+// executed or cancelled.
+func TriggerOrderShouldNotExist(account sdk.AccAddress, pair asset.Pair, orderID uint64) action.Action {
+	return triggerOrderShouldExist{
+		Account: account,
+		Pair:    pair,
+		OrderID: orderID,
+	}
+}
@@ -97,6 +97,7 @@ func TestMsgServerRepeg(t *testing.T) {
 			ctx = ctx.WithBlockTime(time.Now())
 
 			pair := asset.Registry.Pair(denoms.BTC, denoms.NUSD)
+			app.OracleKeeper.SetPrice(ctx, pair, sdk.NewDec(20000))
 			traderAccount := testutil.AccAddress()
 
 			t.Log("create market")
@@ -244,6 +245,7 @@ func TestMsgServerUpdateSwapInvariant(t *testing.T) {
 			ctx = ctx.WithBlockTime(time.Now())
 
 			pair := asset.Registry.Pair(denoms.BTC, denoms.NUSD)
+			app.OracleKeeper.SetPrice(ctx, pair, sdk.NewDec(20000))
 			traderAccount := testutil.AccAddress()
 
 			t.Log("create market")
@@ -106,10 +106,7 @@ func (k Keeper) calcFreeCollateral(
 	}
 	remainingMargin := sdk.MinDec(pos.Margin, pos.Margin.Add(unrealizedPnL))
 
-	maintenanceMarginRatio, err := k.PerpAmmKeeper.GetMaintenanceMarginRatio(ctx, pos.Pair)
-	if err != nil {
-		return
-	}
+	maintenanceMarginRatio := market.Config.MarginTierFor(positionNotional).MaintenanceMarginRatio
 	maintenanceMarginRequirement := positionNotional.Mul(maintenanceMarginRatio)
 
 	return remainingMargin.Sub(maintenanceMarginRequirement), nil
@@ -132,3 +129,57 @@ func (k Keeper) getLatestCumulativePremiumFraction(
 	// this should never fail
 	return pairMetadata.LatestCumulativePremiumFraction, nil
 }
+
+// LiquidationPrice returns the price at which the margin ratio of the position
+// falls to the maintenance margin ratio of its margin tier, ignoring price
+// impact. It's zero if no price move can get the position liquidated.
+//
+// long:  (margin + size * price - openNotional) / (size * price) = mmr
+// short: (margin + openNotional - |size| * price) / (|size| * price) = mmr
+func LiquidationPrice(
+	config perpammtypes.MarketConfig, position types.Position, latestCumulativePremiumFraction sdk.Dec,
+) sdk.Dec {
+	if position.Size_.IsZero() {
+		return sdk.ZeroDec()
+	}
+
+	fundingPayment := latestCumulativePremiumFraction.
+		Sub(position.LatestCumulativePremiumFraction).
+		Mul(position.Size_)
+	margin := position.Margin.Sub(fundingPayment)
+	size := position.Size_.Abs()
+	isLong := position.Size_.IsPositive()
+
+	solve := func(mmr sdk.Dec) sdk.Dec {
+		var price sdk.Dec
+		if isLong {
+			if !sdk.OneDec().Sub(mmr).IsPositive() {
+				return sdk.ZeroDec()
+			}
+			price = position.OpenNotional.Sub(margin).Quo(size.Mul(sdk.OneDec().Sub(mmr)))
+		} else {
+			price = position.OpenNotional.Add(margin).Quo(size.Mul(sdk.OneDec().Add(mmr)))
+		}
+		return sdk.MaxDec(price, sdk.ZeroDec())
+	}
+
+	// a price is only a solution if the position falls into the tier whose
+	// maintenance margin ratio it was solved with; longs get liquidated at the
+	// highest solution and shorts at the lowest
+	var liquidationPrice sdk.Dec
+	for _, tier := range config.MarginTierTable() {
+		price := solve(tier.MaintenanceMarginRatio)
+		if !config.MarginTierFor(size.Mul(price)).NotionalFloor.Equal(tier.NotionalFloor) {
+			continue
+		}
+		if liquidationPrice.IsNil() ||
+			(isLong && price.GT(liquidationPrice)) ||
+			(!isLong && price.LT(liquidationPrice)) {
+			liquidationPrice = price
+		}
+	}
+	if liquidationPrice.IsNil() {
+		return solve(config.MaintenanceMarginRatio)
+	}
+	return liquidationPrice
+}
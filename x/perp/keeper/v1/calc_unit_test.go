@@ -87,7 +87,10 @@ func TestCalcFreeCollateralSuccess(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			k, mocks, ctx := getKeeper(t)
 
-			market := perpammtypes.Market{Pair: asset.Registry.Pair(denoms.BTC, denoms.NUSD)}
+			market := perpammtypes.Market{
+				Pair:   asset.Registry.Pair(denoms.BTC, denoms.NUSD),
+				Config: perpammtypes.MarketConfig{MaintenanceMarginRatio: sdk.MustNewDecFromStr("0.0625")},
+			}
 			pos := types.Position{
 				TraderAddress:                   testutil.AccAddress().String(),
 				Pair:                            asset.Registry.Pair(denoms.BTC, denoms.NUSD),
@@ -99,9 +102,6 @@ func TestCalcFreeCollateralSuccess(t *testing.T) {
 			}
 
 			t.Log("mock market keeper")
-			mocks.mockPerpAmmKeeper.EXPECT().
-				GetMaintenanceMarginRatio(ctx, asset.Registry.Pair(denoms.BTC, denoms.NUSD)).
-				Return(sdk.MustNewDecFromStr("0.0625"), nil)
 			mocks.mockPerpAmmKeeper.EXPECT().GetBaseAssetPrice(
 				market,
 				tc.marketDirection,
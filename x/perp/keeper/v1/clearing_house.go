@@ -60,9 +60,16 @@ func (k Keeper) OpenPosition(
 	sameSideLong := position.Size_.IsPositive() && side == perpammtypes.Direction_LONG
 	sameSideShort := position.Size_.IsNegative() && side == perpammtypes.Direction_SHORT
 
+	// while stopped, positions can only be reduced or closed
+	stopped := k.GetParams(ctx).Stopped
+
 	var updatedMarket perpammtypes.Market
 	var openSideMatchesPosition = sameSideLong || sameSideShort
 	if isNewPosition || openSideMatchesPosition {
+		if stopped {
+			return nil, types.ErrTradingStopped
+		}
+
 		updatedMarket, positionResp, err = k.increasePosition(
 			ctx,
 			market,
@@ -75,6 +82,19 @@ func (k Keeper) OpenPosition(
 			return nil, err
 		}
 	} else {
+		if stopped {
+			// a reverse position bigger than the current one opens the other side
+			positionNotional, _, err := k.getPositionNotionalAndUnrealizedPnL(
+				ctx, market, position, types.PnLCalcOption_SPOT_PRICE,
+			)
+			if err != nil {
+				return nil, err
+			}
+			if leverage.MulInt(quoteAssetAmount).GT(positionNotional) {
+				return nil, types.ErrTradingStopped
+			}
+		}
+
 		updatedMarket, positionResp, err = k.openReversePosition(
 			ctx,
 			market,
@@ -88,6 +108,10 @@ func (k Keeper) OpenPosition(
 		}
 	}
 
+	if err = k.checkPositionLimits(ctx, market, position.Size_, *positionResp, leverage); err != nil {
+		return nil, err
+	}
+
 	if err = k.afterPositionUpdate(ctx, updatedMarket, traderAddr, *positionResp); err != nil {
 		return nil, err
 	}
@@ -129,6 +153,12 @@ func (k Keeper) afterPositionUpdate(
 	positionResp types.PositionResp,
 ) (err error) {
 	pair := market.Pair
+	k.updateOpenInterest(
+		ctx,
+		pair,
+		/* oldSize */ positionResp.Position.Size_.Sub(positionResp.ExchangedPositionSize),
+		/* newSize */ positionResp.Position.Size_,
+	)
 	if !positionResp.Position.Size_.IsZero() {
 		k.Positions.Insert(ctx, collections.Join(pair, traderAddr), *positionResp.Position)
 	}
@@ -148,7 +178,7 @@ func (k Keeper) afterPositionUpdate(
 			return err
 		}
 
-		maintenanceMarginRatio := market.Config.MaintenanceMarginRatio
+		maintenanceMarginRatio := market.Config.MarginTierFor(positionResp.PositionNotional).MaintenanceMarginRatio
 		if err = validateMarginRatio(marginRatio, maintenanceMarginRatio, true); err != nil {
 			return types.ErrMarginRatioTooLow
 		}
@@ -863,12 +893,7 @@ func (k Keeper) OnSwapEnd(
 	baseAssetAmount sdk.Dec,
 ) {
 	// Update Metrics
-	metrics := k.Metrics.GetOr(ctx, pair, types.Metrics{
-		Pair:        pair,
-		NetSize:     sdk.ZeroDec(),
-		VolumeQuote: sdk.ZeroDec(),
-		VolumeBase:  sdk.ZeroDec(),
-	})
+	metrics := k.GetMetrics(ctx, pair)
 	metrics.NetSize = metrics.NetSize.Add(baseAssetAmount)
 	metrics.VolumeBase = metrics.VolumeBase.Add(baseAssetAmount.Abs())
 	metrics.VolumeQuote = metrics.VolumeQuote.Add(quoteAssetAmount.Abs())
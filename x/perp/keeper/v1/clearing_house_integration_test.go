@@ -775,3 +775,172 @@ func TestOpenPositionInvalidPair(t *testing.T) {
 		})
 	}
 }
+
+func TestOpenPositionWhenStopped(t *testing.T) {
+	alice := testutil.AccAddress()
+	pairBtcUsdc := asset.Registry.Pair(denoms.BTC, denoms.USDC)
+	app, ctx := testapp.NewNibiruTestAppAndContext(true)
+
+	for _, act := range []Action{
+		createInitMarket(),
+		SetBlockNumber(1),
+		SetBlockTime(time.Now()),
+		SetOraclePrice(pairBtcUsdc, sdk.OneDec()),
+		FundAccount(alice, sdk.NewCoins(sdk.NewCoin(denoms.USDC, sdk.NewInt(10_000_000)))),
+		OpenPosition(alice, pairBtcUsdc, perpammtypes.Direction_LONG, sdk.NewInt(1_000_000), sdk.NewDec(10), sdk.ZeroDec()),
+	} {
+		var err error
+		ctx, err, _ = act.Do(app, ctx)
+		require.NoError(t, err)
+	}
+
+	params := app.PerpKeeper.GetParams(ctx)
+	params.Stopped = true
+	app.PerpKeeper.SetParams(ctx, params)
+
+	t.Log("increasing the position fails")
+	_, err := app.PerpKeeper.OpenPosition(
+		ctx, pairBtcUsdc, perpammtypes.Direction_LONG, alice, sdk.NewInt(1_000_000), sdk.OneDec(), sdk.ZeroDec())
+	require.ErrorIs(t, err, types.ErrTradingStopped)
+
+	t.Log("reversing the position fails")
+	_, err = app.PerpKeeper.OpenPosition(
+		ctx, pairBtcUsdc, perpammtypes.Direction_SHORT, alice, sdk.NewInt(2_000_000), sdk.NewDec(10), sdk.ZeroDec())
+	require.ErrorIs(t, err, types.ErrTradingStopped)
+
+	t.Log("removing margin fails")
+	_, _, _, err = app.PerpKeeper.RemoveMargin(ctx, pairBtcUsdc, alice, sdk.NewInt64Coin(denoms.USDC, 1))
+	require.ErrorIs(t, err, types.ErrTradingStopped)
+
+	t.Log("reducing and closing the position succeed")
+	_, err = app.PerpKeeper.OpenPosition(
+		ctx, pairBtcUsdc, perpammtypes.Direction_SHORT, alice, sdk.NewInt(1_000_000), sdk.OneDec(), sdk.ZeroDec())
+	require.NoError(t, err)
+	_, err = app.PerpKeeper.ClosePosition(ctx, pairBtcUsdc, alice)
+	require.NoError(t, err)
+}
+
+func TestOpenPositionMarginTiers(t *testing.T) {
+	alice := testutil.AccAddress()
+	pairBtcUsdc := asset.Registry.Pair(denoms.BTC, denoms.USDC)
+	app, ctx := testapp.NewNibiruTestAppAndContext(true)
+
+	for _, act := range []Action{
+		CreateCustomMarket(pairBtcUsdc,
+			/* quoteReserve */ sdk.NewDec(1*common.TO_MICRO*common.TO_MICRO),
+			/* baseReserve */ sdk.NewDec(1*common.TO_MICRO*common.TO_MICRO),
+			perpammtypes.MarketConfig{
+				FluctuationLimitRatio:  sdk.MustNewDecFromStr("0.1"),
+				MaintenanceMarginRatio: sdk.MustNewDecFromStr("0.0625"),
+				MaxLeverage:            sdk.MustNewDecFromStr("15"),
+				MaxOracleSpreadRatio:   sdk.OneDec(), // 100%,
+				TradeLimitRatio:        sdk.OneDec(),
+				MarginTiers: []perpammtypes.MarginTier{{
+					NotionalFloor:          sdk.NewDec(15_000_000),
+					MaintenanceMarginRatio: sdk.MustNewDecFromStr("0.125"),
+					MaxLeverage:            sdk.NewDec(5),
+				}},
+			}),
+		SetBlockNumber(1),
+		SetBlockTime(time.Now()),
+		SetOraclePrice(pairBtcUsdc, sdk.OneDec()),
+		FundAccount(alice, sdk.NewCoins(sdk.NewCoin(denoms.USDC, sdk.NewInt(10_000_000)))),
+	} {
+		var err error
+		ctx, err, _ = act.Do(app, ctx)
+		require.NoError(t, err)
+	}
+
+	t.Log("a large position over the max leverage of its tier fails")
+	cachedCtx, _ := ctx.CacheContext()
+	_, err := app.PerpKeeper.OpenPosition(
+		cachedCtx, pairBtcUsdc, perpammtypes.Direction_LONG, alice, sdk.NewInt(2_000_000), sdk.NewDec(10), sdk.ZeroDec())
+	require.ErrorIs(t, err, types.ErrLeverageIsTooHigh)
+
+	t.Log("a small position at the same leverage succeeds")
+	_, err = app.PerpKeeper.OpenPosition(
+		ctx, pairBtcUsdc, perpammtypes.Direction_LONG, alice, sdk.NewInt(1_000_000), sdk.NewDec(10), sdk.ZeroDec())
+	require.NoError(t, err)
+
+	t.Log("a large position within the max leverage of its tier succeeds")
+	_, err = app.PerpKeeper.OpenPosition(
+		ctx, pairBtcUsdc, perpammtypes.Direction_LONG, alice, sdk.NewInt(3_000_000), sdk.NewDec(5), sdk.ZeroDec())
+	require.NoError(t, err)
+
+	t.Log("reducing the position succeeds")
+	_, err = app.PerpKeeper.OpenPosition(
+		ctx, pairBtcUsdc, perpammtypes.Direction_SHORT, alice, sdk.NewInt(1_000_000), sdk.NewDec(10), sdk.ZeroDec())
+	require.NoError(t, err)
+}
+
+func TestOpenPositionCaps(t *testing.T) {
+	alice := testutil.AccAddress()
+	bob := testutil.AccAddress()
+	carol := testutil.AccAddress()
+	pairBtcUsdc := asset.Registry.Pair(denoms.BTC, denoms.USDC)
+	app, ctx := testapp.NewNibiruTestAppAndContext(true)
+
+	for _, act := range []Action{
+		CreateCustomMarket(pairBtcUsdc,
+			/* quoteReserve */ sdk.NewDec(1*common.TO_MICRO*common.TO_MICRO),
+			/* baseReserve */ sdk.NewDec(1*common.TO_MICRO*common.TO_MICRO),
+			perpammtypes.MarketConfig{
+				FluctuationLimitRatio:  sdk.MustNewDecFromStr("0.1"),
+				MaintenanceMarginRatio: sdk.MustNewDecFromStr("0.0625"),
+				MaxLeverage:            sdk.MustNewDecFromStr("15"),
+				MaxOracleSpreadRatio:   sdk.OneDec(), // 100%,
+				TradeLimitRatio:        sdk.OneDec(),
+				MaxOpenInterest:        sdk.NewDec(25_000_000),
+				MaxPositionNotional:    sdk.NewDec(15_000_000),
+			}),
+		SetBlockNumber(1),
+		SetBlockTime(time.Now()),
+		SetOraclePrice(pairBtcUsdc, sdk.OneDec()),
+		FundAccount(alice, sdk.NewCoins(sdk.NewCoin(denoms.USDC, sdk.NewInt(10_000_000)))),
+		FundAccount(bob, sdk.NewCoins(sdk.NewCoin(denoms.USDC, sdk.NewInt(10_000_000)))),
+		FundAccount(carol, sdk.NewCoins(sdk.NewCoin(denoms.USDC, sdk.NewInt(10_000_000)))),
+	} {
+		var err error
+		ctx, err, _ = act.Do(app, ctx)
+		require.NoError(t, err)
+	}
+
+	t.Log("a position under both caps succeeds")
+	_, err := app.PerpKeeper.OpenPosition(
+		ctx, pairBtcUsdc, perpammtypes.Direction_LONG, alice, sdk.NewInt(1_000_000), sdk.NewDec(10), sdk.ZeroDec())
+	require.NoError(t, err)
+
+	t.Log("increasing the position over the max position notional fails")
+	cachedCtx, _ := ctx.CacheContext()
+	_, err = app.PerpKeeper.OpenPosition(
+		cachedCtx, pairBtcUsdc, perpammtypes.Direction_LONG, alice, sdk.NewInt(1_000_000), sdk.NewDec(10), sdk.ZeroDec())
+	require.ErrorIs(t, err, types.ErrPositionNotionalCapExceeded)
+
+	t.Log("another trader on the same side succeeds under the max open interest")
+	_, err = app.PerpKeeper.OpenPosition(
+		ctx, pairBtcUsdc, perpammtypes.Direction_LONG, bob, sdk.NewInt(1_000_000), sdk.NewDec(10), sdk.ZeroDec())
+	require.NoError(t, err)
+
+	t.Log("a third long over the max open interest fails")
+	cachedCtx, _ = ctx.CacheContext()
+	_, err = app.PerpKeeper.OpenPosition(
+		cachedCtx, pairBtcUsdc, perpammtypes.Direction_LONG, carol, sdk.NewInt(1_000_000), sdk.NewDec(10), sdk.ZeroDec())
+	require.ErrorIs(t, err, types.ErrOpenInterestCapExceeded)
+
+	t.Log("the short side has its own cap")
+	_, err = app.PerpKeeper.OpenPosition(
+		ctx, pairBtcUsdc, perpammtypes.Direction_SHORT, carol, sdk.NewInt(1_000_000), sdk.NewDec(10), sdk.ZeroDec())
+	require.NoError(t, err)
+
+	t.Log("closing a position reduces the open interest")
+	_, err = app.PerpKeeper.ClosePosition(ctx, pairBtcUsdc, alice)
+	require.NoError(t, err)
+
+	bobPosition, err := app.PerpKeeper.Positions.Get(ctx, collections.Join(pairBtcUsdc, bob))
+	require.NoError(t, err)
+	carolPosition, err := app.PerpKeeper.Positions.Get(ctx, collections.Join(pairBtcUsdc, carol))
+	require.NoError(t, err)
+	metrics := app.PerpKeeper.GetMetrics(ctx, pairBtcUsdc)
+	assert.Equal(t, bobPosition.Size_, metrics.OpenInterestLong)
+	assert.Equal(t, carolPosition.Size_.Neg(), metrics.OpenInterestShort)
+}
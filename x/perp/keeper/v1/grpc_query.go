@@ -7,11 +7,13 @@ import (
 	"github.com/NibiruChain/collections"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
 	"github.com/NibiruChain/nibiru/x/common/asset"
+	perpammtypes "github.com/NibiruChain/nibiru/x/perp/amm/types"
 	types "github.com/NibiruChain/nibiru/x/perp/types/v1"
 )
 
@@ -80,6 +82,12 @@ func (q queryServer) position(ctx sdk.Context, pair asset.Pair, trader sdk.AccAd
 		return nil, types.ErrPairNotFound
 	}
 
+	return q.positionResponse(ctx, market, position)
+}
+
+func (q queryServer) positionResponse(
+	ctx sdk.Context, market perpammtypes.Market, position types.Position,
+) (*types.QueryPositionResponse, error) {
 	positionNotional, unrealizedPnl, err := q.k.getPositionNotionalAndUnrealizedPnL(ctx, market, position, types.PnLCalcOption_SPOT_PRICE)
 	if err != nil {
 		return nil, err
@@ -97,6 +105,11 @@ func (q queryServer) position(ctx sdk.Context, pair asset.Pair, trader sdk.AccAd
 		marginRatioIndex = sdk.Dec{}
 	}
 
+	latestCumulativePremiumFraction, err := q.k.getLatestCumulativePremiumFraction(ctx, market.Pair)
+	if err != nil {
+		return nil, err
+	}
+
 	return &types.QueryPositionResponse{
 		Position:         &position,
 		PositionNotional: positionNotional,
@@ -104,6 +117,68 @@ func (q queryServer) position(ctx sdk.Context, pair asset.Pair, trader sdk.AccAd
 		MarginRatioMark:  marginRatioMark,
 		MarginRatioIndex: marginRatioIndex,
 		BlockNumber:      ctx.BlockHeight(),
+		LiquidationPrice: LiquidationPrice(market.Config, position, latestCumulativePremiumFraction),
+	}, nil
+}
+
+func (q queryServer) QueryMarketPositions(
+	goCtx context.Context, req *types.QueryMarketPositionsRequest,
+) (*types.QueryMarketPositionsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	market, err := q.k.PerpAmmKeeper.GetPool(ctx, req.Pair)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "could not find pair: %s", req.Pair)
+	}
+
+	positions := []types.QueryPositionResponse{}
+	pageRes, err := query.FilteredPaginate(
+		q.k.prefixStore(ctx, positionsNamespace, asset.PairKeyEncoder.Encode(req.Pair)),
+		req.Pagination,
+		func(_ []byte, value []byte, accumulate bool) (bool, error) {
+			var position types.Position
+			if err := q.k.cdc.Unmarshal(value, &position); err != nil {
+				return false, err
+			}
+
+			switch req.Direction {
+			case perpammtypes.Direction_LONG:
+				if !position.Size_.IsPositive() {
+					return false, nil
+				}
+			case perpammtypes.Direction_SHORT:
+				if !position.Size_.IsNegative() {
+					return false, nil
+				}
+			}
+
+			resp, err := q.positionResponse(ctx, market, position)
+			if err != nil {
+				return false, err
+			}
+			if !req.MaxMarginRatio.IsNil() && resp.MarginRatioMark.GTE(req.MaxMarginRatio) {
+				return false, nil
+			}
+			if !req.MinNotional.IsNil() && resp.PositionNotional.LT(req.MinNotional) {
+				return false, nil
+			}
+
+			if accumulate {
+				positions = append(positions, *resp)
+			}
+			return true, nil
+		},
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryMarketPositionsResponse{
+		Positions:  positions,
+		Pagination: pageRes,
 	}, nil
 }
 
@@ -173,13 +248,7 @@ func (q queryServer) Metrics(
 	if !q.k.PerpAmmKeeper.ExistsPool(ctx, req.Pair) {
 		return nil, status.Errorf(codes.InvalidArgument, "pool not found: %s", req.Pair)
 	}
-	metrics := q.k.Metrics.GetOr(ctx, req.Pair, types.Metrics{
-		Pair:        req.Pair,
-		NetSize:     sdk.NewDec(0),
-		VolumeQuote: sdk.NewDec(0),
-		VolumeBase:  sdk.NewDec(0),
-	})
-	return &types.QueryMetricsResponse{Metrics: metrics}, nil
+	return &types.QueryMetricsResponse{Metrics: q.k.GetMetrics(ctx, req.Pair)}, nil
 }
 
 func (q queryServer) ModuleAccounts(
@@ -258,6 +258,62 @@ func TestQueryPositions(t *testing.T) {
 	}
 }
 
+func TestQueryMarketPositions(t *testing.T) {
+	ctx, app, queryServer := initAppMarkets(
+		t,
+		/* quoteReserve */ sdk.NewDec(100_000),
+		/* baseReserve */ sdk.NewDec(100_000),
+		/* pegMultiplier */ sdk.OneDec(),
+	)
+	pair := asset.Registry.Pair(denoms.BTC, denoms.NUSD)
+	alice, bob := testutil.AccAddress(), testutil.AccAddress()
+
+	t.Log("initialize positions")
+	for _, position := range []types.Position{
+		{TraderAddress: alice.String(), Size_: sdk.NewDec(10), OpenNotional: sdk.NewDec(10), Margin: sdk.NewDec(1)},
+		{TraderAddress: bob.String(), Size_: sdk.NewDec(-100), OpenNotional: sdk.NewDec(100), Margin: sdk.NewDec(50)},
+	} {
+		position.Pair = pair
+		position.BlockNumber = 1
+		position.LatestCumulativePremiumFraction = sdk.ZeroDec()
+		keeper.SetPosition(app.PerpKeeper, ctx, position)
+	}
+	goCtx := sdk.WrapSDKContext(ctx)
+
+	t.Log("every position of the market")
+	resp, err := queryServer.QueryMarketPositions(goCtx, &types.QueryMarketPositionsRequest{Pair: pair})
+	require.NoError(t, err)
+	require.Len(t, resp.Positions, 2)
+	for _, position := range resp.Positions {
+		require.True(t, position.LiquidationPrice.IsPositive())
+	}
+
+	t.Log("filter by side")
+	resp, err = queryServer.QueryMarketPositions(goCtx, &types.QueryMarketPositionsRequest{
+		Pair:      pair,
+		Direction: perpammtypes.Direction_LONG,
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.Positions, 1)
+	assert.Equal(t, alice.String(), resp.Positions[0].Position.TraderAddress)
+
+	t.Log("filter by margin ratio and notional")
+	resp, err = queryServer.QueryMarketPositions(goCtx, &types.QueryMarketPositionsRequest{
+		Pair:           pair,
+		MaxMarginRatio: sdk.MustNewDecFromStr("0.2"),
+		MinNotional:    sdk.NewDec(5),
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.Positions, 1)
+	assert.Equal(t, alice.String(), resp.Positions[0].Position.TraderAddress)
+
+	t.Log("unknown market")
+	_, err = queryServer.QueryMarketPositions(goCtx, &types.QueryMarketPositionsRequest{
+		Pair: asset.Registry.Pair(denoms.ATOM, denoms.NUSD),
+	})
+	require.Error(t, err)
+}
+
 func TestQueryCumulativePremiumFraction(t *testing.T) {
 	tests := []struct {
 		name                string
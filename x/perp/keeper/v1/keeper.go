@@ -2,10 +2,12 @@ package keeper
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/NibiruChain/collections"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	"github.com/tendermint/tendermint/libs/log"
@@ -32,6 +34,10 @@ type Keeper struct {
 	Metrics collections.Map[asset.Pair, types.Metrics]
 }
 
+// Namespace of the collection whose entries are also paginated through
+// prefixStore.
+const positionsNamespace collections.Namespace = 0
+
 // NewKeeper Creates a new x/perp Keeper instance.
 func NewKeeper(
 	cdc codec.BinaryCodec,
@@ -64,7 +70,7 @@ func NewKeeper(
 		PerpAmmKeeper: perpammKeeper,
 		EpochKeeper:   epochKeeper,
 		Positions: collections.NewMap(
-			storeKey, 0,
+			storeKey, positionsNamespace,
 			collections.PairKeyEncoder(asset.PairKeyEncoder, collections.AccAddressKeyEncoder),
 			collections.ProtoValueEncoder[types.Position](cdc),
 		),
@@ -75,6 +81,16 @@ func NewKeeper(
 	}
 }
 
+// prefixStore returns the store of the entries of the collection whose keys
+// start with the given encoded key parts.
+func (k Keeper) prefixStore(ctx sdk.Context, namespace collections.Namespace, keyParts ...[]byte) prefix.Store {
+	storePrefix := namespace.Prefix()
+	for _, keyPart := range keyParts {
+		storePrefix = append(storePrefix, keyPart...)
+	}
+	return prefix.NewStore(ctx.KVStore(k.storeKey), storePrefix)
+}
+
 func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
 }
@@ -85,6 +101,12 @@ func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
 	return params
 }
 
+// TwapLookbackWindow returns the lookback window of the mark and index price
+// twaps.
+func (k Keeper) TwapLookbackWindow(ctx sdk.Context) time.Duration {
+	return k.GetParams(ctx).TwapLookbackWindow
+}
+
 // SetParams set the params
 func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
 	k.ParamSubspace.SetParamSet(ctx, &params)
@@ -57,7 +57,7 @@ func (k Keeper) Liquidate(
 		return
 	}
 
-	marginRatio, err := k.GetMarginRatio(
+	marginRatio, positionNotional, err := k.getMarginRatioAndNotional(
 		ctx,
 		market,
 		position,
@@ -83,10 +83,7 @@ func (k Keeper) Liquidate(
 
 	params := k.GetParams(ctx)
 
-	maintenanceMarginRatio, err := k.PerpAmmKeeper.GetMaintenanceMarginRatio(ctx, pair)
-	if err != nil {
-		return
-	}
+	maintenanceMarginRatio := market.Config.MarginTierFor(positionNotional).MaintenanceMarginRatio
 	err = validateMarginRatio(marginRatio, maintenanceMarginRatio, false)
 	if err != nil {
 		_ = ctx.EventManager().EmitTypedEvent(&types.LiquidationFailedEvent{ // nolint:errcheck
@@ -165,6 +162,7 @@ func (k Keeper) ExecuteFullLiquidation(
 	if err != nil {
 		return types.LiquidateResp{}, err
 	}
+	k.updateOpenInterest(ctx, position.Pair, position.Size_, sdk.ZeroDec())
 
 	remainMargin := positionResp.MarginToVault.Abs()
 
@@ -341,6 +339,7 @@ func (k Keeper) ExecutePartialLiquidation(
 		Mul(params.LiquidationFeeRatio)
 	positionResp.Position.Margin = positionResp.Position.Margin.
 		Sub(liquidationFeeAmount)
+	k.updateOpenInterest(ctx, currentPosition.Pair, currentPosition.Size_, positionResp.Position.Size_)
 	k.Positions.Insert(ctx, collections.Join(positionResp.Position.Pair, traderAddr), *positionResp.Position)
 
 	// Compute splits for the liquidation fee
@@ -261,6 +261,7 @@ func TestExecutePartialLiquidation(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			nibiruApp, ctx := testapp.NewNibiruTestAppAndContext(true)
 			ctx = ctx.WithBlockTime(time.Now())
+			nibiruApp.OracleKeeper.SetPrice(ctx, tokenPair, sdk.NewDec(20000))
 
 			t.Log("Set market defined by pair on PerpAmmKeeper")
 			perpammKeeper := &nibiruApp.PerpAmmKeeper
@@ -109,16 +109,16 @@ func TestLiquidateIntoPartialLiquidation(t *testing.T) {
 			})
 
 			t.Log("mock market keeper")
-			market := perpammtypes.Market{Pair: asset.Registry.Pair(denoms.BTC, denoms.NUSD)}
+			market := perpammtypes.Market{
+				Pair:   asset.Registry.Pair(denoms.BTC, denoms.NUSD),
+				Config: perpammtypes.MarketConfig{MaintenanceMarginRatio: sdk.MustNewDecFromStr("0.0625")},
+			}
 			mocks.mockPerpAmmKeeper.EXPECT().
 				GetPool(ctx, asset.Registry.Pair(denoms.BTC, denoms.NUSD)).
 				Times(2).
 				Return(market, nil)
 			mocks.mockPerpAmmKeeper.EXPECT().
 				ExistsPool(ctx, asset.Registry.Pair(denoms.BTC, denoms.NUSD)).Return(true).Times(1)
-			mocks.mockPerpAmmKeeper.EXPECT().
-				GetMaintenanceMarginRatio(ctx, asset.Registry.Pair(denoms.BTC, denoms.NUSD)).
-				Return(sdk.MustNewDecFromStr("0.0625"), nil)
 
 			mocks.mockPerpAmmKeeper.EXPECT().IsOverSpreadLimit(ctx, asset.Registry.Pair(denoms.BTC, denoms.NUSD)).Return(false, nil)
 			markPrice := tc.newPositionNotional.Quo(tc.initialPositionSize)
@@ -287,15 +287,15 @@ func TestLiquidateIntoFullLiquidation(t *testing.T) {
 			})
 
 			t.Log("mock market keeper")
-			market := perpammtypes.Market{Pair: asset.Registry.Pair(denoms.BTC, denoms.NUSD)}
+			market := perpammtypes.Market{
+				Pair:   asset.Registry.Pair(denoms.BTC, denoms.NUSD),
+				Config: perpammtypes.MarketConfig{MaintenanceMarginRatio: sdk.MustNewDecFromStr("0.0625")},
+			}
 			mocks.mockPerpAmmKeeper.EXPECT().
 				GetPool(ctx, asset.Registry.Pair(denoms.BTC, denoms.NUSD)).Times(2).
 				Return(market, nil)
 			mocks.mockPerpAmmKeeper.EXPECT().
 				ExistsPool(ctx, asset.Registry.Pair(denoms.BTC, denoms.NUSD)).Return(true)
-			mocks.mockPerpAmmKeeper.EXPECT().
-				GetMaintenanceMarginRatio(ctx, asset.Registry.Pair(denoms.BTC, denoms.NUSD)).
-				Return(sdk.MustNewDecFromStr("0.0625"), nil)
 			mocks.mockPerpAmmKeeper.EXPECT().IsOverSpreadLimit(ctx, asset.Registry.Pair(denoms.BTC, denoms.NUSD)).Return(false, nil)
 			markPrice := tc.newPositionNotional.Quo(tc.initialPositionSize)
 			mocks.mockPerpAmmKeeper.EXPECT().GetMarkPrice(ctx, asset.Registry.Pair(denoms.BTC, denoms.NUSD)).Return(markPrice, nil)
@@ -461,15 +461,15 @@ func TestLiquidateIntoFullLiquidationWithBadDebt(t *testing.T) {
 			})
 
 			t.Log("mock market keeper")
-			market := perpammtypes.Market{Pair: asset.Registry.Pair(denoms.BTC, denoms.NUSD)}
+			market := perpammtypes.Market{
+				Pair:   asset.Registry.Pair(denoms.BTC, denoms.NUSD),
+				Config: perpammtypes.MarketConfig{MaintenanceMarginRatio: sdk.MustNewDecFromStr("0.0625")},
+			}
 			mocks.mockPerpAmmKeeper.EXPECT().
 				GetPool(ctx, asset.Registry.Pair(denoms.BTC, denoms.NUSD)).Times(2).
 				Return(market, nil)
 			mocks.mockPerpAmmKeeper.EXPECT().
 				ExistsPool(ctx, asset.Registry.Pair(denoms.BTC, denoms.NUSD)).Return(true)
-			mocks.mockPerpAmmKeeper.EXPECT().
-				GetMaintenanceMarginRatio(ctx, asset.Registry.Pair(denoms.BTC, denoms.NUSD)).
-				Return(sdk.MustNewDecFromStr("0.0625"), nil)
 			mocks.mockPerpAmmKeeper.EXPECT().IsOverSpreadLimit(ctx, asset.Registry.Pair(denoms.BTC, denoms.NUSD)).Return(false, nil)
 			markPrice := tc.newPositionNotional.Quo(tc.initialPositionSize)
 			mocks.mockPerpAmmKeeper.EXPECT().GetMarkPrice(ctx, asset.Registry.Pair(denoms.BTC, denoms.NUSD)).Return(markPrice, nil)
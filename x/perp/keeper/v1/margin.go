@@ -119,6 +119,10 @@ func (k Keeper) RemoveMargin(
 		return sdk.Coin{}, sdk.Dec{}, types.Position{}, types.ErrPairNotFound
 	}
 
+	if k.GetParams(ctx).Stopped {
+		return sdk.Coin{}, sdk.Dec{}, types.Position{}, types.ErrTradingStopped
+	}
+
 	// ------------- RemoveMargin -------------
 	position, err = k.Positions.Get(ctx, collections.Join(pair, traderAddr))
 	if err != nil {
@@ -190,14 +194,20 @@ func (k Keeper) RemoveMargin(
 func (k Keeper) GetMarginRatio(
 	ctx sdk.Context, market perpammtypes.Market, position types.Position, priceOption types.MarginCalculationPriceOption,
 ) (marginRatio sdk.Dec, err error) {
+	marginRatio, _, err = k.getMarginRatioAndNotional(ctx, market, position, priceOption)
+	return marginRatio, err
+}
+
+// getMarginRatioAndNotional calculates the MarginRatio from a Position, along
+// with the position notional it's based on.
+func (k Keeper) getMarginRatioAndNotional(
+	ctx sdk.Context, market perpammtypes.Market, position types.Position, priceOption types.MarginCalculationPriceOption,
+) (marginRatio sdk.Dec, positionNotional sdk.Dec, err error) {
 	if position.Size_.IsZero() {
-		return sdk.Dec{}, types.ErrPositionZero
+		return sdk.Dec{}, sdk.Dec{}, types.ErrPositionZero
 	}
 
-	var (
-		unrealizedPnL    sdk.Dec
-		positionNotional sdk.Dec
-	)
+	var unrealizedPnL sdk.Dec
 
 	switch priceOption {
 	case types.MarginCalculationPriceOption_MAX_PNL:
@@ -224,11 +234,11 @@ func (k Keeper) GetMarginRatio(
 	}
 
 	if err != nil {
-		return sdk.Dec{}, err
+		return sdk.Dec{}, sdk.Dec{}, err
 	}
 	if positionNotional.IsZero() {
 		// NOTE causes division by zero in margin ratio calculation
-		return sdk.Dec{},
+		return sdk.Dec{}, sdk.Dec{},
 			fmt.Errorf("margin ratio doesn't make sense with zero position notional")
 	}
 
@@ -238,12 +248,12 @@ func (k Keeper) GetMarginRatio(
 		/* marginDelta */ unrealizedPnL,
 	)
 	if err != nil {
-		return sdk.Dec{}, err
+		return sdk.Dec{}, sdk.Dec{}, err
 	}
 
 	marginRatio = remaining.Margin.Sub(remaining.BadDebt).
 		Quo(positionNotional)
-	return marginRatio, nil
+	return marginRatio, positionNotional, nil
 }
 
 func (k Keeper) requireMarket(ctx sdk.Context, pair asset.Pair) (err error) {
@@ -339,7 +349,7 @@ func (k Keeper) getPositionNotionalAndUnrealizedPnL(
 			return sdk.ZeroDec(), sdk.ZeroDec(), err
 		}
 	case types.PnLCalcOption_ORACLE:
-		oraclePrice, err := k.OracleKeeper.GetExchangeRate(ctx, currentPosition.Pair)
+		oraclePrice, err := k.OracleKeeper.GetExchangeRateWithMaxAge(ctx, currentPosition.Pair)
 		if err != nil {
 			k.Logger(ctx).Error(err.Error(), "calc_option", pnlCalcOption.String())
 			return sdk.ZeroDec(), sdk.ZeroDec(), err
@@ -165,6 +165,7 @@ func TestRemoveMargin(t *testing.T) {
 				ctx = ctx.WithBlockTime(time.Now())
 				traderAddr := testutilevents.AccAddress()
 				pair := asset.MustNewPair("xxx:yyy")
+				nibiruApp.OracleKeeper.SetPrice(ctx, pair, sdk.NewDec(20000))
 
 				t.Log("Set market defined by pair on PerpAmmKeeper")
 				perpammKeeper := &nibiruApp.PerpAmmKeeper
@@ -225,10 +225,11 @@ func TestRemoveMargin(t *testing.T) {
 				marginToWithdraw := sdk.NewInt64Coin(pair.QuoteDenom(), 100)
 
 				t.Log("mock market keeper")
-				market := perpammtypes.Market{Pair: asset.Registry.Pair(denoms.BTC, denoms.NUSD)}
+				market := perpammtypes.Market{
+					Pair:   asset.Registry.Pair(denoms.BTC, denoms.NUSD),
+					Config: perpammtypes.MarketConfig{MaintenanceMarginRatio: sdk.MustNewDecFromStr("0.0625")},
+				}
 				mocks.mockPerpAmmKeeper.EXPECT().GetPool(ctx, pair).Return(market, nil)
-				mocks.mockPerpAmmKeeper.EXPECT().GetMaintenanceMarginRatio(ctx, pair).
-					Return(sdk.MustNewDecFromStr("0.0625"), nil)
 				mocks.mockPerpAmmKeeper.EXPECT().GetMarkPrice(ctx, pair).Return(sdk.OneDec(), nil)
 				mocks.mockPerpAmmKeeper.EXPECT().GetBaseAssetPrice(
 					market,
@@ -293,10 +294,11 @@ func TestRemoveMargin(t *testing.T) {
 				marginToWithdraw := sdk.NewInt64Coin(pair.QuoteDenom(), 100)
 
 				t.Log("mock market keeper")
-				market := perpammtypes.Market{Pair: asset.Registry.Pair(denoms.BTC, denoms.NUSD)}
+				market := perpammtypes.Market{
+					Pair:   asset.Registry.Pair(denoms.BTC, denoms.NUSD),
+					Config: perpammtypes.MarketConfig{MaintenanceMarginRatio: sdk.MustNewDecFromStr("0.0625")},
+				}
 				mocks.mockPerpAmmKeeper.EXPECT().GetPool(ctx, pair).Return(market, nil)
-				mocks.mockPerpAmmKeeper.EXPECT().GetMaintenanceMarginRatio(ctx, pair).
-					Return(sdk.MustNewDecFromStr("0.0625"), nil)
 
 				mocks.mockPerpAmmKeeper.EXPECT().GetMarkPrice(ctx, pair).Return(sdk.OneDec(), nil)
 				mocks.mockPerpAmmKeeper.EXPECT().GetBaseAssetPrice(
@@ -741,7 +743,7 @@ func TestGetPositionNotionalAndUnrealizedPnl(t *testing.T) {
 			},
 			setMocks: func(ctx sdk.Context, mocks mockedDependencies) {
 				mocks.mockOracleKeeper.EXPECT().
-					GetExchangeRate(
+					GetExchangeRateWithMaxAge(
 						ctx,
 						asset.Registry.Pair(denoms.BTC, denoms.NUSD),
 					).
@@ -762,7 +764,7 @@ func TestGetPositionNotionalAndUnrealizedPnl(t *testing.T) {
 			},
 			setMocks: func(ctx sdk.Context, mocks mockedDependencies) {
 				mocks.mockOracleKeeper.EXPECT().
-					GetExchangeRate(
+					GetExchangeRateWithMaxAge(
 						ctx,
 						asset.Registry.Pair(denoms.BTC, denoms.NUSD),
 					).
@@ -877,7 +879,7 @@ func TestGetPositionNotionalAndUnrealizedPnl(t *testing.T) {
 			},
 			setMocks: func(ctx sdk.Context, mocks mockedDependencies) {
 				mocks.mockOracleKeeper.EXPECT().
-					GetExchangeRate(
+					GetExchangeRateWithMaxAge(
 						ctx,
 						asset.Registry.Pair(denoms.BTC, denoms.NUSD),
 					).
@@ -898,7 +900,7 @@ func TestGetPositionNotionalAndUnrealizedPnl(t *testing.T) {
 			},
 			setMocks: func(ctx sdk.Context, mocks mockedDependencies) {
 				mocks.mockOracleKeeper.EXPECT().
-					GetExchangeRate(
+					GetExchangeRateWithMaxAge(
 						ctx,
 						asset.Registry.Pair(denoms.BTC, denoms.NUSD),
 					).
@@ -40,3 +40,21 @@ func From2To3(perpKeeper Keeper, perpammKeeper types.PerpAmmKeeper) module.Migra
 		return nil
 	}
 }
+
+// From3To4 sets the open interest of every pair from its open positions.
+func From3To4(perpKeeper Keeper) module.MigrationHandler {
+	return func(ctx sdk.Context) error {
+		for _, metrics := range perpKeeper.Metrics.Iterate(ctx, collections.Range[asset.Pair]{}).Values() {
+			metrics.OpenInterestLong = sdk.ZeroDec()
+			metrics.OpenInterestShort = sdk.ZeroDec()
+			perpKeeper.Metrics.Insert(ctx, metrics.Pair, metrics)
+		}
+
+		positions := perpKeeper.Positions.Iterate(ctx, collections.PairRange[asset.Pair, sdk.AccAddress]{}).Values()
+		for _, position := range positions {
+			perpKeeper.updateOpenInterest(ctx, position.Pair, sdk.ZeroDec(), position.Size_)
+		}
+
+		return nil
+	}
+}
@@ -150,3 +150,33 @@ func TestFrom2To3(t *testing.T) {
 		})
 	}
 }
+
+func TestFrom3To4(t *testing.T) {
+	alice := testutil.AccAddress()
+	bob := testutil.AccAddress()
+	carol := testutil.AccAddress()
+	pairBtcUsd := asset.Registry.Pair(denoms.BTC, denoms.NUSD)
+	app, ctx := testapp.NewNibiruTestAppAndContext(true)
+
+	// metrics stored before the open interest was tracked
+	app.PerpKeeper.Metrics.Insert(ctx, pairBtcUsd, types.Metrics{
+		Pair:        pairBtcUsd,
+		NetSize:     sdk.NewDec(5),
+		VolumeQuote: sdk.NewDec(100),
+		VolumeBase:  sdk.NewDec(50),
+	})
+	for _, pos := range []types.Position{
+		{TraderAddress: alice.String(), Pair: pairBtcUsd, Size_: sdk.NewDec(10)},
+		{TraderAddress: bob.String(), Pair: pairBtcUsd, Size_: sdk.NewDec(20)},
+		{TraderAddress: carol.String(), Pair: pairBtcUsd, Size_: sdk.NewDec(-25)},
+	} {
+		app.PerpKeeper.Positions.Insert(ctx, collections.Join(pos.Pair, sdk.MustAccAddressFromBech32(pos.TraderAddress)), pos)
+	}
+
+	require.NoError(t, keeper.From3To4(app.PerpKeeper)(ctx))
+
+	metrics := app.PerpKeeper.GetMetrics(ctx, pairBtcUsd)
+	require.Equal(t, sdk.NewDec(30), metrics.OpenInterestLong)
+	require.Equal(t, sdk.NewDec(25), metrics.OpenInterestShort)
+	require.Equal(t, sdk.NewDec(100), metrics.VolumeQuote)
+}
@@ -284,6 +284,7 @@ func TestMsgServerOpenPosition(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			app, ctx := testapp.NewNibiruTestAppAndContext(true)
 			ctx = ctx.WithBlockTime(time.Now())
+			app.OracleKeeper.SetPrice(ctx, asset.Registry.Pair(denoms.BTC, denoms.NUSD), sdk.NewDec(20000))
 			msgServer := keeper.NewMsgServerImpl(app.PerpKeeper)
 
 			t.Log("create market")
@@ -0,0 +1,77 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/NibiruChain/nibiru/x/common/asset"
+	perpammtypes "github.com/NibiruChain/nibiru/x/perp/amm/types"
+	types "github.com/NibiruChain/nibiru/x/perp/types/v1"
+)
+
+// GetMetrics returns the metrics of a pair, which are zero until the first
+// trade.
+func (k Keeper) GetMetrics(ctx sdk.Context, pair asset.Pair) types.Metrics {
+	return k.Metrics.GetOr(ctx, pair, types.ZeroMetrics(pair))
+}
+
+// updateOpenInterest records that a position of the market changed size from
+// oldSize to newSize.
+func (k Keeper) updateOpenInterest(ctx sdk.Context, pair asset.Pair, oldSize sdk.Dec, newSize sdk.Dec) {
+	k.Metrics.Insert(ctx, pair, k.GetMetrics(ctx, pair).UpdateOpenInterest(oldSize, newSize))
+}
+
+/*
+checkPositionLimits checks that a position change keeps both sides of the
+market under its max open interest, and the position under its max position
+notional and within the max leverage of its margin tier. Changes that reduce
+a side or a position are always allowed.
+
+args:
+  - ctx: cosmos-sdk context
+  - market: the market of the position
+  - oldSize: the size of the position before the change
+  - positionResp: the position after the change
+  - leverage: the leverage of the order
+
+ret:
+  - err: ErrOpenInterestCapExceeded, ErrPositionNotionalCapExceeded or
+    ErrLeverageIsTooHigh if a limit is breached
+*/
+func (k Keeper) checkPositionLimits(
+	ctx sdk.Context,
+	market perpammtypes.Market,
+	oldSize sdk.Dec,
+	positionResp types.PositionResp,
+	leverage sdk.Dec,
+) error {
+	newSize := positionResp.Position.Size_
+	metrics := k.GetMetrics(ctx, market.Pair)
+	if err := metrics.UpdateOpenInterest(oldSize, newSize).CheckOpenInterestCap(
+		metrics, market.Config.MaxOpenInterest,
+	); err != nil {
+		return err
+	}
+
+	isReduction := newSize.IsZero() ||
+		(newSize.IsPositive() == oldSize.IsPositive() && newSize.Abs().LTE(oldSize.Abs()))
+	if isReduction {
+		return nil
+	}
+
+	maxPositionNotional := market.Config.MaxPositionNotional
+	if !maxPositionNotional.IsNil() && maxPositionNotional.IsPositive() &&
+		positionResp.PositionNotional.GT(maxPositionNotional) {
+		return types.ErrPositionNotionalCapExceeded.Wrapf(
+			"position notional %s, max position notional %s",
+			positionResp.PositionNotional, maxPositionNotional)
+	}
+
+	// larger positions fall into margin tiers with a lower max leverage
+	if tier := market.Config.MarginTierFor(positionResp.PositionNotional); leverage.GT(tier.MaxLeverage) {
+		return types.ErrLeverageIsTooHigh.Wrapf(
+			"leverage %s, max leverage %s for position notional %s",
+			leverage, tier.MaxLeverage, positionResp.PositionNotional)
+	}
+
+	return nil
+}
@@ -27,6 +27,7 @@ func (k Keeper) SettlePosition(
 	if err != nil {
 		return sdk.NewCoins(), nil
 	}
+	k.updateOpenInterest(ctx, currentPosition.Pair, currentPosition.Size_, sdk.ZeroDec())
 
 	// run calculations on settled values
 	settlementPrice, err := k.PerpAmmKeeper.GetSettlementPrice(ctx, currentPosition.Pair)
@@ -0,0 +1,200 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/NibiruChain/nibiru/x/common/asset"
+	types "github.com/NibiruChain/nibiru/x/perp/types/v1"
+	v2types "github.com/NibiruChain/nibiru/x/perp/types/v2"
+)
+
+// updateAMM re-pegs the AMM of a market to the index TWAP when its mark price is
+// over the spread limit, and scales up its depth when its net open interest is
+// over the skew limit. The ecosystem fund pays at most the AMM update budget of
+// the market for both updates: a repeg that would cost more moves the price
+// multiplier only part of the way, a depth update that would cost more is
+// skipped.
+//
+// args:
+//   - ctx: the cosmos-sdk context
+//   - market: the perp market
+//   - indexTwap: the index price TWAP over the funding interval, must be positive
+//
+// returns:
+//   - err: error if any
+func (k Keeper) updateAMM(ctx sdk.Context, market v2types.Market, indexTwap sdk.Dec) (err error) {
+	if !market.AmmUpdateBudget.IsPositive() {
+		return nil
+	}
+
+	amm, err := k.AMMs.Get(ctx, market.Pair)
+	if err != nil {
+		return v2types.ErrPairNotFound.Wrapf("pair: %s", market.Pair)
+	}
+
+	budget := market.AmmUpdateBudget
+	if market.MaxSpreadRatio.IsPositive() {
+		indexPrice, err := k.OracleKeeper.GetExchangeRateWithMaxAge(ctx, market.Pair)
+		if err != nil {
+			return err
+		}
+
+		if amm.IsOverSpreadLimit(indexPrice, market.MaxSpreadRatio) {
+			newPriceMultiplier, err := priceMultiplierWithinBudget(amm, indexTwap, budget)
+			if err != nil {
+				return err
+			}
+
+			updatedAMM, cost, err := k.repeg(ctx, amm, newPriceMultiplier)
+			if err != nil {
+				return err
+			}
+			amm = *updatedAMM
+			if cost.IsPositive() {
+				budget = budget.Sub(cost)
+			}
+		}
+	}
+
+	if market.MaxSkewRatio.IsPositive() {
+		skew := amm.Bias().Abs().Quo(amm.BaseReserve)
+		if skew.GT(market.MaxSkewRatio) {
+			// scales the reserves by c so that the skew falls back to the max
+			// skew ratio: |bias| / (c * baseReserve) = maxSkewRatio
+			c := skew.Quo(market.MaxSkewRatio)
+			multiplier := c.Mul(c)
+
+			cost, err := amm.CalcUpdateSwapInvariantCost(multiplier)
+			if err != nil {
+				return err
+			}
+			if cost.Ceil().TruncateInt().GT(budget) {
+				k.Logger(ctx).Info("amm depth update over budget",
+					"pair", market.Pair, "cost", cost, "budget", budget)
+				return nil
+			}
+
+			if _, _, err = k.updateSwapInvariant(ctx, amm, multiplier); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// priceMultiplierWithinBudget returns the price multiplier that brings the mark
+// price of the AMM to the target price, or the part of the way to it that the
+// budget pays for. The repeg cost is linear in the change of price multiplier.
+func priceMultiplierWithinBudget(amm v2types.AMM, targetPrice sdk.Dec, budget sdk.Int) (sdk.Dec, error) {
+	targetPriceMultiplier := targetPrice.Mul(amm.BaseReserve).Quo(amm.QuoteReserve)
+
+	cost, err := amm.CalcRepegCost(targetPriceMultiplier)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+	if cost.LTE(budget.ToDec()) {
+		return targetPriceMultiplier, nil
+	}
+
+	// truncating moves the price multiplier towards the current one
+	delta := targetPriceMultiplier.Sub(amm.PriceMultiplier)
+	return amm.PriceMultiplier.Add(delta.MulTruncate(budget.ToDec().QuoTruncate(cost))), nil
+}
+
+// repeg updates the price multiplier of the AMM and settles its cost between
+// the perp EF and the vault.
+//
+// returns:
+//   - updatedAMM: the updated AMM
+//   - cost: the cost paid by the perp EF, negative if paid by the vault
+//   - err: error if any
+func (k Keeper) repeg(
+	ctx sdk.Context, amm v2types.AMM, newPriceMultiplier sdk.Dec,
+) (updatedAMM *v2types.AMM, cost sdk.Int, err error) {
+	costDec, err := amm.CalcRepegCost(newPriceMultiplier)
+	if err != nil {
+		return nil, sdk.Int{}, err
+	}
+	cost = costDec.Ceil().TruncateInt()
+
+	if err = k.handleMarketUpdateCost(ctx, amm.Pair, cost); err != nil {
+		return nil, sdk.Int{}, err
+	}
+
+	oldPriceMultiplier := amm.PriceMultiplier
+	amm.PriceMultiplier = newPriceMultiplier
+	k.AMMs.Insert(ctx, amm.Pair, amm)
+
+	return &amm, cost, ctx.EventManager().EmitTypedEvent(&v2types.PriceMultiplierUpdatedEvent{
+		Pair:               amm.Pair,
+		OldPriceMultiplier: oldPriceMultiplier,
+		NewPriceMultiplier: newPriceMultiplier,
+		Cost:               cost,
+		BlockHeight:        ctx.BlockHeight(),
+	})
+}
+
+// updateSwapInvariant multiplies the swap invariant of the AMM and settles its
+// cost between the perp EF and the vault.
+//
+// returns:
+//   - updatedAMM: the updated AMM
+//   - cost: the cost paid by the perp EF, negative if paid by the vault
+//   - err: error if any
+func (k Keeper) updateSwapInvariant(
+	ctx sdk.Context, amm v2types.AMM, multiplier sdk.Dec,
+) (updatedAMM *v2types.AMM, cost sdk.Int, err error) {
+	costDec, err := amm.CalcUpdateSwapInvariantCost(multiplier)
+	if err != nil {
+		return nil, sdk.Int{}, err
+	}
+	cost = costDec.Ceil().TruncateInt()
+
+	if err = k.handleMarketUpdateCost(ctx, amm.Pair, cost); err != nil {
+		return nil, sdk.Int{}, err
+	}
+
+	if err = amm.UpdateSwapInvariant(multiplier); err != nil {
+		return nil, sdk.Int{}, err
+	}
+	k.AMMs.Insert(ctx, amm.Pair, amm)
+
+	return &amm, cost, ctx.EventManager().EmitTypedEvent(&v2types.SwapInvariantUpdatedEvent{
+		Pair:                    amm.Pair,
+		SwapInvariantMultiplier: multiplier,
+		NewSqrtDepth:            amm.SqrtDepth,
+		Cost:                    cost,
+		BlockHeight:             ctx.BlockHeight(),
+	})
+}
+
+// handleMarketUpdateCost sends a positive cost from the perp EF to the vault,
+// and a negative cost from the vault to the perp EF. Either transfer failing
+// fails the update, so that the AMM never moves without its cost being paid.
+func (k Keeper) handleMarketUpdateCost(ctx sdk.Context, pair asset.Pair, cost sdk.Int) (err error) {
+	if cost.IsPositive() {
+		err = k.BankKeeper.SendCoinsFromModuleToModule(
+			ctx,
+			types.PerpEFModuleAccount,
+			types.VaultModuleAccount,
+			sdk.NewCoins(sdk.NewCoin(pair.QuoteDenom(), cost)),
+		)
+		if err != nil {
+			return v2types.ErrNotEnoughFundToPayAction.Wrapf("cost: %s", cost)
+		}
+	} else if cost.IsNegative() {
+		err = k.BankKeeper.SendCoinsFromModuleToModule(
+			ctx,
+			types.VaultModuleAccount,
+			types.PerpEFModuleAccount,
+			sdk.NewCoins(sdk.NewCoin(pair.QuoteDenom(), cost.Neg())),
+		)
+		if err != nil {
+			return fmt.Errorf("vault cannot pay %s%s to the perp EF: %w", cost.Neg(), pair.QuoteDenom(), err)
+		}
+	}
+	return nil
+}
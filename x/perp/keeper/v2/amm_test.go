@@ -0,0 +1,166 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/NibiruChain/nibiru/x/common/asset"
+	"github.com/NibiruChain/nibiru/x/common/denoms"
+	"github.com/NibiruChain/nibiru/x/common/testutil"
+	. "github.com/NibiruChain/nibiru/x/common/testutil/action"
+	. "github.com/NibiruChain/nibiru/x/common/testutil/assertion"
+	. "github.com/NibiruChain/nibiru/x/epochs/integration/action"
+	epochtypes "github.com/NibiruChain/nibiru/x/epochs/types"
+	. "github.com/NibiruChain/nibiru/x/oracle/integration/action"
+	. "github.com/NibiruChain/nibiru/x/perp/integration/action/v2"
+	. "github.com/NibiruChain/nibiru/x/perp/integration/assertion/v2"
+	v2types "github.com/NibiruChain/nibiru/x/perp/types/v2"
+)
+
+func TestAMMController(t *testing.T) {
+	alice := testutil.AccAddress()
+	pairBtcUsdc := asset.Registry.Pair(denoms.BTC, denoms.USDC)
+	startTime := time.Now()
+
+	givenMarketWithLong := func(indexPrice sdk.Dec, marketModifiers ...MarketModifier) []Action {
+		return []Action{
+			CreateCustomMarket(pairBtcUsdc, marketModifiers...),
+			SetBlockNumber(1),
+			SetBlockTime(startTime),
+			FundAccount(alice, sdk.NewCoins(sdk.NewCoin(denoms.USDC, sdk.NewInt(1020)))),
+			FundModule(v2types.PerpEFModuleAccount, sdk.NewCoins(sdk.NewCoin(denoms.USDC, sdk.NewInt(10000)))),
+			OpenPosition(alice, pairBtcUsdc, v2types.Direction_LONG, sdk.NewInt(1000), sdk.NewDec(10), sdk.ZeroDec()),
+			SetOraclePrice(pairBtcUsdc, indexPrice),
+			SetOraclePriceMaxAge(pairBtcUsdc, time.Hour),
+			InsertOraclePriceSnapshot(pairBtcUsdc, startTime.Add(15*time.Minute), indexPrice),
+			StartEpoch(epochtypes.ThirtyMinuteEpochID),
+		}
+	}
+
+	tc := TestCases{
+		TC("re-pegs to the index twap when over the spread limit").
+			Given(givenMarketWithLong(sdk.MustNewDecFromStr("1.1"),
+				WithMaxSpreadRatio(sdk.MustNewDecFromStr("0.05")),
+				WithAmmUpdateBudget(sdk.NewInt(2000)),
+			)...).
+			When(
+				MoveToNextBlockWithDuration(30*time.Minute),
+			).
+			Then(
+				AMMShouldBeEqual(pairBtcUsdc, AMM_PriceMultiplierShouldBeEqualTo(sdk.MustNewDecFromStr("1.099999978000000330"))),
+				ModuleBalanceEqual(v2types.PerpEFModuleAccount, denoms.USDC, sdk.NewInt(9010)),
+			),
+
+		TC("re-pegs part of the way when over budget").
+			Given(givenMarketWithLong(sdk.MustNewDecFromStr("1.1"),
+				WithMaxSpreadRatio(sdk.MustNewDecFromStr("0.05")),
+				WithAmmUpdateBudget(sdk.NewInt(500)),
+			)...).
+			When(
+				MoveToNextBlockWithDuration(30*time.Minute),
+			).
+			Then(
+				AMMShouldBeEqual(pairBtcUsdc, AMM_PriceMultiplierShouldBeEqualTo(sdk.MustNewDecFromStr("1.049999999999999999"))),
+				ModuleBalanceEqual(v2types.PerpEFModuleAccount, denoms.USDC, sdk.NewInt(9510)),
+			),
+
+		TC("does not re-peg on a stale index price").
+			Given(append(givenMarketWithLong(sdk.MustNewDecFromStr("1.1"),
+				WithMaxSpreadRatio(sdk.MustNewDecFromStr("0.05")),
+				WithAmmUpdateBudget(sdk.NewInt(2000)),
+			), SetOraclePriceMaxAge(pairBtcUsdc, 10*time.Minute))...).
+			When(
+				MoveToNextBlockWithDuration(30*time.Minute),
+			).
+			Then(
+				AMMShouldBeEqual(pairBtcUsdc, AMM_PriceMultiplierShouldBeEqualTo(sdk.OneDec())),
+				ModuleBalanceEqual(v2types.PerpEFModuleAccount, denoms.USDC, sdk.NewInt(10010)),
+			),
+
+		TC("does not re-peg under the spread limit").
+			Given(givenMarketWithLong(sdk.MustNewDecFromStr("1.02"),
+				WithMaxSpreadRatio(sdk.MustNewDecFromStr("0.05")),
+				WithAmmUpdateBudget(sdk.NewInt(2000)),
+			)...).
+			When(
+				MoveToNextBlockWithDuration(30*time.Minute),
+			).
+			Then(
+				AMMShouldBeEqual(pairBtcUsdc, AMM_PriceMultiplierShouldBeEqualTo(sdk.OneDec())),
+				ModuleBalanceEqual(v2types.PerpEFModuleAccount, denoms.USDC, sdk.NewInt(10010)),
+			),
+
+		TC("does not update the amm without a budget").
+			Given(givenMarketWithLong(sdk.MustNewDecFromStr("1.1"),
+				WithMaxSpreadRatio(sdk.MustNewDecFromStr("0.05")),
+				WithMaxSkewRatio(sdk.MustNewDecFromStr("0.000000005")),
+			)...).
+			When(
+				MoveToNextBlockWithDuration(30*time.Minute),
+			).
+			Then(
+				AMMShouldBeEqual(pairBtcUsdc,
+					AMM_PriceMultiplierShouldBeEqualTo(sdk.OneDec()),
+					AMM_SqrtDepthShouldBeEqualTo(sdk.NewDec(1e12)),
+				),
+				ModuleBalanceEqual(v2types.PerpEFModuleAccount, denoms.USDC, sdk.NewInt(10010)),
+			),
+
+		TC("scales up the depth when over the skew limit").
+			Given(givenMarketWithLong(sdk.OneDec(),
+				WithMaxSkewRatio(sdk.MustNewDecFromStr("0.000000005")),
+				WithAmmUpdateBudget(sdk.NewInt(2000)),
+			)...).
+			When(
+				MoveToNextBlockWithDuration(30*time.Minute),
+			).
+			Then(
+				AMMShouldBeEqual(pairBtcUsdc,
+					AMM_PriceMultiplierShouldBeEqualTo(sdk.OneDec()),
+					AMM_SqrtDepthShouldBeEqualTo(sdk.MustNewDecFromStr("1999999999999.999999999000000000")),
+				),
+				ModuleBalanceEqual(v2types.PerpEFModuleAccount, denoms.USDC, sdk.NewInt(10009)),
+			),
+
+		TC("does not re-peg when the vault can't pay the ecosystem fund").
+			Given(givenMarketWithLong(sdk.MustNewDecFromStr("0.8"),
+				WithMaxSpreadRatio(sdk.MustNewDecFromStr("0.05")),
+				WithAmmUpdateBudget(sdk.NewInt(2000)),
+			)...).
+			When(
+				MoveToNextBlockWithDuration(30*time.Minute),
+			).
+			Then(
+				AMMShouldBeEqual(pairBtcUsdc, AMM_PriceMultiplierShouldBeEqualTo(sdk.OneDec())),
+				ModuleBalanceEqual(v2types.PerpEFModuleAccount, denoms.USDC, sdk.NewInt(10010)),
+				ModuleBalanceEqual(v2types.VaultModuleAccount, denoms.USDC, sdk.NewInt(1000)),
+			),
+
+		TC("does not update the amm when the ecosystem fund can't pay").
+			Given(
+				CreateCustomMarket(pairBtcUsdc,
+					WithMaxSpreadRatio(sdk.MustNewDecFromStr("0.05")),
+					WithAmmUpdateBudget(sdk.NewInt(2000)),
+				),
+				SetBlockNumber(1),
+				SetBlockTime(startTime),
+				FundAccount(alice, sdk.NewCoins(sdk.NewCoin(denoms.USDC, sdk.NewInt(1020)))),
+				OpenPosition(alice, pairBtcUsdc, v2types.Direction_LONG, sdk.NewInt(1000), sdk.NewDec(10), sdk.ZeroDec()),
+				SetOraclePrice(pairBtcUsdc, sdk.MustNewDecFromStr("1.1")),
+				InsertOraclePriceSnapshot(pairBtcUsdc, startTime.Add(15*time.Minute), sdk.MustNewDecFromStr("1.1")),
+				StartEpoch(epochtypes.ThirtyMinuteEpochID),
+			).
+			When(
+				MoveToNextBlockWithDuration(30*time.Minute),
+			).
+			Then(
+				AMMShouldBeEqual(pairBtcUsdc, AMM_PriceMultiplierShouldBeEqualTo(sdk.OneDec())),
+				ModuleBalanceEqual(v2types.PerpEFModuleAccount, denoms.USDC, sdk.NewInt(10)),
+				MarketShouldBeEqual(pairBtcUsdc, Market_LatestCPFShouldBeEqualTo(sdk.MustNewDecFromStr("-0.002083332916666664"))),
+			),
+	}
+
+	NewTestSuite(t).WithTestCases(tc...).Run()
+}
@@ -0,0 +1,254 @@
+package keeper
+
+import (
+	"bytes"
+	"sort"
+
+	"github.com/NibiruChain/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/NibiruChain/nibiru/x/common/asset"
+	v2types "github.com/NibiruChain/nibiru/x/perp/types/v2"
+)
+
+// SetAutoDeleveraging turns auto-deleveraging on or off for a market.
+func (k Keeper) SetAutoDeleveraging(ctx sdk.Context, pair asset.Pair, enabled bool) error {
+	market, err := k.Markets.Get(ctx, pair)
+	if err != nil {
+		return v2types.ErrPairNotFound.Wrapf("pair: %s", pair)
+	}
+
+	market.AutoDeleveragingEnabled = enabled
+	k.Markets.Insert(ctx, pair, market)
+
+	return nil
+}
+
+// MaxDeleverageCandidatesScanned bounds the number of positions of a market
+// scanned for counter positions when a bankrupt position is deleveraged.
+const MaxDeleverageCandidatesScanned = 1000
+
+// deleverageCandidate is a profitable position on the other side of a
+// bankrupt position.
+type deleverageCandidate struct {
+	position v2types.Position
+	// unrealized pnl * leverage, where leverage is the position notional over
+	// the margin balance of the position
+	score sdk.Dec
+}
+
+// autoDeleverage covers the deficit left by a bankrupt position by reducing the
+// profitable positions on the other side of the market. Positions are ranked
+// by unrealized pnl * leverage and reduced, in that order, at the bankruptcy
+// price of the bankrupt position. The reduced size is matched off the AMM, so
+// its reserves are left untouched. Every unit of size closed gives up the
+// difference between the bankruptcy price and the mark price, which stays in
+// the vault.
+//
+// args:
+//   - ctx: the cosmos-sdk context
+//   - market: the perp market
+//   - bankruptPosition: the position whose bad debt is being realized
+//   - bankruptcyPrice: the bankruptcy price of the bankrupt position
+//   - deficit: the bad debt left uncovered, in quote units
+//
+// returns:
+//   - covered: the part of the deficit covered by the deleveraged positions
+//   - err: error if any
+func (k Keeper) autoDeleverage(
+	ctx sdk.Context,
+	market v2types.Market,
+	bankruptPosition v2types.Position,
+	bankruptcyPrice sdk.Dec,
+	deficit sdk.Int,
+) (covered sdk.Int, err error) {
+	covered = sdk.ZeroInt()
+	if bankruptPosition.Size_.IsZero() || !deficit.IsPositive() {
+		return covered, nil
+	}
+
+	amm, err := k.AMMs.Get(ctx, market.Pair)
+	if err != nil {
+		return sdk.Int{}, v2types.ErrPairNotFound
+	}
+
+	// the counter positions of a bankrupt long buy back at the bankruptcy
+	// price, those of a bankrupt short sell at it
+	haircutPerUnit := bankruptcyPrice.Sub(amm.MarkPrice())
+	if bankruptPosition.Size_.IsNegative() {
+		haircutPerUnit = haircutPerUnit.Neg()
+	}
+	if !haircutPerUnit.IsPositive() {
+		return covered, nil
+	}
+
+	candidates, err := k.deleverageCandidates(ctx, market, amm, bankruptPosition, bankruptcyPrice)
+	if err != nil {
+		return sdk.Int{}, err
+	}
+
+	remaining := deficit.ToDec()
+	for _, candidate := range candidates {
+		if !remaining.IsPositive() {
+			break
+		}
+
+		sizeToReduce := sdk.MinDec(candidate.position.Size_.Abs(), remaining.Quo(haircutPerUnit))
+		haircut, err := k.deleveragePosition(
+			ctx, market, candidate, bankruptPosition, bankruptcyPrice, sizeToReduce, haircutPerUnit, remaining)
+		if err != nil {
+			return sdk.Int{}, err
+		}
+
+		remaining = remaining.Sub(haircut)
+	}
+
+	if remaining.IsNegative() {
+		remaining = sdk.ZeroDec()
+	}
+	return deficit.Sub(remaining.Ceil().TruncateInt()), nil
+}
+
+// deleverageCandidates returns the positions on the other side of the bankrupt
+// position that are still profitable at its bankruptcy price, sorted by
+// unrealized pnl * leverage in descending order. At most
+// MaxDeleverageCandidatesScanned positions of the market are scanned.
+func (k Keeper) deleverageCandidates(
+	ctx sdk.Context,
+	market v2types.Market,
+	amm v2types.AMM,
+	bankruptPosition v2types.Position,
+	bankruptcyPrice sdk.Dec,
+) (candidates []deleverageCandidate, err error) {
+	iter := k.Positions.Iterate(
+		ctx,
+		collections.PairRange[asset.Pair, sdk.AccAddress]{}.Prefix(market.Pair),
+	)
+	defer iter.Close()
+
+	for scanned := 0; iter.Valid() && scanned < MaxDeleverageCandidatesScanned; iter.Next() {
+		scanned++
+		position := iter.Value()
+		if position.Size_.IsPositive() == bankruptPosition.Size_.IsPositive() {
+			continue
+		}
+
+		if UnrealizedPnl(position, position.Size_.Abs().Mul(bankruptcyPrice)).IsNegative() {
+			continue
+		}
+
+		positionNotional, err := PositionNotionalSpot(amm, position)
+		if err != nil {
+			return nil, err
+		}
+		unrealizedPnl := UnrealizedPnl(position, positionNotional)
+		if !unrealizedPnl.IsPositive() {
+			continue
+		}
+
+		marginBalance := position.Margin.Sub(FundingPayment(position, market.LatestCumulativePremiumFraction))
+		if !marginBalance.IsPositive() {
+			continue
+		}
+		leverage := positionNotional.Quo(marginBalance.Add(unrealizedPnl))
+
+		candidates = append(candidates, deleverageCandidate{
+			position: position,
+			score:    unrealizedPnl.Mul(leverage),
+		})
+	}
+
+	sort.SliceStable(candidates, func(i, j int) bool {
+		if !candidates[i].score.Equal(candidates[j].score) {
+			return candidates[i].score.GT(candidates[j].score)
+		}
+		return bytes.Compare(
+			[]byte(candidates[i].position.TraderAddress),
+			[]byte(candidates[j].position.TraderAddress),
+		) < 0
+	})
+
+	return candidates, nil
+}
+
+// deleveragePosition reduces a position by sizeToReduce at the bankruptcy
+// price, matched off the AMM. The haircut is the pnl given up against the mark
+// price, capped at maxHaircut. A position reduced to zero is closed and its
+// margin is sent back to the trader.
+//
+// returns:
+//   - haircut: the pnl given up by the position
+//   - err: error if any
+func (k Keeper) deleveragePosition(
+	ctx sdk.Context,
+	market v2types.Market,
+	candidate deleverageCandidate,
+	bankruptPosition v2types.Position,
+	bankruptcyPrice sdk.Dec,
+	sizeToReduce sdk.Dec,
+	haircutPerUnit sdk.Dec,
+	maxHaircut sdk.Dec,
+) (haircut sdk.Dec, err error) {
+	position := candidate.position
+	traderAddr, err := sdk.AccAddressFromBech32(position.TraderAddress)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+
+	exchangedNotional := sizeToReduce.Mul(bankruptcyPrice)
+	reducedPosition := position
+	reducedPosition.Size_ = position.Size_.Mul(sizeToReduce.Quo(position.Size_.Abs()))
+	reducedPosition.OpenNotional = position.OpenNotional.Mul(sizeToReduce.Quo(position.Size_.Abs()))
+	realizedPnl := UnrealizedPnl(reducedPosition, exchangedNotional)
+	haircut = sdk.MinDec(sizeToReduce.Mul(haircutPerUnit), maxHaircut)
+
+	fundingPayment := FundingPayment(position, market.LatestCumulativePremiumFraction)
+	remainingMargin := position.Margin.Add(realizedPnl).Sub(fundingPayment)
+
+	exchangedSize := reducedPosition.Size_.Neg()
+	newSize := position.Size_.Add(exchangedSize)
+	k.updateOpenInterest(ctx, market.Pair, position.Size_, newSize)
+	k.recordTraderHistory(ctx, traderAddr, market.Pair, traderHistoryChange{
+		exchangedSize:     exchangedSize,
+		exchangedNotional: exchangedNotional,
+		realizedPnl:       realizedPnl,
+		fundingPayment:    fundingPayment,
+		fees:              sdk.ZeroDec(),
+		liquidationLoss:   sdk.ZeroDec(),
+	})
+	if newSize.IsZero() {
+		if err = k.Positions.Delete(ctx, collections.Join(market.Pair, traderAddr)); err != nil {
+			return sdk.Dec{}, err
+		}
+		// the prepaid bad debt of the market may have changed since it was read
+		if market, err = k.Markets.Get(ctx, market.Pair); err != nil {
+			return sdk.Dec{}, err
+		}
+		if err = k.Withdraw(ctx, market, traderAddr, remainingMargin.TruncateInt()); err != nil {
+			return sdk.Dec{}, err
+		}
+	} else {
+		k.Positions.Insert(ctx, collections.Join(market.Pair, traderAddr), v2types.Position{
+			TraderAddress:                   position.TraderAddress,
+			Pair:                            position.Pair,
+			Size_:                           newSize,
+			Margin:                          remainingMargin,
+			OpenNotional:                    position.OpenNotional.Sub(reducedPosition.OpenNotional),
+			LatestCumulativePremiumFraction: market.LatestCumulativePremiumFraction,
+			LastUpdatedBlockNumber:          ctx.BlockHeight(),
+		})
+	}
+
+	return haircut, ctx.EventManager().EmitTypedEvent(&v2types.AutoDeleveragedEvent{
+		Pair:                  market.Pair,
+		TraderAddress:         position.TraderAddress,
+		BankruptTraderAddress: bankruptPosition.TraderAddress,
+		ExchangedSize:         exchangedSize,
+		BankruptcyPrice:       bankruptcyPrice,
+		RealizedPnl:           realizedPnl,
+		Haircut:               sdk.NewCoin(market.Pair.QuoteDenom(), haircut.TruncateInt()),
+		PositionSize:          newSize,
+		BlockHeight:           ctx.BlockHeight(),
+		BlockTimeMs:           ctx.BlockTime().UnixMilli(),
+	})
+}
@@ -0,0 +1,172 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/NibiruChain/nibiru/x/common/asset"
+	"github.com/NibiruChain/nibiru/x/common/denoms"
+	"github.com/NibiruChain/nibiru/x/common/testutil"
+	. "github.com/NibiruChain/nibiru/x/common/testutil/action"
+	. "github.com/NibiruChain/nibiru/x/common/testutil/assertion"
+	. "github.com/NibiruChain/nibiru/x/perp/integration/action/v2"
+	. "github.com/NibiruChain/nibiru/x/perp/integration/assertion/v2"
+	v2types "github.com/NibiruChain/nibiru/x/perp/types/v2"
+)
+
+func TestAutoDeleverage(t *testing.T) {
+	alice := testutil.AccAddress()
+	bob := testutil.AccAddress()
+	carol := testutil.AccAddress()
+	liquidator := testutil.AccAddress()
+	pairBtcUsdc := asset.Registry.Pair(denoms.BTC, denoms.USDC)
+	startTime := time.Now()
+
+	// (10000 - 200) / (10000 * (1 - 0.05 / 2)), where the liquidation fee is
+	// taken out of alice's margin
+	bankruptcyPrice := sdk.MustNewDecFromStr("1.005128205128205128")
+
+	// alice's long is liquidated with 50 of bad debt, which nothing but the
+	// short positions of bob and carol can cover
+	givenBankruptLong := func(marketModifiers ...MarketModifier) []Action {
+		return []Action{
+			SetBlockNumber(1),
+			SetBlockTime(startTime),
+			CreateCustomMarket(pairBtcUsdc, marketModifiers...),
+			InsertPosition(
+				WithPair(pairBtcUsdc),
+				WithTrader(alice),
+				WithSize(sdk.NewDec(10000)),
+				WithMargin(sdk.NewDec(200)),
+				WithOpenNotional(sdk.NewDec(10000)),
+			),
+			InsertPosition(
+				WithPair(pairBtcUsdc),
+				WithTrader(bob),
+				WithSize(sdk.NewDec(-20000)),
+				WithMargin(sdk.NewDec(500)),
+				WithOpenNotional(sdk.NewDec(20200)),
+			),
+			InsertPosition(
+				WithPair(pairBtcUsdc),
+				WithTrader(carol),
+				WithSize(sdk.NewDec(-20000)),
+				WithMargin(sdk.NewDec(5000)),
+				WithOpenNotional(sdk.NewDec(20200)),
+			),
+			FundModule(v2types.VaultModuleAccount, sdk.NewCoins(sdk.NewCoin(denoms.USDC, sdk.NewInt(5700)))),
+		}
+	}
+
+	tc := TestCases{
+		TC("bad debt is not deleveraged when auto-deleveraging is disabled").
+			Given(givenBankruptLong()...).
+			When(
+				MoveToNextBlock(),
+				LiquidateExpectingFail(liquidator, alice, pairBtcUsdc),
+			).
+			Then(
+				PositionShouldBeEqual(bob, pairBtcUsdc, Position_PositionSizeShouldBeEqualTo(sdk.NewDec(-20000))),
+				PositionShouldBeEqual(carol, pairBtcUsdc, Position_PositionSizeShouldBeEqualTo(sdk.NewDec(-20000))),
+			),
+
+		TC("the most profitable and leveraged counter position is deleveraged first").
+			Given(givenBankruptLong(WithAutoDeleveragingEnabled(true))...).
+			When(
+				MoveToNextBlock(),
+				Liquidate(liquidator, alice, pairBtcUsdc),
+			).
+			Then(
+				PositionShouldNotExist(alice, pairBtcUsdc),
+				BalanceEqual(liquidator, denoms.USDC, sdk.NewInt(250)),
+				ModuleBalanceEqual(v2types.PerpEFModuleAccount, denoms.USDC, sdk.ZeroInt()),
+				// only alice's position was closed against the AMM
+				AMMShouldBeEqual(pairBtcUsdc, AMM_BaseReserveShouldBeEqualTo(sdk.NewDec(1e12+10000))),
+				AutoDeleveragedEventShouldBeEmitted(bob, sdk.MustNewDecFromStr("9749.961975148867680000"), bankruptcyPrice, sdk.NewInt(50)),
+				PositionShouldBeEqual(bob, pairBtcUsdc,
+					Position_PositionSizeShouldBeEqualTo(sdk.MustNewDecFromStr("-10250.038024851132320000")),
+					Position_MarginShouldBeEqualTo(sdk.MustNewDecFromStr("547.499814750725252599")),
+				),
+				PositionShouldBeEqual(carol, pairBtcUsdc,
+					Position_PositionSizeShouldBeEqualTo(sdk.NewDec(-20000)),
+					Position_MarginShouldBeEqualTo(sdk.NewDec(5000)),
+				),
+			),
+
+		TC("ecosystem fund is used before deleveraging").
+			Given(append(givenBankruptLong(WithAutoDeleveragingEnabled(true)),
+				FundModule(v2types.PerpEFModuleAccount, sdk.NewCoins(sdk.NewCoin(denoms.USDC, sdk.NewInt(30)))),
+			)...).
+			When(
+				MoveToNextBlock(),
+				Liquidate(liquidator, alice, pairBtcUsdc),
+			).
+			Then(
+				PositionShouldNotExist(alice, pairBtcUsdc),
+				ModuleBalanceEqual(v2types.PerpEFModuleAccount, denoms.USDC, sdk.ZeroInt()),
+				AutoDeleveragedEventShouldBeEmitted(bob, sdk.MustNewDecFromStr("3899.984790059547080000"), bankruptcyPrice, sdk.NewInt(20)),
+				PositionShouldBeEqual(bob, pairBtcUsdc,
+					Position_PositionSizeShouldBeEqualTo(sdk.MustNewDecFromStr("-16100.015209940452920000")),
+					Position_MarginShouldBeEqualTo(sdk.MustNewDecFromStr("518.999925900290109120")),
+				),
+				PositionShouldBeEqual(carol, pairBtcUsdc, Position_PositionSizeShouldBeEqualTo(sdk.NewDec(-20000))),
+			),
+
+		TC("a fully deleveraged position is closed and the rest goes to the next one").
+			Given(
+				SetBlockNumber(1),
+				SetBlockTime(startTime),
+				CreateCustomMarket(pairBtcUsdc, WithAutoDeleveragingEnabled(true)),
+				InsertPosition(
+					WithPair(pairBtcUsdc),
+					WithTrader(alice),
+					WithSize(sdk.NewDec(10000)),
+					WithMargin(sdk.NewDec(200)),
+					WithOpenNotional(sdk.NewDec(10000)),
+				),
+				InsertPosition(
+					WithPair(pairBtcUsdc),
+					WithTrader(bob),
+					WithSize(sdk.NewDec(-5000)),
+					WithMargin(sdk.NewDec(100)),
+					WithOpenNotional(sdk.NewDec(5100)),
+				),
+				InsertPosition(
+					WithPair(pairBtcUsdc),
+					WithTrader(carol),
+					WithSize(sdk.NewDec(-20000)),
+					WithMargin(sdk.NewDec(5000)),
+					WithOpenNotional(sdk.NewDec(20200)),
+				),
+				FundModule(v2types.VaultModuleAccount, sdk.NewCoins(sdk.NewCoin(denoms.USDC, sdk.NewInt(5300)))),
+			).
+			When(
+				MoveToNextBlock(),
+				Liquidate(liquidator, alice, pairBtcUsdc),
+			).
+			Then(
+				PositionShouldNotExist(alice, pairBtcUsdc),
+				AutoDeleveragedEventShouldBeEmitted(bob, sdk.NewDec(5000), bankruptcyPrice, sdk.NewInt(25)),
+				PositionShouldNotExist(bob, pairBtcUsdc),
+				BalanceEqual(bob, denoms.USDC, sdk.NewInt(174)),
+				AutoDeleveragedEventShouldBeEmitted(carol, sdk.MustNewDecFromStr("4749.961975148867680000"), bankruptcyPrice, sdk.NewInt(24)),
+				PositionShouldBeEqual(carol, pairBtcUsdc, Position_PositionSizeShouldBeEqualTo(sdk.MustNewDecFromStr("-15250.038024851132320000"))),
+			),
+
+		TC("auto-deleveraging is turned on by governance").
+			Given(givenBankruptLong()...).
+			When(
+				PassSetAutoDeleveragingProposal(pairBtcUsdc, true),
+				MoveToNextBlock(),
+				Liquidate(liquidator, alice, pairBtcUsdc),
+			).
+			Then(
+				PositionShouldNotExist(alice, pairBtcUsdc),
+				PositionShouldBeEqual(bob, pairBtcUsdc, Position_PositionSizeShouldBeEqualTo(sdk.MustNewDecFromStr("-10250.038024851132320000"))),
+			),
+	}
+
+	NewTestSuite(t).WithTestCases(tc...).Run()
+}
@@ -78,8 +78,97 @@ func MarginRatio(
 	return remainingMargin.Quo(positionNotional)
 }
 
+// FreeCollateral returns the amount of margin of a position, net of unrealized
+// losses, that is in excess of the maintenance margin requirement. Unrealized
+// profits do not count towards free collateral.
+func FreeCollateral(
+	position v2types.Position,
+	positionNotional sdk.Dec,
+	maintenanceMarginRatio sdk.Dec,
+) sdk.Dec {
+	unrealizedPnl := UnrealizedPnl(position, positionNotional)
+	remainingMargin := sdk.MinDec(position.Margin, position.Margin.Add(unrealizedPnl))
+	maintenanceMarginRequirement := positionNotional.Mul(maintenanceMarginRatio)
+
+	return remainingMargin.Sub(maintenanceMarginRequirement)
+}
+
 func FundingPayment(position v2types.Position, marketLatestCumulativePremiumFraction sdk.Dec) sdk.Dec {
 	return marketLatestCumulativePremiumFraction.
 		Sub(position.LatestCumulativePremiumFraction).
 		Mul(position.Size_)
 }
+
+// LiquidationPrice returns the price at which the margin ratio of the position
+// falls to the maintenance margin ratio of its margin tier, ignoring price
+// impact. It's zero if no price move can get the position liquidated.
+//
+// long:  (margin + size * price - openNotional) / (size * price) = mmr
+// short: (margin + openNotional - |size| * price) / (|size| * price) = mmr
+func LiquidationPrice(market v2types.Market, position v2types.Position) sdk.Dec {
+	if position.Size_.IsZero() {
+		return sdk.ZeroDec()
+	}
+
+	margin := position.Margin.Sub(FundingPayment(position, market.LatestCumulativePremiumFraction))
+	size := position.Size_.Abs()
+	isLong := position.Size_.IsPositive()
+
+	solve := func(mmr sdk.Dec) sdk.Dec {
+		var price sdk.Dec
+		if isLong {
+			if !sdk.OneDec().Sub(mmr).IsPositive() {
+				return sdk.ZeroDec()
+			}
+			price = position.OpenNotional.Sub(margin).Quo(size.Mul(sdk.OneDec().Sub(mmr)))
+		} else {
+			price = position.OpenNotional.Add(margin).Quo(size.Mul(sdk.OneDec().Add(mmr)))
+		}
+		return sdk.MaxDec(price, sdk.ZeroDec())
+	}
+
+	// a price is only a solution if the position falls into the tier whose
+	// maintenance margin ratio it was solved with; longs get liquidated at the
+	// highest solution and shorts at the lowest
+	var liquidationPrice sdk.Dec
+	for _, tier := range market.MarginTierTable() {
+		price := solve(tier.MaintenanceMarginRatio)
+		if !market.MarginTierFor(size.Mul(price)).NotionalFloor.Equal(tier.NotionalFloor) {
+			continue
+		}
+		if liquidationPrice.IsNil() ||
+			(isLong && price.GT(liquidationPrice)) ||
+			(!isLong && price.LT(liquidationPrice)) {
+			liquidationPrice = price
+		}
+	}
+	if liquidationPrice.IsNil() {
+		return solve(market.MaintenanceMarginRatio)
+	}
+	return liquidationPrice
+}
+
+// BankruptcyPrice returns the price at which the loss of the position and a fee
+// of feeRatio on its notional use up its margin, ignoring price impact.
+//
+// long:  margin + size * price - openNotional - feeRatio * size * price = 0
+// short: margin + openNotional - |size| * price - feeRatio * |size| * price = 0
+func BankruptcyPrice(market v2types.Market, position v2types.Position, feeRatio sdk.Dec) sdk.Dec {
+	if position.Size_.IsZero() {
+		return sdk.ZeroDec()
+	}
+
+	margin := position.Margin.Sub(FundingPayment(position, market.LatestCumulativePremiumFraction))
+	size := position.Size_.Abs()
+
+	var price sdk.Dec
+	if position.Size_.IsPositive() {
+		if !sdk.OneDec().Sub(feeRatio).IsPositive() {
+			return sdk.ZeroDec()
+		}
+		price = position.OpenNotional.Sub(margin).Quo(size.Mul(sdk.OneDec().Sub(feeRatio)))
+	} else {
+		price = position.OpenNotional.Add(margin).Quo(size.Mul(sdk.OneDec().Add(feeRatio)))
+	}
+	return sdk.MaxDec(price, sdk.ZeroDec())
+}
@@ -382,3 +382,139 @@ func TestFundingPayment(t *testing.T) {
 		})
 	}
 }
+
+func TestFreeCollateral(t *testing.T) {
+	tests := []struct {
+		name                   string
+		position               v2types.Position
+		positionNotional       sdk.Dec
+		mmr                    sdk.Dec
+		expectedFreeCollateral sdk.Dec
+	}{
+		{
+			name: "long position, positive PnL is not counted",
+			position: v2types.Position{
+				Margin:       sdk.NewDec(100),
+				Size_:        sdk.OneDec(),
+				OpenNotional: sdk.NewDec(1000),
+			},
+			positionNotional:       sdk.NewDec(1100),
+			mmr:                    sdk.MustNewDecFromStr("0.05"),
+			expectedFreeCollateral: sdk.NewDec(45), // 100 - 1100 * 0.05
+		},
+		{
+			name: "long position, negative PnL",
+			position: v2types.Position{
+				Margin:       sdk.NewDec(100),
+				Size_:        sdk.OneDec(),
+				OpenNotional: sdk.NewDec(1000),
+			},
+			positionNotional:       sdk.NewDec(950),
+			mmr:                    sdk.MustNewDecFromStr("0.05"),
+			expectedFreeCollateral: sdk.MustNewDecFromStr("2.5"), // 100 - 50 - 950 * 0.05
+		},
+		{
+			name: "short position, negative PnL",
+			position: v2types.Position{
+				Margin:       sdk.NewDec(100),
+				Size_:        sdk.NewDec(-1),
+				OpenNotional: sdk.NewDec(1000),
+			},
+			positionNotional:       sdk.NewDec(1100),
+			mmr:                    sdk.MustNewDecFromStr("0.05"),
+			expectedFreeCollateral: sdk.NewDec(-55), // 100 - 100 - 1100 * 0.05
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			freeCollateral := keeper.FreeCollateral(tc.position, tc.positionNotional, tc.mmr)
+			assert.EqualValues(t, tc.expectedFreeCollateral, freeCollateral)
+		})
+	}
+}
+
+func TestLiquidationPrice(t *testing.T) {
+	market := v2types.Market{
+		MaintenanceMarginRatio:          sdk.MustNewDecFromStr("0.05"),
+		LatestCumulativePremiumFraction: sdk.ZeroDec(),
+	}
+	tieredMarket := market
+	tieredMarket.MarginTiers = []v2types.MarginTier{
+		{NotionalFloor: sdk.NewDec(500), MaintenanceMarginRatio: sdk.MustNewDecFromStr("0.1"), MaxLeverage: sdk.NewDec(5)},
+		{NotionalFloor: sdk.NewDec(2000), MaintenanceMarginRatio: sdk.MustNewDecFromStr("0.2"), MaxLeverage: sdk.NewDec(2)},
+	}
+	fundedMarket := market
+	fundedMarket.LatestCumulativePremiumFraction = sdk.NewDec(10)
+
+	tests := []struct {
+		name                     string
+		market                   v2types.Market
+		position                 v2types.Position
+		expectedLiquidationPrice sdk.Dec
+	}{
+		{
+			name:   "long position",
+			market: market,
+			position: v2types.Position{
+				Size_: sdk.OneDec(), Margin: sdk.NewDec(100), OpenNotional: sdk.NewDec(1000),
+				LatestCumulativePremiumFraction: sdk.ZeroDec(),
+			},
+			expectedLiquidationPrice: sdk.NewDec(900).Quo(sdk.MustNewDecFromStr("0.95")),
+		},
+		{
+			name:   "short position",
+			market: market,
+			position: v2types.Position{
+				Size_: sdk.NewDec(-1), Margin: sdk.NewDec(100), OpenNotional: sdk.NewDec(1000),
+				LatestCumulativePremiumFraction: sdk.ZeroDec(),
+			},
+			expectedLiquidationPrice: sdk.NewDec(1100).Quo(sdk.MustNewDecFromStr("1.05")),
+		},
+		{
+			name:   "long position, margin tier of the liquidation notional",
+			market: tieredMarket,
+			position: v2types.Position{
+				Size_: sdk.OneDec(), Margin: sdk.NewDec(100), OpenNotional: sdk.NewDec(1000),
+				LatestCumulativePremiumFraction: sdk.ZeroDec(),
+			},
+			expectedLiquidationPrice: sdk.NewDec(1000), // 900 / (1 - 0.1)
+		},
+		{
+			name:   "long position, unpaid funding eats into the margin",
+			market: fundedMarket,
+			position: v2types.Position{
+				Size_: sdk.OneDec(), Margin: sdk.NewDec(100), OpenNotional: sdk.NewDec(1000),
+				LatestCumulativePremiumFraction: sdk.ZeroDec(),
+			},
+			expectedLiquidationPrice: sdk.NewDec(910).Quo(sdk.MustNewDecFromStr("0.95")),
+		},
+		{
+			name:   "fully collateralized long position is never liquidated",
+			market: market,
+			position: v2types.Position{
+				Size_: sdk.OneDec(), Margin: sdk.NewDec(1000), OpenNotional: sdk.NewDec(1000),
+				LatestCumulativePremiumFraction: sdk.ZeroDec(),
+			},
+			expectedLiquidationPrice: sdk.ZeroDec(),
+		},
+		{
+			name:   "empty position",
+			market: market,
+			position: v2types.Position{
+				Size_: sdk.ZeroDec(), Margin: sdk.ZeroDec(), OpenNotional: sdk.ZeroDec(),
+				LatestCumulativePremiumFraction: sdk.ZeroDec(),
+			},
+			expectedLiquidationPrice: sdk.ZeroDec(),
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			liquidationPrice := keeper.LiquidationPrice(tc.market, tc.position)
+			assert.Equal(t, tc.expectedLiquidationPrice.String(), liquidationPrice.String())
+		})
+	}
+}
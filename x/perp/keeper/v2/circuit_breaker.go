@@ -0,0 +1,189 @@
+package keeper
+
+import (
+	"errors"
+
+	"github.com/NibiruChain/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/NibiruChain/nibiru/x/common/asset"
+	v2types "github.com/NibiruChain/nibiru/x/perp/types/v2"
+)
+
+// CircuitBreaker returns the circuit breaker of a market, which is untripped
+// until it is first updated.
+func (k Keeper) CircuitBreaker(ctx sdk.Context, pair asset.Pair) v2types.CircuitBreaker {
+	return k.CircuitBreakers.GetOr(ctx, pair, v2types.CircuitBreaker{Pair: pair})
+}
+
+// CheckTradingHalted returns ErrTradingStopped if the whole module is stopped,
+// or ErrCircuitBreakerTripped if the circuit breaker of the market is tripped.
+// While halted, traders can only reduce or close their positions and add
+// margin.
+func (k Keeper) CheckTradingHalted(ctx sdk.Context, pair asset.Pair) error {
+	if k.GetParams(ctx).Stopped {
+		return v2types.ErrTradingStopped
+	}
+	if k.CircuitBreaker(ctx, pair).Tripped {
+		return v2types.ErrCircuitBreakerTripped.Wrapf("pair: %s", pair)
+	}
+	return nil
+}
+
+// ResetCircuitBreaker resets the circuit breaker of a market and forgets the
+// oracle prices it has seen, so that it only trips again on new price moves.
+func (k Keeper) ResetCircuitBreaker(ctx sdk.Context, pair asset.Pair) error {
+	if _, err := k.Markets.Get(ctx, pair); err != nil {
+		return v2types.ErrPairNotFound.Wrapf("pair: %s", pair)
+	}
+
+	k.CircuitBreakers.Insert(ctx, pair, v2types.CircuitBreaker{Pair: pair})
+	for _, prices := range []collections.Map[collections.Pair[asset.Pair, uint64], sdk.Dec]{
+		k.CircuitBreakerMinPrices, k.CircuitBreakerMaxPrices,
+	} {
+		for _, key := range prices.Iterate(ctx, collections.PairRange[asset.Pair, uint64]{}.Prefix(pair)).Keys() {
+			_ = prices.Delete(ctx, key)
+		}
+	}
+
+	return ctx.EventManager().EmitTypedEvent(&v2types.CircuitBreakerResetEvent{
+		Pair:        pair,
+		BlockHeight: ctx.BlockHeight(),
+	})
+}
+
+// UpdateCircuitBreaker trips the circuit breaker of a market when either:
+//   - the oracle price moved more than CircuitBreakerPriceChangeRatio within
+//     the last CircuitBreakerWindowBlocks blocks
+//   - the mark price broke the price fluctuation limit of the market for
+//     CircuitBreakerMaxFluctuationViolations blocks in a row
+//
+// It's called every block, before the reserve snapshot of the block is taken.
+// A tripped circuit breaker stays tripped until it's reset.
+//
+// args:
+//   - ctx: the cosmos-sdk context
+//   - market: the perp market
+//   - amm: the amm of the market
+func (k Keeper) UpdateCircuitBreaker(ctx sdk.Context, market v2types.Market, amm v2types.AMM) {
+	breaker := k.CircuitBreaker(ctx, market.Pair)
+	if breaker.Tripped {
+		return
+	}
+
+	changed := false
+	var reason v2types.CircuitBreakerReason
+
+	if market.CircuitBreakerPriceChangeRatio.IsPositive() {
+		if k.oraclePriceMovedOverLimit(ctx, market) {
+			reason = v2types.CircuitBreakerReason_ORACLE_PRICE_CHANGE
+		}
+	}
+
+	if market.CircuitBreakerMaxFluctuationViolations > 0 {
+		err := k.checkPriceFluctuationLimitRatio(ctx, market, amm)
+		switch {
+		case errors.Is(err, v2types.ErrOverFluctuationLimit):
+			breaker.FluctuationViolations++
+			changed = true
+		case breaker.FluctuationViolations != 0:
+			breaker.FluctuationViolations = 0
+			changed = true
+		}
+
+		if reason == v2types.CircuitBreakerReason_CIRCUIT_BREAKER_REASON_UNSPECIFIED &&
+			breaker.FluctuationViolations >= market.CircuitBreakerMaxFluctuationViolations {
+			reason = v2types.CircuitBreakerReason_PRICE_FLUCTUATION
+		}
+	}
+
+	if reason != v2types.CircuitBreakerReason_CIRCUIT_BREAKER_REASON_UNSPECIFIED {
+		breaker.Tripped = true
+		breaker.Reason = reason
+		breaker.TrippedBlockHeight = ctx.BlockHeight()
+		breaker.TrippedBlockTimeMs = ctx.BlockTime().UnixMilli()
+		k.CircuitBreakers.Insert(ctx, market.Pair, breaker)
+
+		_ = ctx.EventManager().EmitTypedEvent(&v2types.CircuitBreakerTrippedEvent{
+			CircuitBreaker: breaker,
+		})
+		return
+	}
+
+	if changed {
+		k.CircuitBreakers.Insert(ctx, market.Pair, breaker)
+	}
+}
+
+// oraclePriceMovedOverLimit records the current oracle price of the market and
+// returns true if it's more than CircuitBreakerPriceChangeRatio away from any
+// oracle price recorded within the last CircuitBreakerWindowBlocks blocks.
+//
+// Only the min and max prices of the window need to be compared against, which
+// are kept in monotonic queues so that each block does a constant amount of
+// work on average instead of scanning the window.
+func (k Keeper) oraclePriceMovedOverLimit(ctx sdk.Context, market v2types.Market) bool {
+	price, err := k.OracleKeeper.GetExchangeRateWithMaxAge(ctx, market.Pair)
+	if err != nil || !price.IsPositive() {
+		// no fresh oracle price to compare against
+		return false
+	}
+
+	height := uint64(ctx.BlockHeight())
+	var windowStart uint64
+	if height > market.CircuitBreakerWindowBlocks {
+		windowStart = height - market.CircuitBreakerWindowBlocks
+	}
+
+	ratio := market.CircuitBreakerPriceChangeRatio
+	minPrice, hasMin := k.slidePriceWindow(ctx, k.CircuitBreakerMinPrices, market.Pair, windowStart, height, price, sdk.Dec.GTE)
+	maxPrice, hasMax := k.slidePriceWindow(ctx, k.CircuitBreakerMaxPrices, market.Pair, windowStart, height, price, sdk.Dec.LTE)
+	return (hasMin && price.Sub(minPrice).Quo(minPrice).GT(ratio)) ||
+		(hasMax && maxPrice.Sub(price).Quo(maxPrice).GT(ratio))
+}
+
+// slidePriceWindow moves a monotonic queue of oracle prices to the window
+// starting at windowStart and returns its first price, the min (max) price of
+// the window, if any. The price is then pushed to the back of the queue after
+// popping the prices it supersedes, i.e. the ones for which supersedes(old,
+// price) is true.
+func (k Keeper) slidePriceWindow(
+	ctx sdk.Context,
+	queue collections.Map[collections.Pair[asset.Pair, uint64], sdk.Dec],
+	pair asset.Pair,
+	windowStart uint64,
+	height uint64,
+	price sdk.Dec,
+	supersedes func(old sdk.Dec, price sdk.Dec) bool,
+) (first sdk.Dec, found bool) {
+	rng := collections.PairRange[asset.Pair, uint64]{}.Prefix(pair)
+
+	// pop the prices recorded before the window from the front
+	var popped []collections.Pair[asset.Pair, uint64]
+	iter := queue.Iterate(ctx, rng)
+	for ; iter.Valid(); iter.Next() {
+		if kv := iter.KeyValue(); kv.Key.K2() >= windowStart {
+			first, found = kv.Value, true
+			break
+		}
+		popped = append(popped, iter.Key())
+	}
+	iter.Close()
+
+	// pop the prices superseded by the new price from the back
+	iter = queue.Iterate(ctx, rng.Descending())
+	for ; iter.Valid(); iter.Next() {
+		if kv := iter.KeyValue(); kv.Key.K2() < windowStart || !supersedes(kv.Value, price) {
+			break
+		}
+		popped = append(popped, iter.Key())
+	}
+	iter.Close()
+
+	for _, key := range popped {
+		_ = queue.Delete(ctx, key)
+	}
+	queue.Insert(ctx, collections.Join(pair, height), price)
+
+	return first, found
+}
@@ -0,0 +1,208 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/NibiruChain/nibiru/x/common/asset"
+	"github.com/NibiruChain/nibiru/x/common/denoms"
+	"github.com/NibiruChain/nibiru/x/common/testutil"
+	. "github.com/NibiruChain/nibiru/x/common/testutil/action"
+	"github.com/NibiruChain/nibiru/x/common/testutil/testapp"
+	. "github.com/NibiruChain/nibiru/x/oracle/integration/action"
+	. "github.com/NibiruChain/nibiru/x/perp/integration/action/v2"
+	. "github.com/NibiruChain/nibiru/x/perp/integration/assertion/v2"
+	"github.com/NibiruChain/nibiru/x/perp/keeper/v2"
+	v2types "github.com/NibiruChain/nibiru/x/perp/types/v2"
+)
+
+func TestCircuitBreaker(t *testing.T) {
+	alice := testutil.AccAddress()
+	pairBtcUsdc := asset.Registry.Pair(denoms.BTC, denoms.USDC)
+	startTime := time.Now()
+
+	givenMarketWithLong := func(marketModifiers ...MarketModifier) []Action {
+		return []Action{
+			CreateCustomMarket(pairBtcUsdc, marketModifiers...),
+			SetBlockNumber(1),
+			SetBlockTime(startTime),
+			SetOraclePrice(pairBtcUsdc, sdk.OneDec()),
+			FundAccount(alice, sdk.NewCoins(sdk.NewCoin(denoms.USDC, sdk.NewInt(10_000)))),
+			OpenPosition(alice, pairBtcUsdc, v2types.Direction_LONG, sdk.NewInt(1000), sdk.NewDec(10), sdk.ZeroDec()),
+		}
+	}
+
+	givenTrippedMarketWithLong := append(
+		givenMarketWithLong(WithCircuitBreakerPriceChange(sdk.MustNewDecFromStr("0.1"), 5)),
+		MoveToNextBlock(),
+		SetOraclePrice(pairBtcUsdc, sdk.MustNewDecFromStr("1.2")),
+		MoveToNextBlock(),
+	)
+
+	tc := TestCases{
+		TC("oracle price move over the limit trips the circuit breaker").
+			Given(givenMarketWithLong(WithCircuitBreakerPriceChange(sdk.MustNewDecFromStr("0.1"), 5))...).
+			When(
+				MoveToNextBlock(),
+				SetOraclePrice(pairBtcUsdc, sdk.MustNewDecFromStr("1.08")),
+				MoveToNextBlock(),
+				SetOraclePrice(pairBtcUsdc, sdk.MustNewDecFromStr("1.16")),
+				MoveToNextBlock(),
+			).
+			Then(
+				CircuitBreakerShouldBeEqual(pairBtcUsdc,
+					CircuitBreaker_TrippedShouldBeEqualTo(true),
+					CircuitBreaker_ReasonShouldBeEqualTo(v2types.CircuitBreakerReason_ORACLE_PRICE_CHANGE),
+				),
+			),
+
+		TC("oracle price move over the limit outside of the window does not trip the circuit breaker").
+			Given(givenMarketWithLong(WithCircuitBreakerPriceChange(sdk.MustNewDecFromStr("0.1"), 1))...).
+			When(
+				MoveToNextBlock(),
+				SetOraclePrice(pairBtcUsdc, sdk.MustNewDecFromStr("1.08")),
+				MoveToNextBlock(),
+				SetOraclePrice(pairBtcUsdc, sdk.MustNewDecFromStr("1.16")),
+				MoveToNextBlock(),
+			).
+			Then(
+				CircuitBreakerShouldBeEqual(pairBtcUsdc, CircuitBreaker_TrippedShouldBeEqualTo(false)),
+			),
+
+		TC("consecutive price fluctuation violations trip the circuit breaker").
+			Given(
+				CreateCustomMarket(pairBtcUsdc, WithCircuitBreakerMaxFluctuationViolations(2)),
+				MoveToNextBlock(),
+			).
+			When(
+				EditPriceMultiplier(pairBtcUsdc, sdk.NewDec(2)),
+				MoveToNextBlock(),
+				EditPriceMultiplier(pairBtcUsdc, sdk.NewDec(4)),
+				MoveToNextBlock(),
+			).
+			Then(
+				CircuitBreakerShouldBeEqual(pairBtcUsdc,
+					CircuitBreaker_TrippedShouldBeEqualTo(true),
+					CircuitBreaker_ReasonShouldBeEqualTo(v2types.CircuitBreakerReason_PRICE_FLUCTUATION),
+					CircuitBreaker_FluctuationViolationsShouldBeEqualTo(2),
+				),
+				OpenPositionExpectingFail(alice, pairBtcUsdc, v2types.Direction_LONG, sdk.NewInt(100), sdk.OneDec(), sdk.ZeroDec()),
+			),
+
+		TC("non-consecutive price fluctuation violations do not trip the circuit breaker").
+			Given(
+				CreateCustomMarket(pairBtcUsdc, WithCircuitBreakerMaxFluctuationViolations(2)),
+				MoveToNextBlock(),
+			).
+			When(
+				EditPriceMultiplier(pairBtcUsdc, sdk.NewDec(2)),
+				MoveToNextBlock(),
+				MoveToNextBlock(),
+				EditPriceMultiplier(pairBtcUsdc, sdk.NewDec(4)),
+				MoveToNextBlock(),
+			).
+			Then(
+				CircuitBreakerShouldBeEqual(pairBtcUsdc,
+					CircuitBreaker_TrippedShouldBeEqualTo(false),
+					CircuitBreaker_FluctuationViolationsShouldBeEqualTo(1),
+				),
+			),
+
+		TC("tripped circuit breaker only allows reducing, closing and adding margin").
+			Given(givenTrippedMarketWithLong...).
+			When(
+				OpenPositionExpectingFail(alice, pairBtcUsdc, v2types.Direction_LONG, sdk.NewInt(100), sdk.OneDec(), sdk.ZeroDec()),
+				OpenPositionExpectingFail(alice, pairBtcUsdc, v2types.Direction_SHORT, sdk.NewInt(2000), sdk.NewDec(10), sdk.ZeroDec()),
+				RemoveMarginExpectingFail(alice, pairBtcUsdc, sdk.NewInt(1)),
+				AddMargin(alice, pairBtcUsdc, sdk.NewInt(100)),
+				OpenPosition(alice, pairBtcUsdc, v2types.Direction_SHORT, sdk.NewInt(100), sdk.OneDec(), sdk.ZeroDec()),
+				ClosePosition(alice, pairBtcUsdc),
+			).
+			Then(
+				PositionShouldNotExist(alice, pairBtcUsdc),
+			),
+
+		TC("governance resets the circuit breaker").
+			Given(givenTrippedMarketWithLong...).
+			When(
+				PassResetCircuitBreakerProposal(pairBtcUsdc),
+				MoveToNextBlock(),
+			).
+			Then(
+				CircuitBreakerShouldBeEqual(pairBtcUsdc, CircuitBreaker_TrippedShouldBeEqualTo(false)),
+				OpenPosition(alice, pairBtcUsdc, v2types.Direction_LONG, sdk.NewInt(100), sdk.OneDec(), sdk.ZeroDec()),
+			),
+
+		TC("stopping the module halts all markets").
+			Given(givenMarketWithLong()...).
+			When(
+				SetStopped(true),
+			).
+			Then(
+				OpenPositionExpectingFail(alice, pairBtcUsdc, v2types.Direction_LONG, sdk.NewInt(100), sdk.OneDec(), sdk.ZeroDec()),
+				AddMargin(alice, pairBtcUsdc, sdk.NewInt(100)),
+				ClosePosition(alice, pairBtcUsdc),
+			),
+	}
+
+	NewTestSuite(t).WithTestCases(tc...).Run()
+}
+
+func TestQueryCircuitBreaker(t *testing.T) {
+	app, ctx := testapp.NewNibiruTestAppAndContext(true)
+	pairBtc := asset.Registry.Pair(denoms.BTC, denoms.NUSD)
+	queryServer := keeper.NewQuerier(app.PerpKeeperV2)
+
+	ctx, err, _ := CreateCustomMarket(pairBtc).Do(app, ctx)
+	require.NoError(t, err)
+
+	t.Log("untripped circuit breaker")
+	resp, err := queryServer.QueryCircuitBreaker(sdk.WrapSDKContext(ctx), &v2types.QueryCircuitBreakerRequest{Pair: pairBtc})
+	require.NoError(t, err)
+	require.Equal(t, pairBtc, resp.CircuitBreaker.Pair)
+	require.False(t, resp.CircuitBreaker.Tripped)
+	require.False(t, resp.Stopped)
+	require.False(t, resp.Halted)
+
+	t.Log("tripped circuit breaker")
+	app.PerpKeeperV2.CircuitBreakers.Insert(ctx, pairBtc, v2types.CircuitBreaker{
+		Pair:    pairBtc,
+		Tripped: true,
+		Reason:  v2types.CircuitBreakerReason_ORACLE_PRICE_CHANGE,
+	})
+	resp, err = queryServer.QueryCircuitBreaker(sdk.WrapSDKContext(ctx), &v2types.QueryCircuitBreakerRequest{Pair: pairBtc})
+	require.NoError(t, err)
+	require.True(t, resp.CircuitBreaker.Tripped)
+	require.Equal(t, v2types.CircuitBreakerReason_ORACLE_PRICE_CHANGE, resp.CircuitBreaker.Reason)
+	require.True(t, resp.Halted)
+
+	t.Log("opening a position fails with a typed error")
+	_, err = app.PerpKeeperV2.OpenPosition(
+		ctx, pairBtc, v2types.Direction_LONG, testutil.AccAddress(), sdk.NewInt(100), sdk.OneDec(), sdk.ZeroDec(),
+	)
+	require.ErrorIs(t, err, v2types.ErrCircuitBreakerTripped)
+
+	t.Log("stopped module")
+	require.NoError(t, app.PerpKeeperV2.ResetCircuitBreaker(ctx, pairBtc))
+	params := app.PerpKeeperV2.GetParams(ctx)
+	params.Stopped = true
+	app.PerpKeeperV2.SetParams(ctx, params)
+	resp, err = queryServer.QueryCircuitBreaker(sdk.WrapSDKContext(ctx), &v2types.QueryCircuitBreakerRequest{Pair: pairBtc})
+	require.NoError(t, err)
+	require.False(t, resp.CircuitBreaker.Tripped)
+	require.True(t, resp.Stopped)
+	require.True(t, resp.Halted)
+	_, err = app.PerpKeeperV2.OpenPosition(
+		ctx, pairBtc, v2types.Direction_LONG, testutil.AccAddress(), sdk.NewInt(100), sdk.OneDec(), sdk.ZeroDec(),
+	)
+	require.ErrorIs(t, err, v2types.ErrTradingStopped)
+
+	t.Log("unknown market")
+	_, err = queryServer.QueryCircuitBreaker(sdk.WrapSDKContext(ctx), &v2types.QueryCircuitBreakerRequest{
+		Pair: asset.Registry.Pair(denoms.ATOM, denoms.NUSD),
+	})
+	require.Error(t, err)
+}
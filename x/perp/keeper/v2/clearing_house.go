@@ -54,6 +54,10 @@ func (k Keeper) OpenPosition(
 		return nil, err
 	}
 
+	if k.hasLiquidationAuction(ctx, pair, traderAddr) {
+		return nil, v2types.ErrLiquidationAuctionInProgress.Wrapf("pair: %s", pair)
+	}
+
 	position, err := k.Positions.Get(ctx, collections.Join(pair, traderAddr))
 	isNewPosition := errors.Is(err, collections.ErrNotFound)
 	if isNewPosition {
@@ -65,9 +69,16 @@ func (k Keeper) OpenPosition(
 	sameSideLong := position.Size_.IsPositive() && dir == v2types.Direction_LONG
 	sameSideShort := position.Size_.IsNegative() && dir == v2types.Direction_SHORT
 
+	// while halted, positions can only be reduced or closed
+	haltedErr := k.CheckTradingHalted(ctx, pair)
+
 	var updatedAMM *v2types.AMM
 	var openSideMatchesPosition = sameSideLong || sameSideShort
 	if isNewPosition || openSideMatchesPosition {
+		if haltedErr != nil {
+			return nil, haltedErr
+		}
+
 		updatedAMM, positionResp, err = k.increasePosition(
 			ctx,
 			market,
@@ -81,6 +92,17 @@ func (k Keeper) OpenPosition(
 			return nil, err
 		}
 	} else {
+		if haltedErr != nil {
+			// a reverse position bigger than the current one opens the other side
+			positionNotional, err := PositionNotionalSpot(amm, position)
+			if err != nil {
+				return nil, err
+			}
+			if leverage.MulInt(quoteAssetAmt).GT(positionNotional) {
+				return nil, haltedErr
+			}
+		}
+
 		updatedAMM, positionResp, err = k.openReversePosition(
 			ctx,
 			market,
@@ -95,9 +117,17 @@ func (k Keeper) OpenPosition(
 		}
 	}
 
-	if err = k.afterPositionUpdate(ctx, market, *updatedAMM, traderAddr, *positionResp); err != nil {
+	openInterest := k.OpenInterest(ctx, pair)
+	if err = checkPositionLimits(market, openInterest, position.Size_, *positionResp, leverage); err != nil {
+		return nil, err
+	}
+
+	if positionResp.TransactionFee, err = k.afterPositionUpdate(
+		ctx, market, *updatedAMM, traderAddr, *positionResp,
+	); err != nil {
 		return nil, err
 	}
+	k.OpenInterests.Insert(ctx, pair, openInterest.Update(position.Size_, positionResp.Position.Size_))
 
 	return positionResp, nil
 }
@@ -501,40 +531,52 @@ func checkOpenPositionRequirements(market v2types.Market, quoteAssetAmt sdk.Int,
 	return nil
 }
 
-// afterPositionUpdate is called when a position has been updated.
+// afterPositionUpdate is called when a position has been updated. It returns
+// the fee the trader paid on the position change.
 func (k Keeper) afterPositionUpdate(
 	ctx sdk.Context,
 	market v2types.Market,
 	amm v2types.AMM,
 	traderAddr sdk.AccAddress,
 	positionResp v2types.PositionResp,
-) (err error) {
+) (transferredFee sdk.Int, err error) {
 	// check bad debt
 	if !positionResp.BadDebt.IsZero() {
-		return fmt.Errorf("bad debt must be zero to prevent attacker from leveraging it")
+		return sdk.Int{}, fmt.Errorf("bad debt must be zero to prevent attacker from leveraging it")
 	}
 
 	// check price fluctuation
 	if err := k.checkPriceFluctuationLimitRatio(ctx, market, amm); err != nil {
-		return err
+		return sdk.Int{}, err
 	}
 
 	if !positionResp.Position.Size_.IsZero() {
 		k.Positions.Insert(ctx, collections.Join(market.Pair, traderAddr), *positionResp.Position)
 
-		spotNotional, err := PositionNotionalSpot(amm, *positionResp.Position)
-		if err != nil {
-			return err
-		}
-		twapNotional, err := k.PositionNotionalTWAP(ctx, *positionResp.Position, market.TwapLookbackWindow)
-		if err != nil {
-			return err
-		}
-		positionNotional := sdk.MaxDec(spotNotional, twapNotional)
-
-		marginRatio := MarginRatio(*positionResp.Position, positionNotional, market.LatestCumulativePremiumFraction)
-		if marginRatio.LT(market.MaintenanceMarginRatio) {
-			return v2types.ErrMarginRatioTooLow
+		if k.IsCrossMargin(ctx, traderAddr) {
+			// cross-margined positions are checked as a whole
+			summary, _, err := k.CrossMarginAccount(ctx, traderAddr, market.Pair.QuoteDenom())
+			if err != nil {
+				return sdk.Int{}, err
+			}
+			if !isCrossMarginAccountHealthy(summary) {
+				return sdk.Int{}, v2types.ErrMarginRatioTooLow
+			}
+		} else {
+			spotNotional, err := PositionNotionalSpot(amm, *positionResp.Position)
+			if err != nil {
+				return sdk.Int{}, err
+			}
+			twapNotional, err := k.PositionNotionalTWAP(ctx, *positionResp.Position, market.TwapLookbackWindow)
+			if err != nil {
+				return sdk.Int{}, err
+			}
+			positionNotional := sdk.MaxDec(spotNotional, twapNotional)
+
+			marginRatio := MarginRatio(*positionResp.Position, positionNotional, market.LatestCumulativePremiumFraction)
+			if marginRatio.LT(market.MarginTierFor(positionNotional).MaintenanceMarginRatio) {
+				return sdk.Int{}, v2types.ErrMarginRatioTooLow
+			}
 		}
 	}
 
@@ -545,19 +587,28 @@ func (k Keeper) afterPositionUpdate(
 		coinToSend := sdk.NewCoin(market.Pair.QuoteDenom(), marginToVault)
 		if err = k.BankKeeper.SendCoinsFromAccountToModule(
 			ctx, traderAddr, v2types.VaultModuleAccount, sdk.NewCoins(coinToSend)); err != nil {
-			return err
+			return sdk.Int{}, err
 		}
 	case marginToVault.IsNegative():
 		if err = k.Withdraw(ctx, market, traderAddr, marginToVault.Abs()); err != nil {
-			return err
+			return sdk.Int{}, err
 		}
 	}
 
-	transferredFee, err := k.transferFee(ctx, market.Pair, traderAddr, positionResp.ExchangedNotionalValue)
+	transferredFee, err = k.transferFee(ctx, market.Pair, traderAddr, positionResp.ExchangedNotionalValue)
 	if err != nil {
-		return err
+		return sdk.Int{}, err
 	}
 
+	k.recordTraderHistory(ctx, traderAddr, market.Pair, traderHistoryChange{
+		exchangedSize:     positionResp.ExchangedPositionSize,
+		exchangedNotional: positionResp.ExchangedNotionalValue,
+		realizedPnl:       positionResp.RealizedPnl,
+		fundingPayment:    positionResp.FundingPayment,
+		fees:              transferredFee.ToDec(),
+		liquidationLoss:   sdk.ZeroDec(),
+	})
+
 	// calculate positionNotional (it's different depends on long or short side)
 	// long: unrealizedPnl = positionNotional - openNotional => positionNotional = openNotional + unrealizedPnl
 	// short: unrealizedPnl = openNotional - positionNotional => positionNotional = openNotional - unrealizedPnl
@@ -568,7 +619,7 @@ func (k Keeper) afterPositionUpdate(
 		positionNotional = positionResp.Position.OpenNotional.Sub(positionResp.UnrealizedPnlAfter)
 	}
 
-	return ctx.EventManager().EmitTypedEvent(&v2types.PositionChangedEvent{
+	return transferredFee, ctx.EventManager().EmitTypedEvent(&v2types.PositionChangedEvent{
 		TraderAddress:      traderAddr.String(),
 		Pair:               market.Pair,
 		Margin:             sdk.NewCoin(market.Pair.QuoteDenom(), positionResp.Position.Margin.RoundInt()),
@@ -608,7 +659,33 @@ func (k Keeper) transferFee(
 		return sdk.Int{}, err
 	}
 
-	feeToExchangeFeePool := m.ExchangeFeeRatio.Mul(positionNotional).RoundInt()
+	// the fee discount is based on the volume traded before this trade
+	feeDiscountRatio := k.FeeDiscountRatio(ctx, trader)
+	feeMultiplier := sdk.OneDec().Sub(feeDiscountRatio)
+	k.recordTraderVolume(ctx, trader, positionNotional)
+
+	exchangeFee := m.ExchangeFeeRatio.Mul(feeMultiplier).Mul(positionNotional).RoundInt()
+
+	// a share of the exchange fee is paid into the market's insurance fund
+	feeToInsuranceFund := insuranceFundShare(m, exchangeFee)
+	if feeToInsuranceFund.IsPositive() {
+		if err = k.BankKeeper.SendCoinsFromAccountToModule(
+			ctx,
+			/* from */ trader,
+			/* to */ v2types.InsuranceFundModuleAccount,
+			/* coins */ sdk.NewCoins(
+				sdk.NewCoin(
+					pair.QuoteDenom(),
+					feeToInsuranceFund,
+				),
+			),
+		); err != nil {
+			return sdk.Int{}, err
+		}
+		k.increaseInsuranceFund(ctx, pair, feeToInsuranceFund)
+	}
+
+	feeToExchangeFeePool := exchangeFee.Sub(feeToInsuranceFund)
 	if feeToExchangeFeePool.IsPositive() {
 		if err = k.BankKeeper.SendCoinsFromAccountToModule(
 			ctx,
@@ -623,9 +700,12 @@ func (k Keeper) transferFee(
 		); err != nil {
 			return sdk.Int{}, err
 		}
+		if err = k.payReferralRebate(ctx, pair, trader, feeToExchangeFeePool); err != nil {
+			return sdk.Int{}, err
+		}
 	}
 
-	feeToEcosystemFund := m.EcosystemFundFeeRatio.Mul(positionNotional).RoundInt()
+	feeToEcosystemFund := m.EcosystemFundFeeRatio.Mul(feeMultiplier).Mul(positionNotional).RoundInt()
 	if feeToEcosystemFund.IsPositive() {
 		if err = k.BankKeeper.SendCoinsFromAccountToModule(
 			ctx,
@@ -642,7 +722,7 @@ func (k Keeper) transferFee(
 		}
 	}
 
-	return feeToExchangeFeePool.Add(feeToEcosystemFund), nil
+	return exchangeFee.Add(feeToEcosystemFund), nil
 }
 
 // checks that the mark price of the pool does not violate the fluctuation limit
@@ -695,6 +775,10 @@ func (k Keeper) ClosePosition(ctx sdk.Context, pair asset.Pair, traderAddr sdk.A
 		return nil, err
 	}
 
+	if k.hasLiquidationAuction(ctx, pair, traderAddr) {
+		return nil, v2types.ErrLiquidationAuctionInProgress.Wrapf("pair: %s", pair)
+	}
+
 	market, err := k.Markets.Get(ctx, pair)
 	if err != nil {
 		return nil, v2types.ErrPairNotFound
@@ -705,6 +789,21 @@ func (k Keeper) ClosePosition(ctx sdk.Context, pair asset.Pair, traderAddr sdk.A
 		return nil, err
 	}
 
+	if k.IsCrossMargin(ctx, traderAddr) {
+		// losses beyond the position's own margin are covered by the margin
+		// of the trader's other positions in the same quote denom
+		positionNotional, err := PositionNotionalSpot(amm, position)
+		if err != nil {
+			return nil, err
+		}
+		remainingMargin := position.Margin.
+			Add(UnrealizedPnl(position, positionNotional)).
+			Sub(FundingPayment(position, market.LatestCumulativePremiumFraction))
+		if remainingMargin.IsNegative() {
+			k.coverWithCrossMargin(ctx, traderAddr, &position, remainingMargin.Abs())
+		}
+	}
+
 	updatedAMM, positionResp, err := k.closePositionEntirely(
 		ctx,
 		market,
@@ -720,7 +819,7 @@ func (k Keeper) ClosePosition(ctx sdk.Context, pair asset.Pair, traderAddr sdk.A
 		return nil, fmt.Errorf("underwater position")
 	}
 
-	if err = k.afterPositionUpdate(
+	if positionResp.TransactionFee, err = k.afterPositionUpdate(
 		ctx,
 		market,
 		*updatedAMM,
@@ -729,6 +828,7 @@ func (k Keeper) ClosePosition(ctx sdk.Context, pair asset.Pair, traderAddr sdk.A
 	); err != nil {
 		return nil, err
 	}
+	k.updateOpenInterest(ctx, pair, position.Size_, sdk.ZeroDec())
 
 	return positionResp, nil
 }
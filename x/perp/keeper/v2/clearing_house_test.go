@@ -972,6 +972,7 @@ func TestClosePosition(t *testing.T) {
 				UnrealizedPnlAfter:     sdk.ZeroDec(),
 				MarginToVault:          tc.expectedMarginToVault,
 				PositionNotional:       sdk.ZeroDec(),
+				TransactionFee:         sdk.ZeroInt(),
 			}, *resp)
 
 			testutil.RequireHasTypedEvent(t, ctx, &v2types.PositionChangedEvent{
@@ -0,0 +1,276 @@
+package keeper
+
+import (
+	"github.com/NibiruChain/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/NibiruChain/nibiru/x/common/asset"
+	v2types "github.com/NibiruChain/nibiru/x/perp/types/v2"
+)
+
+// SetMarginMode switches the positions of a trader between isolated and cross
+// margin. In cross margin, the positions of the trader in markets sharing a
+// quote denom are margined, and liquidated, as a single account.
+//
+// Switching back to isolated margin fails if any position would not meet the
+// maintenance margin ratio on its own.
+//
+// args:
+//   - ctx: the cosmos-sdk context
+//   - traderAddr: the trader's address
+//   - crossMargin: whether the trader's positions are cross-margined
+//
+// returns:
+//   - err: error if any
+func (k Keeper) SetMarginMode(ctx sdk.Context, traderAddr sdk.AccAddress, crossMargin bool) (err error) {
+	if crossMargin == k.CrossMarginTraders.Has(ctx, traderAddr) {
+		return nil
+	}
+
+	if crossMargin {
+		// auctioned positions stay isolated until their auction ends
+		for _, market := range k.Markets.Iterate(ctx, collections.Range[asset.Pair]{}).Values() {
+			if k.hasLiquidationAuction(ctx, market.Pair, traderAddr) {
+				return v2types.ErrLiquidationAuctionInProgress.Wrapf("pair: %s", market.Pair)
+			}
+		}
+		k.CrossMarginTraders.Insert(ctx, traderAddr)
+	} else {
+		for _, market := range k.Markets.Iterate(ctx, collections.Range[asset.Pair]{}).Values() {
+			position, err := k.Positions.Get(ctx, collections.Join(market.Pair, traderAddr))
+			if err != nil {
+				continue
+			}
+
+			positionNotional, err := k.positionNotionalMaxSpotTWAP(ctx, market, position)
+			if err != nil {
+				return err
+			}
+
+			marginRatio := MarginRatio(position, positionNotional, market.LatestCumulativePremiumFraction)
+			maintenanceMarginRatio := market.MarginTierFor(positionNotional).MaintenanceMarginRatio
+			if marginRatio.LT(maintenanceMarginRatio) {
+				return v2types.ErrMarginRatioTooLow.Wrapf(
+					"position in %s has margin ratio %s, below %s", market.Pair, marginRatio, maintenanceMarginRatio)
+			}
+		}
+
+		k.CrossMarginTraders.Delete(ctx, traderAddr)
+	}
+
+	return ctx.EventManager().EmitTypedEvent(&v2types.MarginModeChangedEvent{
+		TraderAddress: traderAddr.String(),
+		CrossMargin:   crossMargin,
+		BlockHeight:   ctx.BlockHeight(),
+	})
+}
+
+// IsCrossMargin returns whether the positions of the trader are cross-margined.
+func (k Keeper) IsCrossMargin(ctx sdk.Context, traderAddr sdk.AccAddress) bool {
+	return k.CrossMarginTraders.Has(ctx, traderAddr)
+}
+
+// CrossMarginAccount aggregates the positions of the trader in the markets
+// quoted in quoteDenom. The notional of every position is the larger of its
+// spot and TWAP notional, as for the isolated maintenance margin checks.
+//
+// args:
+//   - ctx: the cosmos-sdk context
+//   - traderAddr: the trader's address
+//   - quoteDenom: the quote denom of the markets to aggregate
+//
+// returns:
+//   - summary: the aggregated state of the positions
+//   - positions: the positions of the account
+//   - err: error if any
+func (k Keeper) CrossMarginAccount(
+	ctx sdk.Context, traderAddr sdk.AccAddress, quoteDenom string,
+) (summary v2types.CrossMarginAccountSummary, positions []v2types.Position, err error) {
+	summary = v2types.CrossMarginAccountSummary{
+		TraderAddress:                traderAddr.String(),
+		QuoteDenom:                   quoteDenom,
+		TotalMargin:                  sdk.ZeroDec(),
+		UnrealizedPnl:                sdk.ZeroDec(),
+		FundingPayment:               sdk.ZeroDec(),
+		TotalNotional:                sdk.ZeroDec(),
+		MaintenanceMarginRequirement: sdk.ZeroDec(),
+	}
+
+	for _, market := range k.Markets.Iterate(ctx, collections.Range[asset.Pair]{}).Values() {
+		if market.Pair.QuoteDenom() != quoteDenom {
+			continue
+		}
+
+		position, err := k.Positions.Get(ctx, collections.Join(market.Pair, traderAddr))
+		if err != nil {
+			continue
+		}
+
+		positionNotional, err := k.positionNotionalMaxSpotTWAP(ctx, market, position)
+		if err != nil {
+			return v2types.CrossMarginAccountSummary{}, nil, err
+		}
+
+		summary.NumPositions++
+		summary.TotalMargin = summary.TotalMargin.Add(position.Margin)
+		summary.UnrealizedPnl = summary.UnrealizedPnl.Add(UnrealizedPnl(position, positionNotional))
+		summary.FundingPayment = summary.FundingPayment.Add(FundingPayment(position, market.LatestCumulativePremiumFraction))
+		summary.TotalNotional = summary.TotalNotional.Add(positionNotional)
+		summary.MaintenanceMarginRequirement = summary.MaintenanceMarginRequirement.Add(
+			positionNotional.Mul(market.MarginTierFor(positionNotional).MaintenanceMarginRatio))
+
+		positions = append(positions, position)
+	}
+
+	netMargin := summary.TotalMargin.Sub(summary.FundingPayment)
+	summary.Equity = netMargin.Add(summary.UnrealizedPnl)
+	summary.FreeCollateral = sdk.MinDec(netMargin, summary.Equity).Sub(summary.MaintenanceMarginRequirement)
+	summary.MarginRatio = sdk.ZeroDec()
+	if summary.TotalNotional.IsPositive() {
+		summary.MarginRatio = summary.Equity.Quo(summary.TotalNotional)
+	}
+
+	return summary, positions, nil
+}
+
+// isCrossMarginAccountHealthy returns whether the equity of a cross-margin
+// account covers its maintenance margin requirement.
+func isCrossMarginAccountHealthy(summary v2types.CrossMarginAccountSummary) bool {
+	return summary.Equity.GTE(summary.MaintenanceMarginRequirement)
+}
+
+// coverWithCrossMargin moves up to amount of margin from the trader's other
+// positions sharing the quote denom of the position into the position. It is
+// used to close a cross-margined position whose own margin does not cover its
+// losses.
+//
+// returns:
+//   - covered: the amount of margin moved into the position
+func (k Keeper) coverWithCrossMargin(
+	ctx sdk.Context, traderAddr sdk.AccAddress, position *v2types.Position, amount sdk.Dec,
+) (covered sdk.Dec) {
+	covered = sdk.ZeroDec()
+	for _, market := range k.Markets.Iterate(ctx, collections.Range[asset.Pair]{}).Values() {
+		if covered.GTE(amount) {
+			break
+		}
+		if market.Pair == position.Pair || market.Pair.QuoteDenom() != position.Pair.QuoteDenom() {
+			continue
+		}
+
+		sibling, err := k.Positions.Get(ctx, collections.Join(market.Pair, traderAddr))
+		if err != nil || !sibling.Margin.IsPositive() {
+			continue
+		}
+
+		moved := sdk.MinDec(sibling.Margin, amount.Sub(covered))
+		sibling.Margin = sibling.Margin.Sub(moved)
+		k.Positions.Insert(ctx, collections.Join(sibling.Pair, traderAddr), sibling)
+
+		covered = covered.Add(moved)
+	}
+
+	position.Margin = position.Margin.Add(covered)
+	k.Positions.Insert(ctx, collections.Join(position.Pair, traderAddr), *position)
+
+	return covered
+}
+
+// positionNotionalMaxSpotTWAP returns the larger of the spot and TWAP notional
+// of the position.
+func (k Keeper) positionNotionalMaxSpotTWAP(
+	ctx sdk.Context, market v2types.Market, position v2types.Position,
+) (sdk.Dec, error) {
+	amm, err := k.AMMs.Get(ctx, market.Pair)
+	if err != nil {
+		return sdk.Dec{}, v2types.ErrPairNotFound
+	}
+
+	spotNotional, err := PositionNotionalSpot(amm, position)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+	twapNotional, err := k.PositionNotionalTWAP(ctx, position, market.TwapLookbackWindow)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+
+	return sdk.MaxDec(spotNotional, twapNotional), nil
+}
+
+// liquidateCrossMarginAccount fully liquidates every position of a
+// cross-margined account once its equity no longer covers its maintenance
+// margin requirement. Losing positions are first topped up with the margin of
+// the other positions so that bad debt is only realized on the account's net
+// shortfall.
+//
+// args:
+//   - ctx: cosmos-sdk context
+//   - liquidator: the liquidator's address
+//   - pair: the pair the liquidation was requested on
+//   - trader: the owner of the account
+//
+// returns:
+//   - liquidatorFee: the sum of the fees given to the liquidator
+//   - ecosystemFundFee: the sum of the fees given to the ecosystem fund
+//   - err: error
+func (k Keeper) liquidateCrossMarginAccount(
+	ctx sdk.Context, liquidator sdk.AccAddress, pair asset.Pair, trader sdk.AccAddress,
+) (liquidatorFee sdk.Coin, ecosystemFundFee sdk.Coin, err error) {
+	summary, positions, err := k.CrossMarginAccount(ctx, trader, pair.QuoteDenom())
+	if err != nil {
+		return sdk.Coin{}, sdk.Coin{}, err
+	}
+
+	if isCrossMarginAccountHealthy(summary) {
+		_ = ctx.EventManager().EmitTypedEvent(&v2types.LiquidationFailedEvent{
+			Pair:       pair,
+			Trader:     trader.String(),
+			Liquidator: liquidator.String(),
+			Reason:     v2types.LiquidationFailedEvent_POSITION_HEALTHY,
+		})
+		return sdk.Coin{}, sdk.Coin{}, v2types.ErrMarginRatioTooHigh.Wrapf(
+			"account equity %s is above maintenance margin requirement %s",
+			summary.Equity, summary.MaintenanceMarginRequirement)
+	}
+
+	liquidatorFee = sdk.NewCoin(pair.QuoteDenom(), sdk.ZeroInt())
+	ecosystemFundFee = sdk.NewCoin(pair.QuoteDenom(), sdk.ZeroInt())
+	for _, position := range positions {
+		// margins may have been moved by a previous iteration
+		position, err = k.Positions.Get(ctx, collections.Join(position.Pair, trader))
+		if err != nil {
+			return sdk.Coin{}, sdk.Coin{}, err
+		}
+
+		market, err := k.Markets.Get(ctx, position.Pair)
+		if err != nil {
+			return sdk.Coin{}, sdk.Coin{}, v2types.ErrPairNotFound
+		}
+		amm, err := k.AMMs.Get(ctx, position.Pair)
+		if err != nil {
+			return sdk.Coin{}, sdk.Coin{}, v2types.ErrPairNotFound
+		}
+
+		positionNotional, err := PositionNotionalSpot(amm, position)
+		if err != nil {
+			return sdk.Coin{}, sdk.Coin{}, err
+		}
+		remainingMargin := position.Margin.
+			Add(UnrealizedPnl(position, positionNotional)).
+			Sub(FundingPayment(position, market.LatestCumulativePremiumFraction))
+		if remainingMargin.IsNegative() {
+			k.coverWithCrossMargin(ctx, trader, &position, remainingMargin.Abs())
+		}
+
+		liquidationResp, err := k.ExecuteFullLiquidation(ctx, liquidator, &position)
+		if err != nil {
+			return sdk.Coin{}, sdk.Coin{}, err
+		}
+
+		liquidatorFee = liquidatorFee.AddAmount(liquidationResp.FeeToLiquidator)
+		ecosystemFundFee = ecosystemFundFee.AddAmount(liquidationResp.FeeToPerpEcosystemFund)
+	}
+
+	return liquidatorFee, ecosystemFundFee, nil
+}
@@ -0,0 +1,158 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/NibiruChain/nibiru/x/common/asset"
+	"github.com/NibiruChain/nibiru/x/common/denoms"
+	"github.com/NibiruChain/nibiru/x/common/testutil"
+	. "github.com/NibiruChain/nibiru/x/common/testutil/action"
+	. "github.com/NibiruChain/nibiru/x/common/testutil/assertion"
+	. "github.com/NibiruChain/nibiru/x/perp/integration/action/v2"
+	. "github.com/NibiruChain/nibiru/x/perp/integration/assertion/v2"
+	v2types "github.com/NibiruChain/nibiru/x/perp/types/v2"
+)
+
+func TestCrossMargin(t *testing.T) {
+	alice := testutil.AccAddress()
+	liquidator := testutil.AccAddress()
+	pairBtcUsdc := asset.Registry.Pair(denoms.BTC, denoms.USDC)
+	pairEthUsdc := asset.Registry.Pair(denoms.ETH, denoms.USDC)
+	startTime := time.Now()
+
+	// a losing BTC position hedged by a winning ETH position, each with about
+	// 10_000 of notional and 500 of unrealized PnL
+	givenHedgedPositions := func(ethMargin int64) []Action {
+		return []Action{
+			SetBlockNumber(1),
+			SetBlockTime(startTime),
+			CreateCustomMarket(pairBtcUsdc),
+			CreateCustomMarket(pairEthUsdc),
+			InsertPosition(
+				WithPair(pairBtcUsdc),
+				WithTrader(alice),
+				WithSize(sdk.NewDec(10000)),
+				WithMargin(sdk.NewDec(200)),
+				WithOpenNotional(sdk.NewDec(10500)),
+			),
+			InsertPosition(
+				WithPair(pairEthUsdc),
+				WithTrader(alice),
+				WithSize(sdk.NewDec(10000)),
+				WithMargin(sdk.NewDec(ethMargin)),
+				WithOpenNotional(sdk.NewDec(9500)),
+			),
+			FundModule(v2types.VaultModuleAccount, sdk.NewCoins(sdk.NewCoin(denoms.USDC, sdk.NewInt(10000)))),
+			FundModule(v2types.PerpEFModuleAccount, sdk.NewCoins(sdk.NewCoin(denoms.USDC, sdk.NewInt(1000)))),
+			FundAccount(alice, sdk.NewCoins(sdk.NewCoin(denoms.USDC, sdk.NewInt(20)))),
+		}
+	}
+
+	tc := TestCases{
+		TC("isolated losing position is liquidated").
+			Given(givenHedgedPositions(1200)...).
+			When(
+				MoveToNextBlock(),
+				Liquidate(liquidator, alice, pairBtcUsdc),
+			).
+			Then(
+				PositionShouldNotExist(alice, pairBtcUsdc),
+				PositionShouldBeEqual(alice, pairEthUsdc, Position_MarginShouldBeEqualTo(sdk.NewDec(1200))),
+			),
+
+		TC("healthy cross-margin account is not liquidated").
+			Given(givenHedgedPositions(1200)...).
+			When(
+				SetMarginMode(alice, true),
+				MoveToNextBlock(),
+				LiquidateExpectingFail(liquidator, alice, pairBtcUsdc),
+			).
+			Then(
+				PositionShouldBeEqual(alice, pairBtcUsdc, Position_MarginShouldBeEqualTo(sdk.NewDec(200))),
+				PositionShouldBeEqual(alice, pairEthUsdc, Position_MarginShouldBeEqualTo(sdk.NewDec(1200))),
+			),
+
+		TC("unhealthy cross-margin account is liquidated as a whole").
+			Given(givenHedgedPositions(600)...).
+			When(
+				SetMarginMode(alice, true),
+				MoveToNextBlock(),
+				Liquidate(liquidator, alice, pairBtcUsdc),
+			).
+			Then(
+				PositionShouldNotExist(alice, pairBtcUsdc),
+				PositionShouldNotExist(alice, pairEthUsdc),
+				MarketShouldBeEqual(pairBtcUsdc, Market_PrepaidBadDebtShouldBeEqualTo(sdk.ZeroInt())),
+			),
+
+		TC("cross-margin removes margin up to the account's free collateral").
+			Given(givenHedgedPositions(1200)...).
+			When(
+				SetMarginMode(alice, true),
+				RemoveMarginExpectingFail(alice, pairBtcUsdc, sdk.NewInt(200)),
+				RemoveMargin(alice, pairBtcUsdc, sdk.NewInt(100)),
+			).
+			Then(
+				PositionShouldBeEqual(alice, pairBtcUsdc, Position_MarginShouldBeEqualTo(sdk.NewDec(100))),
+				BalanceEqual(alice, denoms.USDC, sdk.NewInt(120)),
+			),
+
+		TC("isolated margin cannot remove margin from a losing position").
+			Given(givenHedgedPositions(1200)...).
+			When(
+				RemoveMarginExpectingFail(alice, pairBtcUsdc, sdk.NewInt(100)),
+			).
+			Then(
+				PositionShouldBeEqual(alice, pairBtcUsdc, Position_MarginShouldBeEqualTo(sdk.NewDec(200))),
+			),
+
+		TC("cross-margin close covers losses with the margin of other positions").
+			Given(givenHedgedPositions(1200)...).
+			When(
+				SetMarginMode(alice, true),
+				ClosePosition(alice, pairBtcUsdc),
+			).
+			Then(
+				PositionShouldNotExist(alice, pairBtcUsdc),
+				PositionShouldBeEqual(alice, pairEthUsdc,
+					Position_MarginShouldBeEqualTo(sdk.MustNewDecFromStr("899.999900000001000000")),
+				),
+			),
+
+		TC("switching back to isolated margin requires healthy positions").
+			Given(givenHedgedPositions(1200)...).
+			When(
+				SetMarginMode(alice, true),
+				SetMarginModeExpectingFail(alice, false),
+			).
+			Then(
+				PositionShouldBeEqual(alice, pairBtcUsdc, Position_MarginShouldBeEqualTo(sdk.NewDec(200))),
+			),
+
+		TC("switching back to isolated margin").
+			Given(
+				SetBlockNumber(1),
+				SetBlockTime(startTime),
+				CreateCustomMarket(pairBtcUsdc),
+				InsertPosition(
+					WithPair(pairBtcUsdc),
+					WithTrader(alice),
+					WithSize(sdk.NewDec(10000)),
+					WithMargin(sdk.NewDec(1000)),
+					WithOpenNotional(sdk.NewDec(10000)),
+				),
+			).
+			When(
+				SetMarginMode(alice, true),
+				SetMarginMode(alice, false),
+			).
+			Then(
+				PositionShouldBeEqual(alice, pairBtcUsdc, Position_MarginShouldBeEqualTo(sdk.NewDec(1000))),
+			),
+	}
+
+	NewTestSuite(t).WithTestCases(tc...).Run()
+}
@@ -0,0 +1,159 @@
+package keeper
+
+import (
+	"github.com/NibiruChain/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/NibiruChain/nibiru/x/common/asset"
+	v2types "github.com/NibiruChain/nibiru/x/perp/types/v2"
+)
+
+const secondsPerDay = 24 * 60 * 60
+
+// volumeDay returns the index of the day of the current block, used to bucket
+// the trading volume of traders.
+func volumeDay(ctx sdk.Context) uint64 {
+	unix := ctx.BlockTime().Unix()
+	if unix < 0 {
+		return 0
+	}
+	return uint64(unix / secondsPerDay)
+}
+
+// firstVolumeDay returns the oldest day still inside the fee tier volume window.
+func firstVolumeDay(today uint64) uint64 {
+	if today < v2types.FeeTierVolumeWindowDays-1 {
+		return 0
+	}
+	return today - (v2types.FeeTierVolumeWindowDays - 1)
+}
+
+// TraderVolume returns the notional traded by the trader, across all markets,
+// over the fee tier volume window.
+func (k Keeper) TraderVolume(ctx sdk.Context, trader sdk.AccAddress) sdk.Dec {
+	volume := sdk.ZeroDec()
+	rng := collections.PairRange[sdk.AccAddress, uint64]{}.
+		Prefix(trader).
+		StartInclusive(firstVolumeDay(volumeDay(ctx)))
+	for _, dayVolume := range k.TraderVolumes.Iterate(ctx, rng).Values() {
+		volume = volume.Add(dayVolume)
+	}
+	return volume
+}
+
+// recordTraderVolume adds the notional to the volume of the trader for the
+// current day and prunes the days that fell out of the volume window.
+func (k Keeper) recordTraderVolume(ctx sdk.Context, trader sdk.AccAddress, notional sdk.Dec) {
+	if !notional.IsPositive() {
+		return
+	}
+
+	today := volumeDay(ctx)
+	staleDays := k.TraderVolumes.Iterate(ctx, collections.PairRange[sdk.AccAddress, uint64]{}.
+		Prefix(trader).
+		EndExclusive(firstVolumeDay(today)),
+	).Keys()
+	for _, key := range staleDays {
+		_ = k.TraderVolumes.Delete(ctx, key)
+	}
+
+	key := collections.Join(trader, today)
+	k.TraderVolumes.Insert(ctx, key, k.TraderVolumes.GetOr(ctx, key, sdk.ZeroDec()).Add(notional))
+}
+
+// IsStaker returns true if the trader bonds enough tokens to be eligible to
+// the staker fee discount.
+func (k Keeper) IsStaker(ctx sdk.Context, params v2types.Params, trader sdk.AccAddress) bool {
+	if !params.StakerFeeDiscountRatio.IsPositive() {
+		return false
+	}
+	return k.StakingKeeper.GetDelegatorBonded(ctx, trader).GTE(params.StakerMinBonded)
+}
+
+// FeeDiscountRatio returns the share of the trading fees waived for the trader.
+func (k Keeper) FeeDiscountRatio(ctx sdk.Context, trader sdk.AccAddress) sdk.Dec {
+	params := k.GetParams(ctx)
+	return params.FeeDiscountRatio(k.TraderVolume(ctx, trader), k.IsStaker(ctx, params, trader))
+}
+
+// payReferralRebate rebates a share of the exchange fee paid by the trader to
+// the referrer of the trader, if any.
+func (k Keeper) payReferralRebate(
+	ctx sdk.Context, pair asset.Pair, trader sdk.AccAddress, exchangeFee sdk.Int,
+) error {
+	referral, err := k.Referrals.Get(ctx, trader)
+	if err != nil {
+		return nil
+	}
+	rebateRatio := k.GetParams(ctx).ReferralRebateRatio
+	if !rebateRatio.IsPositive() {
+		return nil
+	}
+	rebateAmount := rebateRatio.MulInt(exchangeFee).TruncateInt()
+	if !rebateAmount.IsPositive() {
+		return nil
+	}
+
+	referralCode, err := k.ReferralCodes.Get(ctx, referral.Code)
+	if err != nil {
+		return err
+	}
+	referrer := sdk.MustAccAddressFromBech32(referralCode.Referrer)
+
+	rebate := sdk.NewCoin(pair.QuoteDenom(), rebateAmount)
+	if err = k.BankKeeper.SendCoinsFromModuleToAccount(
+		ctx,
+		/* from */ v2types.FeePoolModuleAccount,
+		/* to */ referrer,
+		/* coins */ sdk.NewCoins(rebate),
+	); err != nil {
+		return err
+	}
+
+	key := collections.Join(referrer, rebate.Denom)
+	k.ReferralRebates.Insert(ctx, key, k.ReferralRebates.GetOr(ctx, key, sdk.NewCoin(rebate.Denom, sdk.ZeroInt())).Add(rebate))
+
+	return ctx.EventManager().EmitTypedEvent(&v2types.ReferralRebatePaidEvent{
+		Referrer: referrer.String(),
+		Referee:  trader.String(),
+		Pair:     pair,
+		Rebate:   rebate,
+	})
+}
+
+// RegisterReferralCode registers a new referral code owned by the referrer.
+func (k Keeper) RegisterReferralCode(ctx sdk.Context, referrer sdk.AccAddress, code string) error {
+	if err := v2types.ValidateReferralCode(code); err != nil {
+		return err
+	}
+	if _, err := k.ReferralCodes.Get(ctx, code); err == nil {
+		return v2types.ErrReferralCodeTaken.Wrap(code)
+	}
+
+	k.ReferralCodes.Insert(ctx, code, v2types.ReferralCode{
+		Code:     code,
+		Referrer: referrer.String(),
+	})
+	return nil
+}
+
+// BindReferralCode binds the trader to a referral code. A trader can only be
+// bound once, and never to one of its own codes.
+func (k Keeper) BindReferralCode(ctx sdk.Context, trader sdk.AccAddress, code string) error {
+	referralCode, err := k.ReferralCodes.Get(ctx, code)
+	if err != nil {
+		return v2types.ErrReferralCodeNotFound.Wrap(code)
+	}
+	if referralCode.Referrer == trader.String() {
+		return v2types.ErrSelfReferral.Wrap(code)
+	}
+	if referral, err := k.Referrals.Get(ctx, trader); err == nil {
+		return v2types.ErrReferralAlreadyBound.Wrapf("trader %s is bound to %s", trader, referral.Code)
+	}
+
+	k.Referrals.Insert(ctx, trader, v2types.Referral{
+		Referee: trader.String(),
+		Code:    code,
+	})
+	return nil
+}
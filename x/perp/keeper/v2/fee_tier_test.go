@@ -0,0 +1,173 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/NibiruChain/nibiru/x/common/asset"
+	"github.com/NibiruChain/nibiru/x/common/denoms"
+	"github.com/NibiruChain/nibiru/x/common/testutil"
+	. "github.com/NibiruChain/nibiru/x/common/testutil/action"
+	. "github.com/NibiruChain/nibiru/x/common/testutil/assertion"
+	"github.com/NibiruChain/nibiru/x/common/testutil/testapp"
+	. "github.com/NibiruChain/nibiru/x/oracle/integration/action"
+	. "github.com/NibiruChain/nibiru/x/perp/integration/action/v2"
+	"github.com/NibiruChain/nibiru/x/perp/keeper/v2"
+	v2types "github.com/NibiruChain/nibiru/x/perp/types/v2"
+)
+
+func TestFeeTiers(t *testing.T) {
+	alice := testutil.AccAddress()
+	pairBtcUsdc := asset.Registry.Pair(denoms.BTC, denoms.USDC)
+	startTime := time.Now()
+
+	halfOffTier := v2types.FeeTier{
+		MinVolume:        sdk.NewDec(10_000),
+		FeeDiscountRatio: sdk.MustNewDecFromStr("0.5"),
+	}
+
+	tc := TestCases{
+		TC("traders pay the full fee without fee tiers").
+			Given(
+				CreateCustomMarket(pairBtcUsdc),
+				SetBlockNumber(1),
+				SetBlockTime(startTime),
+				SetOraclePrice(pairBtcUsdc, sdk.OneDec()),
+				FundAccount(alice, sdk.NewCoins(sdk.NewCoin(denoms.USDC, sdk.NewInt(10_000)))),
+			).
+			When(
+				OpenPosition(alice, pairBtcUsdc, v2types.Direction_LONG, sdk.NewInt(1000), sdk.NewDec(10), sdk.ZeroDec()),
+				OpenPosition(alice, pairBtcUsdc, v2types.Direction_LONG, sdk.NewInt(1000), sdk.NewDec(10), sdk.ZeroDec()),
+			).
+			Then(
+				BalanceEqual(alice, denoms.USDC, sdk.NewInt(10_000-1020-1020)),
+			),
+
+		TC("fee tiers apply once the trader reaches their volume").
+			Given(
+				CreateCustomMarket(pairBtcUsdc),
+				SetBlockNumber(1),
+				SetBlockTime(startTime),
+				SetOraclePrice(pairBtcUsdc, sdk.OneDec()),
+				SetFeeTiers(halfOffTier),
+				FundAccount(alice, sdk.NewCoins(sdk.NewCoin(denoms.USDC, sdk.NewInt(10_000)))),
+			).
+			When(
+				OpenPosition(alice, pairBtcUsdc, v2types.Direction_LONG, sdk.NewInt(1000), sdk.NewDec(10), sdk.ZeroDec()),
+				OpenPosition(alice, pairBtcUsdc, v2types.Direction_LONG, sdk.NewInt(1000), sdk.NewDec(10), sdk.ZeroDec()),
+			).
+			Then(
+				BalanceEqual(alice, denoms.USDC, sdk.NewInt(10_000-1020-1010)),
+				ModuleBalanceEqual(v2types.FeePoolModuleAccount, denoms.USDC, sdk.NewInt(15)),
+				ModuleBalanceEqual(v2types.PerpEFModuleAccount, denoms.USDC, sdk.NewInt(15)),
+			),
+
+		TC("volume older than the window doesn't count toward fee tiers").
+			Given(
+				CreateCustomMarket(pairBtcUsdc),
+				SetBlockNumber(1),
+				SetBlockTime(startTime),
+				SetOraclePrice(pairBtcUsdc, sdk.OneDec()),
+				SetFeeTiers(halfOffTier),
+				FundAccount(alice, sdk.NewCoins(sdk.NewCoin(denoms.USDC, sdk.NewInt(10_000)))),
+			).
+			When(
+				OpenPosition(alice, pairBtcUsdc, v2types.Direction_LONG, sdk.NewInt(1000), sdk.NewDec(10), sdk.ZeroDec()),
+				SetBlockTime(startTime.Add(31*24*time.Hour)),
+				OpenPosition(alice, pairBtcUsdc, v2types.Direction_LONG, sdk.NewInt(1000), sdk.NewDec(10), sdk.ZeroDec()),
+			).
+			Then(
+				BalanceEqual(alice, denoms.USDC, sdk.NewInt(10_000-1020-1020)),
+			),
+	}
+
+	NewTestSuite(t).WithTestCases(tc...).Run()
+}
+
+func TestReferralRebates(t *testing.T) {
+	app, ctx := testapp.NewNibiruTestAppAndContext(true)
+	pairBtcUsdc := asset.Registry.Pair(denoms.BTC, denoms.USDC)
+	msgServer := keeper.NewMsgServerImpl(app.PerpKeeperV2)
+	queryServer := keeper.NewQuerier(app.PerpKeeperV2)
+	alice := testutil.AccAddress()
+	bob := testutil.AccAddress()
+
+	for _, act := range []Action{
+		CreateCustomMarket(pairBtcUsdc),
+		SetBlockNumber(1),
+		SetOraclePrice(pairBtcUsdc, sdk.OneDec()),
+		SetReferralRebateRatio(sdk.MustNewDecFromStr("0.2")),
+		FundAccount(alice, sdk.NewCoins(sdk.NewCoin(denoms.USDC, sdk.NewInt(10_000)))),
+	} {
+		var err error
+		ctx, err, _ = act.Do(app, ctx)
+		require.NoError(t, err)
+	}
+	goCtx := sdk.WrapSDKContext(ctx)
+
+	t.Log("register referral codes")
+	_, err := msgServer.RegisterReferralCode(goCtx, &v2types.MsgRegisterReferralCode{Sender: bob.String(), Code: "bob"})
+	require.NoError(t, err)
+	_, err = msgServer.RegisterReferralCode(goCtx, &v2types.MsgRegisterReferralCode{Sender: alice.String(), Code: "bob"})
+	require.ErrorIs(t, err, v2types.ErrReferralCodeTaken)
+	_, err = msgServer.RegisterReferralCode(goCtx, &v2types.MsgRegisterReferralCode{Sender: alice.String(), Code: "Alice!"})
+	require.ErrorIs(t, err, v2types.ErrInvalidReferralCode)
+
+	t.Log("bind referral codes")
+	_, err = msgServer.BindReferralCode(goCtx, &v2types.MsgBindReferralCode{Sender: alice.String(), Code: "carol"})
+	require.ErrorIs(t, err, v2types.ErrReferralCodeNotFound)
+	_, err = msgServer.BindReferralCode(goCtx, &v2types.MsgBindReferralCode{Sender: bob.String(), Code: "bob"})
+	require.ErrorIs(t, err, v2types.ErrSelfReferral)
+	_, err = msgServer.BindReferralCode(goCtx, &v2types.MsgBindReferralCode{Sender: alice.String(), Code: "bob"})
+	require.NoError(t, err)
+	_, err = msgServer.BindReferralCode(goCtx, &v2types.MsgBindReferralCode{Sender: alice.String(), Code: "bob"})
+	require.ErrorIs(t, err, v2types.ErrReferralAlreadyBound)
+
+	t.Log("the referrer earns a share of the exchange fee of the referee")
+	_, err = app.PerpKeeperV2.OpenPosition(
+		ctx, pairBtcUsdc, v2types.Direction_LONG, alice, sdk.NewInt(1000), sdk.NewDec(10), sdk.ZeroDec(),
+	)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt(2), app.BankKeeper.GetBalance(ctx, bob, denoms.USDC).Amount)
+	require.Equal(t, sdk.NewInt(8), app.BankKeeper.GetBalance(
+		ctx, app.AccountKeeper.GetModuleAddress(v2types.FeePoolModuleAccount), denoms.USDC).Amount)
+
+	rebatesResp, err := queryServer.QueryReferralRebates(goCtx, &v2types.QueryReferralRebatesRequest{Referrer: bob.String()})
+	require.NoError(t, err)
+	require.Equal(t, []string{"bob"}, rebatesResp.Codes)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(denoms.USDC, 2)), rebatesResp.Rebates)
+
+	t.Log("query the fee tier of the referee")
+	feeTierResp, err := queryServer.QueryFeeTier(goCtx, &v2types.QueryFeeTierRequest{Trader: alice.String()})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDec(10_000), feeTierResp.Volume)
+	require.Nil(t, feeTierResp.FeeTier)
+	require.False(t, feeTierResp.Staker)
+	require.True(t, feeTierResp.FeeDiscountRatio.IsZero())
+	require.Equal(t, "bob", feeTierResp.ReferralCode)
+
+	_, err = queryServer.QueryFeeTier(goCtx, &v2types.QueryFeeTierRequest{Trader: "invalid"})
+	require.Error(t, err)
+}
+
+func TestStakerFeeDiscount(t *testing.T) {
+	app, ctx := testapp.NewNibiruTestAppAndContext(true)
+	alice := testutil.AccAddress()
+
+	params := app.PerpKeeperV2.GetParams(ctx)
+	params.StakerFeeDiscountRatio = sdk.MustNewDecFromStr("0.1")
+	params.StakerMinBonded = sdk.ZeroInt()
+	params.FeeTiers = []v2types.FeeTier{{MinVolume: sdk.ZeroDec(), FeeDiscountRatio: sdk.MustNewDecFromStr("0.5")}}
+	app.PerpKeeperV2.SetParams(ctx, params)
+
+	require.True(t, app.PerpKeeperV2.IsStaker(ctx, params, alice))
+	require.Equal(t, sdk.MustNewDecFromStr("0.55"), app.PerpKeeperV2.FeeDiscountRatio(ctx, alice))
+
+	params.StakerMinBonded = sdk.NewInt(1)
+	app.PerpKeeperV2.SetParams(ctx, params)
+	require.False(t, app.PerpKeeperV2.IsStaker(ctx, params, alice))
+	require.Equal(t, sdk.MustNewDecFromStr("0.5"), app.PerpKeeperV2.FeeDiscountRatio(ctx, alice))
+}
@@ -0,0 +1,49 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	v2types "github.com/NibiruChain/nibiru/x/perp/types/v2"
+)
+
+// computeFundingRate derives the funding rate of a funding interval from the
+// mark and index TWAPs: the premium rate, (mark - index) / index, plus the
+// interest rate of the market, both per funding interval, clamped to the
+// funding rate bounds of the market.
+//
+// args:
+//   - market: the perp market
+//   - markTwap: the mark price TWAP over the funding interval
+//   - indexTwap: the index price TWAP over the funding interval, must be positive
+//   - intervalsPerDay: the number of funding intervals in a day
+//
+// returns:
+//   - fundingRate: the funding rate record, without the cumulative premium
+//     fraction and block info
+func computeFundingRate(
+	market v2types.Market, markTwap sdk.Dec, indexTwap sdk.Dec, intervalsPerDay int64,
+) (fundingRate v2types.FundingRate) {
+	// See https://www.notion.so/nibiru/Funding-Payments-5032d0f8ed164096808354296d43e1fa for an explanation of these terms.
+	premiumFraction := markTwap.Sub(indexTwap).QuoInt64(intervalsPerDay)
+	interestRate := market.InterestRate.QuoInt64(intervalsPerDay)
+	if !interestRate.IsZero() {
+		premiumFraction = premiumFraction.Add(indexTwap.Mul(interestRate))
+	}
+
+	rate := premiumFraction.Quo(indexTwap)
+	clampedRate, clamped := market.ClampFundingRate(rate)
+	if clamped {
+		premiumFraction = clampedRate.Mul(indexTwap)
+	}
+
+	return v2types.FundingRate{
+		Pair:            market.Pair,
+		MarkTwap:        markTwap,
+		IndexTwap:       indexTwap,
+		PremiumRate:     markTwap.Sub(indexTwap).Quo(indexTwap).QuoInt64(intervalsPerDay),
+		InterestRate:    interestRate,
+		FundingRate:     clampedRate,
+		Clamped:         clamped,
+		PremiumFraction: premiumFraction,
+	}
+}
@@ -0,0 +1,746 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/NibiruChain/collections"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/NibiruChain/nibiru/x/common/asset"
+	v2types "github.com/NibiruChain/nibiru/x/perp/types/v2"
+)
+
+type queryServer struct {
+	k Keeper
+}
+
+func NewQuerier(k Keeper) v2types.QueryServer {
+	return queryServer{k: k}
+}
+
+var _ v2types.QueryServer = queryServer{}
+
+func (q queryServer) QueryMarkets(
+	goCtx context.Context, req *v2types.QueryMarketsRequest,
+) (*v2types.QueryMarketsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	return &v2types.QueryMarketsResponse{
+		Markets: q.k.Markets.Iterate(ctx, collections.Range[asset.Pair]{}).Values(),
+		Amms:    q.k.AMMs.Iterate(ctx, collections.Range[asset.Pair]{}).Values(),
+	}, nil
+}
+
+func (q queryServer) QueryPositions(
+	goCtx context.Context, req *v2types.QueryPositionsRequest,
+) (*v2types.QueryPositionsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	traderAddr, err := sdk.AccAddressFromBech32(req.Trader) // just for validation purposes
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	var positions []*v2types.QueryPositionResponse
+	for _, market := range q.k.Markets.Iterate(ctx, collections.Range[asset.Pair]{}).Values() {
+		position, err := q.position(ctx, market.Pair, traderAddr)
+		if err == nil {
+			positions = append(positions, position)
+		}
+	}
+
+	return &v2types.QueryPositionsResponse{
+		Positions: positions,
+	}, nil
+}
+
+func (q queryServer) QueryPosition(
+	goCtx context.Context, req *v2types.QueryPositionRequest,
+) (*v2types.QueryPositionResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	traderAddr, err := sdk.AccAddressFromBech32(req.Trader) // just for validation purposes
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	return q.position(ctx, req.Pair, traderAddr)
+}
+
+func (q queryServer) position(ctx sdk.Context, pair asset.Pair, trader sdk.AccAddress) (*v2types.QueryPositionResponse, error) {
+	position, err := q.k.Positions.Get(ctx, collections.Join(pair, trader))
+	if err != nil {
+		return nil, err
+	}
+
+	market, err := q.k.Markets.Get(ctx, pair)
+	if err != nil {
+		return nil, v2types.ErrPairNotFound
+	}
+
+	amm, err := q.k.AMMs.Get(ctx, pair)
+	if err != nil {
+		return nil, v2types.ErrPairNotFound
+	}
+
+	return q.positionResponse(ctx, market, amm, position)
+}
+
+func (q queryServer) positionResponse(
+	ctx sdk.Context, market v2types.Market, amm v2types.AMM, position v2types.Position,
+) (*v2types.QueryPositionResponse, error) {
+	pair := market.Pair
+	positionNotional, err := PositionNotionalSpot(amm, position)
+	if err != nil {
+		return nil, err
+	}
+	unrealizedPnl := UnrealizedPnl(position, positionNotional)
+
+	twapNotional, err := q.k.PositionNotionalTWAP(ctx, position, market.TwapLookbackWindow)
+	if err != nil {
+		return nil, err
+	}
+	positionNotionalMaxSpotTWAP := sdk.MaxDec(positionNotional, twapNotional)
+	marginRatioMark := MarginRatio(position, positionNotionalMaxSpotTWAP, market.LatestCumulativePremiumFraction)
+
+	marginRatioIndex := sdk.Dec{}
+	indexPrice, err := q.k.OracleKeeper.GetExchangeRateWithMaxAge(ctx, pair)
+	if err != nil {
+		// The index portion of the query fails silently as not to distrupt all
+		// position queries when oracles aren't posting prices.
+		q.k.Logger(ctx).Error(err.Error())
+	} else {
+		indexNotional := position.Size_.Abs().Mul(indexPrice)
+		marginRatioIndex = MarginRatio(position, indexNotional, market.LatestCumulativePremiumFraction)
+	}
+
+	return &v2types.QueryPositionResponse{
+		Position:                    &position,
+		PositionNotional:            positionNotional,
+		UnrealizedPnl:               unrealizedPnl,
+		MarginRatioMark:             marginRatioMark,
+		MarginRatioIndex:            marginRatioIndex,
+		BlockNumber:                 ctx.BlockHeight(),
+		LiquidationPrice:            LiquidationPrice(market, position),
+		PositionNotionalMaxSpotTwap: positionNotionalMaxSpotTWAP,
+	}, nil
+}
+
+func (q queryServer) QueryMarketPositions(
+	goCtx context.Context, req *v2types.QueryMarketPositionsRequest,
+) (*v2types.QueryMarketPositionsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	market, err := q.k.Markets.Get(ctx, req.Pair)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, v2types.ErrPairNotFound.Error())
+	}
+	amm, err := q.k.AMMs.Get(ctx, req.Pair)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, v2types.ErrPairNotFound.Error())
+	}
+
+	positions := []v2types.QueryPositionResponse{}
+	pageRes, err := query.FilteredPaginate(
+		q.k.prefixStore(ctx, positionsNamespace, asset.PairKeyEncoder.Encode(req.Pair)),
+		req.Pagination,
+		func(_ []byte, value []byte, accumulate bool) (bool, error) {
+			var position v2types.Position
+			if err := q.k.cdc.Unmarshal(value, &position); err != nil {
+				return false, err
+			}
+
+			switch req.Direction {
+			case v2types.Direction_LONG:
+				if !position.Size_.IsPositive() {
+					return false, nil
+				}
+			case v2types.Direction_SHORT:
+				if !position.Size_.IsNegative() {
+					return false, nil
+				}
+			}
+
+			resp, err := q.positionResponse(ctx, market, amm, position)
+			if err != nil {
+				return false, err
+			}
+			if !req.MaxMarginRatio.IsNil() && resp.MarginRatioMark.GTE(req.MaxMarginRatio) {
+				return false, nil
+			}
+			if !req.MinNotional.IsNil() && resp.PositionNotional.LT(req.MinNotional) {
+				return false, nil
+			}
+
+			if accumulate {
+				positions = append(positions, *resp)
+			}
+			return true, nil
+		},
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &v2types.QueryMarketPositionsResponse{
+		Positions:  positions,
+		Pagination: pageRes,
+	}, nil
+}
+
+func (q queryServer) Params(
+	goCtx context.Context, req *v2types.QueryParamsRequest,
+) (*v2types.QueryParamsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	return &v2types.QueryParamsResponse{Params: q.k.GetParams(ctx)}, nil
+}
+
+func (q queryServer) ModuleAccounts(
+	ctx context.Context, _ *v2types.QueryModuleAccountsRequest,
+) (*v2types.QueryModuleAccountsResponse, error) {
+	sdkContext := sdk.UnwrapSDKContext(ctx)
+
+	var moduleAccountsWithBalances []v2types.AccountWithBalance
+	for _, acc := range v2types.ModuleAccounts {
+		account := authtypes.NewModuleAddress(acc)
+
+		balances := q.k.BankKeeper.GetAllBalances(sdkContext, account)
+
+		accWithBalance := v2types.AccountWithBalance{
+			Name:    acc,
+			Address: account.String(),
+			Balance: balances,
+		}
+		moduleAccountsWithBalances = append(moduleAccountsWithBalances, accWithBalance)
+	}
+
+	return &v2types.QueryModuleAccountsResponse{Accounts: moduleAccountsWithBalances}, nil
+}
+
+func (q queryServer) QueryTriggerOrders(
+	goCtx context.Context, req *v2types.QueryTriggerOrdersRequest,
+) (*v2types.QueryTriggerOrdersResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	traderAddr, err := sdk.AccAddressFromBech32(req.Trader)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	var pairs []asset.Pair
+	if req.Pair == "" {
+		pairs = q.k.Markets.Iterate(ctx, collections.Range[asset.Pair]{}).Keys()
+	} else {
+		pair, err := asset.TryNewPair(req.Pair)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		pairs = []asset.Pair{pair}
+	}
+
+	orders := []v2types.TriggerOrder{}
+	for _, pair := range pairs {
+		orders = append(orders, q.k.TriggerOrders.Iterate(
+			ctx,
+			collections.PairRange[collections.Pair[asset.Pair, sdk.AccAddress], uint64]{}.
+				Prefix(collections.Join(pair, traderAddr)),
+		).Values()...)
+	}
+
+	return &v2types.QueryTriggerOrdersResponse{TriggerOrders: orders}, nil
+}
+
+func (q queryServer) QueryCrossMarginAccount(
+	goCtx context.Context, req *v2types.QueryCrossMarginAccountRequest,
+) (*v2types.QueryCrossMarginAccountResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	traderAddr, err := sdk.AccAddressFromBech32(req.Trader)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// one account per quote denom the trader has positions in
+	accounts := []v2types.CrossMarginAccountSummary{}
+	seen := map[string]bool{}
+	for _, pair := range q.k.Markets.Iterate(ctx, collections.Range[asset.Pair]{}).Keys() {
+		quoteDenom := pair.QuoteDenom()
+		if seen[quoteDenom] {
+			continue
+		}
+		seen[quoteDenom] = true
+
+		summary, _, err := q.k.CrossMarginAccount(ctx, traderAddr, quoteDenom)
+		if err != nil {
+			return nil, err
+		}
+		if summary.NumPositions > 0 {
+			accounts = append(accounts, summary)
+		}
+	}
+
+	return &v2types.QueryCrossMarginAccountResponse{
+		CrossMargin: q.k.IsCrossMargin(ctx, traderAddr),
+		Accounts:    accounts,
+	}, nil
+}
+
+func (q queryServer) QueryInsuranceFund(
+	goCtx context.Context, req *v2types.QueryInsuranceFundRequest,
+) (*v2types.QueryInsuranceFundResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	fund, openInterestNotional, coverageRatio, err := q.k.InsuranceFundCoverage(sdk.UnwrapSDKContext(goCtx), req.Pair)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	return &v2types.QueryInsuranceFundResponse{
+		InsuranceFund:        fund,
+		OpenInterestNotional: openInterestNotional,
+		CoverageRatio:        coverageRatio,
+	}, nil
+}
+
+func (q queryServer) QueryInsuranceFundDrawDowns(
+	goCtx context.Context, req *v2types.QueryInsuranceFundDrawDownsRequest,
+) (*v2types.QueryInsuranceFundDrawDownsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if _, err := q.k.Markets.Get(ctx, req.Pair); err != nil {
+		return nil, status.Error(codes.NotFound, v2types.ErrPairNotFound.Error())
+	}
+
+	drawDowns := []v2types.InsuranceFundDrawDown{}
+	pageRes, err := query.Paginate(
+		q.k.prefixStore(ctx, insuranceFundDrawDownsNamespace, asset.PairKeyEncoder.Encode(req.Pair)),
+		req.Pagination,
+		func(_ []byte, value []byte) error {
+			var drawDown v2types.InsuranceFundDrawDown
+			if err := q.k.cdc.Unmarshal(value, &drawDown); err != nil {
+				return err
+			}
+			drawDowns = append(drawDowns, drawDown)
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &v2types.QueryInsuranceFundDrawDownsResponse{
+		DrawDowns:  drawDowns,
+		Pagination: pageRes,
+	}, nil
+}
+
+func (q queryServer) QueryFundingRates(
+	goCtx context.Context, req *v2types.QueryFundingRatesRequest,
+) (*v2types.QueryFundingRatesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if _, err := q.k.Markets.Get(ctx, req.Pair); err != nil {
+		return nil, status.Error(codes.NotFound, v2types.ErrPairNotFound.Error())
+	}
+
+	fundingRates := []v2types.FundingRate{}
+	pageRes, err := query.Paginate(
+		q.k.prefixStore(ctx, fundingRatesNamespace, asset.PairKeyEncoder.Encode(req.Pair)),
+		req.Pagination,
+		func(_ []byte, value []byte) error {
+			var fundingRate v2types.FundingRate
+			if err := q.k.cdc.Unmarshal(value, &fundingRate); err != nil {
+				return err
+			}
+			fundingRates = append(fundingRates, fundingRate)
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &v2types.QueryFundingRatesResponse{
+		FundingRates: fundingRates,
+		Pagination:   pageRes,
+	}, nil
+}
+
+func (q queryServer) QueryCircuitBreaker(
+	goCtx context.Context, req *v2types.QueryCircuitBreakerRequest,
+) (*v2types.QueryCircuitBreakerResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if _, err := q.k.Markets.Get(ctx, req.Pair); err != nil {
+		return nil, status.Error(codes.NotFound, v2types.ErrPairNotFound.Error())
+	}
+
+	breaker := q.k.CircuitBreaker(ctx, req.Pair)
+	stopped := q.k.GetParams(ctx).Stopped
+
+	return &v2types.QueryCircuitBreakerResponse{
+		CircuitBreaker: breaker,
+		Stopped:        stopped,
+		Halted:         stopped || breaker.Tripped,
+	}, nil
+}
+
+func (q queryServer) QueryMarginTiers(
+	goCtx context.Context, req *v2types.QueryMarginTiersRequest,
+) (*v2types.QueryMarginTiersResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	market, err := q.k.Markets.Get(ctx, req.Pair)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, v2types.ErrPairNotFound.Error())
+	}
+
+	return &v2types.QueryMarginTiersResponse{
+		MarginTiers: market.MarginTierTable(),
+	}, nil
+}
+
+func (q queryServer) QueryFeeTier(
+	goCtx context.Context, req *v2types.QueryFeeTierRequest,
+) (*v2types.QueryFeeTierResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	traderAddr, err := sdk.AccAddressFromBech32(req.Trader)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	params := q.k.GetParams(ctx)
+	volume := q.k.TraderVolume(ctx, traderAddr)
+	isStaker := q.k.IsStaker(ctx, params, traderAddr)
+
+	resp := &v2types.QueryFeeTierResponse{
+		Volume:           volume,
+		FeeTier:          params.FeeTierFor(volume),
+		Staker:           isStaker,
+		FeeDiscountRatio: params.FeeDiscountRatio(volume, isStaker),
+	}
+	if referral, err := q.k.Referrals.Get(ctx, traderAddr); err == nil {
+		resp.ReferralCode = referral.Code
+	}
+	return resp, nil
+}
+
+func (q queryServer) QueryReferralRebates(
+	goCtx context.Context, req *v2types.QueryReferralRebatesRequest,
+) (*v2types.QueryReferralRebatesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	referrerAddr, err := sdk.AccAddressFromBech32(req.Referrer)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	referralCodes := []string{}
+	for _, referralCode := range q.k.ReferralCodes.Iterate(ctx, collections.Range[string]{}).Values() {
+		if referralCode.Referrer == referrerAddr.String() {
+			referralCodes = append(referralCodes, referralCode.Code)
+		}
+	}
+
+	rebates := sdk.NewCoins()
+	for _, rebate := range q.k.ReferralRebates.Iterate(ctx,
+		collections.PairRange[sdk.AccAddress, string]{}.Prefix(referrerAddr),
+	).Values() {
+		rebates = rebates.Add(rebate)
+	}
+
+	return &v2types.QueryReferralRebatesResponse{
+		Codes:   referralCodes,
+		Rebates: rebates,
+	}, nil
+}
+
+func (q queryServer) QueryTraderHistory(
+	goCtx context.Context, req *v2types.QueryTraderHistoryRequest,
+) (*v2types.QueryTraderHistoryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	traderAddr, err := sdk.AccAddressFromBech32(req.Trader)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	var pnls []v2types.TraderPnl
+	if req.Pair == "" {
+		pnls = q.k.TraderPnls.Iterate(ctx, collections.PairRange[sdk.AccAddress, asset.Pair]{}.Prefix(traderAddr)).Values()
+	} else {
+		if _, err := q.k.Markets.Get(ctx, req.Pair); err != nil {
+			return nil, status.Error(codes.NotFound, v2types.ErrPairNotFound.Error())
+		}
+		pnls = []v2types.TraderPnl{q.k.TraderPnl(ctx, traderAddr, req.Pair)}
+	}
+
+	// the history of the trader, restricted to a market unless the pair is empty
+	historyKeyParts := [][]byte{collections.AccAddressKeyEncoder.Encode(traderAddr)}
+	if req.Pair != "" {
+		historyKeyParts = append(historyKeyParts, asset.PairKeyEncoder.Encode(req.Pair))
+	}
+	history := []v2types.TraderHistoryEntry{}
+	pageRes, err := query.Paginate(
+		q.k.prefixStore(ctx, traderHistoryNamespace, historyKeyParts...),
+		req.Pagination,
+		func(_ []byte, value []byte) error {
+			var entry v2types.TraderHistoryEntry
+			if err := q.k.cdc.Unmarshal(value, &entry); err != nil {
+				return err
+			}
+			history = append(history, entry)
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &v2types.QueryTraderHistoryResponse{
+		Pnls:       pnls,
+		History:    history,
+		Pagination: pageRes,
+	}, nil
+}
+
+func (q queryServer) LiquidationPrice(
+	goCtx context.Context, req *v2types.QueryLiquidationPriceRequest,
+) (*v2types.QueryLiquidationPriceResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	trader, err := sdk.AccAddressFromBech32(req.Trader)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	market, err := q.k.Markets.Get(ctx, req.Pair)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, v2types.ErrPairNotFound.Error())
+	}
+	amm, err := q.k.AMMs.Get(ctx, req.Pair)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, v2types.ErrPairNotFound.Error())
+	}
+	position, err := q.k.Positions.Get(ctx, collections.Join(req.Pair, trader))
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "no position of trader %s in market %s", trader, req.Pair)
+	}
+
+	return &v2types.QueryLiquidationPriceResponse{
+		LiquidationPrice: LiquidationPrice(market, position),
+		MarkPrice:        amm.MarkPrice(),
+	}, nil
+}
+
+// SimulateOpenPosition runs OpenPosition on a cached context that is then
+// discarded, so the trader needs the funds to open the position just like for
+// the real thing.
+func (q queryServer) SimulateOpenPosition(
+	goCtx context.Context, req *v2types.QuerySimulateOpenPositionRequest,
+) (*v2types.QuerySimulateOpenPositionResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	trader, err := sdk.AccAddressFromBech32(req.Trader)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if req.QuoteAssetAmount.IsNil() || req.Leverage.IsNil() {
+		return nil, status.Error(codes.InvalidArgument, "quote asset amount and leverage are required")
+	}
+	baseAmtLimit := req.BaseAssetAmountLimit
+	if baseAmtLimit.IsNil() {
+		baseAmtLimit = sdk.ZeroDec()
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	ammBefore, err := q.k.AMMs.Get(ctx, req.Pair)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, v2types.ErrPairNotFound.Error())
+	}
+
+	simCtx, _ := ctx.CacheContext()
+	simCtx = simCtx.WithEventManager(sdk.NewEventManager())
+	positionResp, err := q.k.OpenPosition(
+		simCtx, req.Pair, req.Direction, trader, req.QuoteAssetAmount, req.Leverage, baseAmtLimit,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	market, err := q.k.Markets.Get(simCtx, req.Pair)
+	if err != nil {
+		return nil, err
+	}
+	ammAfter, err := q.k.AMMs.Get(simCtx, req.Pair)
+	if err != nil {
+		return nil, err
+	}
+
+	var position *v2types.QueryPositionResponse
+	if !positionResp.Position.Size_.IsZero() {
+		if position, err = q.positionResponse(simCtx, market, ammAfter, *positionResp.Position); err != nil {
+			return nil, err
+		}
+	}
+
+	markPriceBefore, markPriceAfter := ammBefore.MarkPrice(), ammAfter.MarkPrice()
+	priceImpact := sdk.ZeroDec()
+	if markPriceBefore.IsPositive() {
+		priceImpact = markPriceAfter.Sub(markPriceBefore).Quo(markPriceBefore)
+	}
+
+	return &v2types.QuerySimulateOpenPositionResponse{
+		Position:               position,
+		ExchangedNotionalValue: positionResp.ExchangedNotionalValue,
+		ExchangedPositionSize:  positionResp.ExchangedPositionSize,
+		RealizedPnl:            positionResp.RealizedPnl,
+		FundingPayment:         positionResp.FundingPayment,
+		MarginToVault:          positionResp.MarginToVault,
+		Fee:                    sdk.NewCoin(req.Pair.QuoteDenom(), positionResp.TransactionFee),
+		MarkPriceBefore:        markPriceBefore,
+		MarkPriceAfter:         markPriceAfter,
+		PriceImpact:            priceImpact,
+	}, nil
+}
+
+// SimulateRemoveMargin runs RemoveMargin on a cached context that is then
+// discarded.
+func (q queryServer) SimulateRemoveMargin(
+	goCtx context.Context, req *v2types.QuerySimulateRemoveMarginRequest,
+) (*v2types.QuerySimulateRemoveMarginResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	trader, err := sdk.AccAddressFromBech32(req.Trader)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err = req.Margin.Validate(); err != nil || !req.Margin.IsPositive() {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid margin: %s", req.Margin)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	simCtx, _ := ctx.CacheContext()
+	simCtx = simCtx.WithEventManager(sdk.NewEventManager())
+	marginOut, fundingPayment, position, err := q.k.RemoveMargin(simCtx, req.Pair, trader, req.Margin)
+	if err != nil {
+		return nil, err
+	}
+
+	market, err := q.k.Markets.Get(simCtx, req.Pair)
+	if err != nil {
+		return nil, err
+	}
+	amm, err := q.k.AMMs.Get(simCtx, req.Pair)
+	if err != nil {
+		return nil, err
+	}
+	positionResp, err := q.positionResponse(simCtx, market, amm, position)
+	if err != nil {
+		return nil, err
+	}
+
+	return &v2types.QuerySimulateRemoveMarginResponse{
+		Position:       positionResp,
+		MarginOut:      marginOut,
+		FundingPayment: fundingPayment,
+	}, nil
+}
+
+func (q queryServer) QueryLiquidationAuctions(
+	goCtx context.Context, req *v2types.QueryLiquidationAuctionsRequest,
+) (*v2types.QueryLiquidationAuctionsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	market, err := q.k.Markets.Get(ctx, req.Pair)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, v2types.ErrPairNotFound.Error())
+	}
+	indexPrice, err := q.k.OracleKeeper.GetExchangeRateWithMaxAge(ctx, req.Pair)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	resp := &v2types.QueryLiquidationAuctionsResponse{
+		Auctions: []v2types.LiquidationAuctionWithPrice{},
+	}
+	auctions := q.k.LiquidationAuctions.Iterate(ctx, collections.PairRange[asset.Pair, sdk.AccAddress]{}.Prefix(req.Pair)).Values()
+	for _, auction := range auctions {
+		position, err := q.k.Positions.Get(ctx, collections.Join(req.Pair, sdk.MustAccAddressFromBech32(auction.TraderAddress)))
+		if err != nil {
+			// the auction of a closed position is removed by the end blocker
+			continue
+		}
+
+		discount := sdk.ZeroDec()
+		if !market.IsAuctionExpired(auction, ctx.BlockHeight()) {
+			discount = market.AuctionDiscount(auction, ctx.BlockHeight())
+		}
+		resp.Auctions = append(resp.Auctions, v2types.LiquidationAuctionWithPrice{
+			Auction:      auction,
+			PositionSize: position.Size_,
+			Discount:     discount,
+			AuctionPrice: v2types.AuctionPrice(indexPrice, discount, position.Size_),
+		})
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,373 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/NibiruChain/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+
+	"github.com/NibiruChain/nibiru/x/common/asset"
+	"github.com/NibiruChain/nibiru/x/common/denoms"
+	"github.com/NibiruChain/nibiru/x/common/testutil"
+	"github.com/NibiruChain/nibiru/x/common/testutil/action"
+	"github.com/NibiruChain/nibiru/x/common/testutil/testapp"
+	. "github.com/NibiruChain/nibiru/x/perp/integration/action/v2"
+	"github.com/NibiruChain/nibiru/x/perp/keeper/v2"
+	v2types "github.com/NibiruChain/nibiru/x/perp/types/v2"
+)
+
+func TestQueryPosition(t *testing.T) {
+	app, ctx := testapp.NewNibiruTestAppAndContext(true)
+	ctx = ctx.WithBlockTime(time.Now()).WithBlockHeight(1)
+	pairBtc := asset.Registry.Pair(denoms.BTC, denoms.NUSD)
+	pairEth := asset.Registry.Pair(denoms.ETH, denoms.NUSD)
+	trader := testutil.AccAddress()
+	queryServer := keeper.NewQuerier(app.PerpKeeperV2)
+
+	for _, act := range []action.Action{
+		CreateCustomMarket(pairBtc),
+		CreateCustomMarket(pairEth),
+		InsertPosition(WithPair(pairBtc), WithTrader(trader), WithSize(sdk.NewDec(10)), WithMargin(sdk.NewDec(1)), WithOpenNotional(sdk.NewDec(10))),
+	} {
+		var err error
+		ctx, err, _ = act.Do(app, ctx)
+		require.NoError(t, err)
+	}
+	app.OracleKeeper.SetPrice(ctx, pairBtc, sdk.NewDec(2))
+
+	t.Log("query single position")
+	resp, err := queryServer.QueryPosition(sdk.WrapSDKContext(ctx), &v2types.QueryPositionRequest{
+		Pair:   pairBtc,
+		Trader: trader.String(),
+	})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDec(10), resp.Position.Size_)
+	require.Equal(t, sdk.MustNewDecFromStr("9.999999999900000000"), resp.PositionNotional)
+	require.Equal(t, sdk.MustNewDecFromStr("-0.000000000100000000"), resp.UnrealizedPnl)
+	require.Equal(t, sdk.MustNewDecFromStr("0.099999999991000000"), resp.MarginRatioMark)
+	require.Equal(t, sdk.MustNewDecFromStr("0.55"), resp.MarginRatioIndex)
+	require.EqualValues(t, 1, resp.BlockNumber)
+
+	t.Log("query missing position")
+	_, err = queryServer.QueryPosition(sdk.WrapSDKContext(ctx), &v2types.QueryPositionRequest{
+		Pair:   pairEth,
+		Trader: trader.String(),
+	})
+	require.Error(t, err)
+
+	t.Log("query all positions of trader")
+	positionsResp, err := queryServer.QueryPositions(sdk.WrapSDKContext(ctx), &v2types.QueryPositionsRequest{
+		Trader: trader.String(),
+	})
+	require.NoError(t, err)
+	require.Len(t, positionsResp.Positions, 1)
+	require.Equal(t, pairBtc, positionsResp.Positions[0].Position.Pair)
+
+	t.Log("an expired index price leaves out the index margin ratio")
+	expiredCtx := ctx.WithBlockTime(ctx.BlockTime().Add(time.Hour))
+	resp, err = queryServer.QueryPosition(sdk.WrapSDKContext(expiredCtx), &v2types.QueryPositionRequest{
+		Pair:   pairBtc,
+		Trader: trader.String(),
+	})
+	require.NoError(t, err)
+	require.True(t, resp.MarginRatioIndex.IsNil())
+}
+
+func TestQueryMarkets(t *testing.T) {
+	app, ctx := testapp.NewNibiruTestAppAndContext(true)
+	pairBtc := asset.Registry.Pair(denoms.BTC, denoms.NUSD)
+	pairEth := asset.Registry.Pair(denoms.ETH, denoms.NUSD)
+	queryServer := keeper.NewQuerier(app.PerpKeeperV2)
+
+	ctx = doActions(t, app, ctx, CreateCustomMarket(pairBtc), CreateCustomMarket(pairEth))
+
+	resp, err := queryServer.QueryMarkets(sdk.WrapSDKContext(ctx), &v2types.QueryMarketsRequest{})
+	require.NoError(t, err)
+	require.Len(t, resp.Markets, 2)
+	require.Len(t, resp.Amms, 2)
+	require.ElementsMatch(t, []asset.Pair{pairBtc, pairEth}, []asset.Pair{resp.Markets[0].Pair, resp.Markets[1].Pair})
+}
+
+func TestQueryModuleAccounts(t *testing.T) {
+	app, ctx := testapp.NewNibiruTestAppAndContext(true)
+	queryServer := keeper.NewQuerier(app.PerpKeeperV2)
+
+	resp, err := queryServer.ModuleAccounts(sdk.WrapSDKContext(ctx), &v2types.QueryModuleAccountsRequest{})
+	require.NoError(t, err)
+	require.Len(t, resp.Accounts, len(v2types.ModuleAccounts))
+	for i, acc := range resp.Accounts {
+		require.Equal(t, v2types.ModuleAccounts[i], acc.Name)
+	}
+}
+
+func TestQueryFundingRates(t *testing.T) {
+	app, ctx := testapp.NewNibiruTestAppAndContext(true)
+	startTime := time.Now()
+	pairBtc := asset.Registry.Pair(denoms.BTC, denoms.NUSD)
+	pairEth := asset.Registry.Pair(denoms.ETH, denoms.NUSD)
+	queryServer := keeper.NewQuerier(app.PerpKeeperV2)
+
+	for _, act := range []action.Action{
+		CreateCustomMarket(pairBtc),
+		CreateCustomMarket(pairEth),
+	} {
+		var err error
+		ctx, err, _ = act.Do(app, ctx)
+		require.NoError(t, err)
+	}
+
+	for i := int64(0); i < 3; i++ {
+		blockTime := startTime.Add(time.Duration(i) * 30 * time.Minute)
+		for _, pair := range []asset.Pair{pairBtc, pairEth} {
+			app.PerpKeeperV2.FundingRates.Insert(ctx, collections.Join(pair, blockTime), v2types.FundingRate{
+				Pair:                      pair,
+				EpochNumber:               uint64(i + 1),
+				MarkTwap:                  sdk.OneDec(),
+				IndexTwap:                 sdk.OneDec(),
+				PremiumRate:               sdk.ZeroDec(),
+				InterestRate:              sdk.ZeroDec(),
+				FundingRate:               sdk.ZeroDec(),
+				PremiumFraction:           sdk.ZeroDec(),
+				CumulativePremiumFraction: sdk.ZeroDec(),
+				BlockHeight:               i + 1,
+				BlockTimeMs:               blockTime.UnixMilli(),
+			})
+		}
+	}
+
+	t.Log("query the first page of the btc funding rates")
+	resp, err := queryServer.QueryFundingRates(sdk.WrapSDKContext(ctx), &v2types.QueryFundingRatesRequest{
+		Pair:       pairBtc,
+		Pagination: &query.PageRequest{Limit: 2, CountTotal: true},
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.FundingRates, 2)
+	require.EqualValues(t, 3, resp.Pagination.Total)
+	require.EqualValues(t, 1, resp.FundingRates[0].EpochNumber)
+	require.EqualValues(t, 2, resp.FundingRates[1].EpochNumber)
+	for _, fundingRate := range resp.FundingRates {
+		require.Equal(t, pairBtc, fundingRate.Pair)
+	}
+
+	t.Log("query the next page")
+	resp, err = queryServer.QueryFundingRates(sdk.WrapSDKContext(ctx), &v2types.QueryFundingRatesRequest{
+		Pair:       pairBtc,
+		Pagination: &query.PageRequest{Key: resp.Pagination.NextKey},
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.FundingRates, 1)
+	require.EqualValues(t, 3, resp.FundingRates[0].EpochNumber)
+
+	t.Log("query latest first")
+	resp, err = queryServer.QueryFundingRates(sdk.WrapSDKContext(ctx), &v2types.QueryFundingRatesRequest{
+		Pair:       pairEth,
+		Pagination: &query.PageRequest{Limit: 1, Reverse: true},
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.FundingRates, 1)
+	require.EqualValues(t, 3, resp.FundingRates[0].EpochNumber)
+
+	t.Log("query missing market")
+	_, err = queryServer.QueryFundingRates(sdk.WrapSDKContext(ctx), &v2types.QueryFundingRatesRequest{
+		Pair: asset.Registry.Pair(denoms.ATOM, denoms.NUSD),
+	})
+	require.Error(t, err)
+}
+
+func TestQueryMarketPositions(t *testing.T) {
+	app, ctx := testapp.NewNibiruTestAppAndContext(true)
+	ctx = ctx.WithBlockTime(time.Now()).WithBlockHeight(1)
+	pairBtc := asset.Registry.Pair(denoms.BTC, denoms.NUSD)
+	alice, bob, carol := testutil.AccAddress(), testutil.AccAddress(), testutil.AccAddress()
+	queryServer := keeper.NewQuerier(app.PerpKeeperV2)
+
+	for _, act := range []action.Action{
+		CreateCustomMarket(pairBtc),
+		InsertPosition(WithPair(pairBtc), WithTrader(alice), WithSize(sdk.NewDec(10)), WithMargin(sdk.NewDec(1)), WithOpenNotional(sdk.NewDec(10))),
+		InsertPosition(WithPair(pairBtc), WithTrader(bob), WithSize(sdk.NewDec(100)), WithMargin(sdk.NewDec(50)), WithOpenNotional(sdk.NewDec(100))),
+		InsertPosition(WithPair(pairBtc), WithTrader(carol), WithSize(sdk.NewDec(-20)), WithMargin(sdk.NewDec(2)), WithOpenNotional(sdk.NewDec(20))),
+	} {
+		var err error
+		ctx, err, _ = act.Do(app, ctx)
+		require.NoError(t, err)
+	}
+	app.OracleKeeper.SetPrice(ctx, pairBtc, sdk.OneDec())
+	goCtx := sdk.WrapSDKContext(ctx)
+
+	traders := func(resp *v2types.QueryMarketPositionsResponse) (traders []string) {
+		for _, position := range resp.Positions {
+			traders = append(traders, position.Position.TraderAddress)
+		}
+		return traders
+	}
+
+	t.Log("every position of the market")
+	resp, err := queryServer.QueryMarketPositions(goCtx, &v2types.QueryMarketPositionsRequest{Pair: pairBtc})
+	require.NoError(t, err)
+	require.ElementsMatch(t, []string{alice.String(), bob.String(), carol.String()}, traders(resp))
+	for _, position := range resp.Positions {
+		market, err := app.PerpKeeperV2.Markets.Get(ctx, pairBtc)
+		require.NoError(t, err)
+		require.Equal(t, keeper.LiquidationPrice(market, *position.Position), position.LiquidationPrice)
+		require.True(t, position.LiquidationPrice.IsPositive())
+	}
+
+	t.Log("filter by side")
+	resp, err = queryServer.QueryMarketPositions(goCtx, &v2types.QueryMarketPositionsRequest{
+		Pair:      pairBtc,
+		Direction: v2types.Direction_SHORT,
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{carol.String()}, traders(resp))
+
+	t.Log("filter by margin ratio")
+	resp, err = queryServer.QueryMarketPositions(goCtx, &v2types.QueryMarketPositionsRequest{
+		Pair:           pairBtc,
+		MaxMarginRatio: sdk.MustNewDecFromStr("0.2"),
+	})
+	require.NoError(t, err)
+	require.ElementsMatch(t, []string{alice.String(), carol.String()}, traders(resp))
+
+	t.Log("filter by notional")
+	resp, err = queryServer.QueryMarketPositions(goCtx, &v2types.QueryMarketPositionsRequest{
+		Pair:        pairBtc,
+		MinNotional: sdk.NewDec(50),
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{bob.String()}, traders(resp))
+
+	t.Log("paginate")
+	resp, err = queryServer.QueryMarketPositions(goCtx, &v2types.QueryMarketPositionsRequest{
+		Pair:       pairBtc,
+		Pagination: &query.PageRequest{Limit: 2},
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.Positions, 2)
+	require.NotNil(t, resp.Pagination.NextKey)
+	firstPage := traders(resp)
+
+	resp, err = queryServer.QueryMarketPositions(goCtx, &v2types.QueryMarketPositionsRequest{
+		Pair:       pairBtc,
+		Pagination: &query.PageRequest{Key: resp.Pagination.NextKey},
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.Positions, 1)
+	require.NotContains(t, firstPage, resp.Positions[0].Position.TraderAddress)
+
+	t.Log("unknown market")
+	_, err = queryServer.QueryMarketPositions(goCtx, &v2types.QueryMarketPositionsRequest{
+		Pair: asset.Registry.Pair(denoms.ETH, denoms.NUSD),
+	})
+	require.Error(t, err)
+}
+
+func TestQuerySimulations(t *testing.T) {
+	app, ctx := testapp.NewNibiruTestAppAndContext(true)
+	pairBtc := asset.Registry.Pair(denoms.BTC, denoms.USDC)
+	alice := testutil.AccAddress()
+	queryServer := keeper.NewQuerier(app.PerpKeeperV2)
+
+	ctx = doActions(t, app, ctx,
+		CreateCustomMarket(pairBtc),
+		action.SetBlockNumber(1),
+		action.SetBlockTime(time.Now()),
+		action.FundAccount(alice, sdk.NewCoins(sdk.NewCoin(denoms.USDC, sdk.NewInt(10_000)))),
+	)
+	app.OracleKeeper.SetPrice(ctx, pairBtc, sdk.OneDec())
+	ctx = doActions(t, app, ctx,
+		OpenPosition(alice, pairBtc, v2types.Direction_LONG, sdk.NewInt(1000), sdk.NewDec(10), sdk.ZeroDec()),
+	)
+	goCtx := sdk.WrapSDKContext(ctx)
+
+	position, err := app.PerpKeeperV2.Positions.Get(ctx, collections.Join(pairBtc, alice))
+	require.NoError(t, err)
+	market, err := app.PerpKeeperV2.Markets.Get(ctx, pairBtc)
+	require.NoError(t, err)
+	amm, err := app.PerpKeeperV2.AMMs.Get(ctx, pairBtc)
+	require.NoError(t, err)
+	balance := app.BankKeeper.GetBalance(ctx, alice, denoms.USDC)
+
+	t.Log("liquidation price")
+	liquidationPriceResp, err := queryServer.LiquidationPrice(goCtx, &v2types.QueryLiquidationPriceRequest{
+		Pair:   pairBtc,
+		Trader: alice.String(),
+	})
+	require.NoError(t, err)
+	require.Equal(t, keeper.LiquidationPrice(market, position), liquidationPriceResp.LiquidationPrice)
+	require.Equal(t, amm.MarkPrice(), liquidationPriceResp.MarkPrice)
+
+	_, err = queryServer.LiquidationPrice(goCtx, &v2types.QueryLiquidationPriceRequest{
+		Pair:   pairBtc,
+		Trader: testutil.AccAddress().String(),
+	})
+	require.Error(t, err)
+
+	t.Log("simulate open position")
+	openResp, err := queryServer.SimulateOpenPosition(goCtx, &v2types.QuerySimulateOpenPositionRequest{
+		Trader:               alice.String(),
+		Pair:                 pairBtc,
+		Direction:            v2types.Direction_LONG,
+		QuoteAssetAmount:     sdk.NewInt(100),
+		Leverage:             sdk.NewDec(10),
+		BaseAssetAmountLimit: sdk.ZeroDec(),
+	})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDec(1000), openResp.ExchangedNotionalValue)
+	require.Equal(t, sdk.NewDec(100), openResp.MarginToVault)
+	require.Equal(t, sdk.NewInt64Coin(denoms.USDC, 2), openResp.Fee)
+	require.True(t, openResp.PriceImpact.IsPositive())
+	require.True(t, openResp.MarkPriceAfter.GT(openResp.MarkPriceBefore))
+	require.Equal(t, position.Size_.Add(openResp.ExchangedPositionSize), openResp.Position.Position.Size_)
+	require.Equal(t, position.Margin.Add(sdk.NewDec(100)), openResp.Position.Position.Margin)
+	require.True(t, openResp.Position.MarginRatioMark.IsPositive())
+
+	t.Log("the simulation matches the real thing")
+	realResp, err := app.PerpKeeperV2.OpenPosition(
+		ctx.WithEventManager(sdk.NewEventManager()).WithMultiStore(ctx.MultiStore().CacheMultiStore()),
+		pairBtc, v2types.Direction_LONG, alice, sdk.NewInt(100), sdk.NewDec(10), sdk.ZeroDec(),
+	)
+	require.NoError(t, err)
+	require.Equal(t, realResp.Position.Size_, openResp.Position.Position.Size_)
+
+	t.Log("simulate closing the position")
+	closeResp, err := queryServer.SimulateOpenPosition(goCtx, &v2types.QuerySimulateOpenPositionRequest{
+		Trader:           alice.String(),
+		Pair:             pairBtc,
+		Direction:        v2types.Direction_SHORT,
+		QuoteAssetAmount: position.OpenNotional.Quo(sdk.NewDec(10)).TruncateInt(),
+		Leverage:         sdk.NewDec(10),
+	})
+	require.NoError(t, err)
+	require.True(t, closeResp.MarkPriceAfter.LT(closeResp.MarkPriceBefore))
+	require.True(t, closeResp.PriceImpact.IsNegative())
+
+	t.Log("simulate remove margin")
+	removeResp, err := queryServer.SimulateRemoveMargin(goCtx, &v2types.QuerySimulateRemoveMarginRequest{
+		Trader: alice.String(),
+		Pair:   pairBtc,
+		Margin: sdk.NewInt64Coin(denoms.USDC, 100),
+	})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt64Coin(denoms.USDC, 100), removeResp.MarginOut)
+	require.Equal(t, position.Margin.Sub(sdk.NewDec(100)), removeResp.Position.Position.Margin)
+	require.True(t, removeResp.Position.MarginRatioMark.LT(sdk.MustNewDecFromStr("0.1")))
+
+	_, err = queryServer.SimulateRemoveMargin(goCtx, &v2types.QuerySimulateRemoveMarginRequest{
+		Trader: alice.String(),
+		Pair:   pairBtc,
+		Margin: sdk.NewInt64Coin(denoms.USDC, 5000),
+	})
+	require.Error(t, err)
+
+	t.Log("simulations don't change the state")
+	positionAfter, err := app.PerpKeeperV2.Positions.Get(ctx, collections.Join(pairBtc, alice))
+	require.NoError(t, err)
+	require.Equal(t, position, positionAfter)
+	ammAfter, err := app.PerpKeeperV2.AMMs.Get(ctx, pairBtc)
+	require.NoError(t, err)
+	require.Equal(t, amm, ammAfter)
+	require.Equal(t, balance, app.BankKeeper.GetBalance(ctx, alice, denoms.USDC))
+}
@@ -16,10 +16,10 @@ import (
 func (k Keeper) BeforeEpochStart(ctx sdk.Context, epochIdentifier string, epochNumber uint64) {
 }
 
-func (k Keeper) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, _ uint64) {
+func (k Keeper) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, epochNumber uint64) {
 	for _, market := range k.Markets.Iterate(ctx, collections.Range[asset.Pair]{}).Values() {
 		if !market.Enabled || epochIdentifier != market.FundingRateEpochId {
-			return
+			continue
 		}
 
 		indexTWAP, err := k.OracleKeeper.GetExchangeRateTwap(ctx, market.Pair)
@@ -44,22 +44,36 @@ func (k Keeper) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, _ uint64)
 
 		epochInfo := k.EpochKeeper.GetEpochInfo(ctx, epochIdentifier)
 		intervalsPerDay := (24 * time.Hour) / epochInfo.Duration
-		// See https://www.notion.so/nibiru/Funding-Payments-5032d0f8ed164096808354296d43e1fa for an explanation of these terms.
-		premiumFraction := markTwap.Sub(indexTWAP).QuoInt64(int64(intervalsPerDay))
+		fundingRate := computeFundingRate(market, markTwap, indexTWAP, int64(intervalsPerDay))
 
-		market.LatestCumulativePremiumFraction = market.LatestCumulativePremiumFraction.Add(premiumFraction)
+		market.LatestCumulativePremiumFraction = market.LatestCumulativePremiumFraction.Add(fundingRate.PremiumFraction)
 		k.Markets.Insert(ctx, market.Pair, market)
 
+		fundingRate.EpochNumber = epochNumber
+		fundingRate.CumulativePremiumFraction = market.LatestCumulativePremiumFraction
+		fundingRate.BlockHeight = ctx.BlockHeight()
+		fundingRate.BlockTimeMs = ctx.BlockTime().UnixMilli()
+		k.FundingRates.Insert(ctx, collections.Join(market.Pair, time.UnixMilli(fundingRate.BlockTimeMs)), fundingRate)
+
 		_ = ctx.EventManager().EmitTypedEvent(&types.FundingRateChangedEvent{
 			Pair:                      market.Pair,
 			MarkPrice:                 markTwap,
 			IndexPrice:                indexTWAP,
-			LatestFundingRate:         premiumFraction.Quo(indexTWAP),
-			LatestPremiumFraction:     premiumFraction,
+			LatestFundingRate:         fundingRate.PremiumFraction.Quo(indexTWAP),
+			LatestPremiumFraction:     fundingRate.PremiumFraction,
 			CumulativePremiumFraction: market.LatestCumulativePremiumFraction,
 			BlockHeight:               ctx.BlockHeight(),
 			BlockTimeMs:               ctx.BlockTime().UnixMilli(),
 		})
+
+		// re-peg and rescale the AMM, all or nothing
+		cacheCtx, writeCache := ctx.CacheContext()
+		if err := k.updateAMM(cacheCtx, market, indexTWAP); err != nil {
+			ctx.Logger().Error("failed to update amm", "market.Pair", market.Pair, "error", err)
+		} else {
+			writeCache()
+			ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+		}
 	}
 }
 
@@ -18,6 +18,7 @@ import (
 
 func TestAfterEpochEnd(t *testing.T) {
 	pairBtcUsdc := asset.Registry.Pair(denoms.BTC, denoms.USDC)
+	pairEthUsdc := asset.Registry.Pair(denoms.ETH, denoms.USDC)
 	startTime := time.Now()
 
 	tc := TestCases{
@@ -57,10 +58,73 @@ func TestAfterEpochEnd(t *testing.T) {
 				StartEpoch(epochtypes.ThirtyMinuteEpochID),
 			).
 			When(
-				MoveToNextBlockWithDuration(30 * time.Minute),
+				MoveToNextBlockWithDuration(30*time.Minute),
+			).
+			Then(
+				MarketShouldBeEqual(pairBtcUsdc, Market_LatestCPFShouldBeEqualTo(sdk.ZeroDec())),
+				LatestFundingRateShouldBeEqual(pairBtcUsdc, sdk.ZeroDec(), false),
+			),
+
+		TC("funding rate above max is clamped").
+			Given(
+				CreateCustomMarket(pairBtcUsdc, WithFundingRateBounds(sdk.MustNewDecFromStr("-0.01"), sdk.MustNewDecFromStr("0.01"))),
+				SetBlockTime(startTime),
+				InsertOraclePriceSnapshot(pairBtcUsdc, startTime.Add(15*time.Minute), sdk.MustNewDecFromStr("0.52")),
+				StartEpoch(epochtypes.ThirtyMinuteEpochID),
+			).
+			When(
+				MoveToNextBlockWithDuration(30*time.Minute),
+			).
+			Then(
+				MarketShouldBeEqual(pairBtcUsdc, Market_LatestCPFShouldBeEqualTo(sdk.MustNewDecFromStr("0.0052"))),
+				LatestFundingRateShouldBeEqual(pairBtcUsdc, sdk.MustNewDecFromStr("0.01"), true),
+			),
+
+		TC("funding rate below min is clamped").
+			Given(
+				CreateCustomMarket(pairBtcUsdc, WithFundingRateBounds(sdk.MustNewDecFromStr("-0.01"), sdk.MustNewDecFromStr("0.01"))),
+				SetBlockTime(startTime),
+				InsertOraclePriceSnapshot(pairBtcUsdc, startTime.Add(15*time.Minute), sdk.MustNewDecFromStr("5.8")),
+				StartEpoch(epochtypes.ThirtyMinuteEpochID),
+			).
+			When(
+				MoveToNextBlockWithDuration(30*time.Minute),
+			).
+			Then(
+				MarketShouldBeEqual(pairBtcUsdc, Market_LatestCPFShouldBeEqualTo(sdk.MustNewDecFromStr("-0.058"))),
+				LatestFundingRateShouldBeEqual(pairBtcUsdc, sdk.MustNewDecFromStr("-0.01"), true),
+			),
+
+		TC("interest rate is added to the premium").
+			Given(
+				CreateCustomMarket(pairBtcUsdc, WithInterestRate(sdk.MustNewDecFromStr("0.48"))),
+				SetBlockTime(startTime),
+				InsertOraclePriceSnapshot(pairBtcUsdc, startTime.Add(15*time.Minute), sdk.OneDec()),
+				StartEpoch(epochtypes.ThirtyMinuteEpochID),
+			).
+			When(
+				MoveToNextBlockWithDuration(30*time.Minute),
+			).
+			Then(
+				MarketShouldBeEqual(pairBtcUsdc, Market_LatestCPFShouldBeEqualTo(sdk.MustNewDecFromStr("0.01"))),
+				LatestFundingRateShouldBeEqual(pairBtcUsdc, sdk.MustNewDecFromStr("0.01"), false),
+			),
+
+		TC("disabled market does not stop funding of the other markets").
+			Given(
+				CreateCustomMarket(pairBtcUsdc, WithEnabled(false)),
+				CreateCustomMarket(pairEthUsdc),
+				SetBlockTime(startTime),
+				InsertOraclePriceSnapshot(pairBtcUsdc, startTime.Add(15*time.Minute), sdk.MustNewDecFromStr("0.52")),
+				InsertOraclePriceSnapshot(pairEthUsdc, startTime.Add(15*time.Minute), sdk.MustNewDecFromStr("0.52")),
+				StartEpoch(epochtypes.ThirtyMinuteEpochID),
+			).
+			When(
+				MoveToNextBlockWithDuration(30*time.Minute),
 			).
 			Then(
 				MarketShouldBeEqual(pairBtcUsdc, Market_LatestCPFShouldBeEqualTo(sdk.ZeroDec())),
+				MarketShouldBeEqual(pairEthUsdc, Market_LatestCPFShouldBeEqualTo(sdk.MustNewDecFromStr("0.01"))),
 			),
 	}
 
@@ -0,0 +1,119 @@
+package keeper
+
+import (
+	"github.com/NibiruChain/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/NibiruChain/nibiru/x/common/asset"
+	v2types "github.com/NibiruChain/nibiru/x/perp/types/v2"
+)
+
+// InsuranceFund returns the insurance fund of the market, which is empty until
+// the first contribution to it.
+func (k Keeper) InsuranceFund(ctx sdk.Context, pair asset.Pair) v2types.InsuranceFund {
+	return k.InsuranceFunds.GetOr(ctx, pair, v2types.ZeroInsuranceFund(pair))
+}
+
+// InsuranceFundCoverage returns the insurance fund of the market along with
+// the notional value of its open interest at the mark price, and the ratio of
+// the two.
+//
+// returns:
+//   - fund: the insurance fund of the market
+//   - openInterestNotional: (long + short open interest) * mark price
+//   - coverageRatio: fund balance / openInterestNotional, zero without open interest
+//   - err: error if any
+func (k Keeper) InsuranceFundCoverage(ctx sdk.Context, pair asset.Pair) (
+	fund v2types.InsuranceFund, openInterestNotional sdk.Dec, coverageRatio sdk.Dec, err error,
+) {
+	amm, err := k.AMMs.Get(ctx, pair)
+	if err != nil {
+		return v2types.InsuranceFund{}, sdk.Dec{}, sdk.Dec{}, v2types.ErrPairNotFound
+	}
+
+	fund = k.InsuranceFund(ctx, pair)
+	openInterest := k.OpenInterest(ctx, pair)
+	openInterestNotional = openInterest.Long.Add(openInterest.Short).Mul(amm.MarkPrice())
+	coverageRatio = sdk.ZeroDec()
+	if openInterestNotional.IsPositive() {
+		coverageRatio = fund.Balance.Amount.ToDec().Quo(openInterestNotional)
+	}
+
+	return fund, openInterestNotional, coverageRatio, nil
+}
+
+// insuranceFundShare returns the part of a fee that goes to the insurance fund
+// of the market.
+func insuranceFundShare(market v2types.Market, fee sdk.Int) sdk.Int {
+	return market.InsuranceFundFeeShare.MulInt(fee).TruncateInt()
+}
+
+// increaseInsuranceFund credits a contribution to the insurance fund of the
+// market. The caller is responsible for sending the funds to the insurance
+// fund module account.
+func (k Keeper) increaseInsuranceFund(ctx sdk.Context, pair asset.Pair, amount sdk.Int) {
+	if !amount.IsPositive() {
+		return
+	}
+
+	fund := k.InsuranceFund(ctx, pair)
+	fund.Balance = fund.Balance.AddAmount(amount)
+	fund.TotalContributions = fund.TotalContributions.AddAmount(amount)
+	k.InsuranceFunds.Insert(ctx, pair, fund)
+}
+
+// drawDownInsuranceFund covers as much as possible of the bad debt of a market
+// with its insurance fund, sending the funds to the vault. Every draw-down is
+// recorded and emits an InsuranceFundDrawDownEvent.
+//
+// args:
+//   - ctx: the cosmos-sdk context
+//   - market: the perp market
+//   - badDebt: the bad debt to cover
+//
+// returns:
+//   - covered: the amount taken from the insurance fund
+//   - err: error if any
+func (k Keeper) drawDownInsuranceFund(
+	ctx sdk.Context, market v2types.Market, badDebt sdk.Int,
+) (covered sdk.Int, err error) {
+	fund := k.InsuranceFund(ctx, market.Pair)
+
+	covered = sdk.MinInt(fund.Balance.Amount, badDebt)
+	if !covered.IsPositive() {
+		return sdk.ZeroInt(), nil
+	}
+
+	coveredCoin := sdk.NewCoin(market.Pair.QuoteDenom(), covered)
+	if err = k.BankKeeper.SendCoinsFromModuleToModule(
+		ctx,
+		/* from */ v2types.InsuranceFundModuleAccount,
+		/* to */ v2types.VaultModuleAccount,
+		sdk.NewCoins(coveredCoin),
+	); err != nil {
+		return sdk.Int{}, err
+	}
+
+	fund.Balance = fund.Balance.Sub(coveredCoin)
+	fund.TotalDrawDowns = fund.TotalDrawDowns.Add(coveredCoin)
+	k.InsuranceFunds.Insert(ctx, market.Pair, fund)
+
+	drawDown := v2types.InsuranceFundDrawDown{
+		Id:          k.InsuranceFundDrawDownID.Next(ctx),
+		Pair:        market.Pair,
+		Amount:      coveredCoin,
+		BadDebt:     sdk.NewCoin(market.Pair.QuoteDenom(), badDebt),
+		BlockHeight: ctx.BlockHeight(),
+		BlockTimeMs: ctx.BlockTime().UnixMilli(),
+	}
+	k.InsuranceFundDrawDowns.Insert(ctx, insuranceFundDrawDownKey(drawDown.Pair, drawDown.Id), drawDown)
+
+	return covered, ctx.EventManager().EmitTypedEvent(&v2types.InsuranceFundDrawDownEvent{
+		DrawDown:    drawDown,
+		FundBalance: fund.Balance,
+	})
+}
+
+func insuranceFundDrawDownKey(pair asset.Pair, id uint64) collections.Pair[asset.Pair, uint64] {
+	return collections.Join(pair, id)
+}
@@ -0,0 +1,200 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/NibiruChain/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/NibiruChain/nibiru/x/common/asset"
+	"github.com/NibiruChain/nibiru/x/common/denoms"
+	"github.com/NibiruChain/nibiru/x/common/testutil"
+	. "github.com/NibiruChain/nibiru/x/common/testutil/action"
+	. "github.com/NibiruChain/nibiru/x/common/testutil/assertion"
+	"github.com/NibiruChain/nibiru/x/common/testutil/testapp"
+	. "github.com/NibiruChain/nibiru/x/oracle/integration/action"
+	. "github.com/NibiruChain/nibiru/x/perp/integration/action/v2"
+	. "github.com/NibiruChain/nibiru/x/perp/integration/assertion/v2"
+	"github.com/NibiruChain/nibiru/x/perp/keeper/v2"
+	v2types "github.com/NibiruChain/nibiru/x/perp/types/v2"
+)
+
+func TestInsuranceFund(t *testing.T) {
+	alice := testutil.AccAddress()
+	liquidator := testutil.AccAddress()
+	pairBtcUsdc := asset.Registry.Pair(denoms.BTC, denoms.USDC)
+	startTime := time.Now()
+
+	tc := TestCases{
+		TC("exchange fee is shared with the insurance fund").
+			Given(
+				CreateCustomMarket(pairBtcUsdc, WithInsuranceFundFeeShare(sdk.MustNewDecFromStr("0.5"))),
+				SetBlockNumber(1),
+				SetBlockTime(startTime),
+				FundAccount(alice, sdk.NewCoins(sdk.NewCoin(denoms.USDC, sdk.NewInt(1020)))),
+			).
+			When(
+				OpenPosition(alice, pairBtcUsdc, v2types.Direction_LONG, sdk.NewInt(1000), sdk.NewDec(10), sdk.ZeroDec()),
+			).
+			Then(
+				BalanceEqual(alice, denoms.USDC, sdk.ZeroInt()),
+				ModuleBalanceEqual(v2types.InsuranceFundModuleAccount, denoms.USDC, sdk.NewInt(5)),
+				ModuleBalanceEqual(v2types.FeePoolModuleAccount, denoms.USDC, sdk.NewInt(5)),
+				ModuleBalanceEqual(v2types.PerpEFModuleAccount, denoms.USDC, sdk.NewInt(10)),
+				InsuranceFundBalanceShouldBeEqual(pairBtcUsdc, sdk.NewInt(5)),
+			),
+
+		TC("liquidation fee is shared with the insurance fund").
+			Given(
+				SetBlockNumber(1),
+				SetBlockTime(startTime),
+				CreateCustomMarket(pairBtcUsdc,
+					WithLiquidationFeeRatio(sdk.MustNewDecFromStr("0.01")),
+					WithInsuranceFundFeeShare(sdk.MustNewDecFromStr("0.5")),
+				),
+				InsertPosition(
+					WithPair(pairBtcUsdc),
+					WithTrader(alice),
+					WithSize(sdk.NewDec(-10000)),
+					WithMargin(sdk.NewDec(100)),
+					WithOpenNotional(sdk.NewDec(10000)),
+				),
+				FundModule(v2types.VaultModuleAccount, sdk.NewCoins(sdk.NewCoin(denoms.USDC, sdk.NewInt(100)))),
+			).
+			When(
+				MoveToNextBlock(),
+				Liquidate(liquidator, alice, pairBtcUsdc),
+			).
+			Then(
+				PositionShouldNotExist(alice, pairBtcUsdc),
+				BalanceEqual(liquidator, denoms.USDC, sdk.NewInt(50)),
+				ModuleBalanceEqual(v2types.VaultModuleAccount, denoms.USDC, sdk.ZeroInt()),
+				ModuleBalanceEqual(v2types.PerpEFModuleAccount, denoms.USDC, sdk.NewInt(25)),
+				ModuleBalanceEqual(v2types.InsuranceFundModuleAccount, denoms.USDC, sdk.NewInt(25)),
+				InsuranceFundBalanceShouldBeEqual(pairBtcUsdc, sdk.NewInt(25)),
+			),
+
+		TC("bad debt is drawn from the insurance fund before the ecosystem fund").
+			Given(
+				SetBlockNumber(1),
+				SetBlockTime(startTime),
+				CreateCustomMarket(pairBtcUsdc),
+				InsertPosition(
+					WithPair(pairBtcUsdc),
+					WithTrader(alice),
+					WithSize(sdk.NewDec(10000)),
+					WithMargin(sdk.NewDec(200)),
+					WithOpenNotional(sdk.NewDec(10000)),
+				),
+				FundModule(v2types.VaultModuleAccount, sdk.NewCoins(sdk.NewCoin(denoms.USDC, sdk.NewInt(200)))),
+				FundModule(v2types.PerpEFModuleAccount, sdk.NewCoins(sdk.NewCoin(denoms.USDC, sdk.NewInt(50)))),
+				SeedInsuranceFund(pairBtcUsdc, sdk.NewInt(30)),
+			).
+			When(
+				MoveToNextBlock(),
+				Liquidate(liquidator, alice, pairBtcUsdc),
+			).
+			Then(
+				PositionShouldNotExist(alice, pairBtcUsdc),
+				BalanceEqual(liquidator, denoms.USDC, sdk.NewInt(250)),
+				ModuleBalanceEqual(v2types.VaultModuleAccount, denoms.USDC, sdk.ZeroInt()),
+				ModuleBalanceEqual(v2types.InsuranceFundModuleAccount, denoms.USDC, sdk.ZeroInt()),
+				ModuleBalanceEqual(v2types.PerpEFModuleAccount, denoms.USDC, sdk.NewInt(30)),
+				InsuranceFundBalanceShouldBeEqual(pairBtcUsdc, sdk.ZeroInt()),
+				InsuranceFundDrawDownShouldBeEqual(pairBtcUsdc, 1, sdk.NewInt(30), sdk.NewInt(50)),
+			),
+
+		TC("bad debt fully covered by the insurance fund").
+			Given(
+				SetBlockNumber(1),
+				SetBlockTime(startTime),
+				CreateCustomMarket(pairBtcUsdc),
+				InsertPosition(
+					WithPair(pairBtcUsdc),
+					WithTrader(alice),
+					WithSize(sdk.NewDec(10000)),
+					WithMargin(sdk.NewDec(200)),
+					WithOpenNotional(sdk.NewDec(10000)),
+				),
+				FundModule(v2types.VaultModuleAccount, sdk.NewCoins(sdk.NewCoin(denoms.USDC, sdk.NewInt(200)))),
+				SeedInsuranceFund(pairBtcUsdc, sdk.NewInt(100)),
+			).
+			When(
+				MoveToNextBlock(),
+				Liquidate(liquidator, alice, pairBtcUsdc),
+			).
+			Then(
+				PositionShouldNotExist(alice, pairBtcUsdc),
+				BalanceEqual(liquidator, denoms.USDC, sdk.NewInt(250)),
+				ModuleBalanceEqual(v2types.VaultModuleAccount, denoms.USDC, sdk.ZeroInt()),
+				ModuleBalanceEqual(v2types.InsuranceFundModuleAccount, denoms.USDC, sdk.NewInt(50)),
+				ModuleBalanceEqual(v2types.PerpEFModuleAccount, denoms.USDC, sdk.ZeroInt()),
+				InsuranceFundBalanceShouldBeEqual(pairBtcUsdc, sdk.NewInt(50)),
+				InsuranceFundDrawDownShouldBeEqual(pairBtcUsdc, 1, sdk.NewInt(50), sdk.NewInt(50)),
+			),
+
+		TC("no draw-down without bad debt").
+			Given(
+				SetBlockNumber(1),
+				SetBlockTime(startTime),
+				CreateCustomMarket(pairBtcUsdc, WithLiquidationFeeRatio(sdk.MustNewDecFromStr("0.01"))),
+				InsertPosition(
+					WithPair(pairBtcUsdc),
+					WithTrader(alice),
+					WithSize(sdk.NewDec(-10000)),
+					WithMargin(sdk.NewDec(100)),
+					WithOpenNotional(sdk.NewDec(10000)),
+				),
+				FundModule(v2types.VaultModuleAccount, sdk.NewCoins(sdk.NewCoin(denoms.USDC, sdk.NewInt(100)))),
+				SeedInsuranceFund(pairBtcUsdc, sdk.NewInt(100)),
+			).
+			When(
+				MoveToNextBlock(),
+				Liquidate(liquidator, alice, pairBtcUsdc),
+			).
+			Then(
+				PositionShouldNotExist(alice, pairBtcUsdc),
+				InsuranceFundBalanceShouldBeEqual(pairBtcUsdc, sdk.NewInt(100)),
+				InsuranceFundDrawDownShouldNotExist(pairBtcUsdc, 1),
+			),
+	}
+
+	NewTestSuite(t).WithTestCases(tc...).Run()
+}
+
+func TestQueryInsuranceFund(t *testing.T) {
+	app, ctx := testapp.NewNibiruTestAppAndContext(true)
+	pairBtcUsdc := asset.Registry.Pair(denoms.BTC, denoms.USDC)
+	queryServer := keeper.NewQuerier(app.PerpKeeperV2)
+	alice := testutil.AccAddress()
+
+	ctx = doActions(t, app, ctx,
+		CreateCustomMarket(pairBtcUsdc),
+		SetBlockNumber(1),
+		SetOraclePrice(pairBtcUsdc, sdk.OneDec()),
+		SeedInsuranceFund(pairBtcUsdc, sdk.NewInt(100)),
+		FundAccount(alice, sdk.NewCoins(sdk.NewCoin(denoms.USDC, sdk.NewInt(10_000)))),
+		OpenPosition(alice, pairBtcUsdc, v2types.Direction_LONG, sdk.NewInt(1_000), sdk.NewDec(10), sdk.ZeroDec()),
+	)
+
+	t.Log("open position is covered")
+	resp, err := queryServer.QueryInsuranceFund(sdk.WrapSDKContext(ctx), &v2types.QueryInsuranceFundRequest{Pair: pairBtcUsdc})
+	require.NoError(t, err)
+	position, err := app.PerpKeeperV2.Positions.Get(ctx, collections.Join(pairBtcUsdc, alice))
+	require.NoError(t, err)
+	amm, err := app.PerpKeeperV2.AMMs.Get(ctx, pairBtcUsdc)
+	require.NoError(t, err)
+	openInterestNotional := position.Size_.Mul(amm.MarkPrice())
+	require.Equal(t, sdk.NewInt(100), resp.InsuranceFund.Balance.Amount)
+	require.Equal(t, openInterestNotional, resp.OpenInterestNotional)
+	require.Equal(t, sdk.NewDec(100).Quo(openInterestNotional), resp.CoverageRatio)
+
+	t.Log("closed position leaves no open interest")
+	ctx = doActions(t, app, ctx, ClosePosition(alice, pairBtcUsdc))
+	resp, err = queryServer.QueryInsuranceFund(sdk.WrapSDKContext(ctx), &v2types.QueryInsuranceFundRequest{Pair: pairBtcUsdc})
+	require.NoError(t, err)
+	require.Equal(t, sdk.ZeroDec(), resp.OpenInterestNotional)
+	require.Equal(t, sdk.ZeroDec(), resp.CoverageRatio)
+}
@@ -0,0 +1,118 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/NibiruChain/collections"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/NibiruChain/nibiru/x/common/asset"
+	v2types "github.com/NibiruChain/nibiru/x/perp/types/v2"
+)
+
+// RegisterInvariants registers all x/perp v2 invariants.
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(v2types.ModuleName, "amm-reserves", AMMReservesInvariant(k))
+	ir.RegisterRoute(v2types.ModuleName, "position-markets", PositionMarketsInvariant(k))
+	ir.RegisterRoute(v2types.ModuleName, "insurance-fund-balance", InsuranceFundBalanceInvariant(k))
+}
+
+// AllInvariants runs all invariants of the x/perp v2 module.
+func AllInvariants(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		res, stop := AMMReservesInvariant(k)(ctx)
+		if stop {
+			return res, stop
+		}
+		res, stop = PositionMarketsInvariant(k)(ctx)
+		if stop {
+			return res, stop
+		}
+		return InsuranceFundBalanceInvariant(k)(ctx)
+	}
+}
+
+// AMMReservesInvariant checks that every market has an AMM and that every AMM
+// has positive reserves and a liquidity depth consistent with them.
+func AMMReservesInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg    string
+			broken bool
+		)
+
+		for _, market := range k.Markets.Iterate(ctx, collections.Range[asset.Pair]{}).Values() {
+			if _, err := k.AMMs.Get(ctx, market.Pair); err != nil {
+				broken = true
+				msg += fmt.Sprintf("\tmarket %s has no amm\n", market.Pair)
+			}
+		}
+
+		for _, amm := range k.AMMs.Iterate(ctx, collections.Range[asset.Pair]{}).Values() {
+			if _, err := k.Markets.Get(ctx, amm.Pair); err != nil {
+				broken = true
+				msg += fmt.Sprintf("\tamm %s has no market\n", amm.Pair)
+			}
+			if err := amm.ValidateReserves(); err != nil {
+				broken = true
+				msg += fmt.Sprintf("\t%s\n", err)
+			}
+			if err := amm.ValidateLiquidityDepth(); err != nil {
+				broken = true
+				msg += fmt.Sprintf("\t%s\n", err)
+			}
+		}
+
+		return sdk.FormatInvariant(v2types.ModuleName, "amm-reserves", msg), broken
+	}
+}
+
+// PositionMarketsInvariant checks that every position belongs to an existing
+// market and is not a zero position.
+func PositionMarketsInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg    string
+			broken bool
+		)
+
+		for _, pos := range k.Positions.Iterate(ctx, collections.PairRange[asset.Pair, sdk.AccAddress]{}).Values() {
+			if _, err := k.Markets.Get(ctx, pos.Pair); err != nil {
+				broken = true
+				msg += fmt.Sprintf("\tposition of %s has no market %s\n", pos.TraderAddress, pos.Pair)
+			}
+			if pos.Size_.IsZero() {
+				broken = true
+				msg += fmt.Sprintf("\tposition of %s in %s has zero size\n", pos.TraderAddress, pos.Pair)
+			}
+		}
+
+		return sdk.FormatInvariant(v2types.ModuleName, "position-markets", msg), broken
+	}
+}
+
+// InsuranceFundBalanceInvariant checks that the insurance fund module account
+// holds at least the sum of the balances of the insurance funds of every
+// market.
+func InsuranceFundBalanceInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg    string
+			broken bool
+		)
+
+		expected := sdk.NewCoins()
+		for _, fund := range k.InsuranceFunds.Iterate(ctx, collections.Range[asset.Pair]{}).Values() {
+			expected = expected.Add(fund.Balance)
+		}
+
+		balances := k.BankKeeper.GetAllBalances(ctx, k.AccountKeeper.GetModuleAddress(v2types.InsuranceFundModuleAccount))
+		if !balances.IsAllGTE(expected) {
+			broken = true
+			msg += fmt.Sprintf("\tinsurance fund module balance %s is lower than the funds %s\n", balances, expected)
+		}
+
+		return sdk.FormatInvariant(v2types.ModuleName, "insurance-fund-balance", msg), broken
+	}
+}
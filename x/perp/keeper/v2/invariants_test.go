@@ -0,0 +1,60 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/NibiruChain/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/NibiruChain/nibiru/x/common/asset"
+	"github.com/NibiruChain/nibiru/x/common/denoms"
+	"github.com/NibiruChain/nibiru/x/common/testutil"
+	"github.com/NibiruChain/nibiru/x/common/testutil/mock"
+	"github.com/NibiruChain/nibiru/x/common/testutil/testapp"
+	"github.com/NibiruChain/nibiru/x/perp/keeper/v2"
+	v2types "github.com/NibiruChain/nibiru/x/perp/types/v2"
+)
+
+func TestInvariants(t *testing.T) {
+	app, ctx := testapp.NewNibiruTestAppAndContext(true)
+	pair := asset.Registry.Pair(denoms.BTC, denoms.NUSD)
+
+	_, broken := keeper.AllInvariants(app.PerpKeeperV2)(ctx)
+	require.False(t, broken)
+
+	t.Log("amm without market breaks invariant")
+	app.PerpKeeperV2.AMMs.Insert(ctx, pair, *mock.TestAMMDefault())
+	_, broken = keeper.AMMReservesInvariant(app.PerpKeeperV2)(ctx)
+	require.True(t, broken)
+
+	app.PerpKeeperV2.Markets.Insert(ctx, pair, v2types.Market{Pair: pair})
+	_, broken = keeper.AllInvariants(app.PerpKeeperV2)(ctx)
+	require.False(t, broken)
+
+	t.Log("position without market breaks invariant")
+	trader := testutil.AccAddress()
+	otherPair := asset.Registry.Pair(denoms.ETH, denoms.NUSD)
+	app.PerpKeeperV2.Positions.Insert(ctx, collections.Join(otherPair, trader), v2types.Position{
+		TraderAddress: trader.String(),
+		Pair:          otherPair,
+		Size_:         sdk.OneDec(),
+	})
+	_, broken = keeper.PositionMarketsInvariant(app.PerpKeeperV2)(ctx)
+	require.True(t, broken)
+
+	app.PerpKeeperV2.Positions.Delete(ctx, collections.Join(otherPair, trader))
+
+	t.Log("insurance fund without module balance breaks invariant")
+	fund := v2types.ZeroInsuranceFund(pair)
+	fund.Balance = sdk.NewInt64Coin(denoms.NUSD, 100)
+	fund.TotalContributions = sdk.NewInt64Coin(denoms.NUSD, 100)
+	app.PerpKeeperV2.InsuranceFunds.Insert(ctx, pair, fund)
+	_, broken = keeper.InsuranceFundBalanceInvariant(app.PerpKeeperV2)(ctx)
+	require.True(t, broken)
+
+	require.NoError(t, testapp.FundModuleAccount(
+		app.BankKeeper, ctx, v2types.InsuranceFundModuleAccount, sdk.NewCoins(fund.Balance)))
+	_, broken = keeper.AllInvariants(app.PerpKeeperV2)(ctx)
+	require.False(t, broken)
+}
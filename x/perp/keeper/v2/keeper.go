@@ -7,6 +7,7 @@ import (
 	"github.com/NibiruChain/collections"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	"github.com/tendermint/tendermint/libs/log"
@@ -25,13 +26,54 @@ type Keeper struct {
 	AccountKeeper types.AccountKeeper
 	OracleKeeper  types.OracleKeeper
 	EpochKeeper   types.EpochKeeper
+	StakingKeeper types.StakingKeeper
 
-	Markets          collections.Map[asset.Pair, v2types.Market]
-	AMMs             collections.Map[asset.Pair, v2types.AMM]
-	Positions        collections.Map[collections.Pair[asset.Pair, sdk.AccAddress], v2types.Position]
-	ReserveSnapshots collections.Map[collections.Pair[asset.Pair, time.Time], v2types.ReserveSnapshot]
+	Markets            collections.Map[asset.Pair, v2types.Market]
+	AMMs               collections.Map[asset.Pair, v2types.AMM]
+	Positions          collections.Map[collections.Pair[asset.Pair, sdk.AccAddress], v2types.Position]
+	ReserveSnapshots   collections.Map[collections.Pair[asset.Pair, time.Time], v2types.ReserveSnapshot]
+	TriggerOrders      collections.Map[collections.Pair[collections.Pair[asset.Pair, sdk.AccAddress], uint64], v2types.TriggerOrder]
+	TriggerOrderID     collections.Sequence
+	TriggerOrderCursor collections.Item[v2types.TriggerOrder]
+	CrossMarginTraders collections.KeySet[sdk.AccAddress]
+
+	InsuranceFunds          collections.Map[asset.Pair, v2types.InsuranceFund]
+	InsuranceFundDrawDowns  collections.Map[collections.Pair[asset.Pair, uint64], v2types.InsuranceFundDrawDown]
+	InsuranceFundDrawDownID collections.Sequence
+
+	FundingRates collections.Map[collections.Pair[asset.Pair, time.Time], v2types.FundingRate]
+
+	CircuitBreakers collections.Map[asset.Pair, v2types.CircuitBreaker]
+	// monotonic queues of the oracle prices of the circuit breaker window,
+	// keyed by block height, whose first price is the min (max) of the window
+	CircuitBreakerMinPrices collections.Map[collections.Pair[asset.Pair, uint64], sdk.Dec]
+	CircuitBreakerMaxPrices collections.Map[collections.Pair[asset.Pair, uint64], sdk.Dec]
+
+	OpenInterests collections.Map[asset.Pair, v2types.OpenInterest]
+
+	TraderVolumes   collections.Map[collections.Pair[sdk.AccAddress, uint64], sdk.Dec]
+	ReferralCodes   collections.Map[string, v2types.ReferralCode]
+	Referrals       collections.Map[sdk.AccAddress, v2types.Referral]
+	ReferralRebates collections.Map[collections.Pair[sdk.AccAddress, string], sdk.Coin]
+
+	TraderPnls      collections.Map[collections.Pair[sdk.AccAddress, asset.Pair], v2types.TraderPnl]
+	TraderHistory   collections.Map[collections.Pair[collections.Pair[sdk.AccAddress, asset.Pair], uint64], v2types.TraderHistoryEntry]
+	TraderHistoryID collections.Sequence
+	// the last trader history entry checked by PruneTraderHistory
+	TraderHistoryCursor collections.Item[v2types.TraderHistoryEntry]
+
+	LiquidationAuctions collections.Map[collections.Pair[asset.Pair, sdk.AccAddress], v2types.LiquidationAuction]
 }
 
+// Namespaces of the collections whose entries are also paginated through
+// prefixStore.
+const (
+	positionsNamespace              collections.Namespace = 2
+	insuranceFundDrawDownsNamespace collections.Namespace = 8
+	fundingRatesNamespace           collections.Namespace = 10
+	traderHistoryNamespace          collections.Namespace = 19
+)
+
 // NewKeeper Creates a new x/perp Keeper instance.
 func NewKeeper(
 	cdc codec.BinaryCodec,
@@ -42,6 +84,7 @@ func NewKeeper(
 	bankKeeper types.BankKeeper,
 	oracleKeeper types.OracleKeeper,
 	epochKeeper types.EpochKeeper,
+	stakingKeeper types.StakingKeeper,
 ) Keeper {
 	// Ensure that the module account is set.
 	if moduleAcc := accountKeeper.GetModuleAddress(types.ModuleName); moduleAcc == nil {
@@ -50,7 +93,7 @@ func NewKeeper(
 
 	// Set param.types.'KeyTable' if it has not already been set
 	if !paramSubspace.HasKeyTable() {
-		paramSubspace = paramSubspace.WithKeyTable(types.ParamKeyTable())
+		paramSubspace = paramSubspace.WithKeyTable(v2types.ParamKeyTable())
 	}
 
 	return Keeper{
@@ -61,6 +104,7 @@ func NewKeeper(
 		AccountKeeper: accountKeeper,
 		OracleKeeper:  oracleKeeper,
 		EpochKeeper:   epochKeeper,
+		StakingKeeper: stakingKeeper,
 		Markets: collections.NewMap(
 			storeKey, 0,
 			asset.PairKeyEncoder,
@@ -72,7 +116,7 @@ func NewKeeper(
 			collections.ProtoValueEncoder[v2types.AMM](cdc),
 		),
 		Positions: collections.NewMap(
-			storeKey, 2,
+			storeKey, positionsNamespace,
 			collections.PairKeyEncoder(asset.PairKeyEncoder, collections.AccAddressKeyEncoder),
 			collections.ProtoValueEncoder[v2types.Position](cdc),
 		),
@@ -81,7 +125,107 @@ func NewKeeper(
 			collections.PairKeyEncoder(asset.PairKeyEncoder, collections.TimeKeyEncoder),
 			collections.ProtoValueEncoder[v2types.ReserveSnapshot](cdc),
 		),
+		TriggerOrders: collections.NewMap(
+			storeKey, 4,
+			collections.PairKeyEncoder(
+				collections.PairKeyEncoder(asset.PairKeyEncoder, collections.AccAddressKeyEncoder),
+				collections.Uint64KeyEncoder,
+			),
+			collections.ProtoValueEncoder[v2types.TriggerOrder](cdc),
+		),
+		TriggerOrderID:     collections.NewSequence(storeKey, 5),
+		CrossMarginTraders: collections.NewKeySet(storeKey, 6, collections.AccAddressKeyEncoder),
+		InsuranceFunds: collections.NewMap(
+			storeKey, 7,
+			asset.PairKeyEncoder,
+			collections.ProtoValueEncoder[v2types.InsuranceFund](cdc),
+		),
+		InsuranceFundDrawDowns: collections.NewMap(
+			storeKey, insuranceFundDrawDownsNamespace,
+			collections.PairKeyEncoder(asset.PairKeyEncoder, collections.Uint64KeyEncoder),
+			collections.ProtoValueEncoder[v2types.InsuranceFundDrawDown](cdc),
+		),
+		InsuranceFundDrawDownID: collections.NewSequence(storeKey, 9),
+		FundingRates: collections.NewMap(
+			storeKey, fundingRatesNamespace,
+			collections.PairKeyEncoder(asset.PairKeyEncoder, collections.TimeKeyEncoder),
+			collections.ProtoValueEncoder[v2types.FundingRate](cdc),
+		),
+		CircuitBreakers: collections.NewMap(
+			storeKey, 11,
+			asset.PairKeyEncoder,
+			collections.ProtoValueEncoder[v2types.CircuitBreaker](cdc),
+		),
+		CircuitBreakerMinPrices: collections.NewMap(
+			storeKey, 12,
+			collections.PairKeyEncoder(asset.PairKeyEncoder, collections.Uint64KeyEncoder),
+			collections.DecValueEncoder,
+		),
+		CircuitBreakerMaxPrices: collections.NewMap(
+			storeKey, 23,
+			collections.PairKeyEncoder(asset.PairKeyEncoder, collections.Uint64KeyEncoder),
+			collections.DecValueEncoder,
+		),
+		OpenInterests: collections.NewMap(
+			storeKey, 13,
+			asset.PairKeyEncoder,
+			collections.ProtoValueEncoder[v2types.OpenInterest](cdc),
+		),
+		TraderVolumes: collections.NewMap(
+			storeKey, 14,
+			collections.PairKeyEncoder(collections.AccAddressKeyEncoder, collections.Uint64KeyEncoder),
+			collections.DecValueEncoder,
+		),
+		ReferralCodes: collections.NewMap(
+			storeKey, 15,
+			collections.StringKeyEncoder,
+			collections.ProtoValueEncoder[v2types.ReferralCode](cdc),
+		),
+		Referrals: collections.NewMap(
+			storeKey, 16,
+			collections.AccAddressKeyEncoder,
+			collections.ProtoValueEncoder[v2types.Referral](cdc),
+		),
+		ReferralRebates: collections.NewMap(
+			storeKey, 17,
+			collections.PairKeyEncoder(collections.AccAddressKeyEncoder, collections.StringKeyEncoder),
+			collections.ProtoValueEncoder[sdk.Coin](cdc),
+		),
+		TraderPnls: collections.NewMap(
+			storeKey, 18,
+			collections.PairKeyEncoder(collections.AccAddressKeyEncoder, asset.PairKeyEncoder),
+			collections.ProtoValueEncoder[v2types.TraderPnl](cdc),
+		),
+		TraderHistory: collections.NewMap(
+			storeKey, traderHistoryNamespace,
+			collections.PairKeyEncoder(
+				collections.PairKeyEncoder(collections.AccAddressKeyEncoder, asset.PairKeyEncoder),
+				collections.Uint64KeyEncoder,
+			),
+			collections.ProtoValueEncoder[v2types.TraderHistoryEntry](cdc),
+		),
+		TraderHistoryID: collections.NewSequence(storeKey, 20),
+		LiquidationAuctions: collections.NewMap(
+			storeKey, 21,
+			collections.PairKeyEncoder(asset.PairKeyEncoder, collections.AccAddressKeyEncoder),
+			collections.ProtoValueEncoder[v2types.LiquidationAuction](cdc),
+		),
+		TriggerOrderCursor: collections.NewItem(storeKey, 22, collections.ProtoValueEncoder[v2types.TriggerOrder](cdc)),
+		TraderHistoryCursor: collections.NewItem(
+			storeKey, 24,
+			collections.ProtoValueEncoder[v2types.TraderHistoryEntry](cdc),
+		),
+	}
+}
+
+// prefixStore returns the store of the entries of the collection whose keys
+// start with the given encoded key parts.
+func (k Keeper) prefixStore(ctx sdk.Context, namespace collections.Namespace, keyParts ...[]byte) prefix.Store {
+	storePrefix := namespace.Prefix()
+	for _, keyPart := range keyParts {
+		storePrefix = append(storePrefix, keyPart...)
 	}
+	return prefix.NewStore(ctx.KVStore(k.storeKey), storePrefix)
 }
 
 func (k Keeper) Logger(ctx sdk.Context) log.Logger {
@@ -90,7 +234,9 @@ func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 
 // GetParams get all parameters as v2types.Params
 func (k Keeper) GetParams(ctx sdk.Context) (params v2types.Params) {
-	k.ParamSubspace.GetParamSet(ctx, &params)
+	// params missing from the store, e.g. added by an upgrade, keep their defaults
+	params = v2types.DefaultParams()
+	k.ParamSubspace.GetParamSetIfExists(ctx, &params)
 	return params
 }
 
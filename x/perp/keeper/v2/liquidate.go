@@ -0,0 +1,480 @@
+package keeper
+
+import (
+	"github.com/NibiruChain/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/NibiruChain/nibiru/x/common/asset"
+	v2types "github.com/NibiruChain/nibiru/x/perp/types/v2"
+)
+
+// Liquidate allows to liquidate the trader position if the margin is below the
+// required margin maintenance ratio. Cross-margined positions are liquidated
+// together with the rest of their account, see liquidateCrossMarginAccount.
+// In markets in AUCTION liquidation mode, the position is put up for auction
+// instead, see BidLiquidationAuction, and only liquidated into the AMM once
+// its auction expired.
+//
+// args:
+//   - liquidator: the liquidator who is executing the liquidation
+//   - pair: the asset pair
+//   - trader: the trader who owns the position being liquidated
+//
+// returns:
+//   - liquidatorFee: the amount of coins given to the liquidator
+//   - ecosystemFundFee: the amount of coins given to the ecosystem fund
+//   - err: error
+func (k Keeper) Liquidate(
+	ctx sdk.Context,
+	liquidator sdk.AccAddress,
+	pair asset.Pair,
+	trader sdk.AccAddress,
+) (liquidatorFee sdk.Coin, ecosystemFundFee sdk.Coin, err error) {
+	market, err := k.Markets.Get(ctx, pair)
+	if err != nil {
+		_ = ctx.EventManager().EmitTypedEvent(&v2types.LiquidationFailedEvent{
+			Pair:       pair,
+			Trader:     trader.String(),
+			Liquidator: liquidator.String(),
+			Reason:     v2types.LiquidationFailedEvent_NONEXISTENT_PAIR,
+		})
+		return sdk.Coin{}, sdk.Coin{}, v2types.ErrPairNotFound
+	}
+
+	amm, err := k.AMMs.Get(ctx, pair)
+	if err != nil {
+		return sdk.Coin{}, sdk.Coin{}, v2types.ErrPairNotFound
+	}
+
+	position, err := k.Positions.Get(ctx, collections.Join(pair, trader))
+	if err != nil {
+		_ = ctx.EventManager().EmitTypedEvent(&v2types.LiquidationFailedEvent{
+			Pair:       pair,
+			Trader:     trader.String(),
+			Liquidator: liquidator.String(),
+			Reason:     v2types.LiquidationFailedEvent_NONEXISTENT_POSITION,
+		})
+		return sdk.Coin{}, sdk.Coin{}, err
+	}
+
+	if k.IsCrossMargin(ctx, trader) {
+		return k.liquidateCrossMarginAccount(ctx, liquidator, pair, trader)
+	}
+
+	spotNotional, err := PositionNotionalSpot(amm, position)
+	if err != nil {
+		return sdk.Coin{}, sdk.Coin{}, err
+	}
+	twapNotional, err := k.PositionNotionalTWAP(ctx, position, market.TwapLookbackWindow)
+	if err != nil {
+		return sdk.Coin{}, sdk.Coin{}, err
+	}
+
+	positionNotional := sdk.MaxDec(spotNotional, twapNotional)
+	marginRatio := MarginRatio(position, positionNotional, market.LatestCumulativePremiumFraction)
+	maintenanceMarginRatio := market.MarginTierFor(positionNotional).MaintenanceMarginRatio
+	if marginRatio.GTE(maintenanceMarginRatio) {
+		_ = ctx.EventManager().EmitTypedEvent(&v2types.LiquidationFailedEvent{
+			Pair:       pair,
+			Trader:     trader.String(),
+			Liquidator: liquidator.String(),
+			Reason:     v2types.LiquidationFailedEvent_POSITION_HEALTHY,
+		})
+		return sdk.Coin{}, sdk.Coin{}, v2types.ErrMarginRatioTooHigh.Wrapf(
+			"margin ratio %s is above maintenance margin ratio %s", marginRatio, maintenanceMarginRatio)
+	}
+
+	// markets in AUCTION liquidation mode put the position up for auction, and
+	// only liquidate it into the AMM once the auction expired
+	auction, err := k.LiquidationAuctions.Get(ctx, collections.Join(pair, trader))
+	hasAuction := err == nil
+	if hasAuction && !market.IsAuctionExpired(auction, ctx.BlockHeight()) {
+		return sdk.Coin{}, sdk.Coin{}, v2types.ErrLiquidationAuctionInProgress.Wrapf("pair: %s, trader: %s", pair, trader)
+	}
+	if !hasAuction && market.LiquidationMode == v2types.LiquidationMode_AUCTION {
+		return k.startLiquidationAuction(ctx, liquidator, amm, position)
+	}
+	if hasAuction {
+		k.endLiquidationAuction(ctx, auction, "auction expired")
+	}
+
+	spotMarginRatio := MarginRatio(position, spotNotional, market.LatestCumulativePremiumFraction)
+
+	var liquidationResp v2types.LiquidateResp
+	if spotMarginRatio.GTE(market.LiquidationFeeRatio) {
+		liquidationResp, err = k.ExecutePartialLiquidation(ctx, liquidator, &position)
+	} else {
+		liquidationResp, err = k.ExecuteFullLiquidation(ctx, liquidator, &position)
+	}
+	if err != nil {
+		return sdk.Coin{}, sdk.Coin{}, err
+	}
+
+	liquidatorFee = sdk.NewCoin(pair.QuoteDenom(), liquidationResp.FeeToLiquidator)
+	ecosystemFundFee = sdk.NewCoin(pair.QuoteDenom(), liquidationResp.FeeToPerpEcosystemFund)
+
+	return liquidatorFee, ecosystemFundFee, nil
+}
+
+// ExecuteFullLiquidation fully liquidates a position. It is assumed that the
+// margin ratio has already been checked prior to calling this method.
+//
+// args:
+//   - ctx: cosmos-sdk context
+//   - liquidator: the liquidator's address
+//   - position: the position to liquidate
+//
+// returns:
+//   - liquidationResp: a response object containing the results of the liquidation
+//   - err: error
+func (k Keeper) ExecuteFullLiquidation(
+	ctx sdk.Context, liquidator sdk.AccAddress, position *v2types.Position,
+) (liquidationResp v2types.LiquidateResp, err error) {
+	market, err := k.Markets.Get(ctx, position.Pair)
+	if err != nil {
+		return v2types.LiquidateResp{}, v2types.ErrPairNotFound
+	}
+
+	amm, err := k.AMMs.Get(ctx, position.Pair)
+	if err != nil {
+		return v2types.LiquidateResp{}, v2types.ErrPairNotFound
+	}
+
+	updatedAMM, positionResp, err := k.closePositionEntirely(
+		ctx,
+		market,
+		amm,
+		/* currentPosition */ *position,
+		/* quoteAssetAmountLimit */ sdk.ZeroDec(),
+	)
+	if err != nil {
+		return v2types.LiquidateResp{}, err
+	}
+	k.updateOpenInterest(ctx, position.Pair, position.Size_, sdk.ZeroDec())
+
+	remainMargin := positionResp.MarginToVault.Abs()
+
+	feeToLiquidator := market.LiquidationFeeRatio.
+		Mul(positionResp.ExchangedNotionalValue).
+		QuoInt64(2)
+	totalBadDebt := positionResp.BadDebt
+
+	if feeToLiquidator.GT(remainMargin) {
+		// if the remainMargin is not enough for liquidationFee, count it as bad debt
+		totalBadDebt = totalBadDebt.Add(feeToLiquidator.Sub(remainMargin))
+		remainMargin = sdk.ZeroDec()
+	} else {
+		// Otherwise, the remaining margin will be transferred to ecosystemFund
+		remainMargin = remainMargin.Sub(feeToLiquidator)
+	}
+
+	// Realize bad debt
+	if totalBadDebt.IsPositive() {
+		if err = k.realizeBadDebt(
+			ctx,
+			market,
+			totalBadDebt.RoundInt(),
+			*position,
+			BankruptcyPrice(market, *position, market.LiquidationFeeRatio.QuoInt64(2)),
+		); err != nil {
+			return v2types.LiquidateResp{}, err
+		}
+	}
+
+	feeToPerpEcosystemFund := sdk.ZeroDec()
+	if remainMargin.IsPositive() {
+		feeToPerpEcosystemFund = remainMargin
+	}
+
+	liquidationResp = v2types.LiquidateResp{
+		BadDebt:                totalBadDebt.RoundInt(),
+		FeeToLiquidator:        feeToLiquidator.RoundInt(),
+		FeeToPerpEcosystemFund: feeToPerpEcosystemFund.RoundInt(),
+		Liquidator:             liquidator.String(),
+		PositionResp:           positionResp,
+	}
+	err = k.distributeLiquidateRewards(ctx, liquidationResp)
+	if err != nil {
+		return v2types.LiquidateResp{}, err
+	}
+
+	// the trader loses whatever margin remained after realizing its PnL
+	k.recordTraderHistory(ctx, sdk.MustAccAddressFromBech32(position.TraderAddress), position.Pair, traderHistoryChange{
+		exchangedSize:     positionResp.ExchangedPositionSize,
+		exchangedNotional: positionResp.ExchangedNotionalValue,
+		realizedPnl:       positionResp.RealizedPnl,
+		fundingPayment:    positionResp.FundingPayment,
+		fees:              sdk.ZeroDec(),
+		liquidationLoss:   positionResp.MarginToVault.Abs(),
+	})
+
+	_ = ctx.EventManager().EmitTypedEvent(&v2types.PositionLiquidatedEvent{
+		Pair:                  position.Pair,
+		TraderAddress:         position.TraderAddress,
+		ExchangedQuoteAmount:  positionResp.ExchangedNotionalValue,
+		ExchangedPositionSize: positionResp.ExchangedPositionSize,
+		LiquidatorAddress:     liquidator.String(),
+		FeeToLiquidator:       sdk.NewCoin(position.Pair.QuoteDenom(), feeToLiquidator.RoundInt()),
+		FeeToEcosystemFund:    sdk.NewCoin(position.Pair.QuoteDenom(), feeToPerpEcosystemFund.RoundInt()),
+		BadDebt:               sdk.NewCoin(position.Pair.QuoteDenom(), totalBadDebt.RoundInt()),
+		Margin:                sdk.NewCoin(position.Pair.QuoteDenom(), positionResp.Position.Margin.RoundInt()),
+		PositionNotional:      positionResp.PositionNotional,
+		PositionSize:          positionResp.Position.Size_,
+		UnrealizedPnl:         positionResp.UnrealizedPnlAfter,
+		MarkPrice:             updatedAMM.MarkPrice(),
+		BlockHeight:           ctx.BlockHeight(),
+		BlockTimeMs:           ctx.BlockTime().UnixMilli(),
+	})
+
+	return liquidationResp, nil
+}
+
+// ExecutePartialLiquidation partially liquidates a position. The notional
+// value of market.PartialLiquidationRatio of the position size is closed and
+// the liquidation fee is taken out of the remaining margin.
+//
+// args:
+//   - ctx: cosmos-sdk context
+//   - liquidator: the liquidator's address
+//   - currentPosition: the position to liquidate
+//
+// returns:
+//   - liquidationResp: a response object containing the results of the liquidation
+//   - err: error
+func (k Keeper) ExecutePartialLiquidation(
+	ctx sdk.Context, liquidator sdk.AccAddress, currentPosition *v2types.Position,
+) (v2types.LiquidateResp, error) {
+	market, err := k.Markets.Get(ctx, currentPosition.Pair)
+	if err != nil {
+		return v2types.LiquidateResp{}, v2types.ErrPairNotFound
+	}
+
+	amm, err := k.AMMs.Get(ctx, currentPosition.Pair)
+	if err != nil {
+		return v2types.LiquidateResp{}, v2types.ErrPairNotFound
+	}
+
+	traderAddr, err := sdk.AccAddressFromBech32(currentPosition.TraderAddress)
+	if err != nil {
+		return v2types.LiquidateResp{}, err
+	}
+
+	// we want to know the price if the user closes part of their position
+	// e.g. if the user has positive size, we want to short
+	var dir v2types.Direction
+	if currentPosition.Size_.IsPositive() {
+		dir = v2types.Direction_SHORT
+	} else {
+		dir = v2types.Direction_LONG
+	}
+
+	quoteReserveDelta, err := amm.GetQuoteReserveAmt(
+		currentPosition.Size_.Abs().Mul(market.PartialLiquidationRatio), dir)
+	if err != nil {
+		return v2types.LiquidateResp{}, err
+	}
+	partiallyLiquidatedPositionNotional := amm.FromQuoteReserveToAsset(quoteReserveDelta)
+
+	updatedAMM, positionResp, err := k.decreasePosition(
+		ctx,
+		market,
+		amm,
+		/* currentPosition */ *currentPosition,
+		/* decreasedNotional */ partiallyLiquidatedPositionNotional,
+		/* baseAmtLimit */ sdk.ZeroDec(),
+		/* skipFluctuationLimitCheck */ true,
+	)
+	if err != nil {
+		return v2types.LiquidateResp{}, err
+	}
+
+	// Remove the liquidation fee from the margin of the position
+	liquidationFeeAmount := positionResp.ExchangedNotionalValue.
+		Mul(market.LiquidationFeeRatio)
+	positionResp.Position.Margin = positionResp.Position.Margin.
+		Sub(liquidationFeeAmount)
+	k.Positions.Insert(ctx, collections.Join(positionResp.Position.Pair, traderAddr), *positionResp.Position)
+	k.updateOpenInterest(ctx, currentPosition.Pair, currentPosition.Size_, positionResp.Position.Size_)
+
+	// Compute splits for the liquidation fee
+	feeToLiquidator := liquidationFeeAmount.QuoInt64(2)
+	feeToPerpEcosystemFund := liquidationFeeAmount.Sub(feeToLiquidator)
+
+	liquidationResp := v2types.LiquidateResp{
+		BadDebt:                sdk.ZeroInt(),
+		FeeToLiquidator:        feeToLiquidator.RoundInt(),
+		FeeToPerpEcosystemFund: feeToPerpEcosystemFund.RoundInt(),
+		Liquidator:             liquidator.String(),
+		PositionResp:           positionResp,
+	}
+	err = k.distributeLiquidateRewards(ctx, liquidationResp)
+	if err != nil {
+		return v2types.LiquidateResp{}, err
+	}
+
+	k.recordTraderHistory(ctx, traderAddr, currentPosition.Pair, traderHistoryChange{
+		exchangedSize:     positionResp.ExchangedPositionSize,
+		exchangedNotional: positionResp.ExchangedNotionalValue,
+		realizedPnl:       positionResp.RealizedPnl,
+		fundingPayment:    positionResp.FundingPayment,
+		fees:              sdk.ZeroDec(),
+		liquidationLoss:   liquidationFeeAmount,
+	})
+
+	_ = ctx.EventManager().EmitTypedEvent(&v2types.PositionLiquidatedEvent{
+		Pair:                  currentPosition.Pair,
+		TraderAddress:         currentPosition.TraderAddress,
+		ExchangedQuoteAmount:  positionResp.ExchangedNotionalValue,
+		ExchangedPositionSize: positionResp.ExchangedPositionSize,
+		LiquidatorAddress:     liquidator.String(),
+		FeeToLiquidator:       sdk.NewCoin(currentPosition.Pair.QuoteDenom(), feeToLiquidator.RoundInt()),
+		FeeToEcosystemFund:    sdk.NewCoin(currentPosition.Pair.QuoteDenom(), feeToPerpEcosystemFund.RoundInt()),
+		BadDebt:               sdk.NewCoin(currentPosition.Pair.QuoteDenom(), liquidationResp.BadDebt),
+		Margin:                sdk.NewCoin(currentPosition.Pair.QuoteDenom(), positionResp.Position.Margin.RoundInt()),
+		PositionNotional:      positionResp.PositionNotional,
+		PositionSize:          positionResp.Position.Size_,
+		UnrealizedPnl:         positionResp.UnrealizedPnlAfter,
+		MarkPrice:             updatedAMM.MarkPrice(),
+		BlockHeight:           ctx.BlockHeight(),
+		BlockTimeMs:           ctx.BlockTime().UnixMilli(),
+	})
+
+	return liquidationResp, nil
+}
+
+// distributeLiquidateRewards transfers the liquidation fees out of the vault:
+// the ecosystem fund fee goes to the PerpEF, less the market's insurance fund
+// share, and the liquidator fee goes to the liquidator.
+func (k Keeper) distributeLiquidateRewards(
+	ctx sdk.Context, liquidateResp v2types.LiquidateResp,
+) (err error) {
+	// --------------------------------------------------------------
+	//  Preliminary validations
+	// --------------------------------------------------------------
+
+	// validate response
+	err = liquidateResp.Validate()
+	if err != nil {
+		return err
+	}
+
+	liquidator, err := sdk.AccAddressFromBech32(liquidateResp.Liquidator)
+	if err != nil {
+		return err
+	}
+
+	// the market is re-read since realizing bad debt may have updated it
+	market, err := k.Markets.Get(ctx, liquidateResp.PositionResp.Position.Pair)
+	if err != nil {
+		return v2types.ErrPairNotFound
+	}
+
+	// --------------------------------------------------------------
+	// Distribution of rewards
+	// --------------------------------------------------------------
+
+	if err = k.distributeEcosystemFundFee(ctx, market, liquidateResp.FeeToPerpEcosystemFund); err != nil {
+		return err
+	}
+
+	// Transfer fee from vault to liquidator
+	feeToLiquidator := liquidateResp.FeeToLiquidator
+	if feeToLiquidator.IsPositive() {
+		err = k.Withdraw(ctx, market, liquidator, feeToLiquidator)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// distributeEcosystemFundFee transfers the ecosystem fund fee of a liquidation
+// out of the vault: the market's insurance fund share goes to the insurance
+// fund and the rest to the PerpEF.
+func (k Keeper) distributeEcosystemFundFee(ctx sdk.Context, market v2types.Market, fee sdk.Int) (err error) {
+	// Transfer a share of the ecosystem fund fee from vault to the insurance fund
+	feeToInsuranceFund := insuranceFundShare(market, fee)
+	if feeToInsuranceFund.IsPositive() {
+		if err = k.BankKeeper.SendCoinsFromModuleToModule(
+			ctx,
+			/* from */ v2types.VaultModuleAccount,
+			/* to */ v2types.InsuranceFundModuleAccount,
+			sdk.NewCoins(sdk.NewCoin(market.Pair.QuoteDenom(), feeToInsuranceFund)),
+		); err != nil {
+			return err
+		}
+		k.increaseInsuranceFund(ctx, market.Pair, feeToInsuranceFund)
+	}
+
+	// Transfer the rest of the fee from vault to PerpEF
+	feeToPerpEF := fee.Sub(feeToInsuranceFund)
+	if feeToPerpEF.IsPositive() {
+		if err = k.BankKeeper.SendCoinsFromModuleToModule(
+			ctx,
+			/* from */ v2types.VaultModuleAccount,
+			/* to */ v2types.PerpEFModuleAccount,
+			sdk.NewCoins(sdk.NewCoin(market.Pair.QuoteDenom(), feeToPerpEF)),
+		); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// MultiLiquidate liquidates a batch of positions. Each liquidation runs in its
+// own cached context so that a failing liquidation does not revert the others.
+// Errors only if every liquidation in the batch fails.
+//
+// args:
+//   - ctx: cosmos-sdk context
+//   - liquidator: the liquidator's address
+//   - liquidationRequests: the positions to liquidate
+//
+// returns:
+//   - resp: the result of each liquidation, in the order they were requested
+//   - err: error
+func (k Keeper) MultiLiquidate(
+	ctx sdk.Context, liquidator sdk.AccAddress, liquidationRequests []*v2types.MsgMultiLiquidate_Liquidation,
+) ([]*v2types.MsgMultiLiquidateResponse_LiquidationResponse, error) {
+	resp := make([]*v2types.MsgMultiLiquidateResponse_LiquidationResponse, len(liquidationRequests))
+
+	allFailed := true
+	for i, req := range liquidationRequests {
+		traderAddr, err := sdk.AccAddressFromBech32(req.Trader)
+		if err != nil {
+			resp[i] = &v2types.MsgMultiLiquidateResponse_LiquidationResponse{
+				Success: false,
+				Error:   err.Error(),
+			}
+			continue
+		}
+
+		cachedCtx, commit := ctx.CacheContext()
+		liquidatorFee, ecosystemFundFee, err := k.Liquidate(cachedCtx, liquidator, req.Pair, traderAddr)
+
+		if err != nil {
+			resp[i] = &v2types.MsgMultiLiquidateResponse_LiquidationResponse{
+				Success: false,
+				Error:   err.Error(),
+			}
+		} else {
+			allFailed = false
+			resp[i] = &v2types.MsgMultiLiquidateResponse_LiquidationResponse{
+				Success:       true,
+				LiquidatorFee: liquidatorFee,
+				PerpEfFee:     ecosystemFundFee,
+			}
+
+			ctx.EventManager().EmitEvents(cachedCtx.EventManager().Events())
+			commit()
+		}
+	}
+
+	if allFailed {
+		return nil, v2types.ErrAllLiquidationsFailed.Wrapf("%d liquidations failed", len(liquidationRequests))
+	}
+
+	return resp, nil
+}
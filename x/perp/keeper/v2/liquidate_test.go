@@ -0,0 +1,255 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/NibiruChain/nibiru/x/common/asset"
+	"github.com/NibiruChain/nibiru/x/common/denoms"
+	"github.com/NibiruChain/nibiru/x/common/testutil"
+	. "github.com/NibiruChain/nibiru/x/common/testutil/action"
+	. "github.com/NibiruChain/nibiru/x/common/testutil/assertion"
+	. "github.com/NibiruChain/nibiru/x/perp/integration/action/v2"
+	. "github.com/NibiruChain/nibiru/x/perp/integration/assertion/v2"
+	v2types "github.com/NibiruChain/nibiru/x/perp/types/v2"
+)
+
+func TestLiquidate(t *testing.T) {
+	alice := testutil.AccAddress()
+	liquidator := testutil.AccAddress()
+	pairBtcUsdc := asset.Registry.Pair(denoms.BTC, denoms.USDC)
+	startTime := time.Now()
+
+	tc := TestCases{
+		TC("full liquidation, liquidator fee creates bad debt").
+			Given(
+				SetBlockNumber(1),
+				SetBlockTime(startTime),
+				CreateCustomMarket(pairBtcUsdc),
+				InsertPosition(
+					WithPair(pairBtcUsdc),
+					WithTrader(alice),
+					WithSize(sdk.NewDec(10000)),
+					WithMargin(sdk.NewDec(200)),
+					WithOpenNotional(sdk.NewDec(10000)),
+				),
+				FundModule(v2types.VaultModuleAccount, sdk.NewCoins(sdk.NewCoin(denoms.USDC, sdk.NewInt(200)))),
+				FundModule(v2types.PerpEFModuleAccount, sdk.NewCoins(sdk.NewCoin(denoms.USDC, sdk.NewInt(50)))),
+			).
+			When(
+				MoveToNextBlock(),
+				Liquidate(liquidator, alice, pairBtcUsdc),
+			).
+			Then(
+				PositionShouldNotExist(alice, pairBtcUsdc),
+				BalanceEqual(liquidator, denoms.USDC, sdk.NewInt(250)),
+				ModuleBalanceEqual(v2types.VaultModuleAccount, denoms.USDC, sdk.ZeroInt()),
+				ModuleBalanceEqual(v2types.PerpEFModuleAccount, denoms.USDC, sdk.ZeroInt()),
+				MarketShouldBeEqual(pairBtcUsdc, Market_PrepaidBadDebtShouldBeEqualTo(sdk.ZeroInt())),
+			),
+
+		TC("full liquidation, bad debt covered by prepaid bad debt").
+			Given(
+				SetBlockNumber(1),
+				SetBlockTime(startTime),
+				CreateCustomMarket(pairBtcUsdc, WithPrepaidBadDebt(sdk.NewInt(100))),
+				InsertPosition(
+					WithPair(pairBtcUsdc),
+					WithTrader(alice),
+					WithSize(sdk.NewDec(10000)),
+					WithMargin(sdk.NewDec(200)),
+					WithOpenNotional(sdk.NewDec(10000)),
+				),
+				FundModule(v2types.VaultModuleAccount, sdk.NewCoins(sdk.NewCoin(denoms.USDC, sdk.NewInt(250)))),
+			).
+			When(
+				MoveToNextBlock(),
+				Liquidate(liquidator, alice, pairBtcUsdc),
+			).
+			Then(
+				PositionShouldNotExist(alice, pairBtcUsdc),
+				BalanceEqual(liquidator, denoms.USDC, sdk.NewInt(250)),
+				ModuleBalanceEqual(v2types.VaultModuleAccount, denoms.USDC, sdk.ZeroInt()),
+				ModuleBalanceEqual(v2types.PerpEFModuleAccount, denoms.USDC, sdk.ZeroInt()),
+				MarketShouldBeEqual(pairBtcUsdc, Market_PrepaidBadDebtShouldBeEqualTo(sdk.NewInt(50))),
+			),
+
+		TC("full liquidation, remaining margin goes to the ecosystem fund").
+			Given(
+				SetBlockNumber(1),
+				SetBlockTime(startTime),
+				CreateCustomMarket(pairBtcUsdc, WithLiquidationFeeRatio(sdk.MustNewDecFromStr("0.01"))),
+				InsertPosition(
+					WithPair(pairBtcUsdc),
+					WithTrader(alice),
+					WithSize(sdk.NewDec(-10000)),
+					WithMargin(sdk.NewDec(100)),
+					WithOpenNotional(sdk.NewDec(10000)),
+				),
+				FundModule(v2types.VaultModuleAccount, sdk.NewCoins(sdk.NewCoin(denoms.USDC, sdk.NewInt(100)))),
+			).
+			When(
+				MoveToNextBlock(),
+				Liquidate(liquidator, alice, pairBtcUsdc),
+			).
+			Then(
+				PositionShouldNotExist(alice, pairBtcUsdc),
+				BalanceEqual(liquidator, denoms.USDC, sdk.NewInt(50)),
+				ModuleBalanceEqual(v2types.VaultModuleAccount, denoms.USDC, sdk.ZeroInt()),
+				ModuleBalanceEqual(v2types.PerpEFModuleAccount, denoms.USDC, sdk.NewInt(50)),
+				MarketShouldBeEqual(pairBtcUsdc, Market_PrepaidBadDebtShouldBeEqualTo(sdk.ZeroInt())),
+			),
+
+		TC("partial liquidation").
+			Given(
+				SetBlockNumber(1),
+				SetBlockTime(startTime),
+				CreateCustomMarket(pairBtcUsdc),
+				InsertPosition(
+					WithPair(pairBtcUsdc),
+					WithTrader(alice),
+					WithSize(sdk.NewDec(10000)),
+					WithMargin(sdk.NewDec(600)),
+					WithOpenNotional(sdk.NewDec(10000)),
+				),
+				FundModule(v2types.VaultModuleAccount, sdk.NewCoins(sdk.NewCoin(denoms.USDC, sdk.NewInt(600)))),
+			).
+			When(
+				MoveToNextBlock(),
+				Liquidate(liquidator, alice, pairBtcUsdc),
+			).
+			Then(
+				PositionShouldBeEqual(alice, pairBtcUsdc,
+					Position_PositionSizeShouldBeEqualTo(sdk.NewDec(5000)),
+				),
+				BalanceEqual(liquidator, denoms.USDC, sdk.NewInt(125)),
+				ModuleBalanceEqual(v2types.VaultModuleAccount, denoms.USDC, sdk.NewInt(350)),
+				ModuleBalanceEqual(v2types.PerpEFModuleAccount, denoms.USDC, sdk.NewInt(125)),
+			),
+
+		TC("healthy position cannot be liquidated").
+			Given(
+				SetBlockNumber(1),
+				SetBlockTime(startTime),
+				CreateCustomMarket(pairBtcUsdc),
+				InsertPosition(
+					WithPair(pairBtcUsdc),
+					WithTrader(alice),
+					WithSize(sdk.NewDec(10000)),
+					WithMargin(sdk.NewDec(1000)),
+					WithOpenNotional(sdk.NewDec(10000)),
+				),
+			).
+			When(
+				MoveToNextBlock(),
+				LiquidateExpectingFail(liquidator, alice, pairBtcUsdc),
+			).
+			Then(
+				PositionShouldBeEqual(alice, pairBtcUsdc,
+					Position_PositionSizeShouldBeEqualTo(sdk.NewDec(10000)),
+				),
+				BalanceEqual(liquidator, denoms.USDC, sdk.ZeroInt()),
+			),
+
+		TC("nonexistent position cannot be liquidated").
+			Given(
+				SetBlockNumber(1),
+				SetBlockTime(startTime),
+				CreateCustomMarket(pairBtcUsdc),
+			).
+			When(
+				LiquidateExpectingFail(liquidator, alice, pairBtcUsdc),
+			).
+			Then(
+				PositionShouldNotExist(alice, pairBtcUsdc),
+			),
+
+		TC("nonexistent market cannot be liquidated").
+			When(
+				LiquidateExpectingFail(liquidator, alice, pairBtcUsdc),
+			).
+			Then(
+				PositionShouldNotExist(alice, pairBtcUsdc),
+			),
+	}
+
+	NewTestSuite(t).WithTestCases(tc...).Run()
+}
+
+func TestMultiLiquidate(t *testing.T) {
+	alice := testutil.AccAddress()
+	bob := testutil.AccAddress()
+	liquidator := testutil.AccAddress()
+	pairBtcUsdc := asset.Registry.Pair(denoms.BTC, denoms.USDC)
+	startTime := time.Now()
+
+	tc := TestCases{
+		TC("liquidates unhealthy positions and skips healthy ones").
+			Given(
+				SetBlockNumber(1),
+				SetBlockTime(startTime),
+				CreateCustomMarket(pairBtcUsdc),
+				InsertPosition(
+					WithPair(pairBtcUsdc),
+					WithTrader(alice),
+					WithSize(sdk.NewDec(10000)),
+					WithMargin(sdk.NewDec(200)),
+					WithOpenNotional(sdk.NewDec(10000)),
+				),
+				InsertPosition(
+					WithPair(pairBtcUsdc),
+					WithTrader(bob),
+					WithSize(sdk.NewDec(10000)),
+					WithMargin(sdk.NewDec(1000)),
+					WithOpenNotional(sdk.NewDec(10000)),
+				),
+				FundModule(v2types.VaultModuleAccount, sdk.NewCoins(sdk.NewCoin(denoms.USDC, sdk.NewInt(1200)))),
+				FundModule(v2types.PerpEFModuleAccount, sdk.NewCoins(sdk.NewCoin(denoms.USDC, sdk.NewInt(50)))),
+			).
+			When(
+				MoveToNextBlock(),
+				MultiLiquidate(liquidator, false,
+					&v2types.MsgMultiLiquidate_Liquidation{Pair: pairBtcUsdc, Trader: alice.String()},
+					&v2types.MsgMultiLiquidate_Liquidation{Pair: pairBtcUsdc, Trader: bob.String()},
+				),
+			).
+			Then(
+				PositionShouldNotExist(alice, pairBtcUsdc),
+				PositionShouldBeEqual(bob, pairBtcUsdc,
+					Position_PositionSizeShouldBeEqualTo(sdk.NewDec(10000)),
+				),
+				BalanceEqual(liquidator, denoms.USDC, sdk.NewInt(250)),
+			),
+
+		TC("fails when all liquidations fail").
+			Given(
+				SetBlockNumber(1),
+				SetBlockTime(startTime),
+				CreateCustomMarket(pairBtcUsdc),
+				InsertPosition(
+					WithPair(pairBtcUsdc),
+					WithTrader(bob),
+					WithSize(sdk.NewDec(10000)),
+					WithMargin(sdk.NewDec(1000)),
+					WithOpenNotional(sdk.NewDec(10000)),
+				),
+			).
+			When(
+				MoveToNextBlock(),
+				MultiLiquidate(liquidator, true,
+					&v2types.MsgMultiLiquidate_Liquidation{Pair: pairBtcUsdc, Trader: alice.String()},
+					&v2types.MsgMultiLiquidate_Liquidation{Pair: pairBtcUsdc, Trader: bob.String()},
+				),
+			).
+			Then(
+				PositionShouldBeEqual(bob, pairBtcUsdc,
+					Position_PositionSizeShouldBeEqualTo(sdk.NewDec(10000)),
+				),
+				BalanceEqual(liquidator, denoms.USDC, sdk.ZeroInt()),
+			),
+	}
+
+	NewTestSuite(t).WithTestCases(tc...).Run()
+}
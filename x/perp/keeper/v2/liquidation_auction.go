@@ -0,0 +1,356 @@
+package keeper
+
+import (
+	"errors"
+
+	"github.com/NibiruChain/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/NibiruChain/nibiru/x/common/asset"
+	v2types "github.com/NibiruChain/nibiru/x/perp/types/v2"
+)
+
+// hasLiquidationAuction returns whether the position of the trader is being
+// auctioned.
+func (k Keeper) hasLiquidationAuction(ctx sdk.Context, pair asset.Pair, trader sdk.AccAddress) bool {
+	_, err := k.LiquidationAuctions.Get(ctx, collections.Join(pair, trader))
+	return err == nil
+}
+
+// startLiquidationAuction puts an underwater position up for auction instead
+// of closing it into the AMM. The initiator is paid the liquidation fee if the
+// auction ends unfilled, see ExecuteLiquidationAuctions.
+//
+// returns:
+//   - liquidatorFee: always zero, the auction discount rewards the liquidators
+//   - ecosystemFundFee: always zero
+func (k Keeper) startLiquidationAuction(
+	ctx sdk.Context, initiator sdk.AccAddress, amm v2types.AMM, position v2types.Position,
+) (liquidatorFee sdk.Coin, ecosystemFundFee sdk.Coin, err error) {
+	auction := v2types.LiquidationAuction{
+		Pair:             position.Pair,
+		TraderAddress:    position.TraderAddress,
+		InitiatorAddress: initiator.String(),
+		StartBlockHeight: ctx.BlockHeight(),
+	}
+	k.LiquidationAuctions.Insert(ctx, collections.Join(position.Pair, sdk.MustAccAddressFromBech32(position.TraderAddress)), auction)
+
+	_ = ctx.EventManager().EmitTypedEvent(&v2types.LiquidationAuctionStartedEvent{
+		Auction:      auction,
+		PositionSize: position.Size_,
+		MarkPrice:    amm.MarkPrice(),
+	})
+
+	zero := sdk.NewCoin(position.Pair.QuoteDenom(), sdk.ZeroInt())
+	return zero, zero, nil
+}
+
+// endLiquidationAuction removes the auction.
+func (k Keeper) endLiquidationAuction(ctx sdk.Context, auction v2types.LiquidationAuction, reason string) {
+	_ = k.LiquidationAuctions.Delete(ctx, collections.Join(auction.Pair, sdk.MustAccAddressFromBech32(auction.TraderAddress)))
+
+	_ = ctx.EventManager().EmitTypedEvent(&v2types.LiquidationAuctionEndedEvent{
+		Auction:     auction,
+		Reason:      reason,
+		BlockHeight: ctx.BlockHeight(),
+	})
+}
+
+// BidLiquidationAuction lets a whitelisted liquidator take over an auctioned
+// position, or part of it, at the auction price: the index price less the
+// auction discount for longs, plus the discount for shorts.
+//
+// The size taken over is added to the liquidator's position on the pair,
+// opened at the auction price with the margin of the bid, and must respect the
+// initial margin ratio. Only then does the trader realize its PnL at the
+// auction price: any bad debt is realized and the margin left in a fully taken
+// over position is paid to the ecosystem fund. The AMM is left untouched.
+//
+// args:
+//   - ctx: cosmos-sdk context
+//   - liquidator: the liquidator's address, in the AuctionLiquidators param
+//   - pair: the market of the auctioned position
+//   - trader: the owner of the auctioned position
+//   - size: the base asset amount to take over, the whole position if zero
+//   - margin: the margin added to the liquidator's position
+//
+// returns:
+//   - resp: the liquidator's position and the auction price
+//   - err: error
+func (k Keeper) BidLiquidationAuction(
+	ctx sdk.Context,
+	liquidator sdk.AccAddress,
+	pair asset.Pair,
+	trader sdk.AccAddress,
+	size sdk.Dec,
+	margin sdk.Coin,
+) (resp *v2types.MsgBidLiquidationAuctionResponse, err error) {
+	if !k.isAuctionLiquidator(ctx, liquidator) {
+		return nil, v2types.ErrUnauthorized.Wrapf("%s is not an auction liquidator", liquidator)
+	}
+
+	// taking over an auctioned position opens a position for the liquidator
+	if err = k.CheckTradingHalted(ctx, pair); err != nil {
+		return nil, err
+	}
+
+	auction, err := k.LiquidationAuctions.Get(ctx, collections.Join(pair, trader))
+	if err != nil {
+		return nil, v2types.ErrLiquidationAuctionNotFound.Wrapf("pair: %s, trader: %s", pair, trader)
+	}
+
+	market, err := k.Markets.Get(ctx, pair)
+	if err != nil {
+		return nil, v2types.ErrPairNotFound
+	}
+
+	amm, err := k.AMMs.Get(ctx, pair)
+	if err != nil {
+		return nil, v2types.ErrPairNotFound
+	}
+
+	if market.IsAuctionExpired(auction, ctx.BlockHeight()) {
+		return nil, v2types.ErrLiquidationAuctionNotFound.Wrapf("auction of %s in %s expired", trader, pair)
+	}
+
+	if margin.Denom != pair.QuoteDenom() {
+		return nil, v2types.ErrInvalidAmount.Wrapf("invalid margin denom, expected %s, got %s", pair.QuoteDenom(), margin.Denom)
+	}
+
+	position, err := k.Positions.Get(ctx, collections.Join(pair, trader))
+	if err != nil {
+		return nil, err
+	}
+
+	positionNotional, err := k.positionNotionalMaxSpotTWAP(ctx, market, position)
+	if err != nil {
+		return nil, err
+	}
+	marginRatio := MarginRatio(position, positionNotional, market.LatestCumulativePremiumFraction)
+	maintenanceMarginRatio := market.MarginTierFor(positionNotional).MaintenanceMarginRatio
+	if marginRatio.GTE(maintenanceMarginRatio) {
+		return nil, v2types.ErrMarginRatioTooHigh.Wrapf(
+			"margin ratio %s is above maintenance margin ratio %s", marginRatio, maintenanceMarginRatio)
+	}
+
+	// the size taken over has the sign of the position
+	takenSize := position.Size_
+	if size.IsPositive() && size.LT(position.Size_.Abs()) {
+		takenSize = size
+		if position.Size_.IsNegative() {
+			takenSize = size.Neg()
+		}
+	}
+
+	// the index price, unlike the mark price, cannot be moved by trading
+	// against the AMM
+	indexPrice, err := k.OracleKeeper.GetExchangeRateWithMaxAge(ctx, pair)
+	if err != nil {
+		return nil, err
+	}
+	discount := market.AuctionDiscount(auction, ctx.BlockHeight())
+	auctionPrice := v2types.AuctionPrice(indexPrice, discount, position.Size_)
+	takenNotional := takenSize.Abs().Mul(auctionPrice)
+
+	// --------------------------------------------------------------
+	// The liquidator buys the size taken over at the auction price
+	// --------------------------------------------------------------
+
+	liquidatorPosition, err := k.Positions.Get(ctx, collections.Join(pair, liquidator))
+	if errors.Is(err, collections.ErrNotFound) {
+		liquidatorPosition = v2types.ZeroPosition(ctx, pair, liquidator)
+	} else if err != nil {
+		return nil, err
+	}
+	if !liquidatorPosition.Size_.IsZero() && liquidatorPosition.Size_.IsPositive() != takenSize.IsPositive() {
+		return nil, v2types.ErrInvalidAmount.Wrap("cannot take over a position opposite to your own position")
+	}
+
+	liquidatorFundingPayment := FundingPayment(liquidatorPosition, market.LatestCumulativePremiumFraction)
+	oldLiquidatorSize := liquidatorPosition.Size_
+	liquidatorPosition.Size_ = liquidatorPosition.Size_.Add(takenSize)
+	liquidatorPosition.OpenNotional = liquidatorPosition.OpenNotional.Add(takenNotional)
+	liquidatorPosition.Margin = liquidatorPosition.Margin.Add(margin.Amount.ToDec()).Sub(liquidatorFundingPayment)
+	liquidatorPosition.LatestCumulativePremiumFraction = market.LatestCumulativePremiumFraction
+	liquidatorPosition.LastUpdatedBlockNumber = ctx.BlockHeight()
+
+	liquidatorNotional, err := PositionNotionalSpot(amm, liquidatorPosition)
+	if err != nil {
+		return nil, err
+	}
+	if market.MaxPositionNotional.IsPositive() && liquidatorNotional.GT(market.MaxPositionNotional) {
+		return nil, v2types.ErrPositionNotionalCapExceeded.Wrapf(
+			"position notional %s, max position notional %s", liquidatorNotional, market.MaxPositionNotional)
+	}
+	initialMarginRatio := sdk.OneDec().Quo(market.MarginTierFor(liquidatorNotional).MaxLeverage)
+	if liquidatorMarginRatio := MarginRatio(
+		liquidatorPosition, liquidatorNotional, market.LatestCumulativePremiumFraction,
+	); liquidatorMarginRatio.LT(initialMarginRatio) {
+		return nil, v2types.ErrMarginRatioTooLow.Wrapf(
+			"margin ratio %s of the position taken over is below the initial margin ratio %s",
+			liquidatorMarginRatio, initialMarginRatio)
+	}
+
+	if err = k.BankKeeper.SendCoinsFromAccountToModule(
+		ctx,
+		/* from */ liquidator,
+		/* to */ v2types.VaultModuleAccount,
+		/* amount */ sdk.NewCoins(margin),
+	); err != nil {
+		return nil, err
+	}
+
+	k.Positions.Insert(ctx, collections.Join(pair, liquidator), liquidatorPosition)
+	k.updateOpenInterest(ctx, pair, oldLiquidatorSize, liquidatorPosition.Size_)
+
+	// --------------------------------------------------------------
+	// The trader sells the size taken over at the auction price
+	// --------------------------------------------------------------
+
+	takenPosition := position
+	takenPosition.Size_ = takenSize
+	takenPosition.OpenNotional = position.OpenNotional.Mul(takenSize.Quo(position.Size_))
+	realizedPnl := UnrealizedPnl(takenPosition, takenNotional)
+	fundingPayment := FundingPayment(position, market.LatestCumulativePremiumFraction)
+
+	remainingMargin := position.Margin.Add(realizedPnl).Sub(fundingPayment)
+	badDebt := sdk.ZeroDec()
+	if remainingMargin.IsNegative() {
+		badDebt = remainingMargin.Neg()
+		remainingMargin = sdk.ZeroDec()
+	}
+
+	traderPosition := position
+	traderPosition.Size_ = position.Size_.Sub(takenSize)
+	traderPosition.OpenNotional = position.OpenNotional.Sub(takenPosition.OpenNotional)
+	traderPosition.Margin = remainingMargin
+	traderPosition.LatestCumulativePremiumFraction = market.LatestCumulativePremiumFraction
+	traderPosition.LastUpdatedBlockNumber = ctx.BlockHeight()
+
+	if badDebt.IsPositive() {
+		if err = k.realizeBadDebt(
+			ctx, market, badDebt.RoundInt(), takenPosition, BankruptcyPrice(market, takenPosition, sdk.ZeroDec()),
+		); err != nil {
+			return nil, err
+		}
+	}
+
+	// the trader forfeits the margin left in a fully taken over position
+	feeToEcosystemFund := sdk.ZeroInt()
+	if traderPosition.Size_.IsZero() {
+		feeToEcosystemFund = remainingMargin.TruncateInt()
+		if err = k.Positions.Delete(ctx, collections.Join(pair, trader)); err != nil {
+			return nil, err
+		}
+		k.endLiquidationAuction(ctx, auction, "position fully taken over")
+	} else {
+		k.Positions.Insert(ctx, collections.Join(pair, trader), traderPosition)
+	}
+	k.updateOpenInterest(ctx, pair, position.Size_, traderPosition.Size_)
+
+	// the bad debt may have updated the market
+	if market, err = k.Markets.Get(ctx, pair); err != nil {
+		return nil, v2types.ErrPairNotFound
+	}
+	if err = k.distributeEcosystemFundFee(ctx, market, feeToEcosystemFund); err != nil {
+		return nil, err
+	}
+
+	// --------------------------------------------------------------
+	// Bookkeeping
+	// --------------------------------------------------------------
+
+	k.recordTraderHistory(ctx, trader, pair, traderHistoryChange{
+		exchangedSize:     takenSize.Neg(),
+		exchangedNotional: takenNotional,
+		realizedPnl:       realizedPnl,
+		fundingPayment:    fundingPayment,
+		fees:              sdk.ZeroDec(),
+		liquidationLoss:   feeToEcosystemFund.ToDec(),
+	})
+	k.recordTraderHistory(ctx, liquidator, pair, traderHistoryChange{
+		exchangedSize:     takenSize,
+		exchangedNotional: takenNotional,
+		realizedPnl:       sdk.ZeroDec(),
+		fundingPayment:    liquidatorFundingPayment,
+		fees:              sdk.ZeroDec(),
+		liquidationLoss:   sdk.ZeroDec(),
+	})
+
+	_ = ctx.EventManager().EmitTypedEvent(&v2types.LiquidationAuctionBidEvent{
+		Auction:                auction,
+		LiquidatorAddress:      liquidator.String(),
+		ExchangedPositionSize:  takenSize,
+		ExchangedNotionalValue: takenNotional,
+		Discount:               discount,
+		AuctionPrice:           auctionPrice,
+		RealizedPnl:            realizedPnl,
+		BadDebt:                sdk.NewCoin(pair.QuoteDenom(), badDebt.RoundInt()),
+		FeeToEcosystemFund:     sdk.NewCoin(pair.QuoteDenom(), feeToEcosystemFund),
+		BlockHeight:            ctx.BlockHeight(),
+	})
+
+	return &v2types.MsgBidLiquidationAuctionResponse{
+		Position:               liquidatorPosition,
+		AuctionPrice:           auctionPrice,
+		ExchangedPositionSize:  takenSize,
+		ExchangedNotionalValue: takenNotional,
+		BadDebt:                sdk.NewCoin(pair.QuoteDenom(), badDebt.RoundInt()),
+	}, nil
+}
+
+// isAuctionLiquidator returns whether the account is allowed to bid on
+// liquidation auctions.
+func (k Keeper) isAuctionLiquidator(ctx sdk.Context, account sdk.AccAddress) bool {
+	for _, liquidator := range k.GetParams(ctx).AuctionLiquidators {
+		if liquidator == account.String() {
+			return true
+		}
+	}
+	return false
+}
+
+// ExecuteLiquidationAuctions ends the auctions whose position was closed or
+// is healthy again, and liquidates the positions of expired auctions into the
+// AMM, paying the liquidation fee to the account that started the auction.
+// Called by the end blocker.
+func (k Keeper) ExecuteLiquidationAuctions(ctx sdk.Context) {
+	auctions := k.LiquidationAuctions.Iterate(ctx, collections.PairRange[asset.Pair, sdk.AccAddress]{}).Values()
+
+	for _, auction := range auctions {
+		trader := sdk.MustAccAddressFromBech32(auction.TraderAddress)
+
+		market, err := k.Markets.Get(ctx, auction.Pair)
+		if err != nil {
+			k.endLiquidationAuction(ctx, auction, "market does not exist")
+			continue
+		}
+
+		position, err := k.Positions.Get(ctx, collections.Join(auction.Pair, trader))
+		if err != nil {
+			k.endLiquidationAuction(ctx, auction, "position does not exist")
+			continue
+		}
+
+		if market.IsAuctionExpired(auction, ctx.BlockHeight()) {
+			cachedCtx, commit := ctx.CacheContext()
+			if _, _, err = k.Liquidate(cachedCtx, sdk.MustAccAddressFromBech32(auction.InitiatorAddress), auction.Pair, trader); err != nil {
+				k.endLiquidationAuction(ctx, auction, err.Error())
+				continue
+			}
+			ctx.EventManager().EmitEvents(cachedCtx.EventManager().Events())
+			commit()
+			continue
+		}
+
+		positionNotional, err := k.positionNotionalMaxSpotTWAP(ctx, market, position)
+		if err != nil {
+			k.Logger(ctx).Error("failed to get liquidation auction position notional", "pair", auction.Pair, "trader", trader, "error", err)
+			continue
+		}
+		marginRatio := MarginRatio(position, positionNotional, market.LatestCumulativePremiumFraction)
+		if marginRatio.GTE(market.MarginTierFor(positionNotional).MaintenanceMarginRatio) {
+			k.endLiquidationAuction(ctx, auction, "position is healthy")
+		}
+	}
+}
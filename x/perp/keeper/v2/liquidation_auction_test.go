@@ -0,0 +1,330 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/NibiruChain/nibiru/x/common/asset"
+	"github.com/NibiruChain/nibiru/x/common/denoms"
+	"github.com/NibiruChain/nibiru/x/common/testutil"
+	. "github.com/NibiruChain/nibiru/x/common/testutil/action"
+	. "github.com/NibiruChain/nibiru/x/common/testutil/assertion"
+	"github.com/NibiruChain/nibiru/x/common/testutil/testapp"
+	. "github.com/NibiruChain/nibiru/x/oracle/integration/action"
+	. "github.com/NibiruChain/nibiru/x/perp/integration/action/v2"
+	. "github.com/NibiruChain/nibiru/x/perp/integration/assertion/v2"
+	"github.com/NibiruChain/nibiru/x/perp/keeper/v2"
+	v2types "github.com/NibiruChain/nibiru/x/perp/types/v2"
+)
+
+func TestLiquidationAuction(t *testing.T) {
+	alice := testutil.AccAddress()
+	liquidator := testutil.AccAddress()
+	bidder := testutil.AccAddress()
+	pairBtcUsdc := asset.Registry.Pair(denoms.BTC, denoms.USDC)
+	startTime := time.Now()
+
+	givenAuctionMarket := []Action{
+		SetBlockNumber(1),
+		SetBlockTime(startTime),
+		CreateCustomMarket(pairBtcUsdc,
+			WithLiquidationAuction(sdk.MustNewDecFromStr("0.01"), sdk.MustNewDecFromStr("0.05"), 3),
+		),
+		SetAuctionLiquidators(bidder),
+		SetOraclePrice(pairBtcUsdc, sdk.OneDec()),
+		InsertPosition(
+			WithPair(pairBtcUsdc),
+			WithTrader(alice),
+			WithSize(sdk.NewDec(10000)),
+			WithMargin(sdk.NewDec(400)),
+			WithOpenNotional(sdk.NewDec(10000)),
+		),
+		FundModule(v2types.VaultModuleAccount, sdk.NewCoins(sdk.NewCoin(denoms.USDC, sdk.NewInt(400)))),
+		FundModule(v2types.PerpEFModuleAccount, sdk.NewCoins(sdk.NewCoin(denoms.USDC, sdk.NewInt(200)))),
+		FundAccount(bidder, sdk.NewCoins(sdk.NewCoin(denoms.USDC, sdk.NewInt(1000)))),
+		MoveToNextBlock(),
+	}
+
+	tc := TestCases{
+		TC("liquidation starts an auction and keeps the position").
+			Given(givenAuctionMarket...).
+			When(
+				Liquidate(liquidator, alice, pairBtcUsdc),
+			).
+			Then(
+				LiquidationAuctionShouldExist(alice, pairBtcUsdc),
+				PositionShouldBeEqual(alice, pairBtcUsdc,
+					Position_PositionSizeShouldBeEqualTo(sdk.NewDec(10000)),
+				),
+				BalanceEqual(liquidator, denoms.USDC, sdk.ZeroInt()),
+				ModuleBalanceEqual(v2types.VaultModuleAccount, denoms.USDC, sdk.NewInt(400)),
+				LiquidateExpectingFail(liquidator, alice, pairBtcUsdc),
+			),
+
+		TC("bid takes over the whole position at the discounted price").
+			Given(givenAuctionMarket...).
+			When(
+				Liquidate(liquidator, alice, pairBtcUsdc),
+				MoveToNextBlock(),
+				MoveToNextBlock(),
+				BidLiquidationAuction(bidder, alice, pairBtcUsdc, sdk.ZeroDec(), sdk.NewInt(1000)),
+			).
+			Then(
+				LiquidationAuctionShouldNotExist(alice, pairBtcUsdc),
+				PositionShouldNotExist(alice, pairBtcUsdc),
+				PositionShouldBeEqual(bidder, pairBtcUsdc,
+					Position_PositionShouldBeEqualTo(v2types.Position{
+						TraderAddress:                   bidder.String(),
+						Pair:                            pairBtcUsdc,
+						Size_:                           sdk.NewDec(10000),
+						Margin:                          sdk.NewDec(1000),
+						OpenNotional:                    sdk.NewDec(9800),
+						LatestCumulativePremiumFraction: sdk.ZeroDec(),
+						LastUpdatedBlockNumber:          4,
+					}),
+				),
+				BalanceEqual(bidder, denoms.USDC, sdk.ZeroInt()),
+				ModuleBalanceEqual(v2types.VaultModuleAccount, denoms.USDC, sdk.NewInt(1200)),
+				ModuleBalanceEqual(v2types.PerpEFModuleAccount, denoms.USDC, sdk.NewInt(400)),
+			),
+
+		TC("partial bid keeps the auction running").
+			Given(givenAuctionMarket...).
+			When(
+				Liquidate(liquidator, alice, pairBtcUsdc),
+				MoveToNextBlock(),
+				MoveToNextBlock(),
+				BidLiquidationAuction(bidder, alice, pairBtcUsdc, sdk.NewDec(4000), sdk.NewInt(500)),
+			).
+			Then(
+				LiquidationAuctionShouldExist(alice, pairBtcUsdc),
+				PositionShouldBeEqual(alice, pairBtcUsdc,
+					Position_PositionSizeShouldBeEqualTo(sdk.NewDec(6000)),
+					Position_PositionShouldBeEqualTo(v2types.Position{
+						TraderAddress:                   alice.String(),
+						Pair:                            pairBtcUsdc,
+						Size_:                           sdk.NewDec(6000),
+						Margin:                          sdk.NewDec(320),
+						OpenNotional:                    sdk.NewDec(6000),
+						LatestCumulativePremiumFraction: sdk.ZeroDec(),
+						LastUpdatedBlockNumber:          4,
+					}),
+				),
+				PositionShouldBeEqual(bidder, pairBtcUsdc,
+					Position_PositionSizeShouldBeEqualTo(sdk.NewDec(4000)),
+				),
+				BalanceEqual(bidder, denoms.USDC, sdk.NewInt(500)),
+			),
+
+		TC("bid is priced off the index price, not the mark price").
+			Given(givenAuctionMarket...).
+			When(
+				Liquidate(liquidator, alice, pairBtcUsdc),
+				FundModule(v2types.PerpEFModuleAccount, sdk.NewCoins(sdk.NewCoin(denoms.USDC, sdk.NewInt(1000)))),
+				SetOraclePrice(pairBtcUsdc, sdk.MustNewDecFromStr("0.9")),
+				MoveToNextBlock(),
+				MoveToNextBlock(),
+				BidLiquidationAuction(bidder, alice, pairBtcUsdc, sdk.ZeroDec(), sdk.NewInt(1000)),
+			).
+			Then(
+				PositionShouldNotExist(alice, pairBtcUsdc),
+				PositionShouldBeEqual(bidder, pairBtcUsdc,
+					Position_PositionShouldBeEqualTo(v2types.Position{
+						TraderAddress:                   bidder.String(),
+						Pair:                            pairBtcUsdc,
+						Size_:                           sdk.NewDec(10000),
+						Margin:                          sdk.NewDec(1000),
+						OpenNotional:                    sdk.NewDec(8820),
+						LatestCumulativePremiumFraction: sdk.ZeroDec(),
+						LastUpdatedBlockNumber:          4,
+					}),
+				),
+			),
+
+		TC("bid opposite to the liquidator's position leaves the trader untouched").
+			Given(givenAuctionMarket...).
+			When(
+				InsertPosition(
+					WithPair(pairBtcUsdc),
+					WithTrader(bidder),
+					WithSize(sdk.NewDec(-100)),
+					WithMargin(sdk.NewDec(100)),
+					WithOpenNotional(sdk.NewDec(100)),
+				),
+				Liquidate(liquidator, alice, pairBtcUsdc),
+				MoveToNextBlock(),
+				BidLiquidationAuctionExpectingFail(bidder, alice, pairBtcUsdc, sdk.ZeroDec(), sdk.NewInt(1000)),
+			).
+			Then(
+				LiquidationAuctionShouldExist(alice, pairBtcUsdc),
+				PositionShouldBeEqual(alice, pairBtcUsdc,
+					Position_PositionSizeShouldBeEqualTo(sdk.NewDec(10000)),
+					Position_MarginShouldBeEqualTo(sdk.NewDec(400)),
+				),
+				BalanceEqual(bidder, denoms.USDC, sdk.NewInt(1000)),
+				ModuleBalanceEqual(v2types.PerpEFModuleAccount, denoms.USDC, sdk.NewInt(200)),
+			),
+
+		TC("only auction liquidators can bid").
+			Given(givenAuctionMarket...).
+			When(
+				Liquidate(liquidator, alice, pairBtcUsdc),
+				FundAccount(liquidator, sdk.NewCoins(sdk.NewCoin(denoms.USDC, sdk.NewInt(1000)))),
+				BidLiquidationAuctionExpectingFail(liquidator, alice, pairBtcUsdc, sdk.ZeroDec(), sdk.NewInt(1000)),
+			).
+			Then(
+				LiquidationAuctionShouldExist(alice, pairBtcUsdc),
+				PositionShouldNotExist(liquidator, pairBtcUsdc),
+				BalanceEqual(liquidator, denoms.USDC, sdk.NewInt(1000)),
+			),
+
+		TC("bid while trading is halted fails").
+			Given(givenAuctionMarket...).
+			When(
+				Liquidate(liquidator, alice, pairBtcUsdc),
+				MoveToNextBlock(),
+				SetStopped(true),
+				BidLiquidationAuctionExpectingFail(bidder, alice, pairBtcUsdc, sdk.ZeroDec(), sdk.NewInt(1000)),
+			).
+			Then(
+				LiquidationAuctionShouldExist(alice, pairBtcUsdc),
+				PositionShouldBeEqual(alice, pairBtcUsdc,
+					Position_PositionSizeShouldBeEqualTo(sdk.NewDec(10000)),
+				),
+				PositionShouldNotExist(bidder, pairBtcUsdc),
+				BalanceEqual(bidder, denoms.USDC, sdk.NewInt(1000)),
+			),
+
+		TC("bid without an auction fails").
+			Given(givenAuctionMarket...).
+			When(
+				BidLiquidationAuctionExpectingFail(bidder, alice, pairBtcUsdc, sdk.ZeroDec(), sdk.NewInt(1000)),
+			).
+			Then(
+				PositionShouldNotExist(bidder, pairBtcUsdc),
+				BalanceEqual(bidder, denoms.USDC, sdk.NewInt(1000)),
+			),
+
+		TC("expired auction is liquidated into the AMM").
+			Given(givenAuctionMarket...).
+			When(
+				Liquidate(liquidator, alice, pairBtcUsdc),
+				MoveToNextBlock(),
+				MoveToNextBlock(),
+				MoveToNextBlock(),
+				MoveToNextBlock(),
+			).
+			Then(
+				LiquidationAuctionShouldNotExist(alice, pairBtcUsdc),
+				PositionShouldNotExist(alice, pairBtcUsdc),
+				BalanceEqual(liquidator, denoms.USDC, sdk.NewInt(250)),
+				BidLiquidationAuctionExpectingFail(bidder, alice, pairBtcUsdc, sdk.ZeroDec(), sdk.NewInt(1000)),
+			),
+
+		TC("auction ends once the position is healthy again").
+			Given(givenAuctionMarket...).
+			When(
+				Liquidate(liquidator, alice, pairBtcUsdc),
+				FundAccount(alice, sdk.NewCoins(sdk.NewCoin(denoms.USDC, sdk.NewInt(1000)))),
+				AddMargin(alice, pairBtcUsdc, sdk.NewInt(1000)),
+				MoveToNextBlock(),
+			).
+			Then(
+				LiquidationAuctionShouldNotExist(alice, pairBtcUsdc),
+				PositionShouldBeEqual(alice, pairBtcUsdc,
+					Position_PositionSizeShouldBeEqualTo(sdk.NewDec(10000)),
+				),
+			),
+
+		TC("auctioned position can only have margin added").
+			Given(givenAuctionMarket...).
+			When(
+				Liquidate(liquidator, alice, pairBtcUsdc),
+				FundAccount(alice, sdk.NewCoins(sdk.NewCoin(denoms.USDC, sdk.NewInt(1000)))),
+			).
+			Then(
+				OpenPositionExpectingFail(alice, pairBtcUsdc, v2types.Direction_LONG, sdk.NewInt(100), sdk.OneDec(), sdk.ZeroDec()),
+				RemoveMarginExpectingFail(alice, pairBtcUsdc, sdk.NewInt(1)),
+				SetMarginModeExpectingFail(alice, true),
+				AddMargin(alice, pairBtcUsdc, sdk.NewInt(1)),
+				LiquidationAuctionShouldExist(alice, pairBtcUsdc),
+			),
+
+		TC("instant liquidation markets do not auction").
+			Given(
+				SetBlockNumber(1),
+				SetBlockTime(startTime),
+				CreateCustomMarket(pairBtcUsdc),
+				InsertPosition(
+					WithPair(pairBtcUsdc),
+					WithTrader(alice),
+					WithSize(sdk.NewDec(10000)),
+					WithMargin(sdk.NewDec(400)),
+					WithOpenNotional(sdk.NewDec(10000)),
+				),
+				FundModule(v2types.VaultModuleAccount, sdk.NewCoins(sdk.NewCoin(denoms.USDC, sdk.NewInt(400)))),
+				FundModule(v2types.PerpEFModuleAccount, sdk.NewCoins(sdk.NewCoin(denoms.USDC, sdk.NewInt(200)))),
+			).
+			When(
+				MoveToNextBlock(),
+				Liquidate(liquidator, alice, pairBtcUsdc),
+			).
+			Then(
+				LiquidationAuctionShouldNotExist(alice, pairBtcUsdc),
+				PositionShouldNotExist(alice, pairBtcUsdc),
+			),
+	}
+
+	NewTestSuite(t).WithTestCases(tc...).Run()
+}
+
+func TestQueryLiquidationAuctions(t *testing.T) {
+	alice := testutil.AccAddress()
+	liquidator := testutil.AccAddress()
+	pairBtcUsdc := asset.Registry.Pair(denoms.BTC, denoms.USDC)
+
+	app, ctx := testapp.NewNibiruTestAppAndContext(true)
+	ctx = doActions(t, app, ctx,
+		SetBlockNumber(1),
+		SetBlockTime(time.Now()),
+		CreateCustomMarket(pairBtcUsdc,
+			WithLiquidationAuction(sdk.MustNewDecFromStr("0.01"), sdk.MustNewDecFromStr("0.05"), 10),
+		),
+		SetOraclePrice(pairBtcUsdc, sdk.OneDec()),
+		InsertPosition(
+			WithPair(pairBtcUsdc),
+			WithTrader(alice),
+			WithSize(sdk.NewDec(-10000)),
+			WithMargin(sdk.NewDec(400)),
+			WithOpenNotional(sdk.NewDec(10000)),
+		),
+		FundModule(v2types.VaultModuleAccount, sdk.NewCoins(sdk.NewCoin(denoms.USDC, sdk.NewInt(400)))),
+		MoveToNextBlock(),
+		Liquidate(liquidator, alice, pairBtcUsdc),
+		MoveToNextBlock(),
+		MoveToNextBlock(),
+	)
+
+	_, err := app.PerpKeeperV2.ClosePosition(ctx, pairBtcUsdc, alice)
+	require.ErrorIs(t, err, v2types.ErrLiquidationAuctionInProgress)
+
+	queryServer := keeper.NewQuerier(app.PerpKeeperV2)
+	resp, err := queryServer.QueryLiquidationAuctions(sdk.WrapSDKContext(ctx), &v2types.QueryLiquidationAuctionsRequest{
+		Pair: pairBtcUsdc,
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.Auctions, 1)
+	require.Equal(t, alice.String(), resp.Auctions[0].Auction.TraderAddress)
+	require.Equal(t, liquidator.String(), resp.Auctions[0].Auction.InitiatorAddress)
+	require.Equal(t, sdk.NewDec(-10000), resp.Auctions[0].PositionSize)
+	require.Equal(t, sdk.MustNewDecFromStr("0.02"), resp.Auctions[0].Discount)
+	require.Equal(t, sdk.MustNewDecFromStr("1.02"), resp.Auctions[0].AuctionPrice)
+
+	_, err = queryServer.QueryLiquidationAuctions(sdk.WrapSDKContext(ctx), &v2types.QueryLiquidationAuctionsRequest{
+		Pair: asset.Registry.Pair(denoms.ETH, denoms.USDC),
+	})
+	require.Error(t, err)
+}
@@ -0,0 +1,260 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/NibiruChain/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/NibiruChain/nibiru/x/common/asset"
+	v2types "github.com/NibiruChain/nibiru/x/perp/types/v2"
+)
+
+// AddMargin deleverages an existing position by adding margin (collateral)
+// to it. Adding margin increases the margin ratio of the corresponding position.
+// The funding payment accrued since the last position update is settled
+// against the margin.
+//
+// args:
+//   - ctx: the cosmos-sdk context
+//   - pair: the asset pair
+//   - traderAddr: the trader's address
+//   - margin: the amount of margin to add. Must be positive.
+//
+// returns:
+//   - res: the funding payment applied and the resulting position
+//   - err: error if any
+func (k Keeper) AddMargin(
+	ctx sdk.Context, pair asset.Pair, traderAddr sdk.AccAddress, margin sdk.Coin,
+) (res *v2types.MsgAddMarginResponse, err error) {
+	market, err := k.Markets.Get(ctx, pair)
+	if err != nil {
+		return nil, v2types.ErrPairNotFound
+	}
+
+	amm, err := k.AMMs.Get(ctx, pair)
+	if err != nil {
+		return nil, v2types.ErrPairNotFound
+	}
+
+	if margin.Denom != market.Pair.QuoteDenom() {
+		return nil, fmt.Errorf("invalid margin denom, expected %s, got %s", market.Pair.QuoteDenom(), margin.Denom)
+	}
+
+	position, err := k.Positions.Get(ctx, collections.Join(pair, traderAddr))
+	if err != nil {
+		return nil, err
+	}
+
+	fundingPayment := FundingPayment(position, market.LatestCumulativePremiumFraction)
+	remainingMargin := position.Margin.Add(margin.Amount.ToDec()).Sub(fundingPayment)
+
+	if remainingMargin.IsNegative() {
+		return nil, fmt.Errorf("failed to add margin; position has bad debt; consider adding more margin")
+	}
+
+	if err = k.BankKeeper.SendCoinsFromAccountToModule(
+		ctx,
+		/* from */ traderAddr,
+		/* to */ v2types.VaultModuleAccount,
+		/* amount */ sdk.NewCoins(margin),
+	); err != nil {
+		return nil, err
+	}
+
+	position.Margin = remainingMargin
+	position.LatestCumulativePremiumFraction = market.LatestCumulativePremiumFraction
+	position.LastUpdatedBlockNumber = ctx.BlockHeight()
+	k.Positions.Insert(ctx, collections.Join(position.Pair, traderAddr), position)
+
+	positionNotional, err := PositionNotionalSpot(amm, position)
+	if err != nil {
+		return nil, err
+	}
+
+	if !fundingPayment.IsZero() {
+		k.recordTraderHistory(ctx, traderAddr, pair, traderHistoryChange{
+			exchangedSize:     sdk.ZeroDec(),
+			exchangedNotional: sdk.ZeroDec(),
+			realizedPnl:       sdk.ZeroDec(),
+			fundingPayment:    fundingPayment,
+			fees:              sdk.ZeroDec(),
+			liquidationLoss:   sdk.ZeroDec(),
+		})
+	}
+
+	if err = ctx.EventManager().EmitTypedEvent(
+		&v2types.PositionChangedEvent{
+			Pair:               pair,
+			TraderAddress:      traderAddr.String(),
+			Margin:             sdk.NewCoin(pair.QuoteDenom(), position.Margin.RoundInt()),
+			PositionNotional:   positionNotional,
+			ExchangedNotional:  sdk.ZeroDec(),                                 // always zero when adding margin
+			ExchangedSize:      sdk.ZeroDec(),                                 // always zero when adding margin
+			TransactionFee:     sdk.NewCoin(pair.QuoteDenom(), sdk.ZeroInt()), // always zero when adding margin
+			PositionSize:       position.Size_,
+			RealizedPnl:        sdk.ZeroDec(), // always zero when adding margin
+			UnrealizedPnlAfter: UnrealizedPnl(position, positionNotional),
+			BadDebt:            sdk.NewCoin(pair.QuoteDenom(), sdk.ZeroInt()), // always zero when adding margin
+			FundingPayment:     fundingPayment,
+			BlockHeight:        ctx.BlockHeight(),
+			BlockTimeMs:        ctx.BlockTime().UnixMilli(),
+		},
+	); err != nil {
+		return nil, err
+	}
+
+	return &v2types.MsgAddMarginResponse{
+		FundingPayment: fundingPayment,
+		Position:       &position,
+	}, nil
+}
+
+// RemoveMargin further leverages an existing position by directly removing
+// the margin (collateral) that backs it from the vault. This also decreases the
+// margin ratio of the position. The funding payment accrued since the last
+// position update is settled against the margin.
+//
+// Fails if the position goes underwater, if there is not enough free
+// collateral or if the margin ratio falls below the maintenance margin ratio,
+// based on both the spot and TWAP position notional. Cross-margined positions
+// are instead limited by the free collateral of the whole account.
+//
+// args:
+//   - ctx: the cosmos-sdk context
+//   - pair: the asset pair
+//   - traderAddr: the trader's address
+//   - margin: the amount of margin to withdraw. Must be positive.
+//
+// returns:
+//   - marginOut: the amount of margin removed
+//   - fundingPayment: the funding payment that was applied with this position interaction
+//   - position: the resulting position
+//   - err: error if any
+func (k Keeper) RemoveMargin(
+	ctx sdk.Context, pair asset.Pair, traderAddr sdk.AccAddress, margin sdk.Coin,
+) (marginOut sdk.Coin, fundingPayment sdk.Dec, position v2types.Position, err error) {
+	market, err := k.Markets.Get(ctx, pair)
+	if err != nil {
+		return sdk.Coin{}, sdk.Dec{}, v2types.Position{}, v2types.ErrPairNotFound
+	}
+
+	amm, err := k.AMMs.Get(ctx, pair)
+	if err != nil {
+		return sdk.Coin{}, sdk.Dec{}, v2types.Position{}, v2types.ErrPairNotFound
+	}
+
+	if margin.Denom != market.Pair.QuoteDenom() {
+		return sdk.Coin{}, sdk.Dec{}, v2types.Position{}, fmt.Errorf(
+			"invalid margin denom, expected %s, got %s", market.Pair.QuoteDenom(), margin.Denom)
+	}
+
+	if err = k.CheckTradingHalted(ctx, pair); err != nil {
+		return sdk.Coin{}, sdk.Dec{}, v2types.Position{}, err
+	}
+
+	if k.hasLiquidationAuction(ctx, pair, traderAddr) {
+		return sdk.Coin{}, sdk.Dec{}, v2types.Position{}, v2types.ErrLiquidationAuctionInProgress.Wrapf("pair: %s", pair)
+	}
+
+	position, err = k.Positions.Get(ctx, collections.Join(pair, traderAddr))
+	if err != nil {
+		return sdk.Coin{}, sdk.Dec{}, v2types.Position{}, err
+	}
+
+	isCrossMargin := k.IsCrossMargin(ctx, traderAddr)
+	var accountFreeCollateral sdk.Dec
+	if isCrossMargin {
+		summary, _, err := k.CrossMarginAccount(ctx, traderAddr, market.Pair.QuoteDenom())
+		if err != nil {
+			return sdk.Coin{}, sdk.Dec{}, v2types.Position{}, err
+		}
+		accountFreeCollateral = summary.FreeCollateral.Sub(margin.Amount.ToDec())
+	}
+
+	fundingPayment = FundingPayment(position, market.LatestCumulativePremiumFraction)
+	remainingMargin := position.Margin.Sub(margin.Amount.ToDec()).Sub(fundingPayment)
+
+	if remainingMargin.IsNegative() {
+		return sdk.Coin{}, sdk.Dec{}, v2types.Position{}, v2types.ErrFailedRemoveMarginCanCauseBadDebt
+	}
+
+	position.Margin = remainingMargin
+	position.LatestCumulativePremiumFraction = market.LatestCumulativePremiumFraction
+	position.LastUpdatedBlockNumber = ctx.BlockHeight()
+
+	spotNotional, err := PositionNotionalSpot(amm, position)
+	if err != nil {
+		return sdk.Coin{}, sdk.Dec{}, v2types.Position{}, err
+	}
+	twapNotional, err := k.PositionNotionalTWAP(ctx, position, market.TwapLookbackWindow)
+	if err != nil {
+		return sdk.Coin{}, sdk.Dec{}, v2types.Position{}, err
+	}
+
+	if isCrossMargin {
+		// the account's free collateral backs the withdrawal
+		if !accountFreeCollateral.IsPositive() {
+			return sdk.Coin{}, sdk.Dec{}, v2types.Position{}, v2types.ErrNotEnoughFreeCollateral.Wrapf(
+				"account free collateral %s", accountFreeCollateral)
+		}
+	} else {
+		freeCollateral := sdk.MinDec(
+			FreeCollateral(position, spotNotional, market.MarginTierFor(spotNotional).MaintenanceMarginRatio),
+			FreeCollateral(position, twapNotional, market.MarginTierFor(twapNotional).MaintenanceMarginRatio),
+		)
+		if !freeCollateral.IsPositive() {
+			return sdk.Coin{}, sdk.Dec{}, v2types.Position{}, v2types.ErrNotEnoughFreeCollateral.Wrapf(
+				"free collateral %s", freeCollateral)
+		}
+
+		marginRatio := sdk.MinDec(
+			MarginRatio(position, spotNotional, market.LatestCumulativePremiumFraction),
+			MarginRatio(position, twapNotional, market.LatestCumulativePremiumFraction),
+		)
+		maintenanceMarginRatio := market.MarginTierFor(sdk.MaxDec(spotNotional, twapNotional)).MaintenanceMarginRatio
+		if marginRatio.LT(maintenanceMarginRatio) {
+			return sdk.Coin{}, sdk.Dec{}, v2types.Position{}, v2types.ErrMarginRatioTooLow
+		}
+	}
+
+	k.Positions.Insert(ctx, collections.Join(position.Pair, traderAddr), position)
+
+	if err = k.Withdraw(ctx, market, traderAddr, margin.Amount); err != nil {
+		return sdk.Coin{}, sdk.Dec{}, v2types.Position{}, err
+	}
+
+	if !fundingPayment.IsZero() {
+		k.recordTraderHistory(ctx, traderAddr, pair, traderHistoryChange{
+			exchangedSize:     sdk.ZeroDec(),
+			exchangedNotional: sdk.ZeroDec(),
+			realizedPnl:       sdk.ZeroDec(),
+			fundingPayment:    fundingPayment,
+			fees:              sdk.ZeroDec(),
+			liquidationLoss:   sdk.ZeroDec(),
+		})
+	}
+
+	if err = ctx.EventManager().EmitTypedEvent(
+		&v2types.PositionChangedEvent{
+			Pair:               pair,
+			TraderAddress:      traderAddr.String(),
+			Margin:             sdk.NewCoin(pair.QuoteDenom(), position.Margin.RoundInt()),
+			PositionNotional:   spotNotional,
+			ExchangedNotional:  sdk.ZeroDec(),                                 // always zero when removing margin
+			ExchangedSize:      sdk.ZeroDec(),                                 // always zero when removing margin
+			TransactionFee:     sdk.NewCoin(pair.QuoteDenom(), sdk.ZeroInt()), // always zero when removing margin
+			PositionSize:       position.Size_,
+			RealizedPnl:        sdk.ZeroDec(), // always zero when removing margin
+			UnrealizedPnlAfter: UnrealizedPnl(position, spotNotional),
+			BadDebt:            sdk.NewCoin(pair.QuoteDenom(), sdk.ZeroInt()), // always zero when removing margin
+			FundingPayment:     fundingPayment,
+			BlockHeight:        ctx.BlockHeight(),
+			BlockTimeMs:        ctx.BlockTime().UnixMilli(),
+		},
+	); err != nil {
+		return sdk.Coin{}, sdk.Dec{}, v2types.Position{}, err
+	}
+
+	return margin, fundingPayment, position, nil
+}
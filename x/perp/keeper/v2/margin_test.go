@@ -0,0 +1,309 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/NibiruChain/nibiru/x/common/asset"
+	"github.com/NibiruChain/nibiru/x/common/denoms"
+	"github.com/NibiruChain/nibiru/x/common/testutil"
+	. "github.com/NibiruChain/nibiru/x/common/testutil/action"
+	. "github.com/NibiruChain/nibiru/x/common/testutil/assertion"
+	. "github.com/NibiruChain/nibiru/x/perp/integration/action/v2"
+	. "github.com/NibiruChain/nibiru/x/perp/integration/assertion/v2"
+	v2types "github.com/NibiruChain/nibiru/x/perp/types/v2"
+)
+
+func TestAddMargin(t *testing.T) {
+	alice := testutil.AccAddress()
+	pairBtcUsdc := asset.Registry.Pair(denoms.BTC, denoms.USDC)
+	startBlockTime := time.Now()
+
+	tc := TestCases{
+		TC("add margin, no funding payment").
+			Given(
+				SetBlockNumber(1),
+				SetBlockTime(startBlockTime),
+				CreateCustomMarket(pairBtcUsdc),
+				InsertPosition(
+					WithPair(pairBtcUsdc),
+					WithTrader(alice),
+					WithSize(sdk.NewDec(10000)),
+					WithMargin(sdk.NewDec(1000)),
+					WithOpenNotional(sdk.NewDec(10000)),
+				),
+				FundAccount(alice, sdk.NewCoins(sdk.NewCoin(denoms.USDC, sdk.NewInt(1000)))),
+				FundModule(v2types.VaultModuleAccount, sdk.NewCoins(sdk.NewCoin(denoms.USDC, sdk.NewInt(1000)))),
+			).
+			When(
+				MoveToNextBlock(),
+				AddMargin(alice, pairBtcUsdc, sdk.NewInt(1000)),
+			).
+			Then(
+				PositionShouldBeEqual(alice, pairBtcUsdc,
+					Position_PositionShouldBeEqualTo(v2types.Position{
+						Pair:                            pairBtcUsdc,
+						TraderAddress:                   alice.String(),
+						Size_:                           sdk.NewDec(10000),
+						Margin:                          sdk.NewDec(2000),
+						OpenNotional:                    sdk.NewDec(10000),
+						LatestCumulativePremiumFraction: sdk.ZeroDec(),
+						LastUpdatedBlockNumber:          2,
+					}),
+				),
+				PositionChangedEventShouldBeEqual(&v2types.PositionChangedEvent{
+					Pair:               pairBtcUsdc,
+					TraderAddress:      alice.String(),
+					Margin:             sdk.NewCoin(denoms.USDC, sdk.NewInt(2000)),
+					PositionNotional:   sdk.MustNewDecFromStr("9999.999900000001000000"),
+					ExchangedNotional:  sdk.ZeroDec(),
+					ExchangedSize:      sdk.ZeroDec(),
+					TransactionFee:     sdk.NewCoin(denoms.USDC, sdk.ZeroInt()),
+					PositionSize:       sdk.NewDec(10000),
+					RealizedPnl:        sdk.ZeroDec(),
+					UnrealizedPnlAfter: sdk.MustNewDecFromStr("-0.000099999999000000"),
+					BadDebt:            sdk.NewCoin(denoms.USDC, sdk.ZeroInt()),
+					FundingPayment:     sdk.ZeroDec(),
+					BlockHeight:        2,
+					BlockTimeMs:        startBlockTime.Add(time.Second * 5).UnixMilli(),
+				}),
+				BalanceEqual(alice, denoms.USDC, sdk.ZeroInt()),
+				ModuleBalanceEqual(v2types.VaultModuleAccount, denoms.USDC, sdk.NewInt(2000)),
+			),
+
+		TC("add margin, settles funding payment").
+			Given(
+				SetBlockNumber(1),
+				SetBlockTime(startBlockTime),
+				CreateCustomMarket(pairBtcUsdc, WithLatestMarketCPF(sdk.MustNewDecFromStr("0.01"))),
+				InsertPosition(
+					WithPair(pairBtcUsdc),
+					WithTrader(alice),
+					WithSize(sdk.NewDec(10000)),
+					WithMargin(sdk.NewDec(1000)),
+					WithOpenNotional(sdk.NewDec(10000)),
+				),
+				FundAccount(alice, sdk.NewCoins(sdk.NewCoin(denoms.USDC, sdk.NewInt(1000)))),
+			).
+			When(
+				MoveToNextBlock(),
+				AddMargin(alice, pairBtcUsdc, sdk.NewInt(1000)),
+			).
+			Then(
+				PositionShouldBeEqual(alice, pairBtcUsdc,
+					Position_PositionShouldBeEqualTo(v2types.Position{
+						Pair:                            pairBtcUsdc,
+						TraderAddress:                   alice.String(),
+						Size_:                           sdk.NewDec(10000),
+						Margin:                          sdk.NewDec(1900),
+						OpenNotional:                    sdk.NewDec(10000),
+						LatestCumulativePremiumFraction: sdk.MustNewDecFromStr("0.01"),
+						LastUpdatedBlockNumber:          2,
+					}),
+				),
+				BalanceEqual(alice, denoms.USDC, sdk.ZeroInt()),
+			),
+
+		TC("fails with insufficient funds").
+			Given(
+				SetBlockNumber(1),
+				SetBlockTime(startBlockTime),
+				CreateCustomMarket(pairBtcUsdc),
+				InsertPosition(
+					WithPair(pairBtcUsdc),
+					WithTrader(alice),
+					WithSize(sdk.NewDec(10000)),
+					WithMargin(sdk.NewDec(1000)),
+					WithOpenNotional(sdk.NewDec(10000)),
+				),
+				FundAccount(alice, sdk.NewCoins(sdk.NewCoin(denoms.USDC, sdk.NewInt(999)))),
+			).
+			When(
+				MoveToNextBlock(),
+				AddMarginExpectingFail(alice, pairBtcUsdc, sdk.NewInt(1000)),
+			).
+			Then(
+				BalanceEqual(alice, denoms.USDC, sdk.NewInt(999)),
+			),
+
+		TC("fails with nonexistent position").
+			Given(
+				SetBlockNumber(1),
+				SetBlockTime(startBlockTime),
+				CreateCustomMarket(pairBtcUsdc),
+				FundAccount(alice, sdk.NewCoins(sdk.NewCoin(denoms.USDC, sdk.NewInt(1000)))),
+			).
+			When(
+				AddMarginExpectingFail(alice, pairBtcUsdc, sdk.NewInt(1000)),
+			).
+			Then(
+				BalanceEqual(alice, denoms.USDC, sdk.NewInt(1000)),
+			),
+	}
+
+	NewTestSuite(t).WithTestCases(tc...).Run()
+}
+
+func TestRemoveMargin(t *testing.T) {
+	alice := testutil.AccAddress()
+	pairBtcUsdc := asset.Registry.Pair(denoms.BTC, denoms.USDC)
+	startBlockTime := time.Now()
+
+	tc := TestCases{
+		TC("remove margin, no funding payment").
+			Given(
+				SetBlockNumber(1),
+				SetBlockTime(startBlockTime),
+				CreateCustomMarket(pairBtcUsdc),
+				InsertPosition(
+					WithPair(pairBtcUsdc),
+					WithTrader(alice),
+					WithSize(sdk.NewDec(10000)),
+					WithMargin(sdk.NewDec(2000)),
+					WithOpenNotional(sdk.NewDec(10000)),
+				),
+				FundModule(v2types.VaultModuleAccount, sdk.NewCoins(sdk.NewCoin(denoms.USDC, sdk.NewInt(2000)))),
+			).
+			When(
+				MoveToNextBlock(),
+				RemoveMargin(alice, pairBtcUsdc, sdk.NewInt(500)),
+			).
+			Then(
+				PositionShouldBeEqual(alice, pairBtcUsdc,
+					Position_PositionShouldBeEqualTo(v2types.Position{
+						Pair:                            pairBtcUsdc,
+						TraderAddress:                   alice.String(),
+						Size_:                           sdk.NewDec(10000),
+						Margin:                          sdk.NewDec(1500),
+						OpenNotional:                    sdk.NewDec(10000),
+						LatestCumulativePremiumFraction: sdk.ZeroDec(),
+						LastUpdatedBlockNumber:          2,
+					}),
+				),
+				PositionChangedEventShouldBeEqual(&v2types.PositionChangedEvent{
+					Pair:               pairBtcUsdc,
+					TraderAddress:      alice.String(),
+					Margin:             sdk.NewCoin(denoms.USDC, sdk.NewInt(1500)),
+					PositionNotional:   sdk.MustNewDecFromStr("9999.999900000001000000"),
+					ExchangedNotional:  sdk.ZeroDec(),
+					ExchangedSize:      sdk.ZeroDec(),
+					TransactionFee:     sdk.NewCoin(denoms.USDC, sdk.ZeroInt()),
+					PositionSize:       sdk.NewDec(10000),
+					RealizedPnl:        sdk.ZeroDec(),
+					UnrealizedPnlAfter: sdk.MustNewDecFromStr("-0.000099999999000000"),
+					BadDebt:            sdk.NewCoin(denoms.USDC, sdk.ZeroInt()),
+					FundingPayment:     sdk.ZeroDec(),
+					BlockHeight:        2,
+					BlockTimeMs:        startBlockTime.Add(time.Second * 5).UnixMilli(),
+				}),
+				BalanceEqual(alice, denoms.USDC, sdk.NewInt(500)),
+				ModuleBalanceEqual(v2types.VaultModuleAccount, denoms.USDC, sdk.NewInt(1500)),
+			),
+
+		TC("remove margin, settles funding payment").
+			Given(
+				SetBlockNumber(1),
+				SetBlockTime(startBlockTime),
+				CreateCustomMarket(pairBtcUsdc, WithLatestMarketCPF(sdk.MustNewDecFromStr("0.01"))),
+				InsertPosition(
+					WithPair(pairBtcUsdc),
+					WithTrader(alice),
+					WithSize(sdk.NewDec(10000)),
+					WithMargin(sdk.NewDec(2000)),
+					WithOpenNotional(sdk.NewDec(10000)),
+				),
+				FundModule(v2types.VaultModuleAccount, sdk.NewCoins(sdk.NewCoin(denoms.USDC, sdk.NewInt(2000)))),
+			).
+			When(
+				MoveToNextBlock(),
+				RemoveMargin(alice, pairBtcUsdc, sdk.NewInt(500)),
+			).
+			Then(
+				PositionShouldBeEqual(alice, pairBtcUsdc,
+					Position_PositionShouldBeEqualTo(v2types.Position{
+						Pair:                            pairBtcUsdc,
+						TraderAddress:                   alice.String(),
+						Size_:                           sdk.NewDec(10000),
+						Margin:                          sdk.NewDec(1400),
+						OpenNotional:                    sdk.NewDec(10000),
+						LatestCumulativePremiumFraction: sdk.MustNewDecFromStr("0.01"),
+						LastUpdatedBlockNumber:          2,
+					}),
+				),
+				BalanceEqual(alice, denoms.USDC, sdk.NewInt(500)),
+			),
+
+		TC("fails if margin would be negative").
+			Given(
+				SetBlockNumber(1),
+				SetBlockTime(startBlockTime),
+				CreateCustomMarket(pairBtcUsdc),
+				InsertPosition(
+					WithPair(pairBtcUsdc),
+					WithTrader(alice),
+					WithSize(sdk.NewDec(10000)),
+					WithMargin(sdk.NewDec(2000)),
+					WithOpenNotional(sdk.NewDec(10000)),
+				),
+				FundModule(v2types.VaultModuleAccount, sdk.NewCoins(sdk.NewCoin(denoms.USDC, sdk.NewInt(2000)))),
+			).
+			When(
+				MoveToNextBlock(),
+				RemoveMarginExpectingFail(alice, pairBtcUsdc, sdk.NewInt(2001)),
+			).
+			Then(
+				BalanceEqual(alice, denoms.USDC, sdk.ZeroInt()),
+				ModuleBalanceEqual(v2types.VaultModuleAccount, denoms.USDC, sdk.NewInt(2000)),
+			),
+
+		TC("fails if there is not enough free collateral").
+			Given(
+				SetBlockNumber(1),
+				SetBlockTime(startBlockTime),
+				CreateCustomMarket(pairBtcUsdc),
+				InsertPosition(
+					WithPair(pairBtcUsdc),
+					WithTrader(alice),
+					WithSize(sdk.NewDec(10000)),
+					WithMargin(sdk.NewDec(1000)),
+					WithOpenNotional(sdk.NewDec(10000)),
+				),
+				FundModule(v2types.VaultModuleAccount, sdk.NewCoins(sdk.NewCoin(denoms.USDC, sdk.NewInt(1000)))),
+			).
+			When(
+				MoveToNextBlock(),
+				RemoveMarginExpectingFail(alice, pairBtcUsdc, sdk.NewInt(400)),
+			).
+			Then(
+				PositionShouldBeEqual(alice, pairBtcUsdc,
+					Position_PositionShouldBeEqualTo(v2types.Position{
+						Pair:                            pairBtcUsdc,
+						TraderAddress:                   alice.String(),
+						Size_:                           sdk.NewDec(10000),
+						Margin:                          sdk.NewDec(1000),
+						OpenNotional:                    sdk.NewDec(10000),
+						LatestCumulativePremiumFraction: sdk.ZeroDec(),
+						LastUpdatedBlockNumber:          0,
+					}),
+				),
+				BalanceEqual(alice, denoms.USDC, sdk.ZeroInt()),
+			),
+
+		TC("fails with nonexistent position").
+			Given(
+				SetBlockNumber(1),
+				SetBlockTime(startBlockTime),
+				CreateCustomMarket(pairBtcUsdc),
+			).
+			When(
+				RemoveMarginExpectingFail(alice, pairBtcUsdc, sdk.NewInt(100)),
+			).
+			Then(
+				BalanceEqual(alice, denoms.USDC, sdk.ZeroInt()),
+			),
+	}
+
+	NewTestSuite(t).WithTestCases(tc...).Run()
+}
@@ -0,0 +1,129 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/NibiruChain/nibiru/x/common/asset"
+	"github.com/NibiruChain/nibiru/x/common/denoms"
+	"github.com/NibiruChain/nibiru/x/common/testutil"
+	. "github.com/NibiruChain/nibiru/x/common/testutil/action"
+	"github.com/NibiruChain/nibiru/x/common/testutil/testapp"
+	. "github.com/NibiruChain/nibiru/x/oracle/integration/action"
+	. "github.com/NibiruChain/nibiru/x/perp/integration/action/v2"
+	. "github.com/NibiruChain/nibiru/x/perp/integration/assertion/v2"
+	"github.com/NibiruChain/nibiru/x/perp/keeper/v2"
+	v2types "github.com/NibiruChain/nibiru/x/perp/types/v2"
+)
+
+var largePositionTier = v2types.MarginTier{
+	NotionalFloor:          sdk.NewDec(5000),
+	MaintenanceMarginRatio: sdk.MustNewDecFromStr("0.125"),
+	MaxLeverage:            sdk.NewDec(5),
+}
+
+func TestMarginTiers(t *testing.T) {
+	alice := testutil.AccAddress()
+	liquidator := testutil.AccAddress()
+	pairBtcUsdc := asset.Registry.Pair(denoms.BTC, denoms.USDC)
+	startTime := time.Now()
+
+	tc := TestCases{
+		TC("large positions are capped at the max leverage of their tier").
+			Given(
+				CreateCustomMarket(pairBtcUsdc, WithMarginTiers(largePositionTier)),
+				SetBlockNumber(1),
+				SetOraclePrice(pairBtcUsdc, sdk.OneDec()),
+				FundAccount(alice, sdk.NewCoins(sdk.NewCoin(denoms.USDC, sdk.NewInt(10_000)))),
+			).
+			When(
+				OpenPositionExpectingFail(alice, pairBtcUsdc, v2types.Direction_LONG, sdk.NewInt(1000), sdk.NewDec(10), sdk.ZeroDec()),
+				OpenPosition(alice, pairBtcUsdc, v2types.Direction_LONG, sdk.NewInt(400), sdk.NewDec(10), sdk.ZeroDec()),
+			).
+			Then(
+				OpenPositionExpectingFail(alice, pairBtcUsdc, v2types.Direction_LONG, sdk.NewInt(200), sdk.NewDec(10), sdk.ZeroDec()),
+				OpenPosition(alice, pairBtcUsdc, v2types.Direction_LONG, sdk.NewInt(1000), sdk.NewDec(5), sdk.ZeroDec()),
+				OpenPosition(alice, pairBtcUsdc, v2types.Direction_SHORT, sdk.NewInt(100), sdk.NewDec(10), sdk.ZeroDec()),
+			),
+
+		TC("large positions are liquidated at the maintenance margin ratio of their tier").
+			Given(
+				SetBlockNumber(1),
+				SetBlockTime(startTime),
+				CreateCustomMarket(pairBtcUsdc, WithMarginTiers(largePositionTier)),
+				InsertPosition(
+					WithPair(pairBtcUsdc),
+					WithTrader(alice),
+					WithSize(sdk.NewDec(10000)),
+					WithMargin(sdk.NewDec(1000)),
+					WithOpenNotional(sdk.NewDec(10000)),
+				),
+				FundModule(v2types.VaultModuleAccount, sdk.NewCoins(sdk.NewCoin(denoms.USDC, sdk.NewInt(1000)))),
+			).
+			When(
+				MoveToNextBlock(),
+				Liquidate(liquidator, alice, pairBtcUsdc),
+			).
+			Then(
+				PositionShouldBeEqual(alice, pairBtcUsdc,
+					Position_PositionSizeShouldBeEqualTo(sdk.NewDec(5000)),
+				),
+			),
+
+		TC("small positions use the base maintenance margin ratio").
+			Given(
+				SetBlockNumber(1),
+				SetBlockTime(startTime),
+				CreateCustomMarket(pairBtcUsdc, WithMarginTiers(largePositionTier)),
+				InsertPosition(
+					WithPair(pairBtcUsdc),
+					WithTrader(alice),
+					WithSize(sdk.NewDec(4000)),
+					WithMargin(sdk.NewDec(400)),
+					WithOpenNotional(sdk.NewDec(4000)),
+				),
+			).
+			When(
+				MoveToNextBlock(),
+				LiquidateExpectingFail(liquidator, alice, pairBtcUsdc),
+			).
+			Then(
+				PositionShouldBeEqual(alice, pairBtcUsdc,
+					Position_PositionSizeShouldBeEqualTo(sdk.NewDec(4000)),
+				),
+			),
+	}
+
+	NewTestSuite(t).WithTestCases(tc...).Run()
+}
+
+func TestQueryMarginTiers(t *testing.T) {
+	app, ctx := testapp.NewNibiruTestAppAndContext(true)
+	pairBtc := asset.Registry.Pair(denoms.BTC, denoms.USDC)
+	queryServer := keeper.NewQuerier(app.PerpKeeperV2)
+
+	ctx, err, _ := CreateCustomMarket(pairBtc, WithMarginTiers(largePositionTier)).Do(app, ctx)
+	require.NoError(t, err)
+
+	resp, err := queryServer.QueryMarginTiers(sdk.WrapSDKContext(ctx), &v2types.QueryMarginTiersRequest{Pair: pairBtc})
+	require.NoError(t, err)
+	require.Len(t, resp.MarginTiers, 2)
+	require.True(t, resp.MarginTiers[0].NotionalFloor.IsZero())
+	require.Equal(t, sdk.MustNewDecFromStr("0.0625"), resp.MarginTiers[0].MaintenanceMarginRatio)
+	require.Equal(t, largePositionTier, resp.MarginTiers[1])
+
+	t.Log("opening a position over the max leverage of its tier fails with a typed error")
+	_, err = app.PerpKeeperV2.OpenPosition(
+		ctx, pairBtc, v2types.Direction_LONG, testutil.AccAddress(), sdk.NewInt(1000), sdk.NewDec(10), sdk.ZeroDec(),
+	)
+	require.ErrorIs(t, err, v2types.ErrLeverageIsTooHigh)
+
+	t.Log("unknown market")
+	_, err = queryServer.QueryMarginTiers(sdk.WrapSDKContext(ctx), &v2types.QueryMarginTiersRequest{
+		Pair: asset.Registry.Pair(denoms.ATOM, denoms.NUSD),
+	})
+	require.Error(t, err)
+}
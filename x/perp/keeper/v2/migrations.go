@@ -0,0 +1,220 @@
+package keeper
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/NibiruChain/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+
+	"github.com/NibiruChain/nibiru/x/common/asset"
+	perpammkeeper "github.com/NibiruChain/nibiru/x/perp/amm/keeper"
+	v1keeper "github.com/NibiruChain/nibiru/x/perp/keeper/v1"
+	v2types "github.com/NibiruChain/nibiru/x/perp/types/v2"
+)
+
+// From1To2 moves the x/perp v1 and x/perp/amm state into the x/perp v2
+// collections:
+//   - every perp/amm pool and its config becomes a v2 Market and AMM, with the
+//     cumulative premium fraction of the v1 PairMetadata and the fee ratios of
+//     the v1 Params
+//   - every v1 prepaid bad debt is carried over to the first market quoting
+//     its denom
+//   - every non-zero v1 position becomes a v2 position and is removed from v1
+//   - every perp/amm reserve snapshot becomes a v2 reserve snapshot
+//
+// The v1 module is stopped once its positions have been moved, and the
+// perp/amm pools and reserve snapshots are removed so that the perp/amm end
+// blocker stops snapshotting them. Collateral does not need to move since both
+// versions share the same module accounts.
+//
+// The migration errors if the v2 invariants are broken afterwards.
+//
+// NOTE: x/perp v2 is a new module, so the upgrade handler sets its version to 1
+// in the version map for this migration to run instead of InitGenesis.
+func From1To2(k Keeper, v1Keeper v1keeper.Keeper) module.MigrationHandler {
+	return func(ctx sdk.Context) error {
+		ammKeeper, ok := v1Keeper.PerpAmmKeeper.(perpammkeeper.Keeper)
+		if !ok {
+			panic("market keeper is not perpammkeeper.Keeper")
+		}
+
+		v1Params := v1Keeper.GetParams(ctx)
+
+		// compute the open interest of every pair from the v1 positions
+		totalLong := make(map[asset.Pair]sdk.Dec)
+		totalShort := make(map[asset.Pair]sdk.Dec)
+		for _, position := range v1Keeper.Positions.Iterate(ctx, collections.PairRange[asset.Pair, sdk.AccAddress]{}).Values() {
+			if _, exists := totalLong[position.Pair]; !exists {
+				totalLong[position.Pair] = sdk.ZeroDec()
+				totalShort[position.Pair] = sdk.ZeroDec()
+			}
+			if position.Size_.IsPositive() {
+				totalLong[position.Pair] = totalLong[position.Pair].Add(position.Size_)
+			} else {
+				totalShort[position.Pair] = totalShort[position.Pair].Add(position.Size_.Neg())
+			}
+		}
+
+		// markets and amms
+		prepaidBadDebtMoved := make(map[string]bool)
+		for _, pool := range ammKeeper.Pools.Iterate(ctx, collections.Range[asset.Pair]{}).Values() {
+			latestCPF := sdk.ZeroDec()
+			if metadata, err := v1Keeper.PairsMetadata.Get(ctx, pool.Pair); err == nil {
+				latestCPF = metadata.LatestCumulativePremiumFraction
+			}
+
+			// v1 prepaid bad debt is tracked per denom, so it is moved only once
+			quoteDenom := pool.Pair.QuoteDenom()
+			prepaidBadDebt := sdk.NewCoin(quoteDenom, sdk.ZeroInt())
+			if !prepaidBadDebtMoved[quoteDenom] {
+				if badDebt, err := v1Keeper.PrepaidBadDebt.Get(ctx, quoteDenom); err == nil {
+					prepaidBadDebt.Amount = badDebt.Amount
+				}
+				prepaidBadDebtMoved[quoteDenom] = true
+			}
+
+			market := v2types.Market{
+				Pair:                            pool.Pair,
+				Enabled:                         true,
+				PriceFluctuationLimitRatio:      pool.Config.FluctuationLimitRatio,
+				MaintenanceMarginRatio:          pool.Config.MaintenanceMarginRatio,
+				MaxLeverage:                     pool.Config.MaxLeverage,
+				LatestCumulativePremiumFraction: latestCPF,
+				ExchangeFeeRatio:                v1Params.FeePoolFeeRatio,
+				EcosystemFundFeeRatio:           v1Params.EcosystemFundFeeRatio,
+				LiquidationFeeRatio:             v1Params.LiquidationFeeRatio,
+				PartialLiquidationRatio:         v1Params.PartialLiquidationRatio,
+				FundingRateEpochId:              v1Params.FundingRateInterval,
+				TwapLookbackWindow:              v1Params.TwapLookbackWindow,
+				PrepaidBadDebt:                  prepaidBadDebt,
+				InsuranceFundFeeShare:           sdk.ZeroDec(),
+				MaxFundingRate:                  sdk.ZeroDec(),
+				MinFundingRate:                  sdk.ZeroDec(),
+				InterestRate:                    sdk.ZeroDec(),
+				MaxSpreadRatio:                  sdk.ZeroDec(),
+				MaxSkewRatio:                    sdk.ZeroDec(),
+				AmmUpdateBudget:                 sdk.ZeroInt(),
+				CircuitBreakerPriceChangeRatio:  sdk.ZeroDec(),
+				MaxOpenInterest:                 sdk.ZeroDec(),
+				MaxPositionNotional:             sdk.ZeroDec(),
+				AuctionDiscountPerBlock:         sdk.ZeroDec(),
+				AuctionMaxDiscount:              sdk.ZeroDec(),
+			}
+			if !pool.Config.MaxOpenInterest.IsNil() {
+				market.MaxOpenInterest = pool.Config.MaxOpenInterest
+			}
+			if !pool.Config.MaxPositionNotional.IsNil() {
+				market.MaxPositionNotional = pool.Config.MaxPositionNotional
+			}
+			for _, tier := range pool.Config.MarginTiers {
+				market.MarginTiers = append(market.MarginTiers, v2types.MarginTier{
+					NotionalFloor:          tier.NotionalFloor,
+					MaintenanceMarginRatio: tier.MaintenanceMarginRatio,
+					MaxLeverage:            tier.MaxLeverage,
+				})
+			}
+			if err := market.Validate(); err != nil {
+				return fmt.Errorf("invalid market %s: %w", market.Pair, err)
+			}
+
+			amm := v2types.AMM{
+				Pair:            pool.Pair,
+				BaseReserve:     pool.BaseReserve,
+				QuoteReserve:    pool.QuoteReserve,
+				SqrtDepth:       pool.SqrtDepth,
+				PriceMultiplier: pool.PegMultiplier,
+				TotalLong:       sdk.ZeroDec(),
+				TotalShort:      sdk.ZeroDec(),
+			}
+			if long, exists := totalLong[pool.Pair]; exists {
+				amm.TotalLong = long
+				amm.TotalShort = totalShort[pool.Pair]
+			}
+			if err := amm.Validate(); err != nil {
+				return fmt.Errorf("invalid amm %s: %w", amm.Pair, err)
+			}
+
+			k.Markets.Insert(ctx, market.Pair, market)
+			k.AMMs.Insert(ctx, amm.Pair, amm)
+		}
+
+		// prepaid bad debt
+		for _, badDebt := range v1Keeper.PrepaidBadDebt.Iterate(ctx, collections.Range[string]{}).Values() {
+			if badDebt.Amount.IsPositive() && !prepaidBadDebtMoved[badDebt.Denom] {
+				return fmt.Errorf("prepaid bad debt of %s has no market", badDebt.Denom)
+			}
+			if err := v1Keeper.PrepaidBadDebt.Delete(ctx, badDebt.Denom); err != nil {
+				return err
+			}
+		}
+
+		// positions
+		for _, kv := range v1Keeper.Positions.Iterate(ctx, collections.PairRange[asset.Pair, sdk.AccAddress]{}).KeyValues() {
+			v1Position := kv.Value
+			if !v1Position.Size_.IsZero() {
+				position := v2types.Position{
+					TraderAddress:                   v1Position.TraderAddress,
+					Pair:                            v1Position.Pair,
+					Size_:                           v1Position.Size_,
+					Margin:                          v1Position.Margin,
+					OpenNotional:                    v1Position.OpenNotional,
+					LatestCumulativePremiumFraction: v1Position.LatestCumulativePremiumFraction,
+					LastUpdatedBlockNumber:          v1Position.BlockNumber,
+				}
+				if err := position.Validate(); err != nil {
+					return fmt.Errorf("invalid position of %s in %s: %w", position.TraderAddress, position.Pair, err)
+				}
+				k.Positions.Insert(ctx, kv.Key, position)
+				k.updateOpenInterest(ctx, position.Pair, sdk.ZeroDec(), position.Size_)
+			}
+
+			if err := v1Keeper.Positions.Delete(ctx, kv.Key); err != nil {
+				return err
+			}
+		}
+
+		// reserve snapshots
+		for _, kv := range ammKeeper.ReserveSnapshots.Iterate(ctx, collections.PairRange[asset.Pair, time.Time]{}).KeyValues() {
+			amm := v2types.AMM{
+				Pair:            kv.Value.Pair,
+				BaseReserve:     kv.Value.BaseReserve,
+				QuoteReserve:    kv.Value.QuoteReserve,
+				PriceMultiplier: kv.Value.PegMultiplier,
+				TotalLong:       sdk.ZeroDec(),
+				TotalShort:      sdk.ZeroDec(),
+			}
+			sqrtDepth, err := amm.ComputeSqrtDepth()
+			if err != nil {
+				return err
+			}
+			amm.SqrtDepth = sqrtDepth
+
+			k.ReserveSnapshots.Insert(ctx, kv.Key, v2types.ReserveSnapshot{
+				Amm:         amm,
+				TimestampMs: kv.Value.TimestampMs,
+			})
+			if err := ammKeeper.ReserveSnapshots.Delete(ctx, kv.Key); err != nil {
+				return err
+			}
+		}
+
+		// the v2 markets replace the perp/amm pools
+		for _, pair := range ammKeeper.Pools.Iterate(ctx, collections.Range[asset.Pair]{}).Keys() {
+			if err := ammKeeper.Pools.Delete(ctx, pair); err != nil {
+				return err
+			}
+		}
+
+		// stop the v1 module now that its positions live in v2
+		v1Params.Stopped = true
+		v1Keeper.SetParams(ctx, v1Params)
+
+		if msg, broken := AllInvariants(k)(ctx); broken {
+			return fmt.Errorf("x/perp v2 invariants broken after migration: %s", msg)
+		}
+
+		return nil
+	}
+}
@@ -0,0 +1,190 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/NibiruChain/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/NibiruChain/nibiru/x/common/asset"
+	"github.com/NibiruChain/nibiru/x/common/denoms"
+	"github.com/NibiruChain/nibiru/x/common/testutil"
+	"github.com/NibiruChain/nibiru/x/common/testutil/testapp"
+	perpammtypes "github.com/NibiruChain/nibiru/x/perp/amm/types"
+	"github.com/NibiruChain/nibiru/x/perp/keeper/v2"
+	types "github.com/NibiruChain/nibiru/x/perp/types/v1"
+	v2types "github.com/NibiruChain/nibiru/x/perp/types/v2"
+)
+
+func TestFrom1To2(t *testing.T) {
+	alice := testutil.AccAddress()
+	bob := testutil.AccAddress()
+	charlie := testutil.AccAddress()
+	pairBtcUsdc := asset.Registry.Pair(denoms.BTC, denoms.USDC)
+	pairEthUsdc := asset.Registry.Pair(denoms.ETH, denoms.USDC)
+
+	app, ctx := testapp.NewNibiruTestAppAndContext(true)
+
+	config := perpammtypes.MarketConfig{
+		TradeLimitRatio:        sdk.MustNewDecFromStr("0.9"),
+		FluctuationLimitRatio:  sdk.MustNewDecFromStr("0.2"),
+		MaxOracleSpreadRatio:   sdk.MustNewDecFromStr("0.2"),
+		MaintenanceMarginRatio: sdk.MustNewDecFromStr("0.0625"),
+		MaxLeverage:            sdk.NewDec(10),
+	}
+	for _, pair := range []asset.Pair{pairBtcUsdc, pairEthUsdc} {
+		require.NoError(t, app.PerpAmmKeeper.CreatePool(
+			ctx, pair, sdk.NewDec(1e12), sdk.NewDec(1e12), config, sdk.NewDec(2),
+		))
+	}
+
+	app.PerpKeeper.PairsMetadata.Insert(ctx, pairBtcUsdc, types.PairMetadata{
+		Pair:                            pairBtcUsdc,
+		LatestCumulativePremiumFraction: sdk.MustNewDecFromStr("0.01"),
+	})
+	app.PerpKeeper.PrepaidBadDebt.Insert(ctx, denoms.USDC, types.PrepaidBadDebt{
+		Denom:  denoms.USDC,
+		Amount: sdk.NewInt(1000),
+	})
+
+	v1Positions := []types.Position{
+		{
+			TraderAddress:                   alice.String(),
+			Pair:                            pairBtcUsdc,
+			Size_:                           sdk.NewDec(100),
+			Margin:                          sdk.NewDec(50),
+			OpenNotional:                    sdk.NewDec(200),
+			LatestCumulativePremiumFraction: sdk.MustNewDecFromStr("0.005"),
+			BlockNumber:                     10,
+		},
+		{
+			TraderAddress:                   bob.String(),
+			Pair:                            pairBtcUsdc,
+			Size_:                           sdk.NewDec(-40),
+			Margin:                          sdk.NewDec(20),
+			OpenNotional:                    sdk.NewDec(80),
+			LatestCumulativePremiumFraction: sdk.ZeroDec(),
+			BlockNumber:                     11,
+		},
+		{
+			// zero positions are dropped
+			TraderAddress:                   charlie.String(),
+			Pair:                            pairEthUsdc,
+			Size_:                           sdk.ZeroDec(),
+			Margin:                          sdk.ZeroDec(),
+			OpenNotional:                    sdk.ZeroDec(),
+			LatestCumulativePremiumFraction: sdk.ZeroDec(),
+			BlockNumber:                     12,
+		},
+	}
+	for _, pos := range v1Positions {
+		app.PerpKeeper.Positions.Insert(ctx, collections.Join(pos.Pair, sdk.MustAccAddressFromBech32(pos.TraderAddress)), pos)
+	}
+
+	require.NoError(t, keeper.From1To2(app.PerpKeeperV2, app.PerpKeeper)(ctx))
+
+	// markets
+	v1Params := app.PerpKeeper.GetParams(ctx)
+	btcMarket, err := app.PerpKeeperV2.Markets.Get(ctx, pairBtcUsdc)
+	require.NoError(t, err)
+	require.EqualValues(t, v2types.Market{
+		Pair:                            pairBtcUsdc,
+		Enabled:                         true,
+		PriceFluctuationLimitRatio:      config.FluctuationLimitRatio,
+		MaintenanceMarginRatio:          config.MaintenanceMarginRatio,
+		MaxLeverage:                     config.MaxLeverage,
+		LatestCumulativePremiumFraction: sdk.MustNewDecFromStr("0.01"),
+		ExchangeFeeRatio:                v1Params.FeePoolFeeRatio,
+		EcosystemFundFeeRatio:           v1Params.EcosystemFundFeeRatio,
+		LiquidationFeeRatio:             v1Params.LiquidationFeeRatio,
+		PartialLiquidationRatio:         v1Params.PartialLiquidationRatio,
+		FundingRateEpochId:              v1Params.FundingRateInterval,
+		TwapLookbackWindow:              v1Params.TwapLookbackWindow,
+		PrepaidBadDebt:                  sdk.NewCoin(denoms.USDC, sdk.NewInt(1000)),
+		InsuranceFundFeeShare:           sdk.ZeroDec(),
+		MaxFundingRate:                  sdk.ZeroDec(),
+		MinFundingRate:                  sdk.ZeroDec(),
+		InterestRate:                    sdk.ZeroDec(),
+		MaxSpreadRatio:                  sdk.ZeroDec(),
+		MaxSkewRatio:                    sdk.ZeroDec(),
+		AmmUpdateBudget:                 sdk.ZeroInt(),
+		CircuitBreakerPriceChangeRatio:  sdk.ZeroDec(),
+		MaxOpenInterest:                 sdk.ZeroDec(),
+		MaxPositionNotional:             sdk.ZeroDec(),
+		AuctionDiscountPerBlock:         sdk.ZeroDec(),
+		AuctionMaxDiscount:              sdk.ZeroDec(),
+	}, btcMarket)
+
+	ethMarket, err := app.PerpKeeperV2.Markets.Get(ctx, pairEthUsdc)
+	require.NoError(t, err)
+	require.EqualValues(t, sdk.ZeroDec(), ethMarket.LatestCumulativePremiumFraction)
+	// the denom's prepaid bad debt is carried over only once
+	require.EqualValues(t, sdk.ZeroInt(), ethMarket.PrepaidBadDebt.Amount)
+
+	// amms
+	btcAMM, err := app.PerpKeeperV2.AMMs.Get(ctx, pairBtcUsdc)
+	require.NoError(t, err)
+	require.EqualValues(t, v2types.AMM{
+		Pair:            pairBtcUsdc,
+		BaseReserve:     sdk.NewDec(1e12),
+		QuoteReserve:    sdk.NewDec(1e12),
+		SqrtDepth:       sdk.NewDec(1e12),
+		PriceMultiplier: sdk.NewDec(2),
+		TotalLong:       sdk.NewDec(100),
+		TotalShort:      sdk.NewDec(40),
+	}, btcAMM)
+
+	// positions
+	alicePosition, err := app.PerpKeeperV2.Positions.Get(ctx, collections.Join(pairBtcUsdc, alice))
+	require.NoError(t, err)
+	require.EqualValues(t, v2types.Position{
+		TraderAddress:                   alice.String(),
+		Pair:                            pairBtcUsdc,
+		Size_:                           sdk.NewDec(100),
+		Margin:                          sdk.NewDec(50),
+		OpenNotional:                    sdk.NewDec(200),
+		LatestCumulativePremiumFraction: sdk.MustNewDecFromStr("0.005"),
+		LastUpdatedBlockNumber:          10,
+	}, alicePosition)
+
+	_, err = app.PerpKeeperV2.Positions.Get(ctx, collections.Join(pairBtcUsdc, bob))
+	require.NoError(t, err)
+	_, err = app.PerpKeeperV2.Positions.Get(ctx, collections.Join(pairEthUsdc, charlie))
+	require.ErrorIs(t, err, collections.ErrNotFound)
+
+	// reserve snapshots
+	snapshot, err := app.PerpKeeperV2.ReserveSnapshots.Get(ctx, collections.Join(pairBtcUsdc, ctx.BlockTime()))
+	require.NoError(t, err)
+	require.EqualValues(t, sdk.NewDec(1e12), snapshot.Amm.SqrtDepth)
+	require.EqualValues(t, sdk.NewDec(2), snapshot.Amm.PriceMultiplier)
+	require.EqualValues(t, ctx.BlockTime().UnixMilli(), snapshot.TimestampMs)
+
+	// v1 state is moved out and the v1 module is stopped
+	require.Empty(t, app.PerpKeeper.Positions.Iterate(ctx, collections.PairRange[asset.Pair, sdk.AccAddress]{}).Keys())
+	require.Empty(t, app.PerpKeeper.PrepaidBadDebt.Iterate(ctx, collections.Range[string]{}).Keys())
+	require.True(t, app.PerpKeeper.GetParams(ctx).Stopped)
+
+	// perp/amm pools are removed
+	require.Empty(t, app.PerpAmmKeeper.Pools.Iterate(ctx, collections.Range[asset.Pair]{}).Keys())
+	require.Empty(t, app.PerpAmmKeeper.ReserveSnapshots.Iterate(ctx, collections.PairRange[asset.Pair, time.Time]{}).Keys())
+}
+
+func TestFrom1To2_PositionWithoutMarket(t *testing.T) {
+	alice := testutil.AccAddress()
+	pairBtcUsdc := asset.Registry.Pair(denoms.BTC, denoms.USDC)
+
+	app, ctx := testapp.NewNibiruTestAppAndContext(true)
+
+	app.PerpKeeper.Positions.Insert(ctx, collections.Join(pairBtcUsdc, alice), types.Position{
+		TraderAddress:                   alice.String(),
+		Pair:                            pairBtcUsdc,
+		Size_:                           sdk.NewDec(100),
+		Margin:                          sdk.NewDec(50),
+		OpenNotional:                    sdk.NewDec(200),
+		LatestCumulativePremiumFraction: sdk.ZeroDec(),
+	})
+
+	require.ErrorContains(t, keeper.From1To2(app.PerpKeeperV2, app.PerpKeeper)(ctx), "invariants broken")
+}
@@ -0,0 +1,190 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	v2types "github.com/NibiruChain/nibiru/x/perp/types/v2"
+)
+
+type msgServer struct {
+	k Keeper
+}
+
+var _ v2types.MsgServer = msgServer{}
+
+// NewMsgServerImpl returns an implementation of the MsgServer interface
+// for the provided Keeper.
+func NewMsgServerImpl(keeper Keeper) v2types.MsgServer {
+	return &msgServer{k: keeper}
+}
+
+func (m msgServer) RemoveMargin(ctx context.Context, msg *v2types.MsgRemoveMargin,
+) (*v2types.MsgRemoveMarginResponse, error) {
+	traderAddr := sdk.MustAccAddressFromBech32(msg.Sender)
+
+	marginOut, fundingPayment, position, err := m.k.RemoveMargin(
+		sdk.UnwrapSDKContext(ctx), msg.Pair, traderAddr, msg.Margin)
+	if err != nil {
+		return nil, err
+	}
+
+	return &v2types.MsgRemoveMarginResponse{
+		MarginOut:      marginOut,
+		FundingPayment: fundingPayment,
+		Position:       &position,
+	}, nil
+}
+
+func (m msgServer) AddMargin(ctx context.Context, msg *v2types.MsgAddMargin,
+) (*v2types.MsgAddMarginResponse, error) {
+	traderAddr := sdk.MustAccAddressFromBech32(msg.Sender)
+
+	return m.k.AddMargin(sdk.UnwrapSDKContext(ctx), msg.Pair, traderAddr, msg.Margin)
+}
+
+func (m msgServer) OpenPosition(goCtx context.Context, req *v2types.MsgOpenPosition,
+) (response *v2types.MsgOpenPositionResponse, err error) {
+	traderAddr := sdk.MustAccAddressFromBech32(req.Sender)
+
+	positionResp, err := m.k.OpenPosition(
+		sdk.UnwrapSDKContext(goCtx),
+		req.Pair,
+		req.Side,
+		traderAddr,
+		req.QuoteAssetAmount,
+		req.Leverage,
+		req.BaseAssetAmountLimit.ToDec(),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &v2types.MsgOpenPositionResponse{
+		Position:               positionResp.Position,
+		ExchangedNotionalValue: positionResp.ExchangedNotionalValue,
+		ExchangedPositionSize:  positionResp.ExchangedPositionSize,
+		FundingPayment:         positionResp.FundingPayment,
+		RealizedPnl:            positionResp.RealizedPnl,
+		UnrealizedPnlAfter:     positionResp.UnrealizedPnlAfter,
+		MarginToVault:          positionResp.MarginToVault,
+		PositionNotional:       positionResp.PositionNotional,
+	}, nil
+}
+
+func (m msgServer) ClosePosition(goCtx context.Context, msg *v2types.MsgClosePosition) (*v2types.MsgClosePositionResponse, error) {
+	traderAddr := sdk.MustAccAddressFromBech32(msg.Sender)
+
+	resp, err := m.k.ClosePosition(sdk.UnwrapSDKContext(goCtx), msg.Pair, traderAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	return &v2types.MsgClosePositionResponse{
+		ExchangedNotionalValue: resp.ExchangedNotionalValue,
+		ExchangedPositionSize:  resp.ExchangedPositionSize,
+		FundingPayment:         resp.FundingPayment,
+		RealizedPnl:            resp.RealizedPnl,
+		MarginToTrader:         resp.MarginToVault.Neg(),
+	}, nil
+}
+
+func (m msgServer) MultiLiquidate(goCtx context.Context, req *v2types.MsgMultiLiquidate) (*v2types.MsgMultiLiquidateResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	liquidatorAddr := sdk.MustAccAddressFromBech32(req.Sender)
+
+	resp, err := m.k.MultiLiquidate(ctx, liquidatorAddr, req.Liquidations)
+	if err != nil {
+		return nil, err
+	}
+
+	return &v2types.MsgMultiLiquidateResponse{Liquidations: resp}, nil
+}
+
+func (m msgServer) DonateToEcosystemFund(ctx context.Context, msg *v2types.MsgDonateToEcosystemFund) (*v2types.MsgDonateToEcosystemFundResponse, error) {
+	if err := m.k.BankKeeper.SendCoinsFromAccountToModule(
+		sdk.UnwrapSDKContext(ctx),
+		sdk.MustAccAddressFromBech32(msg.Sender),
+		v2types.PerpEFModuleAccount,
+		sdk.NewCoins(msg.Donation),
+	); err != nil {
+		return nil, err
+	}
+
+	return &v2types.MsgDonateToEcosystemFundResponse{}, nil
+}
+
+func (m msgServer) PlaceTriggerOrder(goCtx context.Context, msg *v2types.MsgPlaceTriggerOrder) (*v2types.MsgPlaceTriggerOrderResponse, error) {
+	order, err := m.k.PlaceTriggerOrder(sdk.UnwrapSDKContext(goCtx), v2types.TriggerOrder{
+		TraderAddress:        msg.Sender,
+		Pair:                 msg.Pair,
+		OrderType:            msg.OrderType,
+		Side:                 msg.Side,
+		TriggerPrice:         msg.TriggerPrice,
+		PriceSource:          msg.PriceSource,
+		Collateral:           msg.Collateral,
+		Leverage:             msg.Leverage,
+		BaseAssetAmountLimit: msg.BaseAssetAmountLimit,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &v2types.MsgPlaceTriggerOrderResponse{OrderId: order.Id}, nil
+}
+
+func (m msgServer) CancelTriggerOrder(goCtx context.Context, msg *v2types.MsgCancelTriggerOrder) (*v2types.MsgCancelTriggerOrderResponse, error) {
+	traderAddr := sdk.MustAccAddressFromBech32(msg.Sender)
+
+	refund, err := m.k.CancelTriggerOrder(sdk.UnwrapSDKContext(goCtx), msg.Pair, traderAddr, msg.OrderId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &v2types.MsgCancelTriggerOrderResponse{RefundedCollateral: refund}, nil
+}
+
+func (m msgServer) SetMarginMode(goCtx context.Context, msg *v2types.MsgSetMarginMode) (*v2types.MsgSetMarginModeResponse, error) {
+	traderAddr := sdk.MustAccAddressFromBech32(msg.Sender)
+
+	if err := m.k.SetMarginMode(sdk.UnwrapSDKContext(goCtx), traderAddr, msg.CrossMargin); err != nil {
+		return nil, err
+	}
+
+	return &v2types.MsgSetMarginModeResponse{}, nil
+}
+
+func (m msgServer) RegisterReferralCode(goCtx context.Context, msg *v2types.MsgRegisterReferralCode) (*v2types.MsgRegisterReferralCodeResponse, error) {
+	referrerAddr := sdk.MustAccAddressFromBech32(msg.Sender)
+
+	if err := m.k.RegisterReferralCode(sdk.UnwrapSDKContext(goCtx), referrerAddr, msg.Code); err != nil {
+		return nil, err
+	}
+
+	return &v2types.MsgRegisterReferralCodeResponse{}, nil
+}
+
+func (m msgServer) BindReferralCode(goCtx context.Context, msg *v2types.MsgBindReferralCode) (*v2types.MsgBindReferralCodeResponse, error) {
+	traderAddr := sdk.MustAccAddressFromBech32(msg.Sender)
+
+	if err := m.k.BindReferralCode(sdk.UnwrapSDKContext(goCtx), traderAddr, msg.Code); err != nil {
+		return nil, err
+	}
+
+	return &v2types.MsgBindReferralCodeResponse{}, nil
+}
+
+func (m msgServer) BidLiquidationAuction(goCtx context.Context, msg *v2types.MsgBidLiquidationAuction) (*v2types.MsgBidLiquidationAuctionResponse, error) {
+	liquidatorAddr := sdk.MustAccAddressFromBech32(msg.Sender)
+	traderAddr := sdk.MustAccAddressFromBech32(msg.Trader)
+
+	return m.k.BidLiquidationAuction(
+		sdk.UnwrapSDKContext(goCtx),
+		liquidatorAddr,
+		msg.Pair,
+		traderAddr,
+		msg.Size_,
+		msg.Margin,
+	)
+}
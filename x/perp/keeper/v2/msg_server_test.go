@@ -0,0 +1,79 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/NibiruChain/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/NibiruChain/nibiru/x/common/asset"
+	"github.com/NibiruChain/nibiru/x/common/denoms"
+	"github.com/NibiruChain/nibiru/x/common/testutil"
+	"github.com/NibiruChain/nibiru/x/common/testutil/testapp"
+	. "github.com/NibiruChain/nibiru/x/perp/integration/action/v2"
+	"github.com/NibiruChain/nibiru/x/perp/keeper/v2"
+	v2types "github.com/NibiruChain/nibiru/x/perp/types/v2"
+)
+
+func TestMsgServerOpenAndClosePosition(t *testing.T) {
+	app, ctx := testapp.NewNibiruTestAppAndContext(true)
+	ctx = ctx.WithBlockTime(time.Now()).WithBlockHeight(1)
+	pair := asset.Registry.Pair(denoms.BTC, denoms.NUSD)
+	trader := testutil.AccAddress()
+	msgServer := keeper.NewMsgServerImpl(app.PerpKeeperV2)
+
+	ctx, err, _ := CreateCustomMarket(pair).Do(app, ctx)
+	require.NoError(t, err)
+	require.NoError(t, testapp.FundAccount(app.BankKeeper, ctx, trader, sdk.NewCoins(sdk.NewInt64Coin(denoms.NUSD, 1020))))
+
+	t.Log("open position")
+	openResp, err := msgServer.OpenPosition(sdk.WrapSDKContext(ctx), &v2types.MsgOpenPosition{
+		Sender:               trader.String(),
+		Pair:                 pair,
+		Side:                 v2types.Direction_LONG,
+		QuoteAssetAmount:     sdk.NewInt(1000),
+		Leverage:             sdk.NewDec(10),
+		BaseAssetAmountLimit: sdk.ZeroInt(),
+	})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDec(10_000), openResp.ExchangedNotionalValue)
+	require.Equal(t, sdk.NewDec(1000), openResp.MarginToVault)
+	require.Equal(t, sdk.NewDec(1000), openResp.Position.Margin)
+
+	position, err := app.PerpKeeperV2.Positions.Get(ctx, collections.Join(pair, trader))
+	require.NoError(t, err)
+	require.Equal(t, openResp.Position.Size_, position.Size_)
+
+	t.Log("close position")
+	closeResp, err := msgServer.ClosePosition(sdk.WrapSDKContext(ctx), &v2types.MsgClosePosition{
+		Sender: trader.String(),
+		Pair:   pair,
+	})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDec(1000), closeResp.MarginToTrader)
+	require.Equal(t, openResp.Position.Size_.Neg(), closeResp.ExchangedPositionSize)
+
+	_, err = app.PerpKeeperV2.Positions.Get(ctx, collections.Join(pair, trader))
+	require.ErrorIs(t, err, collections.ErrNotFound)
+}
+
+func TestMsgServerDonateToEcosystemFund(t *testing.T) {
+	app, ctx := testapp.NewNibiruTestAppAndContext(true)
+	sender := testutil.AccAddress()
+	msgServer := keeper.NewMsgServerImpl(app.PerpKeeperV2)
+
+	require.NoError(t, testapp.FundAccount(app.BankKeeper, ctx, sender, sdk.NewCoins(sdk.NewInt64Coin(denoms.NUSD, 100))))
+
+	_, err := msgServer.DonateToEcosystemFund(sdk.WrapSDKContext(ctx), &v2types.MsgDonateToEcosystemFund{
+		Sender:   sender.String(),
+		Donation: sdk.NewInt64Coin(denoms.NUSD, 100),
+	})
+	require.NoError(t, err)
+	require.Equal(t,
+		sdk.NewInt64Coin(denoms.NUSD, 100),
+		app.BankKeeper.GetBalance(ctx, app.AccountKeeper.GetModuleAddress(v2types.PerpEFModuleAccount), denoms.NUSD),
+	)
+	require.True(t, app.BankKeeper.GetBalance(ctx, sender, denoms.NUSD).IsZero())
+}
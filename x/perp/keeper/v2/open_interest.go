@@ -0,0 +1,68 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/NibiruChain/nibiru/x/common/asset"
+	v2types "github.com/NibiruChain/nibiru/x/perp/types/v2"
+)
+
+// OpenInterest returns the open interest of a market, which is zero until the
+// first position is opened.
+func (k Keeper) OpenInterest(ctx sdk.Context, pair asset.Pair) v2types.OpenInterest {
+	return k.OpenInterests.GetOr(ctx, pair, v2types.ZeroOpenInterest(pair))
+}
+
+// updateOpenInterest records that a position of the market changed size from
+// oldSize to newSize.
+func (k Keeper) updateOpenInterest(ctx sdk.Context, pair asset.Pair, oldSize sdk.Dec, newSize sdk.Dec) {
+	k.OpenInterests.Insert(ctx, pair, k.OpenInterest(ctx, pair).Update(oldSize, newSize))
+}
+
+// checkPositionLimits checks that a position change keeps both sides of the
+// market under its max open interest, and the position under its max position
+// notional and within the max leverage of its margin tier. Changes that reduce
+// a side or a position are always allowed.
+//
+// args:
+//   - market: the perp market
+//   - openInterest: the open interest of the market before the change
+//   - oldSize: the size of the position before the change
+//   - positionResp: the position after the change
+//   - leverage: the leverage of the order
+//
+// returns:
+//   - err: ErrOpenInterestCapExceeded, ErrPositionNotionalCapExceeded or
+//     ErrLeverageIsTooHigh if a limit is breached
+func checkPositionLimits(
+	market v2types.Market,
+	openInterest v2types.OpenInterest,
+	oldSize sdk.Dec,
+	positionResp v2types.PositionResp,
+	leverage sdk.Dec,
+) error {
+	newSize := positionResp.Position.Size_
+	if err := openInterest.Update(oldSize, newSize).CheckCap(openInterest, market.MaxOpenInterest); err != nil {
+		return err
+	}
+
+	isReduction := newSize.IsZero() ||
+		(newSize.IsPositive() == oldSize.IsPositive() && newSize.Abs().LTE(oldSize.Abs()))
+	if isReduction {
+		return nil
+	}
+
+	if market.MaxPositionNotional.IsPositive() && positionResp.PositionNotional.GT(market.MaxPositionNotional) {
+		return v2types.ErrPositionNotionalCapExceeded.Wrapf(
+			"position notional %s, max position notional %s",
+			positionResp.PositionNotional, market.MaxPositionNotional)
+	}
+
+	if tier := market.MarginTierFor(positionResp.PositionNotional); leverage.GT(tier.MaxLeverage) {
+		return v2types.ErrLeverageIsTooHigh.Wrapf(
+			"leverage %s, max leverage %s for position notional %s",
+			leverage, tier.MaxLeverage, positionResp.PositionNotional)
+	}
+
+	return nil
+}
@@ -0,0 +1,118 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/NibiruChain/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/NibiruChain/nibiru/x/common/asset"
+	"github.com/NibiruChain/nibiru/x/common/denoms"
+	"github.com/NibiruChain/nibiru/x/common/testutil"
+	. "github.com/NibiruChain/nibiru/x/common/testutil/action"
+	"github.com/NibiruChain/nibiru/x/common/testutil/testapp"
+	. "github.com/NibiruChain/nibiru/x/oracle/integration/action"
+	. "github.com/NibiruChain/nibiru/x/perp/integration/action/v2"
+	. "github.com/NibiruChain/nibiru/x/perp/integration/assertion/v2"
+	v2types "github.com/NibiruChain/nibiru/x/perp/types/v2"
+)
+
+func TestPositionLimits(t *testing.T) {
+	alice := testutil.AccAddress()
+	bob := testutil.AccAddress()
+	pairBtcUsdc := asset.Registry.Pair(denoms.BTC, denoms.USDC)
+
+	givenMarket := func(marketModifiers ...MarketModifier) []Action {
+		return []Action{
+			CreateCustomMarket(pairBtcUsdc, marketModifiers...),
+			SetBlockNumber(1),
+			SetOraclePrice(pairBtcUsdc, sdk.OneDec()),
+			FundAccount(alice, sdk.NewCoins(sdk.NewCoin(denoms.USDC, sdk.NewInt(10_000)))),
+			FundAccount(bob, sdk.NewCoins(sdk.NewCoin(denoms.USDC, sdk.NewInt(10_000)))),
+		}
+	}
+
+	tc := TestCases{
+		TC("open interest is zero after all positions are closed").
+			Given(givenMarket()...).
+			When(
+				OpenPosition(alice, pairBtcUsdc, v2types.Direction_LONG, sdk.NewInt(1000), sdk.NewDec(10), sdk.ZeroDec()),
+				OpenPosition(bob, pairBtcUsdc, v2types.Direction_SHORT, sdk.NewInt(500), sdk.NewDec(10), sdk.ZeroDec()),
+				ClosePosition(alice, pairBtcUsdc),
+				ClosePosition(bob, pairBtcUsdc),
+			).
+			Then(
+				OpenInterestShouldBeEqual(pairBtcUsdc, sdk.ZeroDec(), sdk.ZeroDec()),
+			),
+
+		TC("max open interest caps each side of the market").
+			Given(givenMarket(WithMaxOpenInterest(sdk.NewDec(15_000)))...).
+			When(
+				OpenPosition(alice, pairBtcUsdc, v2types.Direction_LONG, sdk.NewInt(1000), sdk.NewDec(10), sdk.ZeroDec()),
+			).
+			Then(
+				OpenPositionExpectingFail(bob, pairBtcUsdc, v2types.Direction_LONG, sdk.NewInt(1000), sdk.NewDec(10), sdk.ZeroDec()),
+				OpenPosition(bob, pairBtcUsdc, v2types.Direction_SHORT, sdk.NewInt(1000), sdk.NewDec(10), sdk.ZeroDec()),
+			),
+
+		TC("reducing a side over max open interest is allowed").
+			Given(givenMarket()...).
+			When(
+				OpenPosition(alice, pairBtcUsdc, v2types.Direction_LONG, sdk.NewInt(1000), sdk.NewDec(10), sdk.ZeroDec()),
+				EditMaxOpenInterest(pairBtcUsdc, sdk.NewDec(1000)),
+			).
+			Then(
+				OpenPositionExpectingFail(bob, pairBtcUsdc, v2types.Direction_LONG, sdk.NewInt(10), sdk.OneDec(), sdk.ZeroDec()),
+				OpenPosition(alice, pairBtcUsdc, v2types.Direction_SHORT, sdk.NewInt(100), sdk.NewDec(10), sdk.ZeroDec()),
+				ClosePosition(alice, pairBtcUsdc),
+				OpenInterestShouldBeEqual(pairBtcUsdc, sdk.ZeroDec(), sdk.ZeroDec()),
+			),
+
+		TC("max position notional caps a single trader").
+			Given(givenMarket(WithMaxPositionNotional(sdk.NewDec(5000)))...).
+			When(
+				OpenPosition(alice, pairBtcUsdc, v2types.Direction_LONG, sdk.NewInt(400), sdk.NewDec(10), sdk.ZeroDec()),
+			).
+			Then(
+				OpenPositionExpectingFail(alice, pairBtcUsdc, v2types.Direction_LONG, sdk.NewInt(200), sdk.NewDec(10), sdk.ZeroDec()),
+				OpenPositionExpectingFail(bob, pairBtcUsdc, v2types.Direction_SHORT, sdk.NewInt(600), sdk.NewDec(10), sdk.ZeroDec()),
+				OpenPosition(bob, pairBtcUsdc, v2types.Direction_SHORT, sdk.NewInt(400), sdk.NewDec(10), sdk.ZeroDec()),
+				OpenPosition(alice, pairBtcUsdc, v2types.Direction_SHORT, sdk.NewInt(100), sdk.NewDec(10), sdk.ZeroDec()),
+			),
+	}
+
+	NewTestSuite(t).WithTestCases(tc...).Run()
+}
+
+func TestPositionLimitErrors(t *testing.T) {
+	app, ctx := testapp.NewNibiruTestAppAndContext(true)
+	pairBtc := asset.Registry.Pair(denoms.BTC, denoms.USDC)
+	alice := testutil.AccAddress()
+
+	ctx, err, _ := CreateCustomMarket(pairBtc,
+		WithMaxOpenInterest(sdk.NewDec(15_000)),
+		WithMaxPositionNotional(sdk.NewDec(12_000)),
+	).Do(app, ctx)
+	require.NoError(t, err)
+	ctx, err, _ = FundAccount(alice, sdk.NewCoins(sdk.NewCoin(denoms.USDC, sdk.NewInt(10_000)))).Do(app, ctx)
+	require.NoError(t, err)
+
+	t.Log("open interest tracks the position size")
+	_, err = app.PerpKeeperV2.OpenPosition(ctx, pairBtc, v2types.Direction_LONG, alice, sdk.NewInt(1000), sdk.NewDec(10), sdk.ZeroDec())
+	require.NoError(t, err)
+	position, err := app.PerpKeeperV2.Positions.Get(ctx, collections.Join(pairBtc, alice))
+	require.NoError(t, err)
+	oi := app.PerpKeeperV2.OpenInterest(ctx, pairBtc)
+	require.Equal(t, position.Size_, oi.Long)
+	require.True(t, oi.Short.IsZero())
+
+	t.Log("position notional over the cap")
+	_, err = app.PerpKeeperV2.OpenPosition(ctx, pairBtc, v2types.Direction_LONG, alice, sdk.NewInt(300), sdk.NewDec(10), sdk.ZeroDec())
+	require.ErrorIs(t, err, v2types.ErrPositionNotionalCapExceeded)
+
+	t.Log("open interest over the cap")
+	_, err = app.PerpKeeperV2.OpenPosition(ctx, pairBtc, v2types.Direction_LONG, testutil.AccAddress(), sdk.NewInt(1000), sdk.NewDec(10), sdk.ZeroDec())
+	require.ErrorIs(t, err, v2types.ErrOpenInterestCapExceeded)
+	require.Equal(t, oi, app.PerpKeeperV2.OpenInterest(ctx, pairBtc))
+}
@@ -0,0 +1,57 @@
+package keeper
+
+import (
+	"time"
+
+	"github.com/NibiruChain/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/NibiruChain/nibiru/x/common/asset"
+)
+
+// MaxPrunedReserveSnapshotsPerBlock bounds the number of reserve snapshots
+// deleted by PruneReserveSnapshots in a single block.
+const MaxPrunedReserveSnapshotsPerBlock = 100
+
+// PruneReserveSnapshots deletes the reserve snapshots older than the
+// ReserveSnapshotRetention param, at most MaxPrunedReserveSnapshotsPerBlock of
+// them. The snapshots of a market are kept for at least its twap lookback
+// window, along with the last snapshot before it since the twap starts from
+// its price. It is a no-op when the retention is zero.
+func (k Keeper) PruneReserveSnapshots(ctx sdk.Context) {
+	params := k.GetParams(ctx)
+	if params.ReserveSnapshotRetention == 0 {
+		return
+	}
+
+	var staleKeys []collections.Pair[asset.Pair, time.Time]
+	for _, market := range k.Markets.Iterate(ctx, collections.Range[asset.Pair]{}).Values() {
+		budget := MaxPrunedReserveSnapshotsPerBlock - len(staleKeys)
+		if budget <= 0 {
+			break
+		}
+
+		retention := params.ReserveSnapshotRetention
+		if retention < market.TwapLookbackWindow {
+			retention = market.TwapLookbackWindow
+		}
+
+		// read one key past the budget: the last snapshot before the cutoff is kept
+		var keys []collections.Pair[asset.Pair, time.Time]
+		iter := k.ReserveSnapshots.Iterate(ctx, collections.PairRange[asset.Pair, time.Time]{}.
+			Prefix(market.Pair).
+			EndExclusive(ctx.BlockTime().Add(-retention)))
+		for ; iter.Valid() && len(keys) <= budget; iter.Next() {
+			keys = append(keys, iter.Key())
+		}
+		iter.Close()
+
+		if len(keys) > 0 {
+			staleKeys = append(staleKeys, keys[:len(keys)-1]...)
+		}
+	}
+
+	for _, key := range staleKeys {
+		_ = k.ReserveSnapshots.Delete(ctx, key)
+	}
+}
@@ -0,0 +1,79 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/NibiruChain/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/NibiruChain/nibiru/app"
+	"github.com/NibiruChain/nibiru/x/common/asset"
+	"github.com/NibiruChain/nibiru/x/common/denoms"
+	. "github.com/NibiruChain/nibiru/x/common/testutil/action"
+	"github.com/NibiruChain/nibiru/x/common/testutil/testapp"
+	. "github.com/NibiruChain/nibiru/x/perp/integration/action/v2"
+	"github.com/NibiruChain/nibiru/x/perp/keeper/v2"
+)
+
+func TestPruneReserveSnapshots(t *testing.T) {
+	pairBtcUsdc := asset.Registry.Pair(denoms.BTC, denoms.USDC)
+	startTime := time.UnixMilli(1_000_000).UTC()
+
+	// a snapshot every minute for 200 minutes, the market twap lookback window is 30 minutes
+	setup := func(t *testing.T, retention time.Duration) (*app.NibiruApp, sdk.Context) {
+		app, ctx := testapp.NewNibiruTestAppAndContext(true)
+		actions := []Action{
+			SetBlockTime(startTime),
+			CreateCustomMarket(pairBtcUsdc),
+			SetReserveSnapshotRetention(retention),
+		}
+		for i := 0; i < 200; i++ {
+			actions = append(actions, InsertReserveSnapshot(pairBtcUsdc, startTime.Add(time.Duration(i)*time.Minute)))
+		}
+		ctx = doActions(t, app, ctx, actions...)
+		return app, ctx.WithBlockTime(startTime.Add(199 * time.Minute))
+	}
+
+	snapshotTimes := func(app *app.NibiruApp, ctx sdk.Context) (times []time.Time) {
+		rng := collections.PairRange[asset.Pair, time.Time]{}.Prefix(pairBtcUsdc)
+		for _, key := range app.PerpKeeperV2.ReserveSnapshots.Iterate(ctx, rng).Keys() {
+			times = append(times, key.K2())
+		}
+		return times
+	}
+
+	t.Run("deletions are bounded per block", func(t *testing.T) {
+		app, ctx := setup(t, time.Hour)
+
+		// the snapshots at 0..138m are older than the retention, the one at 138m is kept
+		app.PerpKeeperV2.PruneReserveSnapshots(ctx)
+		require.Len(t, snapshotTimes(app, ctx), 200-keeper.MaxPrunedReserveSnapshotsPerBlock)
+
+		app.PerpKeeperV2.PruneReserveSnapshots(ctx)
+		times := snapshotTimes(app, ctx)
+		require.Len(t, times, 62)
+		require.Equal(t, startTime.Add(138*time.Minute), times[0])
+
+		app.PerpKeeperV2.PruneReserveSnapshots(ctx)
+		require.Len(t, snapshotTimes(app, ctx), 62)
+	})
+
+	t.Run("the twap lookback window of the market is always kept", func(t *testing.T) {
+		app, ctx := setup(t, time.Minute)
+
+		app.PerpKeeperV2.PruneReserveSnapshots(ctx)
+		app.PerpKeeperV2.PruneReserveSnapshots(ctx)
+		times := snapshotTimes(app, ctx)
+		require.Len(t, times, 32)
+		require.Equal(t, startTime.Add(168*time.Minute), times[0])
+	})
+
+	t.Run("zero retention keeps every snapshot", func(t *testing.T) {
+		app, ctx := setup(t, 0)
+
+		app.PerpKeeperV2.PruneReserveSnapshots(ctx)
+		require.Len(t, snapshotTimes(app, ctx), 200)
+	})
+}
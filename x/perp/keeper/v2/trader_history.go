@@ -0,0 +1,155 @@
+package keeper
+
+import (
+	"github.com/NibiruChain/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/NibiruChain/nibiru/x/common/asset"
+	v2types "github.com/NibiruChain/nibiru/x/perp/types/v2"
+)
+
+// MaxTraderHistoryEntriesScannedPerBlock bounds the number of trader history
+// entries checked by PruneTraderHistory in a single block.
+const MaxTraderHistoryEntriesScannedPerBlock = 100
+
+type traderHistoryKeyType = collections.Pair[collections.Pair[sdk.AccAddress, asset.Pair], uint64]
+
+// ZeroTraderPnl returns an empty ledger of the trader in the market.
+func ZeroTraderPnl(trader sdk.AccAddress, pair asset.Pair) v2types.TraderPnl {
+	return v2types.TraderPnl{
+		Trader:          trader.String(),
+		Pair:            pair,
+		RealizedPnl:     sdk.ZeroDec(),
+		FundingPayment:  sdk.ZeroDec(),
+		Fees:            sdk.ZeroDec(),
+		LiquidationLoss: sdk.ZeroDec(),
+	}
+}
+
+// TraderPnl returns the cumulative ledger of the trader in the market.
+func (k Keeper) TraderPnl(ctx sdk.Context, trader sdk.AccAddress, pair asset.Pair) v2types.TraderPnl {
+	return k.TraderPnls.GetOr(ctx, collections.Join(trader, pair), ZeroTraderPnl(trader, pair))
+}
+
+// traderHistoryChange is a position change to record in the trader history.
+type traderHistoryChange struct {
+	exchangedSize     sdk.Dec
+	exchangedNotional sdk.Dec
+	realizedPnl       sdk.Dec
+	fundingPayment    sdk.Dec
+	fees              sdk.Dec
+	liquidationLoss   sdk.Dec
+}
+
+// recordTraderHistory adds a position change to the ledger of the trader in
+// the market and appends it to the trader history, if the trader history is
+// enabled. Entries of the trader in the market older than the retention are
+// pruned on the way.
+func (k Keeper) recordTraderHistory(
+	ctx sdk.Context, trader sdk.AccAddress, pair asset.Pair, change traderHistoryChange,
+) {
+	params := k.GetParams(ctx)
+	if !params.TraderHistoryEnabled {
+		return
+	}
+
+	pnl := k.TraderPnl(ctx, trader, pair)
+	pnl.RealizedPnl = pnl.RealizedPnl.Add(change.realizedPnl)
+	pnl.FundingPayment = pnl.FundingPayment.Add(change.fundingPayment)
+	pnl.Fees = pnl.Fees.Add(change.fees)
+	pnl.LiquidationLoss = pnl.LiquidationLoss.Add(change.liquidationLoss)
+	k.TraderPnls.Insert(ctx, collections.Join(trader, pair), pnl)
+
+	if params.TraderHistoryRetention > 0 {
+		k.pruneTraderHistory(ctx, trader, pair, ctx.BlockTime().Add(-params.TraderHistoryRetention).UnixMilli())
+	}
+
+	id := k.TraderHistoryID.Next(ctx)
+	k.TraderHistory.Insert(ctx, collections.Join(collections.Join(trader, pair), id), v2types.TraderHistoryEntry{
+		Id:                        id,
+		Trader:                    trader.String(),
+		Pair:                      pair,
+		ExchangedSize:             change.exchangedSize,
+		ExchangedNotional:         change.exchangedNotional,
+		RealizedPnl:               change.realizedPnl,
+		FundingPayment:            change.fundingPayment,
+		Fees:                      change.fees,
+		LiquidationLoss:           change.liquidationLoss,
+		CumulativeRealizedPnl:     pnl.RealizedPnl,
+		CumulativeFundingPayment:  pnl.FundingPayment,
+		CumulativeFees:            pnl.Fees,
+		CumulativeLiquidationLoss: pnl.LiquidationLoss,
+		BlockHeight:               ctx.BlockHeight(),
+		BlockTimeMs:               ctx.BlockTime().UnixMilli(),
+	})
+}
+
+// pruneTraderHistory deletes the history entries of the trader in the market
+// recorded before cutoffMs. Entries are ordered by time, so it stops at the
+// first entry to keep.
+func (k Keeper) pruneTraderHistory(ctx sdk.Context, trader sdk.AccAddress, pair asset.Pair, cutoffMs int64) {
+	rng := collections.PairRange[collections.Pair[sdk.AccAddress, asset.Pair], uint64]{}.
+		Prefix(collections.Join(trader, pair))
+
+	var staleKeys []collections.Pair[collections.Pair[sdk.AccAddress, asset.Pair], uint64]
+	iter := k.TraderHistory.Iterate(ctx, rng)
+	for ; iter.Valid(); iter.Next() {
+		if iter.Value().BlockTimeMs >= cutoffMs {
+			break
+		}
+		staleKeys = append(staleKeys, iter.Key())
+	}
+	iter.Close()
+
+	for _, key := range staleKeys {
+		_ = k.TraderHistory.Delete(ctx, key)
+	}
+}
+
+// PruneTraderHistory deletes the trader history entries older than the
+// TraderHistoryRetention param, checking at most
+// MaxTraderHistoryEntriesScannedPerBlock of them. Entries are checked in a
+// round-robin, resuming after the last entry checked by the previous call, so
+// the history of traders who stopped trading is pruned too. It is a no-op when
+// the trader history is disabled or the retention is zero.
+func (k Keeper) PruneTraderHistory(ctx sdk.Context) {
+	params := k.GetParams(ctx)
+	if !params.TraderHistoryEnabled || params.TraderHistoryRetention == 0 {
+		return
+	}
+	cutoffMs := ctx.BlockTime().Add(-params.TraderHistoryRetention).UnixMilli()
+
+	var entries []v2types.TraderHistoryEntry
+	collect := func(rng collections.Range[traderHistoryKeyType]) {
+		iter := k.TraderHistory.Iterate(ctx, rng)
+		defer iter.Close()
+		for ; iter.Valid() && len(entries) < MaxTraderHistoryEntriesScannedPerBlock; iter.Next() {
+			entries = append(entries, iter.Value())
+		}
+	}
+
+	cursor, err := k.TraderHistoryCursor.Get(ctx)
+	if err != nil {
+		collect(collections.Range[traderHistoryKeyType]{})
+	} else {
+		cursorKey := traderHistoryKey(cursor)
+		collect(collections.Range[traderHistoryKeyType]{}.StartExclusive(cursorKey))
+		collect(collections.Range[traderHistoryKeyType]{}.EndInclusive(cursorKey))
+	}
+
+	if len(entries) > 0 {
+		k.TraderHistoryCursor.Set(ctx, entries[len(entries)-1])
+	}
+	for _, entry := range entries {
+		if entry.BlockTimeMs < cutoffMs {
+			_ = k.TraderHistory.Delete(ctx, traderHistoryKey(entry))
+		}
+	}
+}
+
+func traderHistoryKey(entry v2types.TraderHistoryEntry) traderHistoryKeyType {
+	return collections.Join(
+		collections.Join(sdk.MustAccAddressFromBech32(entry.Trader), entry.Pair),
+		entry.Id,
+	)
+}
@@ -0,0 +1,212 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/NibiruChain/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+
+	"github.com/NibiruChain/nibiru/app"
+	"github.com/NibiruChain/nibiru/x/common/asset"
+	"github.com/NibiruChain/nibiru/x/common/denoms"
+	"github.com/NibiruChain/nibiru/x/common/testutil"
+	. "github.com/NibiruChain/nibiru/x/common/testutil/action"
+	"github.com/NibiruChain/nibiru/x/common/testutil/testapp"
+	. "github.com/NibiruChain/nibiru/x/oracle/integration/action"
+	. "github.com/NibiruChain/nibiru/x/perp/integration/action/v2"
+	"github.com/NibiruChain/nibiru/x/perp/keeper/v2"
+	v2types "github.com/NibiruChain/nibiru/x/perp/types/v2"
+)
+
+var traderHistoryRange = collections.PairRange[collections.Pair[sdk.AccAddress, asset.Pair], uint64]{}
+
+func doActions(t *testing.T, app *app.NibiruApp, ctx sdk.Context, actions ...Action) sdk.Context {
+	for _, act := range actions {
+		var err error
+		ctx, err, _ = act.Do(app, ctx)
+		require.NoError(t, err)
+	}
+	return ctx
+}
+
+func TestTraderHistory(t *testing.T) {
+	pairBtcUsdc := asset.Registry.Pair(denoms.BTC, denoms.USDC)
+	alice := testutil.AccAddress()
+	startTime := time.Now()
+
+	setup := func(t *testing.T, historyActions ...Action) (*app.NibiruApp, sdk.Context) {
+		app, ctx := testapp.NewNibiruTestAppAndContext(true)
+		ctx = doActions(t, app, ctx, append([]Action{
+			CreateCustomMarket(pairBtcUsdc),
+			SetBlockNumber(1),
+			SetBlockTime(startTime),
+			SetOraclePrice(pairBtcUsdc, sdk.OneDec()),
+			FundAccount(alice, sdk.NewCoins(sdk.NewCoin(denoms.USDC, sdk.NewInt(10_000)))),
+		}, historyActions...)...)
+		return app, ctx
+	}
+
+	t.Run("nothing is recorded while the trader history is disabled", func(t *testing.T) {
+		app, ctx := setup(t)
+		ctx = doActions(t, app, ctx,
+			OpenPosition(alice, pairBtcUsdc, v2types.Direction_LONG, sdk.NewInt(1000), sdk.NewDec(10), sdk.ZeroDec()),
+		)
+
+		require.Empty(t, app.PerpKeeperV2.TraderPnls.Iterate(ctx, collections.PairRange[sdk.AccAddress, asset.Pair]{}).Keys())
+		require.Empty(t, app.PerpKeeperV2.TraderHistory.Iterate(ctx, traderHistoryRange).Keys())
+	})
+
+	t.Run("trades are recorded in the ledger", func(t *testing.T) {
+		app, ctx := setup(t, SetTraderHistory(true, 0))
+		ctx = doActions(t, app, ctx,
+			OpenPosition(alice, pairBtcUsdc, v2types.Direction_LONG, sdk.NewInt(1000), sdk.NewDec(10), sdk.ZeroDec()),
+			MoveToNextBlock(),
+			ClosePosition(alice, pairBtcUsdc),
+		)
+
+		pnl := app.PerpKeeperV2.TraderPnl(ctx, alice, pairBtcUsdc)
+		require.Equal(t, sdk.NewDec(40), pnl.Fees)
+		require.True(t, pnl.LiquidationLoss.IsZero())
+
+		history := app.PerpKeeperV2.TraderHistory.Iterate(ctx, traderHistoryRange).Values()
+		require.Len(t, history, 2)
+		require.Equal(t, sdk.NewDec(10_000), history[0].ExchangedNotional)
+		require.Equal(t, sdk.NewDec(20), history[0].Fees)
+		require.Equal(t, sdk.NewDec(20), history[0].CumulativeFees)
+		require.True(t, history[1].ExchangedSize.IsNegative())
+		require.Equal(t, sdk.NewDec(40), history[1].CumulativeFees)
+		require.Equal(t, pnl.RealizedPnl, history[1].CumulativeRealizedPnl)
+	})
+
+	t.Run("entries older than the retention are pruned", func(t *testing.T) {
+		app, ctx := setup(t, SetTraderHistory(true, time.Hour))
+		ctx = doActions(t, app, ctx,
+			OpenPosition(alice, pairBtcUsdc, v2types.Direction_LONG, sdk.NewInt(1000), sdk.NewDec(10), sdk.ZeroDec()),
+			MoveToNextBlockWithDuration(2*time.Hour),
+			OpenPosition(alice, pairBtcUsdc, v2types.Direction_LONG, sdk.NewInt(100), sdk.NewDec(10), sdk.ZeroDec()),
+		)
+
+		history := app.PerpKeeperV2.TraderHistory.Iterate(ctx, traderHistoryRange).Values()
+		require.Len(t, history, 1)
+		require.EqualValues(t, 2, history[0].Id)
+		require.Equal(t, sdk.NewDec(22), history[0].CumulativeFees)
+		require.Equal(t, sdk.NewDec(22), app.PerpKeeperV2.TraderPnl(ctx, alice, pairBtcUsdc).Fees)
+	})
+
+	t.Run("the history of a trader who stopped trading is pruned", func(t *testing.T) {
+		app, ctx := setup(t, SetTraderHistory(true, time.Hour))
+		ctx = doActions(t, app, ctx,
+			OpenPosition(alice, pairBtcUsdc, v2types.Direction_LONG, sdk.NewInt(1000), sdk.NewDec(10), sdk.ZeroDec()),
+			MoveToNextBlockWithDuration(30*time.Minute),
+		)
+
+		app.PerpKeeperV2.PruneTraderHistory(ctx)
+		require.Len(t, app.PerpKeeperV2.TraderHistory.Iterate(ctx, traderHistoryRange).Keys(), 1)
+
+		ctx = doActions(t, app, ctx, MoveToNextBlockWithDuration(time.Hour))
+		app.PerpKeeperV2.PruneTraderHistory(ctx)
+		require.Empty(t, app.PerpKeeperV2.TraderHistory.Iterate(ctx, traderHistoryRange).Keys())
+	})
+
+	t.Run("pruning is bounded per block", func(t *testing.T) {
+		app, ctx := setup(t, SetTraderHistory(true, time.Hour))
+		for i := 0; i < 150; i++ {
+			trader := testutil.AccAddress()
+			id := app.PerpKeeperV2.TraderHistoryID.Next(ctx)
+			app.PerpKeeperV2.TraderHistory.Insert(ctx, collections.Join(collections.Join(trader, pairBtcUsdc), id), v2types.TraderHistoryEntry{
+				Id:          id,
+				Trader:      trader.String(),
+				Pair:        pairBtcUsdc,
+				BlockTimeMs: startTime.UnixMilli(),
+			})
+		}
+		ctx = doActions(t, app, ctx, MoveToNextBlockWithDuration(2*time.Hour))
+
+		app.PerpKeeperV2.PruneTraderHistory(ctx)
+		require.Len(t, app.PerpKeeperV2.TraderHistory.Iterate(ctx, traderHistoryRange).Keys(),
+			150-keeper.MaxTraderHistoryEntriesScannedPerBlock)
+
+		app.PerpKeeperV2.PruneTraderHistory(ctx)
+		require.Empty(t, app.PerpKeeperV2.TraderHistory.Iterate(ctx, traderHistoryRange).Keys())
+	})
+
+	t.Run("liquidations are recorded as liquidation losses", func(t *testing.T) {
+		app, ctx := setup(t, SetTraderHistory(true, 0))
+		ctx = doActions(t, app, ctx,
+			InsertPosition(
+				WithPair(pairBtcUsdc),
+				WithTrader(alice),
+				WithSize(sdk.NewDec(10_000)),
+				WithMargin(sdk.NewDec(500)),
+				WithOpenNotional(sdk.NewDec(10_000)),
+			),
+			FundModule(v2types.VaultModuleAccount, sdk.NewCoins(sdk.NewCoin(denoms.USDC, sdk.NewInt(500)))),
+			MoveToNextBlock(),
+			Liquidate(testutil.AccAddress(), alice, pairBtcUsdc),
+		)
+
+		pnl := app.PerpKeeperV2.TraderPnl(ctx, alice, pairBtcUsdc)
+		require.True(t, pnl.LiquidationLoss.IsPositive())
+		require.True(t, pnl.Fees.IsZero())
+	})
+}
+
+func TestQueryTraderHistory(t *testing.T) {
+	app, ctx := testapp.NewNibiruTestAppAndContext(true)
+	pairBtcUsdc := asset.Registry.Pair(denoms.BTC, denoms.USDC)
+	pairEthUsdc := asset.Registry.Pair(denoms.ETH, denoms.USDC)
+	queryServer := keeper.NewQuerier(app.PerpKeeperV2)
+	alice := testutil.AccAddress()
+
+	ctx = doActions(t, app, ctx,
+		CreateCustomMarket(pairBtcUsdc),
+		CreateCustomMarket(pairEthUsdc),
+		SetBlockNumber(1),
+		SetOraclePrice(pairBtcUsdc, sdk.OneDec()),
+		SetOraclePrice(pairEthUsdc, sdk.OneDec()),
+		SetTraderHistory(true, 0),
+		FundAccount(alice, sdk.NewCoins(sdk.NewCoin(denoms.USDC, sdk.NewInt(10_000)))),
+		OpenPosition(alice, pairBtcUsdc, v2types.Direction_LONG, sdk.NewInt(100), sdk.NewDec(10), sdk.ZeroDec()),
+		OpenPosition(alice, pairBtcUsdc, v2types.Direction_LONG, sdk.NewInt(100), sdk.NewDec(10), sdk.ZeroDec()),
+		OpenPosition(alice, pairBtcUsdc, v2types.Direction_LONG, sdk.NewInt(100), sdk.NewDec(10), sdk.ZeroDec()),
+		OpenPosition(alice, pairEthUsdc, v2types.Direction_SHORT, sdk.NewInt(100), sdk.NewDec(10), sdk.ZeroDec()),
+	)
+	goCtx := sdk.WrapSDKContext(ctx)
+
+	t.Log("history of a market, paginated")
+	resp, err := queryServer.QueryTraderHistory(goCtx, &v2types.QueryTraderHistoryRequest{
+		Trader:     alice.String(),
+		Pair:       pairBtcUsdc,
+		Pagination: &query.PageRequest{Limit: 2},
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.Pnls, 1)
+	require.Equal(t, sdk.NewDec(6), resp.Pnls[0].Fees)
+	require.Len(t, resp.History, 2)
+	require.NotNil(t, resp.Pagination.NextKey)
+
+	resp, err = queryServer.QueryTraderHistory(goCtx, &v2types.QueryTraderHistoryRequest{
+		Trader:     alice.String(),
+		Pair:       pairBtcUsdc,
+		Pagination: &query.PageRequest{Key: resp.Pagination.NextKey},
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.History, 1)
+	require.EqualValues(t, 3, resp.History[0].Id)
+
+	t.Log("history of every market")
+	resp, err = queryServer.QueryTraderHistory(goCtx, &v2types.QueryTraderHistoryRequest{Trader: alice.String()})
+	require.NoError(t, err)
+	require.Len(t, resp.Pnls, 2)
+	require.Len(t, resp.History, 4)
+
+	t.Log("unknown market")
+	_, err = queryServer.QueryTraderHistory(goCtx, &v2types.QueryTraderHistoryRequest{
+		Trader: alice.String(),
+		Pair:   asset.Registry.Pair(denoms.ATOM, denoms.NUSD),
+	})
+	require.Error(t, err)
+}
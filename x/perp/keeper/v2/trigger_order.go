@@ -0,0 +1,313 @@
+package keeper
+
+import (
+	"github.com/NibiruChain/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/NibiruChain/nibiru/x/common/asset"
+	v2types "github.com/NibiruChain/nibiru/x/perp/types/v2"
+)
+
+// MaxTriggerOrdersPerPosition is the maximum number of resting trigger orders
+// a trader can have on a pair.
+const MaxTriggerOrdersPerPosition = 10
+
+// MaxTriggerOrdersEvaluatedPerBlock is the maximum number of trigger orders
+// checked by the end blocker. Orders are checked in a round-robin, resuming
+// after the last order checked by the previous block.
+const MaxTriggerOrdersEvaluatedPerBlock = 100
+
+type triggerOrderKeyType = collections.Pair[collections.Pair[asset.Pair, sdk.AccAddress], uint64]
+
+// PlaceTriggerOrder stores a conditional order that is executed by the end
+// blocker once the price of its source crosses the trigger price.
+//
+// LIMIT_OPEN orders escrow their collateral in the vault until they are
+// executed or cancelled. STOP_LOSS and TAKE_PROFIT orders close the entire
+// position and require the trader to have one on the pair. A trader can have at
+// most MaxTriggerOrdersPerPosition orders on a pair.
+//
+// args:
+//   - ctx: the cosmos-sdk context
+//   - order: the order to place. The id and creation height are assigned here.
+//
+// returns:
+//   - placedOrder: the order as stored
+//   - err: error if any
+func (k Keeper) PlaceTriggerOrder(ctx sdk.Context, order v2types.TriggerOrder) (placedOrder v2types.TriggerOrder, err error) {
+	market, err := k.Markets.Get(ctx, order.Pair)
+	if err != nil {
+		return v2types.TriggerOrder{}, v2types.ErrPairNotFound
+	}
+
+	traderAddr, err := sdk.AccAddressFromBech32(order.TraderAddress)
+	if err != nil {
+		return v2types.TriggerOrder{}, err
+	}
+
+	switch order.OrderType {
+	case v2types.TriggerOrderType_LIMIT_OPEN:
+		if !market.Enabled {
+			return v2types.TriggerOrder{}, v2types.ErrMarketNotEnabled
+		}
+
+		if err = checkOpenPositionRequirements(market, order.Collateral.Amount, order.Leverage); err != nil {
+			return v2types.TriggerOrder{}, err
+		}
+	default:
+		if _, err = k.Positions.Get(ctx, collections.Join(order.Pair, traderAddr)); err != nil {
+			return v2types.TriggerOrder{}, err
+		}
+
+		order.Side = v2types.Direction_DIRECTION_UNSPECIFIED
+		order.Collateral = sdk.NewCoin(order.Pair.QuoteDenom(), sdk.ZeroInt())
+		order.Leverage = sdk.ZeroDec()
+		order.BaseAssetAmountLimit = sdk.ZeroDec()
+	}
+
+	restingOrders := k.TriggerOrders.Iterate(
+		ctx,
+		collections.PairRange[collections.Pair[asset.Pair, sdk.AccAddress], uint64]{}.
+			Prefix(collections.Join(order.Pair, traderAddr)),
+	).Keys()
+	if len(restingOrders) >= MaxTriggerOrdersPerPosition {
+		return v2types.TriggerOrder{}, v2types.ErrTooManyTriggerOrders.Wrapf(
+			"%s has %d orders on %s", traderAddr, len(restingOrders), order.Pair)
+	}
+
+	order.CreatedBlockHeight = ctx.BlockHeight()
+	if err = order.Validate(); err != nil {
+		return v2types.TriggerOrder{}, err
+	}
+
+	if order.Collateral.IsPositive() {
+		if err = k.BankKeeper.SendCoinsFromAccountToModule(
+			ctx,
+			/* from */ traderAddr,
+			/* to */ v2types.TriggerOrderEscrowModuleAccount,
+			/* amount */ sdk.NewCoins(order.Collateral),
+		); err != nil {
+			return v2types.TriggerOrder{}, err
+		}
+	}
+
+	order.Id = k.TriggerOrderID.Next(ctx)
+	k.TriggerOrders.Insert(ctx, triggerOrderKey(order.Pair, traderAddr, order.Id), order)
+
+	return order, nil
+}
+
+// CancelTriggerOrder removes a resting trigger order and refunds its escrowed
+// collateral to the trader.
+//
+// args:
+//   - ctx: the cosmos-sdk context
+//   - pair: the pair the order was placed on
+//   - traderAddr: the owner of the order
+//   - orderID: the id of the order
+//
+// returns:
+//   - refund: the collateral returned to the trader
+//   - err: error if any
+func (k Keeper) CancelTriggerOrder(
+	ctx sdk.Context, pair asset.Pair, traderAddr sdk.AccAddress, orderID uint64,
+) (refund sdk.Coin, err error) {
+	order, err := k.TriggerOrders.Get(ctx, triggerOrderKey(pair, traderAddr, orderID))
+	if err != nil {
+		return sdk.Coin{}, v2types.ErrTriggerOrderNotFound.Wrapf("order %d of %s on %s", orderID, traderAddr, pair)
+	}
+
+	if err = k.cancelTriggerOrder(ctx, order, "cancelled by trader"); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	return order.Collateral, nil
+}
+
+// ExecuteTriggerOrders checks up to MaxTriggerOrdersEvaluatedPerBlock resting
+// trigger orders against the current price of their source and executes the
+// ones that are triggered through the clearing house. Each execution runs in
+// its own cached context: an order whose execution fails is cancelled and its
+// collateral refunded, without affecting the other orders.
+//
+// Close orders of a position that no longer exists are cancelled. Orders whose
+// price is unavailable, e.g. because the oracle has no rate, are kept.
+func (k Keeper) ExecuteTriggerOrders(ctx sdk.Context) {
+	for _, order := range k.nextTriggerOrders(ctx, MaxTriggerOrdersEvaluatedPerBlock) {
+		traderAddr := sdk.MustAccAddressFromBech32(order.TraderAddress)
+
+		// a previous execution may have cancelled this order
+		if _, err := k.TriggerOrders.Get(ctx, triggerOrderKey(order.Pair, traderAddr, order.Id)); err != nil {
+			continue
+		}
+
+		positionSize := sdk.ZeroDec()
+		if order.OrderType != v2types.TriggerOrderType_LIMIT_OPEN {
+			position, err := k.Positions.Get(ctx, collections.Join(order.Pair, traderAddr))
+			if err != nil {
+				k.cancelTriggerOrderAndLog(ctx, order, "position does not exist")
+				continue
+			}
+			positionSize = position.Size_
+		}
+
+		price, err := k.triggerPrice(ctx, order)
+		if err != nil {
+			k.Logger(ctx).Error("failed to get trigger order price", "id", order.Id, "error", err)
+			continue
+		}
+
+		if !order.IsTriggered(price, positionSize) {
+			continue
+		}
+
+		cachedCtx, commit := ctx.CacheContext()
+		if err = k.executeTriggerOrder(cachedCtx, order, traderAddr, price); err != nil {
+			k.cancelTriggerOrderAndLog(ctx, order, err.Error())
+			continue
+		}
+		ctx.EventManager().EmitEvents(cachedCtx.EventManager().Events())
+		commit()
+	}
+}
+
+// nextTriggerOrders returns up to limit resting trigger orders, starting after
+// the cursor left by the previous call and wrapping around to the first order,
+// and moves the cursor to the last order returned.
+func (k Keeper) nextTriggerOrders(ctx sdk.Context, limit int) (orders []v2types.TriggerOrder) {
+	collect := func(rng collections.Range[triggerOrderKeyType]) {
+		iter := k.TriggerOrders.Iterate(ctx, rng)
+		defer iter.Close()
+		for ; iter.Valid() && len(orders) < limit; iter.Next() {
+			orders = append(orders, iter.Value())
+		}
+	}
+
+	cursor, err := k.TriggerOrderCursor.Get(ctx)
+	if err != nil {
+		collect(collections.Range[triggerOrderKeyType]{})
+	} else {
+		cursorKey := triggerOrderKey(cursor.Pair, sdk.MustAccAddressFromBech32(cursor.TraderAddress), cursor.Id)
+		collect(collections.Range[triggerOrderKeyType]{}.StartExclusive(cursorKey))
+		collect(collections.Range[triggerOrderKeyType]{}.EndInclusive(cursorKey))
+	}
+
+	if len(orders) > 0 {
+		k.TriggerOrderCursor.Set(ctx, orders[len(orders)-1])
+	}
+	return orders
+}
+
+// executeTriggerOrder removes the order and executes it through the clearing
+// house. Once a close order is executed, the other close orders of the
+// position are cancelled.
+func (k Keeper) executeTriggerOrder(
+	ctx sdk.Context, order v2types.TriggerOrder, traderAddr sdk.AccAddress, price sdk.Dec,
+) (err error) {
+	if err = k.TriggerOrders.Delete(ctx, triggerOrderKey(order.Pair, traderAddr, order.Id)); err != nil {
+		return err
+	}
+
+	switch order.OrderType {
+	case v2types.TriggerOrderType_LIMIT_OPEN:
+		// release the escrow, OpenPosition takes the margin from the trader
+		if err = k.BankKeeper.SendCoinsFromModuleToAccount(
+			ctx,
+			/* from */ v2types.TriggerOrderEscrowModuleAccount,
+			/* to */ traderAddr,
+			/* amount */ sdk.NewCoins(order.Collateral),
+		); err != nil {
+			return err
+		}
+
+		if _, err = k.OpenPosition(
+			ctx,
+			order.Pair,
+			order.Side,
+			traderAddr,
+			order.Collateral.Amount,
+			order.Leverage,
+			order.BaseAssetAmountLimit,
+		); err != nil {
+			return err
+		}
+	default:
+		if _, err = k.ClosePosition(ctx, order.Pair, traderAddr); err != nil {
+			return err
+		}
+
+		for _, sibling := range k.TriggerOrders.Iterate(
+			ctx,
+			collections.PairRange[collections.Pair[asset.Pair, sdk.AccAddress], uint64]{}.
+				Prefix(collections.Join(order.Pair, traderAddr)),
+		).Values() {
+			if sibling.OrderType == v2types.TriggerOrderType_LIMIT_OPEN {
+				continue
+			}
+			if err = k.cancelTriggerOrder(ctx, sibling, "position closed"); err != nil {
+				return err
+			}
+		}
+	}
+
+	return ctx.EventManager().EmitTypedEvent(&v2types.TriggerOrderExecutedEvent{
+		Order:            order,
+		TriggerPriceSeen: price,
+		BlockHeight:      ctx.BlockHeight(),
+	})
+}
+
+// cancelTriggerOrder removes the order, refunds its escrowed collateral and
+// emits a TriggerOrderCancelledEvent.
+func (k Keeper) cancelTriggerOrder(ctx sdk.Context, order v2types.TriggerOrder, reason string) (err error) {
+	traderAddr, err := sdk.AccAddressFromBech32(order.TraderAddress)
+	if err != nil {
+		return err
+	}
+
+	if order.Collateral.IsPositive() {
+		if err = k.BankKeeper.SendCoinsFromModuleToAccount(
+			ctx,
+			/* from */ v2types.TriggerOrderEscrowModuleAccount,
+			/* to */ traderAddr,
+			/* amount */ sdk.NewCoins(order.Collateral),
+		); err != nil {
+			return err
+		}
+	}
+
+	if err = k.TriggerOrders.Delete(ctx, triggerOrderKey(order.Pair, traderAddr, order.Id)); err != nil {
+		return err
+	}
+
+	return ctx.EventManager().EmitTypedEvent(&v2types.TriggerOrderCancelledEvent{
+		Order:       order,
+		Reason:      reason,
+		BlockHeight: ctx.BlockHeight(),
+	})
+}
+
+// cancelTriggerOrderAndLog cancels the order from the end blocker, where
+// errors cannot be returned.
+func (k Keeper) cancelTriggerOrderAndLog(ctx sdk.Context, order v2types.TriggerOrder, reason string) {
+	if err := k.cancelTriggerOrder(ctx, order, reason); err != nil {
+		k.Logger(ctx).Error("failed to cancel trigger order", "id", order.Id, "error", err)
+	}
+}
+
+// triggerPrice returns the current price of the order's price source.
+func (k Keeper) triggerPrice(ctx sdk.Context, order v2types.TriggerOrder) (sdk.Dec, error) {
+	if order.PriceSource == v2types.TriggerPriceSource_INDEX {
+		return k.OracleKeeper.GetExchangeRateWithMaxAge(ctx, order.Pair)
+	}
+
+	amm, err := k.AMMs.Get(ctx, order.Pair)
+	if err != nil {
+		return sdk.Dec{}, v2types.ErrPairNotFound
+	}
+	return amm.MarkPrice(), nil
+}
+
+func triggerOrderKey(pair asset.Pair, traderAddr sdk.AccAddress, orderID uint64) triggerOrderKeyType {
+	return collections.Join(collections.Join(pair, traderAddr), orderID)
+}
@@ -0,0 +1,261 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/NibiruChain/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/NibiruChain/nibiru/app"
+	"github.com/NibiruChain/nibiru/x/common/asset"
+	"github.com/NibiruChain/nibiru/x/common/denoms"
+	"github.com/NibiruChain/nibiru/x/common/testutil"
+	. "github.com/NibiruChain/nibiru/x/common/testutil/action"
+	. "github.com/NibiruChain/nibiru/x/common/testutil/assertion"
+	"github.com/NibiruChain/nibiru/x/common/testutil/testapp"
+	. "github.com/NibiruChain/nibiru/x/perp/integration/action/v2"
+	. "github.com/NibiruChain/nibiru/x/perp/integration/assertion/v2"
+	"github.com/NibiruChain/nibiru/x/perp/keeper/v2"
+	v2types "github.com/NibiruChain/nibiru/x/perp/types/v2"
+)
+
+func TestLimitOrder(t *testing.T) {
+	alice := testutil.AccAddress()
+	pairBtcUsdc := asset.Registry.Pair(denoms.BTC, denoms.USDC)
+	startTime := time.Now()
+
+	tc := TestCases{
+		TC("limit order escrows collateral until triggered").
+			Given(
+				SetBlockNumber(1),
+				SetBlockTime(startTime),
+				CreateCustomMarket(pairBtcUsdc),
+				FundAccount(alice, sdk.NewCoins(sdk.NewCoin(denoms.USDC, sdk.NewInt(1002)))),
+			).
+			When(
+				PlaceLimitOrder(alice, pairBtcUsdc, v2types.Direction_LONG, sdk.MustNewDecFromStr("0.5"), sdk.NewInt(1000), sdk.OneDec()),
+				ExecuteTriggerOrders(),
+			).
+			Then(
+				TriggerOrderShouldExist(alice, pairBtcUsdc, 1),
+				PositionShouldNotExist(alice, pairBtcUsdc),
+				BalanceEqual(alice, denoms.USDC, sdk.NewInt(2)),
+				ModuleBalanceEqual(v2types.TriggerOrderEscrowModuleAccount, denoms.USDC, sdk.NewInt(1000)),
+				ModuleBalanceEqual(v2types.VaultModuleAccount, denoms.USDC, sdk.ZeroInt()),
+			),
+
+		TC("limit order opens a position once triggered").
+			Given(
+				SetBlockNumber(1),
+				SetBlockTime(startTime),
+				CreateCustomMarket(pairBtcUsdc),
+				FundAccount(alice, sdk.NewCoins(sdk.NewCoin(denoms.USDC, sdk.NewInt(1002)))),
+			).
+			When(
+				PlaceLimitOrder(alice, pairBtcUsdc, v2types.Direction_LONG, sdk.OneDec(), sdk.NewInt(1000), sdk.OneDec()),
+				ExecuteTriggerOrders(),
+			).
+			Then(
+				TriggerOrderShouldNotExist(alice, pairBtcUsdc, 1),
+				PositionShouldBeEqual(alice, pairBtcUsdc,
+					Position_PositionSizeShouldBeEqualTo(sdk.MustNewDecFromStr("999.999999000000001000")),
+				),
+				BalanceEqual(alice, denoms.USDC, sdk.ZeroInt()),
+				ModuleBalanceEqual(v2types.TriggerOrderEscrowModuleAccount, denoms.USDC, sdk.ZeroInt()),
+				ModuleBalanceEqual(v2types.VaultModuleAccount, denoms.USDC, sdk.NewInt(1000)),
+			),
+
+		TC("limit order is cancelled and refunded when its execution fails").
+			Given(
+				SetBlockNumber(1),
+				SetBlockTime(startTime),
+				CreateCustomMarket(pairBtcUsdc),
+				// not enough funds to pay the exchange fees
+				FundAccount(alice, sdk.NewCoins(sdk.NewCoin(denoms.USDC, sdk.NewInt(1000)))),
+			).
+			When(
+				PlaceLimitOrder(alice, pairBtcUsdc, v2types.Direction_SHORT, sdk.OneDec(), sdk.NewInt(1000), sdk.OneDec()),
+				ExecuteTriggerOrders(),
+			).
+			Then(
+				TriggerOrderShouldNotExist(alice, pairBtcUsdc, 1),
+				PositionShouldNotExist(alice, pairBtcUsdc),
+				BalanceEqual(alice, denoms.USDC, sdk.NewInt(1000)),
+				ModuleBalanceEqual(v2types.TriggerOrderEscrowModuleAccount, denoms.USDC, sdk.ZeroInt()),
+			),
+
+		TC("cancelled limit order refunds its collateral").
+			Given(
+				SetBlockNumber(1),
+				SetBlockTime(startTime),
+				CreateCustomMarket(pairBtcUsdc),
+				FundAccount(alice, sdk.NewCoins(sdk.NewCoin(denoms.USDC, sdk.NewInt(1000)))),
+				PlaceLimitOrder(alice, pairBtcUsdc, v2types.Direction_LONG, sdk.MustNewDecFromStr("0.5"), sdk.NewInt(1000), sdk.OneDec()),
+			).
+			When(
+				CancelTriggerOrder(alice, pairBtcUsdc, 1),
+			).
+			Then(
+				TriggerOrderShouldNotExist(alice, pairBtcUsdc, 1),
+				BalanceEqual(alice, denoms.USDC, sdk.NewInt(1000)),
+				ModuleBalanceEqual(v2types.TriggerOrderEscrowModuleAccount, denoms.USDC, sdk.ZeroInt()),
+			),
+	}
+
+	NewTestSuite(t).WithTestCases(tc...).Run()
+}
+
+func TestCloseOrders(t *testing.T) {
+	alice := testutil.AccAddress()
+	pairBtcUsdc := asset.Registry.Pair(denoms.BTC, denoms.USDC)
+	startTime := time.Now()
+
+	givenLongPosition := []Action{
+		SetBlockNumber(1),
+		SetBlockTime(startTime),
+		CreateCustomMarket(pairBtcUsdc),
+		InsertPosition(
+			WithPair(pairBtcUsdc),
+			WithTrader(alice),
+			WithSize(sdk.NewDec(10000)),
+			WithMargin(sdk.NewDec(1000)),
+			WithOpenNotional(sdk.NewDec(10000)),
+		),
+		FundModule(v2types.VaultModuleAccount, sdk.NewCoins(sdk.NewCoin(denoms.USDC, sdk.NewInt(1000)))),
+		FundAccount(alice, sdk.NewCoins(sdk.NewCoin(denoms.USDC, sdk.NewInt(20)))),
+	}
+
+	tc := TestCases{
+		TC("stop loss closes the position and cancels the take profit").
+			Given(givenLongPosition...).
+			When(
+				PlaceCloseOrder(alice, pairBtcUsdc, v2types.TriggerOrderType_STOP_LOSS, sdk.OneDec(), v2types.TriggerPriceSource_MARK),
+				PlaceCloseOrder(alice, pairBtcUsdc, v2types.TriggerOrderType_TAKE_PROFIT, sdk.NewDec(2), v2types.TriggerPriceSource_MARK),
+				ExecuteTriggerOrders(),
+			).
+			Then(
+				PositionShouldNotExist(alice, pairBtcUsdc),
+				TriggerOrderShouldNotExist(alice, pairBtcUsdc, 1),
+				TriggerOrderShouldNotExist(alice, pairBtcUsdc, 2),
+			),
+
+		TC("take profit rests until the price is reached").
+			Given(givenLongPosition...).
+			When(
+				PlaceCloseOrder(alice, pairBtcUsdc, v2types.TriggerOrderType_TAKE_PROFIT, sdk.NewDec(2), v2types.TriggerPriceSource_MARK),
+				ExecuteTriggerOrders(),
+			).
+			Then(
+				PositionShouldBeEqual(alice, pairBtcUsdc,
+					Position_PositionSizeShouldBeEqualTo(sdk.NewDec(10000)),
+				),
+				TriggerOrderShouldExist(alice, pairBtcUsdc, 1),
+			),
+
+		TC("close orders are dropped once the position is closed").
+			Given(givenLongPosition...).
+			When(
+				PlaceCloseOrder(alice, pairBtcUsdc, v2types.TriggerOrderType_TAKE_PROFIT, sdk.NewDec(2), v2types.TriggerPriceSource_MARK),
+				ClosePosition(alice, pairBtcUsdc),
+				ExecuteTriggerOrders(),
+			).
+			Then(
+				TriggerOrderShouldNotExist(alice, pairBtcUsdc, 1),
+			),
+
+		TC("orders on the index price rest while there is no oracle price").
+			Given(givenLongPosition...).
+			When(
+				PlaceCloseOrder(alice, pairBtcUsdc, v2types.TriggerOrderType_STOP_LOSS, sdk.NewDec(2), v2types.TriggerPriceSource_INDEX),
+				ExecuteTriggerOrders(),
+			).
+			Then(
+				PositionShouldBeEqual(alice, pairBtcUsdc,
+					Position_PositionSizeShouldBeEqualTo(sdk.NewDec(10000)),
+				),
+				TriggerOrderShouldExist(alice, pairBtcUsdc, 1),
+			),
+
+		TC("close order requires a position").
+			Given(
+				SetBlockNumber(1),
+				SetBlockTime(startTime),
+				CreateCustomMarket(pairBtcUsdc),
+			).
+			When(
+				PlaceCloseOrderExpectingFail(alice, pairBtcUsdc, v2types.TriggerOrderType_STOP_LOSS, sdk.OneDec()),
+			).
+			Then(
+				TriggerOrderShouldNotExist(alice, pairBtcUsdc, 1),
+			),
+	}
+
+	NewTestSuite(t).WithTestCases(tc...).Run()
+}
+
+func TestTriggerOrderLimits(t *testing.T) {
+	pairBtcUsdc := asset.Registry.Pair(denoms.BTC, denoms.USDC)
+
+	setup := func(t *testing.T) (*app.NibiruApp, sdk.Context) {
+		app, ctx := testapp.NewNibiruTestAppAndContext(true)
+		ctx = ctx.WithBlockTime(time.Now()).WithBlockHeight(1)
+		ctx, err, _ := CreateCustomMarket(pairBtcUsdc).Do(app, ctx)
+		require.NoError(t, err)
+		return app, ctx
+	}
+
+	stopLoss := func(trader sdk.AccAddress, id uint64) v2types.TriggerOrder {
+		return v2types.TriggerOrder{
+			Id:                   id,
+			TraderAddress:        trader.String(),
+			Pair:                 pairBtcUsdc,
+			OrderType:            v2types.TriggerOrderType_STOP_LOSS,
+			TriggerPrice:         sdk.OneDec(),
+			PriceSource:          v2types.TriggerPriceSource_MARK,
+			Collateral:           sdk.NewCoin(denoms.USDC, sdk.ZeroInt()),
+			Leverage:             sdk.ZeroDec(),
+			BaseAssetAmountLimit: sdk.ZeroDec(),
+		}
+	}
+
+	t.Run("orders per position are capped", func(t *testing.T) {
+		app, ctx := setup(t)
+		alice := testutil.AccAddress()
+		_, err, _ := InsertPosition(WithPair(pairBtcUsdc), WithTrader(alice)).Do(app, ctx)
+		require.NoError(t, err)
+
+		for i := 0; i < keeper.MaxTriggerOrdersPerPosition; i++ {
+			_, err = app.PerpKeeperV2.PlaceTriggerOrder(ctx, stopLoss(alice, 0))
+			require.NoError(t, err)
+		}
+		_, err = app.PerpKeeperV2.PlaceTriggerOrder(ctx, stopLoss(alice, 0))
+		require.ErrorIs(t, err, v2types.ErrTooManyTriggerOrders)
+	})
+
+	t.Run("orders evaluated per block are bounded", func(t *testing.T) {
+		app, ctx := setup(t)
+		numOrders := keeper.MaxTriggerOrdersEvaluatedPerBlock * 3 / 2
+		for i := 0; i < numOrders; i++ {
+			// the traders have no position, so their orders are cancelled once checked
+			trader := testutil.AccAddress()
+			app.PerpKeeperV2.TriggerOrders.Insert(ctx,
+				collections.Join(collections.Join(pairBtcUsdc, trader), uint64(i)),
+				stopLoss(trader, uint64(i)),
+			)
+		}
+
+		countOrders := func() int {
+			return len(app.PerpKeeperV2.TriggerOrders.Iterate(
+				ctx, collections.PairRange[collections.Pair[asset.Pair, sdk.AccAddress], uint64]{},
+			).Keys())
+		}
+
+		app.PerpKeeperV2.ExecuteTriggerOrders(ctx)
+		require.Equal(t, numOrders-keeper.MaxTriggerOrdersEvaluatedPerBlock, countOrders())
+
+		app.PerpKeeperV2.ExecuteTriggerOrders(ctx)
+		require.Zero(t, countOrders())
+	})
+}
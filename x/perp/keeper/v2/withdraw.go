@@ -78,6 +78,87 @@ func (k Keeper) Withdraw(
 	)
 }
 
+// realizeBadDebt realizes bad debt of a market against its prepaid bad debt.
+// If the prepaid bad debt does not cover it, the prepaid bad debt is zeroed
+// out and the shortage is drawn from the market's insurance fund, then from
+// the PerpEF, into the vault. If auto-deleveraging is enabled for the market,
+// the part the PerpEF cannot cover is taken out of the profitable positions on
+// the other side of the bankrupt position.
+//
+// args:
+// - ctx: context
+// - market: the perp market
+// - badDebtToRealize: amount of bad debt to realize, in quote units
+// - bankruptPosition: the position the bad debt comes from
+// - bankruptcyPrice: the bankruptcy price of the bankrupt position
+//
+// returns:
+// - error: error
+func (k Keeper) realizeBadDebt(
+	ctx sdk.Context,
+	market v2types.Market,
+	badDebtToRealize sdk.Int,
+	bankruptPosition v2types.Position,
+	bankruptcyPrice sdk.Dec,
+) (err error) {
+	if market.PrepaidBadDebt.Amount.GTE(badDebtToRealize) {
+		// prepaidBadDebtBalance > totalBadDebt
+		k.DecrementPrepaidBadDebt(ctx, market, badDebtToRealize)
+	} else {
+		// totalBadDebt > prepaidBadDebtBalance
+		prepaidBadDebtBalance := market.PrepaidBadDebt.Amount
+		k.ZeroPrepaidBadDebt(ctx, market)
+		// withdrawals made while deleveraging must see the zeroed prepaid bad debt
+		if market, err = k.Markets.Get(ctx, market.Pair); err != nil {
+			return err
+		}
+
+		shortage := badDebtToRealize.Sub(prepaidBadDebtBalance)
+		coveredByInsuranceFund, err := k.drawDownInsuranceFund(ctx, market, shortage)
+		if err != nil {
+			return err
+		}
+
+		shortage = shortage.Sub(coveredByInsuranceFund)
+		if !shortage.IsPositive() {
+			return nil
+		}
+
+		if market.AutoDeleveragingEnabled {
+			perpEFBalance := k.BankKeeper.GetBalance(
+				ctx,
+				k.AccountKeeper.GetModuleAddress(types.PerpEFModuleAccount),
+				market.Pair.QuoteDenom(),
+			)
+			if perpEFBalance.Amount.LT(shortage) {
+				coveredByDeleveraging, err := k.autoDeleverage(
+					ctx, market, bankruptPosition, bankruptcyPrice, shortage.Sub(perpEFBalance.Amount))
+				if err != nil {
+					return err
+				}
+
+				shortage = shortage.Sub(coveredByDeleveraging)
+				if !shortage.IsPositive() {
+					return nil
+				}
+			}
+		}
+
+		return k.BankKeeper.SendCoinsFromModuleToModule(ctx,
+			/*from=*/ types.PerpEFModuleAccount,
+			/*to=*/ types.VaultModuleAccount,
+			sdk.NewCoins(
+				sdk.NewCoin(
+					market.Pair.QuoteDenom(),
+					shortage,
+				),
+			),
+		)
+	}
+
+	return nil
+}
+
 // IncrementPrepaidBadDebt increases the bad debt for the provided denom.
 func (k Keeper) IncrementPrepaidBadDebt(ctx sdk.Context, market v2types.Market, amount sdk.Int) {
 	market.PrepaidBadDebt.Amount = market.PrepaidBadDebt.Amount.Add(amount)
@@ -158,6 +158,10 @@ func (am AppModule) RegisterServices(cfg module.Configurator) {
 	if err != nil {
 		panic(fmt.Errorf("failed to register migration: %w", err))
 	}
+	err = cfg.RegisterMigration(types.ModuleName, 3, keeper.From3To4(am.keeper)) // From 3 to 4
+	if err != nil {
+		panic(fmt.Errorf("failed to register migration: %w", err))
+	}
 }
 
 // RegisterInvariants registers the capability module's invariants.
@@ -188,7 +192,7 @@ func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.Raw
 }
 
 // ConsensusVersion implements ConsensusVersion.
-func (AppModule) ConsensusVersion() uint64 { return 3 }
+func (AppModule) ConsensusVersion() uint64 { return 4 }
 
 // BeginBlock executes all ABCI BeginBlock logic respective to the capability module.
 func (am AppModule) BeginBlock(_ sdk.Context, _ abci.RequestBeginBlock) {}
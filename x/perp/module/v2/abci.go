@@ -0,0 +1,37 @@
+package perp
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/NibiruChain/collections"
+
+	"github.com/NibiruChain/nibiru/x/common/asset"
+	"github.com/NibiruChain/nibiru/x/perp/keeper/v2"
+	v2types "github.com/NibiruChain/nibiru/x/perp/types/v2"
+)
+
+// EndBlocker Called every block to execute the triggered trigger orders, end
+// the liquidation auctions that are over, update the circuit breaker of each
+// market, store a snapshot of each AMM, prune the old snapshots and prune the
+// old trader history entries.
+func EndBlocker(ctx sdk.Context, k keeper.Keeper) []abci.ValidatorUpdate {
+	k.ExecuteTriggerOrders(ctx)
+	k.ExecuteLiquidationAuctions(ctx)
+
+	for _, amm := range k.AMMs.Iterate(ctx, collections.Range[asset.Pair]{}).Values() {
+		// the circuit breaker compares the AMM with the previous snapshot
+		if market, err := k.Markets.Get(ctx, amm.Pair); err == nil {
+			k.UpdateCircuitBreaker(ctx, market, amm)
+		}
+
+		snapshot := v2types.ReserveSnapshot{
+			Amm:         amm,
+			TimestampMs: ctx.BlockTime().UnixMilli(),
+		}
+		k.ReserveSnapshots.Insert(ctx, collections.Join(amm.Pair, ctx.BlockTime()), snapshot)
+	}
+	k.PruneReserveSnapshots(ctx)
+	k.PruneTraderHistory(ctx)
+	return []abci.ValidatorUpdate{}
+}
@@ -0,0 +1,41 @@
+package perp_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/NibiruChain/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/NibiruChain/nibiru/x/common/asset"
+	"github.com/NibiruChain/nibiru/x/common/denoms"
+	"github.com/NibiruChain/nibiru/x/common/testutil/mock"
+	"github.com/NibiruChain/nibiru/x/common/testutil/testapp"
+	perp "github.com/NibiruChain/nibiru/x/perp/module/v2"
+	v2types "github.com/NibiruChain/nibiru/x/perp/types/v2"
+)
+
+func TestSnapshotUpdates(t *testing.T) {
+	app, ctx := testapp.NewNibiruTestAppAndContext(true)
+	ctx = ctx.WithBlockTime(time.Date(2015, 10, 21, 0, 0, 0, 0, time.UTC)).WithBlockHeight(1)
+
+	pair := asset.Registry.Pair(denoms.BTC, denoms.NUSD)
+	amm := *mock.TestAMMDefault()
+	app.PerpKeeperV2.AMMs.Insert(ctx, pair, amm)
+
+	t.Log("run one block")
+	perp.EndBlocker(ctx, app.PerpKeeperV2)
+	snapshot, err := app.PerpKeeperV2.ReserveSnapshots.Get(ctx, collections.Join(pair, ctx.BlockTime()))
+	require.NoError(t, err)
+	require.Equal(t, v2types.ReserveSnapshot{Amm: amm, TimestampMs: ctx.BlockTime().UnixMilli()}, snapshot)
+
+	t.Log("affect reserves and run another block")
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(5 * time.Second)).WithBlockHeight(2)
+	amm.BaseReserve = amm.BaseReserve.Sub(sdk.NewDec(1e6))
+	app.PerpKeeperV2.AMMs.Insert(ctx, pair, amm)
+	perp.EndBlocker(ctx, app.PerpKeeperV2)
+	snapshot, err = app.PerpKeeperV2.ReserveSnapshots.Get(ctx, collections.Join(pair, ctx.BlockTime()))
+	require.NoError(t, err)
+	require.Equal(t, amm, snapshot.Amm)
+}
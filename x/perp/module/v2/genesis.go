@@ -0,0 +1,158 @@
+package perp
+
+import (
+	"time"
+
+	"github.com/NibiruChain/collections"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/NibiruChain/nibiru/x/common/asset"
+	"github.com/NibiruChain/nibiru/x/perp/keeper/v2"
+	v2types "github.com/NibiruChain/nibiru/x/perp/types/v2"
+)
+
+// InitGenesis initializes the capability module's state from a provided genesis
+// state.
+func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState v2types.GenesisState) {
+	if err := genState.Validate(); err != nil {
+		panic(err)
+	}
+
+	k.SetParams(ctx, genState.Params)
+
+	for _, m := range genState.Markets {
+		k.Markets.Insert(ctx, m.Pair, m)
+	}
+
+	for _, amm := range genState.Amms {
+		k.AMMs.Insert(ctx, amm.Pair, amm)
+	}
+
+	for _, p := range genState.Positions {
+		k.Positions.Insert(ctx, collections.Join(p.Pair, sdk.MustAccAddressFromBech32(p.TraderAddress)), p)
+		// open interest isn't exported, it's derived from the positions
+		k.OpenInterests.Insert(ctx, p.Pair, k.OpenInterest(ctx, p.Pair).Update(sdk.ZeroDec(), p.Size_))
+	}
+
+	for _, s := range genState.ReserveSnapshots {
+		k.ReserveSnapshots.Insert(ctx, collections.Join(s.Amm.Pair, time.UnixMilli(s.TimestampMs)), s)
+	}
+
+	// set the next order ID after the highest one in genesis
+	var lastOrderID uint64
+	for _, o := range genState.TriggerOrders {
+		trader := sdk.MustAccAddressFromBech32(o.TraderAddress)
+		k.TriggerOrders.Insert(ctx, collections.Join(collections.Join(o.Pair, trader), o.Id), o)
+		if o.Id > lastOrderID {
+			lastOrderID = o.Id
+		}
+	}
+	if len(genState.TriggerOrders) != 0 {
+		k.TriggerOrderID.Set(ctx, lastOrderID+1)
+	}
+
+	for _, trader := range genState.CrossMarginTraders {
+		k.CrossMarginTraders.Insert(ctx, sdk.MustAccAddressFromBech32(trader))
+	}
+
+	for _, fund := range genState.InsuranceFunds {
+		k.InsuranceFunds.Insert(ctx, fund.Pair, fund)
+	}
+
+	// set the next draw-down ID after the highest one in genesis
+	var lastDrawDownID uint64
+	for _, d := range genState.InsuranceFundDrawDowns {
+		k.InsuranceFundDrawDowns.Insert(ctx, collections.Join(d.Pair, d.Id), d)
+		if d.Id > lastDrawDownID {
+			lastDrawDownID = d.Id
+		}
+	}
+	if len(genState.InsuranceFundDrawDowns) != 0 {
+		k.InsuranceFundDrawDownID.Set(ctx, lastDrawDownID+1)
+	}
+
+	for _, f := range genState.FundingRates {
+		k.FundingRates.Insert(ctx, collections.Join(f.Pair, time.UnixMilli(f.BlockTimeMs)), f)
+	}
+
+	for _, b := range genState.CircuitBreakers {
+		k.CircuitBreakers.Insert(ctx, b.Pair, b)
+	}
+
+	for _, v := range genState.TraderVolumes {
+		k.TraderVolumes.Insert(ctx, collections.Join(sdk.MustAccAddressFromBech32(v.Trader), v.Day), v.Volume)
+	}
+
+	for _, c := range genState.ReferralCodes {
+		k.ReferralCodes.Insert(ctx, c.Code, c)
+	}
+
+	for _, r := range genState.Referrals {
+		k.Referrals.Insert(ctx, sdk.MustAccAddressFromBech32(r.Referee), r)
+	}
+
+	for _, r := range genState.ReferralRebates {
+		k.ReferralRebates.Insert(ctx, collections.Join(sdk.MustAccAddressFromBech32(r.Referrer), r.Rebate.Denom), r.Rebate)
+	}
+
+	for _, p := range genState.TraderPnls {
+		k.TraderPnls.Insert(ctx, collections.Join(sdk.MustAccAddressFromBech32(p.Trader), p.Pair), p)
+	}
+
+	// set the next history entry ID after the highest one in genesis
+	var lastHistoryID uint64
+	for _, e := range genState.TraderHistory {
+		k.TraderHistory.Insert(ctx, collections.Join(collections.Join(sdk.MustAccAddressFromBech32(e.Trader), e.Pair), e.Id), e)
+		if e.Id > lastHistoryID {
+			lastHistoryID = e.Id
+		}
+	}
+	if len(genState.TraderHistory) != 0 {
+		k.TraderHistoryID.Set(ctx, lastHistoryID+1)
+	}
+
+	for _, a := range genState.LiquidationAuctions {
+		k.LiquidationAuctions.Insert(ctx, collections.Join(a.Pair, sdk.MustAccAddressFromBech32(a.TraderAddress)), a)
+	}
+}
+
+// ExportGenesis returns the capability module's exported genesis.
+func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *v2types.GenesisState {
+	genesis := new(v2types.GenesisState)
+
+	genesis.Params = k.GetParams(ctx)
+	genesis.Markets = k.Markets.Iterate(ctx, collections.Range[asset.Pair]{}).Values()
+	genesis.Amms = k.AMMs.Iterate(ctx, collections.Range[asset.Pair]{}).Values()
+	genesis.Positions = k.Positions.Iterate(ctx, collections.PairRange[asset.Pair, sdk.AccAddress]{}).Values()
+	genesis.ReserveSnapshots = k.ReserveSnapshots.Iterate(ctx, collections.PairRange[asset.Pair, time.Time]{}).Values()
+	genesis.TriggerOrders = k.TriggerOrders.Iterate(ctx, collections.PairRange[collections.Pair[asset.Pair, sdk.AccAddress], uint64]{}).Values()
+	for _, trader := range k.CrossMarginTraders.Iterate(ctx, collections.Range[sdk.AccAddress]{}).Keys() {
+		genesis.CrossMarginTraders = append(genesis.CrossMarginTraders, trader.String())
+	}
+	genesis.InsuranceFunds = k.InsuranceFunds.Iterate(ctx, collections.Range[asset.Pair]{}).Values()
+	genesis.InsuranceFundDrawDowns = k.InsuranceFundDrawDowns.Iterate(ctx, collections.PairRange[asset.Pair, uint64]{}).Values()
+	genesis.FundingRates = k.FundingRates.Iterate(ctx, collections.PairRange[asset.Pair, time.Time]{}).Values()
+	genesis.CircuitBreakers = k.CircuitBreakers.Iterate(ctx, collections.Range[asset.Pair]{}).Values()
+	for _, kv := range k.TraderVolumes.Iterate(ctx, collections.PairRange[sdk.AccAddress, uint64]{}).KeyValues() {
+		genesis.TraderVolumes = append(genesis.TraderVolumes, v2types.TraderVolume{
+			Trader: kv.Key.K1().String(),
+			Day:    kv.Key.K2(),
+			Volume: kv.Value,
+		})
+	}
+	genesis.ReferralCodes = k.ReferralCodes.Iterate(ctx, collections.Range[string]{}).Values()
+	genesis.Referrals = k.Referrals.Iterate(ctx, collections.Range[sdk.AccAddress]{}).Values()
+	for _, kv := range k.ReferralRebates.Iterate(ctx, collections.PairRange[sdk.AccAddress, string]{}).KeyValues() {
+		genesis.ReferralRebates = append(genesis.ReferralRebates, v2types.ReferralRebate{
+			Referrer: kv.Key.K1().String(),
+			Rebate:   kv.Value,
+		})
+	}
+
+	genesis.TraderPnls = k.TraderPnls.Iterate(ctx, collections.PairRange[sdk.AccAddress, asset.Pair]{}).Values()
+	genesis.TraderHistory = k.TraderHistory.Iterate(ctx, collections.PairRange[collections.Pair[sdk.AccAddress, asset.Pair], uint64]{}).Values()
+	genesis.LiquidationAuctions = k.LiquidationAuctions.Iterate(ctx, collections.PairRange[asset.Pair, sdk.AccAddress]{}).Values()
+
+	return genesis
+}
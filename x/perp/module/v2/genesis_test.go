@@ -0,0 +1,482 @@
+package perp_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/NibiruChain/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/NibiruChain/nibiru/app"
+	"github.com/NibiruChain/nibiru/x/common/asset"
+	"github.com/NibiruChain/nibiru/x/common/denoms"
+	"github.com/NibiruChain/nibiru/x/common/testutil"
+	"github.com/NibiruChain/nibiru/x/common/testutil/mock"
+	"github.com/NibiruChain/nibiru/x/common/testutil/testapp"
+	epochstypes "github.com/NibiruChain/nibiru/x/epochs/types"
+	"github.com/NibiruChain/nibiru/x/perp/keeper/v2"
+	perp "github.com/NibiruChain/nibiru/x/perp/module/v2"
+	v2types "github.com/NibiruChain/nibiru/x/perp/types/v2"
+)
+
+func TestGenesis(t *testing.T) {
+	encodingConfig := app.MakeTestEncodingConfig()
+	app := testapp.NewNibiruTestApp(app.NewDefaultGenesisState(encodingConfig.Marshaler))
+
+	ctxUncached := app.NewContext(false, tmproto.Header{}).
+		WithBlockTime(time.Date(2015, 10, 21, 0, 0, 0, 0, time.UTC))
+	ctx, _ := ctxUncached.CacheContext()
+
+	pair := asset.Registry.Pair(denoms.BTC, denoms.NUSD)
+	market := v2types.Market{
+		Pair:                            pair,
+		Enabled:                         true,
+		PriceFluctuationLimitRatio:      sdk.MustNewDecFromStr("0.1"),
+		MaintenanceMarginRatio:          sdk.MustNewDecFromStr("0.0625"),
+		MaxLeverage:                     sdk.NewDec(10),
+		LatestCumulativePremiumFraction: sdk.MustNewDecFromStr("0.002"),
+		ExchangeFeeRatio:                sdk.MustNewDecFromStr("0.001"),
+		EcosystemFundFeeRatio:           sdk.MustNewDecFromStr("0.001"),
+		LiquidationFeeRatio:             sdk.MustNewDecFromStr("0.05"),
+		PartialLiquidationRatio:         sdk.MustNewDecFromStr("0.5"),
+		FundingRateEpochId:              epochstypes.ThirtyMinuteEpochID,
+		TwapLookbackWindow:              30 * time.Minute,
+		PrepaidBadDebt:                  sdk.NewInt64Coin(denoms.NUSD, 10),
+		InsuranceFundFeeShare:           sdk.MustNewDecFromStr("0.1"),
+		MaxFundingRate:                  sdk.ZeroDec(),
+		MinFundingRate:                  sdk.ZeroDec(),
+		InterestRate:                    sdk.ZeroDec(),
+		MaxSpreadRatio:                  sdk.ZeroDec(),
+		MaxSkewRatio:                    sdk.ZeroDec(),
+		AmmUpdateBudget:                 sdk.ZeroInt(),
+		CircuitBreakerPriceChangeRatio:  sdk.ZeroDec(),
+		MaxOpenInterest:                 sdk.ZeroDec(),
+		MaxPositionNotional:             sdk.ZeroDec(),
+	}
+	amm := *mock.TestAMMDefault()
+	app.PerpKeeperV2.Markets.Insert(ctx, pair, market)
+	app.PerpKeeperV2.AMMs.Insert(ctx, pair, amm)
+
+	// create some positions
+	for i := int64(0); i < 100; i++ {
+		addr := testutil.AccAddress()
+		app.PerpKeeperV2.Positions.Insert(ctx, collections.Join(pair, addr), v2types.Position{
+			TraderAddress:                   addr.String(),
+			Pair:                            pair,
+			Size_:                           sdk.NewDec(i + 1),
+			Margin:                          sdk.NewDec(i*2 + 1),
+			OpenNotional:                    sdk.NewDec(i*100 + 1),
+			LatestCumulativePremiumFraction: sdk.NewDec(5 * 100),
+			LastUpdatedBlockNumber:          i,
+		})
+	}
+
+	// create some reserve snapshots
+	for i := int64(0); i < 10; i++ {
+		blockTime := ctx.BlockTime().Add(time.Duration(i) * time.Second)
+		app.PerpKeeperV2.ReserveSnapshots.Insert(ctx, collections.Join(pair, blockTime), v2types.ReserveSnapshot{
+			Amm:         amm,
+			TimestampMs: blockTime.UnixMilli(),
+		})
+	}
+
+	// create some trigger orders
+	for i := uint64(1); i <= 5; i++ {
+		addr := testutil.AccAddress()
+		app.PerpKeeperV2.TriggerOrders.Insert(ctx, collections.Join(collections.Join(pair, addr), i), v2types.TriggerOrder{
+			Id:                   i,
+			TraderAddress:        addr.String(),
+			Pair:                 pair,
+			OrderType:            v2types.TriggerOrderType_LIMIT_OPEN,
+			Side:                 v2types.Direction_LONG,
+			TriggerPrice:         sdk.NewDec(int64(i)),
+			PriceSource:          v2types.TriggerPriceSource_MARK,
+			Collateral:           sdk.NewInt64Coin(denoms.NUSD, 100),
+			Leverage:             sdk.NewDec(2),
+			BaseAssetAmountLimit: sdk.ZeroDec(),
+		})
+	}
+
+	// create some cross-margin traders
+	for i := 0; i < 3; i++ {
+		app.PerpKeeperV2.CrossMarginTraders.Insert(ctx, testutil.AccAddress())
+	}
+
+	// create an insurance fund with some draw-downs
+	app.PerpKeeperV2.InsuranceFunds.Insert(ctx, pair, v2types.InsuranceFund{
+		Pair:               pair,
+		Balance:            sdk.NewInt64Coin(denoms.NUSD, 700),
+		TotalContributions: sdk.NewInt64Coin(denoms.NUSD, 1000),
+		TotalDrawDowns:     sdk.NewInt64Coin(denoms.NUSD, 300),
+	})
+	for i := uint64(1); i <= 3; i++ {
+		app.PerpKeeperV2.InsuranceFundDrawDowns.Insert(ctx, collections.Join(pair, i), v2types.InsuranceFundDrawDown{
+			Id:          i,
+			Pair:        pair,
+			Amount:      sdk.NewInt64Coin(denoms.NUSD, 100),
+			BadDebt:     sdk.NewInt64Coin(denoms.NUSD, 150),
+			BlockHeight: int64(i),
+			BlockTimeMs: ctx.BlockTime().UnixMilli(),
+		})
+	}
+
+	// record some funding rates
+	for i := int64(0); i < 4; i++ {
+		app.PerpKeeperV2.FundingRates.Insert(ctx, collections.Join(pair, time.UnixMilli(ctx.BlockTime().UnixMilli()+i)), v2types.FundingRate{
+			Pair:                      pair,
+			EpochNumber:               uint64(i),
+			MarkTwap:                  sdk.OneDec(),
+			IndexTwap:                 sdk.OneDec(),
+			PremiumRate:               sdk.ZeroDec(),
+			InterestRate:              sdk.ZeroDec(),
+			FundingRate:               sdk.ZeroDec(),
+			PremiumFraction:           sdk.ZeroDec(),
+			CumulativePremiumFraction: sdk.ZeroDec(),
+			BlockHeight:               i,
+			BlockTimeMs:               ctx.BlockTime().UnixMilli() + i,
+		})
+	}
+
+	// trip the circuit breaker
+	app.PerpKeeperV2.CircuitBreakers.Insert(ctx, pair, v2types.CircuitBreaker{
+		Pair:               pair,
+		Tripped:            true,
+		Reason:             v2types.CircuitBreakerReason_ORACLE_PRICE_CHANGE,
+		TrippedBlockHeight: ctx.BlockHeight(),
+		TrippedBlockTimeMs: ctx.BlockTime().UnixMilli(),
+	})
+
+	// record some trading volume and referrals
+	referrer := testutil.AccAddress()
+	app.PerpKeeperV2.ReferralCodes.Insert(ctx, "referrer", v2types.ReferralCode{Code: "referrer", Referrer: referrer.String()})
+	for i := uint64(0); i < 2; i++ {
+		referee := testutil.AccAddress()
+		app.PerpKeeperV2.TraderVolumes.Insert(ctx, collections.Join(referee, i), sdk.NewDec(1000))
+		app.PerpKeeperV2.Referrals.Insert(ctx, referee, v2types.Referral{Referee: referee.String(), Code: "referrer"})
+	}
+	app.PerpKeeperV2.ReferralRebates.Insert(ctx, collections.Join(referrer, denoms.NUSD), sdk.NewInt64Coin(denoms.NUSD, 10))
+
+	// record some trader history
+	traderPnl := keeper.ZeroTraderPnl(referrer, pair)
+	traderPnl.Fees = sdk.NewDec(10)
+	app.PerpKeeperV2.TraderPnls.Insert(ctx, collections.Join(referrer, pair), traderPnl)
+	for i := uint64(1); i <= 2; i++ {
+		app.PerpKeeperV2.TraderHistory.Insert(ctx, collections.Join(collections.Join(referrer, pair), i), v2types.TraderHistoryEntry{
+			Id:                        i,
+			Trader:                    referrer.String(),
+			Pair:                      pair,
+			ExchangedSize:             sdk.OneDec(),
+			ExchangedNotional:         sdk.OneDec(),
+			RealizedPnl:               sdk.ZeroDec(),
+			FundingPayment:            sdk.ZeroDec(),
+			Fees:                      sdk.NewDec(5),
+			LiquidationLoss:           sdk.ZeroDec(),
+			CumulativeRealizedPnl:     sdk.ZeroDec(),
+			CumulativeFundingPayment:  sdk.ZeroDec(),
+			CumulativeFees:            sdk.NewDec(5 * int64(i)),
+			CumulativeLiquidationLoss: sdk.ZeroDec(),
+			BlockHeight:               ctx.BlockHeight(),
+			BlockTimeMs:               ctx.BlockTime().UnixMilli(),
+		})
+	}
+
+	// export genesis
+	genState := perp.ExportGenesis(ctx, app.PerpKeeperV2)
+	require.NoError(t, genState.Validate())
+	require.Len(t, genState.Markets, 1)
+	require.Len(t, genState.Amms, 1)
+	require.Len(t, genState.Positions, 100)
+	require.Len(t, genState.ReserveSnapshots, 10)
+	require.Len(t, genState.TriggerOrders, 5)
+	require.Len(t, genState.CrossMarginTraders, 3)
+	require.Len(t, genState.InsuranceFunds, 1)
+	require.Len(t, genState.InsuranceFundDrawDowns, 3)
+	require.Len(t, genState.FundingRates, 4)
+	require.Len(t, genState.CircuitBreakers, 1)
+	require.Len(t, genState.TraderVolumes, 2)
+	require.Len(t, genState.ReferralCodes, 1)
+	require.Len(t, genState.Referrals, 2)
+	require.Len(t, genState.ReferralRebates, 1)
+	require.Len(t, genState.TraderPnls, 1)
+	require.Len(t, genState.TraderHistory, 2)
+
+	// create new context and init genesis
+	ctx, _ = ctxUncached.CacheContext()
+	perp.InitGenesis(ctx, app.PerpKeeperV2, *genState)
+
+	// export again to ensure they match
+	genStateAfterInit := perp.ExportGenesis(ctx, app.PerpKeeperV2)
+	require.Equal(t, genState.Params, genStateAfterInit.Params)
+	require.Equal(t, genState.Markets, genStateAfterInit.Markets)
+	require.Equal(t, genState.Amms, genStateAfterInit.Amms)
+	require.Equal(t, genState.Positions, genStateAfterInit.Positions)
+	require.Equal(t, genState.ReserveSnapshots, genStateAfterInit.ReserveSnapshots)
+	require.Equal(t, genState.TriggerOrders, genStateAfterInit.TriggerOrders)
+	require.EqualValues(t, 6, app.PerpKeeperV2.TriggerOrderID.Peek(ctx))
+	require.Equal(t, genState.CrossMarginTraders, genStateAfterInit.CrossMarginTraders)
+	require.Equal(t, genState.InsuranceFunds, genStateAfterInit.InsuranceFunds)
+	require.Equal(t, genState.InsuranceFundDrawDowns, genStateAfterInit.InsuranceFundDrawDowns)
+	require.EqualValues(t, 4, app.PerpKeeperV2.InsuranceFundDrawDownID.Peek(ctx))
+	require.Equal(t, genState.FundingRates, genStateAfterInit.FundingRates)
+	require.Equal(t, genState.CircuitBreakers, genStateAfterInit.CircuitBreakers)
+	require.Equal(t, genState.TraderVolumes, genStateAfterInit.TraderVolumes)
+	require.Equal(t, genState.ReferralCodes, genStateAfterInit.ReferralCodes)
+	require.Equal(t, genState.Referrals, genStateAfterInit.Referrals)
+	require.Equal(t, genState.ReferralRebates, genStateAfterInit.ReferralRebates)
+	require.Equal(t, genState.TraderPnls, genStateAfterInit.TraderPnls)
+	require.Equal(t, genState.TraderHistory, genStateAfterInit.TraderHistory)
+	require.EqualValues(t, 3, app.PerpKeeperV2.TraderHistoryID.Peek(ctx))
+}
+
+func TestGenesisValidate(t *testing.T) {
+	pair := asset.Registry.Pair(denoms.BTC, denoms.NUSD)
+	market := v2types.Market{
+		Pair:                            pair,
+		PriceFluctuationLimitRatio:      sdk.MustNewDecFromStr("0.1"),
+		MaintenanceMarginRatio:          sdk.MustNewDecFromStr("0.0625"),
+		MaxLeverage:                     sdk.NewDec(10),
+		LatestCumulativePremiumFraction: sdk.ZeroDec(),
+		ExchangeFeeRatio:                sdk.MustNewDecFromStr("0.001"),
+		EcosystemFundFeeRatio:           sdk.MustNewDecFromStr("0.001"),
+		LiquidationFeeRatio:             sdk.MustNewDecFromStr("0.05"),
+		PartialLiquidationRatio:         sdk.MustNewDecFromStr("0.5"),
+		InsuranceFundFeeShare:           sdk.MustNewDecFromStr("0.1"),
+		MaxFundingRate:                  sdk.ZeroDec(),
+		MinFundingRate:                  sdk.ZeroDec(),
+		InterestRate:                    sdk.ZeroDec(),
+		MaxSpreadRatio:                  sdk.ZeroDec(),
+		MaxSkewRatio:                    sdk.ZeroDec(),
+		AmmUpdateBudget:                 sdk.ZeroInt(),
+		CircuitBreakerPriceChangeRatio:  sdk.ZeroDec(),
+		MaxOpenInterest:                 sdk.ZeroDec(),
+		MaxPositionNotional:             sdk.ZeroDec(),
+	}
+	amm := *mock.TestAMMDefault()
+	trader := testutil.AccAddress()
+	position := v2types.Position{
+		TraderAddress:                   trader.String(),
+		Pair:                            pair,
+		Size_:                           sdk.OneDec(),
+		Margin:                          sdk.OneDec(),
+		OpenNotional:                    sdk.OneDec(),
+		LatestCumulativePremiumFraction: sdk.ZeroDec(),
+	}
+	fund := v2types.InsuranceFund{
+		Pair:               pair,
+		Balance:            sdk.NewInt64Coin(denoms.NUSD, 70),
+		TotalContributions: sdk.NewInt64Coin(denoms.NUSD, 100),
+		TotalDrawDowns:     sdk.NewInt64Coin(denoms.NUSD, 30),
+	}
+	drawDown := v2types.InsuranceFundDrawDown{
+		Id:      1,
+		Pair:    pair,
+		Amount:  sdk.NewInt64Coin(denoms.NUSD, 30),
+		BadDebt: sdk.NewInt64Coin(denoms.NUSD, 30),
+	}
+	invertedFundingRateBounds := market
+	invertedFundingRateBounds.WithFundingRateBounds(sdk.MustNewDecFromStr("0.1"), sdk.MustNewDecFromStr("-0.1"))
+	fundingRate := v2types.FundingRate{
+		Pair:                      pair,
+		MarkTwap:                  sdk.OneDec(),
+		IndexTwap:                 sdk.OneDec(),
+		PremiumRate:               sdk.ZeroDec(),
+		InterestRate:              sdk.ZeroDec(),
+		FundingRate:               sdk.ZeroDec(),
+		PremiumFraction:           sdk.ZeroDec(),
+		CumulativePremiumFraction: sdk.ZeroDec(),
+	}
+	order := v2types.TriggerOrder{
+		Id:                   1,
+		TraderAddress:        trader.String(),
+		Pair:                 pair,
+		OrderType:            v2types.TriggerOrderType_STOP_LOSS,
+		TriggerPrice:         sdk.OneDec(),
+		PriceSource:          v2types.TriggerPriceSource_INDEX,
+		Collateral:           sdk.NewInt64Coin(denoms.NUSD, 0),
+		Leverage:             sdk.ZeroDec(),
+		BaseAssetAmountLimit: sdk.ZeroDec(),
+	}
+
+	testCases := []struct {
+		name      string
+		genesis   v2types.GenesisState
+		expectErr bool
+	}{
+		{
+			name:    "default genesis",
+			genesis: *v2types.DefaultGenesis(),
+		},
+		{
+			name: "valid genesis",
+			genesis: v2types.GenesisState{
+				Markets:                []v2types.Market{market},
+				Amms:                   []v2types.AMM{amm},
+				Positions:              []v2types.Position{position},
+				TriggerOrders:          []v2types.TriggerOrder{order},
+				CrossMarginTraders:     []string{trader.String()},
+				InsuranceFunds:         []v2types.InsuranceFund{fund},
+				InsuranceFundDrawDowns: []v2types.InsuranceFundDrawDown{drawDown},
+				FundingRates:           []v2types.FundingRate{fundingRate},
+			},
+		},
+		{
+			name: "min funding rate above max funding rate",
+			genesis: v2types.GenesisState{
+				Markets: []v2types.Market{invertedFundingRateBounds},
+				Amms:    []v2types.AMM{amm},
+			},
+			expectErr: true,
+		},
+		{
+			name: "duplicate market",
+			genesis: v2types.GenesisState{
+				Markets: []v2types.Market{market, market},
+				Amms:    []v2types.AMM{amm},
+			},
+			expectErr: true,
+		},
+		{
+			name: "market without amm",
+			genesis: v2types.GenesisState{
+				Markets: []v2types.Market{market},
+			},
+			expectErr: true,
+		},
+		{
+			name: "amm without market",
+			genesis: v2types.GenesisState{
+				Amms: []v2types.AMM{amm},
+			},
+			expectErr: true,
+		},
+		{
+			name: "position without market",
+			genesis: v2types.GenesisState{
+				Positions: []v2types.Position{position},
+			},
+			expectErr: true,
+		},
+		{
+			name: "duplicate position",
+			genesis: v2types.GenesisState{
+				Markets:   []v2types.Market{market},
+				Amms:      []v2types.AMM{amm},
+				Positions: []v2types.Position{position, position},
+			},
+			expectErr: true,
+		},
+		{
+			name: "trigger order without market",
+			genesis: v2types.GenesisState{
+				TriggerOrders: []v2types.TriggerOrder{order},
+			},
+			expectErr: true,
+		},
+		{
+			name: "duplicate trigger order id",
+			genesis: v2types.GenesisState{
+				Markets:       []v2types.Market{market},
+				Amms:          []v2types.AMM{amm},
+				TriggerOrders: []v2types.TriggerOrder{order, order},
+			},
+			expectErr: true,
+		},
+		{
+			name: "invalid cross-margin trader",
+			genesis: v2types.GenesisState{
+				CrossMarginTraders: []string{"invalid"},
+			},
+			expectErr: true,
+		},
+		{
+			name: "insurance fund balance does not match its history",
+			genesis: v2types.GenesisState{
+				Markets: []v2types.Market{market},
+				Amms:    []v2types.AMM{amm},
+				InsuranceFunds: []v2types.InsuranceFund{{
+					Pair:               pair,
+					Balance:            sdk.NewInt64Coin(denoms.NUSD, 100),
+					TotalContributions: sdk.NewInt64Coin(denoms.NUSD, 100),
+					TotalDrawDowns:     sdk.NewInt64Coin(denoms.NUSD, 30),
+				}},
+			},
+			expectErr: true,
+		},
+		{
+			name: "insurance fund without market",
+			genesis: v2types.GenesisState{
+				InsuranceFunds: []v2types.InsuranceFund{fund},
+			},
+			expectErr: true,
+		},
+		{
+			name: "duplicate insurance fund draw-down id",
+			genesis: v2types.GenesisState{
+				Markets:                []v2types.Market{market},
+				Amms:                   []v2types.AMM{amm},
+				InsuranceFundDrawDowns: []v2types.InsuranceFundDrawDown{drawDown, drawDown},
+			},
+			expectErr: true,
+		},
+		{
+			name: "funding rate without market",
+			genesis: v2types.GenesisState{
+				FundingRates: []v2types.FundingRate{fundingRate},
+			},
+			expectErr: true,
+		},
+		{
+			name: "duplicate funding rate",
+			genesis: v2types.GenesisState{
+				Markets:      []v2types.Market{market},
+				Amms:         []v2types.AMM{amm},
+				FundingRates: []v2types.FundingRate{fundingRate, fundingRate},
+			},
+			expectErr: true,
+		},
+		{
+			name: "circuit breaker without market",
+			genesis: v2types.GenesisState{
+				CircuitBreakers: []v2types.CircuitBreaker{{Pair: pair}},
+			},
+			expectErr: true,
+		},
+		{
+			name: "duplicate circuit breaker",
+			genesis: v2types.GenesisState{
+				Markets:         []v2types.Market{market},
+				Amms:            []v2types.AMM{amm},
+				CircuitBreakers: []v2types.CircuitBreaker{{Pair: pair}, {Pair: pair}},
+			},
+			expectErr: true,
+		},
+		{
+			name: "referral to an unknown code",
+			genesis: v2types.GenesisState{
+				Referrals: []v2types.Referral{{Referee: trader.String(), Code: "unknown"}},
+			},
+			expectErr: true,
+		},
+		{
+			name: "duplicate cross-margin trader",
+			genesis: v2types.GenesisState{
+				CrossMarginTraders: []string{trader.String(), trader.String()},
+			},
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.genesis.Validate()
+			if tc.expectErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
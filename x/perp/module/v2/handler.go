@@ -0,0 +1,101 @@
+package perp
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/NibiruChain/nibiru/x/perp/keeper/v2"
+	v2types "github.com/NibiruChain/nibiru/x/perp/types/v2"
+)
+
+// NewHandler returns an sdk.Handler for "x/perp" v2 messages.
+func NewHandler(k keeper.Keeper) sdk.Handler {
+	msgServer := keeper.NewMsgServerImpl(k)
+
+	return func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
+		ctx = ctx.WithEventManager(sdk.NewEventManager())
+		goCtx := sdk.WrapSDKContext(ctx)
+
+		switch msg := msg.(type) {
+		case *v2types.MsgRemoveMargin:
+			res, err := msgServer.RemoveMargin(goCtx, msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *v2types.MsgAddMargin:
+			res, err := msgServer.AddMargin(goCtx, msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *v2types.MsgOpenPosition:
+			res, err := msgServer.OpenPosition(goCtx, msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *v2types.MsgClosePosition:
+			res, err := msgServer.ClosePosition(goCtx, msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *v2types.MsgMultiLiquidate:
+			res, err := msgServer.MultiLiquidate(goCtx, msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *v2types.MsgDonateToEcosystemFund:
+			res, err := msgServer.DonateToEcosystemFund(goCtx, msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *v2types.MsgPlaceTriggerOrder:
+			res, err := msgServer.PlaceTriggerOrder(goCtx, msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *v2types.MsgCancelTriggerOrder:
+			res, err := msgServer.CancelTriggerOrder(goCtx, msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *v2types.MsgSetMarginMode:
+			res, err := msgServer.SetMarginMode(goCtx, msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *v2types.MsgRegisterReferralCode:
+			res, err := msgServer.RegisterReferralCode(goCtx, msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *v2types.MsgBindReferralCode:
+			res, err := msgServer.BindReferralCode(goCtx, msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *v2types.MsgBidLiquidationAuction:
+			res, err := msgServer.BidLiquidationAuction(goCtx, msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		default:
+			errMsg := fmt.Sprintf(
+				"unrecognized %s message type: %T", v2types.ModuleName, msg)
+			return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, errMsg)
+		}
+	}
+}
+
+// NewProposalHandler returns a govtypes.Handler for "x/perp" v2 proposals.
+func NewProposalHandler(k keeper.Keeper) govtypes.Handler {
+	return func(ctx sdk.Context, content govtypes.Content) error {
+		switch proposal := content.(type) {
+		case *v2types.SetAutoDeleveragingProposal:
+			return handleProposalSetAutoDeleveraging(ctx, k, proposal)
+		case *v2types.ResetCircuitBreakerProposal:
+			return handleProposalResetCircuitBreaker(ctx, k, proposal)
+		default:
+			return sdkerrors.Wrapf(
+				sdkerrors.ErrUnknownRequest,
+				"unrecognized %s proposal content type: %T", v2types.ModuleName, proposal)
+		}
+	}
+}
+
+func handleProposalSetAutoDeleveraging(
+	ctx sdk.Context, k keeper.Keeper, proposal *v2types.SetAutoDeleveragingProposal,
+) error {
+	if err := proposal.ValidateBasic(); err != nil {
+		return err
+	}
+
+	return k.SetAutoDeleveraging(ctx, proposal.Pair, proposal.Enabled)
+}
+
+func handleProposalResetCircuitBreaker(
+	ctx sdk.Context, k keeper.Keeper, proposal *v2types.ResetCircuitBreakerProposal,
+) error {
+	if err := proposal.ValidateBasic(); err != nil {
+		return err
+	}
+
+	return k.ResetCircuitBreaker(ctx, proposal.Pair)
+}
@@ -0,0 +1,202 @@
+package perp
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/gorilla/mux"
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"github.com/spf13/cobra"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	cli "github.com/NibiruChain/nibiru/x/perp/client/cli/v2"
+	v1keeper "github.com/NibiruChain/nibiru/x/perp/keeper/v1"
+	"github.com/NibiruChain/nibiru/x/perp/keeper/v2"
+	types "github.com/NibiruChain/nibiru/x/perp/types/v1"
+	v2types "github.com/NibiruChain/nibiru/x/perp/types/v2"
+)
+
+// type check to ensure the interface is properly implemented
+var (
+	_ module.AppModule      = AppModule{}
+	_ module.AppModuleBasic = AppModuleBasic{}
+)
+
+// ----------------------------------------------------------------------------
+// AppModuleBasic
+// ----------------------------------------------------------------------------
+
+type AppModuleBasic struct {
+	binaryCodec codec.BinaryCodec
+}
+
+func NewAppModuleBasic(binaryCodec codec.BinaryCodec) AppModuleBasic {
+	return AppModuleBasic{binaryCodec: binaryCodec}
+}
+
+func (AppModuleBasic) Name() string {
+	return v2types.ModuleName
+}
+
+// RegisterInterfaces registers interfaces and implementations of the perp module.
+func (AppModuleBasic) RegisterInterfaces(interfaceRegistry codectypes.InterfaceRegistry) {
+	v2types.RegisterInterfaces(interfaceRegistry)
+}
+
+func (AppModuleBasic) RegisterCodec(aminoCodec *codec.LegacyAmino) {
+	v2types.RegisterCodec(aminoCodec)
+}
+
+func (AppModuleBasic) RegisterLegacyAminoCodec(aminoCodec *codec.LegacyAmino) {
+	v2types.RegisterCodec(aminoCodec)
+}
+
+// DefaultGenesis returns default genesis state as raw bytes for the perp
+// module.
+func (AppModuleBasic) DefaultGenesis(cdc codec.JSONCodec) json.RawMessage {
+	return cdc.MustMarshalJSON(v2types.DefaultGenesis())
+}
+
+// ValidateGenesis performs genesis state validation for the perp module.
+func (AppModuleBasic) ValidateGenesis(
+	cdc codec.JSONCodec, config client.TxEncodingConfig, bz json.RawMessage,
+) error {
+	var genState v2types.GenesisState
+	if err := cdc.UnmarshalJSON(bz, &genState); err != nil {
+		return fmt.Errorf("failed to unmarshal %s genesis state: %w", v2types.ModuleName, err)
+	}
+	return genState.Validate()
+}
+
+// RegisterRESTRoutes registers the perp module's REST service handlers.
+func (AppModuleBasic) RegisterRESTRoutes(clientCtx client.Context, rtr *mux.Router) {
+}
+
+// RegisterGRPCGatewayRoutes registers the gRPC Gateway routes for the module.
+func (AppModuleBasic) RegisterGRPCGatewayRoutes(
+	clientCtx client.Context, mux *runtime.ServeMux,
+) {
+	if err := v2types.RegisterQueryHandlerClient(context.Background(), mux, v2types.NewQueryClient(clientCtx)); err != nil {
+		panic(err)
+	}
+}
+
+// GetTxCmd returns the perp module's root tx command.
+func (a AppModuleBasic) GetTxCmd() *cobra.Command {
+	return cli.GetTxCmd()
+}
+
+// GetQueryCmd returns the perp module's root query command.
+func (AppModuleBasic) GetQueryCmd() *cobra.Command {
+	return cli.GetQueryCmd()
+}
+
+// ----------------------------------------------------------------------------
+// AppModule
+// ----------------------------------------------------------------------------
+
+// AppModule implements the AppModule interface for the module.
+type AppModule struct {
+	AppModuleBasic
+
+	keeper   keeper.Keeper
+	v1Keeper v1keeper.Keeper
+	ak       types.AccountKeeper
+	bk       types.BankKeeper
+	ok       types.OracleKeeper
+}
+
+func NewAppModule(
+	cdc codec.Codec,
+	keeper keeper.Keeper,
+	v1Keeper v1keeper.Keeper,
+	ak types.AccountKeeper,
+	bk types.BankKeeper,
+	ok types.OracleKeeper,
+) AppModule {
+	return AppModule{
+		AppModuleBasic: NewAppModuleBasic(cdc),
+		keeper:         keeper,
+		v1Keeper:       v1Keeper,
+		ak:             ak,
+		bk:             bk,
+		ok:             ok,
+	}
+}
+
+// Name returns the perp module's name.
+func (am AppModule) Name() string {
+	return am.AppModuleBasic.Name()
+}
+
+// Route returns the perp module's message routing key.
+func (am AppModule) Route() sdk.Route {
+	return sdk.NewRoute(v2types.RouterKey, NewHandler(am.keeper))
+}
+
+// QuerierRoute returns the perp module's query routing key.
+func (AppModule) QuerierRoute() string { return v2types.QuerierRoute }
+
+// LegacyQuerierHandler returns the perp module's Querier.
+func (am AppModule) LegacyQuerierHandler(legacyQuerierCdc *codec.LegacyAmino) sdk.Querier {
+	return nil
+}
+
+// RegisterServices registers a GRPC query service to respond to the
+// module-specific GRPC queries.
+func (am AppModule) RegisterServices(cfg module.Configurator) {
+	v2types.RegisterQueryServer(cfg.QueryServer(), keeper.NewQuerier(am.keeper))
+	v2types.RegisterMsgServer(cfg.MsgServer(), keeper.NewMsgServerImpl(am.keeper))
+
+	err := cfg.RegisterMigration(v2types.ModuleName, 1, keeper.From1To2(am.keeper, am.v1Keeper)) // From 1 to 2
+	if err != nil {
+		panic(fmt.Errorf("failed to register migration: %w", err))
+	}
+}
+
+// RegisterInvariants registers the perp module's invariants.
+func (am AppModule) RegisterInvariants(ir sdk.InvariantRegistry) {
+	keeper.RegisterInvariants(ir, am.keeper)
+}
+
+// InitGenesis performs the perp module's genesis initialization It returns
+// no validator updates.
+func (am AppModule) InitGenesis(ctx sdk.Context, cdc codec.JSONCodec, gs json.RawMessage,
+) []abci.ValidatorUpdate {
+	var genState v2types.GenesisState
+	cdc.MustUnmarshalJSON(gs, &genState)
+
+	InitGenesis(ctx, am.keeper, genState)
+
+	// See https://github.com/cosmos/cosmos-sdk/issues/5569 on why we do this.
+	am.ak.GetModuleAccount(ctx, v2types.PerpEFModuleAccount)
+	am.ak.GetModuleAccount(ctx, v2types.VaultModuleAccount)
+	am.ak.GetModuleAccount(ctx, v2types.FeePoolModuleAccount)
+
+	return []abci.ValidatorUpdate{}
+}
+
+// ExportGenesis returns the perp module's exported genesis state as raw JSON bytes.
+func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.RawMessage {
+	genState := ExportGenesis(ctx, am.keeper)
+	return cdc.MustMarshalJSON(genState)
+}
+
+// ConsensusVersion implements ConsensusVersion.
+func (AppModule) ConsensusVersion() uint64 { return 2 }
+
+// BeginBlock executes all ABCI BeginBlock logic respective to the perp module.
+func (am AppModule) BeginBlock(_ sdk.Context, _ abci.RequestBeginBlock) {}
+
+// EndBlock executes all ABCI EndBlock logic respective to the perp module. It
+// returns no validator updates.
+func (am AppModule) EndBlock(ctx sdk.Context, _ abci.RequestEndBlock) []abci.ValidatorUpdate {
+	EndBlocker(ctx, am.keeper)
+	return []abci.ValidatorUpdate{}
+}
@@ -46,6 +46,7 @@ type BankKeeper interface {
 
 type OracleKeeper interface {
 	GetExchangeRate(ctx sdk.Context, pair asset.Pair) (sdk.Dec, error)
+	GetExchangeRateWithMaxAge(ctx sdk.Context, pair asset.Pair) (sdk.Dec, error)
 	GetExchangeRateTwap(ctx sdk.Context, pair asset.Pair) (sdk.Dec, error)
 	SetPrice(ctx sdk.Context, pair asset.Pair, price sdk.Dec)
 }
@@ -109,6 +110,11 @@ type PerpAmmKeeper interface {
 	) (newMarket perpammtypes.Market, err error)
 }
 
+type StakingKeeper interface {
+	// GetDelegatorBonded returns the amount of tokens bonded by a delegator.
+	GetDelegatorBonded(ctx sdk.Context, delegator sdk.AccAddress) sdk.Int
+}
+
 type EpochKeeper interface {
 	// GetEpochInfo returns epoch info by identifier.
 	GetEpochInfo(ctx sdk.Context, identifier string) types.EpochInfo
@@ -7,8 +7,10 @@ import (
 	context "context"
 	fmt "fmt"
 	github_com_NibiruChain_nibiru_x_common_asset "github.com/NibiruChain/nibiru/x/common/asset"
+	types "github.com/NibiruChain/nibiru/x/perp/amm/types"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
-	types "github.com/cosmos/cosmos-sdk/types"
+	types1 "github.com/cosmos/cosmos-sdk/types"
+	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
@@ -204,6 +206,116 @@ func (m *QueryPositionsResponse) GetPositions() []*QueryPositionResponse {
 	return nil
 }
 
+type QueryMarketPositionsRequest struct {
+	Pair github_com_NibiruChain_nibiru_x_common_asset.Pair `protobuf:"bytes,1,opt,name=pair,proto3,customtype=github.com/NibiruChain/nibiru/x/common/asset.Pair" json:"pair"`
+	// only positions with a mark margin ratio below this value, if set
+	MaxMarginRatio github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=max_margin_ratio,json=maxMarginRatio,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_margin_ratio"`
+	// only positions on this side, both sides if unspecified
+	Direction types.Direction `protobuf:"varint,3,opt,name=direction,proto3,enum=nibiru.perp.amm.v1.Direction" json:"direction,omitempty"`
+	// only positions with at least this notional value, if set
+	MinNotional github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=min_notional,json=minNotional,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min_notional"`
+	Pagination  *query.PageRequest                     `protobuf:"bytes,5,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryMarketPositionsRequest) Reset()         { *m = QueryMarketPositionsRequest{} }
+func (m *QueryMarketPositionsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryMarketPositionsRequest) ProtoMessage()    {}
+func (*QueryMarketPositionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8212d8958be09421, []int{4}
+}
+func (m *QueryMarketPositionsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMarketPositionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMarketPositionsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMarketPositionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMarketPositionsRequest.Merge(m, src)
+}
+func (m *QueryMarketPositionsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMarketPositionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMarketPositionsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMarketPositionsRequest proto.InternalMessageInfo
+
+func (m *QueryMarketPositionsRequest) GetDirection() types.Direction {
+	if m != nil {
+		return m.Direction
+	}
+	return types.Direction_DIRECTION_UNSPECIFIED
+}
+
+func (m *QueryMarketPositionsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryMarketPositionsResponse struct {
+	Positions  []QueryPositionResponse `protobuf:"bytes,1,rep,name=positions,proto3" json:"positions"`
+	Pagination *query.PageResponse     `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryMarketPositionsResponse) Reset()         { *m = QueryMarketPositionsResponse{} }
+func (m *QueryMarketPositionsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryMarketPositionsResponse) ProtoMessage()    {}
+func (*QueryMarketPositionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8212d8958be09421, []int{5}
+}
+func (m *QueryMarketPositionsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMarketPositionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMarketPositionsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMarketPositionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMarketPositionsResponse.Merge(m, src)
+}
+func (m *QueryMarketPositionsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMarketPositionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMarketPositionsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMarketPositionsResponse proto.InternalMessageInfo
+
+func (m *QueryMarketPositionsResponse) GetPositions() []QueryPositionResponse {
+	if m != nil {
+		return m.Positions
+	}
+	return nil
+}
+
+func (m *QueryMarketPositionsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 // QueryPositionRequest is the request type for the position of the x/perp
 // module account.
 type QueryPositionRequest struct {
@@ -215,7 +327,7 @@ func (m *QueryPositionRequest) Reset()         { *m = QueryPositionRequest{} }
 func (m *QueryPositionRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryPositionRequest) ProtoMessage()    {}
 func (*QueryPositionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8212d8958be09421, []int{4}
+	return fileDescriptor_8212d8958be09421, []int{6}
 }
 func (m *QueryPositionRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -269,13 +381,17 @@ type QueryPositionResponse struct {
 	MarginRatioIndex github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=margin_ratio_index,json=marginRatioIndex,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"margin_ratio_index"`
 	// BlockNumber is current block number at the time of query.
 	BlockNumber int64 `protobuf:"varint,7,opt,name=block_number,json=blockNumber,proto3" json:"block_number,omitempty"`
+	// The price at which the position would fall to the maintenance margin
+	// ratio of its margin tier, ignoring price impact. Zero if no price move
+	// can get it liquidated.
+	LiquidationPrice github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,8,opt,name=liquidation_price,json=liquidationPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"liquidation_price"`
 }
 
 func (m *QueryPositionResponse) Reset()         { *m = QueryPositionResponse{} }
 func (m *QueryPositionResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryPositionResponse) ProtoMessage()    {}
 func (*QueryPositionResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8212d8958be09421, []int{5}
+	return fileDescriptor_8212d8958be09421, []int{7}
 }
 func (m *QueryPositionResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -327,7 +443,7 @@ func (m *QueryCumulativePremiumFractionRequest) Reset()         { *m = QueryCumu
 func (m *QueryCumulativePremiumFractionRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryCumulativePremiumFractionRequest) ProtoMessage()    {}
 func (*QueryCumulativePremiumFractionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8212d8958be09421, []int{6}
+	return fileDescriptor_8212d8958be09421, []int{8}
 }
 func (m *QueryCumulativePremiumFractionRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -368,7 +484,7 @@ func (m *QueryCumulativePremiumFractionResponse) Reset() {
 func (m *QueryCumulativePremiumFractionResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryCumulativePremiumFractionResponse) ProtoMessage()    {}
 func (*QueryCumulativePremiumFractionResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8212d8958be09421, []int{7}
+	return fileDescriptor_8212d8958be09421, []int{9}
 }
 func (m *QueryCumulativePremiumFractionResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -406,7 +522,7 @@ func (m *QueryMetricsRequest) Reset()         { *m = QueryMetricsRequest{} }
 func (m *QueryMetricsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryMetricsRequest) ProtoMessage()    {}
 func (*QueryMetricsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8212d8958be09421, []int{8}
+	return fileDescriptor_8212d8958be09421, []int{10}
 }
 func (m *QueryMetricsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -444,7 +560,7 @@ func (m *QueryMetricsResponse) Reset()         { *m = QueryMetricsResponse{} }
 func (m *QueryMetricsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryMetricsResponse) ProtoMessage()    {}
 func (*QueryMetricsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8212d8958be09421, []int{9}
+	return fileDescriptor_8212d8958be09421, []int{11}
 }
 func (m *QueryMetricsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -487,7 +603,7 @@ func (m *QueryModuleAccountsRequest) Reset()         { *m = QueryModuleAccountsR
 func (m *QueryModuleAccountsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryModuleAccountsRequest) ProtoMessage()    {}
 func (*QueryModuleAccountsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8212d8958be09421, []int{10}
+	return fileDescriptor_8212d8958be09421, []int{12}
 }
 func (m *QueryModuleAccountsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -524,7 +640,7 @@ func (m *QueryModuleAccountsResponse) Reset()         { *m = QueryModuleAccounts
 func (m *QueryModuleAccountsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryModuleAccountsResponse) ProtoMessage()    {}
 func (*QueryModuleAccountsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8212d8958be09421, []int{11}
+	return fileDescriptor_8212d8958be09421, []int{13}
 }
 func (m *QueryModuleAccountsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -570,7 +686,7 @@ func (m *AccountWithBalance) Reset()         { *m = AccountWithBalance{} }
 func (m *AccountWithBalance) String() string { return proto.CompactTextString(m) }
 func (*AccountWithBalance) ProtoMessage()    {}
 func (*AccountWithBalance) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8212d8958be09421, []int{12}
+	return fileDescriptor_8212d8958be09421, []int{14}
 }
 func (m *AccountWithBalance) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -625,6 +741,8 @@ func init() {
 	proto.RegisterType((*QueryParamsResponse)(nil), "nibiru.perp.v1.QueryParamsResponse")
 	proto.RegisterType((*QueryPositionsRequest)(nil), "nibiru.perp.v1.QueryPositionsRequest")
 	proto.RegisterType((*QueryPositionsResponse)(nil), "nibiru.perp.v1.QueryPositionsResponse")
+	proto.RegisterType((*QueryMarketPositionsRequest)(nil), "nibiru.perp.v1.QueryMarketPositionsRequest")
+	proto.RegisterType((*QueryMarketPositionsResponse)(nil), "nibiru.perp.v1.QueryMarketPositionsResponse")
 	proto.RegisterType((*QueryPositionRequest)(nil), "nibiru.perp.v1.QueryPositionRequest")
 	proto.RegisterType((*QueryPositionResponse)(nil), "nibiru.perp.v1.QueryPositionResponse")
 	proto.RegisterType((*QueryCumulativePremiumFractionRequest)(nil), "nibiru.perp.v1.QueryCumulativePremiumFractionRequest")
@@ -639,68 +757,81 @@ func init() {
 func init() { proto.RegisterFile("perp/v1/query.proto", fileDescriptor_8212d8958be09421) }
 
 var fileDescriptor_8212d8958be09421 = []byte{
-	// 971 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0xdd, 0x6e, 0x1b, 0x45,
-	0x14, 0xce, 0xc6, 0xa9, 0xdd, 0x4e, 0x68, 0xa0, 0x93, 0xc4, 0xdd, 0x38, 0xc1, 0x09, 0x4b, 0x1a,
-	0x59, 0x41, 0xec, 0xd6, 0x29, 0x3f, 0x42, 0x5c, 0xe1, 0x54, 0x48, 0x08, 0x25, 0x84, 0x95, 0x10,
-	0x52, 0x01, 0xad, 0xc6, 0xbb, 0x83, 0x33, 0xca, 0xee, 0xcc, 0x76, 0x66, 0xd6, 0x4a, 0x41, 0x08,
-	0x89, 0x4b, 0x6e, 0x40, 0xe2, 0x19, 0xb8, 0x80, 0x47, 0x40, 0x3c, 0x40, 0x2f, 0x2b, 0x71, 0x83,
-	0xb8, 0x08, 0x28, 0xe1, 0x41, 0xaa, 0x9d, 0x99, 0x75, 0xbd, 0xae, 0x63, 0xa7, 0x91, 0x72, 0xe5,
-	0xdd, 0x33, 0xe7, 0x7c, 0xdf, 0x37, 0x67, 0xcf, 0x8f, 0xc1, 0x62, 0x8a, 0x79, 0xea, 0xf5, 0xdb,
-	0xde, 0xc3, 0x0c, 0xf3, 0x47, 0x6e, 0xca, 0x99, 0x64, 0x70, 0x81, 0x92, 0x2e, 0xe1, 0x99, 0x9b,
-	0x9f, 0xb9, 0xfd, 0x76, 0x63, 0xa9, 0xc7, 0x7a, 0x4c, 0x1d, 0x79, 0xf9, 0x93, 0xf6, 0x6a, 0xac,
-	0xf5, 0x18, 0xeb, 0xc5, 0xd8, 0x43, 0x29, 0xf1, 0x10, 0xa5, 0x4c, 0x22, 0x49, 0x18, 0x15, 0xe6,
-	0x74, 0x00, 0x2c, 0x24, 0x92, 0xd8, 0x18, 0x9b, 0x21, 0x13, 0x09, 0x13, 0x5e, 0x17, 0x09, 0xec,
-	0xf5, 0xdb, 0x5d, 0x2c, 0x51, 0xdb, 0x0b, 0x19, 0xa1, 0xfa, 0xdc, 0x59, 0x02, 0xf0, 0xd3, 0x5c,
-	0xc7, 0x01, 0xe2, 0x28, 0x11, 0x3e, 0x7e, 0x98, 0x61, 0x21, 0x9d, 0x8f, 0xc1, 0x62, 0xc9, 0x2a,
-	0x52, 0x46, 0x05, 0x86, 0x6f, 0x81, 0x6a, 0xaa, 0x2c, 0xb6, 0xb5, 0x61, 0xb5, 0xe6, 0x77, 0xea,
-	0x6e, 0x59, 0xb6, 0xab, 0xfd, 0x3b, 0x73, 0x8f, 0x4f, 0xd6, 0x67, 0x7c, 0xe3, 0xeb, 0x78, 0x60,
-	0x59, 0x83, 0x31, 0x41, 0x94, 0x5e, 0xc3, 0x02, 0xeb, 0xa0, 0x2a, 0x39, 0x8a, 0x30, 0x57, 0x70,
-	0x37, 0x7c, 0xf3, 0xe6, 0x7c, 0x05, 0xea, 0xa3, 0x01, 0x46, 0xc0, 0x2e, 0xb8, 0x91, 0x16, 0x46,
-	0xdb, 0xda, 0xa8, 0xb4, 0xe6, 0x77, 0xee, 0x8c, 0x6a, 0x28, 0x85, 0x16, 0x91, 0xfe, 0xb3, 0x38,
-	0xe7, 0x3b, 0xb0, 0x34, 0xe2, 0xa3, 0xe5, 0xec, 0x81, 0xb9, 0x14, 0x11, 0x23, 0xa6, 0xf3, 0x5e,
-	0x7e, 0x87, 0x7f, 0x4e, 0xd6, 0xdb, 0x3d, 0x22, 0x0f, 0xb3, 0xae, 0x1b, 0xb2, 0xc4, 0xdb, 0x57,
-	0x4c, 0xbb, 0x87, 0x88, 0x50, 0x4f, 0xb3, 0x7a, 0xc7, 0x5e, 0xc8, 0x92, 0x84, 0x51, 0x0f, 0x09,
-	0x81, 0xa5, 0x7b, 0x80, 0x08, 0xf7, 0x15, 0xcc, 0xd0, 0xed, 0x66, 0x4b, 0xb7, 0x3b, 0xa9, 0x8c,
-	0xe4, 0x63, 0x28, 0xbd, 0xd7, 0x0b, 0x95, 0x26, 0xc1, 0xf6, 0x73, 0x09, 0x2e, 0x62, 0x06, 0x9e,
-	0xf0, 0x0b, 0x70, 0xab, 0x78, 0x0e, 0x28, 0xcb, 0x7f, 0x50, 0xac, 0x29, 0x3b, 0xae, 0xb9, 0xc3,
-	0xd6, 0xd0, 0x1d, 0x4c, 0x3d, 0xe8, 0x9f, 0x37, 0x45, 0x74, 0xe4, 0xc9, 0x47, 0x29, 0x16, 0xee,
-	0x7d, 0x1c, 0xfa, 0xaf, 0x14, 0x40, 0xfb, 0x06, 0x07, 0x7e, 0x06, 0x16, 0x32, 0xca, 0x31, 0x8a,
-	0xc9, 0x37, 0x38, 0x0a, 0x52, 0x1a, 0xdb, 0x95, 0x4b, 0x21, 0xdf, 0x7c, 0x86, 0x72, 0x40, 0x63,
-	0xf8, 0x00, 0xdc, 0x4a, 0x10, 0xef, 0x11, 0x1a, 0xf0, 0xbc, 0x84, 0x83, 0x04, 0xf1, 0x23, 0x7b,
-	0xee, 0x52, 0xc8, 0x2f, 0x6b, 0x20, 0x3f, 0xc7, 0xd9, 0x43, 0xfc, 0x08, 0x7e, 0x09, 0x60, 0x09,
-	0x9b, 0xd0, 0x08, 0x1f, 0xdb, 0xd7, 0x2e, 0x97, 0x90, 0x21, 0xf0, 0x8f, 0x72, 0x1c, 0xf8, 0x1a,
-	0x78, 0xa9, 0x1b, 0xb3, 0xf0, 0x28, 0xa0, 0x59, 0xd2, 0xc5, 0xdc, 0xae, 0x6d, 0x58, 0xad, 0x8a,
-	0x3f, 0xaf, 0x6c, 0xfb, 0xca, 0xe4, 0xf4, 0xc1, 0x1d, 0xf5, 0x7d, 0x77, 0xb3, 0x24, 0x8b, 0x91,
-	0x24, 0x7d, 0x7c, 0xc0, 0x71, 0x42, 0xb2, 0xe4, 0x43, 0x8e, 0xc2, 0xab, 0x2b, 0x38, 0xe7, 0xd7,
-	0x59, 0xb0, 0x35, 0x8d, 0xd8, 0x54, 0x1a, 0x05, 0xab, 0xe1, 0xc0, 0x29, 0x48, 0xb5, 0x57, 0xf0,
-	0xb5, 0x71, 0x33, 0x82, 0x5e, 0x34, 0x59, 0x2b, 0xe1, 0x79, 0xbc, 0xf0, 0x47, 0x0b, 0x6c, 0x63,
-	0x21, 0x49, 0x82, 0x24, 0x8e, 0x02, 0x8a, 0x8f, 0x65, 0x30, 0x89, 0xff, 0x72, 0xd5, 0xbb, 0x35,
-	0x60, 0xd8, 0xc7, 0xc7, 0xf2, 0xdc, 0x24, 0x38, 0x91, 0x19, 0x6e, 0x7b, 0x58, 0x72, 0x12, 0x8a,
-	0x2b, 0xfa, 0x1a, 0x9f, 0x98, 0x29, 0x33, 0x60, 0x31, 0xa9, 0x7f, 0x17, 0xd4, 0x12, 0x6d, 0x32,
-	0x3d, 0x7e, 0x7b, 0xb4, 0xc7, 0x4d, 0x84, 0x99, 0xa2, 0x85, 0xb7, 0xb3, 0x06, 0x1a, 0x1a, 0x90,
-	0x45, 0x59, 0x8c, 0x3f, 0x08, 0x43, 0x96, 0x51, 0x39, 0x98, 0xd8, 0x21, 0x58, 0x1d, 0x7b, 0x6a,
-	0x58, 0xef, 0x83, 0xeb, 0xc8, 0xd8, 0xcc, 0xdc, 0x74, 0x46, 0x69, 0x4d, 0xcc, 0xe7, 0x44, 0x1e,
-	0x76, 0x50, 0x8c, 0x68, 0x88, 0x8d, 0x82, 0x41, 0xa4, 0xf3, 0x9b, 0x05, 0xe0, 0xf3, 0x6e, 0x10,
-	0x82, 0x39, 0x8a, 0x12, 0x6c, 0xa6, 0xb8, 0x7a, 0x86, 0x36, 0xa8, 0xa1, 0x28, 0xe2, 0x58, 0x08,
-	0x33, 0xfe, 0x8a, 0x57, 0x88, 0x41, 0xad, 0xab, 0x03, 0xed, 0x8a, 0x52, 0xb2, 0xe2, 0xea, 0xcf,
-	0xe9, 0xe6, 0x3b, 0xca, 0x35, 0x3b, 0xca, 0xdd, 0x65, 0x84, 0x76, 0xee, 0xe6, 0x02, 0x7e, 0xff,
-	0x77, 0xbd, 0x75, 0x81, 0x12, 0xc8, 0x03, 0x84, 0x5f, 0x60, 0xef, 0xfc, 0x51, 0x05, 0xd7, 0x54,
-	0x46, 0x20, 0x05, 0x55, 0xbd, 0x97, 0xa0, 0x33, 0x7e, 0x57, 0x0c, 0xaf, 0xbe, 0xc6, 0xeb, 0x13,
-	0x7d, 0x74, 0x3a, 0x9d, 0xd5, 0x1f, 0xfe, 0xfa, 0xff, 0x97, 0xd9, 0x65, 0xb8, 0x58, 0xd4, 0x81,
-	0x5a, 0xbd, 0x7a, 0xdf, 0xc1, 0x6f, 0xc1, 0xcd, 0xd2, 0x7c, 0x87, 0x9b, 0x53, 0x56, 0x94, 0x26,
-	0xbe, 0xd8, 0x22, 0x73, 0x5e, 0x55, 0xd4, 0xb7, 0xe1, 0x72, 0x99, 0xba, 0xe0, 0xfa, 0x1e, 0x2c,
-	0x94, 0x77, 0x27, 0x9c, 0x8c, 0x3b, 0xb8, 0xf7, 0xd6, 0x34, 0x37, 0xc3, 0xdf, 0x54, 0xfc, 0x36,
-	0xac, 0x8f, 0xe5, 0x17, 0xf0, 0x4f, 0x0b, 0xac, 0x9c, 0xdb, 0x7b, 0xf0, 0xed, 0xb1, 0x2c, 0xd3,
-	0x26, 0x65, 0xe3, 0x9d, 0x17, 0x0d, 0x33, 0x62, 0xef, 0x2a, 0xb1, 0xdb, 0xb0, 0x55, 0x12, 0x3b,
-	0x61, 0xf4, 0x40, 0x0e, 0x6a, 0xa6, 0xff, 0xe0, 0xf8, 0x4a, 0x28, 0x4f, 0x8d, 0xc6, 0xe6, 0x64,
-	0x27, 0xa3, 0x63, 0x4d, 0xe9, 0xa8, 0xc3, 0xa5, 0x92, 0x0e, 0xd3, 0xd9, 0xf0, 0x27, 0x0b, 0x2c,
-	0x94, 0xfb, 0x16, 0x6e, 0x8f, 0x87, 0x1d, 0xd7, 0xfa, 0x8d, 0x37, 0x2e, 0xe4, 0x6b, 0x94, 0x6c,
-	0x2a, 0x25, 0x4d, 0xb8, 0x56, 0x28, 0xc9, 0x24, 0x89, 0xbd, 0x44, 0x39, 0x07, 0x45, 0xa3, 0x77,
-	0xf6, 0x1e, 0x9f, 0x36, 0xad, 0x27, 0xa7, 0x4d, 0xeb, 0xbf, 0xd3, 0xa6, 0xf5, 0xf3, 0x59, 0x73,
-	0xe6, 0xc9, 0x59, 0x73, 0xe6, 0xef, 0xb3, 0xe6, 0xcc, 0x83, 0x7b, 0xd3, 0xe6, 0xa1, 0xba, 0x99,
-	0xea, 0x48, 0xaf, 0xdf, 0x7e, 0x5f, 0x3d, 0x74, 0xab, 0xea, 0xbf, 0xe6, 0xbd, 0xa7, 0x01, 0x00,
-	0x00, 0xff, 0xff, 0xd0, 0x63, 0x7f, 0x9f, 0xfb, 0x0a, 0x00, 0x00,
+	// 1181 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0xcf, 0x4f, 0x24, 0x45,
+	0x14, 0xa6, 0x19, 0x96, 0x81, 0xc7, 0xee, 0xc8, 0x16, 0x03, 0x34, 0x03, 0x0c, 0xd8, 0x02, 0x4e,
+	0x58, 0xed, 0xde, 0x61, 0xfd, 0x11, 0xb3, 0x27, 0x81, 0xac, 0xd9, 0x18, 0x70, 0x76, 0x12, 0xa3,
+	0x59, 0x35, 0x9d, 0x9a, 0x9e, 0x72, 0xa8, 0xd0, 0x55, 0xdd, 0xf4, 0x8f, 0xc9, 0xac, 0xc6, 0x98,
+	0x78, 0xf4, 0xa2, 0x89, 0x17, 0x4f, 0xde, 0x3c, 0xe8, 0xd1, 0xab, 0xfe, 0x01, 0x7b, 0xdc, 0xc4,
+	0x98, 0x18, 0x0f, 0xab, 0x01, 0xff, 0x10, 0xd3, 0xd5, 0xd5, 0x33, 0xd3, 0x43, 0xc3, 0xe0, 0x24,
+	0x9c, 0xe8, 0xa9, 0xfa, 0xde, 0xf7, 0xbe, 0xaa, 0xfa, 0xde, 0xab, 0x02, 0xe6, 0x5c, 0xe2, 0xb9,
+	0x46, 0xbb, 0x6a, 0x9c, 0x84, 0xc4, 0x7b, 0xa2, 0xbb, 0x9e, 0x13, 0x38, 0xa8, 0xc0, 0x69, 0x83,
+	0x7a, 0xa1, 0x1e, 0xcd, 0xe9, 0xed, 0x6a, 0xa9, 0xd8, 0x72, 0x5a, 0x8e, 0x98, 0x32, 0xa2, 0xaf,
+	0x18, 0x55, 0x5a, 0x69, 0x39, 0x4e, 0xcb, 0x26, 0x06, 0x76, 0xa9, 0x81, 0x39, 0x77, 0x02, 0x1c,
+	0x50, 0x87, 0xfb, 0x72, 0xb6, 0x4b, 0xec, 0x07, 0x38, 0x20, 0x72, 0xb0, 0x6c, 0x39, 0x3e, 0x73,
+	0x7c, 0xa3, 0x81, 0x7d, 0x62, 0xb4, 0xab, 0x0d, 0x12, 0xe0, 0xaa, 0x61, 0x39, 0x94, 0xcb, 0xf9,
+	0xed, 0xfe, 0x79, 0xa1, 0xa8, 0x8b, 0x72, 0x71, 0x8b, 0x72, 0x91, 0x41, 0x62, 0x17, 0x45, 0x02,
+	0xcc, 0xd8, 0x40, 0x12, 0xad, 0x08, 0xe8, 0x51, 0x14, 0x5a, 0xc3, 0x1e, 0x66, 0x7e, 0x9d, 0x9c,
+	0x84, 0xc4, 0x0f, 0xb4, 0x77, 0x61, 0x2e, 0x35, 0xea, 0xbb, 0x0e, 0xf7, 0x09, 0x7a, 0x0d, 0x26,
+	0x5d, 0x31, 0xa2, 0x2a, 0xeb, 0x4a, 0x65, 0x66, 0x67, 0x41, 0x4f, 0xaf, 0x5d, 0x8f, 0xf1, 0xbb,
+	0x13, 0x4f, 0x9f, 0xaf, 0x8d, 0xd5, 0x25, 0x56, 0x33, 0x60, 0x3e, 0x26, 0x73, 0x7c, 0x2a, 0x16,
+	0x2d, 0xb3, 0xa0, 0x05, 0x98, 0x0c, 0x3c, 0xdc, 0x24, 0x9e, 0xa0, 0x9b, 0xae, 0xcb, 0x5f, 0xda,
+	0x27, 0xb0, 0x30, 0x18, 0x20, 0x05, 0xec, 0xc1, 0xb4, 0x9b, 0x0c, 0xaa, 0xca, 0x7a, 0xae, 0x32,
+	0xb3, 0xb3, 0x39, 0xa8, 0x21, 0x15, 0x9a, 0x44, 0xd6, 0x7b, 0x71, 0xda, 0x0f, 0x39, 0x58, 0x16,
+	0xa0, 0x03, 0xec, 0x1d, 0x93, 0xe0, 0x9c, 0xac, 0x03, 0x98, 0x70, 0x31, 0x95, 0xa2, 0x76, 0xdf,
+	0x8a, 0xd6, 0xf2, 0xd7, 0xf3, 0xb5, 0x6a, 0x8b, 0x06, 0x47, 0x61, 0x43, 0xb7, 0x1c, 0x66, 0x1c,
+	0x8a, 0x8c, 0x7b, 0x47, 0x98, 0x72, 0x23, 0xce, 0x6e, 0x74, 0x0c, 0xcb, 0x61, 0xcc, 0xe1, 0x06,
+	0xf6, 0x7d, 0x12, 0xe8, 0x35, 0x4c, 0xbd, 0xba, 0xa0, 0x41, 0x1f, 0xc2, 0x2c, 0xc3, 0x1d, 0x93,
+	0x61, 0xaf, 0x45, 0xb9, 0xe9, 0x45, 0xa7, 0xa2, 0x8e, 0x0b, 0x6a, 0x5d, 0x52, 0x6f, 0xf5, 0x51,
+	0xcb, 0x33, 0x8d, 0xff, 0xbc, 0xea, 0x37, 0x8f, 0x8d, 0xe0, 0x89, 0x4b, 0x7c, 0x7d, 0x9f, 0x58,
+	0xf5, 0x02, 0xc3, 0x9d, 0x03, 0x41, 0x53, 0x8f, 0x58, 0xd0, 0x7d, 0x98, 0x6e, 0x52, 0x8f, 0x58,
+	0x91, 0x7a, 0x35, 0xb7, 0xae, 0x54, 0x0a, 0x3b, 0xab, 0xa9, 0xdd, 0xc0, 0x8c, 0x45, 0x3b, 0xb2,
+	0x9f, 0x80, 0xea, 0x3d, 0x3c, 0x7a, 0x04, 0x37, 0x19, 0xe5, 0x26, 0x77, 0xa2, 0x5f, 0xd8, 0x56,
+	0x27, 0x46, 0x92, 0x34, 0xc3, 0x28, 0x3f, 0x94, 0x14, 0xe8, 0x01, 0x40, 0xcf, 0x78, 0xea, 0x0d,
+	0x61, 0x91, 0x2d, 0x3d, 0x8e, 0xd3, 0x23, 0x97, 0xea, 0x71, 0xdd, 0x48, 0x97, 0xea, 0x35, 0xdc,
+	0x22, 0x72, 0xd3, 0xeb, 0x7d, 0x91, 0xda, 0x2f, 0x0a, 0xac, 0x64, 0x1f, 0x90, 0xb4, 0xc1, 0xc3,
+	0x51, 0x6d, 0x20, 0x9d, 0xd9, 0x8b, 0x46, 0xef, 0xa4, 0x34, 0x8f, 0x0b, 0xcd, 0x2f, 0x0f, 0xd5,
+	0x2c, 0x4d, 0xd5, 0x2f, 0xfa, 0x0b, 0x28, 0x0e, 0xa4, 0xbc, 0x16, 0x37, 0xf5, 0x6a, 0x66, 0x3c,
+	0x55, 0x33, 0xbf, 0x4e, 0x0c, 0x54, 0x59, 0x5f, 0xd1, 0x4e, 0x25, 0xcb, 0x95, 0x65, 0xab, 0x9e,
+	0x2b, 0xdb, 0x24, 0xa6, 0x8b, 0x44, 0x1f, 0xc1, 0xed, 0xe4, 0xbb, 0xe7, 0x91, 0xd1, 0x6c, 0x3b,
+	0x9b, 0x10, 0x75, 0x8d, 0xf2, 0x3e, 0x14, 0x42, 0xee, 0x11, 0x6c, 0xd3, 0xcf, 0x48, 0xd3, 0x74,
+	0xb9, 0xad, 0xe6, 0x46, 0x62, 0xbe, 0xd5, 0x63, 0xa9, 0x71, 0x1b, 0x3d, 0x86, 0xdb, 0xfd, 0x55,
+	0x16, 0x95, 0xdc, 0xf1, 0x88, 0xbe, 0x7e, 0x81, 0xf5, 0xea, 0x2c, 0x72, 0x21, 0xfa, 0x18, 0x50,
+	0x8a, 0x9b, 0xf2, 0x26, 0xe9, 0xa8, 0x37, 0x46, 0x22, 0x9f, 0xed, 0x23, 0x7f, 0x18, 0xf1, 0xa0,
+	0x17, 0xe1, 0x66, 0xc3, 0x76, 0xac, 0x63, 0x93, 0x87, 0xac, 0x41, 0x3c, 0x35, 0xbf, 0xae, 0x54,
+	0x72, 0xf5, 0x19, 0x31, 0x76, 0x28, 0x86, 0xa2, 0x03, 0xb1, 0xe9, 0x49, 0x48, 0x9b, 0xc2, 0x6e,
+	0xa6, 0xeb, 0x51, 0x8b, 0xa8, 0x53, 0xa3, 0xe5, 0xef, 0x23, 0xaa, 0x45, 0x3c, 0x5a, 0x1b, 0x36,
+	0x85, 0x79, 0xf6, 0x42, 0x16, 0xda, 0x38, 0xa0, 0x6d, 0x52, 0xf3, 0x08, 0xa3, 0x21, 0x7b, 0xe0,
+	0x61, 0xeb, 0xfa, 0xdc, 0xac, 0xfd, 0x38, 0x0e, 0x5b, 0xc3, 0x12, 0x4b, 0x1b, 0x73, 0x58, 0xb6,
+	0xba, 0x20, 0xd3, 0x8d, 0x51, 0xe6, 0xa7, 0x12, 0xa6, 0x2a, 0x23, 0xed, 0xc4, 0x92, 0x75, 0x51,
+	0x5e, 0xf4, 0xb5, 0x02, 0xdb, 0xc4, 0x0f, 0x28, 0xc3, 0x01, 0x69, 0x9a, 0x9c, 0x74, 0x02, 0xf3,
+	0xb2, 0xfc, 0xa3, 0x95, 0xc6, 0x56, 0x37, 0xc3, 0x21, 0xe9, 0x04, 0x17, 0x6e, 0x82, 0xd6, 0x94,
+	0xf7, 0xf1, 0x01, 0x09, 0x3c, 0x6a, 0x5d, 0xd3, 0x4d, 0xa5, 0xbd, 0x07, 0xc5, 0x74, 0x16, 0xb9,
+	0xf5, 0x6f, 0x42, 0x9e, 0xc5, 0x43, 0xb2, 0x81, 0x2c, 0x0e, 0x36, 0x10, 0x19, 0x21, 0xdb, 0x6b,
+	0x82, 0xd6, 0x56, 0xa0, 0x14, 0x13, 0x3a, 0xcd, 0xd0, 0x26, 0x6f, 0x5b, 0x96, 0x13, 0xf2, 0xa0,
+	0xfb, 0xc8, 0xb0, 0x60, 0x39, 0x73, 0x56, 0x66, 0xdd, 0x87, 0x29, 0x2c, 0xc7, 0x64, 0x8f, 0xd7,
+	0x06, 0xd3, 0xca, 0x98, 0x0f, 0x68, 0x70, 0xb4, 0x8b, 0x6d, 0xcc, 0xad, 0xa4, 0xc1, 0x77, 0x23,
+	0xb5, 0x9f, 0x14, 0x40, 0xe7, 0x61, 0x08, 0xc1, 0x04, 0xc7, 0x8c, 0xc8, 0x87, 0x87, 0xf8, 0x46,
+	0x2a, 0xe4, 0x71, 0xb3, 0xe9, 0x11, 0xdf, 0x97, 0xbd, 0x35, 0xf9, 0x89, 0x08, 0xe4, 0x1b, 0x71,
+	0xa0, 0x9a, 0x13, 0x4a, 0x96, 0x52, 0x37, 0x44, 0x72, 0x37, 0xec, 0x39, 0x94, 0xef, 0xde, 0x8d,
+	0x04, 0xfc, 0xfc, 0xf7, 0x5a, 0xe5, 0x0a, 0x16, 0x88, 0x02, 0xfc, 0x7a, 0xc2, 0xbd, 0xf3, 0x47,
+	0x1e, 0x6e, 0x88, 0x1d, 0x41, 0x1c, 0x26, 0xe3, 0xa7, 0x14, 0xd2, 0xb2, 0xef, 0xb5, 0xfe, 0xd7,
+	0x5a, 0xe9, 0xa5, 0x4b, 0x31, 0xf1, 0x76, 0x6a, 0xcb, 0x5f, 0xfd, 0xfe, 0xef, 0x77, 0xe3, 0xf3,
+	0x68, 0x2e, 0xf1, 0x41, 0x04, 0x36, 0xe2, 0x27, 0x1a, 0xfa, 0x1c, 0x6e, 0xa5, 0x2e, 0x0f, 0xb4,
+	0x31, 0xe4, 0x3a, 0x8d, 0x13, 0x5f, 0xed, 0xd2, 0xd5, 0x56, 0x45, 0xea, 0x45, 0x34, 0x9f, 0x4e,
+	0x9d, 0xe4, 0xfa, 0x5e, 0x81, 0x62, 0xd6, 0x75, 0x8f, 0xee, 0x64, 0xd2, 0x67, 0xbf, 0xda, 0x4a,
+	0xaf, 0x5c, 0x0d, 0x2c, 0x25, 0x6d, 0x0a, 0x49, 0x6b, 0x68, 0x35, 0x25, 0x89, 0x09, 0xb4, 0xd9,
+	0x7b, 0x1d, 0x7c, 0x09, 0x85, 0xd4, 0x92, 0x7c, 0x74, 0xf9, 0x92, 0xbb, 0x6a, 0xb6, 0x86, 0xc1,
+	0xa4, 0x8e, 0xb2, 0xd0, 0xa1, 0xa2, 0x85, 0xcc, 0xad, 0xf1, 0xd1, 0x6f, 0x0a, 0x2c, 0x5d, 0xd8,
+	0x16, 0xd0, 0xeb, 0x99, 0x59, 0x86, 0x35, 0xf1, 0xd2, 0x1b, 0xff, 0x37, 0x4c, 0x8a, 0xbd, 0x2b,
+	0xc4, 0x6e, 0xa3, 0x4a, 0x4a, 0xec, 0x25, 0x5d, 0x11, 0x79, 0x90, 0x97, 0xad, 0x01, 0x65, 0x9b,
+	0x34, 0xdd, 0xd0, 0x4a, 0x1b, 0x97, 0x83, 0xa4, 0x8e, 0x15, 0xa1, 0x63, 0x01, 0x15, 0xd3, 0x87,
+	0x27, 0x13, 0x7d, 0xa3, 0x40, 0x21, 0xdd, 0x52, 0xd0, 0x76, 0x36, 0x6d, 0x56, 0x57, 0x2a, 0xdd,
+	0xb9, 0x12, 0x56, 0x2a, 0xd9, 0x10, 0x4a, 0xca, 0x68, 0x25, 0x51, 0x12, 0x06, 0xd4, 0x36, 0x98,
+	0x00, 0x9b, 0x49, 0x0f, 0xda, 0x3d, 0x78, 0x7a, 0x5a, 0x56, 0x9e, 0x9d, 0x96, 0x95, 0x7f, 0x4e,
+	0xcb, 0xca, 0xb7, 0x67, 0xe5, 0xb1, 0x67, 0x67, 0xe5, 0xb1, 0x3f, 0xcf, 0xca, 0x63, 0x8f, 0xef,
+	0x0d, 0x6b, 0xd5, 0x62, 0x65, 0xa2, 0x59, 0x18, 0xed, 0xea, 0x7d, 0xf1, 0xd1, 0x98, 0x14, 0xff,
+	0xb9, 0xdd, 0xfb, 0x6f, 0x00, 0x82, 0x7c, 0x97, 0xdf, 0x8e, 0x0e, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -718,6 +849,9 @@ type QueryClient interface {
 	// Parameters queries the parameters of the x/perp module.
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
 	QueryPosition(ctx context.Context, in *QueryPositionRequest, opts ...grpc.CallOption) (*QueryPositionResponse, error)
+	// Queries the open positions of a market across all traders, with optional
+	// filters on margin ratio, side and notional.
+	QueryMarketPositions(ctx context.Context, in *QueryMarketPositionsRequest, opts ...grpc.CallOption) (*QueryMarketPositionsResponse, error)
 	QueryPositions(ctx context.Context, in *QueryPositionsRequest, opts ...grpc.CallOption) (*QueryPositionsResponse, error)
 	// Queries the latest cumulative premium fraction and the estimated next
 	// cumulative premium fraction.
@@ -753,6 +887,15 @@ func (c *queryClient) QueryPosition(ctx context.Context, in *QueryPositionReques
 	return out, nil
 }
 
+func (c *queryClient) QueryMarketPositions(ctx context.Context, in *QueryMarketPositionsRequest, opts ...grpc.CallOption) (*QueryMarketPositionsResponse, error) {
+	out := new(QueryMarketPositionsResponse)
+	err := c.cc.Invoke(ctx, "/nibiru.perp.v1.Query/QueryMarketPositions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) QueryPositions(ctx context.Context, in *QueryPositionsRequest, opts ...grpc.CallOption) (*QueryPositionsResponse, error) {
 	out := new(QueryPositionsResponse)
 	err := c.cc.Invoke(ctx, "/nibiru.perp.v1.Query/QueryPositions", in, out, opts...)
@@ -794,6 +937,9 @@ type QueryServer interface {
 	// Parameters queries the parameters of the x/perp module.
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
 	QueryPosition(context.Context, *QueryPositionRequest) (*QueryPositionResponse, error)
+	// Queries the open positions of a market across all traders, with optional
+	// filters on margin ratio, side and notional.
+	QueryMarketPositions(context.Context, *QueryMarketPositionsRequest) (*QueryMarketPositionsResponse, error)
 	QueryPositions(context.Context, *QueryPositionsRequest) (*QueryPositionsResponse, error)
 	// Queries the latest cumulative premium fraction and the estimated next
 	// cumulative premium fraction.
@@ -813,6 +959,9 @@ func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsReq
 func (*UnimplementedQueryServer) QueryPosition(ctx context.Context, req *QueryPositionRequest) (*QueryPositionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method QueryPosition not implemented")
 }
+func (*UnimplementedQueryServer) QueryMarketPositions(ctx context.Context, req *QueryMarketPositionsRequest) (*QueryMarketPositionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryMarketPositions not implemented")
+}
 func (*UnimplementedQueryServer) QueryPositions(ctx context.Context, req *QueryPositionsRequest) (*QueryPositionsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method QueryPositions not implemented")
 }
@@ -866,6 +1015,24 @@ func _Query_QueryPosition_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_QueryMarketPositions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMarketPositionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryMarketPositions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nibiru.perp.v1.Query/QueryMarketPositions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryMarketPositions(ctx, req.(*QueryMarketPositionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_QueryPositions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryPositionsRequest)
 	if err := dec(in); err != nil {
@@ -950,6 +1117,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "QueryPosition",
 			Handler:    _Query_QueryPosition_Handler,
 		},
+		{
+			MethodName: "QueryMarketPositions",
+			Handler:    _Query_QueryMarketPositions_Handler,
+		},
 		{
 			MethodName: "QueryPositions",
 			Handler:    _Query_QueryPositions_Handler,
@@ -1094,6 +1265,125 @@ func (m *QueryPositionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryMarketPositionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMarketPositionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMarketPositionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	{
+		size := m.MinNotional.Size()
+		i -= size
+		if _, err := m.MinNotional.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.Direction != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Direction))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.MaxMarginRatio.Size()
+		i -= size
+		if _, err := m.MaxMarginRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.Pair.Size()
+		i -= size
+		if _, err := m.Pair.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMarketPositionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMarketPositionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMarketPositionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Positions) > 0 {
+		for iNdEx := len(m.Positions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Positions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *QueryPositionRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1154,6 +1444,16 @@ func (m *QueryPositionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.LiquidationPrice.Size()
+		i -= size
+		if _, err := m.LiquidationPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
 	if m.BlockNumber != 0 {
 		i = encodeVarintQuery(dAtA, i, uint64(m.BlockNumber))
 		i--
@@ -1526,6 +1826,47 @@ func (m *QueryPositionsResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryMarketPositionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Pair.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.MaxMarginRatio.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Direction != 0 {
+		n += 1 + sovQuery(uint64(m.Direction))
+	}
+	l = m.MinNotional.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryMarketPositionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Positions) > 0 {
+		for _, e := range m.Positions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func (m *QueryPositionRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1562,6 +1903,8 @@ func (m *QueryPositionResponse) Size() (n int) {
 	if m.BlockNumber != 0 {
 		n += 1 + sovQuery(uint64(m.BlockNumber))
 	}
+	l = m.LiquidationPrice.Size()
+	n += 1 + l + sovQuery(uint64(l))
 	return n
 }
 
@@ -1963,7 +2306,7 @@ func (m *QueryPositionsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPositionRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryMarketPositionsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1986,10 +2329,10 @@ func (m *QueryPositionRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPositionRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryMarketPositionsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPositionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryMarketPositionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2028,7 +2371,7 @@ func (m *QueryPositionRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Trader", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxMarginRatio", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2056,16 +2399,343 @@ func (m *QueryPositionRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Trader = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
+			if err := m.MaxMarginRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Direction", wireType)
+			}
+			m.Direction = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Direction |= types.Direction(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinNotional", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinNotional.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMarketPositionsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMarketPositionsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMarketPositionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Positions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Positions = append(m.Positions, QueryPositionResponse{})
+			if err := m.Positions[len(m.Positions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPositionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPositionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPositionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pair", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Pair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Trader", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Trader = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
 			}
 			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
@@ -2299,6 +2969,40 @@ func (m *QueryPositionResponse) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidationPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LiquidationPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2945,7 +3649,7 @@ func (m *AccountWithBalance) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Balance = append(m.Balance, types.Coin{})
+			m.Balance = append(m.Balance, types1.Coin{})
 			if err := m.Balance[len(m.Balance)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
@@ -4,6 +4,8 @@ import (
 	"fmt"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/NibiruChain/nibiru/x/common/asset"
 )
 
 func (m *Position) Validate() error {
@@ -52,3 +54,56 @@ func (m *PrepaidBadDebt) Validate() error {
 		Amount: m.Amount,
 	}.Validate()
 }
+
+// ZeroMetrics returns the metrics of a pair without any trades.
+func ZeroMetrics(pair asset.Pair) Metrics {
+	return Metrics{
+		Pair:              pair,
+		NetSize:           sdk.ZeroDec(),
+		VolumeQuote:       sdk.ZeroDec(),
+		VolumeBase:        sdk.ZeroDec(),
+		OpenInterestLong:  sdk.ZeroDec(),
+		OpenInterestShort: sdk.ZeroDec(),
+	}
+}
+
+// UpdateOpenInterest returns the metrics after a position changes size from
+// oldSize to newSize. Positive sizes are long, negative sizes are short.
+func (m Metrics) UpdateOpenInterest(oldSize sdk.Dec, newSize sdk.Dec) Metrics {
+	if oldSize.IsPositive() {
+		m.OpenInterestLong = m.OpenInterestLong.Sub(oldSize)
+	} else {
+		m.OpenInterestShort = m.OpenInterestShort.Add(oldSize)
+	}
+
+	if newSize.IsPositive() {
+		m.OpenInterestLong = m.OpenInterestLong.Add(newSize)
+	} else {
+		m.OpenInterestShort = m.OpenInterestShort.Sub(newSize)
+	}
+
+	return m
+}
+
+// CheckOpenInterestCap returns an error if a side of the open interest went
+// up and is over the max open interest. A side that goes down is always
+// allowed, even over the cap, so that traders can reduce their positions
+// after the cap is lowered. A zero or empty max open interest disables the
+// cap.
+func (m Metrics) CheckOpenInterestCap(before Metrics, maxOpenInterest sdk.Dec) error {
+	if maxOpenInterest.IsNil() || !maxOpenInterest.IsPositive() {
+		return nil
+	}
+
+	if m.OpenInterestLong.GT(before.OpenInterestLong) && m.OpenInterestLong.GT(maxOpenInterest) {
+		return ErrOpenInterestCapExceeded.Wrapf(
+			"long open interest %s, max open interest %s", m.OpenInterestLong, maxOpenInterest)
+	}
+
+	if m.OpenInterestShort.GT(before.OpenInterestShort) && m.OpenInterestShort.GT(maxOpenInterest) {
+		return ErrOpenInterestCapExceeded.Wrapf(
+			"short open interest %s, max open interest %s", m.OpenInterestShort, maxOpenInterest)
+	}
+
+	return nil
+}
@@ -371,6 +371,10 @@ type Metrics struct {
 	VolumeQuote github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=volumeQuote,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"volumeQuote"`
 	// Total size volume for the pair.
 	VolumeBase github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=volumeBase,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"volumeBase"`
+	// Sum of the sizes of all long positions for the pair.
+	OpenInterestLong github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=open_interest_long,json=openInterestLong,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"open_interest_long"`
+	// Sum of the absolute sizes of all short positions for the pair.
+	OpenInterestShort github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=open_interest_short,json=openInterestShort,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"open_interest_short"`
 }
 
 func (m *Metrics) Reset()         { *m = Metrics{} }
@@ -420,70 +424,72 @@ func init() {
 func init() { proto.RegisterFile("perp/v1/state.proto", fileDescriptor_0416b6ef16ef80be) }
 
 var fileDescriptor_0416b6ef16ef80be = []byte{
-	// 993 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0xcf, 0x6f, 0xe3, 0x44,
-	0x14, 0xae, 0x93, 0xb4, 0x49, 0xa7, 0x3f, 0x88, 0xa6, 0x2d, 0x9b, 0x76, 0x51, 0x52, 0x22, 0x81,
-	0xaa, 0x02, 0xb1, 0xb2, 0x7b, 0x40, 0xc0, 0x29, 0x49, 0x93, 0x55, 0xa4, 0xc4, 0x35, 0x6e, 0x97,
-	0xad, 0xe0, 0x60, 0x8d, 0xed, 0x97, 0x74, 0xa8, 0x3d, 0xe3, 0x1d, 0x8f, 0x53, 0x0a, 0x27, 0xfe,
-	0x03, 0x4e, 0x88, 0xbf, 0x86, 0x1b, 0xd2, 0x1e, 0xf7, 0x88, 0xf6, 0x50, 0x50, 0x7b, 0xe3, 0xc8,
-	0x5f, 0x80, 0x3c, 0x76, 0xb3, 0x11, 0xaa, 0x04, 0xb2, 0x76, 0x4f, 0x99, 0x99, 0x6f, 0xde, 0xf7,
-	0xbd, 0x79, 0xef, 0x7b, 0x49, 0xd0, 0x56, 0x08, 0x22, 0xd4, 0x67, 0x6d, 0x3d, 0x92, 0x44, 0x42,
-	0x2b, 0x14, 0x5c, 0x72, 0xbc, 0xc9, 0xa8, 0x43, 0x45, 0xdc, 0x4a, 0xb0, 0xd6, 0xac, 0xbd, 0xb7,
-	0x3d, 0xe5, 0x53, 0xae, 0x20, 0x3d, 0x59, 0xa5, 0xb7, 0xf6, 0xea, 0x2e, 0x8f, 0x02, 0x1e, 0xe9,
-	0x0e, 0x89, 0x40, 0x9f, 0xb5, 0x1d, 0x90, 0xa4, 0xad, 0xbb, 0x9c, 0xb2, 0x0c, 0xdf, 0x4d, 0x71,
-	0x3b, 0x0d, 0x4c, 0x37, 0x77, 0xa1, 0x53, 0xce, 0xa7, 0x3e, 0xe8, 0x6a, 0xe7, 0xc4, 0x13, 0xdd,
-	0x8b, 0x05, 0x91, 0x94, 0x67, 0xa1, 0xcd, 0x5f, 0x97, 0xd1, 0x8a, 0x49, 0x04, 0x09, 0x22, 0x5c,
-	0x43, 0xe5, 0x48, 0xf2, 0x30, 0x04, 0xaf, 0xa6, 0xed, 0x6b, 0x07, 0x15, 0xeb, 0x6e, 0x8b, 0xbf,
-	0x41, 0x78, 0x02, 0x60, 0x87, 0x9c, 0xfb, 0x76, 0xb2, 0x50, 0x0c, 0xb5, 0xe2, 0xbe, 0x76, 0xb0,
-	0xda, 0x6d, 0xbd, 0xb8, 0x6e, 0x2c, 0xbd, 0xba, 0x6e, 0x7c, 0x38, 0xa5, 0xf2, 0x3c, 0x76, 0x5a,
-	0x2e, 0x0f, 0xb2, 0x0c, 0xb2, 0x8f, 0x4f, 0x22, 0xef, 0x42, 0x97, 0x57, 0x21, 0x44, 0xad, 0x23,
-	0x70, 0xad, 0x77, 0x26, 0x00, 0x26, 0xe7, 0xfe, 0x00, 0xc0, 0x4a, 0x68, 0xf0, 0x14, 0xd5, 0xc0,
-	0xe5, 0xd1, 0x55, 0x24, 0x21, 0xb0, 0x27, 0x31, 0xf3, 0x16, 0x24, 0x4a, 0xb9, 0x24, 0x76, 0xe6,
-	0x7c, 0x83, 0x98, 0x79, 0x73, 0x21, 0x07, 0xed, 0xf8, 0xf4, 0x79, 0x4c, 0x3d, 0xf5, 0xfe, 0x05,
-	0x95, 0xe5, 0x5c, 0x2a, 0x5b, 0x0b, 0x64, 0x73, 0x8d, 0x6f, 0xd1, 0x6e, 0x48, 0x84, 0xa4, 0xc4,
-	0xb7, 0x17, 0xb5, 0x52, 0x9d, 0x95, 0x5c, 0x3a, 0x0f, 0x32, 0xc2, 0xd1, 0x6b, 0xbe, 0x54, 0xeb,
-	0x11, 0xda, 0x49, 0xca, 0x45, 0xd9, 0x34, 0xe1, 0x07, 0x9b, 0x32, 0x09, 0x62, 0x46, 0xfc, 0x5a,
-	0x39, 0xd1, 0xb1, 0xb6, 0x32, 0xd0, 0x22, 0x12, 0x86, 0x19, 0x84, 0x7f, 0xd6, 0xd0, 0xb6, 0xbc,
-	0x24, 0xa1, 0xed, 0x73, 0x7e, 0xe1, 0x10, 0xf7, 0xc2, 0xbe, 0xa4, 0xcc, 0xe3, 0x97, 0xb5, 0xca,
-	0xbe, 0x76, 0xb0, 0xf6, 0x68, 0xb7, 0x95, 0xda, 0xa5, 0x75, 0x67, 0x97, 0xd6, 0x51, 0x66, 0x97,
-	0xee, 0x30, 0x49, 0xfb, 0xaf, 0xeb, 0x46, 0xfd, 0xbe, 0xf0, 0x8f, 0x79, 0x40, 0x25, 0x04, 0xa1,
-	0xbc, 0xfa, 0xfb, 0xba, 0xf1, 0xf0, 0x8a, 0x04, 0xfe, 0xe7, 0xcd, 0xfb, 0xee, 0x35, 0x7f, 0xf9,
-	0xa3, 0xa1, 0x59, 0x38, 0x81, 0x46, 0x19, 0xf2, 0x4c, 0x01, 0xf8, 0x53, 0xf4, 0xe0, 0xf2, 0x9c,
-	0x4a, 0xf0, 0x69, 0x24, 0xc1, 0x9b, 0x17, 0x8f, 0x8b, 0xa8, 0xb6, 0xba, 0x5f, 0x3c, 0x58, 0xb5,
-	0xde, 0x5d, 0x80, 0x47, 0xaf, 0xd1, 0xe6, 0x8f, 0x25, 0x54, 0x31, 0x79, 0x44, 0x93, 0x24, 0xf1,
-	0x07, 0x68, 0x53, 0x0a, 0xe2, 0x81, 0xb0, 0x89, 0xe7, 0x09, 0x88, 0x22, 0xe5, 0xe4, 0x55, 0x6b,
-	0x23, 0x3d, 0xed, 0xa4, 0x87, 0x78, 0x8c, 0x4a, 0x21, 0xa1, 0xa2, 0x56, 0x50, 0x0d, 0xf9, 0x2c,
-	0x6b, 0x48, 0x7b, 0xa1, 0x21, 0x86, 0x1a, 0xcb, 0xde, 0x39, 0xa1, 0x4c, 0x4f, 0x47, 0x54, 0xff,
-	0x4e, 0x77, 0x79, 0x10, 0x70, 0xa6, 0x93, 0x28, 0x02, 0xd9, 0x32, 0x09, 0x15, 0x96, 0xa2, 0xc1,
-	0x5d, 0x54, 0x8a, 0xe8, 0xf7, 0x90, 0x73, 0x20, 0x54, 0x2c, 0x1e, 0xa0, 0x95, 0x80, 0x88, 0x29,
-	0x65, 0x39, 0x3d, 0x9f, 0x45, 0xe3, 0x13, 0xb4, 0xc1, 0x43, 0x60, 0x36, 0xe3, 0x49, 0x41, 0x88,
-	0x9f, 0xd3, 0xdc, 0xeb, 0x09, 0x89, 0x91, 0x71, 0xe0, 0x1f, 0x50, 0xd3, 0x27, 0x12, 0x22, 0x69,
-	0xbb, 0x71, 0x10, 0xfb, 0x44, 0xd2, 0x19, 0xd8, 0xa1, 0x80, 0x80, 0xc6, 0x81, 0x3d, 0x11, 0xc4,
-	0x4d, 0xee, 0xe5, 0xb4, 0x77, 0x23, 0x65, 0xee, 0xcd, 0x89, 0xcd, 0x94, 0x77, 0x90, 0xd1, 0xe2,
-	0xf7, 0xd1, 0xba, 0xe3, 0x73, 0xf7, 0xc2, 0x66, 0x71, 0xe0, 0x80, 0x50, 0xee, 0x2e, 0x5a, 0x6b,
-	0xea, 0xcc, 0x50, 0x47, 0xcd, 0x57, 0x1a, 0x5a, 0x4f, 0xfa, 0x31, 0x06, 0x49, 0x3c, 0x22, 0xc9,
-	0xbc, 0xc1, 0xda, 0x9b, 0x69, 0xf0, 0xff, 0x7b, 0x7f, 0xe1, 0xad, 0xbc, 0xbf, 0xc9, 0xd0, 0xa6,
-	0x29, 0x20, 0x24, 0xd4, 0xeb, 0x12, 0xef, 0x08, 0x1c, 0x89, 0xb7, 0xd1, 0xb2, 0x07, 0x8c, 0x07,
-	0x99, 0xb9, 0xd3, 0x4d, 0xe2, 0x20, 0x12, 0xf0, 0x98, 0xc9, 0x1c, 0x89, 0x0c, 0x99, 0xb4, 0xb2,
-	0xe8, 0xe6, 0x6f, 0x05, 0x54, 0x1e, 0x83, 0x14, 0xd4, 0x8d, 0xde, 0x74, 0x1d, 0x87, 0xa8, 0xc2,
-	0x40, 0xda, 0x6a, 0x58, 0xf2, 0x55, 0xab, 0xcc, 0x40, 0x9e, 0x24, 0xf3, 0x62, 0xa2, 0xb5, 0x19,
-	0xf7, 0xe3, 0x00, 0xbe, 0x8c, 0xb9, 0xcc, 0x3b, 0x7a, 0x8b, 0x14, 0xd8, 0x40, 0x28, 0xdd, 0x76,
-	0x49, 0x04, 0x39, 0xa7, 0x70, 0x81, 0xe1, 0xf0, 0x2b, 0xb4, 0x61, 0xb2, 0x51, 0x8f, 0xf8, 0xee,
-	0x71, 0xa8, 0x8c, 0xdc, 0x40, 0x0f, 0x4d, 0x63, 0x64, 0xf7, 0x3a, 0xa3, 0x9e, 0x7d, 0x6c, 0x9e,
-	0x0e, 0x8f, 0x0d, 0xfb, 0xa9, 0x71, 0x62, 0xf6, 0x7b, 0xc3, 0xc1, 0xb0, 0x7f, 0x54, 0x5d, 0xc2,
-	0x9b, 0x08, 0x9d, 0x98, 0xc7, 0xa7, 0xb6, 0x69, 0x0d, 0x7b, 0xfd, 0xaa, 0x86, 0x2b, 0xa8, 0x74,
-	0xfa, 0xac, 0x63, 0x56, 0x0b, 0x18, 0xa1, 0x95, 0x63, 0xab, 0xd3, 0x1b, 0xf5, 0xab, 0xc5, 0xc3,
-	0x27, 0x68, 0xcb, 0x64, 0x23, 0x53, 0xc0, 0x04, 0x04, 0x30, 0x17, 0x32, 0xf6, 0x3a, 0xda, 0x4b,
-	0xd8, 0x4d, 0xab, 0x3f, 0xe8, 0x5b, 0x7d, 0xa3, 0xd7, 0xff, 0x17, 0x79, 0x19, 0x15, 0xc7, 0x9d,
-	0xb3, 0xaa, 0xa6, 0x16, 0x43, 0xa3, 0x5a, 0x38, 0x7c, 0x8e, 0xde, 0x1b, 0xab, 0x2f, 0x8d, 0x24,
-	0x47, 0x65, 0x3e, 0xce, 0x4c, 0x41, 0xe7, 0x8c, 0x3a, 0xfa, 0x68, 0xdc, 0xb1, 0x9e, 0x0c, 0x0d,
-	0x95, 0xf2, 0xd3, 0x51, 0x47, 0xa5, 0xac, 0x92, 0xbb, 0x3f, 0xff, 0x0a, 0x2a, 0x25, 0xf9, 0x57,
-	0x35, 0xbc, 0x8a, 0x96, 0x87, 0xc6, 0x51, 0xff, 0xac, 0x5a, 0xc0, 0x6b, 0xa8, 0x3c, 0xee, 0x9c,
-	0xd9, 0xa6, 0x31, 0xaa, 0x16, 0xbb, 0xe3, 0x17, 0x37, 0x75, 0xed, 0xe5, 0x4d, 0x5d, 0xfb, 0xf3,
-	0xa6, 0xae, 0xfd, 0x74, 0x5b, 0x5f, 0x7a, 0x79, 0x5b, 0x5f, 0xfa, 0xfd, 0xb6, 0xbe, 0xf4, 0xf5,
-	0xe3, 0xff, 0xf2, 0x94, 0xfa, 0xf7, 0xa4, 0x2a, 0xad, 0xcf, 0xda, 0x5f, 0xa8, 0x85, 0xb3, 0xa2,
-	0x7e, 0xa6, 0x1e, 0xff, 0x13, 0x00, 0x00, 0xff, 0xff, 0x28, 0x5e, 0x6f, 0x52, 0x5b, 0x09, 0x00,
-	0x00,
+	// 1032 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x96, 0xcf, 0x6f, 0xe3, 0x44,
+	0x14, 0xc7, 0xeb, 0x26, 0x6d, 0xd2, 0xe9, 0x0f, 0xc2, 0xb4, 0x65, 0xd3, 0x2e, 0x4a, 0x4a, 0x24,
+	0x50, 0x55, 0x20, 0x56, 0x76, 0x0f, 0x08, 0x38, 0x25, 0x69, 0xb2, 0xb2, 0x94, 0xb8, 0xc6, 0xed,
+	0xb2, 0x15, 0x20, 0xac, 0x89, 0x3d, 0x49, 0x87, 0xda, 0x33, 0xde, 0x99, 0x71, 0x4a, 0xe1, 0x84,
+	0xf8, 0x07, 0x38, 0x21, 0xfe, 0x1a, 0xce, 0x7b, 0xdc, 0x23, 0xda, 0x43, 0x41, 0xed, 0x8d, 0x23,
+	0x7f, 0x01, 0x9a, 0xb1, 0x9b, 0x0d, 0xa8, 0x12, 0xc8, 0xda, 0x3d, 0x75, 0xde, 0x3c, 0xbf, 0xcf,
+	0x7b, 0x7e, 0xef, 0x7d, 0xdd, 0x80, 0xcd, 0x18, 0xf3, 0xd8, 0x9c, 0xb6, 0x4c, 0x21, 0x91, 0xc4,
+	0xcd, 0x98, 0x33, 0xc9, 0xe0, 0x06, 0x25, 0x23, 0xc2, 0x93, 0xa6, 0xf2, 0x35, 0xa7, 0xad, 0xdd,
+	0xad, 0x09, 0x9b, 0x30, 0xed, 0x32, 0xd5, 0x29, 0x7d, 0x6a, 0xb7, 0xe6, 0x33, 0x11, 0x31, 0x61,
+	0x8e, 0x90, 0xc0, 0xe6, 0xb4, 0x35, 0xc2, 0x12, 0xb5, 0x4c, 0x9f, 0x11, 0x9a, 0xf9, 0x77, 0x52,
+	0xbf, 0x97, 0x06, 0xa6, 0xc6, 0x6d, 0xe8, 0x84, 0xb1, 0x49, 0x88, 0x4d, 0x6d, 0x8d, 0x92, 0xb1,
+	0x19, 0x24, 0x1c, 0x49, 0xc2, 0xb2, 0xd0, 0xc6, 0xaf, 0x4b, 0x60, 0xd9, 0x41, 0x1c, 0x45, 0x02,
+	0x56, 0x41, 0x49, 0x48, 0x16, 0xc7, 0x38, 0xa8, 0x1a, 0x7b, 0xc6, 0x7e, 0xd9, 0xbd, 0x35, 0xe1,
+	0x97, 0x00, 0x8e, 0x31, 0xf6, 0x62, 0xc6, 0x42, 0x4f, 0x1d, 0x34, 0xa1, 0x5a, 0xd8, 0x33, 0xf6,
+	0x57, 0x3a, 0xcd, 0x67, 0x57, 0xf5, 0x85, 0x17, 0x57, 0xf5, 0xf7, 0x26, 0x44, 0x9e, 0x25, 0xa3,
+	0xa6, 0xcf, 0xa2, 0xac, 0x82, 0xec, 0xcf, 0x87, 0x22, 0x38, 0x37, 0xe5, 0x65, 0x8c, 0x45, 0xf3,
+	0x10, 0xfb, 0xee, 0x1b, 0x63, 0x8c, 0x1d, 0xc6, 0xc2, 0x3e, 0xc6, 0xae, 0xc2, 0xc0, 0x09, 0xa8,
+	0x62, 0x9f, 0x89, 0x4b, 0x21, 0x71, 0xe4, 0x8d, 0x13, 0x1a, 0xcc, 0xa5, 0x28, 0xe6, 0x4a, 0xb1,
+	0x3d, 0xe3, 0xf5, 0x13, 0x1a, 0xcc, 0x12, 0x8d, 0xc0, 0x76, 0x48, 0x9e, 0x26, 0x24, 0x50, 0x16,
+	0x9d, 0xcb, 0xb2, 0x94, 0x2b, 0xcb, 0xe6, 0x1c, 0x6c, 0x96, 0xe3, 0x1b, 0xb0, 0x13, 0x23, 0x2e,
+	0x09, 0x0a, 0xbd, 0xf9, 0x5c, 0x69, 0x9e, 0xe5, 0x5c, 0x79, 0xee, 0x65, 0xc0, 0xc1, 0x4b, 0x5e,
+	0x9a, 0xeb, 0x01, 0xd8, 0x56, 0xed, 0x22, 0x74, 0xa2, 0xf8, 0xd8, 0x23, 0x54, 0x62, 0x3e, 0x45,
+	0x61, 0xb5, 0xa4, 0xf2, 0xb8, 0x9b, 0x99, 0xd3, 0x45, 0x12, 0x5b, 0x99, 0x0b, 0xfe, 0x6c, 0x80,
+	0x2d, 0x79, 0x81, 0x62, 0x2f, 0x64, 0xec, 0x7c, 0x84, 0xfc, 0x73, 0xef, 0x82, 0xd0, 0x80, 0x5d,
+	0x54, 0xcb, 0x7b, 0xc6, 0xfe, 0xea, 0x83, 0x9d, 0x66, 0xba, 0x2e, 0xcd, 0xdb, 0x75, 0x69, 0x1e,
+	0x66, 0xeb, 0xd2, 0xb1, 0x54, 0xd9, 0x7f, 0x5e, 0xd5, 0x6b, 0x77, 0x85, 0x7f, 0xc0, 0x22, 0x22,
+	0x71, 0x14, 0xcb, 0xcb, 0xbf, 0xae, 0xea, 0xf7, 0x2f, 0x51, 0x14, 0x7e, 0xd2, 0xb8, 0xeb, 0xb9,
+	0xc6, 0x2f, 0xbf, 0xd7, 0x0d, 0x17, 0x2a, 0xd7, 0x20, 0xf3, 0x3c, 0xd1, 0x0e, 0xf8, 0x11, 0xb8,
+	0x77, 0x71, 0x46, 0x24, 0x0e, 0x89, 0x90, 0x38, 0x98, 0x35, 0x8f, 0x71, 0x51, 0x5d, 0xd9, 0x2b,
+	0xec, 0xaf, 0xb8, 0x6f, 0xcd, 0xb9, 0x07, 0x2f, 0xbd, 0x8d, 0x1f, 0x8a, 0xa0, 0xec, 0x30, 0x41,
+	0x54, 0x91, 0xf0, 0x5d, 0xb0, 0x21, 0x39, 0x0a, 0x30, 0xf7, 0x50, 0x10, 0x70, 0x2c, 0x84, 0xde,
+	0xe4, 0x15, 0x77, 0x3d, 0xbd, 0x6d, 0xa7, 0x97, 0x70, 0x08, 0x8a, 0x31, 0x22, 0xbc, 0xba, 0xa8,
+	0x07, 0xf2, 0x71, 0x36, 0x90, 0xd6, 0xdc, 0x40, 0x6c, 0x2d, 0xcb, 0xee, 0x19, 0x22, 0xd4, 0x4c,
+	0x25, 0x6a, 0x7e, 0x6b, 0xfa, 0x2c, 0x8a, 0x18, 0x35, 0x91, 0x10, 0x58, 0x36, 0x1d, 0x44, 0xb8,
+	0xab, 0x31, 0xb0, 0x03, 0x8a, 0x82, 0x7c, 0x87, 0x73, 0x0a, 0x42, 0xc7, 0xc2, 0x3e, 0x58, 0x8e,
+	0x10, 0x9f, 0x10, 0x9a, 0x73, 0xe7, 0xb3, 0x68, 0x78, 0x0c, 0xd6, 0x59, 0x8c, 0xa9, 0x47, 0x99,
+	0x6a, 0x08, 0x0a, 0x73, 0x2e, 0xf7, 0x9a, 0x82, 0xd8, 0x19, 0x03, 0x7e, 0x0f, 0x1a, 0x21, 0x92,
+	0x58, 0x48, 0xcf, 0x4f, 0xa2, 0x24, 0x44, 0x92, 0x4c, 0xb1, 0x17, 0x73, 0x1c, 0x91, 0x24, 0xf2,
+	0xc6, 0x1c, 0xf9, 0xea, 0xb9, 0x9c, 0xeb, 0x5d, 0x4f, 0xc9, 0xdd, 0x19, 0xd8, 0x49, 0xb9, 0xfd,
+	0x0c, 0x0b, 0xdf, 0x01, 0x6b, 0xa3, 0x90, 0xf9, 0xe7, 0x1e, 0x4d, 0xa2, 0x11, 0xe6, 0x7a, 0xbb,
+	0x0b, 0xee, 0xaa, 0xbe, 0xb3, 0xf5, 0x55, 0xe3, 0x85, 0x01, 0xd6, 0xd4, 0x3c, 0x86, 0x58, 0xa2,
+	0x00, 0x49, 0x34, 0x1b, 0xb0, 0xf1, 0x6a, 0x06, 0xfc, 0xff, 0xde, 0x7f, 0xf1, 0xb5, 0xbc, 0x7f,
+	0x83, 0x82, 0x0d, 0x87, 0xe3, 0x18, 0x91, 0xa0, 0x83, 0x82, 0x43, 0x3c, 0x92, 0x70, 0x0b, 0x2c,
+	0x05, 0x98, 0xb2, 0x28, 0x5b, 0xee, 0xd4, 0x50, 0x1b, 0x84, 0x22, 0x96, 0x50, 0x99, 0xa3, 0x10,
+	0x8b, 0x4a, 0x37, 0x8b, 0x6e, 0xfc, 0x58, 0x04, 0xa5, 0x21, 0x96, 0x9c, 0xf8, 0xe2, 0x55, 0xf7,
+	0xd1, 0x02, 0x65, 0x8a, 0xa5, 0xa7, 0xc5, 0x92, 0xaf, 0x5b, 0x25, 0x8a, 0xe5, 0xb1, 0xd2, 0x8b,
+	0x03, 0x56, 0xa7, 0x2c, 0x4c, 0x22, 0xfc, 0x59, 0xc2, 0x64, 0x5e, 0xe9, 0xcd, 0x23, 0xa0, 0x0d,
+	0x40, 0x6a, 0x76, 0x90, 0xc0, 0x39, 0x55, 0x38, 0x47, 0x80, 0x5f, 0x01, 0xa8, 0x95, 0xa8, 0x3f,
+	0xcb, 0x6a, 0x77, 0x42, 0x46, 0x27, 0x39, 0xe5, 0x58, 0x51, 0x24, 0x2b, 0x03, 0x0d, 0x18, 0x9d,
+	0xc0, 0xaf, 0xc1, 0xe6, 0x3f, 0xe9, 0xe2, 0x8c, 0x71, 0x99, 0x53, 0x83, 0x6f, 0xce, 0xe3, 0x8f,
+	0x15, 0xe8, 0xe0, 0x73, 0xb0, 0xee, 0xd0, 0x41, 0x17, 0x85, 0xfe, 0x51, 0xac, 0x65, 0x58, 0x07,
+	0xf7, 0x1d, 0x7b, 0xe0, 0x75, 0xdb, 0x83, 0xae, 0x77, 0xe4, 0x9c, 0x58, 0x47, 0xb6, 0xf7, 0xd8,
+	0x3e, 0x76, 0x7a, 0x5d, 0xab, 0x6f, 0xf5, 0x0e, 0x2b, 0x0b, 0x70, 0x03, 0x80, 0x63, 0xe7, 0xe8,
+	0xc4, 0x73, 0x5c, 0xab, 0xdb, 0xab, 0x18, 0xb0, 0x0c, 0x8a, 0x27, 0x4f, 0xda, 0x4e, 0x65, 0x11,
+	0x02, 0xb0, 0x7c, 0xe4, 0xb6, 0xbb, 0x83, 0x5e, 0xa5, 0x70, 0xf0, 0x08, 0x6c, 0x3a, 0x74, 0xe0,
+	0x70, 0x3c, 0xc6, 0x1c, 0x53, 0x1f, 0x67, 0xf4, 0x1a, 0xd8, 0x55, 0x74, 0xc7, 0xed, 0xf5, 0x7b,
+	0x6e, 0xcf, 0xee, 0xf6, 0xfe, 0x05, 0x2f, 0x81, 0xc2, 0xb0, 0x7d, 0x5a, 0x31, 0xf4, 0xc1, 0xb2,
+	0x2b, 0x8b, 0x07, 0x4f, 0xc1, 0xdb, 0x43, 0xfd, 0xc9, 0x53, 0x35, 0x6a, 0xe9, 0x30, 0xea, 0x70,
+	0x32, 0x23, 0x9a, 0xe0, 0xfd, 0x61, 0xdb, 0x7d, 0x64, 0xd9, 0xba, 0xe4, 0xc7, 0x83, 0xb6, 0x2e,
+	0x59, 0x17, 0x77, 0x77, 0xfd, 0x65, 0x50, 0x54, 0xf5, 0x57, 0x0c, 0xb8, 0x02, 0x96, 0x2c, 0xfb,
+	0xb0, 0x77, 0x5a, 0x59, 0x84, 0xab, 0xa0, 0x34, 0x6c, 0x9f, 0x7a, 0x8e, 0x3d, 0xa8, 0x14, 0x3a,
+	0xc3, 0x67, 0xd7, 0x35, 0xe3, 0xf9, 0x75, 0xcd, 0xf8, 0xe3, 0xba, 0x66, 0xfc, 0x74, 0x53, 0x5b,
+	0x78, 0x7e, 0x53, 0x5b, 0xf8, 0xed, 0xa6, 0xb6, 0xf0, 0xc5, 0xc3, 0xff, 0x52, 0x84, 0xfe, 0xed,
+	0xa7, 0x1b, 0x6e, 0x4e, 0x5b, 0x9f, 0xea, 0xc3, 0x68, 0x59, 0xff, 0x93, 0x7d, 0xf8, 0xf7, 0x00,
+	0xe7, 0xab, 0x6e, 0x98, 0x19, 0x0a, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -771,6 +777,26 @@ func (m *Metrics) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.OpenInterestShort.Size()
+		i -= size
+		if _, err := m.OpenInterestShort.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.OpenInterestLong.Size()
+		i -= size
+		if _, err := m.OpenInterestLong.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
 	{
 		size := m.VolumeBase.Size()
 		i -= size
@@ -925,6 +951,10 @@ func (m *Metrics) Size() (n int) {
 	n += 1 + l + sovState(uint64(l))
 	l = m.VolumeBase.Size()
 	n += 1 + l + sovState(uint64(l))
+	l = m.OpenInterestLong.Size()
+	n += 1 + l + sovState(uint64(l))
+	l = m.OpenInterestShort.Size()
+	n += 1 + l + sovState(uint64(l))
 	return n
 }
 
@@ -1907,6 +1937,74 @@ func (m *Metrics) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OpenInterestLong", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.OpenInterestLong.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OpenInterestShort", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.OpenInterestShort.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipState(dAtA[iNdEx:])
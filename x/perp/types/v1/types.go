@@ -31,6 +31,9 @@ var (
 	ErrAllLiquidationsFailed             = sdkerrors.Register(ModuleName, 10, "all liquidations failed")
 	ErrNotEnoughFundToPayAction          = sdkerrors.Register(ModuleName, 11, "not enough fund in perp EF to pay for action")
 	ErrQuoteAmountIsTooSmall             = sdkerrors.Register(ModuleName, 12, "quote amount is too low")
+	ErrTradingStopped                    = sdkerrors.Register(ModuleName, 13, "trading is stopped, you can only reduce or close your position or add margin")
+	ErrOpenInterestCapExceeded           = sdkerrors.Register(ModuleName, 14, "open interest would exceed the max open interest of the market")
+	ErrPositionNotionalCapExceeded       = sdkerrors.Register(ModuleName, 15, "position notional would exceed the max position notional of the market")
 )
 
 func ZeroPosition(ctx sdk.Context, tokenPair asset.Pair, traderAddr sdk.AccAddress) Position {
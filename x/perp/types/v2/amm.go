@@ -167,6 +167,100 @@ func (amm AMM) ComputeSqrtDepth() (sqrtDepth sdk.Dec, err error) {
 	return common.SqrtDec(liqDepth)
 }
 
+// Bias returns the net open interest of the AMM in base units, total long minus
+// total short.
+func (amm AMM) Bias() sdk.Dec {
+	return amm.TotalLong.Sub(amm.TotalShort)
+}
+
+// IsOverSpreadLimit returns whether the mark price deviates from the index
+// price by at least maxSpreadRatio, relative to the index price.
+func (amm AMM) IsOverSpreadLimit(indexPrice sdk.Dec, maxSpreadRatio sdk.Dec) bool {
+	return amm.MarkPrice().Sub(indexPrice).Quo(indexPrice).Abs().GTE(maxSpreadRatio)
+}
+
+// biasInQuoteReserve returns the amount of quote reserve swapped to close the
+// net open interest of the AMM.
+func (amm AMM) biasInQuoteReserve() (sdk.Dec, error) {
+	bias := amm.Bias()
+	if bias.IsPositive() {
+		return amm.GetQuoteReserveAmt(bias, Direction_SHORT)
+	}
+	return amm.GetQuoteReserveAmt(bias.Abs(), Direction_LONG)
+}
+
+// CalcRepegCost returns the cost of updating the price multiplier of the AMM.
+// A positive cost is owed by the ecosystem fund to the vault, a negative cost
+// by the vault to the ecosystem fund.
+func (amm AMM) CalcRepegCost(newPriceMultiplier sdk.Dec) (cost sdk.Dec, err error) {
+	if !newPriceMultiplier.IsPositive() {
+		return sdk.Dec{}, ErrNonPositivePriceMultiplier
+	}
+
+	biasInQuoteReserve, err := amm.biasInQuoteReserve()
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+
+	cost = biasInQuoteReserve.Mul(newPriceMultiplier.Sub(amm.PriceMultiplier))
+	if amm.Bias().IsNegative() {
+		cost = cost.Neg()
+	}
+
+	return cost, nil
+}
+
+// UpdateSwapInvariant multiplies the swap invariant of the AMM by
+// swapInvariantMultiplier, holding the mark price constant.
+func (amm *AMM) UpdateSwapInvariant(swapInvariantMultiplier sdk.Dec) (err error) {
+	if swapInvariantMultiplier.IsNil() || !swapInvariantMultiplier.IsPositive() {
+		return ErrNonPositiveSwapInvariantMultiplier
+	}
+
+	// k = x * y
+	// newK = (cx) * (cy) = c^2 k
+	// newPrice = (cy) / (cx) = y / x = price
+	c, err := common.SqrtDec(swapInvariantMultiplier)
+	if err != nil {
+		return err
+	}
+
+	amm.BaseReserve = amm.BaseReserve.Mul(c)
+	amm.QuoteReserve = amm.QuoteReserve.Mul(c)
+	amm.SqrtDepth, err = amm.ComputeSqrtDepth()
+	return err
+}
+
+// CalcUpdateSwapInvariantCost returns the cost of multiplying the swap
+// invariant of the AMM by swapInvariantMultiplier. A positive cost is owed by
+// the ecosystem fund to the vault, a negative cost by the vault to the
+// ecosystem fund.
+func (amm AMM) CalcUpdateSwapInvariantCost(swapInvariantMultiplier sdk.Dec) (cost sdk.Dec, err error) {
+	biasInQuoteReserveBefore, err := amm.biasInQuoteReserve()
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+
+	updatedAMM := amm
+	if err = updatedAMM.UpdateSwapInvariant(swapInvariantMultiplier); err != nil {
+		return sdk.Dec{}, err
+	}
+
+	biasInQuoteReserveAfter, err := updatedAMM.biasInQuoteReserve()
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+
+	// longs are paid the quote reserve they swap out, shorts pay the quote
+	// reserve they swap in
+	cost = amm.FromQuoteReserveToAsset(biasInQuoteReserveAfter.Sub(biasInQuoteReserveBefore))
+	if amm.Bias().IsNegative() {
+		cost = cost.Neg()
+	}
+
+	return cost, nil
+}
+
 func (amm *AMM) WithBaseReserve(baseReserve sdk.Dec) *AMM {
 	amm.BaseReserve = baseReserve
 	return amm
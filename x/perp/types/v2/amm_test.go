@@ -194,3 +194,150 @@ func TestSwapQuoteAsset(t *testing.T) {
 		})
 	}
 }
+
+func TestCalcRepegCost(t *testing.T) {
+	tests := []struct {
+		name               string
+		totalLong          sdk.Dec
+		totalShort         sdk.Dec
+		newPriceMultiplier sdk.Dec
+		expectedCost       sdk.Dec
+		expectedErr        error
+	}{
+		{
+			name:               "no bias",
+			totalLong:          sdk.NewDec(10),
+			totalShort:         sdk.NewDec(10),
+			newPriceMultiplier: sdk.NewDec(2),
+			expectedCost:       sdk.ZeroDec(),
+		},
+		{
+			name:               "net long, peg up",
+			totalLong:          sdk.NewDec(10),
+			totalShort:         sdk.ZeroDec(),
+			newPriceMultiplier: sdk.NewDec(2),
+			expectedCost:       sdk.MustNewDecFromStr("9.090909090909090909"),
+		},
+		{
+			name:               "net long, peg down",
+			totalLong:          sdk.NewDec(10),
+			totalShort:         sdk.ZeroDec(),
+			newPriceMultiplier: sdk.MustNewDecFromStr("0.5"),
+			expectedCost:       sdk.MustNewDecFromStr("-4.545454545454545454"),
+		},
+		{
+			name:               "net short, peg up",
+			totalLong:          sdk.ZeroDec(),
+			totalShort:         sdk.NewDec(10),
+			newPriceMultiplier: sdk.NewDec(2),
+			expectedCost:       sdk.MustNewDecFromStr("-11.111111111111111111"),
+		},
+		{
+			name:               "non positive price multiplier",
+			totalLong:          sdk.NewDec(10),
+			totalShort:         sdk.ZeroDec(),
+			newPriceMultiplier: sdk.ZeroDec(),
+			expectedErr:        v2.ErrNonPositivePriceMultiplier,
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			amm := mock.TestAMM(sdk.NewDec(100), sdk.OneDec()).
+				WithTotalLong(tc.totalLong).
+				WithTotalShort(tc.totalShort)
+
+			cost, err := amm.CalcRepegCost(tc.newPriceMultiplier)
+			if tc.expectedErr != nil {
+				require.ErrorIs(t, err, tc.expectedErr)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tc.expectedCost, cost)
+			}
+		})
+	}
+}
+
+func TestCalcUpdateSwapInvariantCost(t *testing.T) {
+	tests := []struct {
+		name                    string
+		totalLong               sdk.Dec
+		totalShort              sdk.Dec
+		swapInvariantMultiplier sdk.Dec
+		expectedCost            sdk.Dec
+		expectedErr             error
+	}{
+		{
+			name:                    "no bias",
+			totalLong:               sdk.ZeroDec(),
+			totalShort:              sdk.ZeroDec(),
+			swapInvariantMultiplier: sdk.NewDec(4),
+			expectedCost:            sdk.ZeroDec(),
+		},
+		{
+			name:                    "net long, deeper",
+			totalLong:               sdk.NewDec(10),
+			totalShort:              sdk.ZeroDec(),
+			swapInvariantMultiplier: sdk.NewDec(4),
+			expectedCost:            sdk.MustNewDecFromStr("0.432900432900432901"),
+		},
+		{
+			name:                    "net short, deeper",
+			totalLong:               sdk.ZeroDec(),
+			totalShort:              sdk.NewDec(10),
+			swapInvariantMultiplier: sdk.NewDec(4),
+			expectedCost:            sdk.MustNewDecFromStr("0.584795321637426900"),
+		},
+		{
+			name:                    "net long, shallower",
+			totalLong:               sdk.NewDec(10),
+			totalShort:              sdk.ZeroDec(),
+			swapInvariantMultiplier: sdk.MustNewDecFromStr("0.25"),
+			expectedCost:            sdk.MustNewDecFromStr("-0.757575757575757576"),
+		},
+		{
+			name:                    "non positive multiplier",
+			totalLong:               sdk.NewDec(10),
+			totalShort:              sdk.ZeroDec(),
+			swapInvariantMultiplier: sdk.ZeroDec(),
+			expectedErr:             v2.ErrNonPositiveSwapInvariantMultiplier,
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			amm := mock.TestAMM(sdk.NewDec(100), sdk.OneDec()).
+				WithTotalLong(tc.totalLong).
+				WithTotalShort(tc.totalShort)
+
+			cost, err := amm.CalcUpdateSwapInvariantCost(tc.swapInvariantMultiplier)
+			if tc.expectedErr != nil {
+				require.ErrorIs(t, err, tc.expectedErr)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tc.expectedCost, cost)
+			}
+		})
+	}
+}
+
+func TestUpdateSwapInvariant(t *testing.T) {
+	amm := mock.TestAMM(sdk.NewDec(100), sdk.NewDec(2))
+
+	require.NoError(t, amm.UpdateSwapInvariant(sdk.NewDec(4)))
+	assert.Equal(t, sdk.NewDec(200), amm.BaseReserve)
+	assert.Equal(t, sdk.NewDec(200), amm.QuoteReserve)
+	assert.Equal(t, sdk.NewDec(200), amm.SqrtDepth)
+	assert.Equal(t, sdk.NewDec(2), amm.MarkPrice())
+	require.NoError(t, amm.ValidateLiquidityDepth())
+}
+
+func TestIsOverSpreadLimit(t *testing.T) {
+	amm := mock.TestAMM(sdk.NewDec(100), sdk.OneDec())
+
+	assert.False(t, amm.IsOverSpreadLimit(sdk.MustNewDecFromStr("1.05"), sdk.MustNewDecFromStr("0.1")))
+	assert.True(t, amm.IsOverSpreadLimit(sdk.MustNewDecFromStr("1.25"), sdk.MustNewDecFromStr("0.1")))
+	assert.True(t, amm.IsOverSpreadLimit(sdk.MustNewDecFromStr("0.8"), sdk.MustNewDecFromStr("0.1")))
+}
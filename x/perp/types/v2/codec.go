@@ -5,15 +5,22 @@ import (
 	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/msgservice"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
 func RegisterCodec(cdc *codec.LegacyAmino) {
-	cdc.RegisterConcrete(&MsgAddMargin{}, "perp/add_margin", nil)
-	cdc.RegisterConcrete(&MsgRemoveMargin{}, "perp/remove_margin", nil)
-	cdc.RegisterConcrete(&MsgOpenPosition{}, "perp/open_position", nil)
-	cdc.RegisterConcrete(&MsgClosePosition{}, "perp/close_position", nil)
-	cdc.RegisterConcrete(&MsgDonateToEcosystemFund{}, "perp/donate_to_ef", nil)
-	cdc.RegisterConcrete(&MsgMultiLiquidate{}, "perp/multi_liquidate", nil)
+	cdc.RegisterConcrete(&MsgAddMargin{}, "v2perp/add_margin", nil)
+	cdc.RegisterConcrete(&MsgRemoveMargin{}, "v2perp/remove_margin", nil)
+	cdc.RegisterConcrete(&MsgOpenPosition{}, "v2perp/open_position", nil)
+	cdc.RegisterConcrete(&MsgClosePosition{}, "v2perp/close_position", nil)
+	cdc.RegisterConcrete(&MsgDonateToEcosystemFund{}, "v2perp/donate_to_ef", nil)
+	cdc.RegisterConcrete(&MsgMultiLiquidate{}, "v2perp/multi_liquidate", nil)
+	cdc.RegisterConcrete(&MsgPlaceTriggerOrder{}, "v2perp/place_trigger_order", nil)
+	cdc.RegisterConcrete(&MsgCancelTriggerOrder{}, "v2perp/cancel_trigger_order", nil)
+	cdc.RegisterConcrete(&MsgSetMarginMode{}, "v2perp/set_margin_mode", nil)
+	cdc.RegisterConcrete(&MsgRegisterReferralCode{}, "v2perp/register_referral_code", nil)
+	cdc.RegisterConcrete(&MsgBindReferralCode{}, "v2perp/bind_referral_code", nil)
+	cdc.RegisterConcrete(&MsgBidLiquidationAuction{}, "v2perp/bid_liquidation_auction", nil)
 }
 
 func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
@@ -25,6 +32,19 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		&MsgOpenPosition{},
 		&MsgClosePosition{},
 		&MsgMultiLiquidate{},
+		&MsgDonateToEcosystemFund{},
+		&MsgPlaceTriggerOrder{},
+		&MsgCancelTriggerOrder{},
+		&MsgSetMarginMode{},
+		&MsgRegisterReferralCode{},
+		&MsgBindReferralCode{},
+		&MsgBidLiquidationAuction{},
+	)
+
+	registry.RegisterImplementations(
+		(*govtypes.Content)(nil),
+		&SetAutoDeleveragingProposal{},
+		&ResetCircuitBreakerProposal{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
@@ -17,18 +17,35 @@ var (
 		"base and quote reserves must always be positive")
 	ErrLiquidityDepth = sdkerrors.Register(ModuleName, 11,
 		"liquidity depth must be positive and equal to the square of the reserves")
-	ErrInvalidAmount                     = sdkerrors.Register(ModuleName, 12, "invalid amount")
-	ErrMarginRatioTooHigh                = sdkerrors.Register(ModuleName, 13, "margin ratio is too healthy to liquidate")
-	ErrPairNotFound                      = sdkerrors.Register(ModuleName, 14, "pair doesn't have live market")
-	ErrPositionZero                      = sdkerrors.Register(ModuleName, 15, "position is zero")
-	ErrFailedRemoveMarginCanCauseBadDebt = sdkerrors.Register(ModuleName, 16, "failed to remove margin; position would have bad debt if removed")
-	ErrQuoteAmountIsZero                 = sdkerrors.Register(ModuleName, 17, "quote amount cannot be zero")
-	ErrLeverageIsZero                    = sdkerrors.Register(ModuleName, 18, "leverage cannot be zero")
-	ErrMarginRatioTooLow                 = sdkerrors.Register(ModuleName, 19, "margin ratio did not meet maintenance margin ratio")
-	ErrLeverageIsTooHigh                 = sdkerrors.Register(ModuleName, 20, "leverage cannot be higher than market parameter")
-	ErrUnauthorized                      = sdkerrors.Register(ModuleName, 21, "operation not authorized")
-	ErrAllLiquidationsFailed             = sdkerrors.Register(ModuleName, 22, "all liquidations failed")
-	ErrPositionHealthy                   = sdkerrors.Register(ModuleName, 23, "position is healthy")
-	ErrLiquidityDepthOverflow            = sdkerrors.Register(ModuleName, 24, "liquidty depth overflow")
-	ErrMarketNotEnabled                  = sdkerrors.Register(ModuleName, 25, "market is not enabled, you can only fully close your position")
+	ErrInvalidAmount                      = sdkerrors.Register(ModuleName, 12, "invalid amount")
+	ErrMarginRatioTooHigh                 = sdkerrors.Register(ModuleName, 13, "margin ratio is too healthy to liquidate")
+	ErrPairNotFound                       = sdkerrors.Register(ModuleName, 14, "pair doesn't have live market")
+	ErrPositionZero                       = sdkerrors.Register(ModuleName, 15, "position is zero")
+	ErrFailedRemoveMarginCanCauseBadDebt  = sdkerrors.Register(ModuleName, 16, "failed to remove margin; position would have bad debt if removed")
+	ErrQuoteAmountIsZero                  = sdkerrors.Register(ModuleName, 17, "quote amount cannot be zero")
+	ErrLeverageIsZero                     = sdkerrors.Register(ModuleName, 18, "leverage cannot be zero")
+	ErrMarginRatioTooLow                  = sdkerrors.Register(ModuleName, 19, "margin ratio did not meet maintenance margin ratio")
+	ErrLeverageIsTooHigh                  = sdkerrors.Register(ModuleName, 20, "leverage cannot be higher than market parameter")
+	ErrUnauthorized                       = sdkerrors.Register(ModuleName, 21, "operation not authorized")
+	ErrAllLiquidationsFailed              = sdkerrors.Register(ModuleName, 22, "all liquidations failed")
+	ErrPositionHealthy                    = sdkerrors.Register(ModuleName, 23, "position is healthy")
+	ErrLiquidityDepthOverflow             = sdkerrors.Register(ModuleName, 24, "liquidty depth overflow")
+	ErrMarketNotEnabled                   = sdkerrors.Register(ModuleName, 25, "market is not enabled, you can only fully close your position")
+	ErrNotEnoughFreeCollateral            = sdkerrors.Register(ModuleName, 26, "not enough free collateral")
+	ErrTriggerOrderNotFound               = sdkerrors.Register(ModuleName, 27, "trigger order not found")
+	ErrNonPositivePriceMultiplier         = sdkerrors.Register(ModuleName, 28, "price multiplier must be positive")
+	ErrNonPositiveSwapInvariantMultiplier = sdkerrors.Register(ModuleName, 29, "swap invariant multiplier must be positive")
+	ErrNotEnoughFundToPayAction           = sdkerrors.Register(ModuleName, 30, "not enough fund in perp EF to pay for action")
+	ErrCircuitBreakerTripped              = sdkerrors.Register(ModuleName, 31, "circuit breaker is tripped, you can only reduce or close your position or add margin")
+	ErrOpenInterestCapExceeded            = sdkerrors.Register(ModuleName, 32, "open interest would exceed the max open interest of the market")
+	ErrPositionNotionalCapExceeded        = sdkerrors.Register(ModuleName, 33, "position notional would exceed the max position notional of the market")
+	ErrInvalidReferralCode                = sdkerrors.Register(ModuleName, 34, "invalid referral code")
+	ErrReferralCodeTaken                  = sdkerrors.Register(ModuleName, 35, "referral code is already registered")
+	ErrReferralCodeNotFound               = sdkerrors.Register(ModuleName, 36, "referral code not found")
+	ErrReferralAlreadyBound               = sdkerrors.Register(ModuleName, 37, "trader is already bound to a referral code")
+	ErrSelfReferral                       = sdkerrors.Register(ModuleName, 38, "trader cannot bind its own referral code")
+	ErrLiquidationAuctionNotFound         = sdkerrors.Register(ModuleName, 39, "liquidation auction not found")
+	ErrLiquidationAuctionInProgress       = sdkerrors.Register(ModuleName, 40, "position is being auctioned, it can only have margin added")
+	ErrTooManyTriggerOrders               = sdkerrors.Register(ModuleName, 41, "too many resting trigger orders on the pair")
+	ErrTradingStopped                     = sdkerrors.Register(ModuleName, 42, "trading is stopped, you can only reduce or close your position or add margin")
 )
@@ -79,7 +79,7 @@ type PositionChangedEvent struct {
 	// units). The size is a signed quantity expressing how much exposure a
 	// position has in base units of the pair.
 	ExchangedSize github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=exchanged_size,json=exchangedSize,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"exchanged_size"`
-	//
+	//*
 	// Exchanged notional is the value of the exchanged size in quote units.
 	// exchangedNotional = posBefore.OpenNotional + (direction * realizedPnl),
 	// where 'posBefore' is the position before the change, and
@@ -97,10 +97,10 @@ type PositionChangedEvent struct {
 	// Bad debt is negative net margin past the liquidation point of a position.
 	BadDebt types.Coin `protobuf:"bytes,11,opt,name=bad_debt,json=badDebt,proto3" json:"bad_debt"`
 	// A funding payment made or received by the trader on the current position.
-	// 'fundingPayment' is positive if 'owner' is the sender and negative if 'owner'
-	// is the receiver of the payment. Its magnitude is abs(vSize * fundingRate).
-	// Funding payments act to converge the mark price (vPrice) and index price
-	// (average price on major exchanges).
+	//'fundingPayment' is positive if 'owner' is the sender and negative if 'owner'
+	//is the receiver of the payment. Its magnitude is abs(vSize * fundingRate).
+	//Funding payments act to converge the mark price (vPrice) and index price
+	//(average price on major exchanges).
 	FundingPayment github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,12,opt,name=funding_payment,json=fundingPayment,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"funding_payment"`
 	// The block number at which this position was changed.
 	BlockHeight int64 `protobuf:"varint,13,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
@@ -503,773 +503,4773 @@ func (m *LiquidationFailedEvent) GetReason() LiquidationFailedEvent_LiquidationF
 	return LiquidationFailedEvent_UNSPECIFIED
 }
 
-func init() {
-	proto.RegisterEnum("nibiru.perp.v2.LiquidationFailedEvent_LiquidationFailedReason", LiquidationFailedEvent_LiquidationFailedReason_name, LiquidationFailedEvent_LiquidationFailedReason_value)
-	proto.RegisterType((*PositionChangedEvent)(nil), "nibiru.perp.v2.PositionChangedEvent")
-	proto.RegisterType((*PositionLiquidatedEvent)(nil), "nibiru.perp.v2.PositionLiquidatedEvent")
-	proto.RegisterType((*PositionSettledEvent)(nil), "nibiru.perp.v2.PositionSettledEvent")
-	proto.RegisterType((*FundingRateChangedEvent)(nil), "nibiru.perp.v2.FundingRateChangedEvent")
-	proto.RegisterType((*LiquidationFailedEvent)(nil), "nibiru.perp.v2.LiquidationFailedEvent")
+// Emitted when a trigger order is executed.
+type TriggerOrderExecutedEvent struct {
+	Order TriggerOrder `protobuf:"bytes,1,opt,name=order,proto3" json:"order"`
+	// The price that triggered the order.
+	TriggerPriceSeen github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=trigger_price_seen,json=triggerPriceSeen,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"trigger_price_seen"`
+	// The block number at which the order was executed.
+	BlockHeight int64 `protobuf:"varint,3,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
 }
 
-func init() { proto.RegisterFile("perp/v2/event.proto", fileDescriptor_e18a1bd6d2374200) }
+func (m *TriggerOrderExecutedEvent) Reset()         { *m = TriggerOrderExecutedEvent{} }
+func (m *TriggerOrderExecutedEvent) String() string { return proto.CompactTextString(m) }
+func (*TriggerOrderExecutedEvent) ProtoMessage()    {}
+func (*TriggerOrderExecutedEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e18a1bd6d2374200, []int{5}
+}
+func (m *TriggerOrderExecutedEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TriggerOrderExecutedEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TriggerOrderExecutedEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TriggerOrderExecutedEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TriggerOrderExecutedEvent.Merge(m, src)
+}
+func (m *TriggerOrderExecutedEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *TriggerOrderExecutedEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_TriggerOrderExecutedEvent.DiscardUnknown(m)
+}
 
-var fileDescriptor_e18a1bd6d2374200 = []byte{
-	// 1143 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x97, 0x4d, 0x4f, 0x23, 0x37,
-	0x18, 0xc7, 0x09, 0xb0, 0xbc, 0x38, 0x24, 0x04, 0x6f, 0x80, 0xd9, 0xed, 0x2a, 0xd0, 0xa8, 0xad,
-	0xb8, 0x30, 0x23, 0xe8, 0xa9, 0x7b, 0xa8, 0xc4, 0x4b, 0x28, 0x91, 0x20, 0x64, 0x27, 0xd9, 0xbe,
-	0xaa, 0x9d, 0x7a, 0x66, 0x9c, 0x60, 0x31, 0x63, 0xcf, 0x8e, 0x3d, 0x11, 0xf0, 0x05, 0xda, 0x4b,
-	0xa5, 0xde, 0xfa, 0x1d, 0x7a, 0xed, 0x97, 0xd8, 0xe3, 0x1e, 0xab, 0x1e, 0x68, 0x05, 0xa7, 0x5e,
-	0xfb, 0x09, 0xaa, 0xf1, 0x38, 0x6f, 0xa4, 0x5d, 0x60, 0x76, 0xd1, 0x9e, 0x92, 0x79, 0x6c, 0xff,
-	0x1e, 0x3f, 0x8f, 0x9e, 0xe7, 0x6f, 0x1b, 0x3c, 0x0c, 0x70, 0x18, 0x18, 0x9d, 0x4d, 0x03, 0x77,
-	0x30, 0x15, 0x7a, 0x10, 0x32, 0xc1, 0x60, 0x9e, 0x12, 0x9b, 0x84, 0x91, 0x1e, 0x8f, 0xe9, 0x9d,
-	0xcd, 0xc7, 0xc5, 0x36, 0x6b, 0x33, 0x39, 0x64, 0xc4, 0xff, 0x92, 0x59, 0x8f, 0x9f, 0xb4, 0x19,
-	0x6b, 0x7b, 0xd8, 0x40, 0x01, 0x31, 0x10, 0xa5, 0x4c, 0x20, 0x41, 0x18, 0xe5, 0x6a, 0xb4, 0xe4,
-	0x30, 0xee, 0x33, 0x6e, 0xd8, 0x88, 0x63, 0xa3, 0xb3, 0x61, 0x63, 0x81, 0x36, 0x0c, 0x87, 0x11,
-	0xaa, 0xc6, 0x7b, 0x8e, 0xb9, 0x40, 0x02, 0x2b, 0xe3, 0x8a, 0x42, 0xca, 0x2f, 0x3b, 0x6a, 0x19,
-	0x82, 0xf8, 0x98, 0x0b, 0xe4, 0x07, 0xc9, 0x84, 0xf2, 0x6f, 0x33, 0xa0, 0x58, 0x67, 0x9c, 0xc4,
-	0x9e, 0x76, 0x8e, 0x11, 0x6d, 0x63, 0xb7, 0x12, 0x6f, 0x1c, 0x1e, 0x82, 0xc9, 0x00, 0x91, 0x50,
-	0xcb, 0xac, 0x66, 0xd6, 0x66, 0xb7, 0x3f, 0x79, 0x79, 0xb1, 0x32, 0xf6, 0xc7, 0xc5, 0xca, 0x46,
-	0x9b, 0x88, 0xe3, 0xc8, 0xd6, 0x1d, 0xe6, 0x1b, 0x35, 0x19, 0xd3, 0xce, 0x31, 0x22, 0xd4, 0x48,
-	0xe2, 0x33, 0x4e, 0x0d, 0x87, 0xf9, 0x3e, 0xa3, 0x06, 0xe2, 0x1c, 0x0b, 0xbd, 0x8e, 0x48, 0x68,
-	0x4a, 0x0c, 0xfc, 0x10, 0xe4, 0x45, 0x88, 0x5c, 0x1c, 0x5a, 0xc8, 0x75, 0x43, 0xcc, 0xb9, 0x36,
-	0x1e, 0x83, 0xcd, 0x5c, 0x62, 0xdd, 0x4a, 0x8c, 0x70, 0x1f, 0x4c, 0xf9, 0x28, 0x6c, 0x13, 0xaa,
-	0x4d, 0xac, 0x66, 0xd6, 0xb2, 0x9b, 0x8f, 0xf4, 0x24, 0x6a, 0x3d, 0x8e, 0x5a, 0x57, 0x51, 0xeb,
-	0x3b, 0x8c, 0xd0, 0xed, 0xc5, 0x78, 0x4b, 0xff, 0x5c, 0xac, 0xe4, 0xce, 0x90, 0xef, 0x3d, 0x2d,
-	0x27, 0xcb, 0xca, 0xa6, 0x5a, 0x0f, 0xbf, 0x01, 0x0b, 0x81, 0x8a, 0xcb, 0xa2, 0x2c, 0xfe, 0x41,
-	0x9e, 0x36, 0x29, 0x83, 0xd1, 0x55, 0x30, 0x1f, 0x0d, 0x04, 0xa3, 0x92, 0x9b, 0xfc, 0xac, 0x73,
-	0xf7, 0xc4, 0x10, 0x67, 0x01, 0xe6, 0xfa, 0x2e, 0x76, 0xcc, 0x42, 0x17, 0x54, 0x53, 0x1c, 0xf8,
-	0x1c, 0xe4, 0xf1, 0xa9, 0x93, 0xa4, 0xcb, 0xe2, 0xe4, 0x1c, 0x6b, 0x0f, 0x52, 0x91, 0x73, 0x3d,
-	0x4a, 0x83, 0x9c, 0x63, 0xf8, 0x2d, 0x80, 0x7d, 0x6c, 0x6f, 0xd3, 0x53, 0xa9, 0xd0, 0x0b, 0x3d,
-	0x52, 0x6f, 0xd7, 0x36, 0x98, 0x17, 0x21, 0xa2, 0x1c, 0x39, 0x32, 0x2b, 0x2d, 0x8c, 0xb5, 0xe9,
-	0x9b, 0xb2, 0x5c, 0x52, 0x59, 0x5e, 0x4a, 0xb2, 0x7c, 0x6d, 0x7d, 0xd9, 0xcc, 0x0f, 0x58, 0xf6,
-	0x30, 0x86, 0x0d, 0x90, 0xeb, 0xa5, 0x5d, 0x26, 0x66, 0x26, 0xd5, 0xee, 0xe7, 0xba, 0x10, 0x99,
-	0x97, 0x67, 0x60, 0x2e, 0xc4, 0xc8, 0x23, 0xe7, 0xd8, 0xb5, 0x02, 0xea, 0x69, 0xb3, 0xa9, 0x98,
-	0xd9, 0x2e, 0xa3, 0x4e, 0x3d, 0xf8, 0x3d, 0x28, 0x46, 0x74, 0x10, 0x6a, 0xa1, 0x96, 0xc0, 0xa1,
-	0x06, 0x52, 0xa1, 0x61, 0x9f, 0x55, 0xa7, 0xde, 0x56, 0x4c, 0x82, 0x4f, 0xc1, 0x8c, 0x8d, 0x5c,
-	0xcb, 0xc5, 0xb6, 0xd0, 0xb2, 0x37, 0xa5, 0x79, 0x32, 0x76, 0x68, 0x4e, 0xdb, 0xc8, 0xdd, 0xc5,
-	0xb6, 0x80, 0x5f, 0x80, 0xf9, 0x56, 0x44, 0x5d, 0x42, 0xdb, 0x56, 0x80, 0xce, 0x7c, 0x4c, 0x85,
-	0x36, 0x97, 0x6a, 0x63, 0x79, 0x85, 0xa9, 0x27, 0x14, 0xf8, 0x3e, 0x98, 0xb3, 0x3d, 0xe6, 0x9c,
-	0x58, 0xc7, 0x98, 0xb4, 0x8f, 0x85, 0x96, 0x5b, 0xcd, 0xac, 0x4d, 0x98, 0x59, 0x69, 0xdb, 0x97,
-	0x26, 0x58, 0x06, 0xb9, 0x64, 0x4a, 0x2c, 0x15, 0x96, 0xcf, 0xb5, 0xfc, 0xc0, 0x9c, 0x26, 0xf1,
-	0xf1, 0x21, 0x2f, 0xff, 0x34, 0x0b, 0x96, 0xbb, 0xaa, 0x71, 0x40, 0x5e, 0x44, 0xc4, 0x45, 0xe2,
-	0xdd, 0x0a, 0x87, 0x0b, 0x96, 0xfa, 0xad, 0xf3, 0x22, 0x62, 0x02, 0x5b, 0xc8, 0x67, 0x11, 0x15,
-	0x52, 0x48, 0xee, 0x9e, 0xb8, 0x62, 0x8f, 0xf6, 0x2c, 0x86, 0x6d, 0x49, 0x16, 0x6c, 0x81, 0xe5,
-	0xbe, 0x97, 0xe1, 0x3a, 0x4f, 0x27, 0x2d, 0x8b, 0x3d, 0x5c, 0x7d, 0xb0, 0xe0, 0xd7, 0x01, 0xf4,
-	0x54, 0x5a, 0x59, 0x3f, 0x70, 0xa9, 0x31, 0xe6, 0x42, 0x7f, 0xa4, 0x1b, 0x7c, 0x1b, 0x2c, 0xb4,
-	0x30, 0xb6, 0x04, 0xb3, 0xfa, 0x63, 0x52, 0x36, 0x5e, 0x5b, 0x73, 0xab, 0xaa, 0xb5, 0xb5, 0xa4,
-	0xb5, 0x47, 0x08, 0x65, 0x73, 0xbe, 0x85, 0x71, 0x93, 0x1d, 0xf4, 0x2c, 0x30, 0x04, 0x8b, 0x6a,
-	0x1a, 0x76, 0x18, 0x3f, 0xe3, 0x02, 0xfb, 0x56, 0x5c, 0x61, 0x37, 0xeb, 0xc8, 0x07, 0xca, 0xd9,
-	0x93, 0x21, 0x67, 0xc3, 0x94, 0xb2, 0x09, 0xa5, 0xc3, 0x4a, 0xd7, 0xba, 0x17, 0x51, 0x77, 0xa8,
-	0x8f, 0x66, 0xee, 0xd8, 0x47, 0xfd, 0xe3, 0x64, 0xf6, 0x3e, 0x8e, 0x13, 0xf0, 0x96, 0x8e, 0x93,
-	0x11, 0xd1, 0xcc, 0xbe, 0x05, 0xd1, 0x6c, 0x82, 0xdc, 0x90, 0x2a, 0xa5, 0x54, 0x90, 0x61, 0x08,
-	0x3c, 0x04, 0xc0, 0x47, 0xe1, 0x89, 0x15, 0x84, 0xc4, 0xc1, 0x52, 0x3e, 0xee, 0x8e, 0x9c, 0x8d,
-	0x09, 0xf5, 0x18, 0x30, 0xa2, 0x47, 0xf9, 0x5b, 0xe8, 0xd1, 0xfc, 0xa8, 0x1e, 0xfd, 0x32, 0xde,
-	0xbf, 0xc5, 0x34, 0xb0, 0x10, 0xde, 0xbb, 0x15, 0xa3, 0x1f, 0x33, 0x20, 0xc7, 0x93, 0x6d, 0x58,
-	0xf1, 0x0d, 0x8d, 0x6b, 0x13, 0xab, 0x13, 0xaf, 0x2f, 0xbf, 0x7d, 0x55, 0x7e, 0xc5, 0xa4, 0xfc,
-	0x86, 0x56, 0x97, 0x7f, 0xfd, 0x73, 0x65, 0xed, 0x16, 0xb9, 0x8d, 0x41, 0xdc, 0x9c, 0x53, 0x6b,
-	0xe5, 0x57, 0xf9, 0x87, 0x07, 0x60, 0x79, 0x2f, 0x39, 0x03, 0x4c, 0x24, 0xf0, 0x7d, 0x5e, 0xf1,
-	0x86, 0x4b, 0x63, 0xfc, 0x4d, 0x4b, 0xe3, 0x08, 0x64, 0x09, 0x75, 0xf1, 0xa9, 0xe2, 0xa5, 0x93,
-	0x71, 0x20, 0x11, 0x09, 0xf0, 0x3b, 0xf0, 0xd0, 0x43, 0x02, 0x73, 0x61, 0x75, 0xcf, 0xd6, 0x10,
-	0x89, 0xb4, 0xc2, 0xbd, 0x90, 0xa0, 0x06, 0x52, 0x1b, 0x1f, 0x0e, 0x8a, 0x1f, 0x84, 0xd8, 0x27,
-	0x91, 0x6f, 0xb5, 0xc2, 0xe4, 0x62, 0x94, 0xf2, 0x76, 0xb8, 0x98, 0xe0, 0xea, 0x09, 0x6d, 0x4f,
-	0xc1, 0x20, 0x05, 0xef, 0x39, 0x91, 0x1f, 0x79, 0x48, 0x90, 0x0e, 0x1e, 0xf5, 0x95, 0xee, 0xba,
-	0xf8, 0xa8, 0x8f, 0xbc, 0xee, 0xef, 0x7a, 0x8f, 0x4e, 0xdf, 0xa2, 0x47, 0x67, 0x46, 0x7b, 0xf4,
-	0xef, 0x71, 0xb0, 0xd4, 0x3d, 0x4a, 0xe2, 0xcb, 0x22, 0x22, 0xf7, 0xd5, 0xa5, 0x4b, 0x60, 0x2a,
-	0xe9, 0x47, 0xd5, 0x9d, 0xea, 0x0b, 0x96, 0x00, 0x18, 0x38, 0x1f, 0x65, 0x41, 0x99, 0x03, 0x16,
-	0xf8, 0x39, 0x98, 0x0a, 0x31, 0xe2, 0x8c, 0xca, 0x9a, 0xc8, 0x6f, 0x7e, 0xaa, 0x0f, 0x3f, 0xdb,
-	0xf4, 0xff, 0xde, 0xfe, 0xa8, 0xd9, 0x94, 0x14, 0x53, 0xd1, 0xca, 0x01, 0x58, 0xfe, 0x9f, 0x29,
-	0x70, 0x1e, 0x64, 0x9f, 0xd7, 0x1a, 0xf5, 0xca, 0x4e, 0x75, 0xaf, 0x5a, 0xd9, 0x2d, 0x8c, 0xc1,
-	0x22, 0x28, 0xd4, 0x8f, 0x1a, 0xd5, 0x66, 0xf5, 0xa8, 0x66, 0xed, 0x57, 0xb6, 0x0e, 0x9a, 0xfb,
-	0x5f, 0x15, 0x32, 0xb1, 0xb5, 0x76, 0x54, 0xab, 0x7c, 0x59, 0x6d, 0x34, 0x2b, 0xb5, 0xa6, 0x55,
-	0xdf, 0xaa, 0x9a, 0x85, 0x71, 0xa8, 0x81, 0xe2, 0x90, 0x55, 0xad, 0x2b, 0x4c, 0x6c, 0x7f, 0xf6,
-	0xf2, 0xb2, 0x94, 0x79, 0x75, 0x59, 0xca, 0xfc, 0x75, 0x59, 0xca, 0xfc, 0x7c, 0x55, 0x1a, 0x7b,
-	0x75, 0x55, 0x1a, 0xfb, 0xfd, 0xaa, 0x34, 0xf6, 0xf5, 0xfa, 0x4d, 0x49, 0x95, 0xaf, 0x48, 0x59,
-	0x17, 0x46, 0x67, 0xd3, 0x9e, 0x92, 0xaf, 0xc4, 0x8f, 0xff, 0x0d, 0x00, 0x00, 0xff, 0xff, 0x0c,
-	0xdf, 0xec, 0xab, 0xd6, 0x0e, 0x00, 0x00,
+var xxx_messageInfo_TriggerOrderExecutedEvent proto.InternalMessageInfo
+
+func (m *TriggerOrderExecutedEvent) GetOrder() TriggerOrder {
+	if m != nil {
+		return m.Order
+	}
+	return TriggerOrder{}
 }
 
-func (m *PositionChangedEvent) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *TriggerOrderExecutedEvent) GetBlockHeight() int64 {
+	if m != nil {
+		return m.BlockHeight
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *PositionChangedEvent) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// Emitted when a trigger order is removed without being executed.
+type TriggerOrderCancelledEvent struct {
+	Order TriggerOrder `protobuf:"bytes,1,opt,name=order,proto3" json:"order"`
+	// Why the order was cancelled, e.g. by the trader or because its execution
+	// failed.
+	Reason string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	// The block number at which the order was cancelled.
+	BlockHeight int64 `protobuf:"varint,3,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
 }
 
-func (m *PositionChangedEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.BlockTimeMs != 0 {
-		i = encodeVarintEvent(dAtA, i, uint64(m.BlockTimeMs))
-		i--
-		dAtA[i] = 0x70
-	}
-	if m.BlockHeight != 0 {
-		i = encodeVarintEvent(dAtA, i, uint64(m.BlockHeight))
-		i--
-		dAtA[i] = 0x68
-	}
-	{
-		size := m.FundingPayment.Size()
-		i -= size
-		if _, err := m.FundingPayment.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintEvent(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x62
-	{
-		size, err := m.BadDebt.MarshalToSizedBuffer(dAtA[:i])
+func (m *TriggerOrderCancelledEvent) Reset()         { *m = TriggerOrderCancelledEvent{} }
+func (m *TriggerOrderCancelledEvent) String() string { return proto.CompactTextString(m) }
+func (*TriggerOrderCancelledEvent) ProtoMessage()    {}
+func (*TriggerOrderCancelledEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e18a1bd6d2374200, []int{6}
+}
+func (m *TriggerOrderCancelledEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TriggerOrderCancelledEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TriggerOrderCancelledEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintEvent(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x5a
-	{
-		size := m.UnrealizedPnlAfter.Size()
-		i -= size
-		if _, err := m.UnrealizedPnlAfter.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintEvent(dAtA, i, uint64(size))
+}
+func (m *TriggerOrderCancelledEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TriggerOrderCancelledEvent.Merge(m, src)
+}
+func (m *TriggerOrderCancelledEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *TriggerOrderCancelledEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_TriggerOrderCancelledEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TriggerOrderCancelledEvent proto.InternalMessageInfo
+
+func (m *TriggerOrderCancelledEvent) GetOrder() TriggerOrder {
+	if m != nil {
+		return m.Order
 	}
-	i--
-	dAtA[i] = 0x52
-	{
-		size := m.RealizedPnl.Size()
-		i -= size
-		if _, err := m.RealizedPnl.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintEvent(dAtA, i, uint64(size))
+	return TriggerOrder{}
+}
+
+func (m *TriggerOrderCancelledEvent) GetReason() string {
+	if m != nil {
+		return m.Reason
 	}
-	i--
-	dAtA[i] = 0x4a
-	{
-		size := m.PositionSize.Size()
-		i -= size
-		if _, err := m.PositionSize.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintEvent(dAtA, i, uint64(size))
+	return ""
+}
+
+func (m *TriggerOrderCancelledEvent) GetBlockHeight() int64 {
+	if m != nil {
+		return m.BlockHeight
 	}
-	i--
-	dAtA[i] = 0x42
-	{
-		size, err := m.TransactionFee.MarshalToSizedBuffer(dAtA[:i])
+	return 0
+}
+
+// Emitted when a trader switches between isolated and cross margin.
+type MarginModeChangedEvent struct {
+	TraderAddress string `protobuf:"bytes,1,opt,name=trader_address,json=traderAddress,proto3" json:"trader_address,omitempty"`
+	// whether the positions of the trader are now cross-margined
+	CrossMargin bool `protobuf:"varint,2,opt,name=cross_margin,json=crossMargin,proto3" json:"cross_margin,omitempty"`
+	// The block number at which the margin mode changed.
+	BlockHeight int64 `protobuf:"varint,3,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+}
+
+func (m *MarginModeChangedEvent) Reset()         { *m = MarginModeChangedEvent{} }
+func (m *MarginModeChangedEvent) String() string { return proto.CompactTextString(m) }
+func (*MarginModeChangedEvent) ProtoMessage()    {}
+func (*MarginModeChangedEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e18a1bd6d2374200, []int{7}
+}
+func (m *MarginModeChangedEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MarginModeChangedEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MarginModeChangedEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintEvent(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x3a
-	{
-		size := m.ExchangedNotional.Size()
-		i -= size
-		if _, err := m.ExchangedNotional.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintEvent(dAtA, i, uint64(size))
+}
+func (m *MarginModeChangedEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MarginModeChangedEvent.Merge(m, src)
+}
+func (m *MarginModeChangedEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *MarginModeChangedEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_MarginModeChangedEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MarginModeChangedEvent proto.InternalMessageInfo
+
+func (m *MarginModeChangedEvent) GetTraderAddress() string {
+	if m != nil {
+		return m.TraderAddress
 	}
-	i--
-	dAtA[i] = 0x32
-	{
-		size := m.ExchangedSize.Size()
-		i -= size
-		if _, err := m.ExchangedSize.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintEvent(dAtA, i, uint64(size))
+	return ""
+}
+
+func (m *MarginModeChangedEvent) GetCrossMargin() bool {
+	if m != nil {
+		return m.CrossMargin
 	}
-	i--
-	dAtA[i] = 0x2a
-	{
-		size := m.PositionNotional.Size()
-		i -= size
-		if _, err := m.PositionNotional.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintEvent(dAtA, i, uint64(size))
+	return false
+}
+
+func (m *MarginModeChangedEvent) GetBlockHeight() int64 {
+	if m != nil {
+		return m.BlockHeight
 	}
-	i--
-	dAtA[i] = 0x22
-	{
-		size, err := m.Margin.MarshalToSizedBuffer(dAtA[:i])
+	return 0
+}
+
+// Emitted when the insurance fund of a market covers bad debt.
+type InsuranceFundDrawDownEvent struct {
+	DrawDown InsuranceFundDrawDown `protobuf:"bytes,1,opt,name=draw_down,json=drawDown,proto3" json:"draw_down"`
+	// the balance of the fund after the draw-down
+	FundBalance types.Coin `protobuf:"bytes,2,opt,name=fund_balance,json=fundBalance,proto3" json:"fund_balance"`
+}
+
+func (m *InsuranceFundDrawDownEvent) Reset()         { *m = InsuranceFundDrawDownEvent{} }
+func (m *InsuranceFundDrawDownEvent) String() string { return proto.CompactTextString(m) }
+func (*InsuranceFundDrawDownEvent) ProtoMessage()    {}
+func (*InsuranceFundDrawDownEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e18a1bd6d2374200, []int{8}
+}
+func (m *InsuranceFundDrawDownEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *InsuranceFundDrawDownEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_InsuranceFundDrawDownEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintEvent(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	if len(m.TraderAddress) > 0 {
-		i -= len(m.TraderAddress)
-		copy(dAtA[i:], m.TraderAddress)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.TraderAddress)))
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	{
-		size := m.Pair.Size()
-		i -= size
-		if _, err := m.Pair.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintEvent(dAtA, i, uint64(size))
+}
+func (m *InsuranceFundDrawDownEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InsuranceFundDrawDownEvent.Merge(m, src)
+}
+func (m *InsuranceFundDrawDownEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *InsuranceFundDrawDownEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_InsuranceFundDrawDownEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InsuranceFundDrawDownEvent proto.InternalMessageInfo
+
+func (m *InsuranceFundDrawDownEvent) GetDrawDown() InsuranceFundDrawDown {
+	if m != nil {
+		return m.DrawDown
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return InsuranceFundDrawDown{}
 }
 
-func (m *PositionLiquidatedEvent) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *InsuranceFundDrawDownEvent) GetFundBalance() types.Coin {
+	if m != nil {
+		return m.FundBalance
 	}
-	return dAtA[:n], nil
+	return types.Coin{}
 }
 
-func (m *PositionLiquidatedEvent) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// Emitted when a position is reduced to cover bad debt that neither the
+// insurance fund nor the ecosystem fund could cover.
+type AutoDeleveragedEvent struct {
+	Pair github_com_NibiruChain_nibiru_x_common_asset.Pair `protobuf:"bytes,1,opt,name=pair,proto3,customtype=github.com/NibiruChain/nibiru/x/common/asset.Pair" json:"pair"`
+	// the owner of the deleveraged position
+	TraderAddress string `protobuf:"bytes,2,opt,name=trader_address,json=traderAddress,proto3" json:"trader_address,omitempty"`
+	// the owner of the bankrupt position whose bad debt is covered
+	BankruptTraderAddress string `protobuf:"bytes,3,opt,name=bankrupt_trader_address,json=bankruptTraderAddress,proto3" json:"bankrupt_trader_address,omitempty"`
+	// the signed change in the size of the deleveraged position
+	ExchangedSize github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=exchanged_size,json=exchangedSize,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"exchanged_size"`
+	// the bankruptcy price of the bankrupt position, at which the reduced size is
+	// settled off the AMM
+	BankruptcyPrice github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=bankruptcy_price,json=bankruptcyPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"bankruptcy_price"`
+	// the pnl realized by the reduction at the bankruptcy price
+	RealizedPnl github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=realized_pnl,json=realizedPnl,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"realized_pnl"`
+	// the pnl given up against the mark price, which covers the bad debt
+	Haircut types.Coin `protobuf:"bytes,7,opt,name=haircut,proto3" json:"haircut"`
+	// the size of the position after the reduction
+	PositionSize github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,8,opt,name=position_size,json=positionSize,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"position_size"`
+	// The block number at which the position was deleveraged.
+	BlockHeight int64 `protobuf:"varint,9,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	// The block time in unix milliseconds at which the position was
+	// deleveraged.
+	BlockTimeMs int64 `protobuf:"varint,10,opt,name=block_time_ms,json=blockTimeMs,proto3" json:"block_time_ms,omitempty"`
 }
 
-func (m *PositionLiquidatedEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.BlockTimeMs != 0 {
-		i = encodeVarintEvent(dAtA, i, uint64(m.BlockTimeMs))
-		i--
-		dAtA[i] = 0x78
-	}
-	if m.BlockHeight != 0 {
-		i = encodeVarintEvent(dAtA, i, uint64(m.BlockHeight))
-		i--
-		dAtA[i] = 0x70
-	}
-	{
-		size := m.MarkPrice.Size()
-		i -= size
-		if _, err := m.MarkPrice.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintEvent(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x6a
-	{
-		size := m.UnrealizedPnl.Size()
-		i -= size
-		if _, err := m.UnrealizedPnl.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintEvent(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x62
-	{
-		size := m.PositionSize.Size()
-		i -= size
-		if _, err := m.PositionSize.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintEvent(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x5a
-	{
-		size := m.PositionNotional.Size()
-		i -= size
-		if _, err := m.PositionNotional.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintEvent(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x52
-	{
-		size, err := m.Margin.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintEvent(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x4a
-	{
-		size, err := m.BadDebt.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintEvent(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x42
-	{
-		size, err := m.FeeToEcosystemFund.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintEvent(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x3a
-	{
-		size, err := m.FeeToLiquidator.MarshalToSizedBuffer(dAtA[:i])
+func (m *AutoDeleveragedEvent) Reset()         { *m = AutoDeleveragedEvent{} }
+func (m *AutoDeleveragedEvent) String() string { return proto.CompactTextString(m) }
+func (*AutoDeleveragedEvent) ProtoMessage()    {}
+func (*AutoDeleveragedEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e18a1bd6d2374200, []int{9}
+}
+func (m *AutoDeleveragedEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AutoDeleveragedEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AutoDeleveragedEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintEvent(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x32
-	if len(m.LiquidatorAddress) > 0 {
-		i -= len(m.LiquidatorAddress)
-		copy(dAtA[i:], m.LiquidatorAddress)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.LiquidatorAddress)))
-		i--
-		dAtA[i] = 0x2a
+		return b[:n], nil
 	}
-	{
-		size := m.ExchangedPositionSize.Size()
-		i -= size
-		if _, err := m.ExchangedPositionSize.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintEvent(dAtA, i, uint64(size))
+}
+func (m *AutoDeleveragedEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AutoDeleveragedEvent.Merge(m, src)
+}
+func (m *AutoDeleveragedEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *AutoDeleveragedEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_AutoDeleveragedEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AutoDeleveragedEvent proto.InternalMessageInfo
+
+func (m *AutoDeleveragedEvent) GetTraderAddress() string {
+	if m != nil {
+		return m.TraderAddress
 	}
-	i--
-	dAtA[i] = 0x22
-	{
-		size := m.ExchangedQuoteAmount.Size()
-		i -= size
-		if _, err := m.ExchangedQuoteAmount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintEvent(dAtA, i, uint64(size))
+	return ""
+}
+
+func (m *AutoDeleveragedEvent) GetBankruptTraderAddress() string {
+	if m != nil {
+		return m.BankruptTraderAddress
 	}
-	i--
-	dAtA[i] = 0x1a
-	if len(m.TraderAddress) > 0 {
-		i -= len(m.TraderAddress)
-		copy(dAtA[i:], m.TraderAddress)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.TraderAddress)))
-		i--
-		dAtA[i] = 0x12
+	return ""
+}
+
+func (m *AutoDeleveragedEvent) GetHaircut() types.Coin {
+	if m != nil {
+		return m.Haircut
 	}
-	{
-		size := m.Pair.Size()
-		i -= size
-		if _, err := m.Pair.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintEvent(dAtA, i, uint64(size))
+	return types.Coin{}
+}
+
+func (m *AutoDeleveragedEvent) GetBlockHeight() int64 {
+	if m != nil {
+		return m.BlockHeight
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *PositionSettledEvent) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *AutoDeleveragedEvent) GetBlockTimeMs() int64 {
+	if m != nil {
+		return m.BlockTimeMs
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *PositionSettledEvent) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// Emitted when the price multiplier of an AMM is updated.
+type PriceMultiplierUpdatedEvent struct {
+	Pair github_com_NibiruChain_nibiru_x_common_asset.Pair `protobuf:"bytes,1,opt,name=pair,proto3,customtype=github.com/NibiruChain/nibiru/x/common/asset.Pair" json:"pair"`
+	// the price multiplier before the update
+	OldPriceMultiplier github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=old_price_multiplier,json=oldPriceMultiplier,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"old_price_multiplier"`
+	// the price multiplier after the update
+	NewPriceMultiplier github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=new_price_multiplier,json=newPriceMultiplier,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"new_price_multiplier"`
+	// the cost of the update paid by the ecosystem fund to the vault. Negative
+	// when the vault pays the ecosystem fund.
+	Cost github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=cost,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"cost"`
+	// The block number at which the price multiplier was updated.
+	BlockHeight int64 `protobuf:"varint,5,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
 }
 
-func (m *PositionSettledEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.SettledCoins) > 0 {
-		for iNdEx := len(m.SettledCoins) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.SettledCoins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintEvent(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
-		}
-	}
-	if len(m.TraderAddress) > 0 {
-		i -= len(m.TraderAddress)
-		copy(dAtA[i:], m.TraderAddress)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.TraderAddress)))
-		i--
-		dAtA[i] = 0x12
-	}
-	{
-		size := m.Pair.Size()
-		i -= size
-		if _, err := m.Pair.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *PriceMultiplierUpdatedEvent) Reset()         { *m = PriceMultiplierUpdatedEvent{} }
+func (m *PriceMultiplierUpdatedEvent) String() string { return proto.CompactTextString(m) }
+func (*PriceMultiplierUpdatedEvent) ProtoMessage()    {}
+func (*PriceMultiplierUpdatedEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e18a1bd6d2374200, []int{10}
+}
+func (m *PriceMultiplierUpdatedEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PriceMultiplierUpdatedEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PriceMultiplierUpdatedEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintEvent(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *PriceMultiplierUpdatedEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PriceMultiplierUpdatedEvent.Merge(m, src)
+}
+func (m *PriceMultiplierUpdatedEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *PriceMultiplierUpdatedEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_PriceMultiplierUpdatedEvent.DiscardUnknown(m)
 }
 
-func (m *FundingRateChangedEvent) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_PriceMultiplierUpdatedEvent proto.InternalMessageInfo
+
+func (m *PriceMultiplierUpdatedEvent) GetBlockHeight() int64 {
+	if m != nil {
+		return m.BlockHeight
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *FundingRateChangedEvent) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// Emitted when the swap invariant of an AMM is updated.
+type SwapInvariantUpdatedEvent struct {
+	Pair github_com_NibiruChain_nibiru_x_common_asset.Pair `protobuf:"bytes,1,opt,name=pair,proto3,customtype=github.com/NibiruChain/nibiru/x/common/asset.Pair" json:"pair"`
+	// the multiplier applied to the swap invariant
+	SwapInvariantMultiplier github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=swap_invariant_multiplier,json=swapInvariantMultiplier,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"swap_invariant_multiplier"`
+	// the sqrt depth after the update
+	NewSqrtDepth github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=new_sqrt_depth,json=newSqrtDepth,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"new_sqrt_depth"`
+	// the cost of the update paid by the ecosystem fund to the vault. Negative
+	// when the vault pays the ecosystem fund.
+	Cost github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=cost,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"cost"`
+	// The block number at which the swap invariant was updated.
+	BlockHeight int64 `protobuf:"varint,5,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
 }
 
-func (m *FundingRateChangedEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.BlockTimeMs != 0 {
-		i = encodeVarintEvent(dAtA, i, uint64(m.BlockTimeMs))
-		i--
-		dAtA[i] = 0x40
-	}
-	if m.BlockHeight != 0 {
-		i = encodeVarintEvent(dAtA, i, uint64(m.BlockHeight))
-		i--
-		dAtA[i] = 0x38
-	}
-	{
-		size := m.CumulativePremiumFraction.Size()
-		i -= size
-		if _, err := m.CumulativePremiumFraction.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *SwapInvariantUpdatedEvent) Reset()         { *m = SwapInvariantUpdatedEvent{} }
+func (m *SwapInvariantUpdatedEvent) String() string { return proto.CompactTextString(m) }
+func (*SwapInvariantUpdatedEvent) ProtoMessage()    {}
+func (*SwapInvariantUpdatedEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e18a1bd6d2374200, []int{11}
+}
+func (m *SwapInvariantUpdatedEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SwapInvariantUpdatedEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SwapInvariantUpdatedEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintEvent(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x32
-	{
-		size := m.LatestPremiumFraction.Size()
-		i -= size
-		if _, err := m.LatestPremiumFraction.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintEvent(dAtA, i, uint64(size))
+}
+func (m *SwapInvariantUpdatedEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SwapInvariantUpdatedEvent.Merge(m, src)
+}
+func (m *SwapInvariantUpdatedEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *SwapInvariantUpdatedEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_SwapInvariantUpdatedEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SwapInvariantUpdatedEvent proto.InternalMessageInfo
+
+func (m *SwapInvariantUpdatedEvent) GetBlockHeight() int64 {
+	if m != nil {
+		return m.BlockHeight
 	}
-	i--
-	dAtA[i] = 0x2a
-	{
-		size := m.LatestFundingRate.Size()
-		i -= size
-		if _, err := m.LatestFundingRate.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	return 0
+}
+
+// Emitted when the circuit breaker of a market is tripped.
+type CircuitBreakerTrippedEvent struct {
+	CircuitBreaker CircuitBreaker `protobuf:"bytes,1,opt,name=circuit_breaker,json=circuitBreaker,proto3" json:"circuit_breaker"`
+}
+
+func (m *CircuitBreakerTrippedEvent) Reset()         { *m = CircuitBreakerTrippedEvent{} }
+func (m *CircuitBreakerTrippedEvent) String() string { return proto.CompactTextString(m) }
+func (*CircuitBreakerTrippedEvent) ProtoMessage()    {}
+func (*CircuitBreakerTrippedEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e18a1bd6d2374200, []int{12}
+}
+func (m *CircuitBreakerTrippedEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CircuitBreakerTrippedEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CircuitBreakerTrippedEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintEvent(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x22
-	{
-		size := m.IndexPrice.Size()
-		i -= size
-		if _, err := m.IndexPrice.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintEvent(dAtA, i, uint64(size))
+}
+func (m *CircuitBreakerTrippedEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CircuitBreakerTrippedEvent.Merge(m, src)
+}
+func (m *CircuitBreakerTrippedEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *CircuitBreakerTrippedEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_CircuitBreakerTrippedEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CircuitBreakerTrippedEvent proto.InternalMessageInfo
+
+func (m *CircuitBreakerTrippedEvent) GetCircuitBreaker() CircuitBreaker {
+	if m != nil {
+		return m.CircuitBreaker
 	}
-	i--
-	dAtA[i] = 0x1a
-	{
-		size := m.MarkPrice.Size()
-		i -= size
-		if _, err := m.MarkPrice.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	return CircuitBreaker{}
+}
+
+// Emitted when the circuit breaker of a market is reset.
+type CircuitBreakerResetEvent struct {
+	Pair github_com_NibiruChain_nibiru_x_common_asset.Pair `protobuf:"bytes,1,opt,name=pair,proto3,customtype=github.com/NibiruChain/nibiru/x/common/asset.Pair" json:"pair"`
+	// The block number at which the circuit breaker was reset.
+	BlockHeight int64 `protobuf:"varint,2,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+}
+
+func (m *CircuitBreakerResetEvent) Reset()         { *m = CircuitBreakerResetEvent{} }
+func (m *CircuitBreakerResetEvent) String() string { return proto.CompactTextString(m) }
+func (*CircuitBreakerResetEvent) ProtoMessage()    {}
+func (*CircuitBreakerResetEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e18a1bd6d2374200, []int{13}
+}
+func (m *CircuitBreakerResetEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CircuitBreakerResetEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CircuitBreakerResetEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintEvent(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x12
-	{
-		size := m.Pair.Size()
-		i -= size
-		if _, err := m.Pair.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+}
+func (m *CircuitBreakerResetEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CircuitBreakerResetEvent.Merge(m, src)
+}
+func (m *CircuitBreakerResetEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *CircuitBreakerResetEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_CircuitBreakerResetEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CircuitBreakerResetEvent proto.InternalMessageInfo
+
+func (m *CircuitBreakerResetEvent) GetBlockHeight() int64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+// Emitted when a referrer earns a rebate on the trading fees of a referee.
+type ReferralRebatePaidEvent struct {
+	Referrer string                                            `protobuf:"bytes,1,opt,name=referrer,proto3" json:"referrer,omitempty"`
+	Referee  string                                            `protobuf:"bytes,2,opt,name=referee,proto3" json:"referee,omitempty"`
+	Pair     github_com_NibiruChain_nibiru_x_common_asset.Pair `protobuf:"bytes,3,opt,name=pair,proto3,customtype=github.com/NibiruChain/nibiru/x/common/asset.Pair" json:"pair"`
+	Rebate   types.Coin                                        `protobuf:"bytes,4,opt,name=rebate,proto3" json:"rebate"`
+}
+
+func (m *ReferralRebatePaidEvent) Reset()         { *m = ReferralRebatePaidEvent{} }
+func (m *ReferralRebatePaidEvent) String() string { return proto.CompactTextString(m) }
+func (*ReferralRebatePaidEvent) ProtoMessage()    {}
+func (*ReferralRebatePaidEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e18a1bd6d2374200, []int{14}
+}
+func (m *ReferralRebatePaidEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReferralRebatePaidEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReferralRebatePaidEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintEvent(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *ReferralRebatePaidEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReferralRebatePaidEvent.Merge(m, src)
+}
+func (m *ReferralRebatePaidEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReferralRebatePaidEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReferralRebatePaidEvent.DiscardUnknown(m)
 }
 
-func (m *LiquidationFailedEvent) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ReferralRebatePaidEvent proto.InternalMessageInfo
+
+func (m *ReferralRebatePaidEvent) GetReferrer() string {
+	if m != nil {
+		return m.Referrer
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *LiquidationFailedEvent) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ReferralRebatePaidEvent) GetReferee() string {
+	if m != nil {
+		return m.Referee
+	}
+	return ""
 }
 
-func (m *LiquidationFailedEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Reason != 0 {
-		i = encodeVarintEvent(dAtA, i, uint64(m.Reason))
-		i--
-		dAtA[i] = 0x20
+func (m *ReferralRebatePaidEvent) GetRebate() types.Coin {
+	if m != nil {
+		return m.Rebate
 	}
-	if len(m.Liquidator) > 0 {
-		i -= len(m.Liquidator)
-		copy(dAtA[i:], m.Liquidator)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.Liquidator)))
-		i--
-		dAtA[i] = 0x1a
+	return types.Coin{}
+}
+
+// Emitted when an underwater position of a market in AUCTION liquidation mode
+// is put up for auction.
+type LiquidationAuctionStartedEvent struct {
+	Auction LiquidationAuction `protobuf:"bytes,1,opt,name=auction,proto3" json:"auction"`
+	// the size of the auctioned position
+	PositionSize github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=position_size,json=positionSize,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"position_size"`
+	// the mark price when the auction started
+	MarkPrice github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=mark_price,json=markPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"mark_price"`
+}
+
+func (m *LiquidationAuctionStartedEvent) Reset()         { *m = LiquidationAuctionStartedEvent{} }
+func (m *LiquidationAuctionStartedEvent) String() string { return proto.CompactTextString(m) }
+func (*LiquidationAuctionStartedEvent) ProtoMessage()    {}
+func (*LiquidationAuctionStartedEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e18a1bd6d2374200, []int{15}
+}
+func (m *LiquidationAuctionStartedEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LiquidationAuctionStartedEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LiquidationAuctionStartedEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Trader) > 0 {
-		i -= len(m.Trader)
-		copy(dAtA[i:], m.Trader)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.Trader)))
-		i--
-		dAtA[i] = 0x12
+}
+func (m *LiquidationAuctionStartedEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LiquidationAuctionStartedEvent.Merge(m, src)
+}
+func (m *LiquidationAuctionStartedEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *LiquidationAuctionStartedEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_LiquidationAuctionStartedEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LiquidationAuctionStartedEvent proto.InternalMessageInfo
+
+func (m *LiquidationAuctionStartedEvent) GetAuction() LiquidationAuction {
+	if m != nil {
+		return m.Auction
 	}
-	{
-		size := m.Pair.Size()
-		i -= size
-		if _, err := m.Pair.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	return LiquidationAuction{}
+}
+
+// Emitted when a liquidator takes over an auctioned position, or part of it.
+type LiquidationAuctionBidEvent struct {
+	Auction           LiquidationAuction `protobuf:"bytes,1,opt,name=auction,proto3" json:"auction"`
+	LiquidatorAddress string             `protobuf:"bytes,2,opt,name=liquidator_address,json=liquidatorAddress,proto3" json:"liquidator_address,omitempty"`
+	// the signed base asset amount taken over
+	ExchangedPositionSize github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=exchanged_position_size,json=exchangedPositionSize,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"exchanged_position_size"`
+	// the notional value, at the auction price, of the size taken over
+	ExchangedNotionalValue github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=exchanged_notional_value,json=exchangedNotionalValue,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"exchanged_notional_value"`
+	// the discount to the index price
+	Discount     github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=discount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"discount"`
+	AuctionPrice github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=auction_price,json=auctionPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"auction_price"`
+	// the PnL realized by the trader at the auction price
+	RealizedPnl github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,7,opt,name=realized_pnl,json=realizedPnl,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"realized_pnl"`
+	BadDebt     types.Coin                             `protobuf:"bytes,8,opt,name=bad_debt,json=badDebt,proto3" json:"bad_debt"`
+	// the margin left in a fully taken over position, paid to the ecosystem
+	// fund
+	FeeToEcosystemFund types.Coin `protobuf:"bytes,9,opt,name=fee_to_ecosystem_fund,json=feeToEcosystemFund,proto3" json:"fee_to_ecosystem_fund"`
+	// The block number at which the bid was placed.
+	BlockHeight int64 `protobuf:"varint,10,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+}
+
+func (m *LiquidationAuctionBidEvent) Reset()         { *m = LiquidationAuctionBidEvent{} }
+func (m *LiquidationAuctionBidEvent) String() string { return proto.CompactTextString(m) }
+func (*LiquidationAuctionBidEvent) ProtoMessage()    {}
+func (*LiquidationAuctionBidEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e18a1bd6d2374200, []int{16}
+}
+func (m *LiquidationAuctionBidEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LiquidationAuctionBidEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LiquidationAuctionBidEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintEvent(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *LiquidationAuctionBidEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LiquidationAuctionBidEvent.Merge(m, src)
+}
+func (m *LiquidationAuctionBidEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *LiquidationAuctionBidEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_LiquidationAuctionBidEvent.DiscardUnknown(m)
 }
 
-func encodeVarintEvent(dAtA []byte, offset int, v uint64) int {
-	offset -= sovEvent(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+var xxx_messageInfo_LiquidationAuctionBidEvent proto.InternalMessageInfo
+
+func (m *LiquidationAuctionBidEvent) GetAuction() LiquidationAuction {
+	if m != nil {
+		return m.Auction
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return LiquidationAuction{}
 }
-func (m *PositionChangedEvent) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *LiquidationAuctionBidEvent) GetLiquidatorAddress() string {
+	if m != nil {
+		return m.LiquidatorAddress
 	}
-	var l int
-	_ = l
-	l = m.Pair.Size()
-	n += 1 + l + sovEvent(uint64(l))
-	l = len(m.TraderAddress)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	return ""
+}
+
+func (m *LiquidationAuctionBidEvent) GetBadDebt() types.Coin {
+	if m != nil {
+		return m.BadDebt
 	}
-	l = m.Margin.Size()
-	n += 1 + l + sovEvent(uint64(l))
-	l = m.PositionNotional.Size()
-	n += 1 + l + sovEvent(uint64(l))
-	l = m.ExchangedSize.Size()
-	n += 1 + l + sovEvent(uint64(l))
-	l = m.ExchangedNotional.Size()
-	n += 1 + l + sovEvent(uint64(l))
-	l = m.TransactionFee.Size()
-	n += 1 + l + sovEvent(uint64(l))
-	l = m.PositionSize.Size()
-	n += 1 + l + sovEvent(uint64(l))
-	l = m.RealizedPnl.Size()
-	n += 1 + l + sovEvent(uint64(l))
-	l = m.UnrealizedPnlAfter.Size()
-	n += 1 + l + sovEvent(uint64(l))
-	l = m.BadDebt.Size()
-	n += 1 + l + sovEvent(uint64(l))
-	l = m.FundingPayment.Size()
-	n += 1 + l + sovEvent(uint64(l))
-	if m.BlockHeight != 0 {
-		n += 1 + sovEvent(uint64(m.BlockHeight))
-	}
-	if m.BlockTimeMs != 0 {
-		n += 1 + sovEvent(uint64(m.BlockTimeMs))
+	return types.Coin{}
+}
+
+func (m *LiquidationAuctionBidEvent) GetFeeToEcosystemFund() types.Coin {
+	if m != nil {
+		return m.FeeToEcosystemFund
 	}
-	return n
+	return types.Coin{}
 }
 
-func (m *PositionLiquidatedEvent) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *LiquidationAuctionBidEvent) GetBlockHeight() int64 {
+	if m != nil {
+		return m.BlockHeight
 	}
-	var l int
-	_ = l
-	l = m.Pair.Size()
-	n += 1 + l + sovEvent(uint64(l))
-	l = len(m.TraderAddress)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	return 0
+}
+
+// Emitted when a liquidation auction is removed, either because the position
+// was fully taken over, closed, became healthy again or because the auction
+// expired.
+type LiquidationAuctionEndedEvent struct {
+	Auction LiquidationAuction `protobuf:"bytes,1,opt,name=auction,proto3" json:"auction"`
+	// Why the auction ended.
+	Reason string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	// The block number at which the auction ended.
+	BlockHeight int64 `protobuf:"varint,3,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+}
+
+func (m *LiquidationAuctionEndedEvent) Reset()         { *m = LiquidationAuctionEndedEvent{} }
+func (m *LiquidationAuctionEndedEvent) String() string { return proto.CompactTextString(m) }
+func (*LiquidationAuctionEndedEvent) ProtoMessage()    {}
+func (*LiquidationAuctionEndedEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e18a1bd6d2374200, []int{17}
+}
+func (m *LiquidationAuctionEndedEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LiquidationAuctionEndedEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LiquidationAuctionEndedEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = m.ExchangedQuoteAmount.Size()
-	n += 1 + l + sovEvent(uint64(l))
-	l = m.ExchangedPositionSize.Size()
-	n += 1 + l + sovEvent(uint64(l))
-	l = len(m.LiquidatorAddress)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+}
+func (m *LiquidationAuctionEndedEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LiquidationAuctionEndedEvent.Merge(m, src)
+}
+func (m *LiquidationAuctionEndedEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *LiquidationAuctionEndedEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_LiquidationAuctionEndedEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LiquidationAuctionEndedEvent proto.InternalMessageInfo
+
+func (m *LiquidationAuctionEndedEvent) GetAuction() LiquidationAuction {
+	if m != nil {
+		return m.Auction
 	}
-	l = m.FeeToLiquidator.Size()
-	n += 1 + l + sovEvent(uint64(l))
-	l = m.FeeToEcosystemFund.Size()
-	n += 1 + l + sovEvent(uint64(l))
-	l = m.BadDebt.Size()
-	n += 1 + l + sovEvent(uint64(l))
-	l = m.Margin.Size()
-	n += 1 + l + sovEvent(uint64(l))
-	l = m.PositionNotional.Size()
-	n += 1 + l + sovEvent(uint64(l))
-	l = m.PositionSize.Size()
-	n += 1 + l + sovEvent(uint64(l))
-	l = m.UnrealizedPnl.Size()
-	n += 1 + l + sovEvent(uint64(l))
-	l = m.MarkPrice.Size()
-	n += 1 + l + sovEvent(uint64(l))
-	if m.BlockHeight != 0 {
-		n += 1 + sovEvent(uint64(m.BlockHeight))
+	return LiquidationAuction{}
+}
+
+func (m *LiquidationAuctionEndedEvent) GetReason() string {
+	if m != nil {
+		return m.Reason
 	}
-	if m.BlockTimeMs != 0 {
-		n += 1 + sovEvent(uint64(m.BlockTimeMs))
+	return ""
+}
+
+func (m *LiquidationAuctionEndedEvent) GetBlockHeight() int64 {
+	if m != nil {
+		return m.BlockHeight
 	}
-	return n
+	return 0
 }
 
-func (m *PositionSettledEvent) Size() (n int) {
-	if m == nil {
-		return 0
+func init() {
+	proto.RegisterEnum("nibiru.perp.v2.LiquidationFailedEvent_LiquidationFailedReason", LiquidationFailedEvent_LiquidationFailedReason_name, LiquidationFailedEvent_LiquidationFailedReason_value)
+	proto.RegisterType((*PositionChangedEvent)(nil), "nibiru.perp.v2.PositionChangedEvent")
+	proto.RegisterType((*PositionLiquidatedEvent)(nil), "nibiru.perp.v2.PositionLiquidatedEvent")
+	proto.RegisterType((*PositionSettledEvent)(nil), "nibiru.perp.v2.PositionSettledEvent")
+	proto.RegisterType((*FundingRateChangedEvent)(nil), "nibiru.perp.v2.FundingRateChangedEvent")
+	proto.RegisterType((*LiquidationFailedEvent)(nil), "nibiru.perp.v2.LiquidationFailedEvent")
+	proto.RegisterType((*TriggerOrderExecutedEvent)(nil), "nibiru.perp.v2.TriggerOrderExecutedEvent")
+	proto.RegisterType((*TriggerOrderCancelledEvent)(nil), "nibiru.perp.v2.TriggerOrderCancelledEvent")
+	proto.RegisterType((*MarginModeChangedEvent)(nil), "nibiru.perp.v2.MarginModeChangedEvent")
+	proto.RegisterType((*InsuranceFundDrawDownEvent)(nil), "nibiru.perp.v2.InsuranceFundDrawDownEvent")
+	proto.RegisterType((*AutoDeleveragedEvent)(nil), "nibiru.perp.v2.AutoDeleveragedEvent")
+	proto.RegisterType((*PriceMultiplierUpdatedEvent)(nil), "nibiru.perp.v2.PriceMultiplierUpdatedEvent")
+	proto.RegisterType((*SwapInvariantUpdatedEvent)(nil), "nibiru.perp.v2.SwapInvariantUpdatedEvent")
+	proto.RegisterType((*CircuitBreakerTrippedEvent)(nil), "nibiru.perp.v2.CircuitBreakerTrippedEvent")
+	proto.RegisterType((*CircuitBreakerResetEvent)(nil), "nibiru.perp.v2.CircuitBreakerResetEvent")
+	proto.RegisterType((*ReferralRebatePaidEvent)(nil), "nibiru.perp.v2.ReferralRebatePaidEvent")
+	proto.RegisterType((*LiquidationAuctionStartedEvent)(nil), "nibiru.perp.v2.LiquidationAuctionStartedEvent")
+	proto.RegisterType((*LiquidationAuctionBidEvent)(nil), "nibiru.perp.v2.LiquidationAuctionBidEvent")
+	proto.RegisterType((*LiquidationAuctionEndedEvent)(nil), "nibiru.perp.v2.LiquidationAuctionEndedEvent")
+}
+
+func init() { proto.RegisterFile("perp/v2/event.proto", fileDescriptor_e18a1bd6d2374200) }
+
+var fileDescriptor_e18a1bd6d2374200 = []byte{
+	// 1844 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x59, 0x49, 0x6f, 0xe3, 0xc8,
+	0xf5, 0xb7, 0x2c, 0x2f, 0xd2, 0xd3, 0x62, 0x9b, 0x23, 0xdb, 0xb4, 0xa7, 0x21, 0x7b, 0x88, 0xff,
+	0xfc, 0xd1, 0x97, 0x96, 0xd0, 0x0e, 0x90, 0x64, 0xe6, 0x10, 0xc0, 0x8b, 0x1c, 0x2b, 0x68, 0xdb,
+	0x1a, 0x4a, 0x3d, 0xc9, 0x64, 0xe3, 0x94, 0xc8, 0x92, 0x5c, 0x31, 0x59, 0x64, 0x17, 0x8b, 0x52,
+	0xbb, 0xef, 0x41, 0xfa, 0x90, 0x00, 0x09, 0x72, 0xc8, 0x29, 0x40, 0xce, 0x73, 0xcd, 0x97, 0x98,
+	0xe3, 0x9c, 0x92, 0x20, 0x87, 0x4e, 0xd0, 0x7d, 0xca, 0x35, 0x9f, 0x20, 0x20, 0xab, 0xb4, 0xd2,
+	0xdd, 0x56, 0x68, 0x3b, 0x7d, 0xb2, 0xf9, 0xaa, 0xde, 0xef, 0x2d, 0xf5, 0xb6, 0x2a, 0xc1, 0x07,
+	0x1e, 0x66, 0x5e, 0xb5, 0xb7, 0x57, 0xc5, 0x3d, 0x4c, 0x79, 0xc5, 0x63, 0x2e, 0x77, 0x95, 0x22,
+	0x25, 0x6d, 0xc2, 0x82, 0x4a, 0xb8, 0x56, 0xe9, 0xed, 0x6d, 0x97, 0xba, 0x6e, 0xd7, 0x8d, 0x96,
+	0xaa, 0xe1, 0x7f, 0x62, 0xd7, 0xf6, 0x83, 0xae, 0xeb, 0x76, 0x6d, 0x5c, 0x45, 0x1e, 0xa9, 0x22,
+	0x4a, 0x5d, 0x8e, 0x38, 0x71, 0xa9, 0x2f, 0x57, 0xcb, 0xa6, 0xeb, 0x3b, 0xae, 0x5f, 0x6d, 0x23,
+	0x1f, 0x57, 0x7b, 0x8f, 0xdb, 0x98, 0xa3, 0xc7, 0x55, 0xd3, 0x25, 0x54, 0xae, 0x0f, 0x05, 0xfb,
+	0x1c, 0x71, 0x2c, 0x89, 0x3b, 0x12, 0x32, 0xfa, 0x6a, 0x07, 0x9d, 0x2a, 0x27, 0x0e, 0xf6, 0x39,
+	0x72, 0x3c, 0xb1, 0x41, 0xfb, 0x73, 0x06, 0x4a, 0x0d, 0xd7, 0x27, 0xa1, 0xa4, 0xc3, 0x0b, 0x44,
+	0xbb, 0xd8, 0xaa, 0x85, 0x8a, 0x2b, 0xa7, 0xb0, 0xe0, 0x21, 0xc2, 0xd4, 0xd4, 0x6e, 0xea, 0x61,
+	0xf6, 0xe0, 0x93, 0xaf, 0x5f, 0xed, 0xcc, 0xfd, 0xfd, 0xd5, 0xce, 0xe3, 0x2e, 0xe1, 0x17, 0x41,
+	0xbb, 0x62, 0xba, 0x4e, 0xf5, 0x2c, 0xb2, 0xe9, 0xf0, 0x02, 0x11, 0x5a, 0x15, 0xf6, 0x55, 0x9f,
+	0x57, 0x4d, 0xd7, 0x71, 0x5c, 0x5a, 0x45, 0xbe, 0x8f, 0x79, 0xa5, 0x81, 0x08, 0xd3, 0x23, 0x18,
+	0xe5, 0x63, 0x28, 0x72, 0x86, 0x2c, 0xcc, 0x0c, 0x64, 0x59, 0x0c, 0xfb, 0xbe, 0x3a, 0x1f, 0x02,
+	0xeb, 0x05, 0x41, 0xdd, 0x17, 0x44, 0xe5, 0x04, 0x96, 0x1c, 0xc4, 0xba, 0x84, 0xaa, 0xe9, 0xdd,
+	0xd4, 0xc3, 0xdc, 0xde, 0x56, 0x45, 0x58, 0x5d, 0x09, 0xad, 0xae, 0x48, 0xab, 0x2b, 0x87, 0x2e,
+	0xa1, 0x07, 0xeb, 0xa1, 0x4a, 0xff, 0x7e, 0xb5, 0x53, 0xb8, 0x42, 0x8e, 0xfd, 0xa9, 0x26, 0xd8,
+	0x34, 0x5d, 0xf2, 0x2b, 0x3f, 0x81, 0x35, 0x4f, 0xda, 0x65, 0x50, 0x37, 0xfc, 0x83, 0x6c, 0x75,
+	0x21, 0x32, 0xa6, 0x22, 0x8d, 0xf9, 0xff, 0x31, 0x63, 0xa4, 0x73, 0xc5, 0x9f, 0x47, 0xbe, 0x75,
+	0x59, 0xe5, 0x57, 0x1e, 0xf6, 0x2b, 0x47, 0xd8, 0xd4, 0x57, 0x07, 0x40, 0x67, 0x12, 0x47, 0x79,
+	0x0a, 0x45, 0xfc, 0xdc, 0x14, 0xee, 0x32, 0x7c, 0xf2, 0x02, 0xab, 0x8b, 0x89, 0x90, 0x0b, 0x43,
+	0x94, 0x26, 0x79, 0x81, 0x95, 0x9f, 0x81, 0x32, 0x82, 0x1d, 0x2a, 0xbd, 0x94, 0x08, 0x7a, 0x6d,
+	0x88, 0x34, 0xd4, 0xba, 0x0d, 0x2b, 0x9c, 0x21, 0xea, 0x23, 0x33, 0xf2, 0x4a, 0x07, 0x63, 0x75,
+	0xf9, 0x26, 0x2f, 0x97, 0xa5, 0x97, 0x37, 0x84, 0x97, 0xa7, 0xf8, 0x35, 0xbd, 0x38, 0x46, 0x39,
+	0xc6, 0x58, 0x69, 0x42, 0x61, 0xe8, 0xf6, 0xc8, 0x31, 0x99, 0x44, 0xda, 0xe7, 0x07, 0x20, 0x91,
+	0x5f, 0x3e, 0x83, 0x3c, 0xc3, 0xc8, 0x26, 0x2f, 0xb0, 0x65, 0x78, 0xd4, 0x56, 0xb3, 0x89, 0x30,
+	0x73, 0x03, 0x8c, 0x06, 0xb5, 0x95, 0x2f, 0xa1, 0x14, 0xd0, 0x71, 0x50, 0x03, 0x75, 0x38, 0x66,
+	0x2a, 0x24, 0x82, 0x56, 0x46, 0x58, 0x0d, 0x6a, 0xef, 0x87, 0x48, 0xca, 0xa7, 0x90, 0x69, 0x23,
+	0xcb, 0xb0, 0x70, 0x9b, 0xab, 0xb9, 0x9b, 0xdc, 0xbc, 0x10, 0x0a, 0xd4, 0x97, 0xdb, 0xc8, 0x3a,
+	0xc2, 0x6d, 0xae, 0xfc, 0x10, 0x56, 0x3a, 0x01, 0xb5, 0x08, 0xed, 0x1a, 0x1e, 0xba, 0x72, 0x30,
+	0xe5, 0x6a, 0x3e, 0x91, 0x62, 0x45, 0x09, 0xd3, 0x10, 0x28, 0xca, 0x47, 0x90, 0x6f, 0xdb, 0xae,
+	0x79, 0x69, 0x5c, 0x60, 0xd2, 0xbd, 0xe0, 0x6a, 0x61, 0x37, 0xf5, 0x30, 0xad, 0xe7, 0x22, 0xda,
+	0x49, 0x44, 0x52, 0x34, 0x28, 0x88, 0x2d, 0x61, 0xa9, 0x30, 0x1c, 0x5f, 0x2d, 0x8e, 0xed, 0x69,
+	0x11, 0x07, 0x9f, 0xfa, 0xda, 0x6f, 0xb2, 0xb0, 0x39, 0xa8, 0x1a, 0x4f, 0xc8, 0xb3, 0x80, 0x58,
+	0x88, 0xbf, 0xdf, 0xc2, 0x61, 0xc1, 0xc6, 0x28, 0x75, 0x9e, 0x05, 0x2e, 0xc7, 0x06, 0x72, 0xdc,
+	0x80, 0x72, 0x35, 0x9d, 0xc8, 0x71, 0xa5, 0x21, 0xda, 0x67, 0x21, 0xd8, 0x7e, 0x84, 0xa5, 0x74,
+	0x60, 0x73, 0x24, 0x65, 0x32, 0xce, 0x93, 0x95, 0x96, 0xf5, 0x21, 0x5c, 0x63, 0x3c, 0xe0, 0x1f,
+	0x81, 0x62, 0x4b, 0xb7, 0xba, 0x23, 0xc3, 0xa3, 0x1a, 0xa3, 0xaf, 0x8d, 0x56, 0x06, 0xc6, 0x77,
+	0x61, 0xad, 0x83, 0xb1, 0xc1, 0x5d, 0x63, 0xb4, 0xa6, 0x2e, 0xdd, 0x14, 0x73, 0xbb, 0x32, 0xb5,
+	0x55, 0x91, 0xda, 0x31, 0x04, 0x4d, 0x5f, 0xe9, 0x60, 0xdc, 0x72, 0x9f, 0x0c, 0x29, 0x0a, 0x83,
+	0x75, 0xb9, 0x0d, 0x9b, 0xae, 0x7f, 0xe5, 0x73, 0xec, 0x18, 0x61, 0x84, 0xdd, 0x5c, 0x47, 0xfe,
+	0x4f, 0x0a, 0x7b, 0x30, 0x21, 0x6c, 0x12, 0x45, 0xd3, 0x95, 0x48, 0x60, 0x6d, 0x40, 0x3d, 0x0e,
+	0xa8, 0x35, 0x91, 0x47, 0x99, 0xff, 0x32, 0x8f, 0x46, 0xed, 0x24, 0x7b, 0x1f, 0xed, 0x04, 0xee,
+	0xa8, 0x9d, 0xc4, 0x8a, 0x66, 0xee, 0x0e, 0x8a, 0x66, 0x0b, 0x0a, 0x13, 0x55, 0x29, 0x61, 0x05,
+	0x99, 0x04, 0x51, 0x4e, 0x01, 0x1c, 0xc4, 0x2e, 0x0d, 0x8f, 0x11, 0x13, 0xab, 0x85, 0x44, 0x90,
+	0xd9, 0x10, 0xa1, 0x11, 0x02, 0xc4, 0xea, 0x51, 0x71, 0x86, 0x7a, 0xb4, 0x12, 0xaf, 0x47, 0x7f,
+	0x98, 0x1f, 0x4d, 0x31, 0x4d, 0xcc, 0xb9, 0xfd, 0x7e, 0x8b, 0xd1, 0xcb, 0x14, 0x14, 0x7c, 0xa1,
+	0x86, 0x11, 0x4e, 0x68, 0xbe, 0x9a, 0xde, 0x4d, 0xbf, 0x3b, 0xfc, 0x4e, 0x64, 0xf8, 0x95, 0x44,
+	0xf8, 0x4d, 0x70, 0x6b, 0x5f, 0xfd, 0x63, 0xe7, 0xe1, 0x0c, 0xbe, 0x0d, 0x81, 0x7c, 0x3d, 0x2f,
+	0x79, 0xa3, 0x2f, 0xed, 0x57, 0x8b, 0xb0, 0x79, 0x2c, 0x7a, 0x80, 0x8e, 0x38, 0xbe, 0xcf, 0x11,
+	0x6f, 0x32, 0x34, 0xe6, 0x6f, 0x1b, 0x1a, 0xe7, 0x90, 0x23, 0xd4, 0xc2, 0xcf, 0x25, 0x5e, 0xb2,
+	0x32, 0x0e, 0x11, 0x84, 0x00, 0xfc, 0x39, 0x7c, 0x60, 0x23, 0x8e, 0x7d, 0x6e, 0x0c, 0x7a, 0x2b,
+	0x43, 0x3c, 0x69, 0xe1, 0x5e, 0x13, 0x50, 0x63, 0xae, 0x0d, 0x9b, 0x83, 0xc4, 0xf7, 0x18, 0x76,
+	0x48, 0xe0, 0x18, 0x1d, 0x26, 0x06, 0xa3, 0x84, 0xd3, 0xe1, 0xba, 0x80, 0x6b, 0x08, 0xb4, 0x63,
+	0x09, 0xa6, 0x50, 0xf8, 0xd0, 0x0c, 0x9c, 0xc0, 0x46, 0x9c, 0xf4, 0x70, 0x5c, 0x56, 0xb2, 0x71,
+	0x71, 0x6b, 0x04, 0x39, 0x2d, 0x6f, 0x3a, 0x47, 0x97, 0x67, 0xc8, 0xd1, 0x4c, 0x3c, 0x47, 0xff,
+	0x35, 0x0f, 0x1b, 0x83, 0x56, 0x12, 0x0e, 0x8b, 0x88, 0xdc, 0x57, 0x96, 0x6e, 0xc0, 0x92, 0xc8,
+	0x47, 0x99, 0x9d, 0xf2, 0x4b, 0x29, 0x03, 0x8c, 0xf5, 0xc7, 0x28, 0xa0, 0xf4, 0x31, 0x8a, 0xf2,
+	0x39, 0x2c, 0x31, 0x8c, 0x7c, 0x97, 0x46, 0x31, 0x51, 0xdc, 0xfb, 0x5e, 0x65, 0xf2, 0xda, 0x56,
+	0xb9, 0x5e, 0xfd, 0x38, 0x59, 0x8f, 0x50, 0x74, 0x89, 0xa6, 0x79, 0xb0, 0xf9, 0x96, 0x2d, 0xca,
+	0x0a, 0xe4, 0x9e, 0x9e, 0x35, 0x1b, 0xb5, 0xc3, 0xfa, 0x71, 0xbd, 0x76, 0xb4, 0x3a, 0xa7, 0x94,
+	0x60, 0xb5, 0x71, 0xde, 0xac, 0xb7, 0xea, 0xe7, 0x67, 0xc6, 0x49, 0x6d, 0xff, 0x49, 0xeb, 0xe4,
+	0x8b, 0xd5, 0x54, 0x48, 0x3d, 0x3b, 0x3f, 0xab, 0xfd, 0xa8, 0xde, 0x6c, 0xd5, 0xce, 0x5a, 0x46,
+	0x63, 0xbf, 0xae, 0xaf, 0xce, 0x2b, 0x2a, 0x94, 0x26, 0xa8, 0x92, 0x6f, 0x35, 0xad, 0xfd, 0x25,
+	0x05, 0x5b, 0x2d, 0x46, 0xba, 0x5d, 0xcc, 0xce, 0x99, 0x85, 0x59, 0xed, 0x39, 0x36, 0x83, 0xe1,
+	0x84, 0xf6, 0x5d, 0x58, 0x74, 0x43, 0x6a, 0xe4, 0xef, 0xdc, 0xde, 0x83, 0x69, 0x33, 0xc7, 0x39,
+	0x65, 0x47, 0x15, 0x0c, 0xca, 0x4f, 0x41, 0xe1, 0x62, 0x51, 0x64, 0xa5, 0xe1, 0x63, 0x4c, 0x13,
+	0xa6, 0xfa, 0xaa, 0x44, 0x8a, 0x92, 0xb3, 0x89, 0x71, 0x3c, 0xd0, 0xd2, 0xb1, 0x40, 0xd3, 0x7e,
+	0x97, 0x82, 0xed, 0x71, 0xf5, 0x0e, 0x11, 0x35, 0xb1, 0x6d, 0xdf, 0xde, 0xb2, 0x8d, 0xe1, 0xd9,
+	0xcb, 0x98, 0x11, 0x5f, 0xb3, 0xe8, 0xf4, 0xcb, 0x14, 0x6c, 0x9c, 0x46, 0x53, 0xc2, 0xa9, 0x6b,
+	0x4d, 0x56, 0xd8, 0x78, 0xbf, 0x48, 0x5d, 0xd7, 0x2f, 0x3e, 0x82, 0xbc, 0xc9, 0x5c, 0xdf, 0x37,
+	0xe4, 0xb0, 0x12, 0xaa, 0x90, 0xd1, 0x73, 0x11, 0x4d, 0x20, 0xcf, 0xa2, 0xc7, 0x57, 0x29, 0xd8,
+	0xae, 0x53, 0x3f, 0x60, 0xa1, 0x4f, 0xc2, 0xc2, 0x74, 0xc4, 0x50, 0xff, 0xc8, 0xed, 0x53, 0xa1,
+	0xcb, 0x09, 0x64, 0x2d, 0x86, 0xfa, 0x86, 0xe5, 0xf6, 0xa9, 0xf4, 0xcf, 0xc7, 0xd3, 0xfe, 0xb9,
+	0x96, 0x5d, 0x3a, 0x2a, 0x63, 0xc9, 0x6f, 0xe5, 0x00, 0xf2, 0x61, 0x05, 0x35, 0xda, 0xc8, 0x0e,
+	0xf7, 0x46, 0xea, 0xce, 0x30, 0x95, 0xe5, 0x42, 0xa6, 0x03, 0xc1, 0xa3, 0xfd, 0x69, 0x11, 0x4a,
+	0xfb, 0x01, 0x77, 0x8f, 0xb0, 0x8d, 0x7b, 0x98, 0xa1, 0xf7, 0xfc, 0xee, 0xf0, 0x6d, 0xd8, 0x6c,
+	0x23, 0x7a, 0xc9, 0x02, 0x8f, 0x1b, 0x53, 0xfb, 0x45, 0x9d, 0x58, 0x1f, 0x2c, 0xb7, 0x26, 0xf8,
+	0xe2, 0x0f, 0x01, 0x0b, 0x77, 0xf1, 0x10, 0xf0, 0x05, 0xac, 0x0e, 0xe4, 0x99, 0x57, 0xb2, 0x01,
+	0x26, 0xeb, 0x21, 0x2b, 0x23, 0x1c, 0xd1, 0x05, 0xa7, 0xef, 0xd2, 0x4b, 0xb7, 0xbf, 0x4b, 0x7f,
+	0x02, 0xcb, 0x17, 0x88, 0x30, 0x33, 0xe0, 0xea, 0xf2, 0x6c, 0xa1, 0x30, 0xd8, 0x7f, 0x3f, 0xcf,
+	0x05, 0xd3, 0xb9, 0x92, 0x9d, 0xa1, 0x61, 0xc1, 0x35, 0x43, 0x65, 0x1a, 0x3e, 0x8c, 0x7c, 0x76,
+	0x1a, 0xd8, 0x9c, 0x78, 0x36, 0xc1, 0xec, 0xa9, 0x77, 0x7f, 0x17, 0xdd, 0x2f, 0xa1, 0xe4, 0xda,
+	0x96, 0xac, 0xab, 0xce, 0x50, 0x64, 0xc2, 0xea, 0xaa, 0xb8, 0xb6, 0x35, 0xa5, 0x7c, 0x28, 0x81,
+	0xe2, 0x7e, 0x5c, 0x42, 0xb2, 0xd1, 0x4a, 0xa1, 0xb8, 0x3f, 0x2d, 0xe1, 0x00, 0x16, 0x4c, 0xd7,
+	0xe7, 0x09, 0x92, 0xa0, 0x4e, 0xb9, 0x1e, 0xf1, 0xc6, 0x4e, 0x6f, 0x31, 0x5e, 0xe9, 0x7e, 0x9f,
+	0x86, 0xad, 0x66, 0x1f, 0x79, 0x75, 0xda, 0x43, 0x8c, 0x20, 0xca, 0xef, 0xf3, 0x5c, 0x7e, 0x01,
+	0x5b, 0x7e, 0x1f, 0x79, 0x06, 0x19, 0x08, 0xbb, 0xfd, 0xe1, 0x6c, 0xfa, 0xe3, 0xca, 0x8f, 0xf9,
+	0xaf, 0x05, 0xc5, 0xf0, 0x84, 0xfc, 0x67, 0x8c, 0x1b, 0x16, 0xf6, 0xf8, 0x45, 0xc2, 0xb3, 0xc9,
+	0x53, 0xdc, 0x6f, 0x3e, 0x63, 0xfc, 0x28, 0xc4, 0xf8, 0x5f, 0x9d, 0xca, 0x25, 0x6c, 0x1f, 0x86,
+	0x59, 0x4d, 0xf8, 0x01, 0xc3, 0xe8, 0x12, 0xb3, 0x16, 0x23, 0x9e, 0x37, 0x3a, 0x95, 0x15, 0x53,
+	0xac, 0x1a, 0x6d, 0xb1, 0x2c, 0x9b, 0x50, 0x79, 0xba, 0x09, 0x4d, 0x82, 0xc8, 0x8a, 0x51, 0x34,
+	0x27, 0xa8, 0xda, 0xaf, 0x53, 0xa0, 0x4e, 0x6e, 0xd4, 0xb1, 0x8f, 0xf9, 0xbd, 0x44, 0xc0, 0xb4,
+	0xed, 0xf3, 0x71, 0xdb, 0xff, 0x9a, 0x82, 0x4d, 0x1d, 0x77, 0x30, 0x63, 0xc8, 0xd6, 0x71, 0x1b,
+	0x71, 0xdc, 0x40, 0x44, 0x5a, 0xbe, 0x0d, 0x19, 0x16, 0x2d, 0x49, 0x93, 0xb3, 0xfa, 0xf0, 0x5b,
+	0x51, 0x61, 0x39, 0xfa, 0x1f, 0xcb, 0x0b, 0x93, 0x3e, 0xf8, 0x1c, 0xda, 0x90, 0xbe, 0x1b, 0x1b,
+	0xbe, 0x13, 0xce, 0x37, 0xed, 0xc1, 0x7d, 0x67, 0x86, 0x12, 0x2d, 0xb7, 0x6b, 0x2f, 0xe7, 0xa1,
+	0x3c, 0x36, 0xbd, 0xee, 0x07, 0xd1, 0xa5, 0xa0, 0xc9, 0x11, 0x1b, 0x26, 0xdc, 0x01, 0x2c, 0x23,
+	0x41, 0x96, 0x47, 0xaa, 0xbd, 0x63, 0x70, 0x96, 0x00, 0x83, 0x46, 0x20, 0x19, 0xe3, 0x8d, 0x60,
+	0xfe, 0x0e, 0x1a, 0xc1, 0xe4, 0x8d, 0x34, 0x7d, 0xcb, 0x1b, 0xa9, 0xf6, 0x72, 0x09, 0xb6, 0xaf,
+	0xb1, 0x84, 0xdc, 0xa1, 0x1b, 0xae, 0x7f, 0xf8, 0x9b, 0x7f, 0xdb, 0xc3, 0xdf, 0x3b, 0xde, 0x23,
+	0xd3, 0x77, 0xf9, 0x1e, 0x79, 0x01, 0x6a, 0xfc, 0x87, 0x09, 0xa3, 0x87, 0xec, 0x20, 0xe9, 0xc0,
+	0xb3, 0x11, 0xfb, 0x79, 0xe2, 0xf3, 0x10, 0x4d, 0xf9, 0x01, 0x64, 0x2c, 0xe2, 0x9b, 0xd1, 0xcb,
+	0x6d, 0xb2, 0x89, 0x67, 0xc8, 0x1f, 0xc6, 0x94, 0xf4, 0xab, 0x8c, 0x80, 0x64, 0xb3, 0x4e, 0x5e,
+	0x82, 0x5c, 0x3f, 0x3f, 0x2d, 0xdf, 0x7e, 0x7e, 0xba, 0xcd, 0x0b, 0xa7, 0xfe, 0xb6, 0x17, 0xd9,
+	0xec, 0x6c, 0x40, 0xd7, 0xbd, 0xb8, 0x4e, 0xd7, 0x3b, 0x88, 0xd7, 0xbb, 0x3f, 0xa6, 0xe0, 0x41,
+	0x3c, 0x9a, 0x6b, 0xd4, 0xba, 0xcb, 0x9a, 0x90, 0xfc, 0x4e, 0x76, 0xf0, 0xfd, 0xaf, 0x5f, 0x97,
+	0x53, 0xdf, 0xbc, 0x2e, 0xa7, 0xfe, 0xf9, 0xba, 0x9c, 0xfa, 0xed, 0x9b, 0xf2, 0xdc, 0x37, 0x6f,
+	0xca, 0x73, 0x7f, 0x7b, 0x53, 0x9e, 0xfb, 0xf1, 0xa3, 0x9b, 0x2a, 0x68, 0xa8, 0x9f, 0x38, 0xa9,
+	0x6a, 0x6f, 0xaf, 0xbd, 0x14, 0xfd, 0x4c, 0xfa, 0xad, 0xff, 0x0c, 0x00, 0xfa, 0xa8, 0xfd, 0x40,
+	0xd7, 0x1d, 0x00, 0x00,
+}
+
+func (m *PositionChangedEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *PositionChangedEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PositionChangedEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Pair.Size()
-	n += 1 + l + sovEvent(uint64(l))
-	l = len(m.TraderAddress)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	if m.BlockTimeMs != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.BlockTimeMs))
+		i--
+		dAtA[i] = 0x70
 	}
-	if len(m.SettledCoins) > 0 {
-		for _, e := range m.SettledCoins {
-			l = e.Size()
-			n += 1 + l + sovEvent(uint64(l))
+	if m.BlockHeight != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x68
+	}
+	{
+		size := m.FundingPayment.Size()
+		i -= size
+		if _, err := m.FundingPayment.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x62
+	{
+		size, err := m.BadDebt.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x5a
+	{
+		size := m.UnrealizedPnlAfter.Size()
+		i -= size
+		if _, err := m.UnrealizedPnlAfter.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	{
+		size := m.RealizedPnl.Size()
+		i -= size
+		if _, err := m.RealizedPnl.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	{
+		size := m.PositionSize.Size()
+		i -= size
+		if _, err := m.PositionSize.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size, err := m.TransactionFee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.ExchangedNotional.Size()
+		i -= size
+		if _, err := m.ExchangedNotional.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.ExchangedSize.Size()
+		i -= size
+		if _, err := m.ExchangedSize.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.PositionNotional.Size()
+		i -= size
+		if _, err := m.PositionNotional.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.Margin.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.TraderAddress) > 0 {
+		i -= len(m.TraderAddress)
+		copy(dAtA[i:], m.TraderAddress)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.TraderAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size := m.Pair.Size()
+		i -= size
+		if _, err := m.Pair.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *PositionLiquidatedEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PositionLiquidatedEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PositionLiquidatedEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BlockTimeMs != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.BlockTimeMs))
+		i--
+		dAtA[i] = 0x78
+	}
+	if m.BlockHeight != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x70
+	}
+	{
+		size := m.MarkPrice.Size()
+		i -= size
+		if _, err := m.MarkPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x6a
+	{
+		size := m.UnrealizedPnl.Size()
+		i -= size
+		if _, err := m.UnrealizedPnl.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x62
+	{
+		size := m.PositionSize.Size()
+		i -= size
+		if _, err := m.PositionSize.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x5a
+	{
+		size := m.PositionNotional.Size()
+		i -= size
+		if _, err := m.PositionNotional.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	{
+		size, err := m.Margin.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	{
+		size, err := m.BadDebt.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size, err := m.FeeToEcosystemFund.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size, err := m.FeeToLiquidator.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if len(m.LiquidatorAddress) > 0 {
+		i -= len(m.LiquidatorAddress)
+		copy(dAtA[i:], m.LiquidatorAddress)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.LiquidatorAddress)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	{
+		size := m.ExchangedPositionSize.Size()
+		i -= size
+		if _, err := m.ExchangedPositionSize.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.ExchangedQuoteAmount.Size()
+		i -= size
+		if _, err := m.ExchangedQuoteAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.TraderAddress) > 0 {
+		i -= len(m.TraderAddress)
+		copy(dAtA[i:], m.TraderAddress)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.TraderAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size := m.Pair.Size()
+		i -= size
+		if _, err := m.Pair.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *PositionSettledEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PositionSettledEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PositionSettledEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SettledCoins) > 0 {
+		for iNdEx := len(m.SettledCoins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SettledCoins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvent(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.TraderAddress) > 0 {
+		i -= len(m.TraderAddress)
+		copy(dAtA[i:], m.TraderAddress)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.TraderAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size := m.Pair.Size()
+		i -= size
+		if _, err := m.Pair.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *FundingRateChangedEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FundingRateChangedEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FundingRateChangedEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BlockTimeMs != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.BlockTimeMs))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.BlockHeight != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x38
+	}
+	{
+		size := m.CumulativePremiumFraction.Size()
+		i -= size
+		if _, err := m.CumulativePremiumFraction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.LatestPremiumFraction.Size()
+		i -= size
+		if _, err := m.LatestPremiumFraction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.LatestFundingRate.Size()
+		i -= size
+		if _, err := m.LatestFundingRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.IndexPrice.Size()
+		i -= size
+		if _, err := m.IndexPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.MarkPrice.Size()
+		i -= size
+		if _, err := m.MarkPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.Pair.Size()
+		i -= size
+		if _, err := m.Pair.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *LiquidationFailedEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LiquidationFailedEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LiquidationFailedEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Reason != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.Reason))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Liquidator) > 0 {
+		i -= len(m.Liquidator)
+		copy(dAtA[i:], m.Liquidator)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Liquidator)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Trader) > 0 {
+		i -= len(m.Trader)
+		copy(dAtA[i:], m.Trader)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Trader)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size := m.Pair.Size()
+		i -= size
+		if _, err := m.Pair.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *TriggerOrderExecutedEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TriggerOrderExecutedEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TriggerOrderExecutedEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BlockHeight != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.TriggerPriceSeen.Size()
+		i -= size
+		if _, err := m.TriggerPriceSeen.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Order.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *TriggerOrderCancelledEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TriggerOrderCancelledEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TriggerOrderCancelledEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BlockHeight != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.Order.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MarginModeChangedEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MarginModeChangedEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MarginModeChangedEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BlockHeight != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.CrossMargin {
+		i--
+		if m.CrossMargin {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.TraderAddress) > 0 {
+		i -= len(m.TraderAddress)
+		copy(dAtA[i:], m.TraderAddress)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.TraderAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *InsuranceFundDrawDownEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InsuranceFundDrawDownEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InsuranceFundDrawDownEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.FundBalance.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.DrawDown.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *AutoDeleveragedEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AutoDeleveragedEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AutoDeleveragedEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BlockTimeMs != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.BlockTimeMs))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.BlockHeight != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x48
+	}
+	{
+		size := m.PositionSize.Size()
+		i -= size
+		if _, err := m.PositionSize.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size, err := m.Haircut.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.RealizedPnl.Size()
+		i -= size
+		if _, err := m.RealizedPnl.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.BankruptcyPrice.Size()
+		i -= size
+		if _, err := m.BankruptcyPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.ExchangedSize.Size()
+		i -= size
+		if _, err := m.ExchangedSize.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.BankruptTraderAddress) > 0 {
+		i -= len(m.BankruptTraderAddress)
+		copy(dAtA[i:], m.BankruptTraderAddress)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.BankruptTraderAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.TraderAddress) > 0 {
+		i -= len(m.TraderAddress)
+		copy(dAtA[i:], m.TraderAddress)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.TraderAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size := m.Pair.Size()
+		i -= size
+		if _, err := m.Pair.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *PriceMultiplierUpdatedEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PriceMultiplierUpdatedEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PriceMultiplierUpdatedEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BlockHeight != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size := m.Cost.Size()
+		i -= size
+		if _, err := m.Cost.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.NewPriceMultiplier.Size()
+		i -= size
+		if _, err := m.NewPriceMultiplier.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.OldPriceMultiplier.Size()
+		i -= size
+		if _, err := m.OldPriceMultiplier.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.Pair.Size()
+		i -= size
+		if _, err := m.Pair.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *SwapInvariantUpdatedEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SwapInvariantUpdatedEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SwapInvariantUpdatedEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BlockHeight != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size := m.Cost.Size()
+		i -= size
+		if _, err := m.Cost.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.NewSqrtDepth.Size()
+		i -= size
+		if _, err := m.NewSqrtDepth.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.SwapInvariantMultiplier.Size()
+		i -= size
+		if _, err := m.SwapInvariantMultiplier.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.Pair.Size()
+		i -= size
+		if _, err := m.Pair.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *CircuitBreakerTrippedEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CircuitBreakerTrippedEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CircuitBreakerTrippedEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.CircuitBreaker.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *CircuitBreakerResetEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CircuitBreakerResetEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CircuitBreakerResetEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BlockHeight != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size := m.Pair.Size()
+		i -= size
+		if _, err := m.Pair.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ReferralRebatePaidEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReferralRebatePaidEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReferralRebatePaidEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Rebate.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Pair.Size()
+		i -= size
+		if _, err := m.Pair.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Referee) > 0 {
+		i -= len(m.Referee)
+		copy(dAtA[i:], m.Referee)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Referee)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Referrer) > 0 {
+		i -= len(m.Referrer)
+		copy(dAtA[i:], m.Referrer)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Referrer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LiquidationAuctionStartedEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LiquidationAuctionStartedEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LiquidationAuctionStartedEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MarkPrice.Size()
+		i -= size
+		if _, err := m.MarkPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.PositionSize.Size()
+		i -= size
+		if _, err := m.PositionSize.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Auction.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *LiquidationAuctionBidEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LiquidationAuctionBidEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LiquidationAuctionBidEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BlockHeight != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x50
+	}
+	{
+		size, err := m.FeeToEcosystemFund.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	{
+		size, err := m.BadDebt.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.RealizedPnl.Size()
+		i -= size
+		if _, err := m.RealizedPnl.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.AuctionPrice.Size()
+		i -= size
+		if _, err := m.AuctionPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.Discount.Size()
+		i -= size
+		if _, err := m.Discount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.ExchangedNotionalValue.Size()
+		i -= size
+		if _, err := m.ExchangedNotionalValue.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.ExchangedPositionSize.Size()
+		i -= size
+		if _, err := m.ExchangedPositionSize.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.LiquidatorAddress) > 0 {
+		i -= len(m.LiquidatorAddress)
+		copy(dAtA[i:], m.LiquidatorAddress)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.LiquidatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.Auction.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *LiquidationAuctionEndedEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LiquidationAuctionEndedEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LiquidationAuctionEndedEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BlockHeight != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.Auction.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintEvent(dAtA []byte, offset int, v uint64) int {
+	offset -= sovEvent(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *PositionChangedEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Pair.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = len(m.TraderAddress)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = m.Margin.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.PositionNotional.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.ExchangedSize.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.ExchangedNotional.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.TransactionFee.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.PositionSize.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.RealizedPnl.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.UnrealizedPnlAfter.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.BadDebt.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.FundingPayment.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	if m.BlockHeight != 0 {
+		n += 1 + sovEvent(uint64(m.BlockHeight))
+	}
+	if m.BlockTimeMs != 0 {
+		n += 1 + sovEvent(uint64(m.BlockTimeMs))
+	}
+	return n
+}
+
+func (m *PositionLiquidatedEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Pair.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = len(m.TraderAddress)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = m.ExchangedQuoteAmount.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.ExchangedPositionSize.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = len(m.LiquidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = m.FeeToLiquidator.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.FeeToEcosystemFund.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.BadDebt.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.Margin.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.PositionNotional.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.PositionSize.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.UnrealizedPnl.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.MarkPrice.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	if m.BlockHeight != 0 {
+		n += 1 + sovEvent(uint64(m.BlockHeight))
+	}
+	if m.BlockTimeMs != 0 {
+		n += 1 + sovEvent(uint64(m.BlockTimeMs))
+	}
+	return n
+}
+
+func (m *PositionSettledEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Pair.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = len(m.TraderAddress)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if len(m.SettledCoins) > 0 {
+		for _, e := range m.SettledCoins {
+			l = e.Size()
+			n += 1 + l + sovEvent(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *FundingRateChangedEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Pair.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.MarkPrice.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.IndexPrice.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.LatestFundingRate.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.LatestPremiumFraction.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.CumulativePremiumFraction.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	if m.BlockHeight != 0 {
+		n += 1 + sovEvent(uint64(m.BlockHeight))
+	}
+	if m.BlockTimeMs != 0 {
+		n += 1 + sovEvent(uint64(m.BlockTimeMs))
+	}
+	return n
+}
+
+func (m *LiquidationFailedEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Pair.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = len(m.Trader)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Liquidator)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.Reason != 0 {
+		n += 1 + sovEvent(uint64(m.Reason))
+	}
+	return n
+}
+
+func (m *TriggerOrderExecutedEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Order.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.TriggerPriceSeen.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	if m.BlockHeight != 0 {
+		n += 1 + sovEvent(uint64(m.BlockHeight))
+	}
+	return n
+}
+
+func (m *TriggerOrderCancelledEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Order.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovEvent(uint64(m.BlockHeight))
+	}
+	return n
+}
+
+func (m *MarginModeChangedEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TraderAddress)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.CrossMargin {
+		n += 2
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovEvent(uint64(m.BlockHeight))
+	}
+	return n
+}
+
+func (m *InsuranceFundDrawDownEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.DrawDown.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.FundBalance.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	return n
+}
+
+func (m *AutoDeleveragedEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Pair.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = len(m.TraderAddress)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.BankruptTraderAddress)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = m.ExchangedSize.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.BankruptcyPrice.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.RealizedPnl.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.Haircut.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.PositionSize.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	if m.BlockHeight != 0 {
+		n += 1 + sovEvent(uint64(m.BlockHeight))
+	}
+	if m.BlockTimeMs != 0 {
+		n += 1 + sovEvent(uint64(m.BlockTimeMs))
+	}
+	return n
+}
+
+func (m *PriceMultiplierUpdatedEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Pair.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.OldPriceMultiplier.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.NewPriceMultiplier.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.Cost.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	if m.BlockHeight != 0 {
+		n += 1 + sovEvent(uint64(m.BlockHeight))
+	}
+	return n
+}
+
+func (m *SwapInvariantUpdatedEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Pair.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.SwapInvariantMultiplier.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.NewSqrtDepth.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.Cost.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	if m.BlockHeight != 0 {
+		n += 1 + sovEvent(uint64(m.BlockHeight))
+	}
+	return n
+}
+
+func (m *CircuitBreakerTrippedEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.CircuitBreaker.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	return n
+}
+
+func (m *CircuitBreakerResetEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Pair.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	if m.BlockHeight != 0 {
+		n += 1 + sovEvent(uint64(m.BlockHeight))
+	}
+	return n
+}
+
+func (m *ReferralRebatePaidEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Referrer)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Referee)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = m.Pair.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.Rebate.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	return n
+}
+
+func (m *LiquidationAuctionStartedEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Auction.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.PositionSize.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.MarkPrice.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	return n
+}
+
+func (m *LiquidationAuctionBidEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Auction.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = len(m.LiquidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = m.ExchangedPositionSize.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.ExchangedNotionalValue.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.Discount.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.AuctionPrice.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.RealizedPnl.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.BadDebt.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.FeeToEcosystemFund.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	if m.BlockHeight != 0 {
+		n += 1 + sovEvent(uint64(m.BlockHeight))
+	}
+	return n
+}
+
+func (m *LiquidationAuctionEndedEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Auction.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovEvent(uint64(m.BlockHeight))
+	}
+	return n
+}
+
+func sovEvent(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozEvent(x uint64) (n int) {
+	return sovEvent(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *PositionChangedEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PositionChangedEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PositionChangedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pair", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Pair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TraderAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TraderAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Margin", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Margin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PositionNotional", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PositionNotional.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExchangedSize", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ExchangedSize.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExchangedNotional", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ExchangedNotional.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransactionFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TransactionFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PositionSize", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PositionSize.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RealizedPnl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RealizedPnl.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnrealizedPnlAfter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.UnrealizedPnlAfter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BadDebt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BadDebt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FundingPayment", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FundingPayment.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockTimeMs", wireType)
+			}
+			m.BlockTimeMs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockTimeMs |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PositionLiquidatedEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PositionLiquidatedEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PositionLiquidatedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pair", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Pair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TraderAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TraderAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExchangedQuoteAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ExchangedQuoteAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExchangedPositionSize", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ExchangedPositionSize.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LiquidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeToLiquidator", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FeeToLiquidator.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeToEcosystemFund", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FeeToEcosystemFund.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BadDebt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BadDebt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Margin", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Margin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PositionNotional", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PositionNotional.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PositionSize", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PositionSize.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnrealizedPnl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.UnrealizedPnl.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarkPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MarkPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockTimeMs", wireType)
+			}
+			m.BlockTimeMs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockTimeMs |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PositionSettledEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PositionSettledEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PositionSettledEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pair", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Pair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TraderAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TraderAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SettledCoins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SettledCoins = append(m.SettledCoins, types.Coin{})
+			if err := m.SettledCoins[len(m.SettledCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FundingRateChangedEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FundingRateChangedEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FundingRateChangedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pair", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Pair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarkPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MarkPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IndexPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.IndexPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LatestFundingRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LatestFundingRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LatestPremiumFraction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LatestPremiumFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CumulativePremiumFraction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CumulativePremiumFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockTimeMs", wireType)
+			}
+			m.BlockTimeMs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockTimeMs |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LiquidationFailedEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LiquidationFailedEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LiquidationFailedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pair", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Pair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Trader", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Trader = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Liquidator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Liquidator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			m.Reason = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Reason |= LiquidationFailedEvent_LiquidationFailedReason(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TriggerOrderExecutedEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TriggerOrderExecutedEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TriggerOrderExecutedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Order", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Order.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TriggerPriceSeen", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TriggerPriceSeen.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TriggerOrderCancelledEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TriggerOrderCancelledEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TriggerOrderCancelledEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Order", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Order.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MarginModeChangedEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MarginModeChangedEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MarginModeChangedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TraderAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TraderAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CrossMargin", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.CrossMargin = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InsuranceFundDrawDownEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InsuranceFundDrawDownEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InsuranceFundDrawDownEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DrawDown", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DrawDown.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FundBalance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FundBalance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
-
-func (m *FundingRateChangedEvent) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Pair.Size()
-	n += 1 + l + sovEvent(uint64(l))
-	l = m.MarkPrice.Size()
-	n += 1 + l + sovEvent(uint64(l))
-	l = m.IndexPrice.Size()
-	n += 1 + l + sovEvent(uint64(l))
-	l = m.LatestFundingRate.Size()
-	n += 1 + l + sovEvent(uint64(l))
-	l = m.LatestPremiumFraction.Size()
-	n += 1 + l + sovEvent(uint64(l))
-	l = m.CumulativePremiumFraction.Size()
-	n += 1 + l + sovEvent(uint64(l))
-	if m.BlockHeight != 0 {
-		n += 1 + sovEvent(uint64(m.BlockHeight))
-	}
-	if m.BlockTimeMs != 0 {
-		n += 1 + sovEvent(uint64(m.BlockTimeMs))
-	}
-	return n
-}
 
-func (m *LiquidationFailedEvent) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Pair.Size()
-	n += 1 + l + sovEvent(uint64(l))
-	l = len(m.Trader)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
-	}
-	l = len(m.Liquidator)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
-	}
-	if m.Reason != 0 {
-		n += 1 + sovEvent(uint64(m.Reason))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovEvent(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozEvent(x uint64) (n int) {
-	return sovEvent(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *PositionChangedEvent) Unmarshal(dAtA []byte) error {
+func (m *AutoDeleveragedEvent) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1292,10 +5292,10 @@ func (m *PositionChangedEvent) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PositionChangedEvent: wiretype end group for non-group")
+			return fmt.Errorf("proto: AutoDeleveragedEvent: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PositionChangedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AutoDeleveragedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1366,9 +5366,9 @@ func (m *PositionChangedEvent) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Margin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BankruptTraderAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -1378,28 +5378,27 @@ func (m *PositionChangedEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvent
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvent
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Margin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.BankruptTraderAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PositionNotional", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExchangedSize", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1427,13 +5426,13 @@ func (m *PositionChangedEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.PositionNotional.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ExchangedSize.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExchangedSize", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BankruptcyPrice", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1461,13 +5460,13 @@ func (m *PositionChangedEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ExchangedSize.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.BankruptcyPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExchangedNotional", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RealizedPnl", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1495,13 +5494,13 @@ func (m *PositionChangedEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ExchangedNotional.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.RealizedPnl.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TransactionFee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Haircut", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1528,7 +5527,7 @@ func (m *PositionChangedEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TransactionFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Haircut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1567,10 +5566,10 @@ func (m *PositionChangedEvent) Unmarshal(dAtA []byte) error {
 			}
 			iNdEx = postIndex
 		case 9:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RealizedPnl", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
 			}
-			var stringLen uint64
+			m.BlockHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -1580,29 +5579,83 @@ func (m *PositionChangedEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.BlockHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvent
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockTimeMs", wireType)
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			m.BlockTimeMs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockTimeMs |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthEvent
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.RealizedPnl.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PriceMultiplierUpdatedEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
 			}
-			iNdEx = postIndex
-		case 10:
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PriceMultiplierUpdatedEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PriceMultiplierUpdatedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnrealizedPnlAfter", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pair", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1630,15 +5683,15 @@ func (m *PositionChangedEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.UnrealizedPnlAfter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Pair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 11:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BadDebt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OldPriceMultiplier", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -1648,28 +5701,29 @@ func (m *PositionChangedEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvent
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvent
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.BadDebt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.OldPriceMultiplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 12:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FundingPayment", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NewPriceMultiplier", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1697,15 +5751,15 @@ func (m *PositionChangedEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.FundingPayment.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.NewPriceMultiplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 13:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cost", wireType)
 			}
-			m.BlockHeight = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -1715,16 +5769,31 @@ func (m *PositionChangedEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BlockHeight |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 14:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Cost.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockTimeMs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
 			}
-			m.BlockTimeMs = 0
+			m.BlockHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -1734,7 +5803,7 @@ func (m *PositionChangedEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BlockTimeMs |= int64(b&0x7F) << shift
+				m.BlockHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -1760,7 +5829,7 @@ func (m *PositionChangedEvent) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PositionLiquidatedEvent) Unmarshal(dAtA []byte) error {
+func (m *SwapInvariantUpdatedEvent) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1783,10 +5852,10 @@ func (m *PositionLiquidatedEvent) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PositionLiquidatedEvent: wiretype end group for non-group")
+			return fmt.Errorf("proto: SwapInvariantUpdatedEvent: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PositionLiquidatedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SwapInvariantUpdatedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1825,7 +5894,7 @@ func (m *PositionLiquidatedEvent) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TraderAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapInvariantMultiplier", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1853,11 +5922,13 @@ func (m *PositionLiquidatedEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TraderAddress = string(dAtA[iNdEx:postIndex])
+			if err := m.SwapInvariantMultiplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExchangedQuoteAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NewSqrtDepth", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1885,13 +5956,13 @@ func (m *PositionLiquidatedEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ExchangedQuoteAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.NewSqrtDepth.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExchangedPositionSize", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Cost", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1919,15 +5990,15 @@ func (m *PositionLiquidatedEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ExchangedPositionSize.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Cost.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LiquidatorAddress", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
 			}
-			var stringLen uint64
+			m.BlockHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -1937,60 +6008,64 @@ func (m *PositionLiquidatedEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.BlockHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvent
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthEvent
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LiquidatorAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FeeToLiquidator", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvent
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthEvent
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvent
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CircuitBreakerTrippedEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.FeeToLiquidator.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 7:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CircuitBreakerTrippedEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CircuitBreakerTrippedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FeeToEcosystemFund", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CircuitBreaker", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2017,48 +6092,65 @@ func (m *PositionLiquidatedEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.FeeToEcosystemFund.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.CircuitBreaker.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BadDebt", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvent
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			if msglen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthEvent
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvent
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CircuitBreakerResetEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.BadDebt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 9:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CircuitBreakerResetEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CircuitBreakerResetEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Margin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pair", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -2068,30 +6160,31 @@ func (m *PositionLiquidatedEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvent
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvent
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Margin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Pair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 10:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PositionNotional", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
 			}
-			var stringLen uint64
+			m.BlockHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -2101,29 +6194,64 @@ func (m *PositionLiquidatedEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.BlockHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvent
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthEvent
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.PositionNotional.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReferralRebatePaidEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
 			}
-			iNdEx = postIndex
-		case 11:
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReferralRebatePaidEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReferralRebatePaidEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PositionSize", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Referrer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2151,13 +6279,11 @@ func (m *PositionLiquidatedEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.PositionSize.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Referrer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 12:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnrealizedPnl", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Referee", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2185,13 +6311,11 @@ func (m *PositionLiquidatedEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.UnrealizedPnl.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Referee = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 13:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MarkPrice", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pair", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2219,15 +6343,15 @@ func (m *PositionLiquidatedEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MarkPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Pair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 14:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rebate", wireType)
 			}
-			m.BlockHeight = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -2237,30 +6361,25 @@ func (m *PositionLiquidatedEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BlockHeight |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 15:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockTimeMs", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
 			}
-			m.BlockTimeMs = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvent
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.BlockTimeMs |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Rebate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvent(dAtA[iNdEx:])
@@ -2282,7 +6401,7 @@ func (m *PositionLiquidatedEvent) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PositionSettledEvent) Unmarshal(dAtA []byte) error {
+func (m *LiquidationAuctionStartedEvent) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2305,17 +6424,17 @@ func (m *PositionSettledEvent) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PositionSettledEvent: wiretype end group for non-group")
+			return fmt.Errorf("proto: LiquidationAuctionStartedEvent: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PositionSettledEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: LiquidationAuctionStartedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pair", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Auction", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -2325,29 +6444,28 @@ func (m *PositionSettledEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthEvent
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvent
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Pair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Auction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TraderAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PositionSize", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2375,13 +6493,15 @@ func (m *PositionSettledEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TraderAddress = string(dAtA[iNdEx:postIndex])
+			if err := m.PositionSize.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SettledCoins", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MarkPrice", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -2391,23 +6511,23 @@ func (m *PositionSettledEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvent
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvent
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SettledCoins = append(m.SettledCoins, types.Coin{})
-			if err := m.SettledCoins[len(m.SettledCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.MarkPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2432,7 +6552,7 @@ func (m *PositionSettledEvent) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *FundingRateChangedEvent) Unmarshal(dAtA []byte) error {
+func (m *LiquidationAuctionBidEvent) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2455,17 +6575,17 @@ func (m *FundingRateChangedEvent) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: FundingRateChangedEvent: wiretype end group for non-group")
+			return fmt.Errorf("proto: LiquidationAuctionBidEvent: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: FundingRateChangedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: LiquidationAuctionBidEvent: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pair", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Auction", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -2475,29 +6595,28 @@ func (m *FundingRateChangedEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthEvent
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvent
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Pair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Auction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MarkPrice", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidatorAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2525,13 +6644,11 @@ func (m *FundingRateChangedEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MarkPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.LiquidatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IndexPrice", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExchangedPositionSize", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2559,13 +6676,13 @@ func (m *FundingRateChangedEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.IndexPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ExchangedPositionSize.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LatestFundingRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExchangedNotionalValue", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2593,13 +6710,13 @@ func (m *FundingRateChangedEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.LatestFundingRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ExchangedNotionalValue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LatestPremiumFraction", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Discount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2627,13 +6744,13 @@ func (m *FundingRateChangedEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.LatestPremiumFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Discount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CumulativePremiumFraction", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AuctionPrice", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2661,15 +6778,15 @@ func (m *FundingRateChangedEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.CumulativePremiumFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.AuctionPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RealizedPnl", wireType)
 			}
-			m.BlockHeight = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -2679,16 +6796,97 @@ func (m *FundingRateChangedEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BlockHeight |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RealizedPnl.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BadDebt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BadDebt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeToEcosystemFund", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FeeToEcosystemFund.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockTimeMs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
 			}
-			m.BlockTimeMs = 0
+			m.BlockHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -2698,7 +6896,7 @@ func (m *FundingRateChangedEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BlockTimeMs |= int64(b&0x7F) << shift
+				m.BlockHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -2724,7 +6922,7 @@ func (m *FundingRateChangedEvent) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *LiquidationFailedEvent) Unmarshal(dAtA []byte) error {
+func (m *LiquidationAuctionEndedEvent) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2747,17 +6945,17 @@ func (m *LiquidationFailedEvent) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: LiquidationFailedEvent: wiretype end group for non-group")
+			return fmt.Errorf("proto: LiquidationAuctionEndedEvent: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LiquidationFailedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: LiquidationAuctionEndedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pair", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Auction", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -2767,29 +6965,28 @@ func (m *LiquidationFailedEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthEvent
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvent
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Pair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Auction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Trader", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2817,45 +7014,13 @@ func (m *LiquidationFailedEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Trader = string(dAtA[iNdEx:postIndex])
+			m.Reason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Liquidator", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvent
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvent
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvent
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Liquidator = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
 			}
-			m.Reason = 0
+			m.BlockHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -2865,7 +7030,7 @@ func (m *LiquidationFailedEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Reason |= LiquidationFailedEvent_LiquidationFailedReason(b&0x7F) << shift
+				m.BlockHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
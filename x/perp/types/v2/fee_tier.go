@@ -0,0 +1,50 @@
+package v2
+
+import (
+	"regexp"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// FeeTierVolumeWindowDays is the number of days of trading volume that count
+// toward the fee tier of a trader.
+const FeeTierVolumeWindowDays = 30
+
+// FeeTierFor returns the highest fee tier reached with the given volume, or
+// nil if the volume is below every tier.
+func (p Params) FeeTierFor(volume sdk.Dec) *FeeTier {
+	var feeTier *FeeTier
+	for i := range p.FeeTiers {
+		if volume.LT(p.FeeTiers[i].MinVolume) {
+			break
+		}
+		feeTier = &p.FeeTiers[i]
+	}
+	return feeTier
+}
+
+// FeeDiscountRatio returns the share of the trading fees waived for a trader
+// with the given volume, compounding its fee tier discount with the staker
+// discount if it's a staker.
+func (p Params) FeeDiscountRatio(volume sdk.Dec, isStaker bool) sdk.Dec {
+	feeMultiplier := sdk.OneDec()
+	if feeTier := p.FeeTierFor(volume); feeTier != nil {
+		feeMultiplier = feeMultiplier.Mul(sdk.OneDec().Sub(feeTier.FeeDiscountRatio))
+	}
+	if isStaker {
+		feeMultiplier = feeMultiplier.Mul(sdk.OneDec().Sub(p.StakerFeeDiscountRatio))
+	}
+	return sdk.OneDec().Sub(feeMultiplier)
+}
+
+var referralCodeRegex = regexp.MustCompile(`^[a-z0-9_-]{3,32}$`)
+
+// ValidateReferralCode checks that a referral code is 3 to 32 lowercase
+// letters, digits, dashes or underscores.
+func ValidateReferralCode(code string) error {
+	if !referralCodeRegex.MatchString(code) {
+		return ErrInvalidReferralCode.Wrapf(
+			"%q must be 3 to 32 lowercase letters, digits, dashes or underscores", code)
+	}
+	return nil
+}
@@ -0,0 +1,39 @@
+package v2_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	v2types "github.com/NibiruChain/nibiru/x/perp/types/v2"
+)
+
+func TestFeeTierFor(t *testing.T) {
+	params := v2types.DefaultParams()
+	params.FeeTiers = []v2types.FeeTier{
+		{MinVolume: sdk.NewDec(100), FeeDiscountRatio: sdk.MustNewDecFromStr("0.1")},
+		{MinVolume: sdk.NewDec(1000), FeeDiscountRatio: sdk.MustNewDecFromStr("0.2")},
+	}
+	params.StakerFeeDiscountRatio = sdk.MustNewDecFromStr("0.5")
+	require.NoError(t, params.Validate())
+
+	require.Nil(t, params.FeeTierFor(sdk.NewDec(99)))
+	require.Equal(t, params.FeeTiers[0], *params.FeeTierFor(sdk.NewDec(100)))
+	require.Equal(t, params.FeeTiers[0], *params.FeeTierFor(sdk.NewDec(999)))
+	require.Equal(t, params.FeeTiers[1], *params.FeeTierFor(sdk.NewDec(1_000_000)))
+
+	require.True(t, params.FeeDiscountRatio(sdk.ZeroDec(), false).IsZero())
+	require.Equal(t, sdk.MustNewDecFromStr("0.5"), params.FeeDiscountRatio(sdk.ZeroDec(), true))
+	require.Equal(t, sdk.MustNewDecFromStr("0.2"), params.FeeDiscountRatio(sdk.NewDec(1000), false))
+	require.Equal(t, sdk.MustNewDecFromStr("0.6"), params.FeeDiscountRatio(sdk.NewDec(1000), true))
+}
+
+func TestValidateReferralCode(t *testing.T) {
+	for _, code := range []string{"abc", "my-code", "my_code_2", "a234567890123456789012345678901z"} {
+		require.NoError(t, v2types.ValidateReferralCode(code), code)
+	}
+	for _, code := range []string{"", "ab", "MyCode", "my code", "my.code", "a234567890123456789012345678901zz"} {
+		require.ErrorIs(t, v2types.ValidateReferralCode(code), v2types.ErrInvalidReferralCode, code)
+	}
+}
@@ -0,0 +1,34 @@
+package v2
+
+import (
+	fmt "fmt"
+)
+
+// Validate performs stateless validation of a funding rate record.
+func (m FundingRate) Validate() error {
+	if err := m.Pair.Validate(); err != nil {
+		return err
+	}
+
+	if m.MarkTwap.IsNil() || !m.MarkTwap.IsPositive() {
+		return fmt.Errorf("mark twap must be positive")
+	}
+
+	if m.IndexTwap.IsNil() || !m.IndexTwap.IsPositive() {
+		return fmt.Errorf("index twap must be positive")
+	}
+
+	if m.PremiumRate.IsNil() || m.InterestRate.IsNil() || m.FundingRate.IsNil() {
+		return fmt.Errorf("premium, interest and funding rates must be set")
+	}
+
+	if m.PremiumFraction.IsNil() || m.CumulativePremiumFraction.IsNil() {
+		return fmt.Errorf("premium fractions must be set")
+	}
+
+	if m.BlockHeight < 0 {
+		return fmt.Errorf("invalid block number")
+	}
+
+	return nil
+}
@@ -0,0 +1,298 @@
+package v2
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// DefaultGenesis returns the default genesis state of the x/perp v2 module.
+func DefaultGenesis() *GenesisState {
+	return &GenesisState{
+		Params:                 DefaultParams(),
+		Markets:                []Market{},
+		Amms:                   []AMM{},
+		Positions:              []Position{},
+		ReserveSnapshots:       []ReserveSnapshot{},
+		TriggerOrders:          []TriggerOrder{},
+		CrossMarginTraders:     []string{},
+		InsuranceFunds:         []InsuranceFund{},
+		InsuranceFundDrawDowns: []InsuranceFundDrawDown{},
+		FundingRates:           []FundingRate{},
+		CircuitBreakers:        []CircuitBreaker{},
+		TraderVolumes:          []TraderVolume{},
+		ReferralCodes:          []ReferralCode{},
+		Referrals:              []Referral{},
+		ReferralRebates:        []ReferralRebate{},
+		TraderPnls:             []TraderPnl{},
+		TraderHistory:          []TraderHistoryEntry{},
+		LiquidationAuctions:    []LiquidationAuction{},
+	}
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
+	markets := make(map[string]struct{}, len(gs.Markets))
+	for i, m := range gs.Markets {
+		if err := m.Pair.Validate(); err != nil {
+			return fmt.Errorf("malformed genesis market pair at index %d: %w", i, err)
+		}
+		if err := m.Validate(); err != nil {
+			return fmt.Errorf("malformed genesis market %s at index %d: %w", m.Pair, i, err)
+		}
+		if _, exists := markets[m.Pair.String()]; exists {
+			return fmt.Errorf("duplicate market: %s", m.Pair)
+		}
+		markets[m.Pair.String()] = struct{}{}
+	}
+
+	amms := make(map[string]struct{}, len(gs.Amms))
+	for i, amm := range gs.Amms {
+		if err := amm.Pair.Validate(); err != nil {
+			return fmt.Errorf("malformed genesis amm pair at index %d: %w", i, err)
+		}
+		if err := amm.Validate(); err != nil {
+			return fmt.Errorf("malformed genesis amm %s at index %d: %w", amm.Pair, i, err)
+		}
+		if _, exists := markets[amm.Pair.String()]; !exists {
+			return fmt.Errorf("amm %s has no market", amm.Pair)
+		}
+		if _, exists := amms[amm.Pair.String()]; exists {
+			return fmt.Errorf("duplicate amm: %s", amm.Pair)
+		}
+		amms[amm.Pair.String()] = struct{}{}
+	}
+
+	for pair := range markets {
+		if _, exists := amms[pair]; !exists {
+			return fmt.Errorf("market %s has no amm", pair)
+		}
+	}
+
+	positions := make(map[string]struct{}, len(gs.Positions))
+	for i, pos := range gs.Positions {
+		if err := pos.Validate(); err != nil {
+			return fmt.Errorf("malformed genesis position %s at index %d: %w", &pos, i, err)
+		}
+		if _, exists := markets[pos.Pair.String()]; !exists {
+			return fmt.Errorf("position of %s has no market %s", pos.TraderAddress, pos.Pair)
+		}
+		key := pos.Pair.String() + pos.TraderAddress
+		if _, exists := positions[key]; exists {
+			return fmt.Errorf("duplicate position of %s in %s", pos.TraderAddress, pos.Pair)
+		}
+		positions[key] = struct{}{}
+	}
+
+	for i, snapshot := range gs.ReserveSnapshots {
+		if err := snapshot.Validate(); err != nil {
+			return fmt.Errorf("malformed genesis reserve snapshot at index %d: %w", i, err)
+		}
+		if _, exists := markets[snapshot.Amm.Pair.String()]; !exists {
+			return fmt.Errorf("reserve snapshot has no market %s", snapshot.Amm.Pair)
+		}
+	}
+
+	orderIDs := make(map[uint64]struct{}, len(gs.TriggerOrders))
+	for i, order := range gs.TriggerOrders {
+		if err := order.Validate(); err != nil {
+			return fmt.Errorf("malformed genesis trigger order at index %d: %w", i, err)
+		}
+		if _, exists := markets[order.Pair.String()]; !exists {
+			return fmt.Errorf("trigger order %d has no market %s", order.Id, order.Pair)
+		}
+		if _, exists := orderIDs[order.Id]; exists {
+			return fmt.Errorf("duplicate trigger order id %d", order.Id)
+		}
+		orderIDs[order.Id] = struct{}{}
+	}
+
+	crossMarginTraders := make(map[string]struct{}, len(gs.CrossMarginTraders))
+	for i, trader := range gs.CrossMarginTraders {
+		if _, err := sdk.AccAddressFromBech32(trader); err != nil {
+			return fmt.Errorf("malformed genesis cross margin trader at index %d: %w", i, err)
+		}
+		if _, exists := crossMarginTraders[trader]; exists {
+			return fmt.Errorf("duplicate cross margin trader %s", trader)
+		}
+		crossMarginTraders[trader] = struct{}{}
+	}
+
+	insuranceFunds := make(map[string]struct{}, len(gs.InsuranceFunds))
+	for i, fund := range gs.InsuranceFunds {
+		if err := fund.Validate(); err != nil {
+			return fmt.Errorf("malformed genesis insurance fund at index %d: %w", i, err)
+		}
+		if _, exists := markets[fund.Pair.String()]; !exists {
+			return fmt.Errorf("insurance fund has no market %s", fund.Pair)
+		}
+		if _, exists := insuranceFunds[fund.Pair.String()]; exists {
+			return fmt.Errorf("duplicate insurance fund: %s", fund.Pair)
+		}
+		insuranceFunds[fund.Pair.String()] = struct{}{}
+	}
+
+	drawDownIDs := make(map[uint64]struct{}, len(gs.InsuranceFundDrawDowns))
+	for i, drawDown := range gs.InsuranceFundDrawDowns {
+		if err := drawDown.Validate(); err != nil {
+			return fmt.Errorf("malformed genesis insurance fund draw-down at index %d: %w", i, err)
+		}
+		if _, exists := markets[drawDown.Pair.String()]; !exists {
+			return fmt.Errorf("insurance fund draw-down %d has no market %s", drawDown.Id, drawDown.Pair)
+		}
+		if _, exists := drawDownIDs[drawDown.Id]; exists {
+			return fmt.Errorf("duplicate insurance fund draw-down id %d", drawDown.Id)
+		}
+		drawDownIDs[drawDown.Id] = struct{}{}
+	}
+
+	fundingRates := make(map[string]struct{}, len(gs.FundingRates))
+	for i, fundingRate := range gs.FundingRates {
+		if err := fundingRate.Validate(); err != nil {
+			return fmt.Errorf("malformed genesis funding rate at index %d: %w", i, err)
+		}
+		if _, exists := markets[fundingRate.Pair.String()]; !exists {
+			return fmt.Errorf("funding rate has no market %s", fundingRate.Pair)
+		}
+		key := fmt.Sprintf("%s/%d", fundingRate.Pair, fundingRate.BlockTimeMs)
+		if _, exists := fundingRates[key]; exists {
+			return fmt.Errorf("duplicate funding rate of %s at %d", fundingRate.Pair, fundingRate.BlockTimeMs)
+		}
+		fundingRates[key] = struct{}{}
+	}
+
+	circuitBreakers := make(map[string]struct{}, len(gs.CircuitBreakers))
+	for i, breaker := range gs.CircuitBreakers {
+		if err := breaker.Pair.Validate(); err != nil {
+			return fmt.Errorf("malformed genesis circuit breaker pair at index %d: %w", i, err)
+		}
+		if _, exists := markets[breaker.Pair.String()]; !exists {
+			return fmt.Errorf("circuit breaker has no market %s", breaker.Pair)
+		}
+		if _, exists := circuitBreakers[breaker.Pair.String()]; exists {
+			return fmt.Errorf("duplicate circuit breaker: %s", breaker.Pair)
+		}
+		circuitBreakers[breaker.Pair.String()] = struct{}{}
+	}
+
+	traderVolumes := make(map[string]struct{}, len(gs.TraderVolumes))
+	for i, volume := range gs.TraderVolumes {
+		if _, err := sdk.AccAddressFromBech32(volume.Trader); err != nil {
+			return fmt.Errorf("malformed genesis trader volume at index %d: %w", i, err)
+		}
+		if volume.Volume.IsNil() || volume.Volume.IsNegative() {
+			return fmt.Errorf("malformed genesis trader volume at index %d: volume must be >= 0", i)
+		}
+		key := fmt.Sprintf("%s/%d", volume.Trader, volume.Day)
+		if _, exists := traderVolumes[key]; exists {
+			return fmt.Errorf("duplicate trader volume of %s on day %d", volume.Trader, volume.Day)
+		}
+		traderVolumes[key] = struct{}{}
+	}
+
+	referralCodes := make(map[string]struct{}, len(gs.ReferralCodes))
+	for i, code := range gs.ReferralCodes {
+		if err := ValidateReferralCode(code.Code); err != nil {
+			return fmt.Errorf("malformed genesis referral code at index %d: %w", i, err)
+		}
+		if _, err := sdk.AccAddressFromBech32(code.Referrer); err != nil {
+			return fmt.Errorf("malformed genesis referral code at index %d: %w", i, err)
+		}
+		if _, exists := referralCodes[code.Code]; exists {
+			return fmt.Errorf("duplicate referral code %s", code.Code)
+		}
+		referralCodes[code.Code] = struct{}{}
+	}
+
+	referees := make(map[string]struct{}, len(gs.Referrals))
+	for i, referral := range gs.Referrals {
+		if _, err := sdk.AccAddressFromBech32(referral.Referee); err != nil {
+			return fmt.Errorf("malformed genesis referral at index %d: %w", i, err)
+		}
+		if _, exists := referralCodes[referral.Code]; !exists {
+			return fmt.Errorf("referral of %s has no referral code %s", referral.Referee, referral.Code)
+		}
+		if _, exists := referees[referral.Referee]; exists {
+			return fmt.Errorf("duplicate referral of %s", referral.Referee)
+		}
+		referees[referral.Referee] = struct{}{}
+	}
+
+	referralRebates := make(map[string]struct{}, len(gs.ReferralRebates))
+	for i, rebate := range gs.ReferralRebates {
+		if _, err := sdk.AccAddressFromBech32(rebate.Referrer); err != nil {
+			return fmt.Errorf("malformed genesis referral rebate at index %d: %w", i, err)
+		}
+		if err := rebate.Rebate.Validate(); err != nil {
+			return fmt.Errorf("malformed genesis referral rebate at index %d: %w", i, err)
+		}
+		key := rebate.Referrer + rebate.Rebate.Denom
+		if _, exists := referralRebates[key]; exists {
+			return fmt.Errorf("duplicate referral rebate of %s in %s", rebate.Referrer, rebate.Rebate.Denom)
+		}
+		referralRebates[key] = struct{}{}
+	}
+
+	traderPnls := make(map[string]struct{}, len(gs.TraderPnls))
+	for i, pnl := range gs.TraderPnls {
+		if _, err := sdk.AccAddressFromBech32(pnl.Trader); err != nil {
+			return fmt.Errorf("malformed genesis trader pnl at index %d: %w", i, err)
+		}
+		if err := pnl.Pair.Validate(); err != nil {
+			return fmt.Errorf("malformed genesis trader pnl at index %d: %w", i, err)
+		}
+		key := pnl.Trader + pnl.Pair.String()
+		if _, exists := traderPnls[key]; exists {
+			return fmt.Errorf("duplicate trader pnl of %s in %s", pnl.Trader, pnl.Pair)
+		}
+		traderPnls[key] = struct{}{}
+	}
+
+	historyIDs := make(map[uint64]struct{}, len(gs.TraderHistory))
+	for i, entry := range gs.TraderHistory {
+		if _, err := sdk.AccAddressFromBech32(entry.Trader); err != nil {
+			return fmt.Errorf("malformed genesis trader history entry at index %d: %w", i, err)
+		}
+		if err := entry.Pair.Validate(); err != nil {
+			return fmt.Errorf("malformed genesis trader history entry at index %d: %w", i, err)
+		}
+		if _, exists := historyIDs[entry.Id]; exists {
+			return fmt.Errorf("duplicate trader history entry id %d", entry.Id)
+		}
+		historyIDs[entry.Id] = struct{}{}
+	}
+
+	auctions := make(map[string]struct{}, len(gs.LiquidationAuctions))
+	for i, auction := range gs.LiquidationAuctions {
+		if err := auction.Validate(); err != nil {
+			return fmt.Errorf("malformed genesis liquidation auction at index %d: %w", i, err)
+		}
+		key := auction.Pair.String() + auction.TraderAddress
+		if _, exists := positions[key]; !exists {
+			return fmt.Errorf("liquidation auction of %s in %s has no position", auction.TraderAddress, auction.Pair)
+		}
+		if _, exists := auctions[key]; exists {
+			return fmt.Errorf("duplicate liquidation auction of %s in %s", auction.TraderAddress, auction.Pair)
+		}
+		auctions[key] = struct{}{}
+	}
+
+	return nil
+}
+
+func GetGenesisStateFromAppState(cdc codec.JSONCodec, appState map[string]json.RawMessage) *GenesisState {
+	var genesisState GenesisState
+
+	if appState[ModuleName] != nil {
+		cdc.MustUnmarshalJSON(appState[ModuleName], &genesisState)
+	}
+
+	return &genesisState
+}
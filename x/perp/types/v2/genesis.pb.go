@@ -27,10 +27,25 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // GenesisState defines the perp module's genesis state.
 type GenesisState struct {
-	Params    Params     `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
-	Markets   []Market   `protobuf:"bytes,2,rep,name=markets,proto3" json:"markets"`
-	Amms      []AMM      `protobuf:"bytes,3,rep,name=amms,proto3" json:"amms"`
-	Positions []Position `protobuf:"bytes,4,rep,name=positions,proto3" json:"positions"`
+	Params           Params            `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	Markets          []Market          `protobuf:"bytes,2,rep,name=markets,proto3" json:"markets"`
+	Amms             []AMM             `protobuf:"bytes,3,rep,name=amms,proto3" json:"amms"`
+	Positions        []Position        `protobuf:"bytes,4,rep,name=positions,proto3" json:"positions"`
+	ReserveSnapshots []ReserveSnapshot `protobuf:"bytes,5,rep,name=reserve_snapshots,json=reserveSnapshots,proto3" json:"reserve_snapshots"`
+	TriggerOrders    []TriggerOrder    `protobuf:"bytes,6,rep,name=trigger_orders,json=triggerOrders,proto3" json:"trigger_orders"`
+	// the traders whose positions are cross-margined
+	CrossMarginTraders     []string                `protobuf:"bytes,7,rep,name=cross_margin_traders,json=crossMarginTraders,proto3" json:"cross_margin_traders,omitempty"`
+	InsuranceFunds         []InsuranceFund         `protobuf:"bytes,8,rep,name=insurance_funds,json=insuranceFunds,proto3" json:"insurance_funds"`
+	InsuranceFundDrawDowns []InsuranceFundDrawDown `protobuf:"bytes,9,rep,name=insurance_fund_draw_downs,json=insuranceFundDrawDowns,proto3" json:"insurance_fund_draw_downs"`
+	FundingRates           []FundingRate           `protobuf:"bytes,10,rep,name=funding_rates,json=fundingRates,proto3" json:"funding_rates"`
+	CircuitBreakers        []CircuitBreaker        `protobuf:"bytes,11,rep,name=circuit_breakers,json=circuitBreakers,proto3" json:"circuit_breakers"`
+	TraderVolumes          []TraderVolume          `protobuf:"bytes,12,rep,name=trader_volumes,json=traderVolumes,proto3" json:"trader_volumes"`
+	ReferralCodes          []ReferralCode          `protobuf:"bytes,13,rep,name=referral_codes,json=referralCodes,proto3" json:"referral_codes"`
+	Referrals              []Referral              `protobuf:"bytes,14,rep,name=referrals,proto3" json:"referrals"`
+	ReferralRebates        []ReferralRebate        `protobuf:"bytes,15,rep,name=referral_rebates,json=referralRebates,proto3" json:"referral_rebates"`
+	TraderPnls             []TraderPnl             `protobuf:"bytes,16,rep,name=trader_pnls,json=traderPnls,proto3" json:"trader_pnls"`
+	TraderHistory          []TraderHistoryEntry    `protobuf:"bytes,17,rep,name=trader_history,json=traderHistory,proto3" json:"trader_history"`
+	LiquidationAuctions    []LiquidationAuction    `protobuf:"bytes,18,rep,name=liquidation_auctions,json=liquidationAuctions,proto3" json:"liquidation_auctions"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -94,6 +109,104 @@ func (m *GenesisState) GetPositions() []Position {
 	return nil
 }
 
+func (m *GenesisState) GetReserveSnapshots() []ReserveSnapshot {
+	if m != nil {
+		return m.ReserveSnapshots
+	}
+	return nil
+}
+
+func (m *GenesisState) GetTriggerOrders() []TriggerOrder {
+	if m != nil {
+		return m.TriggerOrders
+	}
+	return nil
+}
+
+func (m *GenesisState) GetCrossMarginTraders() []string {
+	if m != nil {
+		return m.CrossMarginTraders
+	}
+	return nil
+}
+
+func (m *GenesisState) GetInsuranceFunds() []InsuranceFund {
+	if m != nil {
+		return m.InsuranceFunds
+	}
+	return nil
+}
+
+func (m *GenesisState) GetInsuranceFundDrawDowns() []InsuranceFundDrawDown {
+	if m != nil {
+		return m.InsuranceFundDrawDowns
+	}
+	return nil
+}
+
+func (m *GenesisState) GetFundingRates() []FundingRate {
+	if m != nil {
+		return m.FundingRates
+	}
+	return nil
+}
+
+func (m *GenesisState) GetCircuitBreakers() []CircuitBreaker {
+	if m != nil {
+		return m.CircuitBreakers
+	}
+	return nil
+}
+
+func (m *GenesisState) GetTraderVolumes() []TraderVolume {
+	if m != nil {
+		return m.TraderVolumes
+	}
+	return nil
+}
+
+func (m *GenesisState) GetReferralCodes() []ReferralCode {
+	if m != nil {
+		return m.ReferralCodes
+	}
+	return nil
+}
+
+func (m *GenesisState) GetReferrals() []Referral {
+	if m != nil {
+		return m.Referrals
+	}
+	return nil
+}
+
+func (m *GenesisState) GetReferralRebates() []ReferralRebate {
+	if m != nil {
+		return m.ReferralRebates
+	}
+	return nil
+}
+
+func (m *GenesisState) GetTraderPnls() []TraderPnl {
+	if m != nil {
+		return m.TraderPnls
+	}
+	return nil
+}
+
+func (m *GenesisState) GetTraderHistory() []TraderHistoryEntry {
+	if m != nil {
+		return m.TraderHistory
+	}
+	return nil
+}
+
+func (m *GenesisState) GetLiquidationAuctions() []LiquidationAuction {
+	if m != nil {
+		return m.LiquidationAuctions
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "nibiru.perp.v2.GenesisState")
 }
@@ -101,27 +214,51 @@ func init() {
 func init() { proto.RegisterFile("perp/v2/genesis.proto", fileDescriptor_8edcabc35f3cf683) }
 
 var fileDescriptor_8edcabc35f3cf683 = []byte{
-	// 313 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x90, 0xbf, 0x4e, 0xf3, 0x30,
-	0x14, 0xc5, 0x93, 0xaf, 0x55, 0x3f, 0x11, 0x10, 0x43, 0x0a, 0x28, 0xaa, 0x90, 0xa9, 0x98, 0x58,
-	0xea, 0xab, 0x06, 0xc4, 0xc4, 0x42, 0x19, 0x3a, 0x15, 0x21, 0xd8, 0xd8, 0x9c, 0xca, 0x72, 0x2d,
-	0xb0, 0xaf, 0x15, 0xbb, 0x11, 0xbc, 0x05, 0x8f, 0xd5, 0xb1, 0x23, 0x13, 0x42, 0xed, 0xc4, 0x5b,
-	0xa0, 0x38, 0xae, 0x10, 0x7f, 0x36, 0xeb, 0x9e, 0xf3, 0x3b, 0x3e, 0xf7, 0x26, 0xfb, 0x86, 0x97,
-	0x06, 0xaa, 0x1c, 0x04, 0xd7, 0xdc, 0x4a, 0x4b, 0x4d, 0x89, 0x0e, 0xd3, 0x5d, 0x2d, 0x0b, 0x59,
-	0xce, 0x69, 0xad, 0xd2, 0x2a, 0xef, 0xed, 0x09, 0x14, 0xe8, 0x25, 0xa8, 0x5f, 0x8d, 0xab, 0x77,
-	0x28, 0x10, 0xc5, 0x23, 0x07, 0x66, 0x24, 0x30, 0xad, 0xd1, 0x31, 0x27, 0x51, 0x87, 0x8c, 0x1e,
-	0x99, 0xa2, 0x55, 0x68, 0xa1, 0x60, 0x96, 0x43, 0x35, 0x2c, 0xb8, 0x63, 0x43, 0x98, 0xa2, 0xd4,
-	0x41, 0xef, 0x6e, 0xbe, 0xb6, 0x8e, 0x39, 0xde, 0x0c, 0x8f, 0x3f, 0xe2, 0x64, 0x67, 0xdc, 0x54,
-	0xb9, 0xab, 0xc7, 0xe9, 0x59, 0xd2, 0x31, 0xac, 0x64, 0xca, 0x66, 0x71, 0x3f, 0x3e, 0xd9, 0xce,
-	0x0f, 0xe8, 0xf7, 0x6a, 0xf4, 0xc6, 0xab, 0xa3, 0xf6, 0xe2, 0xed, 0x28, 0xba, 0x0d, 0xde, 0xf4,
-	0x3c, 0xf9, 0xaf, 0x58, 0xf9, 0xc0, 0x9d, 0xcd, 0xfe, 0xf5, 0x5b, 0x7f, 0x61, 0x13, 0x2f, 0x07,
-	0x6c, 0x63, 0x4e, 0x07, 0x49, 0x9b, 0x29, 0x65, 0xb3, 0x96, 0x87, 0xba, 0x3f, 0xa1, 0xcb, 0xc9,
-	0x24, 0x10, 0xde, 0x96, 0x5e, 0x24, 0x5b, 0x06, 0xad, 0xf4, 0x5b, 0x67, 0x6d, 0xcf, 0x64, 0xbf,
-	0xfa, 0x05, 0x43, 0x00, 0xbf, 0x80, 0xd1, 0x78, 0xb1, 0x22, 0xf1, 0x72, 0x45, 0xe2, 0xf7, 0x15,
-	0x89, 0x5f, 0xd6, 0x24, 0x5a, 0xae, 0x49, 0xf4, 0xba, 0x26, 0xd1, 0xfd, 0x40, 0x48, 0x37, 0x9b,
-	0x17, 0x74, 0x8a, 0x0a, 0xae, 0x7d, 0xdc, 0xd5, 0x8c, 0x49, 0x0d, 0x4d, 0x34, 0x3c, 0x81, 0x3f,
-	0x9d, 0x7b, 0x36, 0xdc, 0x42, 0x95, 0x17, 0x1d, 0x7f, 0xbb, 0xd3, 0xcf, 0x00, 0x00, 0x00, 0xff,
-	0xff, 0x74, 0x8f, 0x37, 0x3b, 0xcd, 0x01, 0x00, 0x00,
+	// 699 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x94, 0xcf, 0x4e, 0xdb, 0x4c,
+	0x14, 0xc5, 0x93, 0x0f, 0x3e, 0x28, 0x03, 0x04, 0x18, 0x28, 0x1a, 0x28, 0x35, 0x08, 0xa9, 0x12,
+	0x1b, 0xe2, 0x92, 0x56, 0x5d, 0x75, 0x51, 0xfe, 0x14, 0x8a, 0x44, 0x0a, 0x0a, 0xa8, 0x8b, 0x76,
+	0x61, 0x8d, 0xed, 0x89, 0x33, 0x22, 0x9e, 0x71, 0xe7, 0x8e, 0x93, 0xf2, 0x16, 0x7d, 0x82, 0x3e,
+	0x0f, 0x4b, 0x96, 0x5d, 0x55, 0x15, 0xbc, 0x48, 0xe5, 0xf1, 0x98, 0x90, 0xe0, 0x76, 0x17, 0x9d,
+	0x73, 0xee, 0x6f, 0x6e, 0x8e, 0x47, 0x83, 0x9e, 0x26, 0x4c, 0x25, 0x6e, 0xaf, 0xe1, 0x46, 0x4c,
+	0x30, 0xe0, 0x50, 0x4f, 0x94, 0xd4, 0x12, 0xd7, 0x04, 0xf7, 0xb9, 0x4a, 0xeb, 0x99, 0x5b, 0xef,
+	0x35, 0x56, 0x97, 0x22, 0x19, 0x49, 0x63, 0xb9, 0xd9, 0xaf, 0x3c, 0xb5, 0xba, 0x16, 0x49, 0x19,
+	0x75, 0x99, 0x4b, 0x13, 0xee, 0x52, 0x21, 0xa4, 0xa6, 0x9a, 0x4b, 0x61, 0x19, 0xab, 0x4e, 0x20,
+	0x21, 0x96, 0xe0, 0xfa, 0x14, 0x98, 0xdb, 0xdb, 0xf1, 0x99, 0xa6, 0x3b, 0x6e, 0x20, 0xb9, 0xb0,
+	0xfe, 0x62, 0x71, 0x34, 0x68, 0xaa, 0x59, 0x2e, 0x6e, 0xfe, 0x40, 0x68, 0xe6, 0x28, 0x5f, 0xe5,
+	0x3c, 0x93, 0xf1, 0x6b, 0x34, 0x91, 0x50, 0x45, 0x63, 0x20, 0xd5, 0x8d, 0xea, 0xd6, 0x74, 0x63,
+	0xb9, 0x3e, 0xbc, 0x5a, 0xfd, 0xcc, 0xb8, 0x7b, 0xe3, 0xd7, 0xbf, 0xd6, 0x2b, 0x2d, 0x9b, 0xc5,
+	0x6f, 0xd0, 0x64, 0x4c, 0xd5, 0x25, 0xd3, 0x40, 0xfe, 0xdb, 0x18, 0x2b, 0x1b, 0x6b, 0x1a, 0xdb,
+	0x8e, 0x15, 0x61, 0xbc, 0x8d, 0xc6, 0x69, 0x1c, 0x03, 0x19, 0x33, 0x43, 0x8b, 0xa3, 0x43, 0xbb,
+	0xcd, 0xa6, 0x9d, 0x30, 0x31, 0xfc, 0x16, 0x4d, 0x25, 0x12, 0xb8, 0xf9, 0xd7, 0x64, 0xdc, 0xcc,
+	0x90, 0x47, 0xfb, 0xd9, 0x80, 0x1d, 0x1c, 0x0c, 0xe0, 0x16, 0x5a, 0x50, 0x0c, 0x98, 0xea, 0x31,
+	0x0f, 0x04, 0x4d, 0xa0, 0x23, 0x35, 0x90, 0xff, 0x0d, 0x65, 0x7d, 0x94, 0xd2, 0xca, 0x83, 0xe7,
+	0x36, 0x67, 0x61, 0xf3, 0x6a, 0x58, 0x06, 0x7c, 0x8c, 0x6a, 0x5a, 0xf1, 0x28, 0x62, 0xca, 0x93,
+	0x2a, 0x64, 0x0a, 0xc8, 0x84, 0x01, 0xae, 0x8d, 0x02, 0x2f, 0xf2, 0xd4, 0x69, 0x16, 0xb2, 0xb4,
+	0x59, 0xfd, 0x40, 0x03, 0xfc, 0x12, 0x2d, 0x05, 0x4a, 0x02, 0x78, 0x31, 0x55, 0x11, 0x17, 0x9e,
+	0x56, 0xd4, 0x00, 0x27, 0x37, 0xc6, 0xb6, 0xa6, 0x5a, 0xd8, 0x78, 0x4d, 0x63, 0x5d, 0xe4, 0x0e,
+	0x3e, 0x41, 0x73, 0x5c, 0x40, 0xaa, 0xa8, 0x08, 0x98, 0xd7, 0x4e, 0x45, 0x08, 0xe4, 0x89, 0x39,
+	0xfd, 0xf9, 0xe8, 0xe9, 0xc7, 0x45, 0xec, 0x30, 0x15, 0xa1, 0x3d, 0xbe, 0xc6, 0x1f, 0x8a, 0x80,
+	0xdb, 0x68, 0x65, 0x98, 0xe6, 0x85, 0x8a, 0xf6, 0xbd, 0x50, 0xf6, 0x05, 0x90, 0x29, 0xc3, 0x7d,
+	0xf1, 0x4f, 0xee, 0x81, 0xa2, 0xfd, 0x03, 0xd9, 0x2f, 0x9a, 0x5f, 0xe6, 0x65, 0x26, 0xe0, 0x43,
+	0x34, 0x9b, 0xd1, 0xb9, 0x88, 0x3c, 0x45, 0x35, 0x03, 0x82, 0x0c, 0xfb, 0xd9, 0x28, 0xfb, 0x30,
+	0x0f, 0xb5, 0xa8, 0x66, 0x96, 0x38, 0xd3, 0x1e, 0x48, 0x80, 0x4f, 0xd1, 0x7c, 0xc0, 0x55, 0x90,
+	0x72, 0xed, 0xf9, 0x8a, 0xd1, 0xcb, 0xac, 0xab, 0x69, 0x83, 0x72, 0x46, 0x51, 0xfb, 0x79, 0x6e,
+	0x2f, 0x8f, 0x59, 0xda, 0x5c, 0x30, 0xa4, 0xda, 0x6f, 0x99, 0x35, 0xeb, 0xf5, 0x64, 0x37, 0x8d,
+	0x19, 0x90, 0x99, 0xbf, 0x7d, 0xcb, 0x2c, 0xf5, 0xc9, 0x84, 0x06, 0xdf, 0x72, 0xa0, 0x19, 0x94,
+	0x62, 0x6d, 0xa6, 0x14, 0xed, 0x7a, 0x81, 0x0c, 0x19, 0x90, 0xd9, 0x72, 0x54, 0xcb, 0xa6, 0xf6,
+	0x65, 0x78, 0x8f, 0x52, 0x0f, 0x34, 0x73, 0xe7, 0x0b, 0x01, 0x48, 0xad, 0xfc, 0xce, 0x17, 0x94,
+	0xe2, 0xce, 0xdf, 0x0f, 0x64, 0x25, 0xdd, 0x2f, 0xa2, 0x98, 0x6f, 0xfa, 0x9e, 0x2b, 0x2f, 0xa9,
+	0x80, 0xb4, 0x4c, 0xac, 0x28, 0x49, 0x0d, 0xa9, 0x80, 0xdf, 0xa1, 0x69, 0x5b, 0x52, 0x22, 0xba,
+	0x40, 0xe6, 0x0d, 0x6b, 0xa5, 0xbc, 0xa1, 0x33, 0x51, 0x6c, 0x84, 0x74, 0x21, 0x64, 0x2b, 0x15,
+	0x35, 0x77, 0x38, 0x68, 0xa9, 0xae, 0xc8, 0x82, 0x81, 0x6c, 0x96, 0x43, 0x3e, 0xe4, 0xa1, 0xf7,
+	0x42, 0xab, 0xab, 0xe1, 0xb2, 0xad, 0x83, 0xbf, 0xa0, 0xa5, 0x2e, 0xff, 0x9a, 0xf2, 0xd0, 0x3c,
+	0x87, 0x1e, 0x4d, 0x83, 0xfc, 0x81, 0xc0, 0xe5, 0xd8, 0x93, 0x41, 0x76, 0x37, 0x8f, 0x5a, 0xec,
+	0x62, 0xf7, 0x91, 0x03, 0x7b, 0x47, 0xd7, 0xb7, 0x4e, 0xf5, 0xe6, 0xd6, 0xa9, 0xfe, 0xbe, 0x75,
+	0xaa, 0xdf, 0xef, 0x9c, 0xca, 0xcd, 0x9d, 0x53, 0xf9, 0x79, 0xe7, 0x54, 0x3e, 0x6f, 0x47, 0x5c,
+	0x77, 0x52, 0xbf, 0x1e, 0xc8, 0xd8, 0xfd, 0x68, 0x8e, 0xd8, 0xef, 0x50, 0x2e, 0xdc, 0xfc, 0x38,
+	0xf7, 0x9b, 0x6b, 0xde, 0x5b, 0x7d, 0x95, 0x30, 0x70, 0x7b, 0x0d, 0x7f, 0xc2, 0x3c, 0xb8, 0xaf,
+	0xfe, 0x0c, 0x00, 0x08, 0xee, 0xbe, 0x08, 0x02, 0x06, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -144,6 +281,203 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.LiquidationAuctions) > 0 {
+		for iNdEx := len(m.LiquidationAuctions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LiquidationAuctions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x92
+		}
+	}
+	if len(m.TraderHistory) > 0 {
+		for iNdEx := len(m.TraderHistory) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TraderHistory[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
+		}
+	}
+	if len(m.TraderPnls) > 0 {
+		for iNdEx := len(m.TraderPnls) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TraderPnls[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	if len(m.ReferralRebates) > 0 {
+		for iNdEx := len(m.ReferralRebates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ReferralRebates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
+	if len(m.Referrals) > 0 {
+		for iNdEx := len(m.Referrals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Referrals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if len(m.ReferralCodes) > 0 {
+		for iNdEx := len(m.ReferralCodes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ReferralCodes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if len(m.TraderVolumes) > 0 {
+		for iNdEx := len(m.TraderVolumes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TraderVolumes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.CircuitBreakers) > 0 {
+		for iNdEx := len(m.CircuitBreakers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CircuitBreakers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.FundingRates) > 0 {
+		for iNdEx := len(m.FundingRates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FundingRates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.InsuranceFundDrawDowns) > 0 {
+		for iNdEx := len(m.InsuranceFundDrawDowns) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.InsuranceFundDrawDowns[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.InsuranceFunds) > 0 {
+		for iNdEx := len(m.InsuranceFunds) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.InsuranceFunds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.CrossMarginTraders) > 0 {
+		for iNdEx := len(m.CrossMarginTraders) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.CrossMarginTraders[iNdEx])
+			copy(dAtA[i:], m.CrossMarginTraders[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.CrossMarginTraders[iNdEx])))
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.TriggerOrders) > 0 {
+		for iNdEx := len(m.TriggerOrders) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TriggerOrders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.ReserveSnapshots) > 0 {
+		for iNdEx := len(m.ReserveSnapshots) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ReserveSnapshots[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
 	if len(m.Positions) > 0 {
 		for iNdEx := len(m.Positions) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -151,132 +485,621 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				if err != nil {
 					return 0, err
 				}
-				i -= size
-				i = encodeVarintGenesis(dAtA, i, uint64(size))
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Amms) > 0 {
+		for iNdEx := len(m.Amms) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amms[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Markets) > 0 {
+		for iNdEx := len(m.Markets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Markets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGenesis(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *GenesisState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.Markets) > 0 {
+		for _, e := range m.Markets {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Amms) > 0 {
+		for _, e := range m.Amms {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Positions) > 0 {
+		for _, e := range m.Positions {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ReserveSnapshots) > 0 {
+		for _, e := range m.ReserveSnapshots {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.TriggerOrders) > 0 {
+		for _, e := range m.TriggerOrders {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.CrossMarginTraders) > 0 {
+		for _, s := range m.CrossMarginTraders {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.InsuranceFunds) > 0 {
+		for _, e := range m.InsuranceFunds {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.InsuranceFundDrawDowns) > 0 {
+		for _, e := range m.InsuranceFundDrawDowns {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.FundingRates) > 0 {
+		for _, e := range m.FundingRates {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.CircuitBreakers) > 0 {
+		for _, e := range m.CircuitBreakers {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.TraderVolumes) > 0 {
+		for _, e := range m.TraderVolumes {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ReferralCodes) > 0 {
+		for _, e := range m.ReferralCodes {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Referrals) > 0 {
+		for _, e := range m.Referrals {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ReferralRebates) > 0 {
+		for _, e := range m.ReferralRebates {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.TraderPnls) > 0 {
+		for _, e := range m.TraderPnls {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.TraderHistory) > 0 {
+		for _, e := range m.TraderHistory {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.LiquidationAuctions) > 0 {
+		for _, e := range m.LiquidationAuctions {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovGenesis(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGenesis(x uint64) (n int) {
+	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *GenesisState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Markets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Markets = append(m.Markets, Market{})
+			if err := m.Markets[len(m.Markets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amms", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amms = append(m.Amms, AMM{})
+			if err := m.Amms[len(m.Amms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Positions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Positions = append(m.Positions, Position{})
+			if err := m.Positions[len(m.Positions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReserveSnapshots", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReserveSnapshots = append(m.ReserveSnapshots, ReserveSnapshot{})
+			if err := m.ReserveSnapshots[len(m.ReserveSnapshots)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TriggerOrders", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TriggerOrders = append(m.TriggerOrders, TriggerOrder{})
+			if err := m.TriggerOrders[len(m.TriggerOrders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CrossMarginTraders", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CrossMarginTraders = append(m.CrossMarginTraders, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InsuranceFunds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InsuranceFunds = append(m.InsuranceFunds, InsuranceFund{})
+			if err := m.InsuranceFunds[len(m.InsuranceFunds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InsuranceFundDrawDowns", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InsuranceFundDrawDowns = append(m.InsuranceFundDrawDowns, InsuranceFundDrawDown{})
+			if err := m.InsuranceFundDrawDowns[len(m.InsuranceFundDrawDowns)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FundingRates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			i--
-			dAtA[i] = 0x22
-		}
-	}
-	if len(m.Amms) > 0 {
-		for iNdEx := len(m.Amms) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Amms[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FundingRates = append(m.FundingRates, FundingRate{})
+			if err := m.FundingRates[len(m.FundingRates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CircuitBreakers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				i -= size
-				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
-			i--
-			dAtA[i] = 0x1a
-		}
-	}
-	if len(m.Markets) > 0 {
-		for iNdEx := len(m.Markets) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Markets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CircuitBreakers = append(m.CircuitBreakers, CircuitBreaker{})
+			if err := m.CircuitBreakers[len(m.CircuitBreakers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TraderVolumes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				i -= size
-				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintGenesis(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
-}
-
-func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
-	offset -= sovGenesis(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *GenesisState) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovGenesis(uint64(l))
-	if len(m.Markets) > 0 {
-		for _, e := range m.Markets {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
-		}
-	}
-	if len(m.Amms) > 0 {
-		for _, e := range m.Amms {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
-		}
-	}
-	if len(m.Positions) > 0 {
-		for _, e := range m.Positions {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
-		}
-	}
-	return n
-}
-
-func sovGenesis(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozGenesis(x uint64) (n int) {
-	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
-}
-func (m *GenesisState) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenesis
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
 			}
-			if iNdEx >= l {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.TraderVolumes = append(m.TraderVolumes, TraderVolume{})
+			if err := m.TraderVolumes[len(m.TraderVolumes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReferralCodes", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -303,13 +1126,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ReferralCodes = append(m.ReferralCodes, ReferralCode{})
+			if err := m.ReferralCodes[len(m.ReferralCodes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 14:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Markets", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Referrals", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -336,14 +1160,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Markets = append(m.Markets, Market{})
-			if err := m.Markets[len(m.Markets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Referrals = append(m.Referrals, Referral{})
+			if err := m.Referrals[len(m.Referrals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 15:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amms", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReferralRebates", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -370,14 +1194,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Amms = append(m.Amms, AMM{})
-			if err := m.Amms[len(m.Amms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ReferralRebates = append(m.ReferralRebates, ReferralRebate{})
+			if err := m.ReferralRebates[len(m.ReferralRebates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 16:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Positions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TraderPnls", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -404,8 +1228,76 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Positions = append(m.Positions, Position{})
-			if err := m.Positions[len(m.Positions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.TraderPnls = append(m.TraderPnls, TraderPnl{})
+			if err := m.TraderPnls[len(m.TraderPnls)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TraderHistory", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TraderHistory = append(m.TraderHistory, TraderHistoryEntry{})
+			if err := m.TraderHistory[len(m.TraderHistory)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidationAuctions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LiquidationAuctions = append(m.LiquidationAuctions, LiquidationAuction{})
+			if err := m.LiquidationAuctions[len(m.LiquidationAuctions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
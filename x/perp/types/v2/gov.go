@@ -0,0 +1,58 @@
+package v2
+
+import (
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+const (
+	ProposalTypeSetAutoDeleveraging = "SetAutoDeleveraging"
+	ProposalTypeResetCircuitBreaker = "ResetCircuitBreaker"
+)
+
+var (
+	_ govtypes.Content = &SetAutoDeleveragingProposal{}
+	_ govtypes.Content = &ResetCircuitBreakerProposal{}
+)
+
+func init() {
+	govtypes.RegisterProposalType(ProposalTypeSetAutoDeleveraging)
+	govtypes.RegisterProposalTypeCodec(&SetAutoDeleveragingProposal{}, "nibiru/v2perp/SetAutoDeleveragingProposal")
+	govtypes.RegisterProposalType(ProposalTypeResetCircuitBreaker)
+	govtypes.RegisterProposalTypeCodec(&ResetCircuitBreakerProposal{}, "nibiru/v2perp/ResetCircuitBreakerProposal")
+}
+
+// SetAutoDeleveragingProposal
+
+func (proposal *SetAutoDeleveragingProposal) ProposalRoute() string {
+	return RouterKey
+}
+
+func (proposal *SetAutoDeleveragingProposal) ProposalType() string {
+	return ProposalTypeSetAutoDeleveraging
+}
+
+func (proposal *SetAutoDeleveragingProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(proposal); err != nil {
+		return err
+	}
+
+	return proposal.Pair.Validate()
+}
+
+// ResetCircuitBreakerProposal
+
+func (proposal *ResetCircuitBreakerProposal) ProposalRoute() string {
+	return RouterKey
+}
+
+func (proposal *ResetCircuitBreakerProposal) ProposalType() string {
+	return ProposalTypeResetCircuitBreaker
+}
+
+func (proposal *ResetCircuitBreakerProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(proposal); err != nil {
+		return err
+	}
+
+	return proposal.Pair.Validate()
+}
@@ -0,0 +1,85 @@
+package v2
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/NibiruChain/nibiru/x/common/asset"
+	"github.com/NibiruChain/nibiru/x/common/denoms"
+)
+
+func TestSetAutoDeleveragingProposal_ValidateBasic(t *testing.T) {
+	cases := map[string]struct {
+		proposal  *SetAutoDeleveragingProposal
+		expectErr bool
+	}{
+		"invalid pair": {&SetAutoDeleveragingProposal{
+			Title:       "enable adl",
+			Description: "enable adl",
+			Pair:        "invalidpair",
+			Enabled:     true,
+		}, true},
+
+		"missing title": {&SetAutoDeleveragingProposal{
+			Description: "enable adl",
+			Pair:        asset.Registry.Pair(denoms.BTC, denoms.NUSD),
+			Enabled:     true,
+		}, true},
+
+		"success": {&SetAutoDeleveragingProposal{
+			Title:       "enable adl",
+			Description: "enable adl",
+			Pair:        asset.Registry.Pair(denoms.BTC, denoms.NUSD),
+			Enabled:     true,
+		}, false},
+	}
+
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			err := tc.proposal.ValidateBasic()
+			if tc.expectErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestResetCircuitBreakerProposal_ValidateBasic(t *testing.T) {
+	cases := map[string]struct {
+		proposal  *ResetCircuitBreakerProposal
+		expectErr bool
+	}{
+		"invalid pair": {&ResetCircuitBreakerProposal{
+			Title:       "reset circuit breaker",
+			Description: "reset circuit breaker",
+			Pair:        "invalidpair",
+		}, true},
+
+		"missing description": {&ResetCircuitBreakerProposal{
+			Title: "reset circuit breaker",
+			Pair:  asset.Registry.Pair(denoms.BTC, denoms.NUSD),
+		}, true},
+
+		"success": {&ResetCircuitBreakerProposal{
+			Title:       "reset circuit breaker",
+			Description: "reset circuit breaker",
+			Pair:        asset.Registry.Pair(denoms.BTC, denoms.NUSD),
+		}, false},
+	}
+
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			err := tc.proposal.ValidateBasic()
+			if tc.expectErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,77 @@
+package v2
+
+import (
+	fmt "fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/NibiruChain/nibiru/x/common/asset"
+)
+
+// ZeroInsuranceFund returns an empty insurance fund for the pair.
+func ZeroInsuranceFund(pair asset.Pair) InsuranceFund {
+	return InsuranceFund{
+		Pair:               pair,
+		Balance:            sdk.NewCoin(pair.QuoteDenom(), sdk.ZeroInt()),
+		TotalContributions: sdk.NewCoin(pair.QuoteDenom(), sdk.ZeroInt()),
+		TotalDrawDowns:     sdk.NewCoin(pair.QuoteDenom(), sdk.ZeroInt()),
+	}
+}
+
+// Validate performs stateless validation of an insurance fund.
+func (m InsuranceFund) Validate() error {
+	if err := m.Pair.Validate(); err != nil {
+		return err
+	}
+
+	for _, coin := range []sdk.Coin{m.Balance, m.TotalContributions, m.TotalDrawDowns} {
+		if err := coin.Validate(); err != nil {
+			return err
+		}
+		if coin.Denom != m.Pair.QuoteDenom() {
+			return fmt.Errorf("invalid insurance fund denom, expected %s, got %s", m.Pair.QuoteDenom(), coin.Denom)
+		}
+	}
+
+	// the balance is what was paid in and not drawn down yet
+	if !m.TotalContributions.Sub(m.TotalDrawDowns).IsEqual(m.Balance) {
+		return fmt.Errorf("insurance fund balance %s does not match contributions %s minus draw-downs %s",
+			m.Balance, m.TotalContributions, m.TotalDrawDowns)
+	}
+
+	return nil
+}
+
+// Validate performs stateless validation of an insurance fund draw-down.
+func (m InsuranceFundDrawDown) Validate() error {
+	if err := m.Pair.Validate(); err != nil {
+		return err
+	}
+
+	if m.Id == 0 {
+		return fmt.Errorf("invalid draw-down id")
+	}
+
+	for _, coin := range []sdk.Coin{m.Amount, m.BadDebt} {
+		if err := coin.Validate(); err != nil {
+			return err
+		}
+		if coin.Denom != m.Pair.QuoteDenom() {
+			return fmt.Errorf("invalid draw-down denom, expected %s, got %s", m.Pair.QuoteDenom(), coin.Denom)
+		}
+	}
+
+	if !m.Amount.IsPositive() {
+		return fmt.Errorf("draw-down amount must be positive")
+	}
+
+	if m.Amount.Amount.GT(m.BadDebt.Amount) {
+		return fmt.Errorf("draw-down amount %s is larger than the bad debt %s", m.Amount, m.BadDebt)
+	}
+
+	if m.BlockHeight < 0 {
+		return fmt.Errorf("invalid block number")
+	}
+
+	return nil
+}
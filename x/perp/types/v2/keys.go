@@ -1,10 +1,19 @@
 package v2
 
+import (
+	"github.com/NibiruChain/nibiru/x/common"
+)
+
 const (
 	ModuleName           = "v2perp"
 	VaultModuleAccount   = "vault"
 	PerpEFModuleAccount  = "perp_ef"
 	FeePoolModuleAccount = "fee_pool"
+	// InsuranceFundModuleAccount holds the insurance funds of every market.
+	InsuranceFundModuleAccount = "insurance_fund"
+	// TriggerOrderEscrowModuleAccount holds the collateral of resting limit
+	// orders, apart from the margin in the vault.
+	TriggerOrderEscrowModuleAccount = "trigger_order_escrow"
 )
 
 var (
@@ -19,3 +28,12 @@ var (
 	// QuerierRoute defines the module's query routing key.
 	QuerierRoute = ModuleName
 )
+
+var ModuleAccounts = []string{
+	VaultModuleAccount,
+	PerpEFModuleAccount,
+	FeePoolModuleAccount,
+	InsuranceFundModuleAccount,
+	TriggerOrderEscrowModuleAccount,
+	common.TreasuryPoolModuleAccount,
+}
@@ -0,0 +1,59 @@
+package v2
+
+import (
+	fmt "fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Validate performs stateless validation of a liquidation auction.
+func (m *LiquidationAuction) Validate() error {
+	if err := m.Pair.Validate(); err != nil {
+		return err
+	}
+
+	if _, err := sdk.AccAddressFromBech32(m.TraderAddress); err != nil {
+		return fmt.Errorf("invalid trader address: %w", err)
+	}
+
+	if _, err := sdk.AccAddressFromBech32(m.InitiatorAddress); err != nil {
+		return fmt.Errorf("invalid initiator address: %w", err)
+	}
+
+	if m.StartBlockHeight < 0 {
+		return fmt.Errorf("invalid start block height %d", m.StartBlockHeight)
+	}
+
+	return nil
+}
+
+// AuctionDiscount returns the discount to the index price of the auction at
+// the block height. It grows by the discount per block of the market up to
+// its max discount.
+func (market Market) AuctionDiscount(auction LiquidationAuction, blockHeight int64) sdk.Dec {
+	elapsedBlocks := blockHeight - auction.StartBlockHeight
+	if elapsedBlocks <= 0 {
+		return sdk.ZeroDec()
+	}
+	return sdk.MinDec(market.AuctionDiscountPerBlock.MulInt64(elapsedBlocks), market.AuctionMaxDiscount)
+}
+
+// IsAuctionExpired returns whether the auction has run for the auction
+// duration of the market, or whether the market left AUCTION liquidation
+// mode.
+func (market Market) IsAuctionExpired(auction LiquidationAuction, blockHeight int64) bool {
+	if market.LiquidationMode != LiquidationMode_AUCTION {
+		return true
+	}
+	return blockHeight-auction.StartBlockHeight >= int64(market.AuctionDurationBlocks)
+}
+
+// AuctionPrice returns the price at which an auctioned position is taken
+// over: long positions are sold below the index price and short positions
+// above it.
+func AuctionPrice(indexPrice sdk.Dec, discount sdk.Dec, positionSize sdk.Dec) sdk.Dec {
+	if positionSize.IsNegative() {
+		return indexPrice.Mul(sdk.OneDec().Add(discount))
+	}
+	return indexPrice.Mul(sdk.OneDec().Sub(discount))
+}
@@ -0,0 +1,82 @@
+package v2_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/NibiruChain/nibiru/x/common/testutil/mock"
+	v2 "github.com/NibiruChain/nibiru/x/perp/types/v2"
+)
+
+func TestAuctionDiscount(t *testing.T) {
+	market := v2.Market{
+		LiquidationMode:         v2.LiquidationMode_AUCTION,
+		AuctionDiscountPerBlock: sdk.MustNewDecFromStr("0.01"),
+		AuctionMaxDiscount:      sdk.MustNewDecFromStr("0.05"),
+		AuctionDurationBlocks:   10,
+	}
+	auction := v2.LiquidationAuction{StartBlockHeight: 100}
+
+	tests := []struct {
+		name             string
+		blockHeight      int64
+		expectedDiscount sdk.Dec
+		expectedExpired  bool
+	}{
+		{"start block", 100, sdk.ZeroDec(), false},
+		{"after one block", 101, sdk.MustNewDecFromStr("0.01"), false},
+		{"after three blocks", 103, sdk.MustNewDecFromStr("0.03"), false},
+		{"capped at the max discount", 109, sdk.MustNewDecFromStr("0.05"), false},
+		{"expired", 110, sdk.MustNewDecFromStr("0.05"), true},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			assert.EqualValues(t, tc.expectedDiscount, market.AuctionDiscount(auction, tc.blockHeight))
+			assert.EqualValues(t, tc.expectedExpired, market.IsAuctionExpired(auction, tc.blockHeight))
+		})
+	}
+
+	market.LiquidationMode = v2.LiquidationMode_INSTANT
+	assert.True(t, market.IsAuctionExpired(auction, 101))
+}
+
+func TestAuctionPrice(t *testing.T) {
+	discount := sdk.MustNewDecFromStr("0.02")
+
+	assert.EqualValues(t, sdk.NewDec(98), v2.AuctionPrice(sdk.NewDec(100), discount, sdk.OneDec()))
+	assert.EqualValues(t, sdk.NewDec(102), v2.AuctionPrice(sdk.NewDec(100), discount, sdk.NewDec(-1)))
+	assert.EqualValues(t, sdk.NewDec(100), v2.AuctionPrice(sdk.NewDec(100), sdk.ZeroDec(), sdk.NewDec(-1)))
+}
+
+func TestMarketValidateLiquidationMode(t *testing.T) {
+	tests := []struct {
+		name             string
+		discountPerBlock sdk.Dec
+		maxDiscount      sdk.Dec
+		durationBlocks   uint64
+		expectErr        bool
+	}{
+		{"valid", sdk.MustNewDecFromStr("0.01"), sdk.MustNewDecFromStr("0.05"), 10, false},
+		{"zero discount per block", sdk.ZeroDec(), sdk.MustNewDecFromStr("0.05"), 10, true},
+		{"max discount of one", sdk.MustNewDecFromStr("0.01"), sdk.OneDec(), 10, true},
+		{"zero max discount", sdk.MustNewDecFromStr("0.01"), sdk.ZeroDec(), 10, true},
+		{"zero duration", sdk.MustNewDecFromStr("0.01"), sdk.MustNewDecFromStr("0.05"), 0, true},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			market := mock.TestMarket().WithLiquidationAuction(tc.discountPerBlock, tc.maxDiscount, tc.durationBlocks)
+			err := market.Validate()
+			if tc.expectErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
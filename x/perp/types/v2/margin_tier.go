@@ -0,0 +1,64 @@
+package v2
+
+import (
+	fmt "fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MarginTierTable returns the margin tiers of the market, starting with the
+// base tier made of the maintenance margin ratio and max leverage of the
+// market.
+func (market Market) MarginTierTable() []MarginTier {
+	table := []MarginTier{{
+		NotionalFloor:          sdk.ZeroDec(),
+		MaintenanceMarginRatio: market.MaintenanceMarginRatio,
+		MaxLeverage:            market.MaxLeverage,
+	}}
+	return append(table, market.MarginTiers...)
+}
+
+// MarginTierFor returns the margin tier a position with the given notional
+// falls into.
+func (market Market) MarginTierFor(positionNotional sdk.Dec) MarginTier {
+	table := market.MarginTierTable()
+	tier := table[0]
+	for _, t := range table[1:] {
+		if positionNotional.LT(t.NotionalFloor) {
+			break
+		}
+		tier = t
+	}
+	return tier
+}
+
+// validateMarginTiers checks that the margin tiers are sorted by notional
+// floor and that larger positions never get a lower maintenance margin ratio
+// or a higher max leverage than smaller ones.
+func validateMarginTiers(table []MarginTier) error {
+	for i := 1; i < len(table); i++ {
+		prev, tier := table[i-1], table[i]
+		if tier.NotionalFloor.IsNil() || tier.MaintenanceMarginRatio.IsNil() || tier.MaxLeverage.IsNil() {
+			return fmt.Errorf("margin tier %d has empty fields", i)
+		}
+
+		if !tier.NotionalFloor.GT(prev.NotionalFloor) {
+			return fmt.Errorf("margin tier %d notional floor %s must be above %s", i, tier.NotionalFloor, prev.NotionalFloor)
+		}
+
+		if !isPercent(tier.MaintenanceMarginRatio) || tier.MaintenanceMarginRatio.LT(prev.MaintenanceMarginRatio) {
+			return fmt.Errorf("margin tier %d maintenance margin ratio %s must be between %s and 1",
+				i, tier.MaintenanceMarginRatio, prev.MaintenanceMarginRatio)
+		}
+
+		if !tier.MaxLeverage.IsPositive() || tier.MaxLeverage.GT(prev.MaxLeverage) {
+			return fmt.Errorf("margin tier %d max leverage %s must be between 0 and %s", i, tier.MaxLeverage, prev.MaxLeverage)
+		}
+
+		if sdk.OneDec().Quo(tier.MaxLeverage).LT(tier.MaintenanceMarginRatio) {
+			return fmt.Errorf("margin tier %d: margin ratio opened with max leverage position will be lower than maintenance margin ratio", i)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,72 @@
+package v2
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func tieredMarket() Market {
+	return Market{
+		MaintenanceMarginRatio: sdk.MustNewDecFromStr("0.0625"),
+		MaxLeverage:            sdk.NewDec(10),
+		MarginTiers: []MarginTier{
+			{NotionalFloor: sdk.NewDec(1000), MaintenanceMarginRatio: sdk.MustNewDecFromStr("0.1"), MaxLeverage: sdk.NewDec(5)},
+			{NotionalFloor: sdk.NewDec(5000), MaintenanceMarginRatio: sdk.MustNewDecFromStr("0.2"), MaxLeverage: sdk.NewDec(2)},
+		},
+	}
+}
+
+func TestMarginTierFor(t *testing.T) {
+	market := tieredMarket()
+
+	tests := []struct {
+		name                           string
+		positionNotional               sdk.Dec
+		expectedMaintenanceMarginRatio sdk.Dec
+		expectedMaxLeverage            sdk.Dec
+	}{
+		{"below the first tier", sdk.NewDec(999), sdk.MustNewDecFromStr("0.0625"), sdk.NewDec(10)},
+		{"at the first tier floor", sdk.NewDec(1000), sdk.MustNewDecFromStr("0.1"), sdk.NewDec(5)},
+		{"between tiers", sdk.NewDec(4999), sdk.MustNewDecFromStr("0.1"), sdk.NewDec(5)},
+		{"above the last tier floor", sdk.NewDec(1_000_000), sdk.MustNewDecFromStr("0.2"), sdk.NewDec(2)},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			tier := market.MarginTierFor(tc.positionNotional)
+			require.Equal(t, tc.expectedMaintenanceMarginRatio, tier.MaintenanceMarginRatio)
+			require.Equal(t, tc.expectedMaxLeverage, tier.MaxLeverage)
+		})
+	}
+
+	t.Run("no tiers", func(t *testing.T) {
+		market := Market{MaintenanceMarginRatio: sdk.MustNewDecFromStr("0.0625"), MaxLeverage: sdk.NewDec(10)}
+		require.Len(t, market.MarginTierTable(), 1)
+		require.Equal(t, sdk.NewDec(10), market.MarginTierFor(sdk.NewDec(1_000_000)).MaxLeverage)
+	})
+}
+
+func TestValidateMarginTiers(t *testing.T) {
+	require.NoError(t, validateMarginTiers(tieredMarket().MarginTierTable()))
+
+	tests := []struct {
+		name   string
+		modify func(tiers []MarginTier)
+	}{
+		{"notional floor not above the previous one", func(tiers []MarginTier) { tiers[1].NotionalFloor = sdk.NewDec(1000) }},
+		{"lower maintenance margin ratio than the previous tier", func(tiers []MarginTier) { tiers[1].MaintenanceMarginRatio = sdk.MustNewDecFromStr("0.05") }},
+		{"higher max leverage than the previous tier", func(tiers []MarginTier) { tiers[1].MaxLeverage = sdk.NewDec(8) }},
+		{"max leverage opens below the maintenance margin ratio", func(tiers []MarginTier) { tiers[1].MaintenanceMarginRatio = sdk.MustNewDecFromStr("0.6") }},
+		{"empty fields", func(tiers []MarginTier) { tiers[1].MaxLeverage = sdk.Dec{} }},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			market := tieredMarket()
+			tc.modify(market.MarginTiers)
+			require.Error(t, validateMarginTiers(market.MarginTierTable()))
+		})
+	}
+}
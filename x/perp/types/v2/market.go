@@ -37,6 +37,54 @@ func (market *Market) Validate() error {
 		return fmt.Errorf("partial liquidation ratio must be 0 <= ratio <= 1")
 	}
 
+	if market.InsuranceFundFeeShare.IsNil() || !isPercent(market.InsuranceFundFeeShare) {
+		return fmt.Errorf("insurance fund fee share must be 0 <= share <= 1")
+	}
+
+	if market.MaxFundingRate.IsNil() || market.MinFundingRate.IsNil() {
+		return fmt.Errorf("max and min funding rates must be set")
+	}
+
+	if market.MinFundingRate.GT(market.MaxFundingRate) {
+		return fmt.Errorf("min funding rate %s must be <= max funding rate %s", market.MinFundingRate, market.MaxFundingRate)
+	}
+
+	if market.InterestRate.IsNil() {
+		return fmt.Errorf("interest rate must be set")
+	}
+
+	if market.MaxSpreadRatio.IsNil() || market.MaxSpreadRatio.IsNegative() {
+		return fmt.Errorf("max spread ratio must be >= 0")
+	}
+
+	if market.MaxSkewRatio.IsNil() || !isPercent(market.MaxSkewRatio) {
+		return fmt.Errorf("max skew ratio must be 0 <= ratio <= 1")
+	}
+
+	if market.AmmUpdateBudget.IsNil() || market.AmmUpdateBudget.IsNegative() {
+		return fmt.Errorf("amm update budget must be >= 0")
+	}
+
+	if market.CircuitBreakerPriceChangeRatio.IsNil() || market.CircuitBreakerPriceChangeRatio.IsNegative() {
+		return fmt.Errorf("circuit breaker price change ratio must be >= 0")
+	}
+
+	if market.CircuitBreakerPriceChangeRatio.IsPositive() && market.CircuitBreakerWindowBlocks == 0 {
+		return fmt.Errorf("circuit breaker window blocks must be > 0 when the price change ratio is set")
+	}
+
+	if market.MaxOpenInterest.IsNil() || market.MaxOpenInterest.IsNegative() {
+		return fmt.Errorf("max open interest must be >= 0")
+	}
+
+	if market.MaxPositionNotional.IsNil() || market.MaxPositionNotional.IsNegative() {
+		return fmt.Errorf("max position notional must be >= 0")
+	}
+
+	if err := market.validateLiquidationMode(); err != nil {
+		return err
+	}
+
 	if market.MaxLeverage.LTE(sdk.ZeroDec()) {
 		return fmt.Errorf("max leverage must be > 0")
 	}
@@ -45,9 +93,60 @@ func (market *Market) Validate() error {
 		return fmt.Errorf("margin ratio opened with max leverage position will be lower than Maintenance margin ratio")
 	}
 
+	if err := validateMarginTiers(market.MarginTierTable()); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateLiquidationMode checks the auction parameters of markets in AUCTION
+// liquidation mode. They're unused, and can be unset, in INSTANT mode.
+func (market *Market) validateLiquidationMode() error {
+	switch market.LiquidationMode {
+	case LiquidationMode_INSTANT:
+		return nil
+	case LiquidationMode_AUCTION:
+	default:
+		return fmt.Errorf("invalid liquidation mode %d", market.LiquidationMode)
+	}
+
+	if market.AuctionDiscountPerBlock.IsNil() || !market.AuctionDiscountPerBlock.IsPositive() {
+		return fmt.Errorf("auction discount per block must be > 0 in auction liquidation mode")
+	}
+
+	if market.AuctionMaxDiscount.IsNil() || !market.AuctionMaxDiscount.IsPositive() || market.AuctionMaxDiscount.GTE(sdk.OneDec()) {
+		return fmt.Errorf("auction max discount must be 0 < discount < 1 in auction liquidation mode")
+	}
+
+	if market.AuctionDurationBlocks == 0 {
+		return fmt.Errorf("auction duration blocks must be > 0 in auction liquidation mode")
+	}
+
 	return nil
 }
 
+// ClampFundingRate bounds the funding rate to the min and max funding rates of
+// the market, unless both are zero.
+//
+// returns:
+//   - fundingRate: the bounded funding rate
+//   - clamped: whether the funding rate was out of bounds
+func (market Market) ClampFundingRate(fundingRate sdk.Dec) (sdk.Dec, bool) {
+	if market.MaxFundingRate.IsZero() && market.MinFundingRate.IsZero() {
+		return fundingRate, false
+	}
+
+	if fundingRate.GT(market.MaxFundingRate) {
+		return market.MaxFundingRate, true
+	}
+	if fundingRate.LT(market.MinFundingRate) {
+		return market.MinFundingRate, true
+	}
+
+	return fundingRate, false
+}
+
 func (market *Market) WithPriceFluctuationLimitRatio(value sdk.Dec) *Market {
 	market.PriceFluctuationLimitRatio = value
 	return market
@@ -78,6 +177,76 @@ func (market *Market) WithLiquidationFee(value sdk.Dec) *Market {
 	return market
 }
 
+func (market *Market) WithInsuranceFundFeeShare(value sdk.Dec) *Market {
+	market.InsuranceFundFeeShare = value
+	return market
+}
+
+func (market *Market) WithAutoDeleveragingEnabled(value bool) *Market {
+	market.AutoDeleveragingEnabled = value
+	return market
+}
+
+func (market *Market) WithFundingRateBounds(min sdk.Dec, max sdk.Dec) *Market {
+	market.MinFundingRate = min
+	market.MaxFundingRate = max
+	return market
+}
+
+func (market *Market) WithInterestRate(value sdk.Dec) *Market {
+	market.InterestRate = value
+	return market
+}
+
+func (market *Market) WithMaxSpreadRatio(value sdk.Dec) *Market {
+	market.MaxSpreadRatio = value
+	return market
+}
+
+func (market *Market) WithMaxSkewRatio(value sdk.Dec) *Market {
+	market.MaxSkewRatio = value
+	return market
+}
+
+func (market *Market) WithAmmUpdateBudget(value sdk.Int) *Market {
+	market.AmmUpdateBudget = value
+	return market
+}
+
+func (market *Market) WithCircuitBreakerPriceChange(ratio sdk.Dec, windowBlocks uint64) *Market {
+	market.CircuitBreakerPriceChangeRatio = ratio
+	market.CircuitBreakerWindowBlocks = windowBlocks
+	return market
+}
+
+func (market *Market) WithCircuitBreakerMaxFluctuationViolations(value uint64) *Market {
+	market.CircuitBreakerMaxFluctuationViolations = value
+	return market
+}
+
+func (market *Market) WithMaxOpenInterest(value sdk.Dec) *Market {
+	market.MaxOpenInterest = value
+	return market
+}
+
+func (market *Market) WithMaxPositionNotional(value sdk.Dec) *Market {
+	market.MaxPositionNotional = value
+	return market
+}
+
+func (market *Market) WithMarginTiers(tiers ...MarginTier) *Market {
+	market.MarginTiers = tiers
+	return market
+}
+
+func (market *Market) WithLiquidationAuction(discountPerBlock sdk.Dec, maxDiscount sdk.Dec, durationBlocks uint64) *Market {
+	market.LiquidationMode = LiquidationMode_AUCTION
+	market.AuctionDiscountPerBlock = discountPerBlock
+	market.AuctionMaxDiscount = maxDiscount
+	market.AuctionDurationBlocks = durationBlocks
+	return market
+}
+
 func (market *Market) WithPartialLiquidationRatio(value sdk.Dec) *Market {
 	market.PartialLiquidationRatio = value
 	return market
@@ -131,6 +300,86 @@ func MarketsAreEqual(expected, actual *Market) error {
 		return fmt.Errorf("expected market partial liquidation ratio %s, got %s", expected.PartialLiquidationRatio, actual.PartialLiquidationRatio)
 	}
 
+	if !expected.InsuranceFundFeeShare.Equal(actual.InsuranceFundFeeShare) {
+		return fmt.Errorf("expected market insurance fund fee share %s, got %s", expected.InsuranceFundFeeShare, actual.InsuranceFundFeeShare)
+	}
+
+	if expected.AutoDeleveragingEnabled != actual.AutoDeleveragingEnabled {
+		return fmt.Errorf("expected market auto-deleveraging enabled %t, got %t", expected.AutoDeleveragingEnabled, actual.AutoDeleveragingEnabled)
+	}
+
+	if !expected.MaxFundingRate.Equal(actual.MaxFundingRate) {
+		return fmt.Errorf("expected market max funding rate %s, got %s", expected.MaxFundingRate, actual.MaxFundingRate)
+	}
+
+	if !expected.MinFundingRate.Equal(actual.MinFundingRate) {
+		return fmt.Errorf("expected market min funding rate %s, got %s", expected.MinFundingRate, actual.MinFundingRate)
+	}
+
+	if !expected.InterestRate.Equal(actual.InterestRate) {
+		return fmt.Errorf("expected market interest rate %s, got %s", expected.InterestRate, actual.InterestRate)
+	}
+
+	if !expected.MaxSpreadRatio.Equal(actual.MaxSpreadRatio) {
+		return fmt.Errorf("expected market max spread ratio %s, got %s", expected.MaxSpreadRatio, actual.MaxSpreadRatio)
+	}
+
+	if !expected.MaxSkewRatio.Equal(actual.MaxSkewRatio) {
+		return fmt.Errorf("expected market max skew ratio %s, got %s", expected.MaxSkewRatio, actual.MaxSkewRatio)
+	}
+
+	if !expected.AmmUpdateBudget.Equal(actual.AmmUpdateBudget) {
+		return fmt.Errorf("expected market amm update budget %s, got %s", expected.AmmUpdateBudget, actual.AmmUpdateBudget)
+	}
+
+	if !expected.CircuitBreakerPriceChangeRatio.Equal(actual.CircuitBreakerPriceChangeRatio) {
+		return fmt.Errorf("expected market circuit breaker price change ratio %s, got %s", expected.CircuitBreakerPriceChangeRatio, actual.CircuitBreakerPriceChangeRatio)
+	}
+
+	if expected.CircuitBreakerWindowBlocks != actual.CircuitBreakerWindowBlocks {
+		return fmt.Errorf("expected market circuit breaker window blocks %d, got %d", expected.CircuitBreakerWindowBlocks, actual.CircuitBreakerWindowBlocks)
+	}
+
+	if expected.CircuitBreakerMaxFluctuationViolations != actual.CircuitBreakerMaxFluctuationViolations {
+		return fmt.Errorf("expected market circuit breaker max fluctuation violations %d, got %d", expected.CircuitBreakerMaxFluctuationViolations, actual.CircuitBreakerMaxFluctuationViolations)
+	}
+
+	if !expected.MaxOpenInterest.Equal(actual.MaxOpenInterest) {
+		return fmt.Errorf("expected market max open interest %s, got %s", expected.MaxOpenInterest, actual.MaxOpenInterest)
+	}
+
+	if !expected.MaxPositionNotional.Equal(actual.MaxPositionNotional) {
+		return fmt.Errorf("expected market max position notional %s, got %s", expected.MaxPositionNotional, actual.MaxPositionNotional)
+	}
+
+	if len(expected.MarginTiers) != len(actual.MarginTiers) {
+		return fmt.Errorf("expected %d market margin tiers, got %d", len(expected.MarginTiers), len(actual.MarginTiers))
+	}
+	for i := range expected.MarginTiers {
+		e, a := expected.MarginTiers[i], actual.MarginTiers[i]
+		if !e.NotionalFloor.Equal(a.NotionalFloor) ||
+			!e.MaintenanceMarginRatio.Equal(a.MaintenanceMarginRatio) ||
+			!e.MaxLeverage.Equal(a.MaxLeverage) {
+			return fmt.Errorf("expected market margin tier %s, got %s", &e, &a)
+		}
+	}
+
+	if expected.LiquidationMode != actual.LiquidationMode {
+		return fmt.Errorf("expected market liquidation mode %s, got %s", expected.LiquidationMode, actual.LiquidationMode)
+	}
+
+	if !decsAreEqual(expected.AuctionDiscountPerBlock, actual.AuctionDiscountPerBlock) {
+		return fmt.Errorf("expected market auction discount per block %s, got %s", expected.AuctionDiscountPerBlock, actual.AuctionDiscountPerBlock)
+	}
+
+	if !decsAreEqual(expected.AuctionMaxDiscount, actual.AuctionMaxDiscount) {
+		return fmt.Errorf("expected market auction max discount %s, got %s", expected.AuctionMaxDiscount, actual.AuctionMaxDiscount)
+	}
+
+	if expected.AuctionDurationBlocks != actual.AuctionDurationBlocks {
+		return fmt.Errorf("expected market auction duration blocks %d, got %d", expected.AuctionDurationBlocks, actual.AuctionDurationBlocks)
+	}
+
 	if expected.FundingRateEpochId != actual.FundingRateEpochId {
 		return fmt.Errorf("expected market funding rate epoch id %s, got %s", expected.FundingRateEpochId, actual.FundingRateEpochId)
 	}
@@ -157,3 +406,14 @@ func MarketsAreEqual(expected, actual *Market) error {
 
 	return nil
 }
+
+// decsAreEqual compares two decs, an unset dec being equal to zero.
+func decsAreEqual(a, b sdk.Dec) bool {
+	if a.IsNil() {
+		a = sdk.ZeroDec()
+	}
+	if b.IsNil() {
+		b = sdk.ZeroDec()
+	}
+	return a.Equal(b)
+}
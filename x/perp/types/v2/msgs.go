@@ -12,10 +12,15 @@ var _ sdk.Msg = &MsgAddMargin{}
 var _ sdk.Msg = &MsgOpenPosition{}
 var _ sdk.Msg = &MsgClosePosition{}
 var _ sdk.Msg = &MsgMultiLiquidate{}
+var _ sdk.Msg = &MsgPlaceTriggerOrder{}
+var _ sdk.Msg = &MsgCancelTriggerOrder{}
+var _ sdk.Msg = &MsgSetMarginMode{}
+var _ sdk.Msg = &MsgRegisterReferralCode{}
+var _ sdk.Msg = &MsgBindReferralCode{}
 
 // MsgRemoveMargin
 
-func (m MsgRemoveMargin) Route() string { return "perp" }
+func (m MsgRemoveMargin) Route() string { return RouterKey }
 func (m MsgRemoveMargin) Type() string  { return "remove_margin_msg" }
 
 func (m MsgRemoveMargin) ValidateBasic() error {
@@ -53,7 +58,7 @@ func (m MsgRemoveMargin) GetSigners() []sdk.AccAddress {
 
 // MsgAddMargin
 
-func (m MsgAddMargin) Route() string { return "perp" }
+func (m MsgAddMargin) Route() string { return RouterKey }
 func (m MsgAddMargin) Type() string  { return "add_margin_msg" }
 
 func (m MsgAddMargin) ValidateBasic() error {
@@ -91,7 +96,7 @@ func (m MsgAddMargin) GetSigners() []sdk.AccAddress {
 
 // MsgOpenPosition
 
-func (m MsgOpenPosition) Route() string { return "perp" }
+func (m MsgOpenPosition) Route() string { return RouterKey }
 func (m MsgOpenPosition) Type() string  { return "open_position_msg" }
 
 func (m *MsgOpenPosition) ValidateBasic() error {
@@ -160,7 +165,7 @@ func (m *MsgMultiLiquidate) GetSigners() []sdk.AccAddress {
 
 // MsgClosePosition
 
-func (m MsgClosePosition) Route() string { return "perp" }
+func (m MsgClosePosition) Route() string { return RouterKey }
 func (m MsgClosePosition) Type() string  { return "close_position_msg" }
 
 func (m MsgClosePosition) ValidateBasic() error {
@@ -187,7 +192,7 @@ func (m MsgClosePosition) GetSigners() []sdk.AccAddress {
 
 // MsgDonateToEcosystemFund
 
-func (m MsgDonateToEcosystemFund) Route() string { return "perp" }
+func (m MsgDonateToEcosystemFund) Route() string { return RouterKey }
 func (m MsgDonateToEcosystemFund) Type() string  { return "donate_to_ef_msg" }
 
 func (m MsgDonateToEcosystemFund) ValidateBasic() error {
@@ -211,3 +216,203 @@ func (m MsgDonateToEcosystemFund) GetSigners() []sdk.AccAddress {
 	}
 	return []sdk.AccAddress{signer}
 }
+
+// MsgPlaceTriggerOrder
+
+func (m MsgPlaceTriggerOrder) Route() string { return RouterKey }
+func (m MsgPlaceTriggerOrder) Type() string  { return "place_trigger_order_msg" }
+
+func (m MsgPlaceTriggerOrder) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address (%s)", err)
+	}
+	if err := m.Pair.Validate(); err != nil {
+		return err
+	}
+	if m.TriggerPrice.IsNil() || !m.TriggerPrice.IsPositive() {
+		return fmt.Errorf("trigger price must be positive")
+	}
+	if m.PriceSource != TriggerPriceSource_MARK && m.PriceSource != TriggerPriceSource_INDEX {
+		return fmt.Errorf("invalid price source")
+	}
+
+	switch m.OrderType {
+	case TriggerOrderType_LIMIT_OPEN:
+		if m.Side != Direction_SHORT && m.Side != Direction_LONG {
+			return fmt.Errorf("invalid side")
+		}
+		if m.Collateral.Amount.IsNil() || !m.Collateral.Amount.IsPositive() {
+			return fmt.Errorf("collateral must be positive")
+		}
+		if m.Collateral.Denom != m.Pair.QuoteDenom() {
+			return fmt.Errorf("invalid collateral denom, expected %s, got %s", m.Pair.QuoteDenom(), m.Collateral.Denom)
+		}
+		if m.Leverage.IsNil() || !m.Leverage.IsPositive() {
+			return fmt.Errorf("leverage must always be greater than zero")
+		}
+		if m.BaseAssetAmountLimit.IsNil() || m.BaseAssetAmountLimit.IsNegative() {
+			return fmt.Errorf("base asset amount limit must not be negative")
+		}
+	case TriggerOrderType_STOP_LOSS, TriggerOrderType_TAKE_PROFIT:
+		if !m.Collateral.Amount.IsNil() && !m.Collateral.Amount.IsZero() {
+			return fmt.Errorf("close orders do not take collateral")
+		}
+	default:
+		return fmt.Errorf("invalid order type")
+	}
+
+	return nil
+}
+
+func (m MsgPlaceTriggerOrder) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgPlaceTriggerOrder) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{signer}
+}
+
+// MsgCancelTriggerOrder
+
+func (m MsgCancelTriggerOrder) Route() string { return RouterKey }
+func (m MsgCancelTriggerOrder) Type() string  { return "cancel_trigger_order_msg" }
+
+func (m MsgCancelTriggerOrder) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address (%s)", err)
+	}
+	if err := m.Pair.Validate(); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m MsgCancelTriggerOrder) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgCancelTriggerOrder) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{signer}
+}
+
+// MsgSetMarginMode
+
+func (m MsgSetMarginMode) Route() string { return RouterKey }
+func (m MsgSetMarginMode) Type() string  { return "set_margin_mode_msg" }
+
+func (m MsgSetMarginMode) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address (%s)", err)
+	}
+	return nil
+}
+
+func (m MsgSetMarginMode) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgSetMarginMode) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{signer}
+}
+
+// MsgRegisterReferralCode
+
+func (m MsgRegisterReferralCode) Route() string { return RouterKey }
+func (m MsgRegisterReferralCode) Type() string  { return "register_referral_code_msg" }
+
+func (m MsgRegisterReferralCode) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address (%s)", err)
+	}
+	return ValidateReferralCode(m.Code)
+}
+
+func (m MsgRegisterReferralCode) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgRegisterReferralCode) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{signer}
+}
+
+// MsgBindReferralCode
+
+func (m MsgBindReferralCode) Route() string { return RouterKey }
+func (m MsgBindReferralCode) Type() string  { return "bind_referral_code_msg" }
+
+func (m MsgBindReferralCode) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address (%s)", err)
+	}
+	return ValidateReferralCode(m.Code)
+}
+
+func (m MsgBindReferralCode) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgBindReferralCode) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{signer}
+}
+
+// MsgBidLiquidationAuction
+
+func (m MsgBidLiquidationAuction) Route() string { return RouterKey }
+func (m MsgBidLiquidationAuction) Type() string  { return "bid_liquidation_auction_msg" }
+
+func (m MsgBidLiquidationAuction) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address (%s)", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(m.Trader); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid trader address (%s)", err)
+	}
+	if m.Sender == m.Trader {
+		return fmt.Errorf("cannot bid on the auction of your own position")
+	}
+	if err := m.Pair.Validate(); err != nil {
+		return err
+	}
+	if m.Size_.IsNil() || m.Size_.IsNegative() {
+		return fmt.Errorf("size must not be negative")
+	}
+	if m.Margin.Amount.IsNil() || !m.Margin.Amount.IsPositive() {
+		return fmt.Errorf("margin must be positive")
+	}
+	if m.Margin.Denom != m.Pair.QuoteDenom() {
+		return fmt.Errorf("invalid margin denom, expected %s, got %s", m.Pair.QuoteDenom(), m.Margin.Denom)
+	}
+	return nil
+}
+
+func (m MsgBidLiquidationAuction) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgBidLiquidationAuction) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{signer}
+}
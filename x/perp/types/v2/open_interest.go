@@ -0,0 +1,56 @@
+package v2
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/NibiruChain/nibiru/x/common/asset"
+)
+
+// ZeroOpenInterest returns the open interest of a market without positions.
+func ZeroOpenInterest(pair asset.Pair) OpenInterest {
+	return OpenInterest{
+		Pair:  pair,
+		Long:  sdk.ZeroDec(),
+		Short: sdk.ZeroDec(),
+	}
+}
+
+// Update returns the open interest after a position changes size from
+// oldSize to newSize. Positive sizes are long, negative sizes are short.
+func (oi OpenInterest) Update(oldSize sdk.Dec, newSize sdk.Dec) OpenInterest {
+	if oldSize.IsPositive() {
+		oi.Long = oi.Long.Sub(oldSize)
+	} else {
+		oi.Short = oi.Short.Add(oldSize)
+	}
+
+	if newSize.IsPositive() {
+		oi.Long = oi.Long.Add(newSize)
+	} else {
+		oi.Short = oi.Short.Sub(newSize)
+	}
+
+	return oi
+}
+
+// CheckCap returns an error if a side of the open interest went up and is
+// over the max open interest. A side that goes down is always allowed, even
+// over the cap, so that traders can reduce their positions after the cap is
+// lowered. A zero max open interest disables the cap.
+func (oi OpenInterest) CheckCap(before OpenInterest, maxOpenInterest sdk.Dec) error {
+	if !maxOpenInterest.IsPositive() {
+		return nil
+	}
+
+	if oi.Long.GT(before.Long) && oi.Long.GT(maxOpenInterest) {
+		return ErrOpenInterestCapExceeded.Wrapf(
+			"long open interest %s, max open interest %s", oi.Long, maxOpenInterest)
+	}
+
+	if oi.Short.GT(before.Short) && oi.Short.GT(maxOpenInterest) {
+		return ErrOpenInterestCapExceeded.Wrapf(
+			"short open interest %s, max open interest %s", oi.Short, maxOpenInterest)
+	}
+
+	return nil
+}
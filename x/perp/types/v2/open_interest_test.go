@@ -0,0 +1,70 @@
+package v2
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/NibiruChain/nibiru/x/common/asset"
+	"github.com/NibiruChain/nibiru/x/common/denoms"
+)
+
+func TestOpenInterestUpdate(t *testing.T) {
+	pair := asset.Registry.Pair(denoms.BTC, denoms.NUSD)
+
+	tests := []struct {
+		name          string
+		oldSize       sdk.Dec
+		newSize       sdk.Dec
+		expectedLong  sdk.Dec
+		expectedShort sdk.Dec
+	}{
+		{"open long", sdk.ZeroDec(), sdk.NewDec(5), sdk.NewDec(15), sdk.NewDec(20)},
+		{"open short", sdk.ZeroDec(), sdk.NewDec(-5), sdk.NewDec(10), sdk.NewDec(25)},
+		{"reduce long", sdk.NewDec(5), sdk.NewDec(2), sdk.NewDec(7), sdk.NewDec(20)},
+		{"close short", sdk.NewDec(-5), sdk.ZeroDec(), sdk.NewDec(10), sdk.NewDec(15)},
+		{"long to short", sdk.NewDec(5), sdk.NewDec(-3), sdk.NewDec(5), sdk.NewDec(23)},
+		{"short to long", sdk.NewDec(-5), sdk.NewDec(3), sdk.NewDec(13), sdk.NewDec(15)},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			oi := OpenInterest{Pair: pair, Long: sdk.NewDec(10), Short: sdk.NewDec(20)}.
+				Update(tc.oldSize, tc.newSize)
+			require.Equal(t, tc.expectedLong, oi.Long)
+			require.Equal(t, tc.expectedShort, oi.Short)
+		})
+	}
+}
+
+func TestOpenInterestCheckCap(t *testing.T) {
+	pair := asset.Registry.Pair(denoms.BTC, denoms.NUSD)
+	before := OpenInterest{Pair: pair, Long: sdk.NewDec(90), Short: sdk.NewDec(120)}
+
+	tests := []struct {
+		name            string
+		after           OpenInterest
+		maxOpenInterest sdk.Dec
+		expectErr       bool
+	}{
+		{"no cap", before.Update(sdk.ZeroDec(), sdk.NewDec(1000)), sdk.ZeroDec(), false},
+		{"long under the cap", before.Update(sdk.ZeroDec(), sdk.NewDec(10)), sdk.NewDec(100), false},
+		{"long over the cap", before.Update(sdk.ZeroDec(), sdk.NewDec(11)), sdk.NewDec(100), true},
+		{"short over the cap going up", before.Update(sdk.ZeroDec(), sdk.NewDec(-1)), sdk.NewDec(100), true},
+		{"short over the cap going down", before.Update(sdk.NewDec(-10), sdk.ZeroDec()), sdk.NewDec(100), false},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.after.CheckCap(before, tc.maxOpenInterest)
+			if tc.expectErr {
+				require.ErrorIs(t, err, ErrOpenInterestCapExceeded)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
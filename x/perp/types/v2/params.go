@@ -1,6 +1,10 @@
 package v2
 
 import (
+	fmt "fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 )
 
@@ -13,20 +17,229 @@ func ParamKeyTable() paramtypes.KeyTable {
 
 // ParamSetPairs get the params.ParamSet
 func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
-	return paramtypes.ParamSetPairs{}
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(
+			[]byte("Stopped"),
+			&p.Stopped,
+			validateStopped,
+		),
+		paramtypes.NewParamSetPair(
+			[]byte("FeeTiers"),
+			&p.FeeTiers,
+			validateFeeTiers,
+		),
+		paramtypes.NewParamSetPair(
+			[]byte("StakerFeeDiscountRatio"),
+			&p.StakerFeeDiscountRatio,
+			validateRatio,
+		),
+		paramtypes.NewParamSetPair(
+			[]byte("StakerMinBonded"),
+			&p.StakerMinBonded,
+			validateStakerMinBonded,
+		),
+		paramtypes.NewParamSetPair(
+			[]byte("ReferralRebateRatio"),
+			&p.ReferralRebateRatio,
+			validateRatio,
+		),
+		paramtypes.NewParamSetPair(
+			[]byte("TraderHistoryEnabled"),
+			&p.TraderHistoryEnabled,
+			validateTraderHistoryEnabled,
+		),
+		paramtypes.NewParamSetPair(
+			[]byte("TraderHistoryRetention"),
+			&p.TraderHistoryRetention,
+			validateTraderHistoryRetention,
+		),
+		paramtypes.NewParamSetPair(
+			[]byte("AuctionLiquidators"),
+			&p.AuctionLiquidators,
+			validateAuctionLiquidators,
+		),
+		paramtypes.NewParamSetPair(
+			[]byte("ReserveSnapshotRetention"),
+			&p.ReserveSnapshotRetention,
+			validateReserveSnapshotRetention,
+		),
+	}
 }
 
 // NewParams creates a new Params instance
-func NewParams() Params {
-	return Params{}
+func NewParams(
+	stopped bool,
+	feeTiers []FeeTier,
+	stakerFeeDiscountRatio sdk.Dec,
+	stakerMinBonded sdk.Int,
+	referralRebateRatio sdk.Dec,
+	traderHistoryEnabled bool,
+	traderHistoryRetention time.Duration,
+	auctionLiquidators []string,
+	reserveSnapshotRetention time.Duration,
+) Params {
+	return Params{
+		Stopped:                  stopped,
+		FeeTiers:                 feeTiers,
+		StakerFeeDiscountRatio:   stakerFeeDiscountRatio,
+		StakerMinBonded:          stakerMinBonded,
+		ReferralRebateRatio:      referralRebateRatio,
+		TraderHistoryEnabled:     traderHistoryEnabled,
+		TraderHistoryRetention:   traderHistoryRetention,
+		AuctionLiquidators:       auctionLiquidators,
+		ReserveSnapshotRetention: reserveSnapshotRetention,
+	}
 }
 
 // DefaultParams returns the default parameters for the x/perp module.
 func DefaultParams() Params {
-	return NewParams()
+	return NewParams(
+		/* stopped */ false,
+		/* feeTiers */ []FeeTier{},
+		/* stakerFeeDiscountRatio */ sdk.ZeroDec(),
+		/* stakerMinBonded */ sdk.ZeroInt(),
+		/* referralRebateRatio */ sdk.ZeroDec(),
+		/* traderHistoryEnabled */ false,
+		/* traderHistoryRetention */ 30*24*time.Hour,
+		/* auctionLiquidators */ []string{},
+		/* reserveSnapshotRetention */ 24*time.Hour,
+	)
 }
 
 // Validate validates the set of params
 func (p *Params) Validate() error {
+	if err := validateStopped(p.Stopped); err != nil {
+		return err
+	}
+	if err := validateFeeTiers(p.FeeTiers); err != nil {
+		return err
+	}
+	if err := validateRatio(p.StakerFeeDiscountRatio); err != nil {
+		return err
+	}
+	if err := validateStakerMinBonded(p.StakerMinBonded); err != nil {
+		return err
+	}
+	if err := validateRatio(p.ReferralRebateRatio); err != nil {
+		return err
+	}
+	if err := validateTraderHistoryEnabled(p.TraderHistoryEnabled); err != nil {
+		return err
+	}
+	if err := validateTraderHistoryRetention(p.TraderHistoryRetention); err != nil {
+		return err
+	}
+	if err := validateAuctionLiquidators(p.AuctionLiquidators); err != nil {
+		return err
+	}
+	return validateReserveSnapshotRetention(p.ReserveSnapshotRetention)
+}
+
+func validateStopped(i interface{}) error {
+	_, ok := i.(bool)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validateTraderHistoryEnabled(i interface{}) error {
+	_, ok := i.(bool)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validateTraderHistoryRetention(i interface{}) error {
+	retention, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if retention < 0 {
+		return fmt.Errorf("trader history retention must be >= 0, not %s", retention)
+	}
+	return nil
+}
+
+func validateReserveSnapshotRetention(i interface{}) error {
+	retention, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if retention < 0 {
+		return fmt.Errorf("reserve snapshot retention must be >= 0, not %s", retention)
+	}
+	return nil
+}
+
+func validateAuctionLiquidators(i interface{}) error {
+	liquidators, ok := i.([]string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	seen := make(map[string]struct{}, len(liquidators))
+	for _, liquidator := range liquidators {
+		if _, err := sdk.AccAddressFromBech32(liquidator); err != nil {
+			return fmt.Errorf("invalid auction liquidator %s: %w", liquidator, err)
+		}
+		if _, exists := seen[liquidator]; exists {
+			return fmt.Errorf("duplicate auction liquidator %s", liquidator)
+		}
+		seen[liquidator] = struct{}{}
+	}
+	return nil
+}
+
+func validateRatio(i interface{}) error {
+	ratio, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	// unset ratios, e.g. in genesis files exported before they existed, are stored as zero
+	if !ratio.IsNil() && !isPercent(ratio) {
+		return fmt.Errorf("ratio must be 0 <= ratio <= 1, not %s", ratio)
+	}
+	return nil
+}
+
+func validateStakerMinBonded(i interface{}) error {
+	minBonded, ok := i.(sdk.Int)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if !minBonded.IsNil() && minBonded.IsNegative() {
+		return fmt.Errorf("staker min bonded must be >= 0, not %s", minBonded)
+	}
+	return nil
+}
+
+// validateFeeTiers checks that the fee tiers are sorted by min volume and
+// that higher tiers never get a smaller discount than lower ones.
+func validateFeeTiers(i interface{}) error {
+	tiers, ok := i.([]FeeTier)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	for j, tier := range tiers {
+		if tier.MinVolume.IsNil() || tier.MinVolume.IsNegative() {
+			return fmt.Errorf("fee tier %d min volume must be >= 0", j)
+		}
+		if tier.FeeDiscountRatio.IsNil() || !isPercent(tier.FeeDiscountRatio) {
+			return fmt.Errorf("fee tier %d fee discount ratio must be 0 <= ratio <= 1", j)
+		}
+		if j == 0 {
+			continue
+		}
+		if !tier.MinVolume.GT(tiers[j-1].MinVolume) {
+			return fmt.Errorf("fee tier %d min volume %s must be above %s", j, tier.MinVolume, tiers[j-1].MinVolume)
+		}
+		if tier.FeeDiscountRatio.LT(tiers[j-1].FeeDiscountRatio) {
+			return fmt.Errorf("fee tier %d fee discount ratio %s must be at least %s",
+				j, tier.FeeDiscountRatio, tiers[j-1].FeeDiscountRatio)
+		}
+	}
 	return nil
 }
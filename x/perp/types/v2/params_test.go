@@ -0,0 +1,94 @@
+package v2_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	v2types "github.com/NibiruChain/nibiru/x/perp/types/v2"
+)
+
+func TestParamsValidate(t *testing.T) {
+	testCases := []struct {
+		name        string
+		modifier    func(params *v2types.Params)
+		expectedErr string
+	}{
+		{
+			name:     "default params",
+			modifier: func(params *v2types.Params) {},
+		},
+		{
+			name: "fee tiers not sorted by volume",
+			modifier: func(params *v2types.Params) {
+				params.FeeTiers = []v2types.FeeTier{
+					{MinVolume: sdk.NewDec(1000), FeeDiscountRatio: sdk.MustNewDecFromStr("0.1")},
+					{MinVolume: sdk.NewDec(100), FeeDiscountRatio: sdk.MustNewDecFromStr("0.2")},
+				}
+			},
+			expectedErr: "fee tier 1 min volume 100.000000000000000000 must be above 1000.000000000000000000",
+		},
+		{
+			name: "fee tier discount decreases",
+			modifier: func(params *v2types.Params) {
+				params.FeeTiers = []v2types.FeeTier{
+					{MinVolume: sdk.NewDec(100), FeeDiscountRatio: sdk.MustNewDecFromStr("0.2")},
+					{MinVolume: sdk.NewDec(1000), FeeDiscountRatio: sdk.MustNewDecFromStr("0.1")},
+				}
+			},
+			expectedErr: "fee tier 1 fee discount ratio 0.100000000000000000 must be at least 0.200000000000000000",
+		},
+		{
+			name: "fee tier discount above one",
+			modifier: func(params *v2types.Params) {
+				params.FeeTiers = []v2types.FeeTier{
+					{MinVolume: sdk.NewDec(100), FeeDiscountRatio: sdk.NewDec(2)},
+				}
+			},
+			expectedErr: "fee tier 0 fee discount ratio must be 0 <= ratio <= 1",
+		},
+		{
+			name: "negative staker min bonded",
+			modifier: func(params *v2types.Params) {
+				params.StakerMinBonded = sdk.NewInt(-1)
+			},
+			expectedErr: "staker min bonded must be >= 0, not -1",
+		},
+		{
+			name: "referral rebate ratio above one",
+			modifier: func(params *v2types.Params) {
+				params.ReferralRebateRatio = sdk.NewDec(2)
+			},
+			expectedErr: "ratio must be 0 <= ratio <= 1, not 2.000000000000000000",
+		},
+		{
+			name: "negative trader history retention",
+			modifier: func(params *v2types.Params) {
+				params.TraderHistoryRetention = -time.Hour
+			},
+			expectedErr: "trader history retention must be >= 0, not -1h0m0s",
+		},
+		{
+			name: "negative reserve snapshot retention",
+			modifier: func(params *v2types.Params) {
+				params.ReserveSnapshotRetention = -time.Hour
+			},
+			expectedErr: "reserve snapshot retention must be >= 0, not -1h0m0s",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			params := v2types.DefaultParams()
+			tc.modifier(&params)
+			err := params.Validate()
+			if tc.expectedErr == "" {
+				require.NoError(t, err)
+			} else {
+				require.EqualError(t, err, tc.expectedErr)
+			}
+		})
+	}
+}
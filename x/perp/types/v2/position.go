@@ -20,6 +20,41 @@ func ZeroPosition(ctx sdk.Context, tokenPair asset.Pair, traderAddr sdk.AccAddre
 	}
 }
 
+// Validate performs stateless validation of a position.
+func (m *Position) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(m.TraderAddress); err != nil {
+		return err
+	}
+
+	if err := m.Pair.Validate(); err != nil {
+		return err
+	}
+
+	if m.Size_.IsNil() || m.Size_.IsZero() {
+		return fmt.Errorf("zero size")
+	}
+
+	// cross-margined positions may be backed entirely by the margin of the
+	// trader's other positions
+	if m.Margin.IsNil() || m.Margin.IsNegative() {
+		return fmt.Errorf("margin < 0")
+	}
+
+	if m.OpenNotional.IsNil() || !m.OpenNotional.IsPositive() {
+		return fmt.Errorf("open notional <= 0")
+	}
+
+	if m.LatestCumulativePremiumFraction.IsNil() {
+		return fmt.Errorf("nil latest cumulative premium fraction")
+	}
+
+	if m.LastUpdatedBlockNumber < 0 {
+		return fmt.Errorf("invalid block number")
+	}
+
+	return nil
+}
+
 func PositionsAreEqual(expected, actual *Position) error {
 	if expected.Pair != actual.Pair {
 		return fmt.Errorf("expected position pair %s, got %s", expected.Pair, actual.Pair)
@@ -78,6 +113,8 @@ type PositionResp struct {
 	// The position's notional value after the position change, measured in quote
 	// units.
 	PositionNotional sdk.Dec
+	// The fee the trader paid on this position change, measured in quote units.
+	TransactionFee sdk.Int
 }
 
 type LiquidateResp struct {
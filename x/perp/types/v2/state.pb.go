@@ -97,7 +97,161 @@ func (TwapCalcOption) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_9a497e70afa7e7d6, []int{1}
 }
 
+// Enumerates the ways underwater positions are liquidated.
+type LiquidationMode int32
+
+const (
+	// The position is closed into the AMM right away.
+	LiquidationMode_INSTANT LiquidationMode = 0
+	// The position is put up for a Dutch auction, whose discount grows every
+	// block, and taken over by whitelisted liquidators.
+	LiquidationMode_AUCTION LiquidationMode = 1
+)
+
+var LiquidationMode_name = map[int32]string{
+	0: "INSTANT",
+	1: "AUCTION",
+}
+
+var LiquidationMode_value = map[string]int32{
+	"INSTANT": 0,
+	"AUCTION": 1,
+}
+
+func (x LiquidationMode) String() string {
+	return proto.EnumName(LiquidationMode_name, int32(x))
+}
+
+func (LiquidationMode) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_9a497e70afa7e7d6, []int{2}
+}
+
+// The reason a circuit breaker was tripped.
+type CircuitBreakerReason int32
+
+const (
+	CircuitBreakerReason_CIRCUIT_BREAKER_REASON_UNSPECIFIED CircuitBreakerReason = 0
+	// the oracle price moved more than the circuit breaker price change ratio
+	CircuitBreakerReason_ORACLE_PRICE_CHANGE CircuitBreakerReason = 1
+	// the mark price broke the price fluctuation limit for too many
+	// consecutive blocks
+	CircuitBreakerReason_PRICE_FLUCTUATION CircuitBreakerReason = 2
+)
+
+var CircuitBreakerReason_name = map[int32]string{
+	0: "CIRCUIT_BREAKER_REASON_UNSPECIFIED",
+	1: "ORACLE_PRICE_CHANGE",
+	2: "PRICE_FLUCTUATION",
+}
+
+var CircuitBreakerReason_value = map[string]int32{
+	"CIRCUIT_BREAKER_REASON_UNSPECIFIED": 0,
+	"ORACLE_PRICE_CHANGE":                1,
+	"PRICE_FLUCTUATION":                  2,
+}
+
+func (x CircuitBreakerReason) String() string {
+	return proto.EnumName(CircuitBreakerReason_name, int32(x))
+}
+
+func (CircuitBreakerReason) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_9a497e70afa7e7d6, []int{3}
+}
+
+// The kind of a resting trigger order.
+type TriggerOrderType int32
+
+const (
+	TriggerOrderType_TRIGGER_ORDER_TYPE_UNSPECIFIED TriggerOrderType = 0
+	// Opens (or increases) a position once the price crosses the trigger price
+	// in the trader's favour: at or below it for longs, at or above it for
+	// shorts.
+	TriggerOrderType_LIMIT_OPEN TriggerOrderType = 1
+	// Closes the position once the price moves against it past the trigger
+	// price.
+	TriggerOrderType_STOP_LOSS TriggerOrderType = 2
+	// Closes the position once the price moves in its favour past the trigger
+	// price.
+	TriggerOrderType_TAKE_PROFIT TriggerOrderType = 3
+)
+
+var TriggerOrderType_name = map[int32]string{
+	0: "TRIGGER_ORDER_TYPE_UNSPECIFIED",
+	1: "LIMIT_OPEN",
+	2: "STOP_LOSS",
+	3: "TAKE_PROFIT",
+}
+
+var TriggerOrderType_value = map[string]int32{
+	"TRIGGER_ORDER_TYPE_UNSPECIFIED": 0,
+	"LIMIT_OPEN":                     1,
+	"STOP_LOSS":                      2,
+	"TAKE_PROFIT":                    3,
+}
+
+func (x TriggerOrderType) String() string {
+	return proto.EnumName(TriggerOrderType_name, int32(x))
+}
+
+func (TriggerOrderType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_9a497e70afa7e7d6, []int{4}
+}
+
+// The price a trigger order is checked against.
+type TriggerPriceSource int32
+
+const (
+	TriggerPriceSource_TRIGGER_PRICE_SOURCE_UNSPECIFIED TriggerPriceSource = 0
+	// The mark price of the market's AMM.
+	TriggerPriceSource_MARK TriggerPriceSource = 1
+	// The oracle index price of the pair.
+	TriggerPriceSource_INDEX TriggerPriceSource = 2
+)
+
+var TriggerPriceSource_name = map[int32]string{
+	0: "TRIGGER_PRICE_SOURCE_UNSPECIFIED",
+	1: "MARK",
+	2: "INDEX",
+}
+
+var TriggerPriceSource_value = map[string]int32{
+	"TRIGGER_PRICE_SOURCE_UNSPECIFIED": 0,
+	"MARK":                             1,
+	"INDEX":                            2,
+}
+
+func (x TriggerPriceSource) String() string {
+	return proto.EnumName(TriggerPriceSource_name, int32(x))
+}
+
+func (TriggerPriceSource) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_9a497e70afa7e7d6, []int{5}
+}
+
 type Params struct {
+	// whether trading is halted on every market. Positions can only be reduced,
+	// closed or have margin added while stopped.
+	Stopped bool `protobuf:"varint,1,opt,name=stopped,proto3" json:"stopped,omitempty"`
+	// volume based discounts on trading fees, sorted by min volume
+	FeeTiers []FeeTier `protobuf:"bytes,2,rep,name=fee_tiers,json=feeTiers,proto3" json:"fee_tiers"`
+	// the discount on trading fees of traders with at least staker_min_bonded
+	// tokens bonded, on top of their fee tier discount
+	StakerFeeDiscountRatio github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=staker_fee_discount_ratio,json=stakerFeeDiscountRatio,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"staker_fee_discount_ratio"`
+	StakerMinBonded        github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=staker_min_bonded,json=stakerMinBonded,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"staker_min_bonded"`
+	// the share of the exchange fee of a referred trader rebated to its
+	// referrer
+	ReferralRebateRatio github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=referral_rebate_ratio,json=referralRebateRatio,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"referral_rebate_ratio"`
+	// whether the realized PnL, funding, fees and liquidation losses of traders
+	// are recorded in their trader history
+	TraderHistoryEnabled bool `protobuf:"varint,6,opt,name=trader_history_enabled,json=traderHistoryEnabled,proto3" json:"trader_history_enabled,omitempty"`
+	// how long trader history entries are kept, zero to keep them forever
+	TraderHistoryRetention time.Duration `protobuf:"bytes,7,opt,name=trader_history_retention,json=traderHistoryRetention,proto3,stdduration" json:"trader_history_retention"`
+	// the accounts allowed to bid on the liquidation auctions of markets in
+	// AUCTION liquidation mode
+	AuctionLiquidators []string `protobuf:"bytes,8,rep,name=auction_liquidators,json=auctionLiquidators,proto3" json:"auction_liquidators,omitempty"`
+	// how long reserve snapshots are kept, zero to keep them forever. The
+	// snapshots of a market are kept for at least its twap lookback window.
+	ReserveSnapshotRetention time.Duration `protobuf:"bytes,9,opt,name=reserve_snapshot_retention,json=reserveSnapshotRetention,proto3,stdduration" json:"reserve_snapshot_retention"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -133,134 +287,69 @@ func (m *Params) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Params proto.InternalMessageInfo
 
-type Market struct {
-	// the trading pair represented by this market
-	// always BASE:QUOTE, e.g. BTC:NUSD or ETH:NUSD
-	Pair github_com_NibiruChain_nibiru_x_common_asset.Pair `protobuf:"bytes,1,opt,name=pair,proto3,customtype=github.com/NibiruChain/nibiru/x/common/asset.Pair" json:"pair"`
-	// whether or not the market is enabled
-	Enabled bool `protobuf:"varint,2,opt,name=enabled,proto3" json:"enabled,omitempty"`
-	// percentage that a single open or close position can alter the reserve
-	// amounts
-	PriceFluctuationLimitRatio github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=price_fluctuation_limit_ratio,json=priceFluctuationLimitRatio,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"price_fluctuation_limit_ratio"`
-	// the minimum margin ratio which a user must maintain on this market
-	MaintenanceMarginRatio github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=maintenance_margin_ratio,json=maintenanceMarginRatio,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"maintenance_margin_ratio"`
-	// the maximum leverage a user is able to be taken on this market
-	MaxLeverage github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=max_leverage,json=maxLeverage,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_leverage"`
-	// Latest cumulative premium fraction for a given pair.
-	// Calculated once per funding rate interval.
-	// A premium fraction is the difference between mark and index, divided by the
-	// number of payments per day. (mark - index) / # payments in a day
-	LatestCumulativePremiumFraction github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=latest_cumulative_premium_fraction,json=latestCumulativePremiumFraction,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"latest_cumulative_premium_fraction"`
-	// the percentage of the notional given to the exchange when trading
-	ExchangeFeeRatio github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,7,opt,name=exchange_fee_ratio,json=exchangeFeeRatio,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"exchange_fee_ratio"`
-	// the percentage of the notional transferred to the ecosystem fund when
-	// trading
-	EcosystemFundFeeRatio github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,8,opt,name=ecosystem_fund_fee_ratio,json=ecosystemFundFeeRatio,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"ecosystem_fund_fee_ratio"`
-	// the percentage of liquidated position that will be
-	// given to out as a reward. Half of the liquidation fee is given to the
-	// liquidator, and the other half is given to the ecosystem fund.
-	LiquidationFeeRatio github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,9,opt,name=liquidation_fee_ratio,json=liquidationFeeRatio,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"liquidation_fee_ratio"`
-	// the portion of the position size we try to liquidate if the available
-	// margin is higher than liquidation fee
-	PartialLiquidationRatio github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,10,opt,name=partial_liquidation_ratio,json=partialLiquidationRatio,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"partial_liquidation_ratio"`
-	// specifies the interval on which the funding rate is updated
-	FundingRateEpochId string `protobuf:"bytes,11,opt,name=funding_rate_epoch_id,json=fundingRateEpochId,proto3" json:"funding_rate_epoch_id,omitempty"`
-	// amount of time to look back for TWAP calculations
-	TwapLookbackWindow time.Duration `protobuf:"bytes,12,opt,name=twap_lookback_window,json=twapLookbackWindow,proto3,stdduration" json:"twap_lookback_window"`
-	// the amount of collateral already credited from the ecosystem fund
-	PrepaidBadDebt types.Coin `protobuf:"bytes,13,opt,name=prepaid_bad_debt,json=prepaidBadDebt,proto3" json:"prepaid_bad_debt"`
+func (m *Params) GetStopped() bool {
+	if m != nil {
+		return m.Stopped
+	}
+	return false
 }
 
-func (m *Market) Reset()         { *m = Market{} }
-func (m *Market) String() string { return proto.CompactTextString(m) }
-func (*Market) ProtoMessage()    {}
-func (*Market) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9a497e70afa7e7d6, []int{1}
-}
-func (m *Market) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
-}
-func (m *Market) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	if deterministic {
-		return xxx_messageInfo_Market.Marshal(b, m, deterministic)
-	} else {
-		b = b[:cap(b)]
-		n, err := m.MarshalToSizedBuffer(b)
-		if err != nil {
-			return nil, err
-		}
-		return b[:n], nil
+func (m *Params) GetFeeTiers() []FeeTier {
+	if m != nil {
+		return m.FeeTiers
 	}
-}
-func (m *Market) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Market.Merge(m, src)
-}
-func (m *Market) XXX_Size() int {
-	return m.Size()
-}
-func (m *Market) XXX_DiscardUnknown() {
-	xxx_messageInfo_Market.DiscardUnknown(m)
+	return nil
 }
 
-var xxx_messageInfo_Market proto.InternalMessageInfo
-
-func (m *Market) GetEnabled() bool {
+func (m *Params) GetTraderHistoryEnabled() bool {
 	if m != nil {
-		return m.Enabled
+		return m.TraderHistoryEnabled
 	}
 	return false
 }
 
-func (m *Market) GetFundingRateEpochId() string {
+func (m *Params) GetTraderHistoryRetention() time.Duration {
 	if m != nil {
-		return m.FundingRateEpochId
+		return m.TraderHistoryRetention
 	}
-	return ""
+	return 0
 }
 
-func (m *Market) GetTwapLookbackWindow() time.Duration {
+func (m *Params) GetAuctionLiquidators() []string {
 	if m != nil {
-		return m.TwapLookbackWindow
+		return m.AuctionLiquidators
 	}
-	return 0
+	return nil
 }
 
-func (m *Market) GetPrepaidBadDebt() types.Coin {
+func (m *Params) GetReserveSnapshotRetention() time.Duration {
 	if m != nil {
-		return m.PrepaidBadDebt
+		return m.ReserveSnapshotRetention
 	}
-	return types.Coin{}
+	return 0
 }
 
-type AMM struct {
-	// identifies the market this AMM belongs to
-	Pair github_com_NibiruChain_nibiru_x_common_asset.Pair `protobuf:"bytes,1,opt,name=pair,proto3,customtype=github.com/NibiruChain/nibiru/x/common/asset.Pair" json:"pair"`
-	// the amount of base reserves this AMM has
-	BaseReserve github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=base_reserve,json=baseReserve,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"base_reserve"`
-	// the amount of quote reserves this AMM has
-	QuoteReserve github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=quote_reserve,json=quoteReserve,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"quote_reserve"`
-	// sqrt(k)
-	SqrtDepth github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=sqrt_depth,json=sqrtDepth,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"sqrt_depth"`
-	// the price multiplier of the dynamic AMM
-	PriceMultiplier github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=price_multiplier,json=priceMultiplier,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"price_multiplier"`
-	// Total long refers to the sum of long open notional in base.
-	TotalLong github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=total_long,json=totalLong,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"total_long"`
-	// Total short refers to the sum of short open notional in base.
-	TotalShort github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,7,opt,name=total_short,json=totalShort,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"total_short"`
+// A volume based discount on trading fees.
+type FeeTier struct {
+	// the notional volume, in quote asset units, a trader needs to have traded
+	// over the last 30 days to reach the tier
+	MinVolume github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=min_volume,json=minVolume,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min_volume"`
+	// the share of the trading fees waived for traders in the tier
+	FeeDiscountRatio github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=fee_discount_ratio,json=feeDiscountRatio,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"fee_discount_ratio"`
 }
 
-func (m *AMM) Reset()         { *m = AMM{} }
-func (m *AMM) String() string { return proto.CompactTextString(m) }
-func (*AMM) ProtoMessage()    {}
-func (*AMM) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9a497e70afa7e7d6, []int{2}
+func (m *FeeTier) Reset()         { *m = FeeTier{} }
+func (m *FeeTier) String() string { return proto.CompactTextString(m) }
+func (*FeeTier) ProtoMessage()    {}
+func (*FeeTier) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9a497e70afa7e7d6, []int{1}
 }
-func (m *AMM) XXX_Unmarshal(b []byte) error {
+func (m *FeeTier) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *AMM) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *FeeTier) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_AMM.Marshal(b, m, deterministic)
+		return xxx_messageInfo_FeeTier.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -270,48 +359,38 @@ func (m *AMM) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *AMM) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_AMM.Merge(m, src)
+func (m *FeeTier) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeTier.Merge(m, src)
 }
-func (m *AMM) XXX_Size() int {
+func (m *FeeTier) XXX_Size() int {
 	return m.Size()
 }
-func (m *AMM) XXX_DiscardUnknown() {
-	xxx_messageInfo_AMM.DiscardUnknown(m)
+func (m *FeeTier) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeTier.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_AMM proto.InternalMessageInfo
+var xxx_messageInfo_FeeTier proto.InternalMessageInfo
 
-type Position struct {
-	// address identifies the address owner of this position
-	TraderAddress string `protobuf:"bytes,1,opt,name=trader_address,json=traderAddress,proto3" json:"trader_address,omitempty"`
-	// pair identifies the pair associated with this position
-	Pair github_com_NibiruChain_nibiru_x_common_asset.Pair `protobuf:"bytes,2,opt,name=pair,proto3,customtype=github.com/NibiruChain/nibiru/x/common/asset.Pair" json:"pair"`
-	// the position size
-	Size_ github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=size,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"size"`
-	// amount of margin remaining in the position
-	Margin github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=margin,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"margin"`
-	// value of position in quote assets when opened
-	OpenNotional github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=open_notional,json=openNotional,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"open_notional"`
-	// The most recent cumulative premium fraction this position has.
-	// Used to calculate the next funding payment.
-	LatestCumulativePremiumFraction github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=latest_cumulative_premium_fraction,json=latestCumulativePremiumFraction,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"latest_cumulative_premium_fraction"`
-	// last block number this position was updated
-	LastUpdatedBlockNumber int64 `protobuf:"varint,7,opt,name=last_updated_block_number,json=lastUpdatedBlockNumber,proto3" json:"last_updated_block_number,omitempty"`
+// The notional volume, in quote asset units, a trader traded on a day.
+type TraderVolume struct {
+	Trader string `protobuf:"bytes,1,opt,name=trader,proto3" json:"trader,omitempty"`
+	// the number of days since the unix epoch
+	Day    uint64                                 `protobuf:"varint,2,opt,name=day,proto3" json:"day,omitempty"`
+	Volume github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=volume,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"volume"`
 }
 
-func (m *Position) Reset()         { *m = Position{} }
-func (m *Position) String() string { return proto.CompactTextString(m) }
-func (*Position) ProtoMessage()    {}
-func (*Position) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9a497e70afa7e7d6, []int{3}
+func (m *TraderVolume) Reset()         { *m = TraderVolume{} }
+func (m *TraderVolume) String() string { return proto.CompactTextString(m) }
+func (*TraderVolume) ProtoMessage()    {}
+func (*TraderVolume) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9a497e70afa7e7d6, []int{2}
 }
-func (m *Position) XXX_Unmarshal(b []byte) error {
+func (m *TraderVolume) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Position) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *TraderVolume) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_Position.Marshal(b, m, deterministic)
+		return xxx_messageInfo_TraderVolume.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -321,51 +400,50 @@ func (m *Position) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *Position) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Position.Merge(m, src)
+func (m *TraderVolume) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TraderVolume.Merge(m, src)
 }
-func (m *Position) XXX_Size() int {
+func (m *TraderVolume) XXX_Size() int {
 	return m.Size()
 }
-func (m *Position) XXX_DiscardUnknown() {
-	xxx_messageInfo_Position.DiscardUnknown(m)
+func (m *TraderVolume) XXX_DiscardUnknown() {
+	xxx_messageInfo_TraderVolume.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Position proto.InternalMessageInfo
+var xxx_messageInfo_TraderVolume proto.InternalMessageInfo
 
-func (m *Position) GetTraderAddress() string {
+func (m *TraderVolume) GetTrader() string {
 	if m != nil {
-		return m.TraderAddress
+		return m.Trader
 	}
 	return ""
 }
 
-func (m *Position) GetLastUpdatedBlockNumber() int64 {
+func (m *TraderVolume) GetDay() uint64 {
 	if m != nil {
-		return m.LastUpdatedBlockNumber
+		return m.Day
 	}
 	return 0
 }
 
-// a snapshot of the perp.amm's reserves at a given point in time
-type ReserveSnapshot struct {
-	Amm AMM `protobuf:"bytes,1,opt,name=amm,proto3" json:"amm"`
-	// milliseconds since unix epoch
-	TimestampMs int64 `protobuf:"varint,2,opt,name=timestamp_ms,json=timestampMs,proto3" json:"timestamp_ms,omitempty"`
+// A referral code and the trader who registered it.
+type ReferralCode struct {
+	Code     string `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	Referrer string `protobuf:"bytes,2,opt,name=referrer,proto3" json:"referrer,omitempty"`
 }
 
-func (m *ReserveSnapshot) Reset()         { *m = ReserveSnapshot{} }
-func (m *ReserveSnapshot) String() string { return proto.CompactTextString(m) }
-func (*ReserveSnapshot) ProtoMessage()    {}
-func (*ReserveSnapshot) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9a497e70afa7e7d6, []int{4}
+func (m *ReferralCode) Reset()         { *m = ReferralCode{} }
+func (m *ReferralCode) String() string { return proto.CompactTextString(m) }
+func (*ReferralCode) ProtoMessage()    {}
+func (*ReferralCode) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9a497e70afa7e7d6, []int{3}
 }
-func (m *ReserveSnapshot) XXX_Unmarshal(b []byte) error {
+func (m *ReferralCode) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ReserveSnapshot) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ReferralCode) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ReserveSnapshot.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ReferralCode.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -375,658 +453,8258 @@ func (m *ReserveSnapshot) XXX_Marshal(b []byte, deterministic bool) ([]byte, err
 		return b[:n], nil
 	}
 }
-func (m *ReserveSnapshot) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ReserveSnapshot.Merge(m, src)
+func (m *ReferralCode) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReferralCode.Merge(m, src)
 }
-func (m *ReserveSnapshot) XXX_Size() int {
+func (m *ReferralCode) XXX_Size() int {
 	return m.Size()
 }
-func (m *ReserveSnapshot) XXX_DiscardUnknown() {
-	xxx_messageInfo_ReserveSnapshot.DiscardUnknown(m)
+func (m *ReferralCode) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReferralCode.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ReserveSnapshot proto.InternalMessageInfo
+var xxx_messageInfo_ReferralCode proto.InternalMessageInfo
 
-func (m *ReserveSnapshot) GetAmm() AMM {
+func (m *ReferralCode) GetCode() string {
 	if m != nil {
-		return m.Amm
+		return m.Code
 	}
-	return AMM{}
+	return ""
 }
 
-func (m *ReserveSnapshot) GetTimestampMs() int64 {
+func (m *ReferralCode) GetReferrer() string {
 	if m != nil {
-		return m.TimestampMs
+		return m.Referrer
 	}
-	return 0
-}
-
-func init() {
-	proto.RegisterEnum("nibiru.perp.v2.Direction", Direction_name, Direction_value)
-	proto.RegisterEnum("nibiru.perp.v2.TwapCalcOption", TwapCalcOption_name, TwapCalcOption_value)
-	proto.RegisterType((*Params)(nil), "nibiru.perp.v2.Params")
-	proto.RegisterType((*Market)(nil), "nibiru.perp.v2.Market")
-	proto.RegisterType((*AMM)(nil), "nibiru.perp.v2.AMM")
-	proto.RegisterType((*Position)(nil), "nibiru.perp.v2.Position")
-	proto.RegisterType((*ReserveSnapshot)(nil), "nibiru.perp.v2.ReserveSnapshot")
+	return ""
 }
 
-func init() { proto.RegisterFile("perp/v2/state.proto", fileDescriptor_9a497e70afa7e7d6) }
-
-var fileDescriptor_9a497e70afa7e7d6 = []byte{
-	// 1068 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x96, 0xcd, 0x6e, 0xdb, 0xc6,
-	0x17, 0xc5, 0x2d, 0x4b, 0x71, 0xa4, 0x91, 0xe2, 0x08, 0x74, 0x9c, 0xbf, 0x1c, 0xfc, 0x2b, 0xbb,
-	0x02, 0x5a, 0x04, 0x29, 0x42, 0xc2, 0xee, 0x2a, 0xe8, 0x4a, 0x9f, 0xa9, 0x00, 0x7d, 0x99, 0x92,
-	0x61, 0xb4, 0x28, 0x30, 0x18, 0x92, 0xd7, 0xd4, 0xd4, 0x24, 0x87, 0x9e, 0x19, 0xca, 0x4e, 0xfb,
-	0x06, 0x5d, 0x75, 0xd9, 0xbe, 0x50, 0x91, 0x65, 0x96, 0x45, 0x17, 0x69, 0x61, 0xbf, 0x48, 0x31,
-	0x43, 0x5a, 0x76, 0x80, 0x02, 0x05, 0x88, 0x00, 0x5d, 0x49, 0x9c, 0xcb, 0xfb, 0x3b, 0x97, 0xc3,
-	0xc3, 0x43, 0xa2, 0x9d, 0x18, 0x78, 0x6c, 0xad, 0x8e, 0x2c, 0x21, 0x89, 0x04, 0x33, 0xe6, 0x4c,
-	0x32, 0x63, 0x3b, 0xa2, 0x0e, 0xe5, 0x89, 0xa9, 0x6a, 0xe6, 0xea, 0xe8, 0xd9, 0x13, 0x9f, 0xf9,
-	0x4c, 0x97, 0x2c, 0xf5, 0x2f, 0x3d, 0xeb, 0x59, 0xd3, 0x65, 0x22, 0x64, 0xc2, 0x72, 0x88, 0x00,
-	0x6b, 0x75, 0xe8, 0x80, 0x24, 0x87, 0x96, 0xcb, 0x68, 0x94, 0xd5, 0xf7, 0xd2, 0x3a, 0x4e, 0x1b,
-	0xd3, 0x83, 0xdb, 0x56, 0x9f, 0x31, 0x3f, 0x00, 0x4b, 0x1f, 0x39, 0xc9, 0x99, 0xe5, 0x25, 0x9c,
-	0x48, 0xca, 0xb2, 0xd6, 0x56, 0x19, 0x6d, 0xcd, 0x08, 0x27, 0xa1, 0x68, 0xfd, 0x54, 0x41, 0x5b,
-	0x63, 0xc2, 0xcf, 0x41, 0x1a, 0x63, 0x54, 0x8a, 0x09, 0xe5, 0x8d, 0xc2, 0x41, 0xe1, 0x79, 0xa5,
-	0xf3, 0xea, 0xed, 0xfb, 0xfd, 0x8d, 0x3f, 0xde, 0xef, 0x1f, 0xfa, 0x54, 0x2e, 0x13, 0xc7, 0x74,
-	0x59, 0x68, 0x4d, 0xf4, 0xd8, 0xdd, 0x25, 0xa1, 0x91, 0x95, 0x5e, 0x82, 0x75, 0x65, 0xb9, 0x2c,
-	0x0c, 0x59, 0x64, 0x11, 0x21, 0x40, 0x9a, 0x33, 0x42, 0xb9, 0xad, 0x31, 0x46, 0x03, 0x3d, 0x84,
-	0x88, 0x38, 0x01, 0x78, 0x8d, 0xcd, 0x83, 0xc2, 0xf3, 0xb2, 0x7d, 0x7b, 0x68, 0x5c, 0xa0, 0x4f,
-	0x62, 0x4e, 0x5d, 0xc0, 0x67, 0x41, 0xe2, 0xca, 0x44, 0x0f, 0x86, 0x03, 0x1a, 0x52, 0x89, 0xf5,
-	0x94, 0x8d, 0xa2, 0x9e, 0xc0, 0xcc, 0x26, 0xf8, 0xfc, 0xde, 0x04, 0xd9, 0x96, 0xa4, 0x3f, 0x2f,
-	0x85, 0x77, 0x6e, 0xc9, 0x37, 0x31, 0x08, 0xb3, 0x07, 0xae, 0xfd, 0x4c, 0x43, 0x07, 0x77, 0xcc,
-	0x91, 0x42, 0xda, 0xea, 0xaf, 0xb1, 0x44, 0x8d, 0x90, 0xd0, 0x48, 0x42, 0x44, 0x22, 0x17, 0x70,
-	0x48, 0xb8, 0x4f, 0xa3, 0x4c, 0xad, 0x94, 0x4b, 0xed, 0xe9, 0x3d, 0xde, 0x58, 0xe3, 0x52, 0xa5,
-	0x63, 0x54, 0x0b, 0xc9, 0x15, 0x0e, 0x60, 0x05, 0x9c, 0xf8, 0xd0, 0x78, 0x90, 0x8b, 0x5e, 0x0d,
-	0xc9, 0xd5, 0x28, 0x43, 0x18, 0x3f, 0xa2, 0x56, 0x40, 0x24, 0x08, 0x89, 0xdd, 0x24, 0x4c, 0x02,
-	0x22, 0xe9, 0x0a, 0x70, 0xcc, 0x21, 0xa4, 0x49, 0x88, 0xcf, 0x38, 0x71, 0xd5, 0xc5, 0x36, 0xb6,
-	0x72, 0x09, 0xed, 0xa7, 0xe4, 0xee, 0x1a, 0x3c, 0x4b, 0xb9, 0x83, 0x0c, 0x6b, 0x7c, 0x87, 0x0c,
-	0xb8, 0x72, 0x97, 0x24, 0xf2, 0x01, 0x9f, 0x01, 0x64, 0x7b, 0xf6, 0x30, 0x97, 0x58, 0xfd, 0x96,
-	0x34, 0x00, 0x48, 0x77, 0xcb, 0x47, 0x0d, 0x70, 0x99, 0x78, 0x23, 0x24, 0x84, 0xf8, 0x2c, 0x89,
-	0xbc, 0x7b, 0x1a, 0xe5, 0x5c, 0x1a, 0xbb, 0x6b, 0xde, 0x20, 0x89, 0xbc, 0xb5, 0x90, 0x83, 0x76,
-	0x03, 0x7a, 0x91, 0x50, 0x2f, 0x75, 0xdb, 0x9d, 0x4a, 0x25, 0x97, 0xca, 0xce, 0x3d, 0xd8, 0x5a,
-	0xe3, 0x7b, 0xb4, 0x17, 0x13, 0x2e, 0x29, 0x09, 0xf0, 0x7d, 0xad, 0x54, 0x07, 0xe5, 0xd2, 0xf9,
-	0x5f, 0x06, 0x1c, 0xdd, 0xf1, 0x52, 0xad, 0x43, 0xb4, 0xab, 0xb6, 0x8b, 0x46, 0xbe, 0xe2, 0x03,
-	0x86, 0x98, 0xb9, 0x4b, 0x4c, 0xbd, 0x46, 0x55, 0xe9, 0xd8, 0x46, 0x56, 0xb4, 0x89, 0x84, 0xbe,
-	0x2a, 0x0d, 0x3d, 0xe3, 0x04, 0x3d, 0x91, 0x97, 0x24, 0xc6, 0x01, 0x63, 0xe7, 0x0e, 0x71, 0xcf,
-	0xf1, 0x25, 0x8d, 0x3c, 0x76, 0xd9, 0xa8, 0x1d, 0x14, 0x9e, 0x57, 0x8f, 0xf6, 0xcc, 0x34, 0x33,
-	0xcc, 0xdb, 0xcc, 0x30, 0x7b, 0x59, 0x66, 0x74, 0xca, 0x6a, 0xe8, 0x5f, 0xfe, 0xdc, 0x2f, 0xd8,
-	0x86, 0x02, 0x8c, 0xb2, 0xfe, 0x53, 0xdd, 0x6e, 0x0c, 0x51, 0x3d, 0xe6, 0x10, 0x13, 0xea, 0x61,
-	0x87, 0x78, 0xd8, 0x03, 0x47, 0x36, 0x1e, 0x65, 0xc8, 0x2c, 0x94, 0x54, 0x82, 0x99, 0x59, 0x82,
-	0x99, 0x5d, 0x46, 0xa3, 0x4e, 0x49, 0x21, 0xed, 0xed, 0xac, 0xb1, 0x43, 0xbc, 0x1e, 0x38, 0xb2,
-	0xf5, 0x5b, 0x09, 0x15, 0xdb, 0xe3, 0xf1, 0xc7, 0x4e, 0xa2, 0x63, 0x54, 0x53, 0x13, 0x60, 0x0e,
-	0x02, 0xf8, 0x0a, 0x74, 0x1c, 0xe5, 0x78, 0x24, 0x15, 0xc3, 0x4e, 0x11, 0xc6, 0x1c, 0x3d, 0xba,
-	0x48, 0x98, 0xbc, 0x63, 0xe6, 0x8b, 0xac, 0x9a, 0x86, 0xdc, 0x42, 0xc7, 0x08, 0x89, 0x0b, 0x2e,
-	0xb1, 0x07, 0xb1, 0x5c, 0xe6, 0x8c, 0xa5, 0x8a, 0x22, 0xf4, 0x14, 0xc0, 0xf8, 0x46, 0xdd, 0x18,
-	0x15, 0xb3, 0x61, 0x12, 0x48, 0x1a, 0x07, 0x14, 0x78, 0xce, 0x34, 0x7a, 0xac, 0x39, 0xe3, 0x35,
-	0x46, 0x4d, 0x2a, 0x99, 0x54, 0x3e, 0x67, 0x91, 0x9f, 0x33, 0x79, 0x2a, 0x9a, 0x30, 0x62, 0x91,
-	0x6f, 0x4c, 0x51, 0x35, 0xc5, 0x89, 0x25, 0xe3, 0x32, 0x67, 0xb8, 0xa4, 0x13, 0xcd, 0x15, 0xa1,
-	0xf5, 0x6b, 0x09, 0x95, 0x67, 0x4c, 0x50, 0x9d, 0x60, 0x9f, 0xa1, 0x6d, 0xc9, 0x89, 0x07, 0x1c,
-	0x13, 0xcf, 0xe3, 0x20, 0x44, 0xea, 0x2b, 0xfb, 0x51, 0xba, 0xda, 0x4e, 0x17, 0xd7, 0xa6, 0xdb,
-	0xfc, 0x38, 0xa6, 0xeb, 0xa0, 0x92, 0xa0, 0x3f, 0xe4, 0x35, 0x86, 0xee, 0x35, 0x06, 0x68, 0x2b,
-	0x7d, 0x53, 0xe5, 0x34, 0x43, 0xd6, 0xad, 0xdc, 0xca, 0x62, 0x88, 0x70, 0xc4, 0xd4, 0x86, 0x90,
-	0x20, 0xa7, 0x0d, 0x6a, 0x0a, 0x32, 0xc9, 0x18, 0xff, 0xed, 0x5b, 0xe9, 0x15, 0xda, 0x0b, 0x88,
-	0x90, 0x38, 0x89, 0x3d, 0x22, 0xc1, 0xc3, 0x4e, 0xc0, 0xdc, 0x73, 0x1c, 0x25, 0xa1, 0x03, 0x5c,
-	0xfb, 0xa7, 0x68, 0x3f, 0x55, 0x27, 0x9c, 0xa4, 0xf5, 0x8e, 0x2a, 0x4f, 0x74, 0xb5, 0x45, 0xd0,
-	0xe3, 0xec, 0x81, 0x9b, 0x47, 0x24, 0x16, 0x4b, 0x26, 0x8d, 0x2f, 0x50, 0x91, 0x84, 0xa1, 0xb6,
-	0x45, 0xf5, 0x68, 0xc7, 0xfc, 0xf0, 0xeb, 0xcc, 0x6c, 0x8f, 0xc7, 0x59, 0x5e, 0xa9, 0xb3, 0x8c,
-	0x4f, 0x51, 0x4d, 0xd2, 0x10, 0x84, 0x24, 0x61, 0x8c, 0x43, 0xa1, 0xfd, 0x52, 0xb4, 0xab, 0xeb,
-	0xb5, 0xb1, 0x78, 0xf1, 0x15, 0xaa, 0xf4, 0x28, 0x87, 0x74, 0xd4, 0x3d, 0xb4, 0xdb, 0x1b, 0xda,
-	0xfd, 0xee, 0x62, 0x38, 0x9d, 0xe0, 0x93, 0xc9, 0x7c, 0xd6, 0xef, 0x0e, 0x07, 0xc3, 0x7e, 0xaf,
-	0xbe, 0x61, 0x94, 0x51, 0x69, 0x34, 0x9d, 0xbc, 0xae, 0x17, 0x8c, 0x0a, 0x7a, 0x30, 0xff, 0x7a,
-	0x6a, 0x2f, 0xea, 0x9b, 0x2f, 0x7c, 0xb4, 0xbd, 0xb8, 0x24, 0x71, 0x97, 0x04, 0xee, 0x34, 0xd6,
-	0x84, 0x03, 0xf4, 0xff, 0xc5, 0x69, 0x7b, 0x86, 0xbb, 0xed, 0x51, 0x17, 0x4f, 0x67, 0xff, 0x0c,
-	0x9a, 0xcf, 0xa6, 0x8b, 0x7a, 0xc1, 0x78, 0x82, 0xea, 0xc7, 0x27, 0xd3, 0x45, 0x1f, 0xb7, 0xe7,
-	0xf3, 0xfe, 0x02, 0xcf, 0x4f, 0xdb, 0xb3, 0xfa, 0xa6, 0xb1, 0x83, 0x1e, 0x77, 0xda, 0xf3, 0x0f,
-	0x16, 0x8b, 0x9d, 0xd7, 0x6f, 0xaf, 0x9b, 0x85, 0x77, 0xd7, 0xcd, 0xc2, 0x5f, 0xd7, 0xcd, 0xc2,
-	0xcf, 0x37, 0xcd, 0x8d, 0x77, 0x37, 0xcd, 0x8d, 0xdf, 0x6f, 0x9a, 0x1b, 0xdf, 0xbe, 0xfc, 0x37,
-	0xd3, 0xeb, 0x8f, 0x5a, 0x7d, 0xbb, 0xac, 0xd5, 0x91, 0xb3, 0xa5, 0x5f, 0x19, 0x5f, 0xfe, 0x1d,
-	0x00, 0x00, 0xff, 0xff, 0xca, 0x64, 0x18, 0xc0, 0xec, 0x0a, 0x00, 0x00,
+// A trader referred with a referral code.
+type Referral struct {
+	Referee string `protobuf:"bytes,1,opt,name=referee,proto3" json:"referee,omitempty"`
+	Code    string `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
 }
 
-func (m *Params) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *Referral) Reset()         { *m = Referral{} }
+func (m *Referral) String() string { return proto.CompactTextString(m) }
+func (*Referral) ProtoMessage()    {}
+func (*Referral) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9a497e70afa7e7d6, []int{4}
 }
-
-func (m *Params) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *Referral) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Referral) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Referral.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Referral) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Referral.Merge(m, src)
+}
+func (m *Referral) XXX_Size() int {
+	return m.Size()
+}
+func (m *Referral) XXX_DiscardUnknown() {
+	xxx_messageInfo_Referral.DiscardUnknown(m)
 }
 
-func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+var xxx_messageInfo_Referral proto.InternalMessageInfo
+
+func (m *Referral) GetReferee() string {
+	if m != nil {
+		return m.Referee
+	}
+	return ""
 }
 
-func (m *Market) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *Referral) GetCode() string {
+	if m != nil {
+		return m.Code
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *Market) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// The rebates a referrer earned in a denom.
+type ReferralRebate struct {
+	Referrer string     `protobuf:"bytes,1,opt,name=referrer,proto3" json:"referrer,omitempty"`
+	Rebate   types.Coin `protobuf:"bytes,2,opt,name=rebate,proto3" json:"rebate"`
 }
 
-func (m *Market) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.PrepaidBadDebt.MarshalToSizedBuffer(dAtA[:i])
+func (m *ReferralRebate) Reset()         { *m = ReferralRebate{} }
+func (m *ReferralRebate) String() string { return proto.CompactTextString(m) }
+func (*ReferralRebate) ProtoMessage()    {}
+func (*ReferralRebate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9a497e70afa7e7d6, []int{5}
+}
+func (m *ReferralRebate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReferralRebate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReferralRebate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintState(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x6a
-	n2, err2 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.TwapLookbackWindow, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.TwapLookbackWindow):])
-	if err2 != nil {
-		return 0, err2
-	}
-	i -= n2
-	i = encodeVarintState(dAtA, i, uint64(n2))
-	i--
-	dAtA[i] = 0x62
-	if len(m.FundingRateEpochId) > 0 {
-		i -= len(m.FundingRateEpochId)
-		copy(dAtA[i:], m.FundingRateEpochId)
-		i = encodeVarintState(dAtA, i, uint64(len(m.FundingRateEpochId)))
-		i--
-		dAtA[i] = 0x5a
-	}
-	{
-		size := m.PartialLiquidationRatio.Size()
-		i -= size
-		if _, err := m.PartialLiquidationRatio.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintState(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x52
-	{
-		size := m.LiquidationFeeRatio.Size()
-		i -= size
-		if _, err := m.LiquidationFeeRatio.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintState(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x4a
-	{
-		size := m.EcosystemFundFeeRatio.Size()
-		i -= size
-		if _, err := m.EcosystemFundFeeRatio.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintState(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x42
-	{
-		size := m.ExchangeFeeRatio.Size()
-		i -= size
-		if _, err := m.ExchangeFeeRatio.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+			return nil, err
 		}
-		i = encodeVarintState(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x3a
-	{
-		size := m.LatestCumulativePremiumFraction.Size()
-		i -= size
-		if _, err := m.LatestCumulativePremiumFraction.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintState(dAtA, i, uint64(size))
+}
+func (m *ReferralRebate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReferralRebate.Merge(m, src)
+}
+func (m *ReferralRebate) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReferralRebate) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReferralRebate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReferralRebate proto.InternalMessageInfo
+
+func (m *ReferralRebate) GetReferrer() string {
+	if m != nil {
+		return m.Referrer
 	}
-	i--
-	dAtA[i] = 0x32
-	{
-		size := m.MaxLeverage.Size()
-		i -= size
-		if _, err := m.MaxLeverage.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintState(dAtA, i, uint64(size))
+	return ""
+}
+
+func (m *ReferralRebate) GetRebate() types.Coin {
+	if m != nil {
+		return m.Rebate
 	}
-	i--
-	dAtA[i] = 0x2a
-	{
-		size := m.MaintenanceMarginRatio.Size()
-		i -= size
-		if _, err := m.MaintenanceMarginRatio.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	return types.Coin{}
+}
+
+// The cumulative realized PnL, funding, fees and liquidation losses of a
+// trader in a market, in quote asset units.
+type TraderPnl struct {
+	Trader      string                                            `protobuf:"bytes,1,opt,name=trader,proto3" json:"trader,omitempty"`
+	Pair        github_com_NibiruChain_nibiru_x_common_asset.Pair `protobuf:"bytes,2,opt,name=pair,proto3,customtype=github.com/NibiruChain/nibiru/x/common/asset.Pair" json:"pair"`
+	RealizedPnl github_com_cosmos_cosmos_sdk_types.Dec            `protobuf:"bytes,3,opt,name=realized_pnl,json=realizedPnl,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"realized_pnl"`
+	// the funding paid by the trader, negative if it received funding
+	FundingPayment github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=funding_payment,json=fundingPayment,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"funding_payment"`
+	Fees           github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=fees,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"fees"`
+	// the margin lost to liquidation fees
+	LiquidationLoss github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=liquidation_loss,json=liquidationLoss,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"liquidation_loss"`
+}
+
+func (m *TraderPnl) Reset()         { *m = TraderPnl{} }
+func (m *TraderPnl) String() string { return proto.CompactTextString(m) }
+func (*TraderPnl) ProtoMessage()    {}
+func (*TraderPnl) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9a497e70afa7e7d6, []int{6}
+}
+func (m *TraderPnl) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TraderPnl) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TraderPnl.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintState(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x22
-	{
-		size := m.PriceFluctuationLimitRatio.Size()
-		i -= size
-		if _, err := m.PriceFluctuationLimitRatio.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintState(dAtA, i, uint64(size))
+}
+func (m *TraderPnl) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TraderPnl.Merge(m, src)
+}
+func (m *TraderPnl) XXX_Size() int {
+	return m.Size()
+}
+func (m *TraderPnl) XXX_DiscardUnknown() {
+	xxx_messageInfo_TraderPnl.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TraderPnl proto.InternalMessageInfo
+
+func (m *TraderPnl) GetTrader() string {
+	if m != nil {
+		return m.Trader
 	}
-	i--
-	dAtA[i] = 0x1a
-	if m.Enabled {
-		i--
-		if m.Enabled {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+	return ""
+}
+
+// A position change recorded in the history of a trader, with the totals of
+// the trader's ledger in the market after the change.
+type TraderHistoryEntry struct {
+	Id                        uint64                                            `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Trader                    string                                            `protobuf:"bytes,2,opt,name=trader,proto3" json:"trader,omitempty"`
+	Pair                      github_com_NibiruChain_nibiru_x_common_asset.Pair `protobuf:"bytes,3,opt,name=pair,proto3,customtype=github.com/NibiruChain/nibiru/x/common/asset.Pair" json:"pair"`
+	ExchangedSize             github_com_cosmos_cosmos_sdk_types.Dec            `protobuf:"bytes,4,opt,name=exchanged_size,json=exchangedSize,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"exchanged_size"`
+	ExchangedNotional         github_com_cosmos_cosmos_sdk_types.Dec            `protobuf:"bytes,5,opt,name=exchanged_notional,json=exchangedNotional,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"exchanged_notional"`
+	RealizedPnl               github_com_cosmos_cosmos_sdk_types.Dec            `protobuf:"bytes,6,opt,name=realized_pnl,json=realizedPnl,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"realized_pnl"`
+	FundingPayment            github_com_cosmos_cosmos_sdk_types.Dec            `protobuf:"bytes,7,opt,name=funding_payment,json=fundingPayment,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"funding_payment"`
+	Fees                      github_com_cosmos_cosmos_sdk_types.Dec            `protobuf:"bytes,8,opt,name=fees,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"fees"`
+	LiquidationLoss           github_com_cosmos_cosmos_sdk_types.Dec            `protobuf:"bytes,9,opt,name=liquidation_loss,json=liquidationLoss,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"liquidation_loss"`
+	CumulativeRealizedPnl     github_com_cosmos_cosmos_sdk_types.Dec            `protobuf:"bytes,10,opt,name=cumulative_realized_pnl,json=cumulativeRealizedPnl,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"cumulative_realized_pnl"`
+	CumulativeFundingPayment  github_com_cosmos_cosmos_sdk_types.Dec            `protobuf:"bytes,11,opt,name=cumulative_funding_payment,json=cumulativeFundingPayment,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"cumulative_funding_payment"`
+	CumulativeFees            github_com_cosmos_cosmos_sdk_types.Dec            `protobuf:"bytes,12,opt,name=cumulative_fees,json=cumulativeFees,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"cumulative_fees"`
+	CumulativeLiquidationLoss github_com_cosmos_cosmos_sdk_types.Dec            `protobuf:"bytes,13,opt,name=cumulative_liquidation_loss,json=cumulativeLiquidationLoss,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"cumulative_liquidation_loss"`
+	BlockHeight               int64                                             `protobuf:"varint,14,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	BlockTimeMs               int64                                             `protobuf:"varint,15,opt,name=block_time_ms,json=blockTimeMs,proto3" json:"block_time_ms,omitempty"`
+}
+
+func (m *TraderHistoryEntry) Reset()         { *m = TraderHistoryEntry{} }
+func (m *TraderHistoryEntry) String() string { return proto.CompactTextString(m) }
+func (*TraderHistoryEntry) ProtoMessage()    {}
+func (*TraderHistoryEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9a497e70afa7e7d6, []int{7}
+}
+func (m *TraderHistoryEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TraderHistoryEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TraderHistoryEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x10
+		return b[:n], nil
 	}
-	{
-		size := m.Pair.Size()
-		i -= size
-		if _, err := m.Pair.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintState(dAtA, i, uint64(size))
+}
+func (m *TraderHistoryEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TraderHistoryEntry.Merge(m, src)
+}
+func (m *TraderHistoryEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *TraderHistoryEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_TraderHistoryEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TraderHistoryEntry proto.InternalMessageInfo
+
+func (m *TraderHistoryEntry) GetId() uint64 {
+	if m != nil {
+		return m.Id
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *AMM) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *TraderHistoryEntry) GetTrader() string {
+	if m != nil {
+		return m.Trader
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *AMM) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *TraderHistoryEntry) GetBlockHeight() int64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
 }
 
-func (m *AMM) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.TotalShort.Size()
-		i -= size
-		if _, err := m.TotalShort.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintState(dAtA, i, uint64(size))
+func (m *TraderHistoryEntry) GetBlockTimeMs() int64 {
+	if m != nil {
+		return m.BlockTimeMs
 	}
-	i--
-	dAtA[i] = 0x3a
-	{
-		size := m.TotalLong.Size()
-		i -= size
-		if _, err := m.TotalLong.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintState(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x32
-	{
-		size := m.PriceMultiplier.Size()
-		i -= size
-		if _, err := m.PriceMultiplier.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintState(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x2a
-	{
-		size := m.SqrtDepth.Size()
-		i -= size
-		if _, err := m.SqrtDepth.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintState(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x22
-	{
-		size := m.QuoteReserve.Size()
-		i -= size
-		if _, err := m.QuoteReserve.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintState(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	{
-		size := m.BaseReserve.Size()
-		i -= size
-		if _, err := m.BaseReserve.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintState(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	{
-		size := m.Pair.Size()
-		i -= size
-		if _, err := m.Pair.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	return 0
+}
+
+type Market struct {
+	// the trading pair represented by this market
+	// always BASE:QUOTE, e.g. BTC:NUSD or ETH:NUSD
+	Pair github_com_NibiruChain_nibiru_x_common_asset.Pair `protobuf:"bytes,1,opt,name=pair,proto3,customtype=github.com/NibiruChain/nibiru/x/common/asset.Pair" json:"pair"`
+	// whether or not the market is enabled
+	Enabled bool `protobuf:"varint,2,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// percentage that a single open or close position can alter the reserve
+	// amounts
+	PriceFluctuationLimitRatio github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=price_fluctuation_limit_ratio,json=priceFluctuationLimitRatio,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"price_fluctuation_limit_ratio"`
+	// the minimum margin ratio which a user must maintain on this market
+	MaintenanceMarginRatio github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=maintenance_margin_ratio,json=maintenanceMarginRatio,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"maintenance_margin_ratio"`
+	// the maximum leverage a user is able to be taken on this market
+	MaxLeverage github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=max_leverage,json=maxLeverage,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_leverage"`
+	// Latest cumulative premium fraction for a given pair.
+	// Calculated once per funding rate interval.
+	// A premium fraction is the difference between mark and index, divided by the
+	// number of payments per day. (mark - index) / # payments in a day
+	LatestCumulativePremiumFraction github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=latest_cumulative_premium_fraction,json=latestCumulativePremiumFraction,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"latest_cumulative_premium_fraction"`
+	// the percentage of the notional given to the exchange when trading
+	ExchangeFeeRatio github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,7,opt,name=exchange_fee_ratio,json=exchangeFeeRatio,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"exchange_fee_ratio"`
+	// the percentage of the notional transferred to the ecosystem fund when
+	// trading
+	EcosystemFundFeeRatio github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,8,opt,name=ecosystem_fund_fee_ratio,json=ecosystemFundFeeRatio,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"ecosystem_fund_fee_ratio"`
+	// the percentage of liquidated position that will be
+	// given to out as a reward. Half of the liquidation fee is given to the
+	// liquidator, and the other half is given to the ecosystem fund.
+	LiquidationFeeRatio github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,9,opt,name=liquidation_fee_ratio,json=liquidationFeeRatio,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"liquidation_fee_ratio"`
+	// the portion of the position size we try to liquidate if the available
+	// margin is higher than liquidation fee
+	PartialLiquidationRatio github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,10,opt,name=partial_liquidation_ratio,json=partialLiquidationRatio,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"partial_liquidation_ratio"`
+	// specifies the interval on which the funding rate is updated
+	FundingRateEpochId string `protobuf:"bytes,11,opt,name=funding_rate_epoch_id,json=fundingRateEpochId,proto3" json:"funding_rate_epoch_id,omitempty"`
+	// amount of time to look back for TWAP calculations
+	TwapLookbackWindow time.Duration `protobuf:"bytes,12,opt,name=twap_lookback_window,json=twapLookbackWindow,proto3,stdduration" json:"twap_lookback_window"`
+	// the amount of collateral already credited from the ecosystem fund
+	PrepaidBadDebt types.Coin `protobuf:"bytes,13,opt,name=prepaid_bad_debt,json=prepaidBadDebt,proto3" json:"prepaid_bad_debt"`
+	// the share of the exchange fee and of the ecosystem fund's half of the
+	// liquidation fee that is paid into the market's insurance fund
+	InsuranceFundFeeShare github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,14,opt,name=insurance_fund_fee_share,json=insuranceFundFeeShare,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"insurance_fund_fee_share"`
+	// whether profitable positions on the other side of a bankrupt position are
+	// deleveraged when the insurance and ecosystem funds cannot cover its bad
+	// debt
+	AutoDeleveragingEnabled bool `protobuf:"varint,15,opt,name=auto_deleveraging_enabled,json=autoDeleveragingEnabled,proto3" json:"auto_deleveraging_enabled,omitempty"`
+	// the highest funding rate paid per funding interval. The funding rate is
+	// not clamped when both the max and min funding rates are zero.
+	MaxFundingRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,16,opt,name=max_funding_rate,json=maxFundingRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_funding_rate"`
+	// the lowest funding rate paid per funding interval
+	MinFundingRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,17,opt,name=min_funding_rate,json=minFundingRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min_funding_rate"`
+	// the daily interest rate added to the premium rate, paid by longs to
+	// shorts when positive
+	InterestRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,18,opt,name=interest_rate,json=interestRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"interest_rate"`
+	// the spread between the mark and index prices, relative to the index
+	// price, beyond which the AMM is re-pegged to the index TWAP at the end of
+	// each funding epoch. Zero disables re-pegging.
+	MaxSpreadRatio github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,19,opt,name=max_spread_ratio,json=maxSpreadRatio,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_spread_ratio"`
+	// the net open interest (total long - total short), relative to the base
+	// reserve, beyond which the depth of the AMM is scaled up at the end of
+	// each funding epoch. Zero disables depth updates.
+	MaxSkewRatio github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,20,opt,name=max_skew_ratio,json=maxSkewRatio,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_skew_ratio"`
+	// the most the ecosystem fund pays, in quote units, for the AMM updates of
+	// a single funding epoch
+	AmmUpdateBudget github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,21,opt,name=amm_update_budget,json=ammUpdateBudget,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amm_update_budget"`
+	// the oracle price change, relative to any oracle price of the last
+	// circuit_breaker_window_blocks blocks, that trips the circuit breaker of
+	// the market. Zero disables the check.
+	CircuitBreakerPriceChangeRatio github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,22,opt,name=circuit_breaker_price_change_ratio,json=circuitBreakerPriceChangeRatio,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"circuit_breaker_price_change_ratio"`
+	// the number of blocks the oracle price change is measured over
+	CircuitBreakerWindowBlocks uint64 `protobuf:"varint,23,opt,name=circuit_breaker_window_blocks,json=circuitBreakerWindowBlocks,proto3" json:"circuit_breaker_window_blocks,omitempty"`
+	// the number of consecutive blocks whose mark price breaks the price
+	// fluctuation limit that trips the circuit breaker of the market. Zero
+	// disables the check.
+	CircuitBreakerMaxFluctuationViolations uint64 `protobuf:"varint,24,opt,name=circuit_breaker_max_fluctuation_violations,json=circuitBreakerMaxFluctuationViolations,proto3" json:"circuit_breaker_max_fluctuation_violations,omitempty"`
+	// the most open interest, in base asset units, either side of the market
+	// can have. Zero disables the cap.
+	MaxOpenInterest github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,25,opt,name=max_open_interest,json=maxOpenInterest,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_open_interest"`
+	// the most notional, in quote asset units, a single position can have.
+	// Zero disables the cap.
+	MaxPositionNotional github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,26,opt,name=max_position_notional,json=maxPositionNotional,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_position_notional"`
+	// margin tiers of positions with a notional above the first tier floor,
+	// sorted by notional floor. Positions below the first tier use
+	// maintenance_margin_ratio and max_leverage.
+	MarginTiers []MarginTier `protobuf:"bytes,27,rep,name=margin_tiers,json=marginTiers,proto3" json:"margin_tiers"`
+	// how underwater positions of the market are liquidated
+	LiquidationMode LiquidationMode `protobuf:"varint,28,opt,name=liquidation_mode,json=liquidationMode,proto3,enum=nibiru.perp.v2.LiquidationMode" json:"liquidation_mode,omitempty"`
+	// the discount to the index price of a liquidation auction added every
+	// block since its start. Only used in AUCTION liquidation mode.
+	AuctionDiscountPerBlock github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,29,opt,name=auction_discount_per_block,json=auctionDiscountPerBlock,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"auction_discount_per_block"`
+	// the highest discount to the index price of a liquidation auction. Only
+	// used in AUCTION liquidation mode.
+	AuctionMaxDiscount github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,30,opt,name=auction_max_discount,json=auctionMaxDiscount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"auction_max_discount"`
+	// the number of blocks after which an unfilled liquidation auction ends and
+	// the rest of the position is liquidated into the AMM. Only used in AUCTION
+	// liquidation mode.
+	AuctionDurationBlocks uint64 `protobuf:"varint,31,opt,name=auction_duration_blocks,json=auctionDurationBlocks,proto3" json:"auction_duration_blocks,omitempty"`
+}
+
+func (m *Market) Reset()         { *m = Market{} }
+func (m *Market) String() string { return proto.CompactTextString(m) }
+func (*Market) ProtoMessage()    {}
+func (*Market) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9a497e70afa7e7d6, []int{8}
+}
+func (m *Market) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Market) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Market.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintState(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *Market) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Market.Merge(m, src)
+}
+func (m *Market) XXX_Size() int {
+	return m.Size()
+}
+func (m *Market) XXX_DiscardUnknown() {
+	xxx_messageInfo_Market.DiscardUnknown(m)
 }
 
-func (m *Position) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_Market proto.InternalMessageInfo
+
+func (m *Market) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
 	}
-	return dAtA[:n], nil
+	return false
 }
 
-func (m *Position) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *Market) GetFundingRateEpochId() string {
+	if m != nil {
+		return m.FundingRateEpochId
+	}
+	return ""
 }
 
-func (m *Position) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.LastUpdatedBlockNumber != 0 {
-		i = encodeVarintState(dAtA, i, uint64(m.LastUpdatedBlockNumber))
-		i--
-		dAtA[i] = 0x38
+func (m *Market) GetTwapLookbackWindow() time.Duration {
+	if m != nil {
+		return m.TwapLookbackWindow
 	}
-	{
-		size := m.LatestCumulativePremiumFraction.Size()
-		i -= size
-		if _, err := m.LatestCumulativePremiumFraction.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintState(dAtA, i, uint64(size))
+	return 0
+}
+
+func (m *Market) GetPrepaidBadDebt() types.Coin {
+	if m != nil {
+		return m.PrepaidBadDebt
 	}
-	i--
-	dAtA[i] = 0x32
-	{
-		size := m.OpenNotional.Size()
-		i -= size
-		if _, err := m.OpenNotional.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintState(dAtA, i, uint64(size))
+	return types.Coin{}
+}
+
+func (m *Market) GetAutoDeleveragingEnabled() bool {
+	if m != nil {
+		return m.AutoDeleveragingEnabled
 	}
-	i--
-	dAtA[i] = 0x2a
-	{
-		size := m.Margin.Size()
-		i -= size
-		if _, err := m.Margin.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintState(dAtA, i, uint64(size))
+	return false
+}
+
+func (m *Market) GetCircuitBreakerWindowBlocks() uint64 {
+	if m != nil {
+		return m.CircuitBreakerWindowBlocks
 	}
-	i--
-	dAtA[i] = 0x22
-	{
-		size := m.Size_.Size()
-		i -= size
-		if _, err := m.Size_.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintState(dAtA, i, uint64(size))
+	return 0
+}
+
+func (m *Market) GetCircuitBreakerMaxFluctuationViolations() uint64 {
+	if m != nil {
+		return m.CircuitBreakerMaxFluctuationViolations
 	}
-	i--
-	dAtA[i] = 0x1a
-	{
-		size := m.Pair.Size()
-		i -= size
-		if _, err := m.Pair.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintState(dAtA, i, uint64(size))
+	return 0
+}
+
+func (m *Market) GetMarginTiers() []MarginTier {
+	if m != nil {
+		return m.MarginTiers
 	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.TraderAddress) > 0 {
-		i -= len(m.TraderAddress)
-		copy(dAtA[i:], m.TraderAddress)
-		i = encodeVarintState(dAtA, i, uint64(len(m.TraderAddress)))
-		i--
-		dAtA[i] = 0xa
+	return nil
+}
+
+func (m *Market) GetLiquidationMode() LiquidationMode {
+	if m != nil {
+		return m.LiquidationMode
 	}
-	return len(dAtA) - i, nil
+	return LiquidationMode_INSTANT
 }
 
-func (m *ReserveSnapshot) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *Market) GetAuctionDurationBlocks() uint64 {
+	if m != nil {
+		return m.AuctionDurationBlocks
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *ReserveSnapshot) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// A band of position notional with its own maintenance margin ratio and max
+// leverage. A tier applies to positions whose notional is at least its floor
+// and below the floor of the next tier.
+type MarginTier struct {
+	// the smallest position notional, in quote asset units, of the tier
+	NotionalFloor          github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=notional_floor,json=notionalFloor,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"notional_floor"`
+	MaintenanceMarginRatio github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=maintenance_margin_ratio,json=maintenanceMarginRatio,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"maintenance_margin_ratio"`
+	MaxLeverage            github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=max_leverage,json=maxLeverage,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_leverage"`
 }
 
-func (m *ReserveSnapshot) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.TimestampMs != 0 {
-		i = encodeVarintState(dAtA, i, uint64(m.TimestampMs))
-		i--
-		dAtA[i] = 0x10
-	}
-	{
-		size, err := m.Amm.MarshalToSizedBuffer(dAtA[:i])
+func (m *MarginTier) Reset()         { *m = MarginTier{} }
+func (m *MarginTier) String() string { return proto.CompactTextString(m) }
+func (*MarginTier) ProtoMessage()    {}
+func (*MarginTier) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9a497e70afa7e7d6, []int{9}
+}
+func (m *MarginTier) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MarginTier) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MarginTier.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintState(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *MarginTier) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MarginTier.Merge(m, src)
+}
+func (m *MarginTier) XXX_Size() int {
+	return m.Size()
+}
+func (m *MarginTier) XXX_DiscardUnknown() {
+	xxx_messageInfo_MarginTier.DiscardUnknown(m)
 }
 
-func encodeVarintState(dAtA []byte, offset int, v uint64) int {
-	offset -= sovState(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
+var xxx_messageInfo_MarginTier proto.InternalMessageInfo
+
+// The circuit breaker of a market. While tripped, positions can only be
+// reduced, closed or have margin added.
+type CircuitBreaker struct {
+	Pair    github_com_NibiruChain_nibiru_x_common_asset.Pair `protobuf:"bytes,1,opt,name=pair,proto3,customtype=github.com/NibiruChain/nibiru/x/common/asset.Pair" json:"pair"`
+	Tripped bool                                              `protobuf:"varint,2,opt,name=tripped,proto3" json:"tripped,omitempty"`
+	Reason  CircuitBreakerReason                              `protobuf:"varint,3,opt,name=reason,proto3,enum=nibiru.perp.v2.CircuitBreakerReason" json:"reason,omitempty"`
+	// the block height and time at which the breaker was tripped
+	TrippedBlockHeight int64 `protobuf:"varint,4,opt,name=tripped_block_height,json=trippedBlockHeight,proto3" json:"tripped_block_height,omitempty"`
+	TrippedBlockTimeMs int64 `protobuf:"varint,5,opt,name=tripped_block_time_ms,json=trippedBlockTimeMs,proto3" json:"tripped_block_time_ms,omitempty"`
+	// the number of consecutive blocks whose mark price broke the price
+	// fluctuation limit
+	FluctuationViolations uint64 `protobuf:"varint,6,opt,name=fluctuation_violations,json=fluctuationViolations,proto3" json:"fluctuation_violations,omitempty"`
 }
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *CircuitBreaker) Reset()         { *m = CircuitBreaker{} }
+func (m *CircuitBreaker) String() string { return proto.CompactTextString(m) }
+func (*CircuitBreaker) ProtoMessage()    {}
+func (*CircuitBreaker) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9a497e70afa7e7d6, []int{10}
+}
+func (m *CircuitBreaker) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CircuitBreaker) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CircuitBreaker.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	return n
+}
+func (m *CircuitBreaker) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CircuitBreaker.Merge(m, src)
+}
+func (m *CircuitBreaker) XXX_Size() int {
+	return m.Size()
+}
+func (m *CircuitBreaker) XXX_DiscardUnknown() {
+	xxx_messageInfo_CircuitBreaker.DiscardUnknown(m)
 }
 
-func (m *Market) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Pair.Size()
-	n += 1 + l + sovState(uint64(l))
-	if m.Enabled {
-		n += 2
-	}
-	l = m.PriceFluctuationLimitRatio.Size()
-	n += 1 + l + sovState(uint64(l))
-	l = m.MaintenanceMarginRatio.Size()
-	n += 1 + l + sovState(uint64(l))
-	l = m.MaxLeverage.Size()
-	n += 1 + l + sovState(uint64(l))
-	l = m.LatestCumulativePremiumFraction.Size()
-	n += 1 + l + sovState(uint64(l))
-	l = m.ExchangeFeeRatio.Size()
-	n += 1 + l + sovState(uint64(l))
-	l = m.EcosystemFundFeeRatio.Size()
-	n += 1 + l + sovState(uint64(l))
-	l = m.LiquidationFeeRatio.Size()
-	n += 1 + l + sovState(uint64(l))
-	l = m.PartialLiquidationRatio.Size()
-	n += 1 + l + sovState(uint64(l))
-	l = len(m.FundingRateEpochId)
-	if l > 0 {
-		n += 1 + l + sovState(uint64(l))
+var xxx_messageInfo_CircuitBreaker proto.InternalMessageInfo
+
+func (m *CircuitBreaker) GetTripped() bool {
+	if m != nil {
+		return m.Tripped
 	}
-	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.TwapLookbackWindow)
-	n += 1 + l + sovState(uint64(l))
-	l = m.PrepaidBadDebt.Size()
-	n += 1 + l + sovState(uint64(l))
-	return n
+	return false
 }
 
-func (m *AMM) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *CircuitBreaker) GetReason() CircuitBreakerReason {
+	if m != nil {
+		return m.Reason
 	}
-	var l int
-	_ = l
-	l = m.Pair.Size()
-	n += 1 + l + sovState(uint64(l))
-	l = m.BaseReserve.Size()
-	n += 1 + l + sovState(uint64(l))
-	l = m.QuoteReserve.Size()
-	n += 1 + l + sovState(uint64(l))
-	l = m.SqrtDepth.Size()
-	n += 1 + l + sovState(uint64(l))
-	l = m.PriceMultiplier.Size()
-	n += 1 + l + sovState(uint64(l))
-	l = m.TotalLong.Size()
-	n += 1 + l + sovState(uint64(l))
-	l = m.TotalShort.Size()
-	n += 1 + l + sovState(uint64(l))
-	return n
+	return CircuitBreakerReason_CIRCUIT_BREAKER_REASON_UNSPECIFIED
 }
 
-func (m *Position) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *CircuitBreaker) GetTrippedBlockHeight() int64 {
+	if m != nil {
+		return m.TrippedBlockHeight
 	}
-	var l int
-	_ = l
-	l = len(m.TraderAddress)
-	if l > 0 {
-		n += 1 + l + sovState(uint64(l))
+	return 0
+}
+
+func (m *CircuitBreaker) GetTrippedBlockTimeMs() int64 {
+	if m != nil {
+		return m.TrippedBlockTimeMs
 	}
-	l = m.Pair.Size()
-	n += 1 + l + sovState(uint64(l))
-	l = m.Size_.Size()
-	n += 1 + l + sovState(uint64(l))
-	l = m.Margin.Size()
-	n += 1 + l + sovState(uint64(l))
-	l = m.OpenNotional.Size()
-	n += 1 + l + sovState(uint64(l))
-	l = m.LatestCumulativePremiumFraction.Size()
-	n += 1 + l + sovState(uint64(l))
-	if m.LastUpdatedBlockNumber != 0 {
-		n += 1 + sovState(uint64(m.LastUpdatedBlockNumber))
+	return 0
+}
+
+func (m *CircuitBreaker) GetFluctuationViolations() uint64 {
+	if m != nil {
+		return m.FluctuationViolations
 	}
-	return n
+	return 0
 }
 
-func (m *ReserveSnapshot) Size() (n int) {
-	if m == nil {
-		return 0
+// The open interest of a market: the sum of the sizes of all long positions
+// and the sum of the sizes of all short positions, in base asset units.
+type OpenInterest struct {
+	Pair  github_com_NibiruChain_nibiru_x_common_asset.Pair `protobuf:"bytes,1,opt,name=pair,proto3,customtype=github.com/NibiruChain/nibiru/x/common/asset.Pair" json:"pair"`
+	Long  github_com_cosmos_cosmos_sdk_types.Dec            `protobuf:"bytes,2,opt,name=long,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"long"`
+	Short github_com_cosmos_cosmos_sdk_types.Dec            `protobuf:"bytes,3,opt,name=short,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"short"`
+}
+
+func (m *OpenInterest) Reset()         { *m = OpenInterest{} }
+func (m *OpenInterest) String() string { return proto.CompactTextString(m) }
+func (*OpenInterest) ProtoMessage()    {}
+func (*OpenInterest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9a497e70afa7e7d6, []int{11}
+}
+func (m *OpenInterest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OpenInterest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OpenInterest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	l = m.Amm.Size()
-	n += 1 + l + sovState(uint64(l))
-	if m.TimestampMs != 0 {
-		n += 1 + sovState(uint64(m.TimestampMs))
+}
+func (m *OpenInterest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OpenInterest.Merge(m, src)
+}
+func (m *OpenInterest) XXX_Size() int {
+	return m.Size()
+}
+func (m *OpenInterest) XXX_DiscardUnknown() {
+	xxx_messageInfo_OpenInterest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OpenInterest proto.InternalMessageInfo
+
+type AMM struct {
+	// identifies the market this AMM belongs to
+	Pair github_com_NibiruChain_nibiru_x_common_asset.Pair `protobuf:"bytes,1,opt,name=pair,proto3,customtype=github.com/NibiruChain/nibiru/x/common/asset.Pair" json:"pair"`
+	// the amount of base reserves this AMM has
+	BaseReserve github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=base_reserve,json=baseReserve,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"base_reserve"`
+	// the amount of quote reserves this AMM has
+	QuoteReserve github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=quote_reserve,json=quoteReserve,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"quote_reserve"`
+	// sqrt(k)
+	SqrtDepth github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=sqrt_depth,json=sqrtDepth,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"sqrt_depth"`
+	// the price multiplier of the dynamic AMM
+	PriceMultiplier github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=price_multiplier,json=priceMultiplier,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"price_multiplier"`
+	// Total long refers to the sum of long open notional in base.
+	TotalLong github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=total_long,json=totalLong,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"total_long"`
+	// Total short refers to the sum of short open notional in base.
+	TotalShort github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,7,opt,name=total_short,json=totalShort,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"total_short"`
+}
+
+func (m *AMM) Reset()         { *m = AMM{} }
+func (m *AMM) String() string { return proto.CompactTextString(m) }
+func (*AMM) ProtoMessage()    {}
+func (*AMM) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9a497e70afa7e7d6, []int{12}
+}
+func (m *AMM) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AMM) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AMM.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *AMM) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AMM.Merge(m, src)
+}
+func (m *AMM) XXX_Size() int {
+	return m.Size()
+}
+func (m *AMM) XXX_DiscardUnknown() {
+	xxx_messageInfo_AMM.DiscardUnknown(m)
 }
 
-func sovState(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+var xxx_messageInfo_AMM proto.InternalMessageInfo
+
+type Position struct {
+	// address identifies the address owner of this position
+	TraderAddress string `protobuf:"bytes,1,opt,name=trader_address,json=traderAddress,proto3" json:"trader_address,omitempty"`
+	// pair identifies the pair associated with this position
+	Pair github_com_NibiruChain_nibiru_x_common_asset.Pair `protobuf:"bytes,2,opt,name=pair,proto3,customtype=github.com/NibiruChain/nibiru/x/common/asset.Pair" json:"pair"`
+	// the position size
+	Size_ github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=size,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"size"`
+	// amount of margin remaining in the position
+	Margin github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=margin,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"margin"`
+	// value of position in quote assets when opened
+	OpenNotional github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=open_notional,json=openNotional,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"open_notional"`
+	// The most recent cumulative premium fraction this position has.
+	// Used to calculate the next funding payment.
+	LatestCumulativePremiumFraction github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=latest_cumulative_premium_fraction,json=latestCumulativePremiumFraction,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"latest_cumulative_premium_fraction"`
+	// last block number this position was updated
+	LastUpdatedBlockNumber int64 `protobuf:"varint,7,opt,name=last_updated_block_number,json=lastUpdatedBlockNumber,proto3" json:"last_updated_block_number,omitempty"`
 }
-func sozState(x uint64) (n int) {
-	return sovState(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *Position) Reset()         { *m = Position{} }
+func (m *Position) String() string { return proto.CompactTextString(m) }
+func (*Position) ProtoMessage()    {}
+func (*Position) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9a497e70afa7e7d6, []int{13}
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowState
+func (m *Position) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Position) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Position.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Position) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Position.Merge(m, src)
+}
+func (m *Position) XXX_Size() int {
+	return m.Size()
+}
+func (m *Position) XXX_DiscardUnknown() {
+	xxx_messageInfo_Position.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Position proto.InternalMessageInfo
+
+func (m *Position) GetTraderAddress() string {
+	if m != nil {
+		return m.TraderAddress
+	}
+	return ""
+}
+
+func (m *Position) GetLastUpdatedBlockNumber() int64 {
+	if m != nil {
+		return m.LastUpdatedBlockNumber
+	}
+	return 0
+}
+
+// a snapshot of the perp.amm's reserves at a given point in time
+type ReserveSnapshot struct {
+	Amm AMM `protobuf:"bytes,1,opt,name=amm,proto3" json:"amm"`
+	// milliseconds since unix epoch
+	TimestampMs int64 `protobuf:"varint,2,opt,name=timestamp_ms,json=timestampMs,proto3" json:"timestamp_ms,omitempty"`
+}
+
+func (m *ReserveSnapshot) Reset()         { *m = ReserveSnapshot{} }
+func (m *ReserveSnapshot) String() string { return proto.CompactTextString(m) }
+func (*ReserveSnapshot) ProtoMessage()    {}
+func (*ReserveSnapshot) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9a497e70afa7e7d6, []int{14}
+}
+func (m *ReserveSnapshot) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReserveSnapshot) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReserveSnapshot.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ReserveSnapshot) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReserveSnapshot.Merge(m, src)
+}
+func (m *ReserveSnapshot) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReserveSnapshot) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReserveSnapshot.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReserveSnapshot proto.InternalMessageInfo
+
+func (m *ReserveSnapshot) GetAmm() AMM {
+	if m != nil {
+		return m.Amm
+	}
+	return AMM{}
+}
+
+func (m *ReserveSnapshot) GetTimestampMs() int64 {
+	if m != nil {
+		return m.TimestampMs
+	}
+	return 0
+}
+
+// A conditional order resting in the perp keeper until its trigger price is
+// crossed.
+type TriggerOrder struct {
+	// unique identifier of the order
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// owner of the order
+	TraderAddress string `protobuf:"bytes,2,opt,name=trader_address,json=traderAddress,proto3" json:"trader_address,omitempty"`
+	// the market the order is placed on
+	Pair      github_com_NibiruChain_nibiru_x_common_asset.Pair `protobuf:"bytes,3,opt,name=pair,proto3,customtype=github.com/NibiruChain/nibiru/x/common/asset.Pair" json:"pair"`
+	OrderType TriggerOrderType                                  `protobuf:"varint,4,opt,name=order_type,json=orderType,proto3,enum=nibiru.perp.v2.TriggerOrderType" json:"order_type,omitempty"`
+	// the direction of the position to open. Only used by LIMIT_OPEN orders.
+	Side Direction `protobuf:"varint,5,opt,name=side,proto3,enum=nibiru.perp.v2.Direction" json:"side,omitempty"`
+	// the price at which the order is executed
+	TriggerPrice github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=trigger_price,json=triggerPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"trigger_price"`
+	PriceSource  TriggerPriceSource                     `protobuf:"varint,7,opt,name=price_source,json=priceSource,proto3,enum=nibiru.perp.v2.TriggerPriceSource" json:"price_source,omitempty"`
+	// the collateral escrowed in the vault for a LIMIT_OPEN order
+	Collateral types.Coin `protobuf:"bytes,8,opt,name=collateral,proto3" json:"collateral"`
+	// the leverage of the position to open. Only used by LIMIT_OPEN orders.
+	Leverage github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,9,opt,name=leverage,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"leverage"`
+	// the minimum base asset amount to receive. Only used by LIMIT_OPEN orders.
+	BaseAssetAmountLimit github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,10,opt,name=base_asset_amount_limit,json=baseAssetAmountLimit,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"base_asset_amount_limit"`
+	// block height at which the order was placed
+	CreatedBlockHeight int64 `protobuf:"varint,11,opt,name=created_block_height,json=createdBlockHeight,proto3" json:"created_block_height,omitempty"`
+}
+
+func (m *TriggerOrder) Reset()         { *m = TriggerOrder{} }
+func (m *TriggerOrder) String() string { return proto.CompactTextString(m) }
+func (*TriggerOrder) ProtoMessage()    {}
+func (*TriggerOrder) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9a497e70afa7e7d6, []int{15}
+}
+func (m *TriggerOrder) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TriggerOrder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TriggerOrder.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TriggerOrder) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TriggerOrder.Merge(m, src)
+}
+func (m *TriggerOrder) XXX_Size() int {
+	return m.Size()
+}
+func (m *TriggerOrder) XXX_DiscardUnknown() {
+	xxx_messageInfo_TriggerOrder.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TriggerOrder proto.InternalMessageInfo
+
+func (m *TriggerOrder) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *TriggerOrder) GetTraderAddress() string {
+	if m != nil {
+		return m.TraderAddress
+	}
+	return ""
+}
+
+func (m *TriggerOrder) GetOrderType() TriggerOrderType {
+	if m != nil {
+		return m.OrderType
+	}
+	return TriggerOrderType_TRIGGER_ORDER_TYPE_UNSPECIFIED
+}
+
+func (m *TriggerOrder) GetSide() Direction {
+	if m != nil {
+		return m.Side
+	}
+	return Direction_DIRECTION_UNSPECIFIED
+}
+
+func (m *TriggerOrder) GetPriceSource() TriggerPriceSource {
+	if m != nil {
+		return m.PriceSource
+	}
+	return TriggerPriceSource_TRIGGER_PRICE_SOURCE_UNSPECIFIED
+}
+
+func (m *TriggerOrder) GetCollateral() types.Coin {
+	if m != nil {
+		return m.Collateral
+	}
+	return types.Coin{}
+}
+
+func (m *TriggerOrder) GetCreatedBlockHeight() int64 {
+	if m != nil {
+		return m.CreatedBlockHeight
+	}
+	return 0
+}
+
+// A Dutch auction of an underwater position of a market in AUCTION
+// liquidation mode. Whitelisted liquidators take over the position, or part
+// of it, at the index price less a discount growing every block.
+type LiquidationAuction struct {
+	Pair github_com_NibiruChain_nibiru_x_common_asset.Pair `protobuf:"bytes,1,opt,name=pair,proto3,customtype=github.com/NibiruChain/nibiru/x/common/asset.Pair" json:"pair"`
+	// owner of the auctioned position
+	TraderAddress string `protobuf:"bytes,2,opt,name=trader_address,json=traderAddress,proto3" json:"trader_address,omitempty"`
+	// the account that started the auction. It's paid the liquidation fee if
+	// the auction ends unfilled and the position is liquidated into the AMM.
+	InitiatorAddress string `protobuf:"bytes,3,opt,name=initiator_address,json=initiatorAddress,proto3" json:"initiator_address,omitempty"`
+	// block height at which the auction started
+	StartBlockHeight int64 `protobuf:"varint,4,opt,name=start_block_height,json=startBlockHeight,proto3" json:"start_block_height,omitempty"`
+}
+
+func (m *LiquidationAuction) Reset()         { *m = LiquidationAuction{} }
+func (m *LiquidationAuction) String() string { return proto.CompactTextString(m) }
+func (*LiquidationAuction) ProtoMessage()    {}
+func (*LiquidationAuction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9a497e70afa7e7d6, []int{16}
+}
+func (m *LiquidationAuction) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LiquidationAuction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LiquidationAuction.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LiquidationAuction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LiquidationAuction.Merge(m, src)
+}
+func (m *LiquidationAuction) XXX_Size() int {
+	return m.Size()
+}
+func (m *LiquidationAuction) XXX_DiscardUnknown() {
+	xxx_messageInfo_LiquidationAuction.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LiquidationAuction proto.InternalMessageInfo
+
+func (m *LiquidationAuction) GetTraderAddress() string {
+	if m != nil {
+		return m.TraderAddress
+	}
+	return ""
+}
+
+func (m *LiquidationAuction) GetInitiatorAddress() string {
+	if m != nil {
+		return m.InitiatorAddress
+	}
+	return ""
+}
+
+func (m *LiquidationAuction) GetStartBlockHeight() int64 {
+	if m != nil {
+		return m.StartBlockHeight
+	}
+	return 0
+}
+
+// The aggregated state of the positions of a cross-margin trader whose markets
+// share a quote denom. The notional of every position is the larger of its
+// spot and TWAP notional.
+type CrossMarginAccountSummary struct {
+	TraderAddress string `protobuf:"bytes,1,opt,name=trader_address,json=traderAddress,proto3" json:"trader_address,omitempty"`
+	// the quote denom the positions are margined in
+	QuoteDenom string `protobuf:"bytes,2,opt,name=quote_denom,json=quoteDenom,proto3" json:"quote_denom,omitempty"`
+	// the number of open positions in the account
+	NumPositions uint64 `protobuf:"varint,3,opt,name=num_positions,json=numPositions,proto3" json:"num_positions,omitempty"`
+	// the sum of the margin of the positions
+	TotalMargin github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=total_margin,json=totalMargin,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"total_margin"`
+	// the sum of the unrealized PnL of the positions
+	UnrealizedPnl github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=unrealized_pnl,json=unrealizedPnl,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"unrealized_pnl"`
+	// the sum of the pending funding payments of the positions
+	FundingPayment github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=funding_payment,json=fundingPayment,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"funding_payment"`
+	// the sum of the notional of the positions
+	TotalNotional github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,7,opt,name=total_notional,json=totalNotional,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"total_notional"`
+	// the sum of the notional of every position times the maintenance margin
+	// ratio of its market
+	MaintenanceMarginRequirement github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,8,opt,name=maintenance_margin_requirement,json=maintenanceMarginRequirement,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"maintenance_margin_requirement"`
+	// total_margin + unrealized_pnl - funding_payment
+	Equity github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,9,opt,name=equity,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"equity"`
+	// the margin, net of funding and unrealized losses, in excess of the
+	// maintenance margin requirement
+	FreeCollateral github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,10,opt,name=free_collateral,json=freeCollateral,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"free_collateral"`
+	// equity / total_notional
+	MarginRatio github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,11,opt,name=margin_ratio,json=marginRatio,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"margin_ratio"`
+}
+
+func (m *CrossMarginAccountSummary) Reset()         { *m = CrossMarginAccountSummary{} }
+func (m *CrossMarginAccountSummary) String() string { return proto.CompactTextString(m) }
+func (*CrossMarginAccountSummary) ProtoMessage()    {}
+func (*CrossMarginAccountSummary) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9a497e70afa7e7d6, []int{17}
+}
+func (m *CrossMarginAccountSummary) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CrossMarginAccountSummary) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CrossMarginAccountSummary.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CrossMarginAccountSummary) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CrossMarginAccountSummary.Merge(m, src)
+}
+func (m *CrossMarginAccountSummary) XXX_Size() int {
+	return m.Size()
+}
+func (m *CrossMarginAccountSummary) XXX_DiscardUnknown() {
+	xxx_messageInfo_CrossMarginAccountSummary.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CrossMarginAccountSummary proto.InternalMessageInfo
+
+func (m *CrossMarginAccountSummary) GetTraderAddress() string {
+	if m != nil {
+		return m.TraderAddress
+	}
+	return ""
+}
+
+func (m *CrossMarginAccountSummary) GetQuoteDenom() string {
+	if m != nil {
+		return m.QuoteDenom
+	}
+	return ""
+}
+
+func (m *CrossMarginAccountSummary) GetNumPositions() uint64 {
+	if m != nil {
+		return m.NumPositions
+	}
+	return 0
+}
+
+// The accounting of the insurance fund of a market. The funds of every market
+// are held by the insurance fund module account.
+type InsuranceFund struct {
+	Pair github_com_NibiruChain_nibiru_x_common_asset.Pair `protobuf:"bytes,1,opt,name=pair,proto3,customtype=github.com/NibiruChain/nibiru/x/common/asset.Pair" json:"pair"`
+	// the amount available to cover the bad debt of the market
+	Balance types.Coin `protobuf:"bytes,2,opt,name=balance,proto3" json:"balance"`
+	// the sum of all the fees paid into the fund
+	TotalContributions types.Coin `protobuf:"bytes,3,opt,name=total_contributions,json=totalContributions,proto3" json:"total_contributions"`
+	// the sum of all the draw-downs of the fund
+	TotalDrawDowns types.Coin `protobuf:"bytes,4,opt,name=total_draw_downs,json=totalDrawDowns,proto3" json:"total_draw_downs"`
+}
+
+func (m *InsuranceFund) Reset()         { *m = InsuranceFund{} }
+func (m *InsuranceFund) String() string { return proto.CompactTextString(m) }
+func (*InsuranceFund) ProtoMessage()    {}
+func (*InsuranceFund) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9a497e70afa7e7d6, []int{18}
+}
+func (m *InsuranceFund) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *InsuranceFund) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_InsuranceFund.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *InsuranceFund) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InsuranceFund.Merge(m, src)
+}
+func (m *InsuranceFund) XXX_Size() int {
+	return m.Size()
+}
+func (m *InsuranceFund) XXX_DiscardUnknown() {
+	xxx_messageInfo_InsuranceFund.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InsuranceFund proto.InternalMessageInfo
+
+func (m *InsuranceFund) GetBalance() types.Coin {
+	if m != nil {
+		return m.Balance
+	}
+	return types.Coin{}
+}
+
+func (m *InsuranceFund) GetTotalContributions() types.Coin {
+	if m != nil {
+		return m.TotalContributions
+	}
+	return types.Coin{}
+}
+
+func (m *InsuranceFund) GetTotalDrawDowns() types.Coin {
+	if m != nil {
+		return m.TotalDrawDowns
+	}
+	return types.Coin{}
+}
+
+// A withdrawal from the insurance fund of a market to cover bad debt.
+type InsuranceFundDrawDown struct {
+	// unique identifier of the draw-down
+	Id   uint64                                            `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Pair github_com_NibiruChain_nibiru_x_common_asset.Pair `protobuf:"bytes,2,opt,name=pair,proto3,customtype=github.com/NibiruChain/nibiru/x/common/asset.Pair" json:"pair"`
+	// the amount sent from the insurance fund to the vault
+	Amount types.Coin `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount"`
+	// the bad debt the draw-down was made for, net of the market's prepaid bad
+	// debt. The part not covered by the draw-down is taken from the ecosystem
+	// fund.
+	BadDebt     types.Coin `protobuf:"bytes,4,opt,name=bad_debt,json=badDebt,proto3" json:"bad_debt"`
+	BlockHeight int64      `protobuf:"varint,5,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	// milliseconds since unix epoch
+	BlockTimeMs int64 `protobuf:"varint,6,opt,name=block_time_ms,json=blockTimeMs,proto3" json:"block_time_ms,omitempty"`
+}
+
+func (m *InsuranceFundDrawDown) Reset()         { *m = InsuranceFundDrawDown{} }
+func (m *InsuranceFundDrawDown) String() string { return proto.CompactTextString(m) }
+func (*InsuranceFundDrawDown) ProtoMessage()    {}
+func (*InsuranceFundDrawDown) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9a497e70afa7e7d6, []int{19}
+}
+func (m *InsuranceFundDrawDown) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *InsuranceFundDrawDown) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_InsuranceFundDrawDown.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *InsuranceFundDrawDown) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InsuranceFundDrawDown.Merge(m, src)
+}
+func (m *InsuranceFundDrawDown) XXX_Size() int {
+	return m.Size()
+}
+func (m *InsuranceFundDrawDown) XXX_DiscardUnknown() {
+	xxx_messageInfo_InsuranceFundDrawDown.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InsuranceFundDrawDown proto.InternalMessageInfo
+
+func (m *InsuranceFundDrawDown) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *InsuranceFundDrawDown) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return types.Coin{}
+}
+
+func (m *InsuranceFundDrawDown) GetBadDebt() types.Coin {
+	if m != nil {
+		return m.BadDebt
+	}
+	return types.Coin{}
+}
+
+func (m *InsuranceFundDrawDown) GetBlockHeight() int64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+func (m *InsuranceFundDrawDown) GetBlockTimeMs() int64 {
+	if m != nil {
+		return m.BlockTimeMs
+	}
+	return 0
+}
+
+// FundingRate records the funding paid in a market at the end of a funding
+// interval.
+type FundingRate struct {
+	Pair github_com_NibiruChain_nibiru_x_common_asset.Pair `protobuf:"bytes,1,opt,name=pair,proto3,customtype=github.com/NibiruChain/nibiru/x/common/asset.Pair" json:"pair"`
+	// the number of the epoch that ended the funding interval
+	EpochNumber uint64                                 `protobuf:"varint,2,opt,name=epoch_number,json=epochNumber,proto3" json:"epoch_number,omitempty"`
+	MarkTwap    github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=mark_twap,json=markTwap,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"mark_twap"`
+	IndexTwap   github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=index_twap,json=indexTwap,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"index_twap"`
+	// (mark twap - index twap) / index twap / funding intervals per day
+	PremiumRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=premium_rate,json=premiumRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"premium_rate"`
+	// the interest rate of the market, per funding interval
+	InterestRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=interest_rate,json=interestRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"interest_rate"`
+	// premium rate + interest rate, clamped to the market's funding rate bounds
+	FundingRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,7,opt,name=funding_rate,json=fundingRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"funding_rate"`
+	// whether the funding rate was clamped
+	Clamped bool `protobuf:"varint,8,opt,name=clamped,proto3" json:"clamped,omitempty"`
+	// the funding paid per unit of position size, funding rate * index twap
+	PremiumFraction github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,9,opt,name=premium_fraction,json=premiumFraction,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"premium_fraction"`
+	// the cumulative premium fraction of the market after the funding interval
+	CumulativePremiumFraction github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,10,opt,name=cumulative_premium_fraction,json=cumulativePremiumFraction,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"cumulative_premium_fraction"`
+	BlockHeight               int64                                  `protobuf:"varint,11,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	// milliseconds since unix epoch
+	BlockTimeMs int64 `protobuf:"varint,12,opt,name=block_time_ms,json=blockTimeMs,proto3" json:"block_time_ms,omitempty"`
+}
+
+func (m *FundingRate) Reset()         { *m = FundingRate{} }
+func (m *FundingRate) String() string { return proto.CompactTextString(m) }
+func (*FundingRate) ProtoMessage()    {}
+func (*FundingRate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9a497e70afa7e7d6, []int{20}
+}
+func (m *FundingRate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FundingRate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FundingRate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FundingRate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FundingRate.Merge(m, src)
+}
+func (m *FundingRate) XXX_Size() int {
+	return m.Size()
+}
+func (m *FundingRate) XXX_DiscardUnknown() {
+	xxx_messageInfo_FundingRate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FundingRate proto.InternalMessageInfo
+
+func (m *FundingRate) GetEpochNumber() uint64 {
+	if m != nil {
+		return m.EpochNumber
+	}
+	return 0
+}
+
+func (m *FundingRate) GetClamped() bool {
+	if m != nil {
+		return m.Clamped
+	}
+	return false
+}
+
+func (m *FundingRate) GetBlockHeight() int64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+func (m *FundingRate) GetBlockTimeMs() int64 {
+	if m != nil {
+		return m.BlockTimeMs
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterEnum("nibiru.perp.v2.Direction", Direction_name, Direction_value)
+	proto.RegisterEnum("nibiru.perp.v2.TwapCalcOption", TwapCalcOption_name, TwapCalcOption_value)
+	proto.RegisterEnum("nibiru.perp.v2.LiquidationMode", LiquidationMode_name, LiquidationMode_value)
+	proto.RegisterEnum("nibiru.perp.v2.CircuitBreakerReason", CircuitBreakerReason_name, CircuitBreakerReason_value)
+	proto.RegisterEnum("nibiru.perp.v2.TriggerOrderType", TriggerOrderType_name, TriggerOrderType_value)
+	proto.RegisterEnum("nibiru.perp.v2.TriggerPriceSource", TriggerPriceSource_name, TriggerPriceSource_value)
+	proto.RegisterType((*Params)(nil), "nibiru.perp.v2.Params")
+	proto.RegisterType((*FeeTier)(nil), "nibiru.perp.v2.FeeTier")
+	proto.RegisterType((*TraderVolume)(nil), "nibiru.perp.v2.TraderVolume")
+	proto.RegisterType((*ReferralCode)(nil), "nibiru.perp.v2.ReferralCode")
+	proto.RegisterType((*Referral)(nil), "nibiru.perp.v2.Referral")
+	proto.RegisterType((*ReferralRebate)(nil), "nibiru.perp.v2.ReferralRebate")
+	proto.RegisterType((*TraderPnl)(nil), "nibiru.perp.v2.TraderPnl")
+	proto.RegisterType((*TraderHistoryEntry)(nil), "nibiru.perp.v2.TraderHistoryEntry")
+	proto.RegisterType((*Market)(nil), "nibiru.perp.v2.Market")
+	proto.RegisterType((*MarginTier)(nil), "nibiru.perp.v2.MarginTier")
+	proto.RegisterType((*CircuitBreaker)(nil), "nibiru.perp.v2.CircuitBreaker")
+	proto.RegisterType((*OpenInterest)(nil), "nibiru.perp.v2.OpenInterest")
+	proto.RegisterType((*AMM)(nil), "nibiru.perp.v2.AMM")
+	proto.RegisterType((*Position)(nil), "nibiru.perp.v2.Position")
+	proto.RegisterType((*ReserveSnapshot)(nil), "nibiru.perp.v2.ReserveSnapshot")
+	proto.RegisterType((*TriggerOrder)(nil), "nibiru.perp.v2.TriggerOrder")
+	proto.RegisterType((*LiquidationAuction)(nil), "nibiru.perp.v2.LiquidationAuction")
+	proto.RegisterType((*CrossMarginAccountSummary)(nil), "nibiru.perp.v2.CrossMarginAccountSummary")
+	proto.RegisterType((*InsuranceFund)(nil), "nibiru.perp.v2.InsuranceFund")
+	proto.RegisterType((*InsuranceFundDrawDown)(nil), "nibiru.perp.v2.InsuranceFundDrawDown")
+	proto.RegisterType((*FundingRate)(nil), "nibiru.perp.v2.FundingRate")
+}
+
+func init() { proto.RegisterFile("perp/v2/state.proto", fileDescriptor_9a497e70afa7e7d6) }
+
+var fileDescriptor_9a497e70afa7e7d6 = []byte{
+	// 3110 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x9a, 0x4b, 0x73, 0x1b, 0xc7,
+	0xf1, 0xc0, 0x05, 0x10, 0x82, 0x80, 0x06, 0x08, 0x42, 0xc3, 0xd7, 0x92, 0xb6, 0x29, 0x1a, 0x7f,
+	0xff, 0x5d, 0x2a, 0x39, 0x26, 0x22, 0xe6, 0xe1, 0xd8, 0x49, 0x25, 0x85, 0x17, 0x25, 0x58, 0x04,
+	0x01, 0x2d, 0x40, 0xcb, 0x76, 0xc5, 0xb5, 0x19, 0x60, 0x87, 0xe0, 0x86, 0xd8, 0x87, 0x66, 0x17,
+	0x7c, 0x28, 0x97, 0x7c, 0x84, 0x1c, 0x93, 0x5b, 0x3e, 0x83, 0x0f, 0xce, 0x29, 0x97, 0x1c, 0x5c,
+	0x3e, 0xe4, 0xe0, 0xaa, 0x5c, 0x52, 0x39, 0x38, 0x29, 0xbb, 0x52, 0xb9, 0xe7, 0x13, 0xa4, 0xe6,
+	0xb1, 0x8b, 0x05, 0x48, 0xd9, 0xcc, 0x08, 0xae, 0x9c, 0x88, 0xdd, 0xd9, 0xf9, 0xf5, 0x4c, 0x4f,
+	0x4f, 0x4f, 0x77, 0x0f, 0x61, 0xd9, 0x23, 0xd4, 0x2b, 0x9f, 0xee, 0x96, 0xfd, 0x00, 0x07, 0x64,
+	0xc7, 0xa3, 0x6e, 0xe0, 0xa2, 0x82, 0x63, 0xf5, 0x2d, 0x3a, 0xde, 0x61, 0x6d, 0x3b, 0xa7, 0xbb,
+	0x9b, 0x2b, 0x43, 0x77, 0xe8, 0xf2, 0xa6, 0x32, 0xfb, 0x25, 0xbe, 0xda, 0xdc, 0x1a, 0xb8, 0xbe,
+	0xed, 0xfa, 0xe5, 0x3e, 0xf6, 0x49, 0xf9, 0xf4, 0x7e, 0x9f, 0x04, 0xf8, 0x7e, 0x79, 0xe0, 0x5a,
+	0x8e, 0x6c, 0xdf, 0x10, 0xed, 0x86, 0xe8, 0x28, 0x1e, 0xc2, 0xae, 0x43, 0xd7, 0x1d, 0x8e, 0x48,
+	0x99, 0x3f, 0xf5, 0xc7, 0x47, 0x65, 0x73, 0x4c, 0x71, 0x60, 0xb9, 0xb2, 0x6b, 0xe9, 0xe3, 0x9b,
+	0x90, 0xee, 0x60, 0x8a, 0x6d, 0x1f, 0x69, 0x70, 0xcb, 0x0f, 0x5c, 0xcf, 0x23, 0xa6, 0x96, 0xd8,
+	0x4e, 0xdc, 0xcd, 0xe8, 0xe1, 0x23, 0x7a, 0x07, 0xb2, 0x47, 0x84, 0x18, 0x81, 0x45, 0xa8, 0xaf,
+	0x25, 0xb7, 0x17, 0xee, 0xe6, 0x76, 0xd7, 0x77, 0xa6, 0x47, 0xbe, 0xb3, 0x47, 0x48, 0xcf, 0x22,
+	0xb4, 0x9a, 0xfa, 0xec, 0x8b, 0x3b, 0x37, 0xf4, 0xcc, 0x91, 0x78, 0xf4, 0x91, 0x05, 0x1b, 0x7e,
+	0x80, 0x4f, 0x08, 0x35, 0x18, 0xc2, 0xb4, 0xfc, 0x81, 0x3b, 0x76, 0x02, 0x83, 0x0f, 0x42, 0x5b,
+	0xd8, 0x4e, 0xdc, 0xcd, 0x56, 0x77, 0x58, 0x97, 0xbf, 0x7d, 0x71, 0xe7, 0xf5, 0xa1, 0x15, 0x1c,
+	0x8f, 0xfb, 0x3b, 0x03, 0xd7, 0x96, 0x93, 0x90, 0x7f, 0xde, 0xf4, 0xcd, 0x93, 0x72, 0x70, 0xe1,
+	0x11, 0x7f, 0xa7, 0x4e, 0x06, 0xfa, 0x9a, 0x00, 0xee, 0x11, 0x52, 0x97, 0x38, 0x9d, 0xd1, 0xd0,
+	0x87, 0x70, 0x5b, 0x8a, 0xb2, 0x2d, 0xc7, 0xe8, 0xbb, 0x8e, 0x49, 0x4c, 0x2d, 0xf5, 0x5f, 0x8b,
+	0x68, 0x3a, 0x81, 0xbe, 0x24, 0x40, 0x2d, 0xcb, 0xa9, 0x72, 0x0c, 0xea, 0xc3, 0x2a, 0x25, 0x47,
+	0x84, 0x52, 0x3c, 0x32, 0x28, 0xe9, 0xe3, 0x80, 0xc8, 0x29, 0xdc, 0x54, 0x9a, 0xc2, 0x72, 0x08,
+	0xd3, 0x39, 0x4b, 0x8c, 0xff, 0xfb, 0xb0, 0x16, 0x50, 0x6c, 0x12, 0x6a, 0x1c, 0x5b, 0x7e, 0xe0,
+	0xd2, 0x0b, 0x83, 0x38, 0xb8, 0x3f, 0x22, 0xa6, 0x96, 0xe6, 0xeb, 0xb1, 0x22, 0x5a, 0x1f, 0x8a,
+	0xc6, 0x86, 0x68, 0x43, 0x1f, 0x81, 0x36, 0xd3, 0x8b, 0x92, 0x80, 0x38, 0x6c, 0x8d, 0xb5, 0x5b,
+	0xdb, 0x89, 0xbb, 0xb9, 0xdd, 0x8d, 0x1d, 0x61, 0x04, 0x3b, 0xa1, 0x11, 0xec, 0xd4, 0xa5, 0x11,
+	0x54, 0x33, 0x6c, 0xdc, 0xbf, 0xfd, 0xfb, 0x9d, 0x84, 0xbe, 0x36, 0x05, 0xd7, 0x43, 0x04, 0x2a,
+	0xc3, 0x32, 0x1e, 0x0f, 0xd8, 0x4f, 0x63, 0x64, 0x3d, 0x1d, 0x5b, 0x26, 0x0e, 0x5c, 0xea, 0x6b,
+	0x99, 0xed, 0x85, 0xbb, 0x59, 0x1d, 0xc9, 0xa6, 0xfd, 0x49, 0x0b, 0xc2, 0xb0, 0x49, 0x89, 0x4f,
+	0xe8, 0x29, 0x31, 0x7c, 0x07, 0x7b, 0xfe, 0xb1, 0x1b, 0xc4, 0x46, 0x94, 0xbd, 0xfe, 0x88, 0x34,
+	0x89, 0xe9, 0x4a, 0x4a, 0x34, 0xa6, 0xd2, 0x27, 0x09, 0xb8, 0x25, 0xed, 0x0d, 0xb5, 0x00, 0xd8,
+	0x6a, 0x9f, 0xba, 0xa3, 0xb1, 0x4d, 0xb4, 0x84, 0xd2, 0x6a, 0x64, 0x6d, 0xcb, 0x79, 0x8f, 0x03,
+	0xd0, 0xcf, 0x01, 0x5d, 0x61, 0xa7, 0x49, 0x25, 0x6c, 0xf1, 0x68, 0xc6, 0x42, 0x4b, 0xbf, 0x4e,
+	0x40, 0xbe, 0xc7, 0xf5, 0x2c, 0xc5, 0xad, 0x41, 0x5a, 0xe8, 0x5d, 0x8c, 0x5c, 0x97, 0x4f, 0xa8,
+	0x08, 0x0b, 0x26, 0xbe, 0xe0, 0x72, 0x53, 0x3a, 0xfb, 0x89, 0xf6, 0x20, 0x2d, 0xe7, 0xa8, 0xb6,
+	0x69, 0x64, 0xef, 0xd2, 0x4f, 0x21, 0xaf, 0x4b, 0xdb, 0xab, 0xb9, 0x26, 0x41, 0x08, 0x52, 0x03,
+	0xd7, 0x94, 0x9a, 0xd3, 0xf9, 0x6f, 0xb4, 0x09, 0x19, 0x61, 0x9f, 0x84, 0x8a, 0xa9, 0xeb, 0xd1,
+	0x73, 0xe9, 0x47, 0x90, 0x09, 0xfb, 0x33, 0x8f, 0xc1, 0xdf, 0x93, 0xb0, 0x7b, 0xf8, 0x18, 0x51,
+	0x93, 0x13, 0x6a, 0x89, 0x40, 0x41, 0x9f, 0xb2, 0xfa, 0x29, 0x39, 0x89, 0x69, 0x39, 0xe8, 0x2d,
+	0x48, 0x8b, 0x7d, 0xa6, 0x25, 0xa5, 0xc9, 0x48, 0xbf, 0xc6, 0x9c, 0xe0, 0x8e, 0x74, 0x82, 0x3b,
+	0x35, 0xd7, 0x72, 0xa4, 0xcb, 0x91, 0x9f, 0x97, 0xfe, 0xb8, 0x00, 0x59, 0xa1, 0xe3, 0x8e, 0x33,
+	0x7a, 0xae, 0x82, 0x5b, 0x90, 0xf2, 0xb0, 0x25, 0xa7, 0x57, 0x7d, 0x5b, 0x2a, 0xf3, 0x7e, 0x4c,
+	0x99, 0x07, 0xdc, 0xbf, 0xd5, 0x8e, 0xb1, 0xe5, 0x94, 0x85, 0xaf, 0x2b, 0x9f, 0x97, 0x07, 0xae,
+	0x6d, 0xbb, 0x4e, 0x19, 0xfb, 0x3e, 0x09, 0x76, 0x3a, 0xd8, 0xa2, 0x3a, 0xc7, 0xa0, 0xc7, 0x90,
+	0xa7, 0x04, 0x8f, 0xac, 0x67, 0xc4, 0x34, 0x3c, 0x67, 0xa4, 0xb8, 0x46, 0xb9, 0x90, 0xc1, 0x46,
+	0xfe, 0x04, 0x96, 0x8e, 0xc6, 0x8e, 0x69, 0x39, 0x43, 0xc3, 0xc3, 0x17, 0x36, 0x71, 0x02, 0x2d,
+	0xa5, 0x44, 0x2d, 0x48, 0x4c, 0x47, 0x50, 0x50, 0x15, 0x52, 0x47, 0x84, 0xf8, 0x8a, 0x9e, 0x8b,
+	0xf7, 0x45, 0x1f, 0x40, 0x31, 0xf4, 0x06, 0xdc, 0x33, 0xb8, 0xbe, 0xaf, 0xa5, 0x95, 0x78, 0x4b,
+	0x31, 0xce, 0xbe, 0xeb, 0xfb, 0xa5, 0x7f, 0x67, 0x00, 0xf5, 0xa6, 0x1d, 0x5d, 0x40, 0x2f, 0x50,
+	0x01, 0x92, 0x96, 0x38, 0x98, 0x52, 0x7a, 0xd2, 0x32, 0x63, 0x0b, 0x9b, 0xbc, 0x72, 0x61, 0x17,
+	0xe6, 0xb3, 0xb0, 0x87, 0x50, 0x20, 0xe7, 0x83, 0x63, 0xec, 0x0c, 0x89, 0x69, 0xf8, 0xd6, 0x33,
+	0xa2, 0xb8, 0x08, 0x8b, 0x11, 0xa5, 0x6b, 0x3d, 0x23, 0xe8, 0x23, 0x40, 0x13, 0xac, 0xe3, 0xb2,
+	0xc9, 0xe3, 0x91, 0xe2, 0x8a, 0xdc, 0x8e, 0x48, 0x07, 0x12, 0x74, 0xc9, 0x1c, 0xd3, 0xdf, 0x8a,
+	0x39, 0xde, 0x9a, 0xab, 0x39, 0x66, 0xe6, 0x6c, 0x8e, 0xd9, 0xb9, 0x98, 0x23, 0x3a, 0x82, 0xf5,
+	0xc1, 0xd8, 0x1e, 0x8f, 0x70, 0x60, 0x9d, 0x12, 0x63, 0x4a, 0xab, 0xa0, 0x24, 0x61, 0x75, 0x82,
+	0xd3, 0x63, 0xfa, 0x1d, 0xc1, 0x66, 0x4c, 0xce, 0xac, 0xaa, 0x73, 0x4a, 0xa2, 0xb4, 0x09, 0x71,
+	0x6f, 0x5a, 0xe9, 0x4f, 0x60, 0x29, 0x2e, 0x8d, 0xe9, 0x3f, 0xaf, 0xb6, 0x9a, 0x31, 0x11, 0x6c,
+	0x25, 0x1c, 0x78, 0x29, 0x06, 0xbe, 0xb4, 0x28, 0x8b, 0x4a, 0x42, 0x36, 0x26, 0xc8, 0xfd, 0x99,
+	0xe5, 0x79, 0x15, 0xf2, 0xfd, 0x91, 0x3b, 0x38, 0x31, 0x8e, 0x89, 0x35, 0x3c, 0x0e, 0xb4, 0xc2,
+	0x76, 0xe2, 0xee, 0x82, 0x9e, 0xe3, 0xef, 0x1e, 0xf2, 0x57, 0xa8, 0x04, 0x8b, 0xe2, 0x93, 0xc0,
+	0xb2, 0x89, 0x61, 0xfb, 0xda, 0x52, 0xec, 0x9b, 0x9e, 0x65, 0x93, 0x96, 0x5f, 0xfa, 0xc3, 0x2a,
+	0xa4, 0x5b, 0x98, 0x9e, 0x90, 0x20, 0x72, 0x20, 0x89, 0xf9, 0x38, 0x10, 0x0d, 0x6e, 0x85, 0x51,
+	0x5c, 0x52, 0x44, 0xd5, 0xf2, 0x11, 0x3d, 0x85, 0x57, 0x3c, 0x6a, 0x0d, 0x88, 0x71, 0x34, 0x1a,
+	0x0f, 0x82, 0xb1, 0xd4, 0x92, 0x65, 0x5b, 0x2f, 0x16, 0x1d, 0x6f, 0x72, 0xe8, 0xde, 0x84, 0xb9,
+	0xcf, 0x90, 0x22, 0xc2, 0x3c, 0x06, 0xcd, 0xc6, 0x96, 0x13, 0x10, 0x07, 0x3b, 0x03, 0x62, 0xd8,
+	0x98, 0x0e, 0x2d, 0x47, 0x4a, 0x53, 0xf3, 0x6b, 0x6b, 0x31, 0x5e, 0x8b, 0xe3, 0x84, 0xa4, 0xc7,
+	0x90, 0xb7, 0xf1, 0xb9, 0x31, 0x22, 0xa7, 0x84, 0xe2, 0x21, 0x51, 0x74, 0x6d, 0x39, 0x1b, 0x9f,
+	0xef, 0x4b, 0x04, 0xfa, 0x15, 0x94, 0x46, 0x38, 0x20, 0x7e, 0x60, 0xc4, 0x2c, 0xcc, 0xa3, 0xc4,
+	0xb6, 0xc6, 0xb6, 0x71, 0x44, 0x31, 0x8f, 0x44, 0x15, 0x5d, 0xdd, 0x1d, 0x41, 0xae, 0x45, 0xe0,
+	0x8e, 0xe0, 0xee, 0x49, 0x2c, 0x8b, 0x0b, 0x43, 0x37, 0xcb, 0x13, 0x19, 0xa1, 0x33, 0x35, 0x0f,
+	0x58, 0x0c, 0x49, 0x7b, 0x44, 0x46, 0xfe, 0x43, 0xd0, 0xc8, 0xc0, 0xf5, 0x2f, 0xfc, 0x80, 0xd8,
+	0x7c, 0xef, 0xc7, 0x64, 0xa8, 0xf9, 0xc5, 0xd5, 0x88, 0xc7, 0x76, 0x7e, 0x24, 0xa8, 0x0f, 0xab,
+	0xf1, 0x3d, 0x39, 0x91, 0xa2, 0xe6, 0x2d, 0x97, 0x63, 0xb0, 0x48, 0xc6, 0x2f, 0x61, 0xc3, 0xc3,
+	0x34, 0xb0, 0xf0, 0x68, 0x6a, 0xff, 0x0b, 0x39, 0x6a, 0x3e, 0x73, 0x5d, 0x02, 0x63, 0xbb, 0x5f,
+	0xc8, 0xba, 0x0f, 0xab, 0xa1, 0xab, 0xa4, 0x2c, 0x27, 0x23, 0x9e, 0x3b, 0x38, 0x36, 0x2c, 0x53,
+	0x38, 0x4c, 0x1d, 0xc9, 0x46, 0x1d, 0x07, 0xa4, 0xc1, 0x9a, 0x9a, 0x26, 0x3a, 0x84, 0x95, 0xe0,
+	0x0c, 0x7b, 0xc6, 0xc8, 0x75, 0x4f, 0xfa, 0x78, 0x70, 0x62, 0x9c, 0x59, 0x8e, 0xe9, 0x9e, 0x69,
+	0x79, 0x19, 0x66, 0x5e, 0x23, 0x33, 0x41, 0x0c, 0xb0, 0x2f, 0xfb, 0x3f, 0xe1, 0xdd, 0x51, 0x13,
+	0x8a, 0x1e, 0x25, 0x1e, 0xb6, 0x4c, 0xa3, 0x8f, 0x4d, 0xc3, 0x24, 0xfd, 0x40, 0x5b, 0x94, 0xc8,
+	0x6f, 0x88, 0x5c, 0x0b, 0xb2, 0x63, 0x15, 0x9b, 0x75, 0xd2, 0x0f, 0x98, 0x35, 0x58, 0x8e, 0x3f,
+	0xa6, 0x7c, 0x8f, 0x46, 0xd6, 0xe0, 0x1f, 0x63, 0x4a, 0xb4, 0x82, 0x92, 0xfe, 0x56, 0x23, 0x9e,
+	0xb4, 0x86, 0x2e, 0x83, 0xa1, 0x77, 0x60, 0x03, 0x8f, 0x03, 0xd7, 0x30, 0x89, 0xdc, 0xa7, 0x4c,
+	0x8f, 0xa1, 0xb7, 0x5a, 0xe2, 0xde, 0x6a, 0x9d, 0x7d, 0x50, 0x8f, 0xb5, 0x87, 0x69, 0xe7, 0xfb,
+	0x50, 0x64, 0x1b, 0x3c, 0xae, 0x7d, 0xad, 0xa8, 0x76, 0x84, 0xd8, 0xf8, 0x7c, 0x6f, 0xb2, 0x4e,
+	0x9c, 0x6c, 0x39, 0xd3, 0xe4, 0xdb, 0x8a, 0x64, 0xcb, 0x89, 0x93, 0xbb, 0xb0, 0xc8, 0x9c, 0x15,
+	0x65, 0x3e, 0x84, 0x63, 0x91, 0x12, 0x36, 0x1f, 0x42, 0xa2, 0xe1, 0xe2, 0x73, 0xc3, 0xf7, 0x28,
+	0xc1, 0xa6, 0xb4, 0xf2, 0x65, 0x65, 0x45, 0x74, 0x39, 0x46, 0x18, 0x77, 0x0f, 0x0a, 0x9c, 0x7c,
+	0x42, 0xce, 0x24, 0x77, 0x45, 0x6d, 0xbc, 0x8c, 0x7b, 0x42, 0xce, 0xa2, 0x2a, 0x09, 0xb6, 0x6d,
+	0x63, 0xec, 0x99, 0x6c, 0xc3, 0xf4, 0xc7, 0xe6, 0x90, 0x04, 0xda, 0xaa, 0x5a, 0x95, 0x04, 0xdb,
+	0xf6, 0x21, 0xe7, 0x54, 0x39, 0x06, 0x3d, 0x83, 0xd2, 0xc0, 0xa2, 0x83, 0xb1, 0x15, 0x18, 0x7d,
+	0x4a, 0x78, 0x29, 0x46, 0x1c, 0x71, 0xd2, 0x73, 0x8a, 0x59, 0xac, 0x29, 0xcd, 0x62, 0x4b, 0x92,
+	0xab, 0x02, 0xdc, 0x61, 0xdc, 0x1a, 0xc7, 0x8a, 0x79, 0x55, 0xe0, 0x95, 0x59, 0xd9, 0x62, 0x67,
+	0x1b, 0xfc, 0xa0, 0xf7, 0xb5, 0x75, 0x9e, 0x3b, 0x6c, 0x4e, 0x63, 0xc4, 0xee, 0xad, 0xf2, 0x2f,
+	0xd0, 0x87, 0x70, 0x6f, 0x16, 0xc1, 0x6d, 0x3c, 0x76, 0x3e, 0x9f, 0x5a, 0xee, 0x88, 0xff, 0xf2,
+	0x35, 0x8d, 0xf3, 0x5e, 0x9f, 0xe6, 0xb5, 0xf0, 0x79, 0xec, 0xe8, 0x7d, 0x2f, 0xfa, 0x9a, 0xa9,
+	0x9d, 0xb1, 0x5c, 0x8f, 0x38, 0x46, 0x68, 0x3f, 0xda, 0x86, 0x5a, 0x8c, 0x6a, 0xe3, 0xf3, 0xb6,
+	0x47, 0x9c, 0xa6, 0xc4, 0x30, 0xaf, 0xce, 0xd8, 0x9e, 0xeb, 0x5b, 0x7c, 0x90, 0x51, 0x42, 0xb1,
+	0xa9, 0xe6, 0xd5, 0x6d, 0x7c, 0xde, 0x91, 0xac, 0x28, 0xa5, 0xa8, 0xb1, 0x03, 0x9d, 0x87, 0x0b,
+	0xa2, 0x0c, 0xf8, 0x12, 0x2f, 0x03, 0x6e, 0xce, 0x96, 0x01, 0x45, 0x0c, 0x10, 0xab, 0x04, 0xe6,
+	0xec, 0xe8, 0x8d, 0x8f, 0xde, 0x9d, 0x8e, 0xd3, 0x6d, 0x56, 0x22, 0x78, 0x79, 0x3b, 0x71, 0xb7,
+	0xb0, 0x7b, 0x67, 0x16, 0x14, 0x73, 0xf5, 0x2d, 0xd7, 0x24, 0x53, 0x81, 0x39, 0x7b, 0x81, 0x4e,
+	0x60, 0x33, 0x2c, 0x4c, 0x45, 0xd5, 0x1a, 0x8f, 0x50, 0xb1, 0xda, 0xda, 0x2b, 0x6a, 0xe7, 0x8c,
+	0x24, 0x86, 0x55, 0x9b, 0x0e, 0xa1, 0xdc, 0x34, 0xd0, 0x2f, 0x60, 0x25, 0x14, 0xc6, 0x34, 0x1d,
+	0x0a, 0xd4, 0xb6, 0x94, 0xc4, 0x84, 0x65, 0xb3, 0x16, 0x3e, 0x0f, 0x25, 0xa1, 0x1f, 0xc2, 0x7a,
+	0x34, 0x1d, 0x79, 0xe2, 0x84, 0x86, 0x7b, 0x87, 0x1b, 0xda, 0x6a, 0x38, 0xb6, 0xf0, 0x3c, 0xe2,
+	0x8d, 0xa5, 0xdf, 0x27, 0x01, 0x26, 0x4a, 0x67, 0xf9, 0x6a, 0xb8, 0xfa, 0xc6, 0xd1, 0xc8, 0x75,
+	0xa9, 0x62, 0x49, 0x6c, 0x31, 0xa4, 0xec, 0x31, 0xc8, 0xd7, 0x06, 0x8e, 0xc9, 0x6f, 0x35, 0x70,
+	0x5c, 0x78, 0xe1, 0xc0, 0xb1, 0xf4, 0x97, 0x24, 0x14, 0x6a, 0x53, 0xbb, 0xf4, 0x5b, 0x08, 0xf2,
+	0x03, 0x6a, 0xf1, 0xd2, 0xb9, 0x0c, 0xf2, 0xe5, 0x23, 0xfa, 0x09, 0x2b, 0x63, 0x61, 0xdf, 0x75,
+	0xf8, 0x44, 0x0a, 0xbb, 0xaf, 0xcd, 0xda, 0xf9, 0xf4, 0xc0, 0x74, 0xfe, 0xad, 0x2e, 0xfb, 0xa0,
+	0xef, 0xc2, 0x8a, 0x04, 0x19, 0x53, 0x59, 0x4e, 0x8a, 0x67, 0x30, 0x48, 0xb6, 0x55, 0x63, 0xc9,
+	0xce, 0x7d, 0x58, 0x9d, 0xee, 0x11, 0x26, 0x3d, 0x37, 0x2f, 0x77, 0x11, 0xb9, 0x0f, 0xfa, 0x01,
+	0xac, 0x3d, 0xc7, 0xc3, 0xa5, 0x85, 0xe1, 0x1d, 0x5d, 0xe5, 0xd0, 0x4a, 0xff, 0x4a, 0x40, 0x7e,
+	0xca, 0x0b, 0xcd, 0x59, 0xa7, 0x55, 0x48, 0x8d, 0x5c, 0x67, 0xa8, 0x68, 0x5e, 0xbc, 0x2f, 0xaa,
+	0xc3, 0x4d, 0xff, 0xd8, 0xa5, 0x81, 0xa2, 0x15, 0x89, 0xce, 0xa5, 0x4f, 0x53, 0xb0, 0x50, 0x69,
+	0xb5, 0xe6, 0x3d, 0xc1, 0xc7, 0x90, 0x67, 0x11, 0xa1, 0x21, 0xcb, 0xdc, 0x8a, 0x13, 0xcd, 0x31,
+	0x86, 0x2e, 0x10, 0x2c, 0xc0, 0x79, 0x3a, 0x76, 0x83, 0x09, 0x53, 0x6d, 0xde, 0x79, 0x0e, 0x09,
+	0xa1, 0x2d, 0x00, 0xff, 0x29, 0x0d, 0x0c, 0x93, 0x78, 0xc1, 0xb1, 0x62, 0x9a, 0x98, 0x65, 0x84,
+	0x3a, 0x03, 0xb0, 0x5a, 0x8d, 0x88, 0x09, 0xec, 0xf1, 0x28, 0xb0, 0xbc, 0x91, 0x45, 0xa8, 0x62,
+	0x76, 0xb8, 0xc4, 0x39, 0xad, 0x08, 0xc3, 0x46, 0x1a, 0xb8, 0x01, 0xcb, 0x3b, 0x98, 0xe1, 0xa8,
+	0x65, 0x82, 0x59, 0x4e, 0xd8, 0x67, 0xd6, 0xd3, 0x86, 0x9c, 0xc0, 0x09, 0x1b, 0x52, 0x4b, 0xf6,
+	0xc4, 0x88, 0xba, 0xdc, 0x90, 0x7e, 0x97, 0x82, 0x4c, 0x78, 0xb0, 0xa2, 0xff, 0x87, 0x82, 0xbc,
+	0xb7, 0xc1, 0xa6, 0x49, 0x89, 0xef, 0xcb, 0x0a, 0xf5, 0xa2, 0x78, 0x5b, 0x11, 0x2f, 0xe7, 0x5d,
+	0xa8, 0xae, 0x42, 0x8a, 0x57, 0x31, 0xd5, 0x0c, 0x83, 0xf7, 0x65, 0x57, 0x11, 0xe2, 0x00, 0x50,
+	0x34, 0x06, 0xd9, 0x9b, 0x59, 0x2b, 0x0f, 0x87, 0x5e, 0xb0, 0xfe, 0x99, 0x67, 0x90, 0x28, 0x4e,
+	0xf9, 0x9f, 0x56, 0x09, 0xde, 0x86, 0x8d, 0x11, 0xf6, 0x03, 0x19, 0x5c, 0x87, 0x2e, 0xd8, 0x19,
+	0xdb, 0x7d, 0x42, 0xb9, 0xfd, 0x2c, 0xe8, 0x6b, 0xec, 0x03, 0x11, 0x34, 0x0b, 0x2f, 0x7c, 0xc0,
+	0x5b, 0x4b, 0x18, 0x96, 0xf4, 0xe9, 0xfb, 0x2e, 0xf4, 0x06, 0x2c, 0x60, 0xdb, 0xe6, 0x66, 0x91,
+	0xdb, 0x5d, 0x9e, 0x3d, 0x38, 0x2a, 0xad, 0x96, 0x0c, 0xb1, 0xd8, 0x57, 0xac, 0x10, 0xc6, 0x5c,
+	0xbd, 0x1f, 0x60, 0xdb, 0x63, 0xfe, 0x3e, 0x29, 0x8a, 0x5c, 0xd1, 0xbb, 0x96, 0x5f, 0xfa, 0xf4,
+	0x26, 0xbb, 0x7d, 0xb2, 0x86, 0x43, 0x42, 0xdb, 0x94, 0xd5, 0xca, 0x67, 0x6b, 0xea, 0x97, 0x4d,
+	0x32, 0xf9, 0x75, 0x26, 0x39, 0xa7, 0x12, 0xfb, 0xcf, 0x00, 0x5c, 0x36, 0x1c, 0x83, 0x29, 0x9a,
+	0x9b, 0x54, 0x61, 0x77, 0x7b, 0x76, 0xb6, 0xf1, 0x71, 0xf7, 0x2e, 0x3c, 0xa2, 0x67, 0xdd, 0xf0,
+	0x27, 0x7a, 0x93, 0xd9, 0xb4, 0x29, 0x6a, 0x4c, 0x85, 0xdd, 0x8d, 0xd9, 0xae, 0x75, 0x8b, 0x12,
+	0xbe, 0x3c, 0x3a, 0xff, 0x8c, 0x99, 0x5d, 0x20, 0x68, 0x22, 0x39, 0x51, 0x34, 0x86, 0xbc, 0x84,
+	0xf0, 0x44, 0x04, 0x35, 0x20, 0x2f, 0xbc, 0x9a, 0xef, 0x8e, 0xe9, 0x80, 0xf0, 0xc5, 0x2e, 0xec,
+	0x96, 0x9e, 0x33, 0x0d, 0xde, 0xa7, 0xcb, 0xbf, 0xd4, 0x73, 0xde, 0xe4, 0x81, 0xe9, 0x62, 0xe0,
+	0x8e, 0x98, 0x99, 0x51, 0x3c, 0xd2, 0x32, 0xd7, 0xab, 0x1f, 0xc4, 0xba, 0xa0, 0x77, 0x21, 0x13,
+	0x85, 0x4e, 0x6a, 0x35, 0x9d, 0xa8, 0x3f, 0x22, 0xb0, 0xce, 0x0f, 0x28, 0xbe, 0x62, 0x06, 0xb6,
+	0x79, 0x88, 0xcd, 0x0b, 0x94, 0x8a, 0x65, 0x9c, 0x15, 0x86, 0xab, 0x30, 0x5a, 0x85, 0xc3, 0x78,
+	0x65, 0x92, 0x05, 0x39, 0x03, 0x4a, 0x70, 0x30, 0x1b, 0xe4, 0xe4, 0x44, 0xc4, 0x22, 0xdb, 0x62,
+	0x41, 0x4e, 0xe9, 0x9f, 0x09, 0x40, 0xb1, 0xfc, 0xa0, 0x22, 0x02, 0xe3, 0x79, 0x9f, 0xcf, 0xd7,
+	0xdc, 0x0d, 0x6f, 0xc0, 0x6d, 0xcb, 0xb1, 0x02, 0x0b, 0x07, 0xee, 0xe4, 0x4b, 0xbe, 0x35, 0xf4,
+	0x62, 0xd4, 0x10, 0x7e, 0xfc, 0x1d, 0x40, 0x7e, 0x80, 0x69, 0x70, 0x55, 0x38, 0x57, 0xe4, 0x2d,
+	0xf1, 0x79, 0xfe, 0x39, 0x0d, 0x1b, 0x35, 0xea, 0xfa, 0xbe, 0x08, 0x90, 0x2b, 0x03, 0x9e, 0x2a,
+	0x74, 0xc7, 0xb6, 0x8d, 0xe9, 0xc5, 0x75, 0x0f, 0x90, 0x3b, 0x90, 0x13, 0x31, 0x81, 0x49, 0x1c,
+	0xd7, 0x96, 0x73, 0x00, 0xfe, 0xaa, 0xce, 0xde, 0xa0, 0xff, 0x83, 0x45, 0x67, 0x6c, 0x47, 0xd9,
+	0xa3, 0x18, 0x7c, 0x4a, 0xcf, 0x3b, 0x63, 0x3b, 0x3c, 0xac, 0x7c, 0x16, 0xac, 0x88, 0xb3, 0xf0,
+	0x85, 0x3c, 0xbf, 0x38, 0x4f, 0xc5, 0x6c, 0x58, 0xaa, 0x32, 0x76, 0xa6, 0x2e, 0x54, 0xd4, 0xfc,
+	0xff, 0xe2, 0x84, 0xf2, 0x9c, 0x8b, 0xaa, 0xf4, 0x5c, 0x2e, 0xaa, 0x0e, 0xa1, 0x20, 0x54, 0x10,
+	0x9d, 0x57, 0x6a, 0x11, 0xc1, 0x22, 0xa7, 0x44, 0x07, 0x56, 0x00, 0x5b, 0x57, 0xa5, 0x56, 0xe4,
+	0xe9, 0xd8, 0xa2, 0x84, 0x0f, 0x5f, 0xad, 0x02, 0xfc, 0xf2, 0xe5, 0x04, 0x6b, 0xc2, 0x64, 0x67,
+	0x38, 0x7b, 0x0a, 0x2e, 0x14, 0xbd, 0x84, 0xec, 0xcd, 0xb5, 0x4d, 0x09, 0x31, 0x62, 0x5e, 0x0b,
+	0x14, 0xb5, 0x4d, 0x09, 0xa9, 0x4d, 0x1c, 0xd9, 0x63, 0xc8, 0x4f, 0x65, 0x99, 0x39, 0xd5, 0x3c,
+	0x30, 0x4a, 0x2d, 0x4b, 0x9f, 0x24, 0x61, 0xb1, 0x19, 0x2f, 0x84, 0xce, 0xdb, 0x63, 0xbc, 0x0d,
+	0xb7, 0xfa, 0x78, 0xc4, 0xe8, 0xd7, 0xfd, 0xa7, 0x85, 0xf0, 0x7b, 0xd4, 0x81, 0x65, 0x61, 0x5c,
+	0x03, 0xd7, 0x09, 0xa8, 0xd5, 0x1f, 0x4f, 0xb6, 0xe2, 0x35, 0x30, 0x88, 0xf7, 0xad, 0xc5, 0xbb,
+	0xb2, 0x82, 0xb4, 0x20, 0x9a, 0x14, 0x9f, 0x19, 0xa6, 0x7b, 0xe6, 0xf8, 0x5a, 0xea, 0x7a, 0x38,
+	0x61, 0xe7, 0x75, 0x8a, 0xcf, 0xea, 0xac, 0x5b, 0xe9, 0xe3, 0x24, 0xac, 0x4e, 0x29, 0x2e, 0x6c,
+	0xba, 0x14, 0x41, 0xcc, 0x39, 0x5a, 0x7d, 0x0b, 0xd2, 0xe2, 0xd8, 0xb9, 0xae, 0x22, 0xe4, 0xe7,
+	0xe8, 0x1d, 0xc8, 0x44, 0x55, 0xf8, 0xd4, 0xb5, 0x97, 0x42, 0x94, 0xdf, 0x67, 0xaf, 0x14, 0x6f,
+	0x5e, 0xe3, 0x4a, 0x31, 0x7d, 0xf9, 0x4a, 0xf1, 0x4f, 0x69, 0xc8, 0xc5, 0x8b, 0xcf, 0x73, 0xb6,
+	0xb5, 0x57, 0x21, 0x2f, 0x2e, 0x3b, 0x64, 0x74, 0x29, 0xfe, 0x55, 0x28, 0xc7, 0xdf, 0x89, 0x90,
+	0x12, 0x3d, 0x82, 0xac, 0x8d, 0xe9, 0x89, 0xc1, 0x6e, 0x2b, 0x14, 0x03, 0xfe, 0x0c, 0x03, 0xf4,
+	0xce, 0xb0, 0xc7, 0x72, 0x2b, 0xcb, 0x31, 0xc9, 0xb9, 0xa0, 0x29, 0x66, 0x81, 0x9c, 0xc0, 0x71,
+	0x8f, 0x59, 0xbc, 0x24, 0x82, 0x72, 0x5e, 0x89, 0x57, 0xbc, 0x1f, 0x94, 0x8c, 0xab, 0xab, 0xfb,
+	0xe9, 0x39, 0x54, 0xf7, 0x1f, 0x43, 0x7e, 0xea, 0x22, 0x42, 0xcd, 0xe5, 0xe7, 0x62, 0xf7, 0x50,
+	0xac, 0x58, 0x34, 0x18, 0x61, 0x9b, 0x15, 0x8b, 0x32, 0xa2, 0x58, 0x24, 0x1f, 0x45, 0x6a, 0x3c,
+	0x93, 0xa9, 0x64, 0x55, 0x53, 0xe3, 0xe9, 0xcc, 0x64, 0xfa, 0x5e, 0xfe, 0x92, 0x14, 0x78, 0xd1,
+	0x7b, 0xf9, 0xd9, 0x4c, 0x68, 0x76, 0x13, 0xe5, 0xae, 0xb1, 0x89, 0xf2, 0x97, 0x36, 0xd1, 0xbd,
+	0x1f, 0x43, 0x36, 0x0a, 0xdf, 0xd1, 0x06, 0xac, 0xd6, 0x9b, 0x7a, 0xa3, 0xd6, 0x6b, 0xb6, 0x0f,
+	0x8c, 0xc3, 0x83, 0x6e, 0xa7, 0x51, 0x6b, 0xee, 0x35, 0x1b, 0xf5, 0xe2, 0x0d, 0x94, 0x81, 0xd4,
+	0x7e, 0xfb, 0xe0, 0x41, 0x31, 0x81, 0xb2, 0x70, 0xb3, 0xfb, 0xb0, 0xad, 0xf7, 0x8a, 0xc9, 0x7b,
+	0x43, 0x28, 0x30, 0x5b, 0xab, 0xe1, 0xd1, 0xa0, 0xed, 0x71, 0xc2, 0x36, 0xbc, 0xdc, 0x7b, 0x52,
+	0xe9, 0x18, 0xb5, 0xca, 0x7e, 0xcd, 0x68, 0x77, 0xae, 0x06, 0x75, 0x3b, 0xed, 0x5e, 0x31, 0x81,
+	0x56, 0xa0, 0xf8, 0xf8, 0xb0, 0xdd, 0x6b, 0x18, 0x95, 0x6e, 0xb7, 0xd1, 0x33, 0xba, 0x4f, 0x2a,
+	0x9d, 0x62, 0x12, 0x2d, 0xc3, 0x52, 0xb5, 0xd2, 0x9d, 0x7a, 0xb9, 0x70, 0xef, 0x0d, 0x58, 0x9a,
+	0x29, 0x57, 0xa3, 0x1c, 0xdc, 0x6a, 0x1e, 0x74, 0x7b, 0x95, 0x83, 0x5e, 0xf1, 0x06, 0x7b, 0xa8,
+	0x1c, 0xf2, 0x61, 0x17, 0x13, 0xf7, 0x1c, 0x58, 0xb9, 0xaa, 0xe6, 0x87, 0x5e, 0x87, 0x52, 0xad,
+	0xa9, 0xd7, 0x0e, 0x9b, 0x3d, 0xa3, 0xaa, 0x37, 0x2a, 0x8f, 0x1a, 0xba, 0xa1, 0x37, 0x2a, 0xdd,
+	0x4b, 0x23, 0x5c, 0x87, 0xe5, 0xb6, 0x5e, 0xa9, 0xed, 0x37, 0x8c, 0x8e, 0xde, 0xac, 0x35, 0x8c,
+	0xda, 0xc3, 0xca, 0xc1, 0x83, 0x46, 0x31, 0x81, 0x56, 0xe1, 0xb6, 0x78, 0xb3, 0xb7, 0x7f, 0x58,
+	0xeb, 0x1d, 0x56, 0xb8, 0xbc, 0xe4, 0xbd, 0x23, 0x28, 0xce, 0x26, 0x4f, 0xa8, 0x04, 0x5b, 0x3d,
+	0xbd, 0xf9, 0xe0, 0x41, 0x43, 0x37, 0xda, 0x7a, 0xbd, 0xa1, 0x1b, 0xbd, 0x0f, 0x3a, 0x8d, 0x19,
+	0x39, 0x05, 0x80, 0xfd, 0x66, 0xab, 0xd9, 0x33, 0xda, 0x9d, 0xc6, 0x41, 0x31, 0x81, 0x16, 0x21,
+	0xdb, 0xed, 0xb5, 0x3b, 0xc6, 0x7e, 0xbb, 0xdb, 0x2d, 0x26, 0xd1, 0x12, 0xe4, 0x7a, 0x95, 0x47,
+	0x6c, 0x10, 0xed, 0xbd, 0x66, 0xaf, 0xb8, 0x70, 0xaf, 0x0d, 0x48, 0xca, 0x89, 0x65, 0x37, 0xe8,
+	0x35, 0xd8, 0x0e, 0x25, 0x89, 0xc1, 0x75, 0xdb, 0x87, 0x7a, 0xad, 0x71, 0x59, 0xeb, 0xad, 0x8a,
+	0xfe, 0x48, 0x2c, 0x5f, 0xf3, 0xa0, 0xde, 0x78, 0xbf, 0x98, 0xac, 0x3e, 0xf8, 0xec, 0xcb, 0xad,
+	0xc4, 0xe7, 0x5f, 0x6e, 0x25, 0xfe, 0xf1, 0xe5, 0x56, 0xe2, 0x37, 0x5f, 0x6d, 0xdd, 0xf8, 0xfc,
+	0xab, 0xad, 0x1b, 0x7f, 0xfd, 0x6a, 0xeb, 0xc6, 0x87, 0x6f, 0x7e, 0x93, 0xd3, 0xe4, 0xff, 0x6a,
+	0xcd, 0xed, 0xb4, 0x7c, 0xba, 0xdb, 0x4f, 0xf3, 0xbb, 0xdc, 0xef, 0xfd, 0x67, 0x00, 0xee, 0xb7,
+	0xa7, 0x7e, 0x82, 0x2d, 0x00, 0x00,
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n1, err1 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.ReserveSnapshotRetention, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.ReserveSnapshotRetention):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintState(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x4a
+	if len(m.AuctionLiquidators) > 0 {
+		for iNdEx := len(m.AuctionLiquidators) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AuctionLiquidators[iNdEx])
+			copy(dAtA[i:], m.AuctionLiquidators[iNdEx])
+			i = encodeVarintState(dAtA, i, uint64(len(m.AuctionLiquidators[iNdEx])))
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	n2, err2 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.TraderHistoryRetention, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.TraderHistoryRetention):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintState(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x3a
+	if m.TraderHistoryEnabled {
+		i--
+		if m.TraderHistoryEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	{
+		size := m.ReferralRebateRatio.Size()
+		i -= size
+		if _, err := m.ReferralRebateRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.StakerMinBonded.Size()
+		i -= size
+		if _, err := m.StakerMinBonded.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.StakerFeeDiscountRatio.Size()
+		i -= size
+		if _, err := m.StakerFeeDiscountRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.FeeTiers) > 0 {
+		for iNdEx := len(m.FeeTiers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeeTiers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintState(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Stopped {
+		i--
+		if m.Stopped {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FeeTier) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeeTier) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeeTier) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.FeeDiscountRatio.Size()
+		i -= size
+		if _, err := m.FeeDiscountRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.MinVolume.Size()
+		i -= size
+		if _, err := m.MinVolume.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *TraderVolume) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TraderVolume) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TraderVolume) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Volume.Size()
+		i -= size
+		if _, err := m.Volume.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.Day != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.Day))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Trader) > 0 {
+		i -= len(m.Trader)
+		copy(dAtA[i:], m.Trader)
+		i = encodeVarintState(dAtA, i, uint64(len(m.Trader)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ReferralCode) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReferralCode) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReferralCode) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Referrer) > 0 {
+		i -= len(m.Referrer)
+		copy(dAtA[i:], m.Referrer)
+		i = encodeVarintState(dAtA, i, uint64(len(m.Referrer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Code) > 0 {
+		i -= len(m.Code)
+		copy(dAtA[i:], m.Code)
+		i = encodeVarintState(dAtA, i, uint64(len(m.Code)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Referral) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Referral) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Referral) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Code) > 0 {
+		i -= len(m.Code)
+		copy(dAtA[i:], m.Code)
+		i = encodeVarintState(dAtA, i, uint64(len(m.Code)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Referee) > 0 {
+		i -= len(m.Referee)
+		copy(dAtA[i:], m.Referee)
+		i = encodeVarintState(dAtA, i, uint64(len(m.Referee)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ReferralRebate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReferralRebate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReferralRebate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Rebate.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Referrer) > 0 {
+		i -= len(m.Referrer)
+		copy(dAtA[i:], m.Referrer)
+		i = encodeVarintState(dAtA, i, uint64(len(m.Referrer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TraderPnl) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TraderPnl) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TraderPnl) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.LiquidationLoss.Size()
+		i -= size
+		if _, err := m.LiquidationLoss.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.Fees.Size()
+		i -= size
+		if _, err := m.Fees.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.FundingPayment.Size()
+		i -= size
+		if _, err := m.FundingPayment.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.RealizedPnl.Size()
+		i -= size
+		if _, err := m.RealizedPnl.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Pair.Size()
+		i -= size
+		if _, err := m.Pair.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Trader) > 0 {
+		i -= len(m.Trader)
+		copy(dAtA[i:], m.Trader)
+		i = encodeVarintState(dAtA, i, uint64(len(m.Trader)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TraderHistoryEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TraderHistoryEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TraderHistoryEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BlockTimeMs != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.BlockTimeMs))
+		i--
+		dAtA[i] = 0x78
+	}
+	if m.BlockHeight != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x70
+	}
+	{
+		size := m.CumulativeLiquidationLoss.Size()
+		i -= size
+		if _, err := m.CumulativeLiquidationLoss.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x6a
+	{
+		size := m.CumulativeFees.Size()
+		i -= size
+		if _, err := m.CumulativeFees.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x62
+	{
+		size := m.CumulativeFundingPayment.Size()
+		i -= size
+		if _, err := m.CumulativeFundingPayment.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x5a
+	{
+		size := m.CumulativeRealizedPnl.Size()
+		i -= size
+		if _, err := m.CumulativeRealizedPnl.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	{
+		size := m.LiquidationLoss.Size()
+		i -= size
+		if _, err := m.LiquidationLoss.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	{
+		size := m.Fees.Size()
+		i -= size
+		if _, err := m.Fees.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.FundingPayment.Size()
+		i -= size
+		if _, err := m.FundingPayment.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.RealizedPnl.Size()
+		i -= size
+		if _, err := m.RealizedPnl.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.ExchangedNotional.Size()
+		i -= size
+		if _, err := m.ExchangedNotional.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.ExchangedSize.Size()
+		i -= size
+		if _, err := m.ExchangedSize.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Pair.Size()
+		i -= size
+		if _, err := m.Pair.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Trader) > 0 {
+		i -= len(m.Trader)
+		copy(dAtA[i:], m.Trader)
+		i = encodeVarintState(dAtA, i, uint64(len(m.Trader)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Market) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Market) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Market) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AuctionDurationBlocks != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.AuctionDurationBlocks))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xf8
+	}
+	{
+		size := m.AuctionMaxDiscount.Size()
+		i -= size
+		if _, err := m.AuctionMaxDiscount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xf2
+	{
+		size := m.AuctionDiscountPerBlock.Size()
+		i -= size
+		if _, err := m.AuctionDiscountPerBlock.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xea
+	if m.LiquidationMode != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.LiquidationMode))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xe0
+	}
+	if len(m.MarginTiers) > 0 {
+		for iNdEx := len(m.MarginTiers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MarginTiers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintState(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xda
+		}
+	}
+	{
+		size := m.MaxPositionNotional.Size()
+		i -= size
+		if _, err := m.MaxPositionNotional.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xd2
+	{
+		size := m.MaxOpenInterest.Size()
+		i -= size
+		if _, err := m.MaxOpenInterest.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xca
+	if m.CircuitBreakerMaxFluctuationViolations != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.CircuitBreakerMaxFluctuationViolations))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc0
+	}
+	if m.CircuitBreakerWindowBlocks != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.CircuitBreakerWindowBlocks))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb8
+	}
+	{
+		size := m.CircuitBreakerPriceChangeRatio.Size()
+		i -= size
+		if _, err := m.CircuitBreakerPriceChangeRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xb2
+	{
+		size := m.AmmUpdateBudget.Size()
+		i -= size
+		if _, err := m.AmmUpdateBudget.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xaa
+	{
+		size := m.MaxSkewRatio.Size()
+		i -= size
+		if _, err := m.MaxSkewRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xa2
+	{
+		size := m.MaxSpreadRatio.Size()
+		i -= size
+		if _, err := m.MaxSpreadRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x9a
+	{
+		size := m.InterestRate.Size()
+		i -= size
+		if _, err := m.InterestRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x92
+	{
+		size := m.MinFundingRate.Size()
+		i -= size
+		if _, err := m.MinFundingRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x8a
+	{
+		size := m.MaxFundingRate.Size()
+		i -= size
+		if _, err := m.MaxFundingRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x82
+	if m.AutoDeleveragingEnabled {
+		i--
+		if m.AutoDeleveragingEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x78
+	}
+	{
+		size := m.InsuranceFundFeeShare.Size()
+		i -= size
+		if _, err := m.InsuranceFundFeeShare.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x72
+	{
+		size, err := m.PrepaidBadDebt.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x6a
+	n5, err5 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.TwapLookbackWindow, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.TwapLookbackWindow):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintState(dAtA, i, uint64(n5))
+	i--
+	dAtA[i] = 0x62
+	if len(m.FundingRateEpochId) > 0 {
+		i -= len(m.FundingRateEpochId)
+		copy(dAtA[i:], m.FundingRateEpochId)
+		i = encodeVarintState(dAtA, i, uint64(len(m.FundingRateEpochId)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	{
+		size := m.PartialLiquidationRatio.Size()
+		i -= size
+		if _, err := m.PartialLiquidationRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	{
+		size := m.LiquidationFeeRatio.Size()
+		i -= size
+		if _, err := m.LiquidationFeeRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	{
+		size := m.EcosystemFundFeeRatio.Size()
+		i -= size
+		if _, err := m.EcosystemFundFeeRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.ExchangeFeeRatio.Size()
+		i -= size
+		if _, err := m.ExchangeFeeRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.LatestCumulativePremiumFraction.Size()
+		i -= size
+		if _, err := m.LatestCumulativePremiumFraction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.MaxLeverage.Size()
+		i -= size
+		if _, err := m.MaxLeverage.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.MaintenanceMarginRatio.Size()
+		i -= size
+		if _, err := m.MaintenanceMarginRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.PriceFluctuationLimitRatio.Size()
+		i -= size
+		if _, err := m.PriceFluctuationLimitRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size := m.Pair.Size()
+		i -= size
+		if _, err := m.Pair.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MarginTier) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MarginTier) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MarginTier) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MaxLeverage.Size()
+		i -= size
+		if _, err := m.MaxLeverage.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.MaintenanceMarginRatio.Size()
+		i -= size
+		if _, err := m.MaintenanceMarginRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.NotionalFloor.Size()
+		i -= size
+		if _, err := m.NotionalFloor.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *CircuitBreaker) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CircuitBreaker) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CircuitBreaker) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.FluctuationViolations != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.FluctuationViolations))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.TrippedBlockTimeMs != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.TrippedBlockTimeMs))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.TrippedBlockHeight != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.TrippedBlockHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Reason != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.Reason))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Tripped {
+		i--
+		if m.Tripped {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size := m.Pair.Size()
+		i -= size
+		if _, err := m.Pair.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *OpenInterest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OpenInterest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OpenInterest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Short.Size()
+		i -= size
+		if _, err := m.Short.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Long.Size()
+		i -= size
+		if _, err := m.Long.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.Pair.Size()
+		i -= size
+		if _, err := m.Pair.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *AMM) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AMM) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AMM) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.TotalShort.Size()
+		i -= size
+		if _, err := m.TotalShort.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.TotalLong.Size()
+		i -= size
+		if _, err := m.TotalLong.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.PriceMultiplier.Size()
+		i -= size
+		if _, err := m.PriceMultiplier.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.SqrtDepth.Size()
+		i -= size
+		if _, err := m.SqrtDepth.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.QuoteReserve.Size()
+		i -= size
+		if _, err := m.QuoteReserve.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.BaseReserve.Size()
+		i -= size
+		if _, err := m.BaseReserve.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.Pair.Size()
+		i -= size
+		if _, err := m.Pair.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *Position) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Position) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Position) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LastUpdatedBlockNumber != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.LastUpdatedBlockNumber))
+		i--
+		dAtA[i] = 0x38
+	}
+	{
+		size := m.LatestCumulativePremiumFraction.Size()
+		i -= size
+		if _, err := m.LatestCumulativePremiumFraction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.OpenNotional.Size()
+		i -= size
+		if _, err := m.OpenNotional.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.Margin.Size()
+		i -= size
+		if _, err := m.Margin.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Size_.Size()
+		i -= size
+		if _, err := m.Size_.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Pair.Size()
+		i -= size
+		if _, err := m.Pair.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.TraderAddress) > 0 {
+		i -= len(m.TraderAddress)
+		copy(dAtA[i:], m.TraderAddress)
+		i = encodeVarintState(dAtA, i, uint64(len(m.TraderAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ReserveSnapshot) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReserveSnapshot) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReserveSnapshot) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TimestampMs != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.TimestampMs))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.Amm.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *TriggerOrder) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TriggerOrder) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TriggerOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CreatedBlockHeight != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.CreatedBlockHeight))
+		i--
+		dAtA[i] = 0x58
+	}
+	{
+		size := m.BaseAssetAmountLimit.Size()
+		i -= size
+		if _, err := m.BaseAssetAmountLimit.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	{
+		size := m.Leverage.Size()
+		i -= size
+		if _, err := m.Leverage.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	{
+		size, err := m.Collateral.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	if m.PriceSource != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.PriceSource))
+		i--
+		dAtA[i] = 0x38
+	}
+	{
+		size := m.TriggerPrice.Size()
+		i -= size
+		if _, err := m.TriggerPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if m.Side != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.Side))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.OrderType != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.OrderType))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.Pair.Size()
+		i -= size
+		if _, err := m.Pair.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.TraderAddress) > 0 {
+		i -= len(m.TraderAddress)
+		copy(dAtA[i:], m.TraderAddress)
+		i = encodeVarintState(dAtA, i, uint64(len(m.TraderAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LiquidationAuction) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LiquidationAuction) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LiquidationAuction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.StartBlockHeight != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.StartBlockHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.InitiatorAddress) > 0 {
+		i -= len(m.InitiatorAddress)
+		copy(dAtA[i:], m.InitiatorAddress)
+		i = encodeVarintState(dAtA, i, uint64(len(m.InitiatorAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.TraderAddress) > 0 {
+		i -= len(m.TraderAddress)
+		copy(dAtA[i:], m.TraderAddress)
+		i = encodeVarintState(dAtA, i, uint64(len(m.TraderAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size := m.Pair.Size()
+		i -= size
+		if _, err := m.Pair.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *CrossMarginAccountSummary) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CrossMarginAccountSummary) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CrossMarginAccountSummary) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MarginRatio.Size()
+		i -= size
+		if _, err := m.MarginRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x5a
+	{
+		size := m.FreeCollateral.Size()
+		i -= size
+		if _, err := m.FreeCollateral.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	{
+		size := m.Equity.Size()
+		i -= size
+		if _, err := m.Equity.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	{
+		size := m.MaintenanceMarginRequirement.Size()
+		i -= size
+		if _, err := m.MaintenanceMarginRequirement.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.TotalNotional.Size()
+		i -= size
+		if _, err := m.TotalNotional.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.FundingPayment.Size()
+		i -= size
+		if _, err := m.FundingPayment.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.UnrealizedPnl.Size()
+		i -= size
+		if _, err := m.UnrealizedPnl.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.TotalMargin.Size()
+		i -= size
+		if _, err := m.TotalMargin.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.NumPositions != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.NumPositions))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.QuoteDenom) > 0 {
+		i -= len(m.QuoteDenom)
+		copy(dAtA[i:], m.QuoteDenom)
+		i = encodeVarintState(dAtA, i, uint64(len(m.QuoteDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.TraderAddress) > 0 {
+		i -= len(m.TraderAddress)
+		copy(dAtA[i:], m.TraderAddress)
+		i = encodeVarintState(dAtA, i, uint64(len(m.TraderAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *InsuranceFund) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InsuranceFund) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InsuranceFund) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.TotalDrawDowns.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.TotalContributions.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.Balance.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.Pair.Size()
+		i -= size
+		if _, err := m.Pair.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *InsuranceFundDrawDown) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InsuranceFundDrawDown) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InsuranceFundDrawDown) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BlockTimeMs != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.BlockTimeMs))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.BlockHeight != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size, err := m.BadDebt.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Pair.Size()
+		i -= size
+		if _, err := m.Pair.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Id != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FundingRate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FundingRate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FundingRate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BlockTimeMs != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.BlockTimeMs))
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.BlockHeight != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x58
+	}
+	{
+		size := m.CumulativePremiumFraction.Size()
+		i -= size
+		if _, err := m.CumulativePremiumFraction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	{
+		size := m.PremiumFraction.Size()
+		i -= size
+		if _, err := m.PremiumFraction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	if m.Clamped {
+		i--
+		if m.Clamped {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
+	{
+		size := m.FundingRate.Size()
+		i -= size
+		if _, err := m.FundingRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.InterestRate.Size()
+		i -= size
+		if _, err := m.InterestRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.PremiumRate.Size()
+		i -= size
+		if _, err := m.PremiumRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.IndexTwap.Size()
+		i -= size
+		if _, err := m.IndexTwap.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.MarkTwap.Size()
+		i -= size
+		if _, err := m.MarkTwap.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.EpochNumber != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.EpochNumber))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size := m.Pair.Size()
+		i -= size
+		if _, err := m.Pair.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintState(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintState(dAtA []byte, offset int, v uint64) int {
+	offset -= sovState(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Stopped {
+		n += 2
+	}
+	if len(m.FeeTiers) > 0 {
+		for _, e := range m.FeeTiers {
+			l = e.Size()
+			n += 1 + l + sovState(uint64(l))
+		}
+	}
+	l = m.StakerFeeDiscountRatio.Size()
+	n += 1 + l + sovState(uint64(l))
+	l = m.StakerMinBonded.Size()
+	n += 1 + l + sovState(uint64(l))
+	l = m.ReferralRebateRatio.Size()
+	n += 1 + l + sovState(uint64(l))
+	if m.TraderHistoryEnabled {
+		n += 2
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.TraderHistoryRetention)
+	n += 1 + l + sovState(uint64(l))
+	if len(m.AuctionLiquidators) > 0 {
+		for _, s := range m.AuctionLiquidators {
+			l = len(s)
+			n += 1 + l + sovState(uint64(l))
+		}
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.ReserveSnapshotRetention)
+	n += 1 + l + sovState(uint64(l))
+	return n
+}
+
+func (m *FeeTier) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.MinVolume.Size()
+	n += 1 + l + sovState(uint64(l))
+	l = m.FeeDiscountRatio.Size()
+	n += 1 + l + sovState(uint64(l))
+	return n
+}
+
+func (m *TraderVolume) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Trader)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	if m.Day != 0 {
+		n += 1 + sovState(uint64(m.Day))
+	}
+	l = m.Volume.Size()
+	n += 1 + l + sovState(uint64(l))
+	return n
+}
+
+func (m *ReferralCode) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Code)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	l = len(m.Referrer)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	return n
+}
+
+func (m *Referral) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Referee)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	l = len(m.Code)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	return n
+}
+
+func (m *ReferralRebate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Referrer)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	l = m.Rebate.Size()
+	n += 1 + l + sovState(uint64(l))
+	return n
+}
+
+func (m *TraderPnl) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Trader)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	l = m.Pair.Size()
+	n += 1 + l + sovState(uint64(l))
+	l = m.RealizedPnl.Size()
+	n += 1 + l + sovState(uint64(l))
+	l = m.FundingPayment.Size()
+	n += 1 + l + sovState(uint64(l))
+	l = m.Fees.Size()
+	n += 1 + l + sovState(uint64(l))
+	l = m.LiquidationLoss.Size()
+	n += 1 + l + sovState(uint64(l))
+	return n
+}
+
+func (m *TraderHistoryEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovState(uint64(m.Id))
+	}
+	l = len(m.Trader)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	l = m.Pair.Size()
+	n += 1 + l + sovState(uint64(l))
+	l = m.ExchangedSize.Size()
+	n += 1 + l + sovState(uint64(l))
+	l = m.ExchangedNotional.Size()
+	n += 1 + l + sovState(uint64(l))
+	l = m.RealizedPnl.Size()
+	n += 1 + l + sovState(uint64(l))
+	l = m.FundingPayment.Size()
+	n += 1 + l + sovState(uint64(l))
+	l = m.Fees.Size()
+	n += 1 + l + sovState(uint64(l))
+	l = m.LiquidationLoss.Size()
+	n += 1 + l + sovState(uint64(l))
+	l = m.CumulativeRealizedPnl.Size()
+	n += 1 + l + sovState(uint64(l))
+	l = m.CumulativeFundingPayment.Size()
+	n += 1 + l + sovState(uint64(l))
+	l = m.CumulativeFees.Size()
+	n += 1 + l + sovState(uint64(l))
+	l = m.CumulativeLiquidationLoss.Size()
+	n += 1 + l + sovState(uint64(l))
+	if m.BlockHeight != 0 {
+		n += 1 + sovState(uint64(m.BlockHeight))
+	}
+	if m.BlockTimeMs != 0 {
+		n += 1 + sovState(uint64(m.BlockTimeMs))
+	}
+	return n
+}
+
+func (m *Market) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Pair.Size()
+	n += 1 + l + sovState(uint64(l))
+	if m.Enabled {
+		n += 2
+	}
+	l = m.PriceFluctuationLimitRatio.Size()
+	n += 1 + l + sovState(uint64(l))
+	l = m.MaintenanceMarginRatio.Size()
+	n += 1 + l + sovState(uint64(l))
+	l = m.MaxLeverage.Size()
+	n += 1 + l + sovState(uint64(l))
+	l = m.LatestCumulativePremiumFraction.Size()
+	n += 1 + l + sovState(uint64(l))
+	l = m.ExchangeFeeRatio.Size()
+	n += 1 + l + sovState(uint64(l))
+	l = m.EcosystemFundFeeRatio.Size()
+	n += 1 + l + sovState(uint64(l))
+	l = m.LiquidationFeeRatio.Size()
+	n += 1 + l + sovState(uint64(l))
+	l = m.PartialLiquidationRatio.Size()
+	n += 1 + l + sovState(uint64(l))
+	l = len(m.FundingRateEpochId)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.TwapLookbackWindow)
+	n += 1 + l + sovState(uint64(l))
+	l = m.PrepaidBadDebt.Size()
+	n += 1 + l + sovState(uint64(l))
+	l = m.InsuranceFundFeeShare.Size()
+	n += 1 + l + sovState(uint64(l))
+	if m.AutoDeleveragingEnabled {
+		n += 2
+	}
+	l = m.MaxFundingRate.Size()
+	n += 2 + l + sovState(uint64(l))
+	l = m.MinFundingRate.Size()
+	n += 2 + l + sovState(uint64(l))
+	l = m.InterestRate.Size()
+	n += 2 + l + sovState(uint64(l))
+	l = m.MaxSpreadRatio.Size()
+	n += 2 + l + sovState(uint64(l))
+	l = m.MaxSkewRatio.Size()
+	n += 2 + l + sovState(uint64(l))
+	l = m.AmmUpdateBudget.Size()
+	n += 2 + l + sovState(uint64(l))
+	l = m.CircuitBreakerPriceChangeRatio.Size()
+	n += 2 + l + sovState(uint64(l))
+	if m.CircuitBreakerWindowBlocks != 0 {
+		n += 2 + sovState(uint64(m.CircuitBreakerWindowBlocks))
+	}
+	if m.CircuitBreakerMaxFluctuationViolations != 0 {
+		n += 2 + sovState(uint64(m.CircuitBreakerMaxFluctuationViolations))
+	}
+	l = m.MaxOpenInterest.Size()
+	n += 2 + l + sovState(uint64(l))
+	l = m.MaxPositionNotional.Size()
+	n += 2 + l + sovState(uint64(l))
+	if len(m.MarginTiers) > 0 {
+		for _, e := range m.MarginTiers {
+			l = e.Size()
+			n += 2 + l + sovState(uint64(l))
+		}
+	}
+	if m.LiquidationMode != 0 {
+		n += 2 + sovState(uint64(m.LiquidationMode))
+	}
+	l = m.AuctionDiscountPerBlock.Size()
+	n += 2 + l + sovState(uint64(l))
+	l = m.AuctionMaxDiscount.Size()
+	n += 2 + l + sovState(uint64(l))
+	if m.AuctionDurationBlocks != 0 {
+		n += 2 + sovState(uint64(m.AuctionDurationBlocks))
+	}
+	return n
+}
+
+func (m *MarginTier) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.NotionalFloor.Size()
+	n += 1 + l + sovState(uint64(l))
+	l = m.MaintenanceMarginRatio.Size()
+	n += 1 + l + sovState(uint64(l))
+	l = m.MaxLeverage.Size()
+	n += 1 + l + sovState(uint64(l))
+	return n
+}
+
+func (m *CircuitBreaker) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Pair.Size()
+	n += 1 + l + sovState(uint64(l))
+	if m.Tripped {
+		n += 2
+	}
+	if m.Reason != 0 {
+		n += 1 + sovState(uint64(m.Reason))
+	}
+	if m.TrippedBlockHeight != 0 {
+		n += 1 + sovState(uint64(m.TrippedBlockHeight))
+	}
+	if m.TrippedBlockTimeMs != 0 {
+		n += 1 + sovState(uint64(m.TrippedBlockTimeMs))
+	}
+	if m.FluctuationViolations != 0 {
+		n += 1 + sovState(uint64(m.FluctuationViolations))
+	}
+	return n
+}
+
+func (m *OpenInterest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Pair.Size()
+	n += 1 + l + sovState(uint64(l))
+	l = m.Long.Size()
+	n += 1 + l + sovState(uint64(l))
+	l = m.Short.Size()
+	n += 1 + l + sovState(uint64(l))
+	return n
+}
+
+func (m *AMM) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Pair.Size()
+	n += 1 + l + sovState(uint64(l))
+	l = m.BaseReserve.Size()
+	n += 1 + l + sovState(uint64(l))
+	l = m.QuoteReserve.Size()
+	n += 1 + l + sovState(uint64(l))
+	l = m.SqrtDepth.Size()
+	n += 1 + l + sovState(uint64(l))
+	l = m.PriceMultiplier.Size()
+	n += 1 + l + sovState(uint64(l))
+	l = m.TotalLong.Size()
+	n += 1 + l + sovState(uint64(l))
+	l = m.TotalShort.Size()
+	n += 1 + l + sovState(uint64(l))
+	return n
+}
+
+func (m *Position) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TraderAddress)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	l = m.Pair.Size()
+	n += 1 + l + sovState(uint64(l))
+	l = m.Size_.Size()
+	n += 1 + l + sovState(uint64(l))
+	l = m.Margin.Size()
+	n += 1 + l + sovState(uint64(l))
+	l = m.OpenNotional.Size()
+	n += 1 + l + sovState(uint64(l))
+	l = m.LatestCumulativePremiumFraction.Size()
+	n += 1 + l + sovState(uint64(l))
+	if m.LastUpdatedBlockNumber != 0 {
+		n += 1 + sovState(uint64(m.LastUpdatedBlockNumber))
+	}
+	return n
+}
+
+func (m *ReserveSnapshot) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Amm.Size()
+	n += 1 + l + sovState(uint64(l))
+	if m.TimestampMs != 0 {
+		n += 1 + sovState(uint64(m.TimestampMs))
+	}
+	return n
+}
+
+func (m *TriggerOrder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovState(uint64(m.Id))
+	}
+	l = len(m.TraderAddress)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	l = m.Pair.Size()
+	n += 1 + l + sovState(uint64(l))
+	if m.OrderType != 0 {
+		n += 1 + sovState(uint64(m.OrderType))
+	}
+	if m.Side != 0 {
+		n += 1 + sovState(uint64(m.Side))
+	}
+	l = m.TriggerPrice.Size()
+	n += 1 + l + sovState(uint64(l))
+	if m.PriceSource != 0 {
+		n += 1 + sovState(uint64(m.PriceSource))
+	}
+	l = m.Collateral.Size()
+	n += 1 + l + sovState(uint64(l))
+	l = m.Leverage.Size()
+	n += 1 + l + sovState(uint64(l))
+	l = m.BaseAssetAmountLimit.Size()
+	n += 1 + l + sovState(uint64(l))
+	if m.CreatedBlockHeight != 0 {
+		n += 1 + sovState(uint64(m.CreatedBlockHeight))
+	}
+	return n
+}
+
+func (m *LiquidationAuction) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Pair.Size()
+	n += 1 + l + sovState(uint64(l))
+	l = len(m.TraderAddress)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	l = len(m.InitiatorAddress)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	if m.StartBlockHeight != 0 {
+		n += 1 + sovState(uint64(m.StartBlockHeight))
+	}
+	return n
+}
+
+func (m *CrossMarginAccountSummary) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TraderAddress)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	l = len(m.QuoteDenom)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	if m.NumPositions != 0 {
+		n += 1 + sovState(uint64(m.NumPositions))
+	}
+	l = m.TotalMargin.Size()
+	n += 1 + l + sovState(uint64(l))
+	l = m.UnrealizedPnl.Size()
+	n += 1 + l + sovState(uint64(l))
+	l = m.FundingPayment.Size()
+	n += 1 + l + sovState(uint64(l))
+	l = m.TotalNotional.Size()
+	n += 1 + l + sovState(uint64(l))
+	l = m.MaintenanceMarginRequirement.Size()
+	n += 1 + l + sovState(uint64(l))
+	l = m.Equity.Size()
+	n += 1 + l + sovState(uint64(l))
+	l = m.FreeCollateral.Size()
+	n += 1 + l + sovState(uint64(l))
+	l = m.MarginRatio.Size()
+	n += 1 + l + sovState(uint64(l))
+	return n
+}
+
+func (m *InsuranceFund) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Pair.Size()
+	n += 1 + l + sovState(uint64(l))
+	l = m.Balance.Size()
+	n += 1 + l + sovState(uint64(l))
+	l = m.TotalContributions.Size()
+	n += 1 + l + sovState(uint64(l))
+	l = m.TotalDrawDowns.Size()
+	n += 1 + l + sovState(uint64(l))
+	return n
+}
+
+func (m *InsuranceFundDrawDown) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovState(uint64(m.Id))
+	}
+	l = m.Pair.Size()
+	n += 1 + l + sovState(uint64(l))
+	l = m.Amount.Size()
+	n += 1 + l + sovState(uint64(l))
+	l = m.BadDebt.Size()
+	n += 1 + l + sovState(uint64(l))
+	if m.BlockHeight != 0 {
+		n += 1 + sovState(uint64(m.BlockHeight))
+	}
+	if m.BlockTimeMs != 0 {
+		n += 1 + sovState(uint64(m.BlockTimeMs))
+	}
+	return n
+}
+
+func (m *FundingRate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Pair.Size()
+	n += 1 + l + sovState(uint64(l))
+	if m.EpochNumber != 0 {
+		n += 1 + sovState(uint64(m.EpochNumber))
+	}
+	l = m.MarkTwap.Size()
+	n += 1 + l + sovState(uint64(l))
+	l = m.IndexTwap.Size()
+	n += 1 + l + sovState(uint64(l))
+	l = m.PremiumRate.Size()
+	n += 1 + l + sovState(uint64(l))
+	l = m.InterestRate.Size()
+	n += 1 + l + sovState(uint64(l))
+	l = m.FundingRate.Size()
+	n += 1 + l + sovState(uint64(l))
+	if m.Clamped {
+		n += 2
+	}
+	l = m.PremiumFraction.Size()
+	n += 1 + l + sovState(uint64(l))
+	l = m.CumulativePremiumFraction.Size()
+	n += 1 + l + sovState(uint64(l))
+	if m.BlockHeight != 0 {
+		n += 1 + sovState(uint64(m.BlockHeight))
+	}
+	if m.BlockTimeMs != 0 {
+		n += 1 + sovState(uint64(m.BlockTimeMs))
+	}
+	return n
+}
+
+func sovState(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozState(x uint64) (n int) {
+	return sovState(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowState
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stopped", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Stopped = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeTiers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeTiers = append(m.FeeTiers, FeeTier{})
+			if err := m.FeeTiers[len(m.FeeTiers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakerFeeDiscountRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.StakerFeeDiscountRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakerMinBonded", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.StakerMinBonded.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReferralRebateRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ReferralRebateRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TraderHistoryEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.TraderHistoryEnabled = bool(v != 0)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TraderHistoryRetention", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.TraderHistoryRetention, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuctionLiquidators", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AuctionLiquidators = append(m.AuctionLiquidators, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReserveSnapshotRetention", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.ReserveSnapshotRetention, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipState(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthState
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FeeTier) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowState
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeeTier: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeeTier: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinVolume", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinVolume.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeDiscountRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FeeDiscountRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipState(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthState
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TraderVolume) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowState
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TraderVolume: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TraderVolume: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Trader", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Trader = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Day", wireType)
+			}
+			m.Day = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Day |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Volume", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Volume.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipState(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthState
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReferralCode) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowState
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReferralCode: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReferralCode: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Code = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Referrer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Referrer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipState(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthState
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Referral) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowState
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Referral: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Referral: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Referee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Referee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Code = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipState(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthState
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReferralRebate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowState
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReferralRebate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReferralRebate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Referrer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Referrer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rebate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Rebate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipState(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthState
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TraderPnl) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowState
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TraderPnl: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TraderPnl: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Trader", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Trader = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pair", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Pair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RealizedPnl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RealizedPnl.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FundingPayment", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FundingPayment.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fees", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Fees.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidationLoss", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LiquidationLoss.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipState(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthState
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TraderHistoryEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowState
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TraderHistoryEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TraderHistoryEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Trader", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Trader = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pair", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Pair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExchangedSize", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ExchangedSize.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExchangedNotional", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ExchangedNotional.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RealizedPnl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RealizedPnl.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FundingPayment", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FundingPayment.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fees", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Fees.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidationLoss", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LiquidationLoss.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CumulativeRealizedPnl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CumulativeRealizedPnl.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CumulativeFundingPayment", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CumulativeFundingPayment.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CumulativeFees", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CumulativeFees.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CumulativeLiquidationLoss", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CumulativeLiquidationLoss.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockTimeMs", wireType)
+			}
+			m.BlockTimeMs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockTimeMs |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipState(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthState
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Market) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowState
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Market: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Market: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pair", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Pair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enabled = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceFluctuationLimitRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PriceFluctuationLimitRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaintenanceMarginRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaintenanceMarginRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxLeverage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxLeverage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LatestCumulativePremiumFraction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LatestCumulativePremiumFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExchangeFeeRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ExchangeFeeRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EcosystemFundFeeRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.EcosystemFundFeeRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidationFeeRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LiquidationFeeRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PartialLiquidationRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PartialLiquidationRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FundingRateEpochId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FundingRateEpochId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TwapLookbackWindow", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.TwapLookbackWindow, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrepaidBadDebt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PrepaidBadDebt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InsuranceFundFeeShare", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InsuranceFundFeeShare.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoDeleveragingEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AutoDeleveragingEnabled = bool(v != 0)
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxFundingRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxFundingRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinFundingRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinFundingRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InterestRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InterestRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSpreadRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxSpreadRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSkewRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxSkewRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AmmUpdateBudget", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AmmUpdateBudget.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CircuitBreakerPriceChangeRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CircuitBreakerPriceChangeRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 23:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CircuitBreakerWindowBlocks", wireType)
+			}
+			m.CircuitBreakerWindowBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CircuitBreakerWindowBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 24:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CircuitBreakerMaxFluctuationViolations", wireType)
+			}
+			m.CircuitBreakerMaxFluctuationViolations = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CircuitBreakerMaxFluctuationViolations |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 25:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxOpenInterest", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxOpenInterest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 26:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPositionNotional", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxPositionNotional.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 27:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarginTiers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MarginTiers = append(m.MarginTiers, MarginTier{})
+			if err := m.MarginTiers[len(m.MarginTiers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 28:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidationMode", wireType)
+			}
+			m.LiquidationMode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LiquidationMode |= LiquidationMode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 29:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuctionDiscountPerBlock", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AuctionDiscountPerBlock.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 30:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuctionMaxDiscount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AuctionMaxDiscount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 31:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuctionDurationBlocks", wireType)
+			}
+			m.AuctionDurationBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AuctionDurationBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipState(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthState
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MarginTier) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowState
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MarginTier: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MarginTier: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NotionalFloor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.NotionalFloor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaintenanceMarginRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaintenanceMarginRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxLeverage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxLeverage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipState(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthState
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CircuitBreaker) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowState
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CircuitBreaker: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CircuitBreaker: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pair", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Pair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tripped", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Tripped = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			m.Reason = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Reason |= CircuitBreakerReason(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TrippedBlockHeight", wireType)
+			}
+			m.TrippedBlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TrippedBlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TrippedBlockTimeMs", wireType)
+			}
+			m.TrippedBlockTimeMs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TrippedBlockTimeMs |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FluctuationViolations", wireType)
+			}
+			m.FluctuationViolations = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FluctuationViolations |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipState(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthState
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OpenInterest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowState
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OpenInterest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OpenInterest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pair", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Pair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Long", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Long.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Short", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Short.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipState(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthState
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AMM) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowState
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AMM: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AMM: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pair", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Pair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseReserve", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BaseReserve.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuoteReserve", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.QuoteReserve.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SqrtDepth", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SqrtDepth.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceMultiplier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PriceMultiplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalLong", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalLong.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalShort", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalShort.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipState(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthState
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Position) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowState
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Position: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Position: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TraderAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TraderAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pair", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Pair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Size_", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Size_.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Margin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Margin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OpenNotional", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.OpenNotional.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LatestCumulativePremiumFraction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LatestCumulativePremiumFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastUpdatedBlockNumber", wireType)
+			}
+			m.LastUpdatedBlockNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastUpdatedBlockNumber |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipState(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthState
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReserveSnapshot) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowState
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReserveSnapshot: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReserveSnapshot: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amm", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amm.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimestampMs", wireType)
+			}
+			m.TimestampMs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimestampMs |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipState(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthState
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TriggerOrder) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowState
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TriggerOrder: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TriggerOrder: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TraderAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TraderAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pair", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Pair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderType", wireType)
+			}
+			m.OrderType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OrderType |= TriggerOrderType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Side", wireType)
+			}
+			m.Side = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Side |= Direction(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TriggerPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TriggerPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceSource", wireType)
+			}
+			m.PriceSource = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PriceSource |= TriggerPriceSource(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Collateral", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Collateral.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Leverage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Leverage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseAssetAmountLimit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BaseAssetAmountLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedBlockHeight", wireType)
+			}
+			m.CreatedBlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreatedBlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipState(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthState
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LiquidationAuction) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowState
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LiquidationAuction: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LiquidationAuction: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pair", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Pair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TraderAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TraderAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitiatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
 			}
-			if iNdEx >= l {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.InitiatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartBlockHeight", wireType)
+			}
+			m.StartBlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartBlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipState(dAtA[iNdEx:])
@@ -1048,7 +8726,7 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Market) Unmarshal(dAtA []byte) error {
+func (m *CrossMarginAccountSummary) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1071,15 +8749,15 @@ func (m *Market) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Market: wiretype end group for non-group")
+			return fmt.Errorf("proto: CrossMarginAccountSummary: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Market: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CrossMarginAccountSummary: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pair", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TraderAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1107,15 +8785,13 @@ func (m *Market) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Pair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.TraderAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuoteDenom", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowState
@@ -1125,15 +8801,46 @@ func (m *Market) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Enabled = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QuoteDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumPositions", wireType)
+			}
+			m.NumPositions = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumPositions |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PriceFluctuationLimitRatio", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalMargin", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1161,13 +8868,13 @@ func (m *Market) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.PriceFluctuationLimitRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TotalMargin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaintenanceMarginRatio", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UnrealizedPnl", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1195,13 +8902,13 @@ func (m *Market) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MaintenanceMarginRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.UnrealizedPnl.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxLeverage", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FundingPayment", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1229,13 +8936,13 @@ func (m *Market) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MaxLeverage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.FundingPayment.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LatestCumulativePremiumFraction", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalNotional", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1263,13 +8970,13 @@ func (m *Market) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.LatestCumulativePremiumFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TotalNotional.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExchangeFeeRatio", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaintenanceMarginRequirement", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1297,13 +9004,13 @@ func (m *Market) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ExchangeFeeRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.MaintenanceMarginRequirement.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EcosystemFundFeeRatio", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Equity", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1331,13 +9038,13 @@ func (m *Market) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.EcosystemFundFeeRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Equity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 9:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LiquidationFeeRatio", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FreeCollateral", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1365,13 +9072,13 @@ func (m *Market) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.LiquidationFeeRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.FreeCollateral.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 10:
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PartialLiquidationRatio", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MarginRatio", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1399,13 +9106,63 @@ func (m *Market) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.PartialLiquidationRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.MarginRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 11:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipState(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthState
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *InsuranceFund) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowState
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InsuranceFund: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InsuranceFund: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FundingRateEpochId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pair", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1433,11 +9190,13 @@ func (m *Market) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FundingRateEpochId = string(dAtA[iNdEx:postIndex])
+			if err := m.Pair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 12:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TwapLookbackWindow", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1464,13 +9223,13 @@ func (m *Market) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.TwapLookbackWindow, dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Balance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 13:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PrepaidBadDebt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalContributions", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1497,7 +9256,40 @@ func (m *Market) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.PrepaidBadDebt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TotalContributions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalDrawDowns", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalDrawDowns.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1522,7 +9314,7 @@ func (m *Market) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AMM) Unmarshal(dAtA []byte) error {
+func (m *InsuranceFundDrawDown) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1541,55 +9333,21 @@ func (m *AMM) Unmarshal(dAtA []byte) error {
 			if b < 0x80 {
 				break
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: AMM: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AMM: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pair", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowState
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthState
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthState
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Pair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BaseReserve", wireType)
-			}
-			var stringLen uint64
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InsuranceFundDrawDown: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InsuranceFundDrawDown: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowState
@@ -1599,29 +9357,14 @@ func (m *AMM) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Id |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthState
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthState
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.BaseReserve.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field QuoteReserve", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pair", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1649,15 +9392,15 @@ func (m *AMM) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.QuoteReserve.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Pair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SqrtDepth", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowState
@@ -1667,31 +9410,30 @@ func (m *AMM) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthState
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthState
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SqrtDepth.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PriceMultiplier", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BadDebt", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowState
@@ -1701,31 +9443,30 @@ func (m *AMM) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthState
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthState
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.PriceMultiplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.BadDebt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalLong", wireType)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
 			}
-			var stringLen uint64
+			m.BlockHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowState
@@ -1735,31 +9476,16 @@ func (m *AMM) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.BlockHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthState
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthState
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.TotalLong.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalShort", wireType)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockTimeMs", wireType)
 			}
-			var stringLen uint64
+			m.BlockTimeMs = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowState
@@ -1769,26 +9495,11 @@ func (m *AMM) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.BlockTimeMs |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthState
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthState
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.TotalShort.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipState(dAtA[iNdEx:])
@@ -1810,7 +9521,7 @@ func (m *AMM) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Position) Unmarshal(dAtA []byte) error {
+func (m *FundingRate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1833,15 +9544,15 @@ func (m *Position) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Position: wiretype end group for non-group")
+			return fmt.Errorf("proto: FundingRate: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Position: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FundingRate: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TraderAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pair", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1869,11 +9580,32 @@ func (m *Position) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TraderAddress = string(dAtA[iNdEx:postIndex])
+			if err := m.Pair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochNumber", wireType)
+			}
+			m.EpochNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochNumber |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pair", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MarkTwap", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1901,13 +9633,13 @@ func (m *Position) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Pair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.MarkTwap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Size_", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IndexTwap", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1935,13 +9667,13 @@ func (m *Position) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Size_.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.IndexTwap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Margin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PremiumRate", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1969,13 +9701,13 @@ func (m *Position) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Margin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.PremiumRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OpenNotional", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InterestRate", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2003,13 +9735,13 @@ func (m *Position) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.OpenNotional.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.InterestRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LatestCumulativePremiumFraction", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FundingRate", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2037,15 +9769,15 @@ func (m *Position) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.LatestCumulativePremiumFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.FundingRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
+		case 8:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastUpdatedBlockNumber", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Clamped", wireType)
 			}
-			m.LastUpdatedBlockNumber = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowState
@@ -2055,66 +9787,51 @@ func (m *Position) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LastUpdatedBlockNumber |= int64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipState(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			m.Clamped = bool(v != 0)
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PremiumFraction", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthState
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ReserveSnapshot) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowState
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.PremiumFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ReserveSnapshot: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ReserveSnapshot: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amm", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CumulativePremiumFraction", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowState
@@ -2124,30 +9841,31 @@ func (m *ReserveSnapshot) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthState
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthState
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amm.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.CumulativePremiumFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 11:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TimestampMs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
 			}
-			m.TimestampMs = 0
+			m.BlockHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowState
@@ -2157,7 +9875,26 @@ func (m *ReserveSnapshot) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TimestampMs |= int64(b&0x7F) << shift
+				m.BlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockTimeMs", wireType)
+			}
+			m.BlockTimeMs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockTimeMs |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -0,0 +1,90 @@
+package v2
+
+import (
+	fmt "fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Validate performs stateless validation of a trigger order.
+func (m *TriggerOrder) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(m.TraderAddress); err != nil {
+		return err
+	}
+
+	if err := m.Pair.Validate(); err != nil {
+		return err
+	}
+
+	if m.TriggerPrice.IsNil() || !m.TriggerPrice.IsPositive() {
+		return fmt.Errorf("trigger price must be positive")
+	}
+
+	if m.PriceSource != TriggerPriceSource_MARK && m.PriceSource != TriggerPriceSource_INDEX {
+		return fmt.Errorf("invalid price source: %s", m.PriceSource)
+	}
+
+	if err := m.Collateral.Validate(); err != nil {
+		return err
+	}
+
+	if m.Collateral.Denom != m.Pair.QuoteDenom() {
+		return fmt.Errorf("invalid collateral denom, expected %s, got %s", m.Pair.QuoteDenom(), m.Collateral.Denom)
+	}
+
+	switch m.OrderType {
+	case TriggerOrderType_LIMIT_OPEN:
+		if m.Side != Direction_LONG && m.Side != Direction_SHORT {
+			return fmt.Errorf("invalid side")
+		}
+		if !m.Collateral.Amount.IsPositive() {
+			return fmt.Errorf("collateral must be positive")
+		}
+		if m.Leverage.IsNil() || !m.Leverage.IsPositive() {
+			return fmt.Errorf("leverage must be positive")
+		}
+		if m.BaseAssetAmountLimit.IsNil() || m.BaseAssetAmountLimit.IsNegative() {
+			return fmt.Errorf("base asset amount limit must not be negative")
+		}
+	case TriggerOrderType_STOP_LOSS, TriggerOrderType_TAKE_PROFIT:
+		if !m.Collateral.Amount.IsZero() {
+			return fmt.Errorf("close orders do not escrow collateral")
+		}
+	default:
+		return fmt.Errorf("invalid order type: %s", m.OrderType)
+	}
+
+	if m.CreatedBlockHeight < 0 {
+		return fmt.Errorf("invalid block number")
+	}
+
+	return nil
+}
+
+// IsTriggered returns whether the order must be executed at the given price.
+//
+// args:
+//   - price: the current price of the order's price source
+//   - positionSize: the size of the trader's position, used by close orders
+//     to know on which side of the trigger price the position loses money
+func (m *TriggerOrder) IsTriggered(price sdk.Dec, positionSize sdk.Dec) bool {
+	switch m.OrderType {
+	case TriggerOrderType_LIMIT_OPEN:
+		if m.Side == Direction_LONG {
+			return price.LTE(m.TriggerPrice)
+		}
+		return price.GTE(m.TriggerPrice)
+	case TriggerOrderType_STOP_LOSS:
+		if positionSize.IsPositive() {
+			return price.LTE(m.TriggerPrice)
+		}
+		return positionSize.IsNegative() && price.GTE(m.TriggerPrice)
+	case TriggerOrderType_TAKE_PROFIT:
+		if positionSize.IsPositive() {
+			return price.GTE(m.TriggerPrice)
+		}
+		return positionSize.IsNegative() && price.LTE(m.TriggerPrice)
+	default:
+		return false
+	}
+}
@@ -0,0 +1,46 @@
+package v2_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+
+	v2 "github.com/NibiruChain/nibiru/x/perp/types/v2"
+)
+
+func TestTriggerOrderIsTriggered(t *testing.T) {
+	tests := []struct {
+		name         string
+		orderType    v2.TriggerOrderType
+		side         v2.Direction
+		price        sdk.Dec
+		positionSize sdk.Dec
+		expected     bool
+	}{
+		{"limit long below trigger", v2.TriggerOrderType_LIMIT_OPEN, v2.Direction_LONG, sdk.NewDec(9), sdk.ZeroDec(), true},
+		{"limit long above trigger", v2.TriggerOrderType_LIMIT_OPEN, v2.Direction_LONG, sdk.NewDec(11), sdk.ZeroDec(), false},
+		{"limit short above trigger", v2.TriggerOrderType_LIMIT_OPEN, v2.Direction_SHORT, sdk.NewDec(11), sdk.ZeroDec(), true},
+		{"limit short below trigger", v2.TriggerOrderType_LIMIT_OPEN, v2.Direction_SHORT, sdk.NewDec(9), sdk.ZeroDec(), false},
+		{"stop loss long below trigger", v2.TriggerOrderType_STOP_LOSS, v2.Direction_DIRECTION_UNSPECIFIED, sdk.NewDec(9), sdk.OneDec(), true},
+		{"stop loss long above trigger", v2.TriggerOrderType_STOP_LOSS, v2.Direction_DIRECTION_UNSPECIFIED, sdk.NewDec(11), sdk.OneDec(), false},
+		{"stop loss short above trigger", v2.TriggerOrderType_STOP_LOSS, v2.Direction_DIRECTION_UNSPECIFIED, sdk.NewDec(11), sdk.NewDec(-1), true},
+		{"stop loss without position", v2.TriggerOrderType_STOP_LOSS, v2.Direction_DIRECTION_UNSPECIFIED, sdk.NewDec(11), sdk.ZeroDec(), false},
+		{"take profit long above trigger", v2.TriggerOrderType_TAKE_PROFIT, v2.Direction_DIRECTION_UNSPECIFIED, sdk.NewDec(11), sdk.OneDec(), true},
+		{"take profit long below trigger", v2.TriggerOrderType_TAKE_PROFIT, v2.Direction_DIRECTION_UNSPECIFIED, sdk.NewDec(9), sdk.OneDec(), false},
+		{"take profit short below trigger", v2.TriggerOrderType_TAKE_PROFIT, v2.Direction_DIRECTION_UNSPECIFIED, sdk.NewDec(9), sdk.NewDec(-1), true},
+		{"at the trigger price", v2.TriggerOrderType_TAKE_PROFIT, v2.Direction_DIRECTION_UNSPECIFIED, sdk.NewDec(10), sdk.NewDec(-1), true},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			order := v2.TriggerOrder{
+				OrderType:    tc.orderType,
+				Side:         tc.side,
+				TriggerPrice: sdk.NewDec(10),
+			}
+			assert.EqualValues(t, tc.expected, order.IsTriggered(tc.price, tc.positionSize))
+		})
+	}
+}
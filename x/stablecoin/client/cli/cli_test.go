@@ -54,7 +54,7 @@ func (s *IntegrationTestSuite) SetupSuite() {
 	genesisState[stabletypes.ModuleName] = encodingConfig.Marshaler.MustMarshalJSON(stableGen)
 
 	oracleGenesis := oracletypes.DefaultGenesisState()
-	oracleGenesis.ExchangeRates = []oracletypes.ExchangeRateTuple{
+	oracleGenesis.ExchangeRates = []oracletypes.GenesisExchangeRate{
 		{Pair: asset.Registry.Pair(denoms.NIBI, denoms.NUSD), ExchangeRate: sdk.NewDec(10)},
 		{Pair: asset.Registry.Pair(denoms.USDC, denoms.NUSD), ExchangeRate: sdk.NewDec(1)},
 	}
@@ -121,7 +121,7 @@ func (k *Keeper) StableRequiredForTargetCollRatio(
 
 	for _, collDenom := range collDenoms {
 		amtColl := moduleCoins.AmountOf(collDenom)
-		priceColl, err := k.OracleKeeper.GetExchangeRate(
+		priceColl, err := k.OracleKeeper.GetExchangeRateWithMaxAge(
 			ctx, asset.Registry.Pair(denoms.USDC, denoms.NUSD))
 		if err != nil {
 			return sdk.ZeroDec(), err
@@ -139,7 +139,7 @@ func (k *Keeper) RecollateralizeCollAmtForTargetCollRatio(
 	ctx sdk.Context,
 ) (neededCollAmount sdk.Int, err error) {
 	neededUSDForRecoll, _ := k.StableRequiredForTargetCollRatio(ctx)
-	priceCollStable, err := k.OracleKeeper.GetExchangeRate(
+	priceCollStable, err := k.OracleKeeper.GetExchangeRateWithMaxAge(
 		ctx, asset.Registry.Pair(denoms.USDC, denoms.NUSD))
 	if err != nil {
 		return sdk.Int{}, err
@@ -223,7 +223,7 @@ func (k Keeper) Recollateralize(
 	}
 
 	// Compute GOV rewarded to user
-	priceCollStable, err := k.OracleKeeper.GetExchangeRate(
+	priceCollStable, err := k.OracleKeeper.GetExchangeRateWithMaxAge(
 		ctx, asset.Registry.Pair(denoms.USDC, denoms.NUSD))
 	if err != nil {
 		return response, err
@@ -294,7 +294,7 @@ func (k *Keeper) GovAmtFromRecollateralize(
 	params := k.GetParams(ctx)
 	bonusRate := params.GetBonusRateRecollAsDec()
 
-	priceGovStable, err := k.OracleKeeper.GetExchangeRate(
+	priceGovStable, err := k.OracleKeeper.GetExchangeRateWithMaxAge(
 		ctx, asset.Registry.Pair(denoms.NIBI, denoms.NUSD))
 	if err != nil {
 		return sdk.Int{}, err
@@ -337,7 +337,7 @@ func (k *Keeper) BuybackGovAmtForTargetCollRatio(
 ) (neededGovAmt sdk.Int, err error) {
 	neededUSDForRecoll, _ := k.StableRequiredForTargetCollRatio(ctx)
 	neededUSDForBuyback := neededUSDForRecoll.Neg()
-	priceGovStable, err := k.OracleKeeper.GetExchangeRate(
+	priceGovStable, err := k.OracleKeeper.GetExchangeRateWithMaxAge(
 		ctx, asset.Registry.Pair(denoms.NIBI, denoms.NUSD))
 	if err != nil {
 		return sdk.Int{}, err
@@ -414,7 +414,7 @@ func (k Keeper) Buyback(
 	}
 
 	// Compute USD (stable) value of the GOV sent by the caller: 'inUSD'
-	priceGovStable, err := k.OracleKeeper.GetExchangeRate(
+	priceGovStable, err := k.OracleKeeper.GetExchangeRateWithMaxAge(
 		ctx, asset.Registry.Pair(denoms.NIBI, denoms.NUSD))
 	if err != nil {
 		return response, err
@@ -474,7 +474,7 @@ Returns:
 func (k *Keeper) CollAmtFromBuyback(
 	ctx sdk.Context, valUSD sdk.Dec,
 ) (collAmt sdk.Int, err error) {
-	priceCollStable, err := k.OracleKeeper.GetExchangeRate(
+	priceCollStable, err := k.OracleKeeper.GetExchangeRateWithMaxAge(
 		ctx, asset.Registry.Pair(denoms.USDC, denoms.NUSD))
 	if err != nil {
 		return sdk.Int{}, err
@@ -94,7 +94,7 @@ func (k Keeper) MintStable(
 func (k Keeper) calcNeededGovAndFees(
 	ctx sdk.Context, stable sdk.Coin, govRatio sdk.Dec, feeRatio sdk.Dec,
 ) (sdk.Coin, sdk.Coin, error) {
-	priceGov, err := k.OracleKeeper.GetExchangeRate(
+	priceGov, err := k.OracleKeeper.GetExchangeRateWithMaxAge(
 		ctx, asset.Registry.Pair(denoms.NIBI, denoms.NUSD))
 	if err != nil {
 		return sdk.Coin{}, sdk.Coin{}, err
@@ -116,7 +116,7 @@ func (k Keeper) calcNeededCollateralAndFees(
 	collRatio sdk.Dec,
 	feeRatio sdk.Dec,
 ) (sdk.Coin, sdk.Coin, error) {
-	priceColl, err := k.OracleKeeper.GetExchangeRate(
+	priceColl, err := k.OracleKeeper.GetExchangeRateWithMaxAge(
 		ctx, asset.Registry.Pair(denoms.USDC, denoms.NUSD))
 	if err != nil {
 		return sdk.Coin{}, sdk.Coin{}, err
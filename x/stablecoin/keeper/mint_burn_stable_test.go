@@ -2,6 +2,7 @@ package keeper_test
 
 import (
 	"testing"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -13,6 +14,7 @@ import (
 	"github.com/NibiruChain/nibiru/x/common/denoms"
 	"github.com/NibiruChain/nibiru/x/common/testutil"
 	"github.com/NibiruChain/nibiru/x/common/testutil/testapp"
+	oracletypes "github.com/NibiruChain/nibiru/x/oracle/types"
 	"github.com/NibiruChain/nibiru/x/stablecoin/types"
 )
 
@@ -324,6 +326,30 @@ func TestMsgMintStableResponse_NotEnoughFunds(t *testing.T) {
 	}
 }
 
+func TestMsgMintStableResponse_StalePrice(t *testing.T) {
+	nibiruApp, ctx := testapp.NewNibiruTestAppAndContext(true)
+	acc := testutil.AccAddress()
+
+	params := types.DefaultParams()
+	params.IsCollateralRatioValid = true
+	nibiruApp.StablecoinKeeper.SetParams(ctx, params)
+
+	nibiruApp.OracleKeeper.SetPrice(ctx, asset.Registry.Pair(denoms.NIBI, denoms.NUSD), sdk.NewDec(10))
+	nibiruApp.OracleKeeper.SetPrice(ctx, asset.Registry.Pair(denoms.USDC, denoms.NUSD), sdk.OneDec())
+
+	require.NoError(t, testapp.FundAccount(nibiruApp.BankKeeper, ctx, acc, sdk.NewCoins(
+		sdk.NewCoin(denoms.USDC, sdk.NewInt(9001)),
+		sdk.NewCoin(denoms.NIBI, sdk.NewInt(9001)),
+	)))
+
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(oracletypes.DefaultMaxPriceAge + time.Second))
+	_, err := nibiruApp.StablecoinKeeper.MintStable(sdk.WrapSDKContext(ctx), &types.MsgMintStable{
+		Creator: acc.String(),
+		Stable:  sdk.NewCoin(denoms.NUSD, sdk.NewInt(100)),
+	})
+	require.ErrorIs(t, err, oracletypes.ErrStalePrice)
+}
+
 // ------------------------------------------------------------------
 // BurnStable / Redeem
 // ------------------------------------------------------------------
@@ -35,7 +35,7 @@ type BankKeeper interface {
 }
 
 type OracleKeeper interface {
-	GetExchangeRate(ctx sdk.Context, pair asset.Pair) (sdk.Dec, error)
+	GetExchangeRateWithMaxAge(ctx sdk.Context, pair asset.Pair) (sdk.Dec, error)
 	GetExchangeRateTwap(ctx sdk.Context, pair asset.Pair) (sdk.Dec, error)
 }
 
@@ -18,6 +18,7 @@ type BindingMsg struct {
 	DonateToInsuranceFund *DonateToInsuranceFund `json:"donate_to_insurance_fund,omitempty"` // TODO
 	PegShift              *PegShift              `json:"peg_shift,omitempty"`
 	DepthShift            *DepthShift            `json:"depth_shift,omitempty"`
+	ResetCircuitBreaker   *ResetCircuitBreaker   `json:"reset_circuit_breaker,omitempty"`
 }
 
 type OpenPosition struct {
@@ -66,6 +67,10 @@ type DepthShift struct {
 	DepthMult sdk.Dec `json:"depth_mult"`
 }
 
+type ResetCircuitBreaker struct {
+	Pair string `json:"pair"`
+}
+
 type DonateToInsuranceFund struct {
 	Sender   string   `json:"sender"`
 	Donation sdk.Coin `json:"donation"`
@@ -107,6 +107,7 @@ func (s *TestSuiteBindingJsonTypes) TestExecuteMsgs() {
 		"donate_to_insurance_fund": new(cw_struct.DonateToInsuranceFund),
 		"peg_shift":                new(cw_struct.PegShift),
 		"depth_shift":              new(cw_struct.DepthShift),
+		"reset_circuit_breaker":    new(cw_struct.ResetCircuitBreaker),
 	}
 
 	for name, cwExecuteMsgPtr := range testCaseMap {
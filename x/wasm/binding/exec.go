@@ -12,6 +12,7 @@ import (
 
 	"github.com/NibiruChain/nibiru/x/common/set"
 	perpkeeper "github.com/NibiruChain/nibiru/x/perp/keeper/v1"
+	perpv2keeper "github.com/NibiruChain/nibiru/x/perp/keeper/v2"
 	"github.com/NibiruChain/nibiru/x/sudo"
 	"github.com/NibiruChain/nibiru/x/wasm/binding/cw_struct"
 )
@@ -86,6 +87,13 @@ func (messenger *CustomWasmExecutor) DispatchMsg(
 			cwMsg := contractExecuteMsg.ExecuteMsg.DepthShift
 			err = messenger.Perp.DepthShift(cwMsg, contractAddr, ctx)
 			return events, data, err
+		case contractExecuteMsg.ExecuteMsg.ResetCircuitBreaker != nil:
+			if err := messenger.CheckPermissions(contractAddr, ctx); err != nil {
+				return events, data, err
+			}
+			cwMsg := contractExecuteMsg.ExecuteMsg.ResetCircuitBreaker
+			err = messenger.Perp.ResetCircuitBreaker(cwMsg, ctx)
+			return events, data, err
 		default:
 			err = wasmvmtypes.InvalidRequest{
 				Err:     "invalid bindings request",
@@ -100,12 +108,13 @@ func (messenger *CustomWasmExecutor) DispatchMsg(
 
 func CustomExecuteMsgHandler(
 	perp perpkeeper.Keeper,
+	perpV2 perpv2keeper.Keeper,
 	sudoKeeper sudo.Keeper,
 ) func(wasmkeeper.Messenger) wasmkeeper.Messenger {
 	return func(originalWasmMessenger wasmkeeper.Messenger) wasmkeeper.Messenger {
 		return &CustomWasmExecutor{
 			Wasm: originalWasmMessenger,
-			Perp: &ExecutorPerp{Perp: perp},
+			Perp: &ExecutorPerp{Perp: perp, PerpV2: perpV2},
 			Sudo: &sudoKeeper,
 		}
 	}
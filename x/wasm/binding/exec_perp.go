@@ -7,12 +7,14 @@ import (
 	"github.com/NibiruChain/nibiru/x/common/asset"
 	perpammtypes "github.com/NibiruChain/nibiru/x/perp/amm/types"
 	perpkeeper "github.com/NibiruChain/nibiru/x/perp/keeper/v1"
+	perpv2keeper "github.com/NibiruChain/nibiru/x/perp/keeper/v2"
 	perptypes "github.com/NibiruChain/nibiru/x/perp/types/v1"
 	"github.com/NibiruChain/nibiru/x/wasm/binding/cw_struct"
 )
 
 type ExecutorPerp struct {
-	Perp perpkeeper.Keeper
+	Perp   perpkeeper.Keeper
+	PerpV2 perpv2keeper.Keeper
 }
 
 func (exec *ExecutorPerp) MsgServer() perptypes.MsgServer {
@@ -163,3 +165,18 @@ func (exec *ExecutorPerp) DepthShift(
 		cwMsg.DepthMult,
 	)
 }
+
+func (exec *ExecutorPerp) ResetCircuitBreaker(
+	cwMsg *cw_struct.ResetCircuitBreaker, ctx sdk.Context,
+) (err error) {
+	if cwMsg == nil {
+		return wasmvmtypes.InvalidRequest{Err: "null reset circuit breaker msg"}
+	}
+
+	pair, err := asset.TryNewPair(cwMsg.Pair)
+	if err != nil {
+		return err
+	}
+
+	return exec.PerpV2.ResetCircuitBreaker(ctx, pair)
+}
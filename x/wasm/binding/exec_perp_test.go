@@ -64,7 +64,8 @@ func (s *TestSuitePerpExecutor) SetupSuite() {
 	wasmkeeper.NewMsgServerImpl(wasmkeeper.NewDefaultPermissionKeeper(nibiru.WasmKeeper))
 	s.contractPerp = ContractMap[wasmbin.WasmKeyPerpBinding]
 	s.exec = &binding.ExecutorPerp{
-		Perp: nibiru.PerpKeeper,
+		Perp:   nibiru.PerpKeeper,
+		PerpV2: nibiru.PerpKeeperV2,
 	}
 	s.OnSetupEnd()
 }
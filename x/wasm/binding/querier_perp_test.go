@@ -47,7 +47,7 @@ func SetExchangeRates(
 		exchangeRate := exchangeRateTuple.ExchangeRate
 		nibiru.OracleKeeper.SetPrice(ctx, pair, exchangeRate)
 
-		rate, err := nibiru.OracleKeeper.ExchangeRates.Get(ctx, pair)
+		rate, err := nibiru.OracleKeeper.GetExchangeRate(ctx, pair)
 		s.Assert().NoError(err)
 		s.Assert().EqualValues(exchangeRate, rate)
 	}
@@ -6,12 +6,14 @@ import (
 
 	perpammkeeper "github.com/NibiruChain/nibiru/x/perp/amm/keeper"
 	perpkeeper "github.com/NibiruChain/nibiru/x/perp/keeper/v1"
+	perpv2keeper "github.com/NibiruChain/nibiru/x/perp/keeper/v2"
 	"github.com/NibiruChain/nibiru/x/sudo"
 )
 
 func RegisterWasmOptions(
 	perp *perpkeeper.Keeper,
 	perpAmm *perpammkeeper.Keeper,
+	perpV2 *perpv2keeper.Keeper,
 	sudoKeeper *sudo.Keeper,
 ) []wasm.Option {
 	// Custom querier
@@ -21,7 +23,7 @@ func RegisterWasmOptions(
 	})
 
 	wasmExecuteOption := wasmkeeper.WithMessageHandlerDecorator(
-		CustomExecuteMsgHandler(*perp, *sudoKeeper),
+		CustomExecuteMsgHandler(*perp, *perpV2, *sudoKeeper),
 	)
 
 	return []wasm.Option{wasmQueryOption, wasmExecuteOption}